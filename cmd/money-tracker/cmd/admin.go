@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/repository"
+	"icekalt.dev/money-tracker/internal/service"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Administer the instance",
+	Long: `Administer the instance directly in the database.
+
+Users are given by ID, email address or local username.`,
+}
+
+var adminUsersCmd = &cobra.Command{
+	Use:   "users",
+	Short: "Manage users",
+}
+
+var adminUsersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all users",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		adminSvc, closeDB, err := openAdminService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		users, err := adminSvc.ListUsers(service.WithOperator(context.Background()))
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tEMAIL\tNAME\tADMIN\tSTATUS\tCREATED")
+		for _, u := range users {
+			admin := ""
+			if u.Admin {
+				admin = "yes"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", u.ID, u.Email, u.Name, admin, userStatus(u), u.CreatedAt.Format(time.DateOnly))
+		}
+		return w.Flush()
+	},
+}
+
+var adminUsersDisableCmd = &cobra.Command{
+	Use:   "disable USER",
+	Short: "Disable a user and log them out everywhere",
+	Long: `Disable a user. They can't log in anymore, and their sessions and API
+tokens are revoked. Their households are kept.`,
+	Args: cobra.ExactArgs(1),
+	RunE: adminUserAction(func(ctx context.Context, svc *service.AdminService, user *domain.User) error {
+		if err := svc.Disable(ctx, user.ID, time.Now()); err != nil {
+			return err
+		}
+		logger.Info("disabled user", zap.Int("id", user.ID), zap.String("email", user.Email))
+		return nil
+	}),
+}
+
+var adminUsersEnableCmd = &cobra.Command{
+	Use:   "enable USER",
+	Short: "Enable a disabled user",
+	Args:  cobra.ExactArgs(1),
+	RunE: adminUserAction(func(ctx context.Context, svc *service.AdminService, user *domain.User) error {
+		if err := svc.Enable(ctx, user.ID); err != nil {
+			return err
+		}
+		logger.Info("enabled user", zap.Int("id", user.ID), zap.String("email", user.Email))
+		return nil
+	}),
+}
+
+var adminUsersDeleteCmd = &cobra.Command{
+	Use:   "delete USER",
+	Short: "Delete a user and all households they own",
+	Args:  cobra.ExactArgs(1),
+	RunE: adminUserAction(func(ctx context.Context, svc *service.AdminService, user *domain.User) error {
+		if !confirm(fmt.Sprintf("Delete %s and all households they own? This can't be undone. [y/N] ", user.Email)) {
+			logger.Info("deletion cancelled")
+			return nil
+		}
+		if err := svc.Delete(ctx, user.ID); err != nil {
+			return err
+		}
+		logger.Info("deleted user", zap.Int("id", user.ID), zap.String("email", user.Email))
+		return nil
+	}),
+}
+
+var adminUsersPromoteCmd = &cobra.Command{
+	Use:   "promote USER",
+	Short: "Make a user an instance admin",
+	Long: `Make a user an instance admin.
+
+If auth.oidc.admin_groups is set, the admin flag of OIDC users follows their
+groups on every login and overrides this.`,
+	Args: cobra.ExactArgs(1),
+	RunE: adminUserAction(func(ctx context.Context, svc *service.AdminService, user *domain.User) error {
+		if err := svc.SetAdmin(ctx, user.ID, true); err != nil {
+			return err
+		}
+		logger.Info("promoted user to admin", zap.Int("id", user.ID), zap.String("email", user.Email))
+		return nil
+	}),
+}
+
+var adminUsersDemoteCmd = &cobra.Command{
+	Use:   "demote USER",
+	Short: "Remove the instance admin role from a user",
+	Args:  cobra.ExactArgs(1),
+	RunE: adminUserAction(func(ctx context.Context, svc *service.AdminService, user *domain.User) error {
+		if err := svc.SetAdmin(ctx, user.ID, false); err != nil {
+			return err
+		}
+		logger.Info("removed admin role", zap.Int("id", user.ID), zap.String("email", user.Email))
+		return nil
+	}),
+}
+
+var adminUsersTokensCmd = &cobra.Command{
+	Use:   "tokens",
+	Short: "Manage the API tokens of a user",
+}
+
+var adminUsersTokensRevokeCmd = &cobra.Command{
+	Use:   "revoke USER",
+	Short: "Revoke all API tokens of a user",
+	Args:  cobra.ExactArgs(1),
+	RunE: adminUserAction(func(ctx context.Context, svc *service.AdminService, user *domain.User) error {
+		n, err := svc.RevokeTokens(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		logger.Info("revoked api tokens", zap.Int("id", user.ID), zap.String("email", user.Email), zap.Int("count", n))
+		return nil
+	}),
+}
+
+// adminUserAction looks up the user given as the only argument and runs fn.
+func adminUserAction(fn func(ctx context.Context, svc *service.AdminService, user *domain.User) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		adminSvc, closeDB, err := openAdminService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		ctx := service.WithOperator(context.Background())
+		user, err := adminSvc.FindUser(ctx, args[0])
+		if err != nil {
+			return fmt.Errorf("finding user: %w", err)
+		}
+		return fn(ctx, adminSvc, user)
+	}
+}
+
+func userStatus(u *domain.User) string {
+	switch {
+	case u.Disabled():
+		return "disabled"
+	case u.Invited():
+		return "invited"
+	}
+	return "active"
+}
+
+func openAdminService() (*service.AdminService, func(), error) {
+	drv, err := repository.OpenDriver(cfg.Database)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to database: %w", err)
+	}
+	client := repository.NewClientFromDriver(drv)
+	events := service.NewSecurityEventService(repository.NewSecurityEventRepository(client))
+	adminSvc := service.NewAdminService(
+		repository.NewUserRepository(client),
+		repository.NewLocalCredentialRepository(client),
+		repository.NewHouseholdRepository(client),
+		repository.NewAPITokenRepository(client),
+		repository.NewSessionRepository(client),
+		repository.NewRevisionRepository(client),
+		repository.NewStatsRepository(client, drv),
+		events,
+		repository.NewTransactor(client),
+	)
+	return adminSvc, func() { client.Close() }, nil
+}
+
+func init() {
+	adminUsersDeleteCmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "skip confirmation")
+	adminUsersTokensCmd.AddCommand(adminUsersTokensRevokeCmd)
+	adminUsersCmd.AddCommand(adminUsersListCmd, adminUsersDisableCmd, adminUsersEnableCmd, adminUsersDeleteCmd,
+		adminUsersPromoteCmd, adminUsersDemoteCmd, adminUsersTokensCmd)
+	adminCmd.AddCommand(adminUsersCmd)
+	rootCmd.AddCommand(adminCmd)
+}
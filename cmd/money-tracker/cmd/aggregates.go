@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/repository"
+	"icekalt.dev/money-tracker/internal/service"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var aggregatesCmd = &cobra.Command{
+	Use:   "aggregates",
+	Short: "Manage the stored monthly aggregates",
+}
+
+var aggregatesRebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Recompute all monthly aggregates",
+	Long: `Delete all stored monthly aggregates and recompute them from the raw data.
+
+Aggregates are kept up to date automatically and missing months are computed
+on demand. Run this after restoring a backup, after changing data directly in
+the database, or to warm up the aggregates of a large installation.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := repository.NewClient(cfg.Database)
+		if err != nil {
+			return fmt.Errorf("connecting to database: %w", err)
+		}
+		defer client.Close()
+
+		ctx := context.Background()
+		householdRepo := repository.NewHouseholdRepository(client)
+		aggregateRepo := repository.NewMonthlyAggregateRepository(client)
+		summarySvc := service.NewSummaryService(
+			repository.NewTransactionRepository(client),
+			repository.NewRecurringExpenseRepository(client),
+			repository.NewRecurringScheduleOverrideRepository(client),
+			repository.NewCategoryRepository(client),
+			aggregateRepo,
+			nil,
+		)
+
+		deleted, err := aggregateRepo.DeleteAll(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting aggregates: %w", err)
+		}
+		logger.Info("deleted stored aggregates", zap.Int("count", deleted))
+
+		households, err := householdRepo.ListAll(ctx)
+		if err != nil {
+			return fmt.Errorf("listing households: %w", err)
+		}
+		ids := make([]int, len(households))
+		for i, hh := range households {
+			ids[i] = hh.ID
+		}
+
+		stored, err := summarySvc.RebuildAggregates(ctx, ids, time.Now())
+		if err != nil {
+			return fmt.Errorf("rebuilding aggregates: %w", err)
+		}
+		logger.Info("aggregates rebuilt", zap.Int("households", len(ids)), zap.Int("months", stored))
+		return nil
+	},
+}
+
+func init() {
+	aggregatesCmd.AddCommand(aggregatesRebuildCmd)
+	rootCmd.AddCommand(aggregatesCmd)
+}
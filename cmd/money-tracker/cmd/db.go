@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/dbcopy"
+	"icekalt.dev/money-tracker/internal/migration"
+	"icekalt.dev/money-tracker/internal/repository"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	copyFromDriver string
+	copyFromDSN    string
+	copyToDriver   string
+	copyToDSN      string
+	copyBatchSize  int
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance commands",
+}
+
+var dbCopyCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copy all data from one database to another",
+	Long: `Copy all data from a source database to an empty target database.
+
+The source must be fully migrated; the target schema is created automatically. All tables are copied in
+dependency order with their IDs preserved, and row counts are verified
+afterwards. The source defaults to the configured database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from := cfg.Database
+		if copyFromDriver != "" {
+			from.Driver = copyFromDriver
+		}
+		if copyFromDSN != "" {
+			from.DSN = copyFromDSN
+		}
+		to := config.DatabaseConfig{Driver: copyToDriver, DSN: copyToDSN}
+		if from == to {
+			return fmt.Errorf("source and target database are identical")
+		}
+
+		ctx := context.Background()
+
+		srcDrv, err := repository.OpenDriver(from)
+		if err != nil {
+			return fmt.Errorf("connecting to source database: %w", err)
+		}
+		defer srcDrv.Close()
+
+		srcMigrator, err := migration.New(srcDrv.DB(), srcDrv.Dialect())
+		if err != nil {
+			return err
+		}
+		if err := srcMigrator.Check(ctx); err != nil {
+			return fmt.Errorf("source database: %w; run \"money-tracker migrate up\" on it first", err)
+		}
+
+		dstDrv, err := repository.OpenDriver(to)
+		if err != nil {
+			return fmt.Errorf("connecting to target database: %w", err)
+		}
+		defer dstDrv.Close()
+
+		logger.Info("creating target schema", zap.String("driver", to.Driver))
+		migrator, err := migration.New(dstDrv.DB(), dstDrv.Dialect())
+		if err != nil {
+			return err
+		}
+		if _, err := migrator.Up(ctx); err != nil {
+			return fmt.Errorf("creating target schema: %w", err)
+		}
+
+		report, err := dbcopy.Copy(ctx, srcDrv, dstDrv, dbcopy.Options{
+			BatchSize: copyBatchSize,
+			Logger:    logger,
+		})
+		if report != nil {
+			for _, t := range report.Tables {
+				fmt.Fprintf(cmd.OutOrStdout(), "%-32s %8d -> %8d\n", t.Table, t.Source, t.Target)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		logger.Info("database copy completed")
+		return nil
+	},
+}
+
+func init() {
+	dbCopyCmd.Flags().StringVar(&copyFromDriver, "from-driver", "", "source database driver (default: configured driver)")
+	dbCopyCmd.Flags().StringVar(&copyFromDSN, "from-dsn", "", "source database DSN (default: configured DSN)")
+	dbCopyCmd.Flags().StringVar(&copyToDriver, "to-driver", "", "target database driver (sqlite or postgres)")
+	dbCopyCmd.Flags().StringVar(&copyToDSN, "to-dsn", "", "target database DSN")
+	dbCopyCmd.Flags().IntVar(&copyBatchSize, "batch-size", dbcopy.DefaultBatchSize, "rows per insert batch")
+	_ = dbCopyCmd.MarkFlagRequired("to-driver")
+	_ = dbCopyCmd.MarkFlagRequired("to-dsn")
+
+	dbCmd.AddCommand(dbCopyCmd)
+	rootCmd.AddCommand(dbCmd)
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/devidp"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	devIDPListen       string
+	devIDPIssuer       string
+	devIDPClientID     string
+	devIDPClientSecret string
+	devIDPRedirectURLs []string
+	devIDPUsersFile    string
+)
+
+var devIDPCmd = &cobra.Command{
+	Use:   "dev-idp",
+	Short: "Start a development OIDC provider for local demos",
+	Long: `Start a small OpenID Connect provider to try the OIDC login without a
+real identity provider. Its login page lets anyone log in as any of its
+users without a password, so never make it reachable from other machines.
+
+Without --users it has two demo users, alice (in the group "admins") and
+bob. A users file is a JSON list like
+  [{"sub": "carol", "email": "carol@example.com", "email_verified": true,
+    "name": "Carol", "groups": ["family"], "claims": {"locale": "de"}}]`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(devIDPRedirectURLs) == 0 {
+			return errors.New("--redirect-url is required")
+		}
+		issuer := devIDPIssuer
+		if issuer == "" {
+			issuer = "http://" + devIDPListen
+		}
+		users := devidp.DemoUsers
+		if devIDPUsersFile != "" {
+			data, err := os.ReadFile(devIDPUsersFile)
+			if err != nil {
+				return fmt.Errorf("reading users: %w", err)
+			}
+			users = nil
+			if err := json.Unmarshal(data, &users); err != nil {
+				return fmt.Errorf("parsing %s: %w", devIDPUsersFile, err)
+			}
+		}
+
+		idp, err := devidp.New(issuer, users...)
+		if err != nil {
+			return err
+		}
+		idp.Clients = []devidp.Client{{ID: devIDPClientID, Secret: devIDPClientSecret, RedirectURIs: devIDPRedirectURLs}}
+
+		fmt.Fprintf(cmd.OutOrStdout(), `Development OIDC provider at %s. Start Money Tracker with:
+
+  MONEY_TRACKER_AUTH_OIDC_ISSUER=%s
+  MONEY_TRACKER_AUTH_OIDC_CLIENT_ID=%s
+  MONEY_TRACKER_AUTH_OIDC_CLIENT_SECRET=%s
+  MONEY_TRACKER_AUTH_OIDC_REDIRECT_URL=%s
+
+`, issuer, issuer, devIDPClientID, devIDPClientSecret, devIDPRedirectURLs[0])
+
+		return serveDevIDP(cmd.Context(), idp)
+	},
+}
+
+func serveDevIDP(ctx context.Context, idp *devidp.Provider) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{Addr: devIDPListen, Handler: idp, ReadHeaderTimeout: 10 * time.Second}
+	logger.Info("starting development OIDC provider", zap.String("addr", devIDPListen), zap.String("issuer", idp.Issuer()))
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		return err
+	}
+}
+
+func init() {
+	devIDPCmd.Flags().StringVar(&devIDPListen, "listen", "localhost:9000", "address to listen on")
+	devIDPCmd.Flags().StringVar(&devIDPIssuer, "issuer", "", "issuer URL (default http://<listen>)")
+	devIDPCmd.Flags().StringVar(&devIDPClientID, "client-id", "money-tracker", "client ID Money Tracker uses")
+	devIDPCmd.Flags().StringVar(&devIDPClientSecret, "client-secret", "dev-secret", "client secret Money Tracker uses")
+	devIDPCmd.Flags().StringSliceVar(&devIDPRedirectURLs, "redirect-url", []string{"http://localhost:8080/auth/callback"}, "allowed redirect URLs")
+	devIDPCmd.Flags().StringVar(&devIDPUsersFile, "users", "", "JSON file with the users (default alice and bob)")
+	rootCmd.AddCommand(devIDPCmd)
+}
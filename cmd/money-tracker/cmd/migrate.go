@@ -3,44 +3,265 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
 
+	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/migration"
 	"icekalt.dev/money-tracker/internal/repository"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 )
 
-var autoApprove bool
+var (
+	autoApprove bool
+
+	diffDialect string
+	diffDevDSN  string
+	diffDir     string
+	diffVersion string
+)
 
 var migrateCmd = &cobra.Command{
 	Use:   "migrate",
 	Short: "Run database migrations",
+	Long: `Manage the versioned database migrations.
+
+Without a subcommand, migrate applies all pending migrations like "migrate up".
+Databases created by versions without migration history are upgraded once by
+comparing them with the current schema and then recorded as fully migrated.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrateUp()
+	},
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if !autoApprove {
-			fmt.Print("Run database migrations? [y/N] ")
-			var answer string
-			_, _ = fmt.Scanln(&answer)
-			if answer != "y" && answer != "Y" {
-				logger.Info("migration cancelled")
-				return nil
+		return migrateUp()
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down N",
+	Short: "Revert the last N migrations",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return fmt.Errorf("N must be a positive number")
+		}
+		if !confirm(fmt.Sprintf("Revert the last %d migration(s)? This may drop data. [y/N] ", n)) {
+			logger.Info("migration cancelled")
+			return nil
+		}
+
+		m, closeDB, err := openMigrator(cfg.Database)
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		reverted, err := m.Down(context.Background(), n)
+		for _, mig := range reverted {
+			logger.Info("reverted migration", zap.String("version", mig.Version), zap.String("name", mig.Name))
+		}
+		if err != nil {
+			return fmt.Errorf("reverting migrations: %w", err)
+		}
+		return nil
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List migrations and whether they are applied",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, closeDB, err := openMigrator(cfg.Database)
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		ctx := context.Background()
+		legacy, err := m.Legacy(ctx)
+		if err != nil {
+			return err
+		}
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+		for _, s := range status {
+			applied := "pending"
+			switch {
+			case s.Unknown:
+				applied = s.AppliedAt.Format(time.RFC3339) + " (unknown to this version)"
+			case s.Applied():
+				applied = s.AppliedAt.Format(time.RFC3339)
 			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", s.Migration.Version, s.Migration.Name, applied)
 		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if legacy {
+			fmt.Fprintln(cmd.OutOrStdout(), "\nThe database has no migration history yet. \"migrate up\" upgrades it and records all migrations as applied.")
+		}
+		return nil
+	},
+}
+
+var migratePlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Print the SQL of all pending migrations without applying them",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		m, closeDB, err := openMigrator(cfg.Database)
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		ctx := context.Background()
+		out := cmd.OutOrStdout()
+		legacy, err := m.Legacy(ctx)
+		if err != nil {
+			return err
+		}
+		if legacy {
+			fmt.Fprintln(out, "-- The database has no migration history yet. \"migrate up\" compares it with")
+			fmt.Fprintln(out, "-- the current schema, applies the difference and records all migrations.")
+			return nil
+		}
+
+		pending, err := m.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			fmt.Fprintln(out, "-- The database schema is up to date.")
+			return nil
+		}
+		for _, mig := range pending {
+			fmt.Fprintf(out, "-- %s_%s\n", mig.Version, mig.Name)
+			for _, stmt := range mig.Up {
+				fmt.Fprintln(out, stmt)
+			}
+			fmt.Fprintln(out)
+		}
+		return nil
+	},
+}
 
-		client, err := repository.NewClient(cfg.Database)
+var migrateDiffCmd = &cobra.Command{
+	Use:   "diff NAME",
+	Short: "Generate a migration from changes to the ent schema (development)",
+	Long: `Generate a migration for the changes made to the ent schema since the last
+migration and write it to the migration directory of the chosen dialect.
+
+The existing migrations are replayed on an empty dev database to compute their
+state. SQLite uses an in-memory database; Postgres needs --dev-dsn pointing to
+an empty database. Generate both dialects with the same --version, e.g. via
+"make migration name=NAME dev_pg=DSN".`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dev := config.DatabaseConfig{Driver: diffDialect, DSN: diffDevDSN}
+		if dev.DSN == "" {
+			if diffDialect != "sqlite" {
+				return fmt.Errorf("--dev-dsn is required for %s", diffDialect)
+			}
+			dev.DSN = "file:dev?mode=memory&cache=shared&_pragma=foreign_keys(1)"
+		}
+		version := diffVersion
+		if version == "" {
+			version = time.Now().UTC().Format("20060102150405")
+		}
+
+		drv, err := repository.OpenDriver(dev)
 		if err != nil {
-			return fmt.Errorf("connecting to database: %w", err)
+			return fmt.Errorf("connecting to dev database: %w", err)
 		}
-		defer client.Close()
+		defer drv.Close()
 
-		logger.Info("running migrations")
-		if err := client.Schema.Create(context.Background()); err != nil {
-			return fmt.Errorf("running migrations: %w", err)
+		written, err := migration.Diff(context.Background(), drv, diffDir, version, args[0])
+		if err != nil {
+			return fmt.Errorf("generating migration: %w", err)
 		}
-		logger.Info("migrations completed")
+		if !written {
+			logger.Info("schema unchanged, no migration generated", zap.String("dialect", diffDialect))
+			return nil
+		}
+		logger.Info("migration generated", zap.String("dialect", diffDialect), zap.String("version", version))
 		return nil
 	},
 }
 
+func migrateUp() error {
+	if !confirm("Run database migrations? [y/N] ") {
+		logger.Info("migration cancelled")
+		return nil
+	}
+
+	m, closeDB, err := openMigrator(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	logger.Info("running migrations")
+	applied, err := m.Up(context.Background())
+	for _, mig := range applied {
+		logger.Info("applied migration", zap.String("version", mig.Version), zap.String("name", mig.Name))
+	}
+	if err != nil {
+		return fmt.Errorf("running migrations: %w", err)
+	}
+	logger.Info("migrations completed")
+	return nil
+}
+
+// openMigrator connects to the database and returns a migrator for it along
+// with a function closing the connection.
+func openMigrator(dbCfg config.DatabaseConfig) (*migration.Migrator, func(), error) {
+	drv, err := repository.OpenDriver(dbCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to database: %w", err)
+	}
+	m, err := migration.New(drv.DB(), drv.Dialect())
+	if err != nil {
+		drv.Close()
+		return nil, nil, err
+	}
+	return m, func() { drv.Close() }, nil
+}
+
+func confirm(prompt string) bool {
+	if autoApprove {
+		return true
+	}
+	fmt.Print(prompt)
+	var answer string
+	_, _ = fmt.Scanln(&answer)
+	return answer == "y" || answer == "Y"
+}
+
 func init() {
-	migrateCmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "skip migration confirmation")
+	migrateCmd.PersistentFlags().BoolVar(&autoApprove, "auto-approve", false, "skip migration confirmation")
+	migrateDiffCmd.Flags().StringVar(&diffDialect, "dialect", "sqlite", "dialect to generate the migration for (sqlite or postgres)")
+	migrateDiffCmd.Flags().StringVar(&diffDevDSN, "dev-dsn", "", "DSN of an empty dev database (default: in-memory SQLite)")
+	migrateDiffCmd.Flags().StringVar(&diffDir, "dir", "internal/migration", "migration directory containing the dialect subdirectories")
+	migrateDiffCmd.Flags().StringVar(&diffVersion, "version", "", "migration version (default: current UTC time as YYYYMMDDHHMMSS)")
+
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migratePlanCmd, migrateDiffCmd)
 	rootCmd.AddCommand(migrateCmd)
 }
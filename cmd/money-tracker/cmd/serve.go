@@ -5,52 +5,84 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"net"
+	"slices"
+	"time"
 
 	"errors"
 
+	"icekalt.dev/money-tracker/ent"
 	"icekalt.dev/money-tracker/internal/api"
 	authpkg "icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/devmode"
 	"icekalt.dev/money-tracker/internal/domain"
+	mw "icekalt.dev/money-tracker/internal/middleware"
+	"icekalt.dev/money-tracker/internal/ratelimit"
 	"icekalt.dev/money-tracker/internal/repository"
 	"icekalt.dev/money-tracker/internal/service"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 )
 
+var noAutoMigrate bool
+
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the HTTP server",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := repository.NewClient(cfg.Database)
+		if err := prepareSchema(context.Background()); err != nil {
+			return err
+		}
+
+		drv, err := repository.OpenDriver(cfg.Database)
 		if err != nil {
 			return fmt.Errorf("connecting to database: %w", err)
 		}
+		client := repository.NewClientFromDriver(drv)
 		defer client.Close()
 
-		// Auto-migrate
-		if err := client.Schema.Create(context.Background()); err != nil {
-			return fmt.Errorf("running migrations: %w", err)
-		}
-
 		// Repositories
 		userRepo := repository.NewUserRepository(client)
 		householdRepo := repository.NewHouseholdRepository(client)
+		transferRepo := repository.NewHouseholdTransferRepository(client)
 		categoryRepo := repository.NewCategoryRepository(client)
 		txRepo := repository.NewTransactionRepository(client)
 		recurringRepo := repository.NewRecurringExpenseRepository(client)
 		overrideRepo := repository.NewRecurringScheduleOverrideRepository(client)
+		aggregateRepo := repository.NewMonthlyAggregateRepository(client)
 		tokenRepo := repository.NewAPITokenRepository(client)
+		memberRepo := repository.NewHouseholdMemberRepository(client)
+		settlementRepo := repository.NewSettlementRepository(client)
+		sessionRepo := repository.NewSessionRepository(client)
+		credentialRepo := repository.NewLocalCredentialRepository(client)
+		identityRepo := repository.NewUserIdentityRepository(client)
+		statsRepo := repository.NewStatsRepository(client, drv)
+		eventRepo := repository.NewSecurityEventRepository(client)
+		revisionRepo := repository.NewRevisionRepository(client)
+		transactor := repository.NewTransactor(client)
 		settingsRepo := repository.NewSettingsRepository(client)
 
 		// Services
-		userSvc := service.NewUserService(userRepo)
-		householdSvc := service.NewHouseholdService(householdRepo, categoryRepo, txRepo, recurringRepo)
+		userSvc := service.NewUserService(userRepo, credentialRepo, identityRepo)
+		eventSvc := service.NewSecurityEventService(eventRepo)
+		householdSvc := service.NewHouseholdService(householdRepo, categoryRepo, txRepo, recurringRepo, eventSvc)
 		categorySvc := service.NewCategoryService(categoryRepo, householdSvc)
-		txSvc := service.NewTransactionService(txRepo, householdSvc)
-		recurringSvc := service.NewRecurringExpenseService(recurringRepo, overrideRepo, householdSvc)
-		summarySvc := service.NewSummaryService(txRepo, recurringRepo, overrideRepo, categoryRepo, householdSvc)
-		tokenSvc := service.NewAPITokenService(tokenRepo)
+		memberSvc := service.NewMemberService(memberRepo, householdSvc)
+		txSvc := service.NewTransactionService(txRepo, householdSvc, memberSvc)
+		recurringSvc := service.NewRecurringExpenseService(recurringRepo, overrideRepo, householdSvc, memberSvc)
+		settlementSvc := service.NewSettlementService(settlementRepo, memberRepo, txRepo, recurringRepo, overrideRepo, householdSvc)
+		summarySvc := service.NewSummaryService(txRepo, recurringRepo, overrideRepo, categoryRepo, aggregateRepo, householdSvc)
+		tokenSvc := service.NewAPITokenService(tokenRepo, householdSvc, eventSvc)
+		sessionSvc := service.NewSessionService(sessionRepo, eventSvc)
+		adminSvc := service.NewAdminService(userRepo, credentialRepo, householdRepo, tokenRepo, sessionRepo, revisionRepo, statsRepo, eventSvc, transactor)
+		revisionSvc := service.NewRevisionService(revisionRepo, householdSvc)
+		accountSvc := service.NewAccountService(userRepo, credentialRepo, identityRepo, householdRepo, transferRepo, categoryRepo, memberRepo, txRepo, recurringRepo, overrideRepo, settlementRepo, tokenRepo, sessionRepo, revisionRepo, eventSvc, adminSvc, transactor)
+		identitySvc := service.NewIdentityService(identityRepo, credentialRepo, userRepo, eventSvc)
+		if cfg.Trash.RetentionDays < 0 {
+			return fmt.Errorf("invalid trash.retention_days %d, must be 0 or more", cfg.Trash.RetentionDays)
+		}
+		trashSvc := service.NewTrashService(householdRepo, txRepo, recurringRepo, householdSvc, eventSvc, time.Duration(cfg.Trash.RetentionDays)*24*time.Hour)
 
 		svcs := &api.Services{
 			User:             userSvc,
@@ -58,12 +90,32 @@ var serveCmd = &cobra.Command{
 			Category:         categorySvc,
 			Transaction:      txSvc,
 			RecurringExpense: recurringSvc,
+			Member:           memberSvc,
+			Settlement:       settlementSvc,
 			Summary:          summarySvc,
 			APIToken:         tokenSvc,
+			Session:          sessionSvc,
+			Admin:            adminSvc,
+			Security:         eventSvc,
+			Revision:         revisionSvc,
+			Trash:            trashSvc,
+			Account:          accountSvc,
+			Identity:         identitySvc,
 		}
 
 		srv := api.NewServer(logger, cfg.Server.Host, cfg.Server.Port, cfg.Server.CORSOrigins, svcs, cfg.Language)
 
+		proxies, err := parseTrustedProxies("server.trusted_proxies", cfg.Server.TrustedProxies)
+		if err != nil {
+			return err
+		}
+		srv.SetTrustedProxies(proxies)
+		limiter, err := newRateLimiter(client)
+		if err != nil {
+			return err
+		}
+		srv.SetupRateLimit(limiter)
+
 		// Session secret: config > DB > generate and persist
 		sessionSecret := cfg.Auth.Session.Secret
 		if sessionSecret == "" {
@@ -102,26 +154,286 @@ var serveCmd = &cobra.Command{
 			if err != nil {
 				return fmt.Errorf("creating dev user: %w", err)
 			}
-			srv.SetupAuth(nil, store, devUserID)
+			srv.SetupAuth(nil, false, store, devUserID)
 		} else {
-			oidcCfg, err := authpkg.NewOIDC(
-				context.Background(),
-				cfg.Auth.OIDC.Issuer,
-				cfg.Auth.OIDC.ClientID,
-				cfg.Auth.OIDC.ClientSecret,
-				cfg.Auth.OIDC.RedirectURL,
-			)
+			if len(cfg.Auth.OIDC.All()) == 0 && !cfg.Auth.Local.Enabled && !cfg.Auth.Header.Enabled {
+				return errors.New("no login method configured: set auth.oidc.issuer or auth.oidc.providers or enable auth.local or auth.header")
+			}
+			if cfg.Auth.Header.Enabled {
+				headerAuth, err := newHeaderAuth(userSvc)
+				if err != nil {
+					return err
+				}
+				srv.SetupHeaderAuth(headerAuth)
+			}
+			providers, err := newOIDCProviders()
 			if err != nil {
-				return fmt.Errorf("setting up OIDC: %w", err)
+				return err
 			}
-			srv.SetupAuth(oidcCfg, store, 0)
+			if err := linkLegacyUsers(logger, userSvc, providers); err != nil {
+				return err
+			}
+			srv.SetupAuth(providers, cfg.Auth.Local.Enabled, store, 0)
 		}
 
-		return srv.Start(context.Background())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go cleanupSessions(ctx, sessionSvc)
+		go purgeTrash(ctx, trashSvc)
+
+		return srv.Start(ctx)
 	},
 }
 
+// newRateLimiter builds the limiter from the rate_limit config. It returns
+// nil if rate limiting is disabled.
+func newRateLimiter(client *ent.Client) (*ratelimit.Limiter, error) {
+	rl := cfg.RateLimit
+	if !rl.Enabled {
+		return nil, nil
+	}
+
+	var store ratelimit.Store
+	switch rl.Store {
+	case "memory":
+		store = ratelimit.NewMemoryStore()
+	case "database":
+		store = ratelimit.NewDBStore(client)
+	default:
+		return nil, fmt.Errorf("invalid rate_limit.store %q, must be memory or database", rl.Store)
+	}
+
+	limiter := ratelimit.New(store)
+	limiter.IP = ratelimit.Rule{Limit: rl.RequestsPerMinute, Window: time.Minute}
+	limiter.Token = ratelimit.Rule{Limit: rl.TokenRequestsPerMinute, Window: time.Minute}
+	limiter.Auth = ratelimit.Rule{Limit: rl.AuthRequestsPerMinute, Window: time.Minute}
+	limiter.MaxFailures = rl.MaxFailures
+	limiter.Lockout = time.Duration(rl.Lockout) * time.Second
+	return limiter, nil
+}
+
+// newHeaderAuth builds the proxy header authentication from the auth.header
+// config. The proxies must be given explicitly: trusting the headers from
+// everyone would let any client log in as anyone.
+func newHeaderAuth(users *service.UserService) (*mw.HeaderAuth, error) {
+	h := cfg.Auth.Header
+	if len(h.TrustedProxies) == 0 {
+		return nil, errors.New("auth.header needs auth.header.trusted_proxies")
+	}
+	if h.UserHeader == "" {
+		return nil, errors.New("auth.header needs auth.header.user_header")
+	}
+	proxies, err := parseTrustedProxies("auth.header.trusted_proxies", h.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &mw.HeaderAuth{
+		Users:       users,
+		Proxies:     proxies,
+		UserHeader:  h.UserHeader,
+		EmailHeader: h.EmailHeader,
+		NameHeader:  h.NameHeader,
+		LogoutURL:   h.LogoutURL,
+	}, nil
+}
+
+// newOIDCProviders sets up the OIDC providers from the auth.oidc config:
+// the one directly under auth.oidc, then those in auth.oidc.providers. The
+// sign-in restrictions apply to all of them.
+func newOIDCProviders() ([]*authpkg.OIDCConfig, error) {
+	o := cfg.Auth.OIDC
+	policy := domain.SignInPolicy{
+		AllowedEmails:  o.AllowedEmails,
+		AllowedDomains: o.AllowedDomains,
+		AllowedGroups:  o.AllowedGroups,
+		AdminGroups:    o.AdminGroups,
+		Registration:   domain.Registration(o.Registration),
+	}
+	if domain.ValidateRegistration(policy.Registration) != nil {
+		return nil, fmt.Errorf("invalid auth.oidc.registration %q, must be open or closed", policy.Registration)
+	}
+
+	var providers []*authpkg.OIDCConfig
+	for _, p := range o.All() {
+		key := "auth.oidc"
+		if p.Name != "" {
+			key += "." + p.Name
+			if p.Issuer == "" || p.ClientID == "" || p.RedirectURL == "" {
+				return nil, fmt.Errorf("%s needs issuer, client_id and redirect_url", key)
+			}
+		}
+		provider, err := authpkg.NewOIDC(context.Background(), p.Issuer, p.ClientID, p.ClientSecret, p.RedirectURL)
+		if err != nil {
+			return nil, fmt.Errorf("setting up OIDC provider %s: %w", key, err)
+		}
+		provider.Name = p.Name
+		provider.DisplayName = p.DisplayName
+		provider.Policy = policy
+		provider.GroupsClaim = o.GroupsClaim
+		provider.PostLogoutRedirectURL = p.PostLogoutRedirectURL
+		provider.TrustUnverifiedEmail = p.TrustUnverifiedEmail
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// linkLegacyUsers moves users from before multiple providers to identities.
+// Their subjects belong to the provider set in auth.oidc.legacy_issuer, the
+// one directly under auth.oidc or, if that one was renamed, the only
+// provider. Startup fails if there are such users but none of these tells
+// the provider, since they couldn't log in anymore.
+func linkLegacyUsers(logger *zap.Logger, users *service.UserService, providers []*authpkg.OIDCConfig) error {
+	if len(providers) == 0 {
+		return nil
+	}
+	ctx := context.Background()
+
+	issuer := cfg.Auth.OIDC.LegacyIssuer
+	if issuer != "" {
+		if !slices.ContainsFunc(providers, func(p *authpkg.OIDCConfig) bool { return p.Issuer == issuer }) {
+			return fmt.Errorf("auth.oidc.legacy_issuer %q is not the issuer of a configured OIDC provider", issuer)
+		}
+	} else {
+		var unnamed []*authpkg.OIDCConfig
+		for _, p := range providers {
+			if p.Name == "" {
+				unnamed = append(unnamed, p)
+			}
+		}
+		switch {
+		case len(unnamed) > 1:
+			return errors.New("only one OIDC provider may be configured without a name")
+		case len(unnamed) == 1:
+			issuer = unnamed[0].Issuer
+		case len(providers) == 1:
+			issuer = providers[0].Issuer
+		}
+	}
+	if issuer == "" {
+		n, err := users.CountLegacyUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("counting OIDC users to link: %w", err)
+		}
+		if n > 0 {
+			return fmt.Errorf("%d OIDC user(s) from before multiple providers can't be linked to their provider: set auth.oidc.legacy_issuer to the issuer they logged in at", n)
+		}
+		return nil
+	}
+
+	n, err := users.LinkLegacyUsers(ctx, issuer)
+	if err != nil {
+		return fmt.Errorf("linking OIDC users to %s: %w", issuer, err)
+	}
+	if n > 0 {
+		logger.Info("linked existing OIDC users to their provider", zap.Int("users", n), zap.String("issuer", issuer))
+	}
+	return nil
+}
+
+// parseTrustedProxies parses a list of proxies from the config option key.
+// Entries are networks in CIDR notation or single addresses.
+func parseTrustedProxies(key string, values []string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, v := range values {
+		if _, ipNet, err := net.ParseCIDR(v); err == nil {
+			proxies = append(proxies, ipNet)
+			continue
+		}
+		ip := net.ParseIP(v)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid %s entry %q", key, v)
+		}
+		bits := 8 * len(ip)
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 32
+		}
+		proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return proxies, nil
+}
+
+// sessionCleanupInterval is how often expired sessions are deleted.
+const sessionCleanupInterval = time.Hour
+
+// cleanupSessions deletes expired sessions at startup and then every
+// sessionCleanupInterval until ctx is done.
+func cleanupSessions(ctx context.Context, svc *service.SessionService) {
+	ticker := time.NewTicker(sessionCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := svc.DeleteExpired(ctx, time.Now())
+		if err != nil {
+			logger.Warn("deleting expired sessions failed", zap.Error(err))
+		} else if n > 0 {
+			logger.Info("deleted expired sessions", zap.Int("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// trashPurgeInterval is how often the trash is checked for records past
+// their retention.
+const trashPurgeInterval = time.Hour
+
+// purgeTrash purges records past the trash retention at startup and then
+// every trashPurgeInterval until ctx is done.
+func purgeTrash(ctx context.Context, svc *service.TrashService) {
+	if svc.Retention() <= 0 {
+		return
+	}
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := svc.Purge(ctx, time.Now())
+		if err != nil {
+			logger.Warn("purging the trash failed", zap.Error(err))
+		} else if n > 0 {
+			logger.Info("purged records from the trash", zap.Int("count", n))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// prepareSchema applies pending migrations, or with --no-auto-migrate only
+// verifies that there are none.
+func prepareSchema(ctx context.Context) error {
+	m, closeDB, err := openMigrator(cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	if noAutoMigrate {
+		if err := m.Check(ctx); err != nil {
+			return fmt.Errorf("%w; run \"money-tracker migrate up\" first", err)
+		}
+		return nil
+	}
+
+	applied, err := m.Up(ctx)
+	for _, mig := range applied {
+		logger.Info("applied migration", zap.String("version", mig.Version), zap.String("name", mig.Name))
+	}
+	if err != nil {
+		return fmt.Errorf("running migrations: %w", err)
+	}
+	return nil
+}
+
 func init() {
 	serveCmd.Flags().IntVar(&cfg.Server.Port, "port", 0, "server port (overrides config)")
+	serveCmd.Flags().BoolVar(&noAutoMigrate, "no-auto-migrate", false, "don't apply pending migrations, refuse to start if there are any")
 	rootCmd.AddCommand(serveCmd)
 }
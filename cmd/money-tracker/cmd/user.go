@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"icekalt.dev/money-tracker/internal/repository"
+	"icekalt.dev/money-tracker/internal/service"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	userEmail     string
+	userName      string
+	passwordStdin bool
+)
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage user accounts",
+	Long: `Manage users that log in with a username and password, and invite
+users that log in via OIDC.
+
+Local logins have to be enabled with auth.local.enabled. Without
+--password-stdin a random password is generated and printed.`,
+}
+
+var userCreateCmd = &cobra.Command{
+	Use:   "create USERNAME",
+	Short: "Create a local user account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if userEmail == "" {
+			return errors.New("--email is required")
+		}
+		name := userName
+		if name == "" {
+			name = args[0]
+		}
+		password, generated, err := readPassword()
+		if err != nil {
+			return err
+		}
+
+		userSvc, closeDB, err := openUserService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		user, err := userSvc.CreateLocal(context.Background(), args[0], userEmail, name, password)
+		if err != nil {
+			return fmt.Errorf("creating user: %w", err)
+		}
+		logger.Info("created user", zap.Int("id", user.ID), zap.String("username", args[0]))
+		if generated {
+			fmt.Println(password)
+		}
+		return nil
+	},
+}
+
+var userSetPasswordCmd = &cobra.Command{
+	Use:   "set-password USERNAME",
+	Short: "Set the password of a local user account",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		password, generated, err := readPassword()
+		if err != nil {
+			return err
+		}
+
+		userSvc, closeDB, err := openUserService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		if err := userSvc.SetPassword(context.Background(), args[0], password); err != nil {
+			return fmt.Errorf("setting password: %w", err)
+		}
+		logger.Info("password changed", zap.String("username", args[0]))
+		if generated {
+			fmt.Println(password)
+		}
+		return nil
+	},
+}
+
+var userInviteCmd = &cobra.Command{
+	Use:   "invite EMAIL",
+	Short: "Invite a user to log in via OIDC",
+	Long: `Create a user that the first OIDC login with this email address takes
+over. Invited users can log in even if auth.oidc.registration is closed, but
+the allow-lists still apply.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := userName
+		if name == "" {
+			name, _, _ = strings.Cut(args[0], "@")
+		}
+
+		userSvc, closeDB, err := openUserService()
+		if err != nil {
+			return err
+		}
+		defer closeDB()
+
+		user, err := userSvc.Invite(context.Background(), args[0], name)
+		if err != nil {
+			return fmt.Errorf("inviting user: %w", err)
+		}
+		logger.Info("invited user", zap.Int("id", user.ID), zap.String("email", user.Email))
+		return nil
+	},
+}
+
+// readPassword reads the password from the first line of stdin with
+// --password-stdin and generates a random one otherwise.
+func readPassword() (password string, generated bool, err error) {
+	if !passwordStdin {
+		return rand.Text(), true, nil
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", false, fmt.Errorf("reading password: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), false, nil
+}
+
+func openUserService() (*service.UserService, func(), error) {
+	client, err := repository.NewClient(cfg.Database)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to database: %w", err)
+	}
+	userSvc := service.NewUserService(
+		repository.NewUserRepository(client),
+		repository.NewLocalCredentialRepository(client),
+		repository.NewUserIdentityRepository(client),
+	)
+	return userSvc, func() { client.Close() }, nil
+}
+
+func init() {
+	userCreateCmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "read the password from stdin")
+	userSetPasswordCmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "read the password from stdin")
+	userCreateCmd.Flags().StringVar(&userEmail, "email", "", "email address of the user")
+	userCreateCmd.Flags().StringVar(&userName, "name", "", "display name (default: the username)")
+	userInviteCmd.Flags().StringVar(&userName, "name", "", "display name (default: the part before the @)")
+	userCmd.AddCommand(userCreateCmd, userSetPasswordCmd, userInviteCmd)
+	rootCmd.AddCommand(userCmd)
+}
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
+	"icekalt.dev/money-tracker/ent/household"
+)
+
+// AggregateGeneration is the model entity for the AggregateGeneration schema.
+type AggregateGeneration struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Generation holds the value of the "generation" field.
+	Generation int `json:"generation,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AggregateGenerationQuery when eager-loading is set.
+	Edges                          AggregateGenerationEdges `json:"edges"`
+	household_aggregate_generation *int
+	selectValues                   sql.SelectValues
+}
+
+// AggregateGenerationEdges holds the relations/edges for other nodes in the graph.
+type AggregateGenerationEdges struct {
+	// Household holds the value of the household edge.
+	Household *Household `json:"household,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HouseholdOrErr returns the Household value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AggregateGenerationEdges) HouseholdOrErr() (*Household, error) {
+	if e.Household != nil {
+		return e.Household, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: household.Label}
+	}
+	return nil, &NotLoadedError{edge: "household"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AggregateGeneration) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case aggregategeneration.FieldID, aggregategeneration.FieldGeneration:
+			values[i] = new(sql.NullInt64)
+		case aggregategeneration.ForeignKeys[0]: // household_aggregate_generation
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AggregateGeneration fields.
+func (_m *AggregateGeneration) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case aggregategeneration.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case aggregategeneration.FieldGeneration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field generation", values[i])
+			} else if value.Valid {
+				_m.Generation = int(value.Int64)
+			}
+		case aggregategeneration.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_aggregate_generation", value)
+			} else if value.Valid {
+				_m.household_aggregate_generation = new(int)
+				*_m.household_aggregate_generation = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AggregateGeneration.
+// This includes values selected through modifiers, order, etc.
+func (_m *AggregateGeneration) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHousehold queries the "household" edge of the AggregateGeneration entity.
+func (_m *AggregateGeneration) QueryHousehold() *HouseholdQuery {
+	return NewAggregateGenerationClient(_m.config).QueryHousehold(_m)
+}
+
+// Update returns a builder for updating this AggregateGeneration.
+// Note that you need to call AggregateGeneration.Unwrap() before calling this method if this AggregateGeneration
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AggregateGeneration) Update() *AggregateGenerationUpdateOne {
+	return NewAggregateGenerationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AggregateGeneration entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AggregateGeneration) Unwrap() *AggregateGeneration {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AggregateGeneration is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AggregateGeneration) String() string {
+	var builder strings.Builder
+	builder.WriteString("AggregateGeneration(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("generation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Generation))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AggregateGenerations is a parsable slice of AggregateGeneration.
+type AggregateGenerations []*AggregateGeneration
@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package aggregategeneration
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldLTE(FieldID, id))
+}
+
+// Generation applies equality check predicate on the "generation" field. It's identical to GenerationEQ.
+func Generation(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldEQ(FieldGeneration, v))
+}
+
+// GenerationEQ applies the EQ predicate on the "generation" field.
+func GenerationEQ(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldEQ(FieldGeneration, v))
+}
+
+// GenerationNEQ applies the NEQ predicate on the "generation" field.
+func GenerationNEQ(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldNEQ(FieldGeneration, v))
+}
+
+// GenerationIn applies the In predicate on the "generation" field.
+func GenerationIn(vs ...int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldIn(FieldGeneration, vs...))
+}
+
+// GenerationNotIn applies the NotIn predicate on the "generation" field.
+func GenerationNotIn(vs ...int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldNotIn(FieldGeneration, vs...))
+}
+
+// GenerationGT applies the GT predicate on the "generation" field.
+func GenerationGT(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldGT(FieldGeneration, v))
+}
+
+// GenerationGTE applies the GTE predicate on the "generation" field.
+func GenerationGTE(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldGTE(FieldGeneration, v))
+}
+
+// GenerationLT applies the LT predicate on the "generation" field.
+func GenerationLT(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldLT(FieldGeneration, v))
+}
+
+// GenerationLTE applies the LTE predicate on the "generation" field.
+func GenerationLTE(v int) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.FieldLTE(FieldGeneration, v))
+}
+
+// HasHousehold applies the HasEdge predicate on the "household" edge.
+func HasHousehold() predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, HouseholdTable, HouseholdColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHouseholdWith applies the HasEdge predicate on the "household" edge with a given conditions (other predicates).
+func HasHouseholdWith(preds ...predicate.Household) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(func(s *sql.Selector) {
+		step := newHouseholdStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AggregateGeneration) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AggregateGeneration) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AggregateGeneration) predicate.AggregateGeneration {
+	return predicate.AggregateGeneration(sql.NotPredicates(p))
+}
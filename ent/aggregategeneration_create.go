@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
+	"icekalt.dev/money-tracker/ent/household"
+)
+
+// AggregateGenerationCreate is the builder for creating a AggregateGeneration entity.
+type AggregateGenerationCreate struct {
+	config
+	mutation *AggregateGenerationMutation
+	hooks    []Hook
+}
+
+// SetGeneration sets the "generation" field.
+func (_c *AggregateGenerationCreate) SetGeneration(v int) *AggregateGenerationCreate {
+	_c.mutation.SetGeneration(v)
+	return _c
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_c *AggregateGenerationCreate) SetNillableGeneration(v *int) *AggregateGenerationCreate {
+	if v != nil {
+		_c.SetGeneration(*v)
+	}
+	return _c
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_c *AggregateGenerationCreate) SetHouseholdID(id int) *AggregateGenerationCreate {
+	_c.mutation.SetHouseholdID(id)
+	return _c
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_c *AggregateGenerationCreate) SetHousehold(v *Household) *AggregateGenerationCreate {
+	return _c.SetHouseholdID(v.ID)
+}
+
+// Mutation returns the AggregateGenerationMutation object of the builder.
+func (_c *AggregateGenerationCreate) Mutation() *AggregateGenerationMutation {
+	return _c.mutation
+}
+
+// Save creates the AggregateGeneration in the database.
+func (_c *AggregateGenerationCreate) Save(ctx context.Context) (*AggregateGeneration, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AggregateGenerationCreate) SaveX(ctx context.Context) *AggregateGeneration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AggregateGenerationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AggregateGenerationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AggregateGenerationCreate) defaults() {
+	if _, ok := _c.mutation.Generation(); !ok {
+		v := aggregategeneration.DefaultGeneration
+		_c.mutation.SetGeneration(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AggregateGenerationCreate) check() error {
+	if _, ok := _c.mutation.Generation(); !ok {
+		return &ValidationError{Name: "generation", err: errors.New(`ent: missing required field "AggregateGeneration.generation"`)}
+	}
+	if len(_c.mutation.HouseholdIDs()) == 0 {
+		return &ValidationError{Name: "household", err: errors.New(`ent: missing required edge "AggregateGeneration.household"`)}
+	}
+	return nil
+}
+
+func (_c *AggregateGenerationCreate) sqlSave(ctx context.Context) (*AggregateGeneration, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AggregateGenerationCreate) createSpec() (*AggregateGeneration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AggregateGeneration{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(aggregategeneration.Table, sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Generation(); ok {
+		_spec.SetField(aggregategeneration.FieldGeneration, field.TypeInt, value)
+		_node.Generation = value
+	}
+	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   aggregategeneration.HouseholdTable,
+			Columns: []string{aggregategeneration.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_aggregate_generation = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AggregateGenerationCreateBulk is the builder for creating many AggregateGeneration entities in bulk.
+type AggregateGenerationCreateBulk struct {
+	config
+	err      error
+	builders []*AggregateGenerationCreate
+}
+
+// Save creates the AggregateGeneration entities in the database.
+func (_c *AggregateGenerationCreateBulk) Save(ctx context.Context) ([]*AggregateGeneration, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AggregateGeneration, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AggregateGenerationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AggregateGenerationCreateBulk) SaveX(ctx context.Context) []*AggregateGeneration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AggregateGenerationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AggregateGenerationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
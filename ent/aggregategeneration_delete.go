@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// AggregateGenerationDelete is the builder for deleting a AggregateGeneration entity.
+type AggregateGenerationDelete struct {
+	config
+	hooks    []Hook
+	mutation *AggregateGenerationMutation
+}
+
+// Where appends a list predicates to the AggregateGenerationDelete builder.
+func (_d *AggregateGenerationDelete) Where(ps ...predicate.AggregateGeneration) *AggregateGenerationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AggregateGenerationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AggregateGenerationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AggregateGenerationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(aggregategeneration.Table, sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AggregateGenerationDeleteOne is the builder for deleting a single AggregateGeneration entity.
+type AggregateGenerationDeleteOne struct {
+	_d *AggregateGenerationDelete
+}
+
+// Where appends a list predicates to the AggregateGenerationDelete builder.
+func (_d *AggregateGenerationDeleteOne) Where(ps ...predicate.AggregateGeneration) *AggregateGenerationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AggregateGenerationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{aggregategeneration.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AggregateGenerationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// AggregateGenerationUpdate is the builder for updating AggregateGeneration entities.
+type AggregateGenerationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AggregateGenerationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AggregateGenerationUpdate builder.
+func (_u *AggregateGenerationUpdate) Where(ps ...predicate.AggregateGeneration) *AggregateGenerationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *AggregateGenerationUpdate) SetGeneration(v int) *AggregateGenerationUpdate {
+	_u.mutation.ResetGeneration()
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *AggregateGenerationUpdate) SetNillableGeneration(v *int) *AggregateGenerationUpdate {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// AddGeneration adds value to the "generation" field.
+func (_u *AggregateGenerationUpdate) AddGeneration(v int) *AggregateGenerationUpdate {
+	_u.mutation.AddGeneration(v)
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *AggregateGenerationUpdate) SetHouseholdID(id int) *AggregateGenerationUpdate {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *AggregateGenerationUpdate) SetHousehold(v *Household) *AggregateGenerationUpdate {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// Mutation returns the AggregateGenerationMutation object of the builder.
+func (_u *AggregateGenerationUpdate) Mutation() *AggregateGenerationMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *AggregateGenerationUpdate) ClearHousehold() *AggregateGenerationUpdate {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AggregateGenerationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AggregateGenerationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AggregateGenerationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AggregateGenerationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AggregateGenerationUpdate) check() error {
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AggregateGeneration.household"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AggregateGenerationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AggregateGenerationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AggregateGenerationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aggregategeneration.Table, aggregategeneration.Columns, sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(aggregategeneration.FieldGeneration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeneration(); ok {
+		_spec.AddField(aggregategeneration.FieldGeneration, field.TypeInt, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   aggregategeneration.HouseholdTable,
+			Columns: []string{aggregategeneration.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   aggregategeneration.HouseholdTable,
+			Columns: []string{aggregategeneration.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aggregategeneration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AggregateGenerationUpdateOne is the builder for updating a single AggregateGeneration entity.
+type AggregateGenerationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AggregateGenerationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *AggregateGenerationUpdateOne) SetGeneration(v int) *AggregateGenerationUpdateOne {
+	_u.mutation.ResetGeneration()
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *AggregateGenerationUpdateOne) SetNillableGeneration(v *int) *AggregateGenerationUpdateOne {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// AddGeneration adds value to the "generation" field.
+func (_u *AggregateGenerationUpdateOne) AddGeneration(v int) *AggregateGenerationUpdateOne {
+	_u.mutation.AddGeneration(v)
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *AggregateGenerationUpdateOne) SetHouseholdID(id int) *AggregateGenerationUpdateOne {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *AggregateGenerationUpdateOne) SetHousehold(v *Household) *AggregateGenerationUpdateOne {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// Mutation returns the AggregateGenerationMutation object of the builder.
+func (_u *AggregateGenerationUpdateOne) Mutation() *AggregateGenerationMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *AggregateGenerationUpdateOne) ClearHousehold() *AggregateGenerationUpdateOne {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// Where appends a list predicates to the AggregateGenerationUpdate builder.
+func (_u *AggregateGenerationUpdateOne) Where(ps ...predicate.AggregateGeneration) *AggregateGenerationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AggregateGenerationUpdateOne) Select(field string, fields ...string) *AggregateGenerationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AggregateGeneration entity.
+func (_u *AggregateGenerationUpdateOne) Save(ctx context.Context) (*AggregateGeneration, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AggregateGenerationUpdateOne) SaveX(ctx context.Context) *AggregateGeneration {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AggregateGenerationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AggregateGenerationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AggregateGenerationUpdateOne) check() error {
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AggregateGeneration.household"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AggregateGenerationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AggregateGenerationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AggregateGenerationUpdateOne) sqlSave(ctx context.Context) (_node *AggregateGeneration, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(aggregategeneration.Table, aggregategeneration.Columns, sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AggregateGeneration.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, aggregategeneration.FieldID)
+		for _, f := range fields {
+			if !aggregategeneration.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != aggregategeneration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(aggregategeneration.FieldGeneration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeneration(); ok {
+		_spec.AddField(aggregategeneration.FieldGeneration, field.TypeInt, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   aggregategeneration.HouseholdTable,
+			Columns: []string{aggregategeneration.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   aggregategeneration.HouseholdTable,
+			Columns: []string{aggregategeneration.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AggregateGeneration{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aggregategeneration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,10 +23,18 @@ type APIToken struct {
 	Name string `json:"name,omitempty"`
 	// TokenHash holds the value of the "token_hash" field.
 	TokenHash string `json:"token_hash,omitempty"`
+	// read or write
+	Access string `json:"access,omitempty"`
+	// Households the token is limited to; empty for all
+	HouseholdIds []int `json:"household_ids,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Set once the token was replaced by a new one
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
 	// LastUsed holds the value of the "last_used" field.
 	LastUsed *time.Time `json:"last_used,omitempty"`
+	// LastUsedIP holds the value of the "last_used_ip" field.
+	LastUsedIP string `json:"last_used_ip,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -60,11 +69,13 @@ func (*APIToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case apitoken.FieldHouseholdIds:
+			values[i] = new([]byte)
 		case apitoken.FieldID:
 			values[i] = new(sql.NullInt64)
-		case apitoken.FieldName, apitoken.FieldTokenHash:
+		case apitoken.FieldName, apitoken.FieldTokenHash, apitoken.FieldAccess, apitoken.FieldLastUsedIP:
 			values[i] = new(sql.NullString)
-		case apitoken.FieldExpiresAt, apitoken.FieldLastUsed, apitoken.FieldCreatedAt:
+		case apitoken.FieldExpiresAt, apitoken.FieldRotatedAt, apitoken.FieldLastUsed, apitoken.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case apitoken.ForeignKeys[0]: // user_api_tokens
 			values[i] = new(sql.NullInt64)
@@ -101,6 +112,20 @@ func (_m *APIToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TokenHash = value.String
 			}
+		case apitoken.FieldAccess:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access", values[i])
+			} else if value.Valid {
+				_m.Access = value.String
+			}
+		case apitoken.FieldHouseholdIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field household_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HouseholdIds); err != nil {
+					return fmt.Errorf("unmarshal field household_ids: %w", err)
+				}
+			}
 		case apitoken.FieldExpiresAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
@@ -108,6 +133,13 @@ func (_m *APIToken) assignValues(columns []string, values []any) error {
 				_m.ExpiresAt = new(time.Time)
 				*_m.ExpiresAt = value.Time
 			}
+		case apitoken.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				_m.RotatedAt = new(time.Time)
+				*_m.RotatedAt = value.Time
+			}
 		case apitoken.FieldLastUsed:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_used", values[i])
@@ -115,6 +147,12 @@ func (_m *APIToken) assignValues(columns []string, values []any) error {
 				_m.LastUsed = new(time.Time)
 				*_m.LastUsed = value.Time
 			}
+		case apitoken.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				_m.LastUsedIP = value.String
+			}
 		case apitoken.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -175,16 +213,30 @@ func (_m *APIToken) String() string {
 	builder.WriteString("token_hash=")
 	builder.WriteString(_m.TokenHash)
 	builder.WriteString(", ")
+	builder.WriteString("access=")
+	builder.WriteString(_m.Access)
+	builder.WriteString(", ")
+	builder.WriteString("household_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HouseholdIds))
+	builder.WriteString(", ")
 	if v := _m.ExpiresAt; v != nil {
 		builder.WriteString("expires_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.RotatedAt; v != nil {
+		builder.WriteString("rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.LastUsed; v != nil {
 		builder.WriteString("last_used=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(_m.LastUsedIP)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
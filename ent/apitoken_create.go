@@ -33,6 +33,26 @@ func (_c *APITokenCreate) SetTokenHash(v string) *APITokenCreate {
 	return _c
 }
 
+// SetAccess sets the "access" field.
+func (_c *APITokenCreate) SetAccess(v string) *APITokenCreate {
+	_c.mutation.SetAccess(v)
+	return _c
+}
+
+// SetNillableAccess sets the "access" field if the given value is not nil.
+func (_c *APITokenCreate) SetNillableAccess(v *string) *APITokenCreate {
+	if v != nil {
+		_c.SetAccess(*v)
+	}
+	return _c
+}
+
+// SetHouseholdIds sets the "household_ids" field.
+func (_c *APITokenCreate) SetHouseholdIds(v []int) *APITokenCreate {
+	_c.mutation.SetHouseholdIds(v)
+	return _c
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (_c *APITokenCreate) SetExpiresAt(v time.Time) *APITokenCreate {
 	_c.mutation.SetExpiresAt(v)
@@ -47,6 +67,20 @@ func (_c *APITokenCreate) SetNillableExpiresAt(v *time.Time) *APITokenCreate {
 	return _c
 }
 
+// SetRotatedAt sets the "rotated_at" field.
+func (_c *APITokenCreate) SetRotatedAt(v time.Time) *APITokenCreate {
+	_c.mutation.SetRotatedAt(v)
+	return _c
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_c *APITokenCreate) SetNillableRotatedAt(v *time.Time) *APITokenCreate {
+	if v != nil {
+		_c.SetRotatedAt(*v)
+	}
+	return _c
+}
+
 // SetLastUsed sets the "last_used" field.
 func (_c *APITokenCreate) SetLastUsed(v time.Time) *APITokenCreate {
 	_c.mutation.SetLastUsed(v)
@@ -61,6 +95,20 @@ func (_c *APITokenCreate) SetNillableLastUsed(v *time.Time) *APITokenCreate {
 	return _c
 }
 
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_c *APITokenCreate) SetLastUsedIP(v string) *APITokenCreate {
+	_c.mutation.SetLastUsedIP(v)
+	return _c
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_c *APITokenCreate) SetNillableLastUsedIP(v *string) *APITokenCreate {
+	if v != nil {
+		_c.SetLastUsedIP(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *APITokenCreate) SetCreatedAt(v time.Time) *APITokenCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -121,6 +169,14 @@ func (_c *APITokenCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *APITokenCreate) defaults() {
+	if _, ok := _c.mutation.Access(); !ok {
+		v := apitoken.DefaultAccess
+		_c.mutation.SetAccess(v)
+	}
+	if _, ok := _c.mutation.LastUsedIP(); !ok {
+		v := apitoken.DefaultLastUsedIP
+		_c.mutation.SetLastUsedIP(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := apitoken.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -145,6 +201,22 @@ func (_c *APITokenCreate) check() error {
 			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "APIToken.token_hash": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Access(); !ok {
+		return &ValidationError{Name: "access", err: errors.New(`ent: missing required field "APIToken.access"`)}
+	}
+	if v, ok := _c.mutation.Access(); ok {
+		if err := apitoken.AccessValidator(v); err != nil {
+			return &ValidationError{Name: "access", err: fmt.Errorf(`ent: validator failed for field "APIToken.access": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastUsedIP(); !ok {
+		return &ValidationError{Name: "last_used_ip", err: errors.New(`ent: missing required field "APIToken.last_used_ip"`)}
+	}
+	if v, ok := _c.mutation.LastUsedIP(); ok {
+		if err := apitoken.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "APIToken.last_used_ip": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APIToken.created_at"`)}
 	}
@@ -185,14 +257,30 @@ func (_c *APITokenCreate) createSpec() (*APIToken, *sqlgraph.CreateSpec) {
 		_spec.SetField(apitoken.FieldTokenHash, field.TypeString, value)
 		_node.TokenHash = value
 	}
+	if value, ok := _c.mutation.Access(); ok {
+		_spec.SetField(apitoken.FieldAccess, field.TypeString, value)
+		_node.Access = value
+	}
+	if value, ok := _c.mutation.HouseholdIds(); ok {
+		_spec.SetField(apitoken.FieldHouseholdIds, field.TypeJSON, value)
+		_node.HouseholdIds = value
+	}
 	if value, ok := _c.mutation.ExpiresAt(); ok {
 		_spec.SetField(apitoken.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = &value
 	}
+	if value, ok := _c.mutation.RotatedAt(); ok {
+		_spec.SetField(apitoken.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
 	if value, ok := _c.mutation.LastUsed(); ok {
 		_spec.SetField(apitoken.FieldLastUsed, field.TypeTime, value)
 		_node.LastUsed = &value
 	}
+	if value, ok := _c.mutation.LastUsedIP(); ok {
+		_spec.SetField(apitoken.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(apitoken.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/apitoken"
 	"icekalt.dev/money-tracker/ent/predicate"
@@ -19,8 +20,9 @@ import (
 // APITokenUpdate is the builder for updating APIToken entities.
 type APITokenUpdate struct {
 	config
-	hooks    []Hook
-	mutation *APITokenMutation
+	hooks     []Hook
+	mutation  *APITokenMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the APITokenUpdate builder.
@@ -57,6 +59,38 @@ func (_u *APITokenUpdate) SetNillableTokenHash(v *string) *APITokenUpdate {
 	return _u
 }
 
+// SetAccess sets the "access" field.
+func (_u *APITokenUpdate) SetAccess(v string) *APITokenUpdate {
+	_u.mutation.SetAccess(v)
+	return _u
+}
+
+// SetNillableAccess sets the "access" field if the given value is not nil.
+func (_u *APITokenUpdate) SetNillableAccess(v *string) *APITokenUpdate {
+	if v != nil {
+		_u.SetAccess(*v)
+	}
+	return _u
+}
+
+// SetHouseholdIds sets the "household_ids" field.
+func (_u *APITokenUpdate) SetHouseholdIds(v []int) *APITokenUpdate {
+	_u.mutation.SetHouseholdIds(v)
+	return _u
+}
+
+// AppendHouseholdIds appends value to the "household_ids" field.
+func (_u *APITokenUpdate) AppendHouseholdIds(v []int) *APITokenUpdate {
+	_u.mutation.AppendHouseholdIds(v)
+	return _u
+}
+
+// ClearHouseholdIds clears the value of the "household_ids" field.
+func (_u *APITokenUpdate) ClearHouseholdIds() *APITokenUpdate {
+	_u.mutation.ClearHouseholdIds()
+	return _u
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (_u *APITokenUpdate) SetExpiresAt(v time.Time) *APITokenUpdate {
 	_u.mutation.SetExpiresAt(v)
@@ -77,6 +111,26 @@ func (_u *APITokenUpdate) ClearExpiresAt() *APITokenUpdate {
 	return _u
 }
 
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *APITokenUpdate) SetRotatedAt(v time.Time) *APITokenUpdate {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *APITokenUpdate) SetNillableRotatedAt(v *time.Time) *APITokenUpdate {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *APITokenUpdate) ClearRotatedAt() *APITokenUpdate {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
 // SetLastUsed sets the "last_used" field.
 func (_u *APITokenUpdate) SetLastUsed(v time.Time) *APITokenUpdate {
 	_u.mutation.SetLastUsed(v)
@@ -97,6 +151,20 @@ func (_u *APITokenUpdate) ClearLastUsed() *APITokenUpdate {
 	return _u
 }
 
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *APITokenUpdate) SetLastUsedIP(v string) *APITokenUpdate {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *APITokenUpdate) SetNillableLastUsedIP(v *string) *APITokenUpdate {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *APITokenUpdate) SetUserID(id int) *APITokenUpdate {
 	_u.mutation.SetUserID(id)
@@ -158,12 +226,28 @@ func (_u *APITokenUpdate) check() error {
 			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "APIToken.token_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Access(); ok {
+		if err := apitoken.AccessValidator(v); err != nil {
+			return &ValidationError{Name: "access", err: fmt.Errorf(`ent: validator failed for field "APIToken.access": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastUsedIP(); ok {
+		if err := apitoken.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "APIToken.last_used_ip": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "APIToken.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *APITokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APITokenUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *APITokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -182,18 +266,41 @@ func (_u *APITokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.TokenHash(); ok {
 		_spec.SetField(apitoken.FieldTokenHash, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Access(); ok {
+		_spec.SetField(apitoken.FieldAccess, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HouseholdIds(); ok {
+		_spec.SetField(apitoken.FieldHouseholdIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHouseholdIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apitoken.FieldHouseholdIds, value)
+		})
+	}
+	if _u.mutation.HouseholdIdsCleared() {
+		_spec.ClearField(apitoken.FieldHouseholdIds, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ExpiresAt(); ok {
 		_spec.SetField(apitoken.FieldExpiresAt, field.TypeTime, value)
 	}
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(apitoken.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(apitoken.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(apitoken.FieldRotatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.LastUsed(); ok {
 		_spec.SetField(apitoken.FieldLastUsed, field.TypeTime, value)
 	}
 	if _u.mutation.LastUsedCleared() {
 		_spec.ClearField(apitoken.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(apitoken.FieldLastUsedIP, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -223,6 +330,7 @@ func (_u *APITokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{apitoken.Label}
@@ -238,9 +346,10 @@ func (_u *APITokenUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // APITokenUpdateOne is the builder for updating a single APIToken entity.
 type APITokenUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *APITokenMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *APITokenMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -271,6 +380,38 @@ func (_u *APITokenUpdateOne) SetNillableTokenHash(v *string) *APITokenUpdateOne
 	return _u
 }
 
+// SetAccess sets the "access" field.
+func (_u *APITokenUpdateOne) SetAccess(v string) *APITokenUpdateOne {
+	_u.mutation.SetAccess(v)
+	return _u
+}
+
+// SetNillableAccess sets the "access" field if the given value is not nil.
+func (_u *APITokenUpdateOne) SetNillableAccess(v *string) *APITokenUpdateOne {
+	if v != nil {
+		_u.SetAccess(*v)
+	}
+	return _u
+}
+
+// SetHouseholdIds sets the "household_ids" field.
+func (_u *APITokenUpdateOne) SetHouseholdIds(v []int) *APITokenUpdateOne {
+	_u.mutation.SetHouseholdIds(v)
+	return _u
+}
+
+// AppendHouseholdIds appends value to the "household_ids" field.
+func (_u *APITokenUpdateOne) AppendHouseholdIds(v []int) *APITokenUpdateOne {
+	_u.mutation.AppendHouseholdIds(v)
+	return _u
+}
+
+// ClearHouseholdIds clears the value of the "household_ids" field.
+func (_u *APITokenUpdateOne) ClearHouseholdIds() *APITokenUpdateOne {
+	_u.mutation.ClearHouseholdIds()
+	return _u
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (_u *APITokenUpdateOne) SetExpiresAt(v time.Time) *APITokenUpdateOne {
 	_u.mutation.SetExpiresAt(v)
@@ -291,6 +432,26 @@ func (_u *APITokenUpdateOne) ClearExpiresAt() *APITokenUpdateOne {
 	return _u
 }
 
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *APITokenUpdateOne) SetRotatedAt(v time.Time) *APITokenUpdateOne {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *APITokenUpdateOne) SetNillableRotatedAt(v *time.Time) *APITokenUpdateOne {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *APITokenUpdateOne) ClearRotatedAt() *APITokenUpdateOne {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
 // SetLastUsed sets the "last_used" field.
 func (_u *APITokenUpdateOne) SetLastUsed(v time.Time) *APITokenUpdateOne {
 	_u.mutation.SetLastUsed(v)
@@ -311,6 +472,20 @@ func (_u *APITokenUpdateOne) ClearLastUsed() *APITokenUpdateOne {
 	return _u
 }
 
+// SetLastUsedIP sets the "last_used_ip" field.
+func (_u *APITokenUpdateOne) SetLastUsedIP(v string) *APITokenUpdateOne {
+	_u.mutation.SetLastUsedIP(v)
+	return _u
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (_u *APITokenUpdateOne) SetNillableLastUsedIP(v *string) *APITokenUpdateOne {
+	if v != nil {
+		_u.SetLastUsedIP(*v)
+	}
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *APITokenUpdateOne) SetUserID(id int) *APITokenUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -385,12 +560,28 @@ func (_u *APITokenUpdateOne) check() error {
 			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "APIToken.token_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Access(); ok {
+		if err := apitoken.AccessValidator(v); err != nil {
+			return &ValidationError{Name: "access", err: fmt.Errorf(`ent: validator failed for field "APIToken.access": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastUsedIP(); ok {
+		if err := apitoken.LastUsedIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_used_ip", err: fmt.Errorf(`ent: validator failed for field "APIToken.last_used_ip": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "APIToken.user"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *APITokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APITokenUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *APITokenUpdateOne) sqlSave(ctx context.Context) (_node *APIToken, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -426,18 +617,41 @@ func (_u *APITokenUpdateOne) sqlSave(ctx context.Context) (_node *APIToken, err
 	if value, ok := _u.mutation.TokenHash(); ok {
 		_spec.SetField(apitoken.FieldTokenHash, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Access(); ok {
+		_spec.SetField(apitoken.FieldAccess, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HouseholdIds(); ok {
+		_spec.SetField(apitoken.FieldHouseholdIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHouseholdIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apitoken.FieldHouseholdIds, value)
+		})
+	}
+	if _u.mutation.HouseholdIdsCleared() {
+		_spec.ClearField(apitoken.FieldHouseholdIds, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ExpiresAt(); ok {
 		_spec.SetField(apitoken.FieldExpiresAt, field.TypeTime, value)
 	}
 	if _u.mutation.ExpiresAtCleared() {
 		_spec.ClearField(apitoken.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(apitoken.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(apitoken.FieldRotatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.LastUsed(); ok {
 		_spec.SetField(apitoken.FieldLastUsed, field.TypeTime, value)
 	}
 	if _u.mutation.LastUsedCleared() {
 		_spec.ClearField(apitoken.FieldLastUsed, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LastUsedIP(); ok {
+		_spec.SetField(apitoken.FieldLastUsedIP, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -467,6 +681,7 @@ func (_u *APITokenUpdateOne) sqlSave(ctx context.Context) (_node *APIToken, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &APIToken{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -65,6 +65,11 @@ func Icon(v string) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldIcon, v))
 }
 
+// TaxClass applies equality check predicate on the "tax_class" field. It's identical to TaxClassEQ.
+func TaxClass(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldTaxClass, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldCreatedAt, v))
@@ -215,6 +220,81 @@ func IconContainsFold(v string) predicate.Category {
 	return predicate.Category(sql.FieldContainsFold(FieldIcon, v))
 }
 
+// TaxClassEQ applies the EQ predicate on the "tax_class" field.
+func TaxClassEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldEQ(FieldTaxClass, v))
+}
+
+// TaxClassNEQ applies the NEQ predicate on the "tax_class" field.
+func TaxClassNEQ(v string) predicate.Category {
+	return predicate.Category(sql.FieldNEQ(FieldTaxClass, v))
+}
+
+// TaxClassIn applies the In predicate on the "tax_class" field.
+func TaxClassIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldIn(FieldTaxClass, vs...))
+}
+
+// TaxClassNotIn applies the NotIn predicate on the "tax_class" field.
+func TaxClassNotIn(vs ...string) predicate.Category {
+	return predicate.Category(sql.FieldNotIn(FieldTaxClass, vs...))
+}
+
+// TaxClassGT applies the GT predicate on the "tax_class" field.
+func TaxClassGT(v string) predicate.Category {
+	return predicate.Category(sql.FieldGT(FieldTaxClass, v))
+}
+
+// TaxClassGTE applies the GTE predicate on the "tax_class" field.
+func TaxClassGTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldGTE(FieldTaxClass, v))
+}
+
+// TaxClassLT applies the LT predicate on the "tax_class" field.
+func TaxClassLT(v string) predicate.Category {
+	return predicate.Category(sql.FieldLT(FieldTaxClass, v))
+}
+
+// TaxClassLTE applies the LTE predicate on the "tax_class" field.
+func TaxClassLTE(v string) predicate.Category {
+	return predicate.Category(sql.FieldLTE(FieldTaxClass, v))
+}
+
+// TaxClassContains applies the Contains predicate on the "tax_class" field.
+func TaxClassContains(v string) predicate.Category {
+	return predicate.Category(sql.FieldContains(FieldTaxClass, v))
+}
+
+// TaxClassHasPrefix applies the HasPrefix predicate on the "tax_class" field.
+func TaxClassHasPrefix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasPrefix(FieldTaxClass, v))
+}
+
+// TaxClassHasSuffix applies the HasSuffix predicate on the "tax_class" field.
+func TaxClassHasSuffix(v string) predicate.Category {
+	return predicate.Category(sql.FieldHasSuffix(FieldTaxClass, v))
+}
+
+// TaxClassIsNil applies the IsNil predicate on the "tax_class" field.
+func TaxClassIsNil() predicate.Category {
+	return predicate.Category(sql.FieldIsNull(FieldTaxClass))
+}
+
+// TaxClassNotNil applies the NotNil predicate on the "tax_class" field.
+func TaxClassNotNil() predicate.Category {
+	return predicate.Category(sql.FieldNotNull(FieldTaxClass))
+}
+
+// TaxClassEqualFold applies the EqualFold predicate on the "tax_class" field.
+func TaxClassEqualFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldEqualFold(FieldTaxClass, v))
+}
+
+// TaxClassContainsFold applies the ContainsFold predicate on the "tax_class" field.
+func TaxClassContainsFold(v string) predicate.Category {
+	return predicate.Category(sql.FieldContainsFold(FieldTaxClass, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Category {
 	return predicate.Category(sql.FieldEQ(FieldCreatedAt, v))
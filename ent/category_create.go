@@ -43,6 +43,20 @@ func (_c *CategoryCreate) SetNillableIcon(v *string) *CategoryCreate {
 	return _c
 }
 
+// SetTaxClass sets the "tax_class" field.
+func (_c *CategoryCreate) SetTaxClass(v string) *CategoryCreate {
+	_c.mutation.SetTaxClass(v)
+	return _c
+}
+
+// SetNillableTaxClass sets the "tax_class" field if the given value is not nil.
+func (_c *CategoryCreate) SetNillableTaxClass(v *string) *CategoryCreate {
+	if v != nil {
+		_c.SetTaxClass(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *CategoryCreate) SetCreatedAt(v time.Time) *CategoryCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -176,6 +190,11 @@ func (_c *CategoryCreate) check() error {
 			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Category.icon": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.TaxClass(); ok {
+		if err := category.TaxClassValidator(v); err != nil {
+			return &ValidationError{Name: "tax_class", err: fmt.Errorf(`ent: validator failed for field "Category.tax_class": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Category.created_at"`)}
 	}
@@ -219,6 +238,10 @@ func (_c *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		_spec.SetField(category.FieldIcon, field.TypeString, value)
 		_node.Icon = value
 	}
+	if value, ok := _c.mutation.TaxClass(); ok {
+		_spec.SetField(category.FieldTaxClass, field.TypeString, value)
+		_node.TaxClass = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(category.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -21,8 +21,9 @@ import (
 // CategoryUpdate is the builder for updating Category entities.
 type CategoryUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CategoryMutation
+	hooks     []Hook
+	mutation  *CategoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CategoryUpdate builder.
@@ -65,6 +66,26 @@ func (_u *CategoryUpdate) ClearIcon() *CategoryUpdate {
 	return _u
 }
 
+// SetTaxClass sets the "tax_class" field.
+func (_u *CategoryUpdate) SetTaxClass(v string) *CategoryUpdate {
+	_u.mutation.SetTaxClass(v)
+	return _u
+}
+
+// SetNillableTaxClass sets the "tax_class" field if the given value is not nil.
+func (_u *CategoryUpdate) SetNillableTaxClass(v *string) *CategoryUpdate {
+	if v != nil {
+		_u.SetTaxClass(*v)
+	}
+	return _u
+}
+
+// ClearTaxClass clears the value of the "tax_class" field.
+func (_u *CategoryUpdate) ClearTaxClass() *CategoryUpdate {
+	_u.mutation.ClearTaxClass()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *CategoryUpdate) SetUpdatedAt(v time.Time) *CategoryUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -213,12 +234,23 @@ func (_u *CategoryUpdate) check() error {
 			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Category.icon": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaxClass(); ok {
+		if err := category.TaxClassValidator(v); err != nil {
+			return &ValidationError{Name: "tax_class", err: fmt.Errorf(`ent: validator failed for field "Category.tax_class": %w`, err)}
+		}
+	}
 	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Category.household"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -240,6 +272,12 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.IconCleared() {
 		_spec.ClearField(category.FieldIcon, field.TypeString)
 	}
+	if value, ok := _u.mutation.TaxClass(); ok {
+		_spec.SetField(category.FieldTaxClass, field.TypeString, value)
+	}
+	if _u.mutation.TaxClassCleared() {
+		_spec.ClearField(category.FieldTaxClass, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -362,6 +400,7 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{category.Label}
@@ -377,9 +416,10 @@ func (_u *CategoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // CategoryUpdateOne is the builder for updating a single Category entity.
 type CategoryUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CategoryMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CategoryMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -416,6 +456,26 @@ func (_u *CategoryUpdateOne) ClearIcon() *CategoryUpdateOne {
 	return _u
 }
 
+// SetTaxClass sets the "tax_class" field.
+func (_u *CategoryUpdateOne) SetTaxClass(v string) *CategoryUpdateOne {
+	_u.mutation.SetTaxClass(v)
+	return _u
+}
+
+// SetNillableTaxClass sets the "tax_class" field if the given value is not nil.
+func (_u *CategoryUpdateOne) SetNillableTaxClass(v *string) *CategoryUpdateOne {
+	if v != nil {
+		_u.SetTaxClass(*v)
+	}
+	return _u
+}
+
+// ClearTaxClass clears the value of the "tax_class" field.
+func (_u *CategoryUpdateOne) ClearTaxClass() *CategoryUpdateOne {
+	_u.mutation.ClearTaxClass()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *CategoryUpdateOne) SetUpdatedAt(v time.Time) *CategoryUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -577,12 +637,23 @@ func (_u *CategoryUpdateOne) check() error {
 			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "Category.icon": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaxClass(); ok {
+		if err := category.TaxClassValidator(v); err != nil {
+			return &ValidationError{Name: "tax_class", err: fmt.Errorf(`ent: validator failed for field "Category.tax_class": %w`, err)}
+		}
+	}
 	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Category.household"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CategoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CategoryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -621,6 +692,12 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 	if _u.mutation.IconCleared() {
 		_spec.ClearField(category.FieldIcon, field.TypeString)
 	}
+	if value, ok := _u.mutation.TaxClass(); ok {
+		_spec.SetField(category.FieldTaxClass, field.TypeString, value)
+	}
+	if _u.mutation.TaxClassCleared() {
+		_spec.ClearField(category.FieldTaxClass, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -743,6 +820,7 @@ func (_u *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Category{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
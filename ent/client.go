@@ -15,15 +15,25 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/apitoken"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/localcredential"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/ratelimit"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/revision"
+	"icekalt.dev/money-tracker/ent/securityevent"
 	"icekalt.dev/money-tracker/ent/session"
 	"icekalt.dev/money-tracker/ent/settings"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/ent/useridentity"
 )
 
 // Client is the client that holds all ent builders.
@@ -33,22 +43,42 @@ type Client struct {
 	Schema *migrate.Schema
 	// APIToken is the client for interacting with the APIToken builders.
 	APIToken *APITokenClient
+	// AggregateGeneration is the client for interacting with the AggregateGeneration builders.
+	AggregateGeneration *AggregateGenerationClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// Household is the client for interacting with the Household builders.
 	Household *HouseholdClient
+	// HouseholdMember is the client for interacting with the HouseholdMember builders.
+	HouseholdMember *HouseholdMemberClient
+	// HouseholdTransfer is the client for interacting with the HouseholdTransfer builders.
+	HouseholdTransfer *HouseholdTransferClient
+	// LocalCredential is the client for interacting with the LocalCredential builders.
+	LocalCredential *LocalCredentialClient
+	// MonthlyAggregate is the client for interacting with the MonthlyAggregate builders.
+	MonthlyAggregate *MonthlyAggregateClient
+	// RateLimit is the client for interacting with the RateLimit builders.
+	RateLimit *RateLimitClient
 	// RecurringExpense is the client for interacting with the RecurringExpense builders.
 	RecurringExpense *RecurringExpenseClient
 	// RecurringScheduleOverride is the client for interacting with the RecurringScheduleOverride builders.
 	RecurringScheduleOverride *RecurringScheduleOverrideClient
+	// Revision is the client for interacting with the Revision builders.
+	Revision *RevisionClient
+	// SecurityEvent is the client for interacting with the SecurityEvent builders.
+	SecurityEvent *SecurityEventClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// Settlement is the client for interacting with the Settlement builders.
+	Settlement *SettlementClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -61,14 +91,24 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.APIToken = NewAPITokenClient(c.config)
+	c.AggregateGeneration = NewAggregateGenerationClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.Household = NewHouseholdClient(c.config)
+	c.HouseholdMember = NewHouseholdMemberClient(c.config)
+	c.HouseholdTransfer = NewHouseholdTransferClient(c.config)
+	c.LocalCredential = NewLocalCredentialClient(c.config)
+	c.MonthlyAggregate = NewMonthlyAggregateClient(c.config)
+	c.RateLimit = NewRateLimitClient(c.config)
 	c.RecurringExpense = NewRecurringExpenseClient(c.config)
 	c.RecurringScheduleOverride = NewRecurringScheduleOverrideClient(c.config)
+	c.Revision = NewRevisionClient(c.config)
+	c.SecurityEvent = NewSecurityEventClient(c.config)
 	c.Session = NewSessionClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
+	c.Settlement = NewSettlementClient(c.config)
 	c.Transaction = NewTransactionClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 }
 
 type (
@@ -162,14 +202,24 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                       ctx,
 		config:                    cfg,
 		APIToken:                  NewAPITokenClient(cfg),
+		AggregateGeneration:       NewAggregateGenerationClient(cfg),
 		Category:                  NewCategoryClient(cfg),
 		Household:                 NewHouseholdClient(cfg),
+		HouseholdMember:           NewHouseholdMemberClient(cfg),
+		HouseholdTransfer:         NewHouseholdTransferClient(cfg),
+		LocalCredential:           NewLocalCredentialClient(cfg),
+		MonthlyAggregate:          NewMonthlyAggregateClient(cfg),
+		RateLimit:                 NewRateLimitClient(cfg),
 		RecurringExpense:          NewRecurringExpenseClient(cfg),
 		RecurringScheduleOverride: NewRecurringScheduleOverrideClient(cfg),
+		Revision:                  NewRevisionClient(cfg),
+		SecurityEvent:             NewSecurityEventClient(cfg),
 		Session:                   NewSessionClient(cfg),
 		Settings:                  NewSettingsClient(cfg),
+		Settlement:                NewSettlementClient(cfg),
 		Transaction:               NewTransactionClient(cfg),
 		User:                      NewUserClient(cfg),
+		UserIdentity:              NewUserIdentityClient(cfg),
 	}, nil
 }
 
@@ -190,14 +240,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                       ctx,
 		config:                    cfg,
 		APIToken:                  NewAPITokenClient(cfg),
+		AggregateGeneration:       NewAggregateGenerationClient(cfg),
 		Category:                  NewCategoryClient(cfg),
 		Household:                 NewHouseholdClient(cfg),
+		HouseholdMember:           NewHouseholdMemberClient(cfg),
+		HouseholdTransfer:         NewHouseholdTransferClient(cfg),
+		LocalCredential:           NewLocalCredentialClient(cfg),
+		MonthlyAggregate:          NewMonthlyAggregateClient(cfg),
+		RateLimit:                 NewRateLimitClient(cfg),
 		RecurringExpense:          NewRecurringExpenseClient(cfg),
 		RecurringScheduleOverride: NewRecurringScheduleOverrideClient(cfg),
+		Revision:                  NewRevisionClient(cfg),
+		SecurityEvent:             NewSecurityEventClient(cfg),
 		Session:                   NewSessionClient(cfg),
 		Settings:                  NewSettingsClient(cfg),
+		Settlement:                NewSettlementClient(cfg),
 		Transaction:               NewTransactionClient(cfg),
 		User:                      NewUserClient(cfg),
+		UserIdentity:              NewUserIdentityClient(cfg),
 	}, nil
 }
 
@@ -227,8 +287,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIToken, c.Category, c.Household, c.RecurringExpense,
-		c.RecurringScheduleOverride, c.Session, c.Settings, c.Transaction, c.User,
+		c.APIToken, c.AggregateGeneration, c.Category, c.Household, c.HouseholdMember,
+		c.HouseholdTransfer, c.LocalCredential, c.MonthlyAggregate, c.RateLimit,
+		c.RecurringExpense, c.RecurringScheduleOverride, c.Revision, c.SecurityEvent,
+		c.Session, c.Settings, c.Settlement, c.Transaction, c.User, c.UserIdentity,
 	} {
 		n.Use(hooks...)
 	}
@@ -238,8 +300,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIToken, c.Category, c.Household, c.RecurringExpense,
-		c.RecurringScheduleOverride, c.Session, c.Settings, c.Transaction, c.User,
+		c.APIToken, c.AggregateGeneration, c.Category, c.Household, c.HouseholdMember,
+		c.HouseholdTransfer, c.LocalCredential, c.MonthlyAggregate, c.RateLimit,
+		c.RecurringExpense, c.RecurringScheduleOverride, c.Revision, c.SecurityEvent,
+		c.Session, c.Settings, c.Settlement, c.Transaction, c.User, c.UserIdentity,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -250,22 +314,42 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *APITokenMutation:
 		return c.APIToken.mutate(ctx, m)
+	case *AggregateGenerationMutation:
+		return c.AggregateGeneration.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
 	case *HouseholdMutation:
 		return c.Household.mutate(ctx, m)
+	case *HouseholdMemberMutation:
+		return c.HouseholdMember.mutate(ctx, m)
+	case *HouseholdTransferMutation:
+		return c.HouseholdTransfer.mutate(ctx, m)
+	case *LocalCredentialMutation:
+		return c.LocalCredential.mutate(ctx, m)
+	case *MonthlyAggregateMutation:
+		return c.MonthlyAggregate.mutate(ctx, m)
+	case *RateLimitMutation:
+		return c.RateLimit.mutate(ctx, m)
 	case *RecurringExpenseMutation:
 		return c.RecurringExpense.mutate(ctx, m)
 	case *RecurringScheduleOverrideMutation:
 		return c.RecurringScheduleOverride.mutate(ctx, m)
+	case *RevisionMutation:
+		return c.Revision.mutate(ctx, m)
+	case *SecurityEventMutation:
+		return c.SecurityEvent.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
 	case *SettingsMutation:
 		return c.Settings.mutate(ctx, m)
+	case *SettlementMutation:
+		return c.Settlement.mutate(ctx, m)
 	case *TransactionMutation:
 		return c.Transaction.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -420,6 +504,155 @@ func (c *APITokenClient) mutate(ctx context.Context, m *APITokenMutation) (Value
 	}
 }
 
+// AggregateGenerationClient is a client for the AggregateGeneration schema.
+type AggregateGenerationClient struct {
+	config
+}
+
+// NewAggregateGenerationClient returns a client for the AggregateGeneration from the given config.
+func NewAggregateGenerationClient(c config) *AggregateGenerationClient {
+	return &AggregateGenerationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `aggregategeneration.Hooks(f(g(h())))`.
+func (c *AggregateGenerationClient) Use(hooks ...Hook) {
+	c.hooks.AggregateGeneration = append(c.hooks.AggregateGeneration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `aggregategeneration.Intercept(f(g(h())))`.
+func (c *AggregateGenerationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AggregateGeneration = append(c.inters.AggregateGeneration, interceptors...)
+}
+
+// Create returns a builder for creating a AggregateGeneration entity.
+func (c *AggregateGenerationClient) Create() *AggregateGenerationCreate {
+	mutation := newAggregateGenerationMutation(c.config, OpCreate)
+	return &AggregateGenerationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AggregateGeneration entities.
+func (c *AggregateGenerationClient) CreateBulk(builders ...*AggregateGenerationCreate) *AggregateGenerationCreateBulk {
+	return &AggregateGenerationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AggregateGenerationClient) MapCreateBulk(slice any, setFunc func(*AggregateGenerationCreate, int)) *AggregateGenerationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AggregateGenerationCreateBulk{err: fmt.Errorf("calling to AggregateGenerationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AggregateGenerationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AggregateGenerationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AggregateGeneration.
+func (c *AggregateGenerationClient) Update() *AggregateGenerationUpdate {
+	mutation := newAggregateGenerationMutation(c.config, OpUpdate)
+	return &AggregateGenerationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AggregateGenerationClient) UpdateOne(_m *AggregateGeneration) *AggregateGenerationUpdateOne {
+	mutation := newAggregateGenerationMutation(c.config, OpUpdateOne, withAggregateGeneration(_m))
+	return &AggregateGenerationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AggregateGenerationClient) UpdateOneID(id int) *AggregateGenerationUpdateOne {
+	mutation := newAggregateGenerationMutation(c.config, OpUpdateOne, withAggregateGenerationID(id))
+	return &AggregateGenerationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AggregateGeneration.
+func (c *AggregateGenerationClient) Delete() *AggregateGenerationDelete {
+	mutation := newAggregateGenerationMutation(c.config, OpDelete)
+	return &AggregateGenerationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AggregateGenerationClient) DeleteOne(_m *AggregateGeneration) *AggregateGenerationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AggregateGenerationClient) DeleteOneID(id int) *AggregateGenerationDeleteOne {
+	builder := c.Delete().Where(aggregategeneration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AggregateGenerationDeleteOne{builder}
+}
+
+// Query returns a query builder for AggregateGeneration.
+func (c *AggregateGenerationClient) Query() *AggregateGenerationQuery {
+	return &AggregateGenerationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAggregateGeneration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AggregateGeneration entity by its id.
+func (c *AggregateGenerationClient) Get(ctx context.Context, id int) (*AggregateGeneration, error) {
+	return c.Query().Where(aggregategeneration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AggregateGenerationClient) GetX(ctx context.Context, id int) *AggregateGeneration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHousehold queries the household edge of a AggregateGeneration.
+func (c *AggregateGenerationClient) QueryHousehold(_m *AggregateGeneration) *HouseholdQuery {
+	query := (&HouseholdClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(aggregategeneration.Table, aggregategeneration.FieldID, id),
+			sqlgraph.To(household.Table, household.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, aggregategeneration.HouseholdTable, aggregategeneration.HouseholdColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AggregateGenerationClient) Hooks() []Hook {
+	return c.hooks.AggregateGeneration
+}
+
+// Interceptors returns the client interceptors.
+func (c *AggregateGenerationClient) Interceptors() []Interceptor {
+	return c.inters.AggregateGeneration
+}
+
+func (c *AggregateGenerationClient) mutate(ctx context.Context, m *AggregateGenerationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AggregateGenerationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AggregateGenerationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AggregateGenerationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AggregateGenerationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AggregateGeneration mutation op: %q", m.Op())
+	}
+}
+
 // CategoryClient is a client for the Category schema.
 type CategoryClient struct {
 	config
@@ -773,6 +1006,86 @@ func (c *HouseholdClient) QueryRecurringExpenses(_m *Household) *RecurringExpens
 	return query
 }
 
+// QueryMonthlyAggregates queries the monthly_aggregates edge of a Household.
+func (c *HouseholdClient) QueryMonthlyAggregates(_m *Household) *MonthlyAggregateQuery {
+	query := (&MonthlyAggregateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, id),
+			sqlgraph.To(monthlyaggregate.Table, monthlyaggregate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, household.MonthlyAggregatesTable, household.MonthlyAggregatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAggregateGeneration queries the aggregate_generation edge of a Household.
+func (c *HouseholdClient) QueryAggregateGeneration(_m *Household) *AggregateGenerationQuery {
+	query := (&AggregateGenerationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, id),
+			sqlgraph.To(aggregategeneration.Table, aggregategeneration.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, household.AggregateGenerationTable, household.AggregateGenerationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Household.
+func (c *HouseholdClient) QueryMembers(_m *Household) *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, id),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, household.MembersTable, household.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySettlements queries the settlements edge of a Household.
+func (c *HouseholdClient) QuerySettlements(_m *Household) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, household.SettlementsTable, household.SettlementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransfer queries the transfer edge of a Household.
+func (c *HouseholdClient) QueryTransfer(_m *Household) *HouseholdTransferQuery {
+	query := (&HouseholdTransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, id),
+			sqlgraph.To(householdtransfer.Table, householdtransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, household.TransferTable, household.TransferColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *HouseholdClient) Hooks() []Hook {
 	return c.hooks.Household
@@ -798,107 +1111,107 @@ func (c *HouseholdClient) mutate(ctx context.Context, m *HouseholdMutation) (Val
 	}
 }
 
-// RecurringExpenseClient is a client for the RecurringExpense schema.
-type RecurringExpenseClient struct {
+// HouseholdMemberClient is a client for the HouseholdMember schema.
+type HouseholdMemberClient struct {
 	config
 }
 
-// NewRecurringExpenseClient returns a client for the RecurringExpense from the given config.
-func NewRecurringExpenseClient(c config) *RecurringExpenseClient {
-	return &RecurringExpenseClient{config: c}
+// NewHouseholdMemberClient returns a client for the HouseholdMember from the given config.
+func NewHouseholdMemberClient(c config) *HouseholdMemberClient {
+	return &HouseholdMemberClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `recurringexpense.Hooks(f(g(h())))`.
-func (c *RecurringExpenseClient) Use(hooks ...Hook) {
-	c.hooks.RecurringExpense = append(c.hooks.RecurringExpense, hooks...)
+// A call to `Use(f, g, h)` equals to `householdmember.Hooks(f(g(h())))`.
+func (c *HouseholdMemberClient) Use(hooks ...Hook) {
+	c.hooks.HouseholdMember = append(c.hooks.HouseholdMember, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `recurringexpense.Intercept(f(g(h())))`.
-func (c *RecurringExpenseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RecurringExpense = append(c.inters.RecurringExpense, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `householdmember.Intercept(f(g(h())))`.
+func (c *HouseholdMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HouseholdMember = append(c.inters.HouseholdMember, interceptors...)
 }
 
-// Create returns a builder for creating a RecurringExpense entity.
-func (c *RecurringExpenseClient) Create() *RecurringExpenseCreate {
-	mutation := newRecurringExpenseMutation(c.config, OpCreate)
-	return &RecurringExpenseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a HouseholdMember entity.
+func (c *HouseholdMemberClient) Create() *HouseholdMemberCreate {
+	mutation := newHouseholdMemberMutation(c.config, OpCreate)
+	return &HouseholdMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RecurringExpense entities.
-func (c *RecurringExpenseClient) CreateBulk(builders ...*RecurringExpenseCreate) *RecurringExpenseCreateBulk {
-	return &RecurringExpenseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of HouseholdMember entities.
+func (c *HouseholdMemberClient) CreateBulk(builders ...*HouseholdMemberCreate) *HouseholdMemberCreateBulk {
+	return &HouseholdMemberCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RecurringExpenseClient) MapCreateBulk(slice any, setFunc func(*RecurringExpenseCreate, int)) *RecurringExpenseCreateBulk {
+func (c *HouseholdMemberClient) MapCreateBulk(slice any, setFunc func(*HouseholdMemberCreate, int)) *HouseholdMemberCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RecurringExpenseCreateBulk{err: fmt.Errorf("calling to RecurringExpenseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &HouseholdMemberCreateBulk{err: fmt.Errorf("calling to HouseholdMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RecurringExpenseCreate, rv.Len())
+	builders := make([]*HouseholdMemberCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RecurringExpenseCreateBulk{config: c.config, builders: builders}
+	return &HouseholdMemberCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RecurringExpense.
-func (c *RecurringExpenseClient) Update() *RecurringExpenseUpdate {
-	mutation := newRecurringExpenseMutation(c.config, OpUpdate)
-	return &RecurringExpenseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for HouseholdMember.
+func (c *HouseholdMemberClient) Update() *HouseholdMemberUpdate {
+	mutation := newHouseholdMemberMutation(c.config, OpUpdate)
+	return &HouseholdMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RecurringExpenseClient) UpdateOne(_m *RecurringExpense) *RecurringExpenseUpdateOne {
-	mutation := newRecurringExpenseMutation(c.config, OpUpdateOne, withRecurringExpense(_m))
-	return &RecurringExpenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HouseholdMemberClient) UpdateOne(_m *HouseholdMember) *HouseholdMemberUpdateOne {
+	mutation := newHouseholdMemberMutation(c.config, OpUpdateOne, withHouseholdMember(_m))
+	return &HouseholdMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RecurringExpenseClient) UpdateOneID(id int) *RecurringExpenseUpdateOne {
-	mutation := newRecurringExpenseMutation(c.config, OpUpdateOne, withRecurringExpenseID(id))
-	return &RecurringExpenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HouseholdMemberClient) UpdateOneID(id int) *HouseholdMemberUpdateOne {
+	mutation := newHouseholdMemberMutation(c.config, OpUpdateOne, withHouseholdMemberID(id))
+	return &HouseholdMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RecurringExpense.
-func (c *RecurringExpenseClient) Delete() *RecurringExpenseDelete {
-	mutation := newRecurringExpenseMutation(c.config, OpDelete)
-	return &RecurringExpenseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for HouseholdMember.
+func (c *HouseholdMemberClient) Delete() *HouseholdMemberDelete {
+	mutation := newHouseholdMemberMutation(c.config, OpDelete)
+	return &HouseholdMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RecurringExpenseClient) DeleteOne(_m *RecurringExpense) *RecurringExpenseDeleteOne {
+func (c *HouseholdMemberClient) DeleteOne(_m *HouseholdMember) *HouseholdMemberDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RecurringExpenseClient) DeleteOneID(id int) *RecurringExpenseDeleteOne {
-	builder := c.Delete().Where(recurringexpense.ID(id))
+func (c *HouseholdMemberClient) DeleteOneID(id int) *HouseholdMemberDeleteOne {
+	builder := c.Delete().Where(householdmember.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RecurringExpenseDeleteOne{builder}
+	return &HouseholdMemberDeleteOne{builder}
 }
 
-// Query returns a query builder for RecurringExpense.
-func (c *RecurringExpenseClient) Query() *RecurringExpenseQuery {
-	return &RecurringExpenseQuery{
+// Query returns a query builder for HouseholdMember.
+func (c *HouseholdMemberClient) Query() *HouseholdMemberQuery {
+	return &HouseholdMemberQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRecurringExpense},
+		ctx:    &QueryContext{Type: TypeHouseholdMember},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RecurringExpense entity by its id.
-func (c *RecurringExpenseClient) Get(ctx context.Context, id int) (*RecurringExpense, error) {
-	return c.Query().Where(recurringexpense.ID(id)).Only(ctx)
+// Get returns a HouseholdMember entity by its id.
+func (c *HouseholdMemberClient) Get(ctx context.Context, id int) (*HouseholdMember, error) {
+	return c.Query().Where(householdmember.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RecurringExpenseClient) GetX(ctx context.Context, id int) *RecurringExpense {
+func (c *HouseholdMemberClient) GetX(ctx context.Context, id int) *HouseholdMember {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -906,15 +1219,15 @@ func (c *RecurringExpenseClient) GetX(ctx context.Context, id int) *RecurringExp
 	return obj
 }
 
-// QueryHousehold queries the household edge of a RecurringExpense.
-func (c *RecurringExpenseClient) QueryHousehold(_m *RecurringExpense) *HouseholdQuery {
+// QueryHousehold queries the household edge of a HouseholdMember.
+func (c *HouseholdMemberClient) QueryHousehold(_m *HouseholdMember) *HouseholdQuery {
 	query := (&HouseholdClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, id),
 			sqlgraph.To(household.Table, household.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, recurringexpense.HouseholdTable, recurringexpense.HouseholdColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, householdmember.HouseholdTable, householdmember.HouseholdColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -922,15 +1235,15 @@ func (c *RecurringExpenseClient) QueryHousehold(_m *RecurringExpense) *Household
 	return query
 }
 
-// QueryCategory queries the category edge of a RecurringExpense.
-func (c *RecurringExpenseClient) QueryCategory(_m *RecurringExpense) *CategoryQuery {
-	query := (&CategoryClient{config: c.config}).Query()
+// QueryPaidTransactions queries the paid_transactions edge of a HouseholdMember.
+func (c *HouseholdMemberClient) QueryPaidTransactions(_m *HouseholdMember) *TransactionQuery {
+	query := (&TransactionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
-			sqlgraph.To(category.Table, category.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, recurringexpense.CategoryTable, recurringexpense.CategoryColumn),
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, id),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.PaidTransactionsTable, householdmember.PaidTransactionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -938,15 +1251,47 @@ func (c *RecurringExpenseClient) QueryCategory(_m *RecurringExpense) *CategoryQu
 	return query
 }
 
-// QueryScheduleOverrides queries the schedule_overrides edge of a RecurringExpense.
-func (c *RecurringExpenseClient) QueryScheduleOverrides(_m *RecurringExpense) *RecurringScheduleOverrideQuery {
-	query := (&RecurringScheduleOverrideClient{config: c.config}).Query()
+// QueryPaidRecurringExpenses queries the paid_recurring_expenses edge of a HouseholdMember.
+func (c *HouseholdMemberClient) QueryPaidRecurringExpenses(_m *HouseholdMember) *RecurringExpenseQuery {
+	query := (&RecurringExpenseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
-			sqlgraph.To(recurringscheduleoverride.Table, recurringscheduleoverride.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, recurringexpense.ScheduleOverridesTable, recurringexpense.ScheduleOverridesColumn),
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, id),
+			sqlgraph.To(recurringexpense.Table, recurringexpense.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.PaidRecurringExpensesTable, householdmember.PaidRecurringExpensesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySettlementsPaid queries the settlements_paid edge of a HouseholdMember.
+func (c *HouseholdMemberClient) QuerySettlementsPaid(_m *HouseholdMember) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.SettlementsPaidTable, householdmember.SettlementsPaidColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySettlementsReceived queries the settlements_received edge of a HouseholdMember.
+func (c *HouseholdMemberClient) QuerySettlementsReceived(_m *HouseholdMember) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.SettlementsReceivedTable, householdmember.SettlementsReceivedColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -955,59 +1300,852 @@ func (c *RecurringExpenseClient) QueryScheduleOverrides(_m *RecurringExpense) *R
 }
 
 // Hooks returns the client hooks.
-func (c *RecurringExpenseClient) Hooks() []Hook {
-	return c.hooks.RecurringExpense
+func (c *HouseholdMemberClient) Hooks() []Hook {
+	return c.hooks.HouseholdMember
 }
 
 // Interceptors returns the client interceptors.
-func (c *RecurringExpenseClient) Interceptors() []Interceptor {
-	return c.inters.RecurringExpense
+func (c *HouseholdMemberClient) Interceptors() []Interceptor {
+	return c.inters.HouseholdMember
 }
 
-func (c *RecurringExpenseClient) mutate(ctx context.Context, m *RecurringExpenseMutation) (Value, error) {
+func (c *HouseholdMemberClient) mutate(ctx context.Context, m *HouseholdMemberMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RecurringExpenseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HouseholdMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RecurringExpenseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HouseholdMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RecurringExpenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HouseholdMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RecurringExpenseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&HouseholdMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RecurringExpense mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown HouseholdMember mutation op: %q", m.Op())
 	}
 }
 
-// RecurringScheduleOverrideClient is a client for the RecurringScheduleOverride schema.
-type RecurringScheduleOverrideClient struct {
+// HouseholdTransferClient is a client for the HouseholdTransfer schema.
+type HouseholdTransferClient struct {
 	config
 }
 
-// NewRecurringScheduleOverrideClient returns a client for the RecurringScheduleOverride from the given config.
-func NewRecurringScheduleOverrideClient(c config) *RecurringScheduleOverrideClient {
-	return &RecurringScheduleOverrideClient{config: c}
+// NewHouseholdTransferClient returns a client for the HouseholdTransfer from the given config.
+func NewHouseholdTransferClient(c config) *HouseholdTransferClient {
+	return &HouseholdTransferClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `recurringscheduleoverride.Hooks(f(g(h())))`.
-func (c *RecurringScheduleOverrideClient) Use(hooks ...Hook) {
-	c.hooks.RecurringScheduleOverride = append(c.hooks.RecurringScheduleOverride, hooks...)
+// A call to `Use(f, g, h)` equals to `householdtransfer.Hooks(f(g(h())))`.
+func (c *HouseholdTransferClient) Use(hooks ...Hook) {
+	c.hooks.HouseholdTransfer = append(c.hooks.HouseholdTransfer, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `recurringscheduleoverride.Intercept(f(g(h())))`.
-func (c *RecurringScheduleOverrideClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RecurringScheduleOverride = append(c.inters.RecurringScheduleOverride, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `householdtransfer.Intercept(f(g(h())))`.
+func (c *HouseholdTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HouseholdTransfer = append(c.inters.HouseholdTransfer, interceptors...)
 }
 
-// Create returns a builder for creating a RecurringScheduleOverride entity.
-func (c *RecurringScheduleOverrideClient) Create() *RecurringScheduleOverrideCreate {
-	mutation := newRecurringScheduleOverrideMutation(c.config, OpCreate)
-	return &RecurringScheduleOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a HouseholdTransfer entity.
+func (c *HouseholdTransferClient) Create() *HouseholdTransferCreate {
+	mutation := newHouseholdTransferMutation(c.config, OpCreate)
+	return &HouseholdTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RecurringScheduleOverride entities.
+// CreateBulk returns a builder for creating a bulk of HouseholdTransfer entities.
+func (c *HouseholdTransferClient) CreateBulk(builders ...*HouseholdTransferCreate) *HouseholdTransferCreateBulk {
+	return &HouseholdTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HouseholdTransferClient) MapCreateBulk(slice any, setFunc func(*HouseholdTransferCreate, int)) *HouseholdTransferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HouseholdTransferCreateBulk{err: fmt.Errorf("calling to HouseholdTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HouseholdTransferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HouseholdTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HouseholdTransfer.
+func (c *HouseholdTransferClient) Update() *HouseholdTransferUpdate {
+	mutation := newHouseholdTransferMutation(c.config, OpUpdate)
+	return &HouseholdTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HouseholdTransferClient) UpdateOne(_m *HouseholdTransfer) *HouseholdTransferUpdateOne {
+	mutation := newHouseholdTransferMutation(c.config, OpUpdateOne, withHouseholdTransfer(_m))
+	return &HouseholdTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HouseholdTransferClient) UpdateOneID(id int) *HouseholdTransferUpdateOne {
+	mutation := newHouseholdTransferMutation(c.config, OpUpdateOne, withHouseholdTransferID(id))
+	return &HouseholdTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HouseholdTransfer.
+func (c *HouseholdTransferClient) Delete() *HouseholdTransferDelete {
+	mutation := newHouseholdTransferMutation(c.config, OpDelete)
+	return &HouseholdTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HouseholdTransferClient) DeleteOne(_m *HouseholdTransfer) *HouseholdTransferDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HouseholdTransferClient) DeleteOneID(id int) *HouseholdTransferDeleteOne {
+	builder := c.Delete().Where(householdtransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HouseholdTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for HouseholdTransfer.
+func (c *HouseholdTransferClient) Query() *HouseholdTransferQuery {
+	return &HouseholdTransferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHouseholdTransfer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HouseholdTransfer entity by its id.
+func (c *HouseholdTransferClient) Get(ctx context.Context, id int) (*HouseholdTransfer, error) {
+	return c.Query().Where(householdtransfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HouseholdTransferClient) GetX(ctx context.Context, id int) *HouseholdTransfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHousehold queries the household edge of a HouseholdTransfer.
+func (c *HouseholdTransferClient) QueryHousehold(_m *HouseholdTransfer) *HouseholdQuery {
+	query := (&HouseholdClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdtransfer.Table, householdtransfer.FieldID, id),
+			sqlgraph.To(household.Table, household.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, householdtransfer.HouseholdTable, householdtransfer.HouseholdColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a HouseholdTransfer.
+func (c *HouseholdTransferClient) QueryUser(_m *HouseholdTransfer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdtransfer.Table, householdtransfer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, householdtransfer.UserTable, householdtransfer.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HouseholdTransferClient) Hooks() []Hook {
+	return c.hooks.HouseholdTransfer
+}
+
+// Interceptors returns the client interceptors.
+func (c *HouseholdTransferClient) Interceptors() []Interceptor {
+	return c.inters.HouseholdTransfer
+}
+
+func (c *HouseholdTransferClient) mutate(ctx context.Context, m *HouseholdTransferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HouseholdTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HouseholdTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HouseholdTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HouseholdTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HouseholdTransfer mutation op: %q", m.Op())
+	}
+}
+
+// LocalCredentialClient is a client for the LocalCredential schema.
+type LocalCredentialClient struct {
+	config
+}
+
+// NewLocalCredentialClient returns a client for the LocalCredential from the given config.
+func NewLocalCredentialClient(c config) *LocalCredentialClient {
+	return &LocalCredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `localcredential.Hooks(f(g(h())))`.
+func (c *LocalCredentialClient) Use(hooks ...Hook) {
+	c.hooks.LocalCredential = append(c.hooks.LocalCredential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `localcredential.Intercept(f(g(h())))`.
+func (c *LocalCredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LocalCredential = append(c.inters.LocalCredential, interceptors...)
+}
+
+// Create returns a builder for creating a LocalCredential entity.
+func (c *LocalCredentialClient) Create() *LocalCredentialCreate {
+	mutation := newLocalCredentialMutation(c.config, OpCreate)
+	return &LocalCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LocalCredential entities.
+func (c *LocalCredentialClient) CreateBulk(builders ...*LocalCredentialCreate) *LocalCredentialCreateBulk {
+	return &LocalCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocalCredentialClient) MapCreateBulk(slice any, setFunc func(*LocalCredentialCreate, int)) *LocalCredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocalCredentialCreateBulk{err: fmt.Errorf("calling to LocalCredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocalCredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocalCredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LocalCredential.
+func (c *LocalCredentialClient) Update() *LocalCredentialUpdate {
+	mutation := newLocalCredentialMutation(c.config, OpUpdate)
+	return &LocalCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocalCredentialClient) UpdateOne(_m *LocalCredential) *LocalCredentialUpdateOne {
+	mutation := newLocalCredentialMutation(c.config, OpUpdateOne, withLocalCredential(_m))
+	return &LocalCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocalCredentialClient) UpdateOneID(id int) *LocalCredentialUpdateOne {
+	mutation := newLocalCredentialMutation(c.config, OpUpdateOne, withLocalCredentialID(id))
+	return &LocalCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LocalCredential.
+func (c *LocalCredentialClient) Delete() *LocalCredentialDelete {
+	mutation := newLocalCredentialMutation(c.config, OpDelete)
+	return &LocalCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocalCredentialClient) DeleteOne(_m *LocalCredential) *LocalCredentialDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocalCredentialClient) DeleteOneID(id int) *LocalCredentialDeleteOne {
+	builder := c.Delete().Where(localcredential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocalCredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for LocalCredential.
+func (c *LocalCredentialClient) Query() *LocalCredentialQuery {
+	return &LocalCredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocalCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LocalCredential entity by its id.
+func (c *LocalCredentialClient) Get(ctx context.Context, id int) (*LocalCredential, error) {
+	return c.Query().Where(localcredential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocalCredentialClient) GetX(ctx context.Context, id int) *LocalCredential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LocalCredential.
+func (c *LocalCredentialClient) QueryUser(_m *LocalCredential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(localcredential.Table, localcredential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, localcredential.UserTable, localcredential.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocalCredentialClient) Hooks() []Hook {
+	return c.hooks.LocalCredential
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocalCredentialClient) Interceptors() []Interceptor {
+	return c.inters.LocalCredential
+}
+
+func (c *LocalCredentialClient) mutate(ctx context.Context, m *LocalCredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocalCredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocalCredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocalCredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocalCredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LocalCredential mutation op: %q", m.Op())
+	}
+}
+
+// MonthlyAggregateClient is a client for the MonthlyAggregate schema.
+type MonthlyAggregateClient struct {
+	config
+}
+
+// NewMonthlyAggregateClient returns a client for the MonthlyAggregate from the given config.
+func NewMonthlyAggregateClient(c config) *MonthlyAggregateClient {
+	return &MonthlyAggregateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `monthlyaggregate.Hooks(f(g(h())))`.
+func (c *MonthlyAggregateClient) Use(hooks ...Hook) {
+	c.hooks.MonthlyAggregate = append(c.hooks.MonthlyAggregate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `monthlyaggregate.Intercept(f(g(h())))`.
+func (c *MonthlyAggregateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MonthlyAggregate = append(c.inters.MonthlyAggregate, interceptors...)
+}
+
+// Create returns a builder for creating a MonthlyAggregate entity.
+func (c *MonthlyAggregateClient) Create() *MonthlyAggregateCreate {
+	mutation := newMonthlyAggregateMutation(c.config, OpCreate)
+	return &MonthlyAggregateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MonthlyAggregate entities.
+func (c *MonthlyAggregateClient) CreateBulk(builders ...*MonthlyAggregateCreate) *MonthlyAggregateCreateBulk {
+	return &MonthlyAggregateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MonthlyAggregateClient) MapCreateBulk(slice any, setFunc func(*MonthlyAggregateCreate, int)) *MonthlyAggregateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MonthlyAggregateCreateBulk{err: fmt.Errorf("calling to MonthlyAggregateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MonthlyAggregateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MonthlyAggregateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MonthlyAggregate.
+func (c *MonthlyAggregateClient) Update() *MonthlyAggregateUpdate {
+	mutation := newMonthlyAggregateMutation(c.config, OpUpdate)
+	return &MonthlyAggregateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MonthlyAggregateClient) UpdateOne(_m *MonthlyAggregate) *MonthlyAggregateUpdateOne {
+	mutation := newMonthlyAggregateMutation(c.config, OpUpdateOne, withMonthlyAggregate(_m))
+	return &MonthlyAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MonthlyAggregateClient) UpdateOneID(id int) *MonthlyAggregateUpdateOne {
+	mutation := newMonthlyAggregateMutation(c.config, OpUpdateOne, withMonthlyAggregateID(id))
+	return &MonthlyAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MonthlyAggregate.
+func (c *MonthlyAggregateClient) Delete() *MonthlyAggregateDelete {
+	mutation := newMonthlyAggregateMutation(c.config, OpDelete)
+	return &MonthlyAggregateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MonthlyAggregateClient) DeleteOne(_m *MonthlyAggregate) *MonthlyAggregateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MonthlyAggregateClient) DeleteOneID(id int) *MonthlyAggregateDeleteOne {
+	builder := c.Delete().Where(monthlyaggregate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MonthlyAggregateDeleteOne{builder}
+}
+
+// Query returns a query builder for MonthlyAggregate.
+func (c *MonthlyAggregateClient) Query() *MonthlyAggregateQuery {
+	return &MonthlyAggregateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMonthlyAggregate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MonthlyAggregate entity by its id.
+func (c *MonthlyAggregateClient) Get(ctx context.Context, id int) (*MonthlyAggregate, error) {
+	return c.Query().Where(monthlyaggregate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MonthlyAggregateClient) GetX(ctx context.Context, id int) *MonthlyAggregate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHousehold queries the household edge of a MonthlyAggregate.
+func (c *MonthlyAggregateClient) QueryHousehold(_m *MonthlyAggregate) *HouseholdQuery {
+	query := (&HouseholdClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlyaggregate.Table, monthlyaggregate.FieldID, id),
+			sqlgraph.To(household.Table, household.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monthlyaggregate.HouseholdTable, monthlyaggregate.HouseholdColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MonthlyAggregateClient) Hooks() []Hook {
+	return c.hooks.MonthlyAggregate
+}
+
+// Interceptors returns the client interceptors.
+func (c *MonthlyAggregateClient) Interceptors() []Interceptor {
+	return c.inters.MonthlyAggregate
+}
+
+func (c *MonthlyAggregateClient) mutate(ctx context.Context, m *MonthlyAggregateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MonthlyAggregateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MonthlyAggregateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MonthlyAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MonthlyAggregateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MonthlyAggregate mutation op: %q", m.Op())
+	}
+}
+
+// RateLimitClient is a client for the RateLimit schema.
+type RateLimitClient struct {
+	config
+}
+
+// NewRateLimitClient returns a client for the RateLimit from the given config.
+func NewRateLimitClient(c config) *RateLimitClient {
+	return &RateLimitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratelimit.Hooks(f(g(h())))`.
+func (c *RateLimitClient) Use(hooks ...Hook) {
+	c.hooks.RateLimit = append(c.hooks.RateLimit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratelimit.Intercept(f(g(h())))`.
+func (c *RateLimitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateLimit = append(c.inters.RateLimit, interceptors...)
+}
+
+// Create returns a builder for creating a RateLimit entity.
+func (c *RateLimitClient) Create() *RateLimitCreate {
+	mutation := newRateLimitMutation(c.config, OpCreate)
+	return &RateLimitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateLimit entities.
+func (c *RateLimitClient) CreateBulk(builders ...*RateLimitCreate) *RateLimitCreateBulk {
+	return &RateLimitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RateLimitClient) MapCreateBulk(slice any, setFunc func(*RateLimitCreate, int)) *RateLimitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RateLimitCreateBulk{err: fmt.Errorf("calling to RateLimitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RateLimitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RateLimitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateLimit.
+func (c *RateLimitClient) Update() *RateLimitUpdate {
+	mutation := newRateLimitMutation(c.config, OpUpdate)
+	return &RateLimitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateLimitClient) UpdateOne(_m *RateLimit) *RateLimitUpdateOne {
+	mutation := newRateLimitMutation(c.config, OpUpdateOne, withRateLimit(_m))
+	return &RateLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateLimitClient) UpdateOneID(id int) *RateLimitUpdateOne {
+	mutation := newRateLimitMutation(c.config, OpUpdateOne, withRateLimitID(id))
+	return &RateLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateLimit.
+func (c *RateLimitClient) Delete() *RateLimitDelete {
+	mutation := newRateLimitMutation(c.config, OpDelete)
+	return &RateLimitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateLimitClient) DeleteOne(_m *RateLimit) *RateLimitDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RateLimitClient) DeleteOneID(id int) *RateLimitDeleteOne {
+	builder := c.Delete().Where(ratelimit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateLimitDeleteOne{builder}
+}
+
+// Query returns a query builder for RateLimit.
+func (c *RateLimitClient) Query() *RateLimitQuery {
+	return &RateLimitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRateLimit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RateLimit entity by its id.
+func (c *RateLimitClient) Get(ctx context.Context, id int) (*RateLimit, error) {
+	return c.Query().Where(ratelimit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateLimitClient) GetX(ctx context.Context, id int) *RateLimit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateLimitClient) Hooks() []Hook {
+	return c.hooks.RateLimit
+}
+
+// Interceptors returns the client interceptors.
+func (c *RateLimitClient) Interceptors() []Interceptor {
+	return c.inters.RateLimit
+}
+
+func (c *RateLimitClient) mutate(ctx context.Context, m *RateLimitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RateLimitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RateLimitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RateLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RateLimitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RateLimit mutation op: %q", m.Op())
+	}
+}
+
+// RecurringExpenseClient is a client for the RecurringExpense schema.
+type RecurringExpenseClient struct {
+	config
+}
+
+// NewRecurringExpenseClient returns a client for the RecurringExpense from the given config.
+func NewRecurringExpenseClient(c config) *RecurringExpenseClient {
+	return &RecurringExpenseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recurringexpense.Hooks(f(g(h())))`.
+func (c *RecurringExpenseClient) Use(hooks ...Hook) {
+	c.hooks.RecurringExpense = append(c.hooks.RecurringExpense, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recurringexpense.Intercept(f(g(h())))`.
+func (c *RecurringExpenseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecurringExpense = append(c.inters.RecurringExpense, interceptors...)
+}
+
+// Create returns a builder for creating a RecurringExpense entity.
+func (c *RecurringExpenseClient) Create() *RecurringExpenseCreate {
+	mutation := newRecurringExpenseMutation(c.config, OpCreate)
+	return &RecurringExpenseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecurringExpense entities.
+func (c *RecurringExpenseClient) CreateBulk(builders ...*RecurringExpenseCreate) *RecurringExpenseCreateBulk {
+	return &RecurringExpenseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecurringExpenseClient) MapCreateBulk(slice any, setFunc func(*RecurringExpenseCreate, int)) *RecurringExpenseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecurringExpenseCreateBulk{err: fmt.Errorf("calling to RecurringExpenseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecurringExpenseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecurringExpenseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecurringExpense.
+func (c *RecurringExpenseClient) Update() *RecurringExpenseUpdate {
+	mutation := newRecurringExpenseMutation(c.config, OpUpdate)
+	return &RecurringExpenseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecurringExpenseClient) UpdateOne(_m *RecurringExpense) *RecurringExpenseUpdateOne {
+	mutation := newRecurringExpenseMutation(c.config, OpUpdateOne, withRecurringExpense(_m))
+	return &RecurringExpenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecurringExpenseClient) UpdateOneID(id int) *RecurringExpenseUpdateOne {
+	mutation := newRecurringExpenseMutation(c.config, OpUpdateOne, withRecurringExpenseID(id))
+	return &RecurringExpenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecurringExpense.
+func (c *RecurringExpenseClient) Delete() *RecurringExpenseDelete {
+	mutation := newRecurringExpenseMutation(c.config, OpDelete)
+	return &RecurringExpenseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecurringExpenseClient) DeleteOne(_m *RecurringExpense) *RecurringExpenseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecurringExpenseClient) DeleteOneID(id int) *RecurringExpenseDeleteOne {
+	builder := c.Delete().Where(recurringexpense.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecurringExpenseDeleteOne{builder}
+}
+
+// Query returns a query builder for RecurringExpense.
+func (c *RecurringExpenseClient) Query() *RecurringExpenseQuery {
+	return &RecurringExpenseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecurringExpense},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecurringExpense entity by its id.
+func (c *RecurringExpenseClient) Get(ctx context.Context, id int) (*RecurringExpense, error) {
+	return c.Query().Where(recurringexpense.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecurringExpenseClient) GetX(ctx context.Context, id int) *RecurringExpense {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHousehold queries the household edge of a RecurringExpense.
+func (c *RecurringExpenseClient) QueryHousehold(_m *RecurringExpense) *HouseholdQuery {
+	query := (&HouseholdClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
+			sqlgraph.To(household.Table, household.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurringexpense.HouseholdTable, recurringexpense.HouseholdColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCategory queries the category edge of a RecurringExpense.
+func (c *RecurringExpenseClient) QueryCategory(_m *RecurringExpense) *CategoryQuery {
+	query := (&CategoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurringexpense.CategoryTable, recurringexpense.CategoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayer queries the payer edge of a RecurringExpense.
+func (c *RecurringExpenseClient) QueryPayer(_m *RecurringExpense) *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurringexpense.PayerTable, recurringexpense.PayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduleOverrides queries the schedule_overrides edge of a RecurringExpense.
+func (c *RecurringExpenseClient) QueryScheduleOverrides(_m *RecurringExpense) *RecurringScheduleOverrideQuery {
+	query := (&RecurringScheduleOverrideClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, id),
+			sqlgraph.To(recurringscheduleoverride.Table, recurringscheduleoverride.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, recurringexpense.ScheduleOverridesTable, recurringexpense.ScheduleOverridesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecurringExpenseClient) Hooks() []Hook {
+	return c.hooks.RecurringExpense
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecurringExpenseClient) Interceptors() []Interceptor {
+	return c.inters.RecurringExpense
+}
+
+func (c *RecurringExpenseClient) mutate(ctx context.Context, m *RecurringExpenseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecurringExpenseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecurringExpenseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecurringExpenseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecurringExpenseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecurringExpense mutation op: %q", m.Op())
+	}
+}
+
+// RecurringScheduleOverrideClient is a client for the RecurringScheduleOverride schema.
+type RecurringScheduleOverrideClient struct {
+	config
+}
+
+// NewRecurringScheduleOverrideClient returns a client for the RecurringScheduleOverride from the given config.
+func NewRecurringScheduleOverrideClient(c config) *RecurringScheduleOverrideClient {
+	return &RecurringScheduleOverrideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recurringscheduleoverride.Hooks(f(g(h())))`.
+func (c *RecurringScheduleOverrideClient) Use(hooks ...Hook) {
+	c.hooks.RecurringScheduleOverride = append(c.hooks.RecurringScheduleOverride, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recurringscheduleoverride.Intercept(f(g(h())))`.
+func (c *RecurringScheduleOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecurringScheduleOverride = append(c.inters.RecurringScheduleOverride, interceptors...)
+}
+
+// Create returns a builder for creating a RecurringScheduleOverride entity.
+func (c *RecurringScheduleOverrideClient) Create() *RecurringScheduleOverrideCreate {
+	mutation := newRecurringScheduleOverrideMutation(c.config, OpCreate)
+	return &RecurringScheduleOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecurringScheduleOverride entities.
 func (c *RecurringScheduleOverrideClient) CreateBulk(builders ...*RecurringScheduleOverrideCreate) *RecurringScheduleOverrideCreateBulk {
 	return &RecurringScheduleOverrideCreateBulk{config: c.config, builders: builders}
 }
@@ -1100,31 +2238,297 @@ func (c *RecurringScheduleOverrideClient) QueryRecurringExpense(_m *RecurringSch
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecurringScheduleOverrideClient) Hooks() []Hook {
+	return c.hooks.RecurringScheduleOverride
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecurringScheduleOverrideClient) Interceptors() []Interceptor {
+	return c.inters.RecurringScheduleOverride
+}
+
+func (c *RecurringScheduleOverrideClient) mutate(ctx context.Context, m *RecurringScheduleOverrideMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecurringScheduleOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecurringScheduleOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecurringScheduleOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecurringScheduleOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecurringScheduleOverride mutation op: %q", m.Op())
+	}
+}
+
+// RevisionClient is a client for the Revision schema.
+type RevisionClient struct {
+	config
+}
+
+// NewRevisionClient returns a client for the Revision from the given config.
+func NewRevisionClient(c config) *RevisionClient {
+	return &RevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revision.Hooks(f(g(h())))`.
+func (c *RevisionClient) Use(hooks ...Hook) {
+	c.hooks.Revision = append(c.hooks.Revision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revision.Intercept(f(g(h())))`.
+func (c *RevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Revision = append(c.inters.Revision, interceptors...)
+}
+
+// Create returns a builder for creating a Revision entity.
+func (c *RevisionClient) Create() *RevisionCreate {
+	mutation := newRevisionMutation(c.config, OpCreate)
+	return &RevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Revision entities.
+func (c *RevisionClient) CreateBulk(builders ...*RevisionCreate) *RevisionCreateBulk {
+	return &RevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevisionClient) MapCreateBulk(slice any, setFunc func(*RevisionCreate, int)) *RevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevisionCreateBulk{err: fmt.Errorf("calling to RevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Revision.
+func (c *RevisionClient) Update() *RevisionUpdate {
+	mutation := newRevisionMutation(c.config, OpUpdate)
+	return &RevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevisionClient) UpdateOne(_m *Revision) *RevisionUpdateOne {
+	mutation := newRevisionMutation(c.config, OpUpdateOne, withRevision(_m))
+	return &RevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevisionClient) UpdateOneID(id int) *RevisionUpdateOne {
+	mutation := newRevisionMutation(c.config, OpUpdateOne, withRevisionID(id))
+	return &RevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Revision.
+func (c *RevisionClient) Delete() *RevisionDelete {
+	mutation := newRevisionMutation(c.config, OpDelete)
+	return &RevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevisionClient) DeleteOne(_m *Revision) *RevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevisionClient) DeleteOneID(id int) *RevisionDeleteOne {
+	builder := c.Delete().Where(revision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for Revision.
+func (c *RevisionClient) Query() *RevisionQuery {
+	return &RevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Revision entity by its id.
+func (c *RevisionClient) Get(ctx context.Context, id int) (*Revision, error) {
+	return c.Query().Where(revision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevisionClient) GetX(ctx context.Context, id int) *Revision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RevisionClient) Hooks() []Hook {
+	return c.hooks.Revision
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevisionClient) Interceptors() []Interceptor {
+	return c.inters.Revision
+}
+
+func (c *RevisionClient) mutate(ctx context.Context, m *RevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Revision mutation op: %q", m.Op())
+	}
+}
+
+// SecurityEventClient is a client for the SecurityEvent schema.
+type SecurityEventClient struct {
+	config
+}
+
+// NewSecurityEventClient returns a client for the SecurityEvent from the given config.
+func NewSecurityEventClient(c config) *SecurityEventClient {
+	return &SecurityEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityevent.Hooks(f(g(h())))`.
+func (c *SecurityEventClient) Use(hooks ...Hook) {
+	c.hooks.SecurityEvent = append(c.hooks.SecurityEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityevent.Intercept(f(g(h())))`.
+func (c *SecurityEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityEvent = append(c.inters.SecurityEvent, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityEvent entity.
+func (c *SecurityEventClient) Create() *SecurityEventCreate {
+	mutation := newSecurityEventMutation(c.config, OpCreate)
+	return &SecurityEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityEvent entities.
+func (c *SecurityEventClient) CreateBulk(builders ...*SecurityEventCreate) *SecurityEventCreateBulk {
+	return &SecurityEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityEventClient) MapCreateBulk(slice any, setFunc func(*SecurityEventCreate, int)) *SecurityEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityEventCreateBulk{err: fmt.Errorf("calling to SecurityEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityEvent.
+func (c *SecurityEventClient) Update() *SecurityEventUpdate {
+	mutation := newSecurityEventMutation(c.config, OpUpdate)
+	return &SecurityEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityEventClient) UpdateOne(_m *SecurityEvent) *SecurityEventUpdateOne {
+	mutation := newSecurityEventMutation(c.config, OpUpdateOne, withSecurityEvent(_m))
+	return &SecurityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityEventClient) UpdateOneID(id int) *SecurityEventUpdateOne {
+	mutation := newSecurityEventMutation(c.config, OpUpdateOne, withSecurityEventID(id))
+	return &SecurityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityEvent.
+func (c *SecurityEventClient) Delete() *SecurityEventDelete {
+	mutation := newSecurityEventMutation(c.config, OpDelete)
+	return &SecurityEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityEventClient) DeleteOne(_m *SecurityEvent) *SecurityEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityEventClient) DeleteOneID(id int) *SecurityEventDeleteOne {
+	builder := c.Delete().Where(securityevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityEventDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityEvent.
+func (c *SecurityEventClient) Query() *SecurityEventQuery {
+	return &SecurityEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityEvent entity by its id.
+func (c *SecurityEventClient) Get(ctx context.Context, id int) (*SecurityEvent, error) {
+	return c.Query().Where(securityevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityEventClient) GetX(ctx context.Context, id int) *SecurityEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *RecurringScheduleOverrideClient) Hooks() []Hook {
-	return c.hooks.RecurringScheduleOverride
+func (c *SecurityEventClient) Hooks() []Hook {
+	return c.hooks.SecurityEvent
 }
 
 // Interceptors returns the client interceptors.
-func (c *RecurringScheduleOverrideClient) Interceptors() []Interceptor {
-	return c.inters.RecurringScheduleOverride
+func (c *SecurityEventClient) Interceptors() []Interceptor {
+	return c.inters.SecurityEvent
 }
 
-func (c *RecurringScheduleOverrideClient) mutate(ctx context.Context, m *RecurringScheduleOverrideMutation) (Value, error) {
+func (c *SecurityEventClient) mutate(ctx context.Context, m *SecurityEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RecurringScheduleOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecurityEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RecurringScheduleOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecurityEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RecurringScheduleOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecurityEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RecurringScheduleOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SecurityEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RecurringScheduleOverride mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SecurityEvent mutation op: %q", m.Op())
 	}
 }
 
@@ -1394,6 +2798,187 @@ func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value
 	}
 }
 
+// SettlementClient is a client for the Settlement schema.
+type SettlementClient struct {
+	config
+}
+
+// NewSettlementClient returns a client for the Settlement from the given config.
+func NewSettlementClient(c config) *SettlementClient {
+	return &SettlementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlement.Hooks(f(g(h())))`.
+func (c *SettlementClient) Use(hooks ...Hook) {
+	c.hooks.Settlement = append(c.hooks.Settlement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlement.Intercept(f(g(h())))`.
+func (c *SettlementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Settlement = append(c.inters.Settlement, interceptors...)
+}
+
+// Create returns a builder for creating a Settlement entity.
+func (c *SettlementClient) Create() *SettlementCreate {
+	mutation := newSettlementMutation(c.config, OpCreate)
+	return &SettlementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Settlement entities.
+func (c *SettlementClient) CreateBulk(builders ...*SettlementCreate) *SettlementCreateBulk {
+	return &SettlementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementClient) MapCreateBulk(slice any, setFunc func(*SettlementCreate, int)) *SettlementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementCreateBulk{err: fmt.Errorf("calling to SettlementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Settlement.
+func (c *SettlementClient) Update() *SettlementUpdate {
+	mutation := newSettlementMutation(c.config, OpUpdate)
+	return &SettlementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementClient) UpdateOne(_m *Settlement) *SettlementUpdateOne {
+	mutation := newSettlementMutation(c.config, OpUpdateOne, withSettlement(_m))
+	return &SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementClient) UpdateOneID(id int) *SettlementUpdateOne {
+	mutation := newSettlementMutation(c.config, OpUpdateOne, withSettlementID(id))
+	return &SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Settlement.
+func (c *SettlementClient) Delete() *SettlementDelete {
+	mutation := newSettlementMutation(c.config, OpDelete)
+	return &SettlementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementClient) DeleteOne(_m *Settlement) *SettlementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementClient) DeleteOneID(id int) *SettlementDeleteOne {
+	builder := c.Delete().Where(settlement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementDeleteOne{builder}
+}
+
+// Query returns a query builder for Settlement.
+func (c *SettlementClient) Query() *SettlementQuery {
+	return &SettlementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Settlement entity by its id.
+func (c *SettlementClient) Get(ctx context.Context, id int) (*Settlement, error) {
+	return c.Query().Where(settlement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementClient) GetX(ctx context.Context, id int) *Settlement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHousehold queries the household edge of a Settlement.
+func (c *SettlementClient) QueryHousehold(_m *Settlement) *HouseholdQuery {
+	query := (&HouseholdClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(household.Table, household.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, settlement.HouseholdTable, settlement.HouseholdColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFrom queries the from edge of a Settlement.
+func (c *SettlementClient) QueryFrom(_m *Settlement) *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, settlement.FromTable, settlement.FromColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTo queries the to edge of a Settlement.
+func (c *SettlementClient) QueryTo(_m *Settlement) *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, settlement.ToTable, settlement.ToColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementClient) Hooks() []Hook {
+	return c.hooks.Settlement
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementClient) Interceptors() []Interceptor {
+	return c.inters.Settlement
+}
+
+func (c *SettlementClient) mutate(ctx context.Context, m *SettlementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Settlement mutation op: %q", m.Op())
+	}
+}
+
 // TransactionClient is a client for the Transaction schema.
 type TransactionClient struct {
 	config
@@ -1534,6 +3119,22 @@ func (c *TransactionClient) QueryCategory(_m *Transaction) *CategoryQuery {
 	return query
 }
 
+// QueryPayer queries the payer edge of a Transaction.
+func (c *TransactionClient) QueryPayer(_m *Transaction) *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, id),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.PayerTable, transaction.PayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TransactionClient) Hooks() []Hook {
 	return c.hooks.Transaction
@@ -1699,6 +3300,54 @@ func (c *UserClient) QueryAPITokens(_m *User) *APITokenQuery {
 	return query
 }
 
+// QueryLocalCredential queries the local_credential edge of a User.
+func (c *UserClient) QueryLocalCredential(_m *User) *LocalCredentialQuery {
+	query := (&LocalCredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(localcredential.Table, localcredential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.LocalCredentialTable, user.LocalCredentialColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(_m *User) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHouseholdTransfers queries the household_transfers edge of a User.
+func (c *UserClient) QueryHouseholdTransfers(_m *User) *HouseholdTransferQuery {
+	query := (&HouseholdTransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(householdtransfer.Table, householdtransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HouseholdTransfersTable, user.HouseholdTransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1724,14 +3373,167 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(_m *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(_m))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id int) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(_m *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id int) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id int) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserIdentityClient) GetX(ctx context.Context, id int) *UserIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserIdentity.
+func (c *UserIdentityClient) QueryUser(_m *UserIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useridentity.UserTable, useridentity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
+}
+
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserIdentity mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIToken, Category, Household, RecurringExpense, RecurringScheduleOverride,
-		Session, Settings, Transaction, User []ent.Hook
+		APIToken, AggregateGeneration, Category, Household, HouseholdMember,
+		HouseholdTransfer, LocalCredential, MonthlyAggregate, RateLimit,
+		RecurringExpense, RecurringScheduleOverride, Revision, SecurityEvent, Session,
+		Settings, Settlement, Transaction, User, UserIdentity []ent.Hook
 	}
 	inters struct {
-		APIToken, Category, Household, RecurringExpense, RecurringScheduleOverride,
-		Session, Settings, Transaction, User []ent.Interceptor
+		APIToken, AggregateGeneration, Category, Household, HouseholdMember,
+		HouseholdTransfer, LocalCredential, MonthlyAggregate, RateLimit,
+		RecurringExpense, RecurringScheduleOverride, Revision, SecurityEvent, Session,
+		Settings, Settlement, Transaction, User, UserIdentity []ent.Interceptor
 	}
 )
@@ -12,15 +12,25 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/apitoken"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/localcredential"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/ratelimit"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/revision"
+	"icekalt.dev/money-tracker/ent/securityevent"
 	"icekalt.dev/money-tracker/ent/session"
 	"icekalt.dev/money-tracker/ent/settings"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/ent/useridentity"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -82,14 +92,24 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			apitoken.Table:                  apitoken.ValidColumn,
+			aggregategeneration.Table:       aggregategeneration.ValidColumn,
 			category.Table:                  category.ValidColumn,
 			household.Table:                 household.ValidColumn,
+			householdmember.Table:           householdmember.ValidColumn,
+			householdtransfer.Table:         householdtransfer.ValidColumn,
+			localcredential.Table:           localcredential.ValidColumn,
+			monthlyaggregate.Table:          monthlyaggregate.ValidColumn,
+			ratelimit.Table:                 ratelimit.ValidColumn,
 			recurringexpense.Table:          recurringexpense.ValidColumn,
 			recurringscheduleoverride.Table: recurringscheduleoverride.ValidColumn,
+			revision.Table:                  revision.ValidColumn,
+			securityevent.Table:             securityevent.ValidColumn,
 			session.Table:                   session.ValidColumn,
 			settings.Table:                  settings.ValidColumn,
+			settlement.Table:                settlement.ValidColumn,
 			transaction.Table:               transaction.ValidColumn,
 			user.Table:                      user.ValidColumn,
+			useridentity.Table:              useridentity.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
 	"icekalt.dev/money-tracker/ent/user"
 )
 
@@ -30,6 +32,8 @@ type Household struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set while the record is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HouseholdQuery when eager-loading is set.
 	Edges           HouseholdEdges `json:"edges"`
@@ -47,9 +51,19 @@ type HouseholdEdges struct {
 	Transactions []*Transaction `json:"transactions,omitempty"`
 	// RecurringExpenses holds the value of the recurring_expenses edge.
 	RecurringExpenses []*RecurringExpense `json:"recurring_expenses,omitempty"`
+	// MonthlyAggregates holds the value of the monthly_aggregates edge.
+	MonthlyAggregates []*MonthlyAggregate `json:"monthly_aggregates,omitempty"`
+	// AggregateGeneration holds the value of the aggregate_generation edge.
+	AggregateGeneration *AggregateGeneration `json:"aggregate_generation,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*HouseholdMember `json:"members,omitempty"`
+	// Settlements holds the value of the settlements edge.
+	Settlements []*Settlement `json:"settlements,omitempty"`
+	// Transfer holds the value of the transfer edge.
+	Transfer *HouseholdTransfer `json:"transfer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [9]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -90,6 +104,55 @@ func (e HouseholdEdges) RecurringExpensesOrErr() ([]*RecurringExpense, error) {
 	return nil, &NotLoadedError{edge: "recurring_expenses"}
 }
 
+// MonthlyAggregatesOrErr returns the MonthlyAggregates value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdEdges) MonthlyAggregatesOrErr() ([]*MonthlyAggregate, error) {
+	if e.loadedTypes[4] {
+		return e.MonthlyAggregates, nil
+	}
+	return nil, &NotLoadedError{edge: "monthly_aggregates"}
+}
+
+// AggregateGenerationOrErr returns the AggregateGeneration value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HouseholdEdges) AggregateGenerationOrErr() (*AggregateGeneration, error) {
+	if e.AggregateGeneration != nil {
+		return e.AggregateGeneration, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: aggregategeneration.Label}
+	}
+	return nil, &NotLoadedError{edge: "aggregate_generation"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdEdges) MembersOrErr() ([]*HouseholdMember, error) {
+	if e.loadedTypes[6] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// SettlementsOrErr returns the Settlements value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdEdges) SettlementsOrErr() ([]*Settlement, error) {
+	if e.loadedTypes[7] {
+		return e.Settlements, nil
+	}
+	return nil, &NotLoadedError{edge: "settlements"}
+}
+
+// TransferOrErr returns the Transfer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HouseholdEdges) TransferOrErr() (*HouseholdTransfer, error) {
+	if e.Transfer != nil {
+		return e.Transfer, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: householdtransfer.Label}
+	}
+	return nil, &NotLoadedError{edge: "transfer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Household) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -99,7 +162,7 @@ func (*Household) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case household.FieldName, household.FieldCurrency, household.FieldDescription, household.FieldIcon:
 			values[i] = new(sql.NullString)
-		case household.FieldCreatedAt, household.FieldUpdatedAt:
+		case household.FieldCreatedAt, household.FieldUpdatedAt, household.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case household.ForeignKeys[0]: // user_households
 			values[i] = new(sql.NullInt64)
@@ -160,6 +223,13 @@ func (_m *Household) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case household.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case household.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_households", value)
@@ -200,6 +270,31 @@ func (_m *Household) QueryRecurringExpenses() *RecurringExpenseQuery {
 	return NewHouseholdClient(_m.config).QueryRecurringExpenses(_m)
 }
 
+// QueryMonthlyAggregates queries the "monthly_aggregates" edge of the Household entity.
+func (_m *Household) QueryMonthlyAggregates() *MonthlyAggregateQuery {
+	return NewHouseholdClient(_m.config).QueryMonthlyAggregates(_m)
+}
+
+// QueryAggregateGeneration queries the "aggregate_generation" edge of the Household entity.
+func (_m *Household) QueryAggregateGeneration() *AggregateGenerationQuery {
+	return NewHouseholdClient(_m.config).QueryAggregateGeneration(_m)
+}
+
+// QueryMembers queries the "members" edge of the Household entity.
+func (_m *Household) QueryMembers() *HouseholdMemberQuery {
+	return NewHouseholdClient(_m.config).QueryMembers(_m)
+}
+
+// QuerySettlements queries the "settlements" edge of the Household entity.
+func (_m *Household) QuerySettlements() *SettlementQuery {
+	return NewHouseholdClient(_m.config).QuerySettlements(_m)
+}
+
+// QueryTransfer queries the "transfer" edge of the Household entity.
+func (_m *Household) QueryTransfer() *HouseholdTransferQuery {
+	return NewHouseholdClient(_m.config).QueryTransfer(_m)
+}
+
 // Update returns a builder for updating this Household.
 // Note that you need to call Household.Unwrap() before calling this method if this Household
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -240,6 +335,11 @@ func (_m *Household) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,6 +26,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeCategories holds the string denoting the categories edge name in mutations.
@@ -34,6 +36,16 @@ const (
 	EdgeTransactions = "transactions"
 	// EdgeRecurringExpenses holds the string denoting the recurring_expenses edge name in mutations.
 	EdgeRecurringExpenses = "recurring_expenses"
+	// EdgeMonthlyAggregates holds the string denoting the monthly_aggregates edge name in mutations.
+	EdgeMonthlyAggregates = "monthly_aggregates"
+	// EdgeAggregateGeneration holds the string denoting the aggregate_generation edge name in mutations.
+	EdgeAggregateGeneration = "aggregate_generation"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeSettlements holds the string denoting the settlements edge name in mutations.
+	EdgeSettlements = "settlements"
+	// EdgeTransfer holds the string denoting the transfer edge name in mutations.
+	EdgeTransfer = "transfer"
 	// Table holds the table name of the household in the database.
 	Table = "households"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -64,6 +76,41 @@ const (
 	RecurringExpensesInverseTable = "recurring_expenses"
 	// RecurringExpensesColumn is the table column denoting the recurring_expenses relation/edge.
 	RecurringExpensesColumn = "household_recurring_expenses"
+	// MonthlyAggregatesTable is the table that holds the monthly_aggregates relation/edge.
+	MonthlyAggregatesTable = "monthly_aggregates"
+	// MonthlyAggregatesInverseTable is the table name for the MonthlyAggregate entity.
+	// It exists in this package in order to avoid circular dependency with the "monthlyaggregate" package.
+	MonthlyAggregatesInverseTable = "monthly_aggregates"
+	// MonthlyAggregatesColumn is the table column denoting the monthly_aggregates relation/edge.
+	MonthlyAggregatesColumn = "household_monthly_aggregates"
+	// AggregateGenerationTable is the table that holds the aggregate_generation relation/edge.
+	AggregateGenerationTable = "aggregate_generations"
+	// AggregateGenerationInverseTable is the table name for the AggregateGeneration entity.
+	// It exists in this package in order to avoid circular dependency with the "aggregategeneration" package.
+	AggregateGenerationInverseTable = "aggregate_generations"
+	// AggregateGenerationColumn is the table column denoting the aggregate_generation relation/edge.
+	AggregateGenerationColumn = "household_aggregate_generation"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "household_members"
+	// MembersInverseTable is the table name for the HouseholdMember entity.
+	// It exists in this package in order to avoid circular dependency with the "householdmember" package.
+	MembersInverseTable = "household_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "household_members"
+	// SettlementsTable is the table that holds the settlements relation/edge.
+	SettlementsTable = "settlements"
+	// SettlementsInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	SettlementsInverseTable = "settlements"
+	// SettlementsColumn is the table column denoting the settlements relation/edge.
+	SettlementsColumn = "household_settlements"
+	// TransferTable is the table that holds the transfer relation/edge.
+	TransferTable = "household_transfers"
+	// TransferInverseTable is the table name for the HouseholdTransfer entity.
+	// It exists in this package in order to avoid circular dependency with the "householdtransfer" package.
+	TransferInverseTable = "household_transfers"
+	// TransferColumn is the table column denoting the transfer relation/edge.
+	TransferColumn = "household_transfer"
 )
 
 // Columns holds all SQL columns for household fields.
@@ -75,6 +122,7 @@ var Columns = []string{
 	FieldIcon,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "households"
@@ -159,6 +207,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -207,6 +260,62 @@ func ByRecurringExpenses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newRecurringExpensesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMonthlyAggregatesCount orders the results by monthly_aggregates count.
+func ByMonthlyAggregatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMonthlyAggregatesStep(), opts...)
+	}
+}
+
+// ByMonthlyAggregates orders the results by monthly_aggregates terms.
+func ByMonthlyAggregates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMonthlyAggregatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAggregateGenerationField orders the results by aggregate_generation field.
+func ByAggregateGenerationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAggregateGenerationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySettlementsCount orders the results by settlements count.
+func BySettlementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSettlementsStep(), opts...)
+	}
+}
+
+// BySettlements orders the results by settlements terms.
+func BySettlements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettlementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransferField orders the results by transfer field.
+func ByTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -235,3 +344,38 @@ func newRecurringExpensesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RecurringExpensesTable, RecurringExpensesColumn),
 	)
 }
+func newMonthlyAggregatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MonthlyAggregatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MonthlyAggregatesTable, MonthlyAggregatesColumn),
+	)
+}
+func newAggregateGenerationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AggregateGenerationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AggregateGenerationTable, AggregateGenerationColumn),
+	)
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
+func newSettlementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettlementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SettlementsTable, SettlementsColumn),
+	)
+}
+func newTransferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, TransferTable, TransferColumn),
+	)
+}
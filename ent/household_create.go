@@ -10,9 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
 )
@@ -100,6 +105,20 @@ func (_c *HouseholdCreate) SetNillableUpdatedAt(v *time.Time) *HouseholdCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *HouseholdCreate) SetDeletedAt(v time.Time) *HouseholdCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *HouseholdCreate) SetNillableDeletedAt(v *time.Time) *HouseholdCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_c *HouseholdCreate) SetOwnerID(id int) *HouseholdCreate {
 	_c.mutation.SetOwnerID(id)
@@ -156,6 +175,89 @@ func (_c *HouseholdCreate) AddRecurringExpenses(v ...*RecurringExpense) *Househo
 	return _c.AddRecurringExpenseIDs(ids...)
 }
 
+// AddMonthlyAggregateIDs adds the "monthly_aggregates" edge to the MonthlyAggregate entity by IDs.
+func (_c *HouseholdCreate) AddMonthlyAggregateIDs(ids ...int) *HouseholdCreate {
+	_c.mutation.AddMonthlyAggregateIDs(ids...)
+	return _c
+}
+
+// AddMonthlyAggregates adds the "monthly_aggregates" edges to the MonthlyAggregate entity.
+func (_c *HouseholdCreate) AddMonthlyAggregates(v ...*MonthlyAggregate) *HouseholdCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMonthlyAggregateIDs(ids...)
+}
+
+// SetAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by ID.
+func (_c *HouseholdCreate) SetAggregateGenerationID(id int) *HouseholdCreate {
+	_c.mutation.SetAggregateGenerationID(id)
+	return _c
+}
+
+// SetNillableAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by ID if the given value is not nil.
+func (_c *HouseholdCreate) SetNillableAggregateGenerationID(id *int) *HouseholdCreate {
+	if id != nil {
+		_c = _c.SetAggregateGenerationID(*id)
+	}
+	return _c
+}
+
+// SetAggregateGeneration sets the "aggregate_generation" edge to the AggregateGeneration entity.
+func (_c *HouseholdCreate) SetAggregateGeneration(v *AggregateGeneration) *HouseholdCreate {
+	return _c.SetAggregateGenerationID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the HouseholdMember entity by IDs.
+func (_c *HouseholdCreate) AddMemberIDs(ids ...int) *HouseholdCreate {
+	_c.mutation.AddMemberIDs(ids...)
+	return _c
+}
+
+// AddMembers adds the "members" edges to the HouseholdMember entity.
+func (_c *HouseholdCreate) AddMembers(v ...*HouseholdMember) *HouseholdCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMemberIDs(ids...)
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by IDs.
+func (_c *HouseholdCreate) AddSettlementIDs(ids ...int) *HouseholdCreate {
+	_c.mutation.AddSettlementIDs(ids...)
+	return _c
+}
+
+// AddSettlements adds the "settlements" edges to the Settlement entity.
+func (_c *HouseholdCreate) AddSettlements(v ...*Settlement) *HouseholdCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSettlementIDs(ids...)
+}
+
+// SetTransferID sets the "transfer" edge to the HouseholdTransfer entity by ID.
+func (_c *HouseholdCreate) SetTransferID(id int) *HouseholdCreate {
+	_c.mutation.SetTransferID(id)
+	return _c
+}
+
+// SetNillableTransferID sets the "transfer" edge to the HouseholdTransfer entity by ID if the given value is not nil.
+func (_c *HouseholdCreate) SetNillableTransferID(id *int) *HouseholdCreate {
+	if id != nil {
+		_c = _c.SetTransferID(*id)
+	}
+	return _c
+}
+
+// SetTransfer sets the "transfer" edge to the HouseholdTransfer entity.
+func (_c *HouseholdCreate) SetTransfer(v *HouseholdTransfer) *HouseholdCreate {
+	return _c.SetTransferID(v.ID)
+}
+
 // Mutation returns the HouseholdMutation object of the builder.
 func (_c *HouseholdCreate) Mutation() *HouseholdMutation {
 	return _c.mutation
@@ -300,6 +402,10 @@ func (_c *HouseholdCreate) createSpec() (*Household, *sqlgraph.CreateSpec) {
 		_spec.SetField(household.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(household.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -365,6 +471,86 @@ func (_c *HouseholdCreate) createSpec() (*Household, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MonthlyAggregatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AggregateGenerationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.AggregateGenerationTable,
+			Columns: []string{household.AggregateGenerationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.TransferTable,
+			Columns: []string{household.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
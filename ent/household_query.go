@@ -12,10 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
 	"icekalt.dev/money-tracker/ent/predicate"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
 )
@@ -23,15 +28,21 @@ import (
 // HouseholdQuery is the builder for querying Household entities.
 type HouseholdQuery struct {
 	config
-	ctx                   *QueryContext
-	order                 []household.OrderOption
-	inters                []Interceptor
-	predicates            []predicate.Household
-	withOwner             *UserQuery
-	withCategories        *CategoryQuery
-	withTransactions      *TransactionQuery
-	withRecurringExpenses *RecurringExpenseQuery
-	withFKs               bool
+	ctx                     *QueryContext
+	order                   []household.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Household
+	withOwner               *UserQuery
+	withCategories          *CategoryQuery
+	withTransactions        *TransactionQuery
+	withRecurringExpenses   *RecurringExpenseQuery
+	withMonthlyAggregates   *MonthlyAggregateQuery
+	withAggregateGeneration *AggregateGenerationQuery
+	withMembers             *HouseholdMemberQuery
+	withSettlements         *SettlementQuery
+	withTransfer            *HouseholdTransferQuery
+	withFKs                 bool
+	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +167,116 @@ func (_q *HouseholdQuery) QueryRecurringExpenses() *RecurringExpenseQuery {
 	return query
 }
 
+// QueryMonthlyAggregates chains the current query on the "monthly_aggregates" edge.
+func (_q *HouseholdQuery) QueryMonthlyAggregates() *MonthlyAggregateQuery {
+	query := (&MonthlyAggregateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, selector),
+			sqlgraph.To(monthlyaggregate.Table, monthlyaggregate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, household.MonthlyAggregatesTable, household.MonthlyAggregatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAggregateGeneration chains the current query on the "aggregate_generation" edge.
+func (_q *HouseholdQuery) QueryAggregateGeneration() *AggregateGenerationQuery {
+	query := (&AggregateGenerationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, selector),
+			sqlgraph.To(aggregategeneration.Table, aggregategeneration.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, household.AggregateGenerationTable, household.AggregateGenerationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMembers chains the current query on the "members" edge.
+func (_q *HouseholdQuery) QueryMembers() *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, selector),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, household.MembersTable, household.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySettlements chains the current query on the "settlements" edge.
+func (_q *HouseholdQuery) QuerySettlements() *SettlementQuery {
+	query := (&SettlementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, household.SettlementsTable, household.SettlementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfer chains the current query on the "transfer" edge.
+func (_q *HouseholdQuery) QueryTransfer() *HouseholdTransferQuery {
+	query := (&HouseholdTransferClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(household.Table, household.FieldID, selector),
+			sqlgraph.To(householdtransfer.Table, householdtransfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, household.TransferTable, household.TransferColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Household entity from the query.
 // Returns a *NotFoundError when no Household was found.
 func (_q *HouseholdQuery) First(ctx context.Context) (*Household, error) {
@@ -343,18 +464,24 @@ func (_q *HouseholdQuery) Clone() *HouseholdQuery {
 		return nil
 	}
 	return &HouseholdQuery{
-		config:                _q.config,
-		ctx:                   _q.ctx.Clone(),
-		order:                 append([]household.OrderOption{}, _q.order...),
-		inters:                append([]Interceptor{}, _q.inters...),
-		predicates:            append([]predicate.Household{}, _q.predicates...),
-		withOwner:             _q.withOwner.Clone(),
-		withCategories:        _q.withCategories.Clone(),
-		withTransactions:      _q.withTransactions.Clone(),
-		withRecurringExpenses: _q.withRecurringExpenses.Clone(),
+		config:                  _q.config,
+		ctx:                     _q.ctx.Clone(),
+		order:                   append([]household.OrderOption{}, _q.order...),
+		inters:                  append([]Interceptor{}, _q.inters...),
+		predicates:              append([]predicate.Household{}, _q.predicates...),
+		withOwner:               _q.withOwner.Clone(),
+		withCategories:          _q.withCategories.Clone(),
+		withTransactions:        _q.withTransactions.Clone(),
+		withRecurringExpenses:   _q.withRecurringExpenses.Clone(),
+		withMonthlyAggregates:   _q.withMonthlyAggregates.Clone(),
+		withAggregateGeneration: _q.withAggregateGeneration.Clone(),
+		withMembers:             _q.withMembers.Clone(),
+		withSettlements:         _q.withSettlements.Clone(),
+		withTransfer:            _q.withTransfer.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -402,6 +529,61 @@ func (_q *HouseholdQuery) WithRecurringExpenses(opts ...func(*RecurringExpenseQu
 	return _q
 }
 
+// WithMonthlyAggregates tells the query-builder to eager-load the nodes that are connected to
+// the "monthly_aggregates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdQuery) WithMonthlyAggregates(opts ...func(*MonthlyAggregateQuery)) *HouseholdQuery {
+	query := (&MonthlyAggregateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMonthlyAggregates = query
+	return _q
+}
+
+// WithAggregateGeneration tells the query-builder to eager-load the nodes that are connected to
+// the "aggregate_generation" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdQuery) WithAggregateGeneration(opts ...func(*AggregateGenerationQuery)) *HouseholdQuery {
+	query := (&AggregateGenerationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAggregateGeneration = query
+	return _q
+}
+
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdQuery) WithMembers(opts ...func(*HouseholdMemberQuery)) *HouseholdQuery {
+	query := (&HouseholdMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMembers = query
+	return _q
+}
+
+// WithSettlements tells the query-builder to eager-load the nodes that are connected to
+// the "settlements" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdQuery) WithSettlements(opts ...func(*SettlementQuery)) *HouseholdQuery {
+	query := (&SettlementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSettlements = query
+	return _q
+}
+
+// WithTransfer tells the query-builder to eager-load the nodes that are connected to
+// the "transfer" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdQuery) WithTransfer(opts ...func(*HouseholdTransferQuery)) *HouseholdQuery {
+	query := (&HouseholdTransferClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTransfer = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +663,16 @@ func (_q *HouseholdQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ho
 		nodes       = []*Household{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [9]bool{
 			_q.withOwner != nil,
 			_q.withCategories != nil,
 			_q.withTransactions != nil,
 			_q.withRecurringExpenses != nil,
+			_q.withMonthlyAggregates != nil,
+			_q.withAggregateGeneration != nil,
+			_q.withMembers != nil,
+			_q.withSettlements != nil,
+			_q.withTransfer != nil,
 		}
 	)
 	if _q.withOwner != nil {
@@ -503,6 +690,9 @@ func (_q *HouseholdQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ho
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -541,6 +731,41 @@ func (_q *HouseholdQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ho
 			return nil, err
 		}
 	}
+	if query := _q.withMonthlyAggregates; query != nil {
+		if err := _q.loadMonthlyAggregates(ctx, query, nodes,
+			func(n *Household) { n.Edges.MonthlyAggregates = []*MonthlyAggregate{} },
+			func(n *Household, e *MonthlyAggregate) {
+				n.Edges.MonthlyAggregates = append(n.Edges.MonthlyAggregates, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAggregateGeneration; query != nil {
+		if err := _q.loadAggregateGeneration(ctx, query, nodes, nil,
+			func(n *Household, e *AggregateGeneration) { n.Edges.AggregateGeneration = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMembers; query != nil {
+		if err := _q.loadMembers(ctx, query, nodes,
+			func(n *Household) { n.Edges.Members = []*HouseholdMember{} },
+			func(n *Household, e *HouseholdMember) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSettlements; query != nil {
+		if err := _q.loadSettlements(ctx, query, nodes,
+			func(n *Household) { n.Edges.Settlements = []*Settlement{} },
+			func(n *Household, e *Settlement) { n.Edges.Settlements = append(n.Edges.Settlements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTransfer; query != nil {
+		if err := _q.loadTransfer(ctx, query, nodes, nil,
+			func(n *Household, e *HouseholdTransfer) { n.Edges.Transfer = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -669,9 +894,161 @@ func (_q *HouseholdQuery) loadRecurringExpenses(ctx context.Context, query *Recu
 	}
 	return nil
 }
+func (_q *HouseholdQuery) loadMonthlyAggregates(ctx context.Context, query *MonthlyAggregateQuery, nodes []*Household, init func(*Household), assign func(*Household, *MonthlyAggregate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Household)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MonthlyAggregate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(household.MonthlyAggregatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_monthly_aggregates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_monthly_aggregates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_monthly_aggregates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdQuery) loadAggregateGeneration(ctx context.Context, query *AggregateGenerationQuery, nodes []*Household, init func(*Household), assign func(*Household, *AggregateGeneration)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Household)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.AggregateGeneration(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(household.AggregateGenerationColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_aggregate_generation
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_aggregate_generation" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_aggregate_generation" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdQuery) loadMembers(ctx context.Context, query *HouseholdMemberQuery, nodes []*Household, init func(*Household), assign func(*Household, *HouseholdMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Household)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.HouseholdMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(household.MembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdQuery) loadSettlements(ctx context.Context, query *SettlementQuery, nodes []*Household, init func(*Household), assign func(*Household, *Settlement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Household)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Settlement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(household.SettlementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_settlements
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_settlements" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_settlements" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdQuery) loadTransfer(ctx context.Context, query *HouseholdTransferQuery, nodes []*Household, init func(*Household), assign func(*Household, *HouseholdTransfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Household)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.HouseholdTransfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(household.TransferColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_transfer
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_transfer" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_transfer" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *HouseholdQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -734,6 +1111,9 @@ func (_q *HouseholdQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -751,6 +1131,12 @@ func (_q *HouseholdQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *HouseholdQuery) Modify(modifiers ...func(s *sql.Selector)) *HouseholdSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // HouseholdGroupBy is the group-by builder for Household entities.
 type HouseholdGroupBy struct {
 	selector
@@ -840,3 +1226,9 @@ func (_s *HouseholdSelect) sqlScan(ctx context.Context, root *HouseholdQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *HouseholdSelect) Modify(modifiers ...func(s *sql.Selector)) *HouseholdSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -11,10 +11,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
 	"icekalt.dev/money-tracker/ent/predicate"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
 )
@@ -22,8 +27,9 @@ import (
 // HouseholdUpdate is the builder for updating Household entities.
 type HouseholdUpdate struct {
 	config
-	hooks    []Hook
-	mutation *HouseholdMutation
+	hooks     []Hook
+	mutation  *HouseholdMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the HouseholdUpdate builder.
@@ -106,6 +112,26 @@ func (_u *HouseholdUpdate) SetUpdatedAt(v time.Time) *HouseholdUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HouseholdUpdate) SetDeletedAt(v time.Time) *HouseholdUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HouseholdUpdate) SetNillableDeletedAt(v *time.Time) *HouseholdUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HouseholdUpdate) ClearDeletedAt() *HouseholdUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *HouseholdUpdate) SetOwnerID(id int) *HouseholdUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -162,6 +188,89 @@ func (_u *HouseholdUpdate) AddRecurringExpenses(v ...*RecurringExpense) *Househo
 	return _u.AddRecurringExpenseIDs(ids...)
 }
 
+// AddMonthlyAggregateIDs adds the "monthly_aggregates" edge to the MonthlyAggregate entity by IDs.
+func (_u *HouseholdUpdate) AddMonthlyAggregateIDs(ids ...int) *HouseholdUpdate {
+	_u.mutation.AddMonthlyAggregateIDs(ids...)
+	return _u
+}
+
+// AddMonthlyAggregates adds the "monthly_aggregates" edges to the MonthlyAggregate entity.
+func (_u *HouseholdUpdate) AddMonthlyAggregates(v ...*MonthlyAggregate) *HouseholdUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMonthlyAggregateIDs(ids...)
+}
+
+// SetAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by ID.
+func (_u *HouseholdUpdate) SetAggregateGenerationID(id int) *HouseholdUpdate {
+	_u.mutation.SetAggregateGenerationID(id)
+	return _u
+}
+
+// SetNillableAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by ID if the given value is not nil.
+func (_u *HouseholdUpdate) SetNillableAggregateGenerationID(id *int) *HouseholdUpdate {
+	if id != nil {
+		_u = _u.SetAggregateGenerationID(*id)
+	}
+	return _u
+}
+
+// SetAggregateGeneration sets the "aggregate_generation" edge to the AggregateGeneration entity.
+func (_u *HouseholdUpdate) SetAggregateGeneration(v *AggregateGeneration) *HouseholdUpdate {
+	return _u.SetAggregateGenerationID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the HouseholdMember entity by IDs.
+func (_u *HouseholdUpdate) AddMemberIDs(ids ...int) *HouseholdUpdate {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the HouseholdMember entity.
+func (_u *HouseholdUpdate) AddMembers(v ...*HouseholdMember) *HouseholdUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by IDs.
+func (_u *HouseholdUpdate) AddSettlementIDs(ids ...int) *HouseholdUpdate {
+	_u.mutation.AddSettlementIDs(ids...)
+	return _u
+}
+
+// AddSettlements adds the "settlements" edges to the Settlement entity.
+func (_u *HouseholdUpdate) AddSettlements(v ...*Settlement) *HouseholdUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSettlementIDs(ids...)
+}
+
+// SetTransferID sets the "transfer" edge to the HouseholdTransfer entity by ID.
+func (_u *HouseholdUpdate) SetTransferID(id int) *HouseholdUpdate {
+	_u.mutation.SetTransferID(id)
+	return _u
+}
+
+// SetNillableTransferID sets the "transfer" edge to the HouseholdTransfer entity by ID if the given value is not nil.
+func (_u *HouseholdUpdate) SetNillableTransferID(id *int) *HouseholdUpdate {
+	if id != nil {
+		_u = _u.SetTransferID(*id)
+	}
+	return _u
+}
+
+// SetTransfer sets the "transfer" edge to the HouseholdTransfer entity.
+func (_u *HouseholdUpdate) SetTransfer(v *HouseholdTransfer) *HouseholdUpdate {
+	return _u.SetTransferID(v.ID)
+}
+
 // Mutation returns the HouseholdMutation object of the builder.
 func (_u *HouseholdUpdate) Mutation() *HouseholdMutation {
 	return _u.mutation
@@ -236,6 +345,81 @@ func (_u *HouseholdUpdate) RemoveRecurringExpenses(v ...*RecurringExpense) *Hous
 	return _u.RemoveRecurringExpenseIDs(ids...)
 }
 
+// ClearMonthlyAggregates clears all "monthly_aggregates" edges to the MonthlyAggregate entity.
+func (_u *HouseholdUpdate) ClearMonthlyAggregates() *HouseholdUpdate {
+	_u.mutation.ClearMonthlyAggregates()
+	return _u
+}
+
+// RemoveMonthlyAggregateIDs removes the "monthly_aggregates" edge to MonthlyAggregate entities by IDs.
+func (_u *HouseholdUpdate) RemoveMonthlyAggregateIDs(ids ...int) *HouseholdUpdate {
+	_u.mutation.RemoveMonthlyAggregateIDs(ids...)
+	return _u
+}
+
+// RemoveMonthlyAggregates removes "monthly_aggregates" edges to MonthlyAggregate entities.
+func (_u *HouseholdUpdate) RemoveMonthlyAggregates(v ...*MonthlyAggregate) *HouseholdUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonthlyAggregateIDs(ids...)
+}
+
+// ClearAggregateGeneration clears the "aggregate_generation" edge to the AggregateGeneration entity.
+func (_u *HouseholdUpdate) ClearAggregateGeneration() *HouseholdUpdate {
+	_u.mutation.ClearAggregateGeneration()
+	return _u
+}
+
+// ClearMembers clears all "members" edges to the HouseholdMember entity.
+func (_u *HouseholdUpdate) ClearMembers() *HouseholdUpdate {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to HouseholdMember entities by IDs.
+func (_u *HouseholdUpdate) RemoveMemberIDs(ids ...int) *HouseholdUpdate {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to HouseholdMember entities.
+func (_u *HouseholdUpdate) RemoveMembers(v ...*HouseholdMember) *HouseholdUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearSettlements clears all "settlements" edges to the Settlement entity.
+func (_u *HouseholdUpdate) ClearSettlements() *HouseholdUpdate {
+	_u.mutation.ClearSettlements()
+	return _u
+}
+
+// RemoveSettlementIDs removes the "settlements" edge to Settlement entities by IDs.
+func (_u *HouseholdUpdate) RemoveSettlementIDs(ids ...int) *HouseholdUpdate {
+	_u.mutation.RemoveSettlementIDs(ids...)
+	return _u
+}
+
+// RemoveSettlements removes "settlements" edges to Settlement entities.
+func (_u *HouseholdUpdate) RemoveSettlements(v ...*Settlement) *HouseholdUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSettlementIDs(ids...)
+}
+
+// ClearTransfer clears the "transfer" edge to the HouseholdTransfer entity.
+func (_u *HouseholdUpdate) ClearTransfer() *HouseholdUpdate {
+	_u.mutation.ClearTransfer()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *HouseholdUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -300,6 +484,12 @@ func (_u *HouseholdUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HouseholdUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HouseholdUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *HouseholdUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -333,6 +523,12 @@ func (_u *HouseholdUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(household.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(household.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(household.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -497,6 +693,200 @@ func (_u *HouseholdUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MonthlyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMonthlyAggregatesIDs(); len(nodes) > 0 && !_u.mutation.MonthlyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonthlyAggregatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AggregateGenerationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.AggregateGenerationTable,
+			Columns: []string{household.AggregateGenerationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AggregateGenerationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.AggregateGenerationTable,
+			Columns: []string{household.AggregateGenerationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSettlementsIDs(); len(nodes) > 0 && !_u.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.TransferTable,
+			Columns: []string{household.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.TransferTable,
+			Columns: []string{household.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{household.Label}
@@ -512,9 +902,10 @@ func (_u *HouseholdUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // HouseholdUpdateOne is the builder for updating a single Household entity.
 type HouseholdUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *HouseholdMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *HouseholdMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -591,6 +982,26 @@ func (_u *HouseholdUpdateOne) SetUpdatedAt(v time.Time) *HouseholdUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HouseholdUpdateOne) SetDeletedAt(v time.Time) *HouseholdUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HouseholdUpdateOne) SetNillableDeletedAt(v *time.Time) *HouseholdUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HouseholdUpdateOne) ClearDeletedAt() *HouseholdUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *HouseholdUpdateOne) SetOwnerID(id int) *HouseholdUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -647,6 +1058,89 @@ func (_u *HouseholdUpdateOne) AddRecurringExpenses(v ...*RecurringExpense) *Hous
 	return _u.AddRecurringExpenseIDs(ids...)
 }
 
+// AddMonthlyAggregateIDs adds the "monthly_aggregates" edge to the MonthlyAggregate entity by IDs.
+func (_u *HouseholdUpdateOne) AddMonthlyAggregateIDs(ids ...int) *HouseholdUpdateOne {
+	_u.mutation.AddMonthlyAggregateIDs(ids...)
+	return _u
+}
+
+// AddMonthlyAggregates adds the "monthly_aggregates" edges to the MonthlyAggregate entity.
+func (_u *HouseholdUpdateOne) AddMonthlyAggregates(v ...*MonthlyAggregate) *HouseholdUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMonthlyAggregateIDs(ids...)
+}
+
+// SetAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by ID.
+func (_u *HouseholdUpdateOne) SetAggregateGenerationID(id int) *HouseholdUpdateOne {
+	_u.mutation.SetAggregateGenerationID(id)
+	return _u
+}
+
+// SetNillableAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by ID if the given value is not nil.
+func (_u *HouseholdUpdateOne) SetNillableAggregateGenerationID(id *int) *HouseholdUpdateOne {
+	if id != nil {
+		_u = _u.SetAggregateGenerationID(*id)
+	}
+	return _u
+}
+
+// SetAggregateGeneration sets the "aggregate_generation" edge to the AggregateGeneration entity.
+func (_u *HouseholdUpdateOne) SetAggregateGeneration(v *AggregateGeneration) *HouseholdUpdateOne {
+	return _u.SetAggregateGenerationID(v.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the HouseholdMember entity by IDs.
+func (_u *HouseholdUpdateOne) AddMemberIDs(ids ...int) *HouseholdUpdateOne {
+	_u.mutation.AddMemberIDs(ids...)
+	return _u
+}
+
+// AddMembers adds the "members" edges to the HouseholdMember entity.
+func (_u *HouseholdUpdateOne) AddMembers(v ...*HouseholdMember) *HouseholdUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMemberIDs(ids...)
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by IDs.
+func (_u *HouseholdUpdateOne) AddSettlementIDs(ids ...int) *HouseholdUpdateOne {
+	_u.mutation.AddSettlementIDs(ids...)
+	return _u
+}
+
+// AddSettlements adds the "settlements" edges to the Settlement entity.
+func (_u *HouseholdUpdateOne) AddSettlements(v ...*Settlement) *HouseholdUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSettlementIDs(ids...)
+}
+
+// SetTransferID sets the "transfer" edge to the HouseholdTransfer entity by ID.
+func (_u *HouseholdUpdateOne) SetTransferID(id int) *HouseholdUpdateOne {
+	_u.mutation.SetTransferID(id)
+	return _u
+}
+
+// SetNillableTransferID sets the "transfer" edge to the HouseholdTransfer entity by ID if the given value is not nil.
+func (_u *HouseholdUpdateOne) SetNillableTransferID(id *int) *HouseholdUpdateOne {
+	if id != nil {
+		_u = _u.SetTransferID(*id)
+	}
+	return _u
+}
+
+// SetTransfer sets the "transfer" edge to the HouseholdTransfer entity.
+func (_u *HouseholdUpdateOne) SetTransfer(v *HouseholdTransfer) *HouseholdUpdateOne {
+	return _u.SetTransferID(v.ID)
+}
+
 // Mutation returns the HouseholdMutation object of the builder.
 func (_u *HouseholdUpdateOne) Mutation() *HouseholdMutation {
 	return _u.mutation
@@ -721,6 +1215,81 @@ func (_u *HouseholdUpdateOne) RemoveRecurringExpenses(v ...*RecurringExpense) *H
 	return _u.RemoveRecurringExpenseIDs(ids...)
 }
 
+// ClearMonthlyAggregates clears all "monthly_aggregates" edges to the MonthlyAggregate entity.
+func (_u *HouseholdUpdateOne) ClearMonthlyAggregates() *HouseholdUpdateOne {
+	_u.mutation.ClearMonthlyAggregates()
+	return _u
+}
+
+// RemoveMonthlyAggregateIDs removes the "monthly_aggregates" edge to MonthlyAggregate entities by IDs.
+func (_u *HouseholdUpdateOne) RemoveMonthlyAggregateIDs(ids ...int) *HouseholdUpdateOne {
+	_u.mutation.RemoveMonthlyAggregateIDs(ids...)
+	return _u
+}
+
+// RemoveMonthlyAggregates removes "monthly_aggregates" edges to MonthlyAggregate entities.
+func (_u *HouseholdUpdateOne) RemoveMonthlyAggregates(v ...*MonthlyAggregate) *HouseholdUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonthlyAggregateIDs(ids...)
+}
+
+// ClearAggregateGeneration clears the "aggregate_generation" edge to the AggregateGeneration entity.
+func (_u *HouseholdUpdateOne) ClearAggregateGeneration() *HouseholdUpdateOne {
+	_u.mutation.ClearAggregateGeneration()
+	return _u
+}
+
+// ClearMembers clears all "members" edges to the HouseholdMember entity.
+func (_u *HouseholdUpdateOne) ClearMembers() *HouseholdUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// RemoveMemberIDs removes the "members" edge to HouseholdMember entities by IDs.
+func (_u *HouseholdUpdateOne) RemoveMemberIDs(ids ...int) *HouseholdUpdateOne {
+	_u.mutation.RemoveMemberIDs(ids...)
+	return _u
+}
+
+// RemoveMembers removes "members" edges to HouseholdMember entities.
+func (_u *HouseholdUpdateOne) RemoveMembers(v ...*HouseholdMember) *HouseholdUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMemberIDs(ids...)
+}
+
+// ClearSettlements clears all "settlements" edges to the Settlement entity.
+func (_u *HouseholdUpdateOne) ClearSettlements() *HouseholdUpdateOne {
+	_u.mutation.ClearSettlements()
+	return _u
+}
+
+// RemoveSettlementIDs removes the "settlements" edge to Settlement entities by IDs.
+func (_u *HouseholdUpdateOne) RemoveSettlementIDs(ids ...int) *HouseholdUpdateOne {
+	_u.mutation.RemoveSettlementIDs(ids...)
+	return _u
+}
+
+// RemoveSettlements removes "settlements" edges to Settlement entities.
+func (_u *HouseholdUpdateOne) RemoveSettlements(v ...*Settlement) *HouseholdUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSettlementIDs(ids...)
+}
+
+// ClearTransfer clears the "transfer" edge to the HouseholdTransfer entity.
+func (_u *HouseholdUpdateOne) ClearTransfer() *HouseholdUpdateOne {
+	_u.mutation.ClearTransfer()
+	return _u
+}
+
 // Where appends a list predicates to the HouseholdUpdate builder.
 func (_u *HouseholdUpdateOne) Where(ps ...predicate.Household) *HouseholdUpdateOne {
 	_u.mutation.Where(ps...)
@@ -798,6 +1367,12 @@ func (_u *HouseholdUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HouseholdUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HouseholdUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *HouseholdUpdateOne) sqlSave(ctx context.Context) (_node *Household, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -848,6 +1423,12 @@ func (_u *HouseholdUpdateOne) sqlSave(ctx context.Context) (_node *Household, er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(household.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(household.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(household.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1012,6 +1593,200 @@ func (_u *HouseholdUpdateOne) sqlSave(ctx context.Context) (_node *Household, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MonthlyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMonthlyAggregatesIDs(); len(nodes) > 0 && !_u.mutation.MonthlyAggregatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonthlyAggregatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MonthlyAggregatesTable,
+			Columns: []string{household.MonthlyAggregatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AggregateGenerationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.AggregateGenerationTable,
+			Columns: []string{household.AggregateGenerationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AggregateGenerationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.AggregateGenerationTable,
+			Columns: []string{household.AggregateGenerationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(aggregategeneration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembersIDs(); len(nodes) > 0 && !_u.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.MembersTable,
+			Columns: []string{household.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSettlementsIDs(); len(nodes) > 0 && !_u.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   household.SettlementsTable,
+			Columns: []string{household.SettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TransferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.TransferTable,
+			Columns: []string{household.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   household.TransferTable,
+			Columns: []string{household.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Household{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+)
+
+// HouseholdMember is the model entity for the HouseholdMember schema.
+type HouseholdMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HouseholdMemberQuery when eager-loading is set.
+	Edges             HouseholdMemberEdges `json:"edges"`
+	household_members *int
+	selectValues      sql.SelectValues
+}
+
+// HouseholdMemberEdges holds the relations/edges for other nodes in the graph.
+type HouseholdMemberEdges struct {
+	// Household holds the value of the household edge.
+	Household *Household `json:"household,omitempty"`
+	// PaidTransactions holds the value of the paid_transactions edge.
+	PaidTransactions []*Transaction `json:"paid_transactions,omitempty"`
+	// PaidRecurringExpenses holds the value of the paid_recurring_expenses edge.
+	PaidRecurringExpenses []*RecurringExpense `json:"paid_recurring_expenses,omitempty"`
+	// SettlementsPaid holds the value of the settlements_paid edge.
+	SettlementsPaid []*Settlement `json:"settlements_paid,omitempty"`
+	// SettlementsReceived holds the value of the settlements_received edge.
+	SettlementsReceived []*Settlement `json:"settlements_received,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// HouseholdOrErr returns the Household value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HouseholdMemberEdges) HouseholdOrErr() (*Household, error) {
+	if e.Household != nil {
+		return e.Household, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: household.Label}
+	}
+	return nil, &NotLoadedError{edge: "household"}
+}
+
+// PaidTransactionsOrErr returns the PaidTransactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdMemberEdges) PaidTransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[1] {
+		return e.PaidTransactions, nil
+	}
+	return nil, &NotLoadedError{edge: "paid_transactions"}
+}
+
+// PaidRecurringExpensesOrErr returns the PaidRecurringExpenses value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdMemberEdges) PaidRecurringExpensesOrErr() ([]*RecurringExpense, error) {
+	if e.loadedTypes[2] {
+		return e.PaidRecurringExpenses, nil
+	}
+	return nil, &NotLoadedError{edge: "paid_recurring_expenses"}
+}
+
+// SettlementsPaidOrErr returns the SettlementsPaid value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdMemberEdges) SettlementsPaidOrErr() ([]*Settlement, error) {
+	if e.loadedTypes[3] {
+		return e.SettlementsPaid, nil
+	}
+	return nil, &NotLoadedError{edge: "settlements_paid"}
+}
+
+// SettlementsReceivedOrErr returns the SettlementsReceived value or an error if the edge
+// was not loaded in eager-loading.
+func (e HouseholdMemberEdges) SettlementsReceivedOrErr() ([]*Settlement, error) {
+	if e.loadedTypes[4] {
+		return e.SettlementsReceived, nil
+	}
+	return nil, &NotLoadedError{edge: "settlements_received"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HouseholdMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case householdmember.FieldID:
+			values[i] = new(sql.NullInt64)
+		case householdmember.FieldName:
+			values[i] = new(sql.NullString)
+		case householdmember.FieldCreatedAt, householdmember.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case householdmember.ForeignKeys[0]: // household_members
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HouseholdMember fields.
+func (_m *HouseholdMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case householdmember.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case householdmember.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case householdmember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case householdmember.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case householdmember.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_members", value)
+			} else if value.Valid {
+				_m.household_members = new(int)
+				*_m.household_members = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HouseholdMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *HouseholdMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHousehold queries the "household" edge of the HouseholdMember entity.
+func (_m *HouseholdMember) QueryHousehold() *HouseholdQuery {
+	return NewHouseholdMemberClient(_m.config).QueryHousehold(_m)
+}
+
+// QueryPaidTransactions queries the "paid_transactions" edge of the HouseholdMember entity.
+func (_m *HouseholdMember) QueryPaidTransactions() *TransactionQuery {
+	return NewHouseholdMemberClient(_m.config).QueryPaidTransactions(_m)
+}
+
+// QueryPaidRecurringExpenses queries the "paid_recurring_expenses" edge of the HouseholdMember entity.
+func (_m *HouseholdMember) QueryPaidRecurringExpenses() *RecurringExpenseQuery {
+	return NewHouseholdMemberClient(_m.config).QueryPaidRecurringExpenses(_m)
+}
+
+// QuerySettlementsPaid queries the "settlements_paid" edge of the HouseholdMember entity.
+func (_m *HouseholdMember) QuerySettlementsPaid() *SettlementQuery {
+	return NewHouseholdMemberClient(_m.config).QuerySettlementsPaid(_m)
+}
+
+// QuerySettlementsReceived queries the "settlements_received" edge of the HouseholdMember entity.
+func (_m *HouseholdMember) QuerySettlementsReceived() *SettlementQuery {
+	return NewHouseholdMemberClient(_m.config).QuerySettlementsReceived(_m)
+}
+
+// Update returns a builder for updating this HouseholdMember.
+// Note that you need to call HouseholdMember.Unwrap() before calling this method if this HouseholdMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HouseholdMember) Update() *HouseholdMemberUpdateOne {
+	return NewHouseholdMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HouseholdMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HouseholdMember) Unwrap() *HouseholdMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HouseholdMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HouseholdMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("HouseholdMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HouseholdMembers is a parsable slice of HouseholdMember.
+type HouseholdMembers []*HouseholdMember
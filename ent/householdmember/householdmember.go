@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package householdmember
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the householdmember type in the database.
+	Label = "household_member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeHousehold holds the string denoting the household edge name in mutations.
+	EdgeHousehold = "household"
+	// EdgePaidTransactions holds the string denoting the paid_transactions edge name in mutations.
+	EdgePaidTransactions = "paid_transactions"
+	// EdgePaidRecurringExpenses holds the string denoting the paid_recurring_expenses edge name in mutations.
+	EdgePaidRecurringExpenses = "paid_recurring_expenses"
+	// EdgeSettlementsPaid holds the string denoting the settlements_paid edge name in mutations.
+	EdgeSettlementsPaid = "settlements_paid"
+	// EdgeSettlementsReceived holds the string denoting the settlements_received edge name in mutations.
+	EdgeSettlementsReceived = "settlements_received"
+	// Table holds the table name of the householdmember in the database.
+	Table = "household_members"
+	// HouseholdTable is the table that holds the household relation/edge.
+	HouseholdTable = "household_members"
+	// HouseholdInverseTable is the table name for the Household entity.
+	// It exists in this package in order to avoid circular dependency with the "household" package.
+	HouseholdInverseTable = "households"
+	// HouseholdColumn is the table column denoting the household relation/edge.
+	HouseholdColumn = "household_members"
+	// PaidTransactionsTable is the table that holds the paid_transactions relation/edge.
+	PaidTransactionsTable = "transactions"
+	// PaidTransactionsInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	PaidTransactionsInverseTable = "transactions"
+	// PaidTransactionsColumn is the table column denoting the paid_transactions relation/edge.
+	PaidTransactionsColumn = "household_member_paid_transactions"
+	// PaidRecurringExpensesTable is the table that holds the paid_recurring_expenses relation/edge.
+	PaidRecurringExpensesTable = "recurring_expenses"
+	// PaidRecurringExpensesInverseTable is the table name for the RecurringExpense entity.
+	// It exists in this package in order to avoid circular dependency with the "recurringexpense" package.
+	PaidRecurringExpensesInverseTable = "recurring_expenses"
+	// PaidRecurringExpensesColumn is the table column denoting the paid_recurring_expenses relation/edge.
+	PaidRecurringExpensesColumn = "household_member_paid_recurring_expenses"
+	// SettlementsPaidTable is the table that holds the settlements_paid relation/edge.
+	SettlementsPaidTable = "settlements"
+	// SettlementsPaidInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	SettlementsPaidInverseTable = "settlements"
+	// SettlementsPaidColumn is the table column denoting the settlements_paid relation/edge.
+	SettlementsPaidColumn = "household_member_settlements_paid"
+	// SettlementsReceivedTable is the table that holds the settlements_received relation/edge.
+	SettlementsReceivedTable = "settlements"
+	// SettlementsReceivedInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	SettlementsReceivedInverseTable = "settlements"
+	// SettlementsReceivedColumn is the table column denoting the settlements_received relation/edge.
+	SettlementsReceivedColumn = "household_member_settlements_received"
+)
+
+// Columns holds all SQL columns for householdmember fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "household_members"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"household_members",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the HouseholdMember queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByHouseholdField orders the results by household field.
+func ByHouseholdField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHouseholdStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaidTransactionsCount orders the results by paid_transactions count.
+func ByPaidTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaidTransactionsStep(), opts...)
+	}
+}
+
+// ByPaidTransactions orders the results by paid_transactions terms.
+func ByPaidTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaidTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPaidRecurringExpensesCount orders the results by paid_recurring_expenses count.
+func ByPaidRecurringExpensesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaidRecurringExpensesStep(), opts...)
+	}
+}
+
+// ByPaidRecurringExpenses orders the results by paid_recurring_expenses terms.
+func ByPaidRecurringExpenses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaidRecurringExpensesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySettlementsPaidCount orders the results by settlements_paid count.
+func BySettlementsPaidCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSettlementsPaidStep(), opts...)
+	}
+}
+
+// BySettlementsPaid orders the results by settlements_paid terms.
+func BySettlementsPaid(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettlementsPaidStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySettlementsReceivedCount orders the results by settlements_received count.
+func BySettlementsReceivedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSettlementsReceivedStep(), opts...)
+	}
+}
+
+// BySettlementsReceived orders the results by settlements_received terms.
+func BySettlementsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettlementsReceivedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newHouseholdStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HouseholdInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HouseholdTable, HouseholdColumn),
+	)
+}
+func newPaidTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaidTransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaidTransactionsTable, PaidTransactionsColumn),
+	)
+}
+func newPaidRecurringExpensesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaidRecurringExpensesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaidRecurringExpensesTable, PaidRecurringExpensesColumn),
+	)
+}
+func newSettlementsPaidStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettlementsPaidInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SettlementsPaidTable, SettlementsPaidColumn),
+	)
+}
+func newSettlementsReceivedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettlementsReceivedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SettlementsReceivedTable, SettlementsReceivedColumn),
+	)
+}
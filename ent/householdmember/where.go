@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package householdmember
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldContainsFold(FieldName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasHousehold applies the HasEdge predicate on the "household" edge.
+func HasHousehold() predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HouseholdTable, HouseholdColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHouseholdWith applies the HasEdge predicate on the "household" edge with a given conditions (other predicates).
+func HasHouseholdWith(preds ...predicate.Household) predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := newHouseholdStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaidTransactions applies the HasEdge predicate on the "paid_transactions" edge.
+func HasPaidTransactions() predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaidTransactionsTable, PaidTransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaidTransactionsWith applies the HasEdge predicate on the "paid_transactions" edge with a given conditions (other predicates).
+func HasPaidTransactionsWith(preds ...predicate.Transaction) predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := newPaidTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPaidRecurringExpenses applies the HasEdge predicate on the "paid_recurring_expenses" edge.
+func HasPaidRecurringExpenses() predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaidRecurringExpensesTable, PaidRecurringExpensesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaidRecurringExpensesWith applies the HasEdge predicate on the "paid_recurring_expenses" edge with a given conditions (other predicates).
+func HasPaidRecurringExpensesWith(preds ...predicate.RecurringExpense) predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := newPaidRecurringExpensesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSettlementsPaid applies the HasEdge predicate on the "settlements_paid" edge.
+func HasSettlementsPaid() predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SettlementsPaidTable, SettlementsPaidColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettlementsPaidWith applies the HasEdge predicate on the "settlements_paid" edge with a given conditions (other predicates).
+func HasSettlementsPaidWith(preds ...predicate.Settlement) predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := newSettlementsPaidStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSettlementsReceived applies the HasEdge predicate on the "settlements_received" edge.
+func HasSettlementsReceived() predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SettlementsReceivedTable, SettlementsReceivedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettlementsReceivedWith applies the HasEdge predicate on the "settlements_received" edge with a given conditions (other predicates).
+func HasSettlementsReceivedWith(preds ...predicate.Settlement) predicate.HouseholdMember {
+	return predicate.HouseholdMember(func(s *sql.Selector) {
+		step := newSettlementsReceivedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HouseholdMember) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HouseholdMember) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HouseholdMember) predicate.HouseholdMember {
+	return predicate.HouseholdMember(sql.NotPredicates(p))
+}
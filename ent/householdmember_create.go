@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/settlement"
+	"icekalt.dev/money-tracker/ent/transaction"
+)
+
+// HouseholdMemberCreate is the builder for creating a HouseholdMember entity.
+type HouseholdMemberCreate struct {
+	config
+	mutation *HouseholdMemberMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *HouseholdMemberCreate) SetName(v string) *HouseholdMemberCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HouseholdMemberCreate) SetCreatedAt(v time.Time) *HouseholdMemberCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HouseholdMemberCreate) SetNillableCreatedAt(v *time.Time) *HouseholdMemberCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *HouseholdMemberCreate) SetUpdatedAt(v time.Time) *HouseholdMemberCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *HouseholdMemberCreate) SetNillableUpdatedAt(v *time.Time) *HouseholdMemberCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_c *HouseholdMemberCreate) SetHouseholdID(id int) *HouseholdMemberCreate {
+	_c.mutation.SetHouseholdID(id)
+	return _c
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_c *HouseholdMemberCreate) SetHousehold(v *Household) *HouseholdMemberCreate {
+	return _c.SetHouseholdID(v.ID)
+}
+
+// AddPaidTransactionIDs adds the "paid_transactions" edge to the Transaction entity by IDs.
+func (_c *HouseholdMemberCreate) AddPaidTransactionIDs(ids ...int) *HouseholdMemberCreate {
+	_c.mutation.AddPaidTransactionIDs(ids...)
+	return _c
+}
+
+// AddPaidTransactions adds the "paid_transactions" edges to the Transaction entity.
+func (_c *HouseholdMemberCreate) AddPaidTransactions(v ...*Transaction) *HouseholdMemberCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaidTransactionIDs(ids...)
+}
+
+// AddPaidRecurringExpenseIDs adds the "paid_recurring_expenses" edge to the RecurringExpense entity by IDs.
+func (_c *HouseholdMemberCreate) AddPaidRecurringExpenseIDs(ids ...int) *HouseholdMemberCreate {
+	_c.mutation.AddPaidRecurringExpenseIDs(ids...)
+	return _c
+}
+
+// AddPaidRecurringExpenses adds the "paid_recurring_expenses" edges to the RecurringExpense entity.
+func (_c *HouseholdMemberCreate) AddPaidRecurringExpenses(v ...*RecurringExpense) *HouseholdMemberCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPaidRecurringExpenseIDs(ids...)
+}
+
+// AddSettlementsPaidIDs adds the "settlements_paid" edge to the Settlement entity by IDs.
+func (_c *HouseholdMemberCreate) AddSettlementsPaidIDs(ids ...int) *HouseholdMemberCreate {
+	_c.mutation.AddSettlementsPaidIDs(ids...)
+	return _c
+}
+
+// AddSettlementsPaid adds the "settlements_paid" edges to the Settlement entity.
+func (_c *HouseholdMemberCreate) AddSettlementsPaid(v ...*Settlement) *HouseholdMemberCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSettlementsPaidIDs(ids...)
+}
+
+// AddSettlementsReceivedIDs adds the "settlements_received" edge to the Settlement entity by IDs.
+func (_c *HouseholdMemberCreate) AddSettlementsReceivedIDs(ids ...int) *HouseholdMemberCreate {
+	_c.mutation.AddSettlementsReceivedIDs(ids...)
+	return _c
+}
+
+// AddSettlementsReceived adds the "settlements_received" edges to the Settlement entity.
+func (_c *HouseholdMemberCreate) AddSettlementsReceived(v ...*Settlement) *HouseholdMemberCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSettlementsReceivedIDs(ids...)
+}
+
+// Mutation returns the HouseholdMemberMutation object of the builder.
+func (_c *HouseholdMemberCreate) Mutation() *HouseholdMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the HouseholdMember in the database.
+func (_c *HouseholdMemberCreate) Save(ctx context.Context) (*HouseholdMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HouseholdMemberCreate) SaveX(ctx context.Context) *HouseholdMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseholdMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseholdMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HouseholdMemberCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := householdmember.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := householdmember.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HouseholdMemberCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "HouseholdMember.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := householdmember.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HouseholdMember.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "HouseholdMember.updated_at"`)}
+	}
+	if len(_c.mutation.HouseholdIDs()) == 0 {
+		return &ValidationError{Name: "household", err: errors.New(`ent: missing required edge "HouseholdMember.household"`)}
+	}
+	return nil
+}
+
+func (_c *HouseholdMemberCreate) sqlSave(ctx context.Context) (*HouseholdMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HouseholdMemberCreate) createSpec() (*HouseholdMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HouseholdMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(householdmember.Table, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(householdmember.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(householdmember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(householdmember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdmember.HouseholdTable,
+			Columns: []string{householdmember.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_members = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaidTransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PaidRecurringExpensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SettlementsPaidIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SettlementsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HouseholdMemberCreateBulk is the builder for creating many HouseholdMember entities in bulk.
+type HouseholdMemberCreateBulk struct {
+	config
+	err      error
+	builders []*HouseholdMemberCreate
+}
+
+// Save creates the HouseholdMember entities in the database.
+func (_c *HouseholdMemberCreateBulk) Save(ctx context.Context) ([]*HouseholdMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HouseholdMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HouseholdMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HouseholdMemberCreateBulk) SaveX(ctx context.Context) []*HouseholdMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseholdMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseholdMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,943 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/settlement"
+	"icekalt.dev/money-tracker/ent/transaction"
+)
+
+// HouseholdMemberQuery is the builder for querying HouseholdMember entities.
+type HouseholdMemberQuery struct {
+	config
+	ctx                       *QueryContext
+	order                     []householdmember.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.HouseholdMember
+	withHousehold             *HouseholdQuery
+	withPaidTransactions      *TransactionQuery
+	withPaidRecurringExpenses *RecurringExpenseQuery
+	withSettlementsPaid       *SettlementQuery
+	withSettlementsReceived   *SettlementQuery
+	withFKs                   bool
+	modifiers                 []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HouseholdMemberQuery builder.
+func (_q *HouseholdMemberQuery) Where(ps ...predicate.HouseholdMember) *HouseholdMemberQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *HouseholdMemberQuery) Limit(limit int) *HouseholdMemberQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *HouseholdMemberQuery) Offset(offset int) *HouseholdMemberQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *HouseholdMemberQuery) Unique(unique bool) *HouseholdMemberQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *HouseholdMemberQuery) Order(o ...householdmember.OrderOption) *HouseholdMemberQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryHousehold chains the current query on the "household" edge.
+func (_q *HouseholdMemberQuery) QueryHousehold() *HouseholdQuery {
+	query := (&HouseholdClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, selector),
+			sqlgraph.To(household.Table, household.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, householdmember.HouseholdTable, householdmember.HouseholdColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaidTransactions chains the current query on the "paid_transactions" edge.
+func (_q *HouseholdMemberQuery) QueryPaidTransactions() *TransactionQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.PaidTransactionsTable, householdmember.PaidTransactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPaidRecurringExpenses chains the current query on the "paid_recurring_expenses" edge.
+func (_q *HouseholdMemberQuery) QueryPaidRecurringExpenses() *RecurringExpenseQuery {
+	query := (&RecurringExpenseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, selector),
+			sqlgraph.To(recurringexpense.Table, recurringexpense.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.PaidRecurringExpensesTable, householdmember.PaidRecurringExpensesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySettlementsPaid chains the current query on the "settlements_paid" edge.
+func (_q *HouseholdMemberQuery) QuerySettlementsPaid() *SettlementQuery {
+	query := (&SettlementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.SettlementsPaidTable, householdmember.SettlementsPaidColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySettlementsReceived chains the current query on the "settlements_received" edge.
+func (_q *HouseholdMemberQuery) QuerySettlementsReceived() *SettlementQuery {
+	query := (&SettlementClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(householdmember.Table, householdmember.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, householdmember.SettlementsReceivedTable, householdmember.SettlementsReceivedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HouseholdMember entity from the query.
+// Returns a *NotFoundError when no HouseholdMember was found.
+func (_q *HouseholdMemberQuery) First(ctx context.Context) (*HouseholdMember, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{householdmember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) FirstX(ctx context.Context) *HouseholdMember {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HouseholdMember ID from the query.
+// Returns a *NotFoundError when no HouseholdMember ID was found.
+func (_q *HouseholdMemberQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{householdmember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HouseholdMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HouseholdMember entity is found.
+// Returns a *NotFoundError when no HouseholdMember entities are found.
+func (_q *HouseholdMemberQuery) Only(ctx context.Context) (*HouseholdMember, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{householdmember.Label}
+	default:
+		return nil, &NotSingularError{householdmember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) OnlyX(ctx context.Context) *HouseholdMember {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HouseholdMember ID in the query.
+// Returns a *NotSingularError when more than one HouseholdMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *HouseholdMemberQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{householdmember.Label}
+	default:
+		err = &NotSingularError{householdmember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HouseholdMembers.
+func (_q *HouseholdMemberQuery) All(ctx context.Context) ([]*HouseholdMember, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HouseholdMember, *HouseholdMemberQuery]()
+	return withInterceptors[[]*HouseholdMember](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) AllX(ctx context.Context) []*HouseholdMember {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HouseholdMember IDs.
+func (_q *HouseholdMemberQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(householdmember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *HouseholdMemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*HouseholdMemberQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *HouseholdMemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *HouseholdMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HouseholdMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *HouseholdMemberQuery) Clone() *HouseholdMemberQuery {
+	if _q == nil {
+		return nil
+	}
+	return &HouseholdMemberQuery{
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]householdmember.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.HouseholdMember{}, _q.predicates...),
+		withHousehold:             _q.withHousehold.Clone(),
+		withPaidTransactions:      _q.withPaidTransactions.Clone(),
+		withPaidRecurringExpenses: _q.withPaidRecurringExpenses.Clone(),
+		withSettlementsPaid:       _q.withSettlementsPaid.Clone(),
+		withSettlementsReceived:   _q.withSettlementsReceived.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithHousehold tells the query-builder to eager-load the nodes that are connected to
+// the "household" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdMemberQuery) WithHousehold(opts ...func(*HouseholdQuery)) *HouseholdMemberQuery {
+	query := (&HouseholdClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHousehold = query
+	return _q
+}
+
+// WithPaidTransactions tells the query-builder to eager-load the nodes that are connected to
+// the "paid_transactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdMemberQuery) WithPaidTransactions(opts ...func(*TransactionQuery)) *HouseholdMemberQuery {
+	query := (&TransactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaidTransactions = query
+	return _q
+}
+
+// WithPaidRecurringExpenses tells the query-builder to eager-load the nodes that are connected to
+// the "paid_recurring_expenses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdMemberQuery) WithPaidRecurringExpenses(opts ...func(*RecurringExpenseQuery)) *HouseholdMemberQuery {
+	query := (&RecurringExpenseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPaidRecurringExpenses = query
+	return _q
+}
+
+// WithSettlementsPaid tells the query-builder to eager-load the nodes that are connected to
+// the "settlements_paid" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdMemberQuery) WithSettlementsPaid(opts ...func(*SettlementQuery)) *HouseholdMemberQuery {
+	query := (&SettlementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSettlementsPaid = query
+	return _q
+}
+
+// WithSettlementsReceived tells the query-builder to eager-load the nodes that are connected to
+// the "settlements_received" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HouseholdMemberQuery) WithSettlementsReceived(opts ...func(*SettlementQuery)) *HouseholdMemberQuery {
+	query := (&SettlementClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSettlementsReceived = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HouseholdMember.Query().
+//		GroupBy(householdmember.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *HouseholdMemberQuery) GroupBy(field string, fields ...string) *HouseholdMemberGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HouseholdMemberGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = householdmember.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.HouseholdMember.Query().
+//		Select(householdmember.FieldName).
+//		Scan(ctx, &v)
+func (_q *HouseholdMemberQuery) Select(fields ...string) *HouseholdMemberSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &HouseholdMemberSelect{HouseholdMemberQuery: _q}
+	sbuild.label = householdmember.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HouseholdMemberSelect configured with the given aggregations.
+func (_q *HouseholdMemberQuery) Aggregate(fns ...AggregateFunc) *HouseholdMemberSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *HouseholdMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !householdmember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *HouseholdMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HouseholdMember, error) {
+	var (
+		nodes       = []*HouseholdMember{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [5]bool{
+			_q.withHousehold != nil,
+			_q.withPaidTransactions != nil,
+			_q.withPaidRecurringExpenses != nil,
+			_q.withSettlementsPaid != nil,
+			_q.withSettlementsReceived != nil,
+		}
+	)
+	if _q.withHousehold != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, householdmember.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HouseholdMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HouseholdMember{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withHousehold; query != nil {
+		if err := _q.loadHousehold(ctx, query, nodes, nil,
+			func(n *HouseholdMember, e *Household) { n.Edges.Household = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaidTransactions; query != nil {
+		if err := _q.loadPaidTransactions(ctx, query, nodes,
+			func(n *HouseholdMember) { n.Edges.PaidTransactions = []*Transaction{} },
+			func(n *HouseholdMember, e *Transaction) {
+				n.Edges.PaidTransactions = append(n.Edges.PaidTransactions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPaidRecurringExpenses; query != nil {
+		if err := _q.loadPaidRecurringExpenses(ctx, query, nodes,
+			func(n *HouseholdMember) { n.Edges.PaidRecurringExpenses = []*RecurringExpense{} },
+			func(n *HouseholdMember, e *RecurringExpense) {
+				n.Edges.PaidRecurringExpenses = append(n.Edges.PaidRecurringExpenses, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSettlementsPaid; query != nil {
+		if err := _q.loadSettlementsPaid(ctx, query, nodes,
+			func(n *HouseholdMember) { n.Edges.SettlementsPaid = []*Settlement{} },
+			func(n *HouseholdMember, e *Settlement) { n.Edges.SettlementsPaid = append(n.Edges.SettlementsPaid, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSettlementsReceived; query != nil {
+		if err := _q.loadSettlementsReceived(ctx, query, nodes,
+			func(n *HouseholdMember) { n.Edges.SettlementsReceived = []*Settlement{} },
+			func(n *HouseholdMember, e *Settlement) {
+				n.Edges.SettlementsReceived = append(n.Edges.SettlementsReceived, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *HouseholdMemberQuery) loadHousehold(ctx context.Context, query *HouseholdQuery, nodes []*HouseholdMember, init func(*HouseholdMember), assign func(*HouseholdMember, *Household)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*HouseholdMember)
+	for i := range nodes {
+		if nodes[i].household_members == nil {
+			continue
+		}
+		fk := *nodes[i].household_members
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(household.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "household_members" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *HouseholdMemberQuery) loadPaidTransactions(ctx context.Context, query *TransactionQuery, nodes []*HouseholdMember, init func(*HouseholdMember), assign func(*HouseholdMember, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*HouseholdMember)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(householdmember.PaidTransactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_member_paid_transactions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_member_paid_transactions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_member_paid_transactions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdMemberQuery) loadPaidRecurringExpenses(ctx context.Context, query *RecurringExpenseQuery, nodes []*HouseholdMember, init func(*HouseholdMember), assign func(*HouseholdMember, *RecurringExpense)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*HouseholdMember)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RecurringExpense(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(householdmember.PaidRecurringExpensesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_member_paid_recurring_expenses
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_member_paid_recurring_expenses" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_member_paid_recurring_expenses" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdMemberQuery) loadSettlementsPaid(ctx context.Context, query *SettlementQuery, nodes []*HouseholdMember, init func(*HouseholdMember), assign func(*HouseholdMember, *Settlement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*HouseholdMember)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Settlement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(householdmember.SettlementsPaidColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_member_settlements_paid
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_member_settlements_paid" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_member_settlements_paid" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HouseholdMemberQuery) loadSettlementsReceived(ctx context.Context, query *SettlementQuery, nodes []*HouseholdMember, init func(*HouseholdMember), assign func(*HouseholdMember, *Settlement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*HouseholdMember)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Settlement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(householdmember.SettlementsReceivedColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.household_member_settlements_received
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "household_member_settlements_received" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "household_member_settlements_received" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *HouseholdMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *HouseholdMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(householdmember.Table, householdmember.Columns, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, householdmember.FieldID)
+		for i := range fields {
+			if fields[i] != householdmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *HouseholdMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(householdmember.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = householdmember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *HouseholdMemberQuery) Modify(modifiers ...func(s *sql.Selector)) *HouseholdMemberSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// HouseholdMemberGroupBy is the group-by builder for HouseholdMember entities.
+type HouseholdMemberGroupBy struct {
+	selector
+	build *HouseholdMemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *HouseholdMemberGroupBy) Aggregate(fns ...AggregateFunc) *HouseholdMemberGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *HouseholdMemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HouseholdMemberQuery, *HouseholdMemberGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *HouseholdMemberGroupBy) sqlScan(ctx context.Context, root *HouseholdMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HouseholdMemberSelect is the builder for selecting fields of HouseholdMember entities.
+type HouseholdMemberSelect struct {
+	*HouseholdMemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *HouseholdMemberSelect) Aggregate(fns ...AggregateFunc) *HouseholdMemberSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *HouseholdMemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HouseholdMemberQuery, *HouseholdMemberSelect](ctx, _s.HouseholdMemberQuery, _s, _s.inters, v)
+}
+
+func (_s *HouseholdMemberSelect) sqlScan(ctx context.Context, root *HouseholdMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *HouseholdMemberSelect) Modify(modifiers ...func(s *sql.Selector)) *HouseholdMemberSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
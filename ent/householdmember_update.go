@@ -0,0 +1,1038 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/settlement"
+	"icekalt.dev/money-tracker/ent/transaction"
+)
+
+// HouseholdMemberUpdate is the builder for updating HouseholdMember entities.
+type HouseholdMemberUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *HouseholdMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the HouseholdMemberUpdate builder.
+func (_u *HouseholdMemberUpdate) Where(ps ...predicate.HouseholdMember) *HouseholdMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HouseholdMemberUpdate) SetName(v string) *HouseholdMemberUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HouseholdMemberUpdate) SetNillableName(v *string) *HouseholdMemberUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HouseholdMemberUpdate) SetUpdatedAt(v time.Time) *HouseholdMemberUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *HouseholdMemberUpdate) SetHouseholdID(id int) *HouseholdMemberUpdate {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *HouseholdMemberUpdate) SetHousehold(v *Household) *HouseholdMemberUpdate {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// AddPaidTransactionIDs adds the "paid_transactions" edge to the Transaction entity by IDs.
+func (_u *HouseholdMemberUpdate) AddPaidTransactionIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.AddPaidTransactionIDs(ids...)
+	return _u
+}
+
+// AddPaidTransactions adds the "paid_transactions" edges to the Transaction entity.
+func (_u *HouseholdMemberUpdate) AddPaidTransactions(v ...*Transaction) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaidTransactionIDs(ids...)
+}
+
+// AddPaidRecurringExpenseIDs adds the "paid_recurring_expenses" edge to the RecurringExpense entity by IDs.
+func (_u *HouseholdMemberUpdate) AddPaidRecurringExpenseIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.AddPaidRecurringExpenseIDs(ids...)
+	return _u
+}
+
+// AddPaidRecurringExpenses adds the "paid_recurring_expenses" edges to the RecurringExpense entity.
+func (_u *HouseholdMemberUpdate) AddPaidRecurringExpenses(v ...*RecurringExpense) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaidRecurringExpenseIDs(ids...)
+}
+
+// AddSettlementsPaidIDs adds the "settlements_paid" edge to the Settlement entity by IDs.
+func (_u *HouseholdMemberUpdate) AddSettlementsPaidIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.AddSettlementsPaidIDs(ids...)
+	return _u
+}
+
+// AddSettlementsPaid adds the "settlements_paid" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdate) AddSettlementsPaid(v ...*Settlement) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSettlementsPaidIDs(ids...)
+}
+
+// AddSettlementsReceivedIDs adds the "settlements_received" edge to the Settlement entity by IDs.
+func (_u *HouseholdMemberUpdate) AddSettlementsReceivedIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.AddSettlementsReceivedIDs(ids...)
+	return _u
+}
+
+// AddSettlementsReceived adds the "settlements_received" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdate) AddSettlementsReceived(v ...*Settlement) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSettlementsReceivedIDs(ids...)
+}
+
+// Mutation returns the HouseholdMemberMutation object of the builder.
+func (_u *HouseholdMemberUpdate) Mutation() *HouseholdMemberMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *HouseholdMemberUpdate) ClearHousehold() *HouseholdMemberUpdate {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// ClearPaidTransactions clears all "paid_transactions" edges to the Transaction entity.
+func (_u *HouseholdMemberUpdate) ClearPaidTransactions() *HouseholdMemberUpdate {
+	_u.mutation.ClearPaidTransactions()
+	return _u
+}
+
+// RemovePaidTransactionIDs removes the "paid_transactions" edge to Transaction entities by IDs.
+func (_u *HouseholdMemberUpdate) RemovePaidTransactionIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.RemovePaidTransactionIDs(ids...)
+	return _u
+}
+
+// RemovePaidTransactions removes "paid_transactions" edges to Transaction entities.
+func (_u *HouseholdMemberUpdate) RemovePaidTransactions(v ...*Transaction) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaidTransactionIDs(ids...)
+}
+
+// ClearPaidRecurringExpenses clears all "paid_recurring_expenses" edges to the RecurringExpense entity.
+func (_u *HouseholdMemberUpdate) ClearPaidRecurringExpenses() *HouseholdMemberUpdate {
+	_u.mutation.ClearPaidRecurringExpenses()
+	return _u
+}
+
+// RemovePaidRecurringExpenseIDs removes the "paid_recurring_expenses" edge to RecurringExpense entities by IDs.
+func (_u *HouseholdMemberUpdate) RemovePaidRecurringExpenseIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.RemovePaidRecurringExpenseIDs(ids...)
+	return _u
+}
+
+// RemovePaidRecurringExpenses removes "paid_recurring_expenses" edges to RecurringExpense entities.
+func (_u *HouseholdMemberUpdate) RemovePaidRecurringExpenses(v ...*RecurringExpense) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaidRecurringExpenseIDs(ids...)
+}
+
+// ClearSettlementsPaid clears all "settlements_paid" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdate) ClearSettlementsPaid() *HouseholdMemberUpdate {
+	_u.mutation.ClearSettlementsPaid()
+	return _u
+}
+
+// RemoveSettlementsPaidIDs removes the "settlements_paid" edge to Settlement entities by IDs.
+func (_u *HouseholdMemberUpdate) RemoveSettlementsPaidIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.RemoveSettlementsPaidIDs(ids...)
+	return _u
+}
+
+// RemoveSettlementsPaid removes "settlements_paid" edges to Settlement entities.
+func (_u *HouseholdMemberUpdate) RemoveSettlementsPaid(v ...*Settlement) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSettlementsPaidIDs(ids...)
+}
+
+// ClearSettlementsReceived clears all "settlements_received" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdate) ClearSettlementsReceived() *HouseholdMemberUpdate {
+	_u.mutation.ClearSettlementsReceived()
+	return _u
+}
+
+// RemoveSettlementsReceivedIDs removes the "settlements_received" edge to Settlement entities by IDs.
+func (_u *HouseholdMemberUpdate) RemoveSettlementsReceivedIDs(ids ...int) *HouseholdMemberUpdate {
+	_u.mutation.RemoveSettlementsReceivedIDs(ids...)
+	return _u
+}
+
+// RemoveSettlementsReceived removes "settlements_received" edges to Settlement entities.
+func (_u *HouseholdMemberUpdate) RemoveSettlementsReceived(v ...*Settlement) *HouseholdMemberUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSettlementsReceivedIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HouseholdMemberUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseholdMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HouseholdMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseholdMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HouseholdMemberUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := householdmember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HouseholdMemberUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := householdmember.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.name": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HouseholdMember.household"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HouseholdMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HouseholdMemberUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HouseholdMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(householdmember.Table, householdmember.Columns, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(householdmember.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(householdmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdmember.HouseholdTable,
+			Columns: []string{householdmember.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdmember.HouseholdTable,
+			Columns: []string{householdmember.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaidTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaidTransactionsIDs(); len(nodes) > 0 && !_u.mutation.PaidTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaidTransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaidRecurringExpensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaidRecurringExpensesIDs(); len(nodes) > 0 && !_u.mutation.PaidRecurringExpensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaidRecurringExpensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SettlementsPaidCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSettlementsPaidIDs(); len(nodes) > 0 && !_u.mutation.SettlementsPaidCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettlementsPaidIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SettlementsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSettlementsReceivedIDs(); len(nodes) > 0 && !_u.mutation.SettlementsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettlementsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{householdmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HouseholdMemberUpdateOne is the builder for updating a single HouseholdMember entity.
+type HouseholdMemberUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *HouseholdMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *HouseholdMemberUpdateOne) SetName(v string) *HouseholdMemberUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HouseholdMemberUpdateOne) SetNillableName(v *string) *HouseholdMemberUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *HouseholdMemberUpdateOne) SetUpdatedAt(v time.Time) *HouseholdMemberUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *HouseholdMemberUpdateOne) SetHouseholdID(id int) *HouseholdMemberUpdateOne {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *HouseholdMemberUpdateOne) SetHousehold(v *Household) *HouseholdMemberUpdateOne {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// AddPaidTransactionIDs adds the "paid_transactions" edge to the Transaction entity by IDs.
+func (_u *HouseholdMemberUpdateOne) AddPaidTransactionIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.AddPaidTransactionIDs(ids...)
+	return _u
+}
+
+// AddPaidTransactions adds the "paid_transactions" edges to the Transaction entity.
+func (_u *HouseholdMemberUpdateOne) AddPaidTransactions(v ...*Transaction) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaidTransactionIDs(ids...)
+}
+
+// AddPaidRecurringExpenseIDs adds the "paid_recurring_expenses" edge to the RecurringExpense entity by IDs.
+func (_u *HouseholdMemberUpdateOne) AddPaidRecurringExpenseIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.AddPaidRecurringExpenseIDs(ids...)
+	return _u
+}
+
+// AddPaidRecurringExpenses adds the "paid_recurring_expenses" edges to the RecurringExpense entity.
+func (_u *HouseholdMemberUpdateOne) AddPaidRecurringExpenses(v ...*RecurringExpense) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPaidRecurringExpenseIDs(ids...)
+}
+
+// AddSettlementsPaidIDs adds the "settlements_paid" edge to the Settlement entity by IDs.
+func (_u *HouseholdMemberUpdateOne) AddSettlementsPaidIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.AddSettlementsPaidIDs(ids...)
+	return _u
+}
+
+// AddSettlementsPaid adds the "settlements_paid" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdateOne) AddSettlementsPaid(v ...*Settlement) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSettlementsPaidIDs(ids...)
+}
+
+// AddSettlementsReceivedIDs adds the "settlements_received" edge to the Settlement entity by IDs.
+func (_u *HouseholdMemberUpdateOne) AddSettlementsReceivedIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.AddSettlementsReceivedIDs(ids...)
+	return _u
+}
+
+// AddSettlementsReceived adds the "settlements_received" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdateOne) AddSettlementsReceived(v ...*Settlement) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSettlementsReceivedIDs(ids...)
+}
+
+// Mutation returns the HouseholdMemberMutation object of the builder.
+func (_u *HouseholdMemberUpdateOne) Mutation() *HouseholdMemberMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *HouseholdMemberUpdateOne) ClearHousehold() *HouseholdMemberUpdateOne {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// ClearPaidTransactions clears all "paid_transactions" edges to the Transaction entity.
+func (_u *HouseholdMemberUpdateOne) ClearPaidTransactions() *HouseholdMemberUpdateOne {
+	_u.mutation.ClearPaidTransactions()
+	return _u
+}
+
+// RemovePaidTransactionIDs removes the "paid_transactions" edge to Transaction entities by IDs.
+func (_u *HouseholdMemberUpdateOne) RemovePaidTransactionIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.RemovePaidTransactionIDs(ids...)
+	return _u
+}
+
+// RemovePaidTransactions removes "paid_transactions" edges to Transaction entities.
+func (_u *HouseholdMemberUpdateOne) RemovePaidTransactions(v ...*Transaction) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaidTransactionIDs(ids...)
+}
+
+// ClearPaidRecurringExpenses clears all "paid_recurring_expenses" edges to the RecurringExpense entity.
+func (_u *HouseholdMemberUpdateOne) ClearPaidRecurringExpenses() *HouseholdMemberUpdateOne {
+	_u.mutation.ClearPaidRecurringExpenses()
+	return _u
+}
+
+// RemovePaidRecurringExpenseIDs removes the "paid_recurring_expenses" edge to RecurringExpense entities by IDs.
+func (_u *HouseholdMemberUpdateOne) RemovePaidRecurringExpenseIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.RemovePaidRecurringExpenseIDs(ids...)
+	return _u
+}
+
+// RemovePaidRecurringExpenses removes "paid_recurring_expenses" edges to RecurringExpense entities.
+func (_u *HouseholdMemberUpdateOne) RemovePaidRecurringExpenses(v ...*RecurringExpense) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePaidRecurringExpenseIDs(ids...)
+}
+
+// ClearSettlementsPaid clears all "settlements_paid" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdateOne) ClearSettlementsPaid() *HouseholdMemberUpdateOne {
+	_u.mutation.ClearSettlementsPaid()
+	return _u
+}
+
+// RemoveSettlementsPaidIDs removes the "settlements_paid" edge to Settlement entities by IDs.
+func (_u *HouseholdMemberUpdateOne) RemoveSettlementsPaidIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.RemoveSettlementsPaidIDs(ids...)
+	return _u
+}
+
+// RemoveSettlementsPaid removes "settlements_paid" edges to Settlement entities.
+func (_u *HouseholdMemberUpdateOne) RemoveSettlementsPaid(v ...*Settlement) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSettlementsPaidIDs(ids...)
+}
+
+// ClearSettlementsReceived clears all "settlements_received" edges to the Settlement entity.
+func (_u *HouseholdMemberUpdateOne) ClearSettlementsReceived() *HouseholdMemberUpdateOne {
+	_u.mutation.ClearSettlementsReceived()
+	return _u
+}
+
+// RemoveSettlementsReceivedIDs removes the "settlements_received" edge to Settlement entities by IDs.
+func (_u *HouseholdMemberUpdateOne) RemoveSettlementsReceivedIDs(ids ...int) *HouseholdMemberUpdateOne {
+	_u.mutation.RemoveSettlementsReceivedIDs(ids...)
+	return _u
+}
+
+// RemoveSettlementsReceived removes "settlements_received" edges to Settlement entities.
+func (_u *HouseholdMemberUpdateOne) RemoveSettlementsReceived(v ...*Settlement) *HouseholdMemberUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSettlementsReceivedIDs(ids...)
+}
+
+// Where appends a list predicates to the HouseholdMemberUpdate builder.
+func (_u *HouseholdMemberUpdateOne) Where(ps ...predicate.HouseholdMember) *HouseholdMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HouseholdMemberUpdateOne) Select(field string, fields ...string) *HouseholdMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HouseholdMember entity.
+func (_u *HouseholdMemberUpdateOne) Save(ctx context.Context) (*HouseholdMember, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseholdMemberUpdateOne) SaveX(ctx context.Context) *HouseholdMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HouseholdMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseholdMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *HouseholdMemberUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := householdmember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HouseholdMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := householdmember.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HouseholdMember.name": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HouseholdMember.household"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HouseholdMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HouseholdMemberUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HouseholdMemberUpdateOne) sqlSave(ctx context.Context) (_node *HouseholdMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(householdmember.Table, householdmember.Columns, sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HouseholdMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, householdmember.FieldID)
+		for _, f := range fields {
+			if !householdmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != householdmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(householdmember.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(householdmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdmember.HouseholdTable,
+			Columns: []string{householdmember.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdmember.HouseholdTable,
+			Columns: []string{householdmember.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaidTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaidTransactionsIDs(); len(nodes) > 0 && !_u.mutation.PaidTransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaidTransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidTransactionsTable,
+			Columns: []string{householdmember.PaidTransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PaidRecurringExpensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPaidRecurringExpensesIDs(); len(nodes) > 0 && !_u.mutation.PaidRecurringExpensesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaidRecurringExpensesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.PaidRecurringExpensesTable,
+			Columns: []string{householdmember.PaidRecurringExpensesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringexpense.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SettlementsPaidCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSettlementsPaidIDs(); len(nodes) > 0 && !_u.mutation.SettlementsPaidCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettlementsPaidIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsPaidTable,
+			Columns: []string{householdmember.SettlementsPaidColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SettlementsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSettlementsReceivedIDs(); len(nodes) > 0 && !_u.mutation.SettlementsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettlementsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   householdmember.SettlementsReceivedTable,
+			Columns: []string{householdmember.SettlementsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &HouseholdMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{householdmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/user"
+)
+
+// HouseholdTransfer is the model entity for the HouseholdTransfer schema.
+type HouseholdTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Lower-case email of the recipient
+	Email string `json:"email,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HouseholdTransferQuery when eager-loading is set.
+	Edges                    HouseholdTransferEdges `json:"edges"`
+	household_transfer       *int
+	user_household_transfers *int
+	selectValues             sql.SelectValues
+}
+
+// HouseholdTransferEdges holds the relations/edges for other nodes in the graph.
+type HouseholdTransferEdges struct {
+	// Household holds the value of the household edge.
+	Household *Household `json:"household,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// HouseholdOrErr returns the Household value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HouseholdTransferEdges) HouseholdOrErr() (*Household, error) {
+	if e.Household != nil {
+		return e.Household, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: household.Label}
+	}
+	return nil, &NotLoadedError{edge: "household"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HouseholdTransferEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HouseholdTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case householdtransfer.FieldID:
+			values[i] = new(sql.NullInt64)
+		case householdtransfer.FieldEmail:
+			values[i] = new(sql.NullString)
+		case householdtransfer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case householdtransfer.ForeignKeys[0]: // household_transfer
+			values[i] = new(sql.NullInt64)
+		case householdtransfer.ForeignKeys[1]: // user_household_transfers
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HouseholdTransfer fields.
+func (_m *HouseholdTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case householdtransfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case householdtransfer.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case householdtransfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case householdtransfer.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_transfer", value)
+			} else if value.Valid {
+				_m.household_transfer = new(int)
+				*_m.household_transfer = int(value.Int64)
+			}
+		case householdtransfer.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_household_transfers", value)
+			} else if value.Valid {
+				_m.user_household_transfers = new(int)
+				*_m.user_household_transfers = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HouseholdTransfer.
+// This includes values selected through modifiers, order, etc.
+func (_m *HouseholdTransfer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHousehold queries the "household" edge of the HouseholdTransfer entity.
+func (_m *HouseholdTransfer) QueryHousehold() *HouseholdQuery {
+	return NewHouseholdTransferClient(_m.config).QueryHousehold(_m)
+}
+
+// QueryUser queries the "user" edge of the HouseholdTransfer entity.
+func (_m *HouseholdTransfer) QueryUser() *UserQuery {
+	return NewHouseholdTransferClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this HouseholdTransfer.
+// Note that you need to call HouseholdTransfer.Unwrap() before calling this method if this HouseholdTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HouseholdTransfer) Update() *HouseholdTransferUpdateOne {
+	return NewHouseholdTransferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HouseholdTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HouseholdTransfer) Unwrap() *HouseholdTransfer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HouseholdTransfer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HouseholdTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("HouseholdTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HouseholdTransfers is a parsable slice of HouseholdTransfer.
+type HouseholdTransfers []*HouseholdTransfer
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/user"
+)
+
+// HouseholdTransferCreate is the builder for creating a HouseholdTransfer entity.
+type HouseholdTransferCreate struct {
+	config
+	mutation *HouseholdTransferMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (_c *HouseholdTransferCreate) SetEmail(v string) *HouseholdTransferCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HouseholdTransferCreate) SetCreatedAt(v time.Time) *HouseholdTransferCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HouseholdTransferCreate) SetNillableCreatedAt(v *time.Time) *HouseholdTransferCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_c *HouseholdTransferCreate) SetHouseholdID(id int) *HouseholdTransferCreate {
+	_c.mutation.SetHouseholdID(id)
+	return _c
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_c *HouseholdTransferCreate) SetHousehold(v *Household) *HouseholdTransferCreate {
+	return _c.SetHouseholdID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *HouseholdTransferCreate) SetUserID(id int) *HouseholdTransferCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *HouseholdTransferCreate) SetUser(v *User) *HouseholdTransferCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the HouseholdTransferMutation object of the builder.
+func (_c *HouseholdTransferCreate) Mutation() *HouseholdTransferMutation {
+	return _c.mutation
+}
+
+// Save creates the HouseholdTransfer in the database.
+func (_c *HouseholdTransferCreate) Save(ctx context.Context) (*HouseholdTransfer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HouseholdTransferCreate) SaveX(ctx context.Context) *HouseholdTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseholdTransferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseholdTransferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HouseholdTransferCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := householdtransfer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HouseholdTransferCreate) check() error {
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "HouseholdTransfer.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := householdtransfer.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "HouseholdTransfer.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HouseholdTransfer.created_at"`)}
+	}
+	if len(_c.mutation.HouseholdIDs()) == 0 {
+		return &ValidationError{Name: "household", err: errors.New(`ent: missing required edge "HouseholdTransfer.household"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "HouseholdTransfer.user"`)}
+	}
+	return nil
+}
+
+func (_c *HouseholdTransferCreate) sqlSave(ctx context.Context) (*HouseholdTransfer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HouseholdTransferCreate) createSpec() (*HouseholdTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HouseholdTransfer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(householdtransfer.Table, sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(householdtransfer.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(householdtransfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   householdtransfer.HouseholdTable,
+			Columns: []string{householdtransfer.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_transfer = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdtransfer.UserTable,
+			Columns: []string{householdtransfer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_household_transfers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HouseholdTransferCreateBulk is the builder for creating many HouseholdTransfer entities in bulk.
+type HouseholdTransferCreateBulk struct {
+	config
+	err      error
+	builders []*HouseholdTransferCreate
+}
+
+// Save creates the HouseholdTransfer entities in the database.
+func (_c *HouseholdTransferCreateBulk) Save(ctx context.Context) ([]*HouseholdTransfer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HouseholdTransfer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HouseholdTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HouseholdTransferCreateBulk) SaveX(ctx context.Context) []*HouseholdTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HouseholdTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HouseholdTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
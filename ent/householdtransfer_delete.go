@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// HouseholdTransferDelete is the builder for deleting a HouseholdTransfer entity.
+type HouseholdTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *HouseholdTransferMutation
+}
+
+// Where appends a list predicates to the HouseholdTransferDelete builder.
+func (_d *HouseholdTransferDelete) Where(ps ...predicate.HouseholdTransfer) *HouseholdTransferDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *HouseholdTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *HouseholdTransferDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *HouseholdTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(householdtransfer.Table, sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// HouseholdTransferDeleteOne is the builder for deleting a single HouseholdTransfer entity.
+type HouseholdTransferDeleteOne struct {
+	_d *HouseholdTransferDelete
+}
+
+// Where appends a list predicates to the HouseholdTransferDelete builder.
+func (_d *HouseholdTransferDeleteOne) Where(ps ...predicate.HouseholdTransfer) *HouseholdTransferDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *HouseholdTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{householdtransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *HouseholdTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,449 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/user"
+)
+
+// HouseholdTransferUpdate is the builder for updating HouseholdTransfer entities.
+type HouseholdTransferUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *HouseholdTransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the HouseholdTransferUpdate builder.
+func (_u *HouseholdTransferUpdate) Where(ps ...predicate.HouseholdTransfer) *HouseholdTransferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *HouseholdTransferUpdate) SetEmail(v string) *HouseholdTransferUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *HouseholdTransferUpdate) SetNillableEmail(v *string) *HouseholdTransferUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *HouseholdTransferUpdate) SetHouseholdID(id int) *HouseholdTransferUpdate {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *HouseholdTransferUpdate) SetHousehold(v *Household) *HouseholdTransferUpdate {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *HouseholdTransferUpdate) SetUserID(id int) *HouseholdTransferUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *HouseholdTransferUpdate) SetUser(v *User) *HouseholdTransferUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the HouseholdTransferMutation object of the builder.
+func (_u *HouseholdTransferUpdate) Mutation() *HouseholdTransferMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *HouseholdTransferUpdate) ClearHousehold() *HouseholdTransferUpdate {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *HouseholdTransferUpdate) ClearUser() *HouseholdTransferUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HouseholdTransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseholdTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HouseholdTransferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseholdTransferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HouseholdTransferUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := householdtransfer.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "HouseholdTransfer.email": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HouseholdTransfer.household"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HouseholdTransfer.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HouseholdTransferUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HouseholdTransferUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HouseholdTransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(householdtransfer.Table, householdtransfer.Columns, sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(householdtransfer.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   householdtransfer.HouseholdTable,
+			Columns: []string{householdtransfer.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   householdtransfer.HouseholdTable,
+			Columns: []string{householdtransfer.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdtransfer.UserTable,
+			Columns: []string{householdtransfer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdtransfer.UserTable,
+			Columns: []string{householdtransfer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{householdtransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HouseholdTransferUpdateOne is the builder for updating a single HouseholdTransfer entity.
+type HouseholdTransferUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *HouseholdTransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetEmail sets the "email" field.
+func (_u *HouseholdTransferUpdateOne) SetEmail(v string) *HouseholdTransferUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *HouseholdTransferUpdateOne) SetNillableEmail(v *string) *HouseholdTransferUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *HouseholdTransferUpdateOne) SetHouseholdID(id int) *HouseholdTransferUpdateOne {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *HouseholdTransferUpdateOne) SetHousehold(v *Household) *HouseholdTransferUpdateOne {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *HouseholdTransferUpdateOne) SetUserID(id int) *HouseholdTransferUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *HouseholdTransferUpdateOne) SetUser(v *User) *HouseholdTransferUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the HouseholdTransferMutation object of the builder.
+func (_u *HouseholdTransferUpdateOne) Mutation() *HouseholdTransferMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *HouseholdTransferUpdateOne) ClearHousehold() *HouseholdTransferUpdateOne {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *HouseholdTransferUpdateOne) ClearUser() *HouseholdTransferUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the HouseholdTransferUpdate builder.
+func (_u *HouseholdTransferUpdateOne) Where(ps ...predicate.HouseholdTransfer) *HouseholdTransferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HouseholdTransferUpdateOne) Select(field string, fields ...string) *HouseholdTransferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HouseholdTransfer entity.
+func (_u *HouseholdTransferUpdateOne) Save(ctx context.Context) (*HouseholdTransfer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HouseholdTransferUpdateOne) SaveX(ctx context.Context) *HouseholdTransfer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HouseholdTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HouseholdTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HouseholdTransferUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := householdtransfer.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "HouseholdTransfer.email": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HouseholdTransfer.household"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HouseholdTransfer.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HouseholdTransferUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HouseholdTransferUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HouseholdTransferUpdateOne) sqlSave(ctx context.Context) (_node *HouseholdTransfer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(householdtransfer.Table, householdtransfer.Columns, sqlgraph.NewFieldSpec(householdtransfer.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HouseholdTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, householdtransfer.FieldID)
+		for _, f := range fields {
+			if !householdtransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != householdtransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(householdtransfer.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   householdtransfer.HouseholdTable,
+			Columns: []string{householdtransfer.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   householdtransfer.HouseholdTable,
+			Columns: []string{householdtransfer.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdtransfer.UserTable,
+			Columns: []string{householdtransfer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   householdtransfer.UserTable,
+			Columns: []string{householdtransfer.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &HouseholdTransfer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{householdtransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
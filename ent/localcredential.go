@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/localcredential"
+	"icekalt.dev/money-tracker/ent/user"
+)
+
+// LocalCredential is the model entity for the LocalCredential schema.
+type LocalCredential struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// argon2id in PHC string format
+	PasswordHash string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LocalCredentialQuery when eager-loading is set.
+	Edges                 LocalCredentialEdges `json:"edges"`
+	user_local_credential *int
+	selectValues          sql.SelectValues
+}
+
+// LocalCredentialEdges holds the relations/edges for other nodes in the graph.
+type LocalCredentialEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LocalCredentialEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LocalCredential) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case localcredential.FieldID:
+			values[i] = new(sql.NullInt64)
+		case localcredential.FieldUsername, localcredential.FieldPasswordHash:
+			values[i] = new(sql.NullString)
+		case localcredential.FieldCreatedAt, localcredential.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case localcredential.ForeignKeys[0]: // user_local_credential
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LocalCredential fields.
+func (_m *LocalCredential) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case localcredential.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case localcredential.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case localcredential.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				_m.PasswordHash = value.String
+			}
+		case localcredential.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case localcredential.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case localcredential.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_local_credential", value)
+			} else if value.Valid {
+				_m.user_local_credential = new(int)
+				*_m.user_local_credential = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LocalCredential.
+// This includes values selected through modifiers, order, etc.
+func (_m *LocalCredential) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LocalCredential entity.
+func (_m *LocalCredential) QueryUser() *UserQuery {
+	return NewLocalCredentialClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LocalCredential.
+// Note that you need to call LocalCredential.Unwrap() before calling this method if this LocalCredential
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LocalCredential) Update() *LocalCredentialUpdateOne {
+	return NewLocalCredentialClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LocalCredential entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LocalCredential) Unwrap() *LocalCredential {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LocalCredential is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LocalCredential) String() string {
+	var builder strings.Builder
+	builder.WriteString("LocalCredential(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LocalCredentials is a parsable slice of LocalCredential.
+type LocalCredentials []*LocalCredential
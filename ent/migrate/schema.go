@@ -13,8 +13,12 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "access", Type: field.TypeString, Size: 10, Default: "write"},
+		{Name: "household_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_used", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Size: 64, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "user_api_tokens", Type: field.TypeInt},
 	}
@@ -26,17 +30,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_tokens_users_api_tokens",
-				Columns:    []*schema.Column{APITokensColumns[6]},
+				Columns:    []*schema.Column{APITokensColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// AggregateGenerationsColumns holds the columns for the "aggregate_generations" table.
+	AggregateGenerationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "generation", Type: field.TypeInt, Default: 0},
+		{Name: "household_aggregate_generation", Type: field.TypeInt, Unique: true},
+	}
+	// AggregateGenerationsTable holds the schema information for the "aggregate_generations" table.
+	AggregateGenerationsTable = &schema.Table{
+		Name:       "aggregate_generations",
+		Columns:    AggregateGenerationsColumns,
+		PrimaryKey: []*schema.Column{AggregateGenerationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "aggregate_generations_households_aggregate_generation",
+				Columns:    []*schema.Column{AggregateGenerationsColumns[2]},
+				RefColumns: []*schema.Column{HouseholdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 50},
 		{Name: "icon", Type: field.TypeString, Nullable: true, Size: 50, Default: "category"},
+		{Name: "tax_class", Type: field.TypeString, Nullable: true, Size: 50},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "household_categories", Type: field.TypeInt},
@@ -49,7 +74,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "categories_households_categories",
-				Columns:    []*schema.Column{CategoriesColumns[5]},
+				Columns:    []*schema.Column{CategoriesColumns[6]},
 				RefColumns: []*schema.Column{HouseholdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -58,7 +83,7 @@ var (
 			{
 				Name:    "category_name_household_categories",
 				Unique:  true,
-				Columns: []*schema.Column{CategoriesColumns[1], CategoriesColumns[5]},
+				Columns: []*schema.Column{CategoriesColumns[1], CategoriesColumns[6]},
 			},
 		},
 	}
@@ -71,6 +96,7 @@ var (
 		{Name: "icon", Type: field.TypeString, Nullable: true, Size: 50, Default: "home"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_households", Type: field.TypeInt},
 	}
 	// HouseholdsTable holds the schema information for the "households" table.
@@ -81,11 +107,160 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "households_users_households",
-				Columns:    []*schema.Column{HouseholdsColumns[7]},
+				Columns:    []*schema.Column{HouseholdsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "household_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{HouseholdsColumns[7]},
+			},
+		},
+	}
+	// HouseholdMembersColumns holds the columns for the "household_members" table.
+	HouseholdMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "household_members", Type: field.TypeInt},
+	}
+	// HouseholdMembersTable holds the schema information for the "household_members" table.
+	HouseholdMembersTable = &schema.Table{
+		Name:       "household_members",
+		Columns:    HouseholdMembersColumns,
+		PrimaryKey: []*schema.Column{HouseholdMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "household_members_households_members",
+				Columns:    []*schema.Column{HouseholdMembersColumns[4]},
+				RefColumns: []*schema.Column{HouseholdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "householdmember_name_household_members",
+				Unique:  true,
+				Columns: []*schema.Column{HouseholdMembersColumns[1], HouseholdMembersColumns[4]},
+			},
+		},
+	}
+	// HouseholdTransfersColumns holds the columns for the "household_transfers" table.
+	HouseholdTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "household_transfer", Type: field.TypeInt, Unique: true},
+		{Name: "user_household_transfers", Type: field.TypeInt},
+	}
+	// HouseholdTransfersTable holds the schema information for the "household_transfers" table.
+	HouseholdTransfersTable = &schema.Table{
+		Name:       "household_transfers",
+		Columns:    HouseholdTransfersColumns,
+		PrimaryKey: []*schema.Column{HouseholdTransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "household_transfers_households_transfer",
+				Columns:    []*schema.Column{HouseholdTransfersColumns[3]},
+				RefColumns: []*schema.Column{HouseholdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "household_transfers_users_household_transfers",
+				Columns:    []*schema.Column{HouseholdTransfersColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "householdtransfer_email",
+				Unique:  false,
+				Columns: []*schema.Column{HouseholdTransfersColumns[1]},
+			},
+		},
+	}
+	// LocalCredentialsColumns holds the columns for the "local_credentials" table.
+	LocalCredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_local_credential", Type: field.TypeInt, Unique: true},
+	}
+	// LocalCredentialsTable holds the schema information for the "local_credentials" table.
+	LocalCredentialsTable = &schema.Table{
+		Name:       "local_credentials",
+		Columns:    LocalCredentialsColumns,
+		PrimaryKey: []*schema.Column{LocalCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "local_credentials_users_local_credential",
+				Columns:    []*schema.Column{LocalCredentialsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// MonthlyAggregatesColumns holds the columns for the "monthly_aggregates" table.
+	MonthlyAggregatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "month", Type: field.TypeString, Size: 7},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "gross_income", Type: field.TypeInt64},
+		{Name: "gross_expenses", Type: field.TypeInt64},
+		{Name: "recurring_total", Type: field.TypeInt64},
+		{Name: "one_time_total", Type: field.TypeInt64},
+		{Name: "monthly_total", Type: field.TypeInt64},
+		{Name: "categories", Type: field.TypeJSON},
+		{Name: "computed_at", Type: field.TypeTime},
+		{Name: "household_monthly_aggregates", Type: field.TypeInt},
+	}
+	// MonthlyAggregatesTable holds the schema information for the "monthly_aggregates" table.
+	MonthlyAggregatesTable = &schema.Table{
+		Name:       "monthly_aggregates",
+		Columns:    MonthlyAggregatesColumns,
+		PrimaryKey: []*schema.Column{MonthlyAggregatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "monthly_aggregates_households_monthly_aggregates",
+				Columns:    []*schema.Column{MonthlyAggregatesColumns[10]},
+				RefColumns: []*schema.Column{HouseholdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "monthlyaggregate_month_household_monthly_aggregates",
+				Unique:  true,
+				Columns: []*schema.Column{MonthlyAggregatesColumns[1], MonthlyAggregatesColumns[10]},
+			},
+		},
+	}
+	// RateLimitsColumns holds the columns for the "rate_limits" table.
+	RateLimitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true, Size: 128},
+		{Name: "count", Type: field.TypeInt, Default: 0},
+		{Name: "reset_at", Type: field.TypeTime},
+	}
+	// RateLimitsTable holds the schema information for the "rate_limits" table.
+	RateLimitsTable = &schema.Table{
+		Name:       "rate_limits",
+		Columns:    RateLimitsColumns,
+		PrimaryKey: []*schema.Column{RateLimitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratelimit_reset_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateLimitsColumns[3]},
+			},
+		},
 	}
 	// RecurringExpensesColumns holds the columns for the "recurring_expenses" table.
 	RecurringExpensesColumns = []*schema.Column{
@@ -93,15 +268,20 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500, Default: ""},
 		{Name: "details", Type: field.TypeString, Nullable: true, Size: 5000, Default: ""},
-		{Name: "amount", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeInt64},
 		{Name: "frequency", Type: field.TypeString},
 		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "deactivated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "start_date", Type: field.TypeTime},
 		{Name: "end_date", Type: field.TypeTime, Nullable: true},
+		{Name: "split_type", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "split_shares", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "category_recurring_expenses", Type: field.TypeInt},
 		{Name: "household_recurring_expenses", Type: field.TypeInt},
+		{Name: "household_member_paid_recurring_expenses", Type: field.TypeInt, Nullable: true},
 	}
 	// RecurringExpensesTable holds the schema information for the "recurring_expenses" table.
 	RecurringExpensesTable = &schema.Table{
@@ -111,23 +291,36 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "recurring_expenses_categories_recurring_expenses",
-				Columns:    []*schema.Column{RecurringExpensesColumns[11]},
+				Columns:    []*schema.Column{RecurringExpensesColumns[15]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "recurring_expenses_households_recurring_expenses",
-				Columns:    []*schema.Column{RecurringExpensesColumns[12]},
+				Columns:    []*schema.Column{RecurringExpensesColumns[16]},
 				RefColumns: []*schema.Column{HouseholdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "recurring_expenses_household_members_paid_recurring_expenses",
+				Columns:    []*schema.Column{RecurringExpensesColumns[17]},
+				RefColumns: []*schema.Column{HouseholdMembersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recurringexpense_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecurringExpensesColumns[14]},
+			},
 		},
 	}
 	// RecurringScheduleOverridesColumns holds the columns for the "recurring_schedule_overrides" table.
 	RecurringScheduleOverridesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "effective_date", Type: field.TypeTime},
-		{Name: "amount", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeInt64},
 		{Name: "frequency", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -147,6 +340,59 @@ var (
 			},
 		},
 	}
+	// RevisionsColumns holds the columns for the "revisions" table.
+	RevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entity_type", Type: field.TypeString, Size: 32},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "household_id", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeString, Size: 16},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RevisionsTable holds the schema information for the "revisions" table.
+	RevisionsTable = &schema.Table{
+		Name:       "revisions",
+		Columns:    RevisionsColumns,
+		PrimaryKey: []*schema.Column{RevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revision_household_id_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{RevisionsColumns[3], RevisionsColumns[1], RevisionsColumns[2]},
+			},
+		},
+	}
+	// SecurityEventsColumns holds the columns for the "security_events" table.
+	SecurityEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Size: 32},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SecurityEventsTable holds the schema information for the "security_events" table.
+	SecurityEventsTable = &schema.Table{
+		Name:       "security_events",
+		Columns:    SecurityEventsColumns,
+		PrimaryKey: []*schema.Column{SecurityEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityevent_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[2]},
+			},
+			{
+				Name:    "securityevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[6]},
+			},
+		},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -154,6 +400,12 @@ var (
 		{Name: "data", Type: field.TypeBytes},
 		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "oidc_issuer", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "oidc_subject", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "oidc_sid", Type: field.TypeString, Size: 255, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -168,6 +420,21 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SessionsColumns[4]},
 			},
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[3]},
+			},
+			{
+				Name:    "session_oidc_subject",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[9]},
+			},
+			{
+				Name:    "session_oidc_sid",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[10]},
+			},
 		},
 	}
 	// SettingsColumns holds the columns for the "settings" table.
@@ -184,17 +451,66 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// SettlementsColumns holds the columns for the "settlements" table.
+	SettlementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 500, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "household_settlements", Type: field.TypeInt},
+		{Name: "household_member_settlements_paid", Type: field.TypeInt},
+		{Name: "household_member_settlements_received", Type: field.TypeInt},
+	}
+	// SettlementsTable holds the schema information for the "settlements" table.
+	SettlementsTable = &schema.Table{
+		Name:       "settlements",
+		Columns:    SettlementsColumns,
+		PrimaryKey: []*schema.Column{SettlementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "settlements_households_settlements",
+				Columns:    []*schema.Column{SettlementsColumns[5]},
+				RefColumns: []*schema.Column{HouseholdsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "settlements_household_members_settlements_paid",
+				Columns:    []*schema.Column{SettlementsColumns[6]},
+				RefColumns: []*schema.Column{HouseholdMembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "settlements_household_members_settlements_received",
+				Columns:    []*schema.Column{SettlementsColumns[7]},
+				RefColumns: []*schema.Column{HouseholdMembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlement_date_household_settlements",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementsColumns[2], SettlementsColumns[5]},
+			},
+		},
+	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "amount", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeInt64},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "details", Type: field.TypeString, Nullable: true, Size: 5000},
 		{Name: "date", Type: field.TypeTime},
+		{Name: "tax_class", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "split_type", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "split_shares", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "category_transactions", Type: field.TypeInt},
 		{Name: "household_transactions", Type: field.TypeInt},
+		{Name: "household_member_paid_transactions", Type: field.TypeInt, Nullable: true},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
 	TransactionsTable = &schema.Table{
@@ -204,22 +520,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "transactions_categories_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[7]},
+				Columns:    []*schema.Column{TransactionsColumns[11]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "transactions_households_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[8]},
+				Columns:    []*schema.Column{TransactionsColumns[12]},
 				RefColumns: []*schema.Column{HouseholdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "transactions_household_members_paid_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[13]},
+				RefColumns: []*schema.Column{HouseholdMembersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "transaction_date_household_transactions",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[4], TransactionsColumns[8]},
+				Columns: []*schema.Column{TransactionsColumns[4], TransactionsColumns[12]},
+			},
+			{
+				Name:    "transaction_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[10]},
 			},
 		},
 	}
@@ -229,6 +556,8 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "subject", Type: field.TypeString, Unique: true},
+		{Name: "admin", Type: field.TypeBool, Default: false},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -238,27 +567,79 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_identities", Type: field.TypeInt},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_identities_users_identities",
+				Columns:    []*schema.Column{UserIdentitiesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[1], UserIdentitiesColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APITokensTable,
+		AggregateGenerationsTable,
 		CategoriesTable,
 		HouseholdsTable,
+		HouseholdMembersTable,
+		HouseholdTransfersTable,
+		LocalCredentialsTable,
+		MonthlyAggregatesTable,
+		RateLimitsTable,
 		RecurringExpensesTable,
 		RecurringScheduleOverridesTable,
+		RevisionsTable,
+		SecurityEventsTable,
 		SessionsTable,
 		SettingsTable,
+		SettlementsTable,
 		TransactionsTable,
 		UsersTable,
+		UserIdentitiesTable,
 	}
 )
 
 func init() {
 	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	AggregateGenerationsTable.ForeignKeys[0].RefTable = HouseholdsTable
 	CategoriesTable.ForeignKeys[0].RefTable = HouseholdsTable
 	HouseholdsTable.ForeignKeys[0].RefTable = UsersTable
+	HouseholdMembersTable.ForeignKeys[0].RefTable = HouseholdsTable
+	HouseholdTransfersTable.ForeignKeys[0].RefTable = HouseholdsTable
+	HouseholdTransfersTable.ForeignKeys[1].RefTable = UsersTable
+	LocalCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	MonthlyAggregatesTable.ForeignKeys[0].RefTable = HouseholdsTable
 	RecurringExpensesTable.ForeignKeys[0].RefTable = CategoriesTable
 	RecurringExpensesTable.ForeignKeys[1].RefTable = HouseholdsTable
+	RecurringExpensesTable.ForeignKeys[2].RefTable = HouseholdMembersTable
 	RecurringScheduleOverridesTable.ForeignKeys[0].RefTable = RecurringExpensesTable
+	SettlementsTable.ForeignKeys[0].RefTable = HouseholdsTable
+	SettlementsTable.ForeignKeys[1].RefTable = HouseholdMembersTable
+	SettlementsTable.ForeignKeys[2].RefTable = HouseholdMembersTable
 	TransactionsTable.ForeignKeys[0].RefTable = CategoriesTable
 	TransactionsTable.ForeignKeys[1].RefTable = HouseholdsTable
+	TransactionsTable.ForeignKeys[2].RefTable = HouseholdMembersTable
+	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/schema"
+)
+
+// MonthlyAggregate is the model entity for the MonthlyAggregate schema.
+type MonthlyAggregate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Month holds the value of the "month" field.
+	Month string `json:"month,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// GrossIncome holds the value of the "gross_income" field.
+	GrossIncome int64 `json:"gross_income,omitempty"`
+	// GrossExpenses holds the value of the "gross_expenses" field.
+	GrossExpenses int64 `json:"gross_expenses,omitempty"`
+	// RecurringTotal holds the value of the "recurring_total" field.
+	RecurringTotal int64 `json:"recurring_total,omitempty"`
+	// OneTimeTotal holds the value of the "one_time_total" field.
+	OneTimeTotal int64 `json:"one_time_total,omitempty"`
+	// MonthlyTotal holds the value of the "monthly_total" field.
+	MonthlyTotal int64 `json:"monthly_total,omitempty"`
+	// Categories holds the value of the "categories" field.
+	Categories []schema.MonthlyAggregateCategory `json:"categories,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt time.Time `json:"computed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MonthlyAggregateQuery when eager-loading is set.
+	Edges                        MonthlyAggregateEdges `json:"edges"`
+	household_monthly_aggregates *int
+	selectValues                 sql.SelectValues
+}
+
+// MonthlyAggregateEdges holds the relations/edges for other nodes in the graph.
+type MonthlyAggregateEdges struct {
+	// Household holds the value of the household edge.
+	Household *Household `json:"household,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HouseholdOrErr returns the Household value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MonthlyAggregateEdges) HouseholdOrErr() (*Household, error) {
+	if e.Household != nil {
+		return e.Household, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: household.Label}
+	}
+	return nil, &NotLoadedError{edge: "household"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MonthlyAggregate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case monthlyaggregate.FieldCategories:
+			values[i] = new([]byte)
+		case monthlyaggregate.FieldID, monthlyaggregate.FieldVersion, monthlyaggregate.FieldGrossIncome, monthlyaggregate.FieldGrossExpenses, monthlyaggregate.FieldRecurringTotal, monthlyaggregate.FieldOneTimeTotal, monthlyaggregate.FieldMonthlyTotal:
+			values[i] = new(sql.NullInt64)
+		case monthlyaggregate.FieldMonth:
+			values[i] = new(sql.NullString)
+		case monthlyaggregate.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		case monthlyaggregate.ForeignKeys[0]: // household_monthly_aggregates
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MonthlyAggregate fields.
+func (_m *MonthlyAggregate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case monthlyaggregate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case monthlyaggregate.FieldMonth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field month", values[i])
+			} else if value.Valid {
+				_m.Month = value.String
+			}
+		case monthlyaggregate.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case monthlyaggregate.FieldGrossIncome:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gross_income", values[i])
+			} else if value.Valid {
+				_m.GrossIncome = value.Int64
+			}
+		case monthlyaggregate.FieldGrossExpenses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gross_expenses", values[i])
+			} else if value.Valid {
+				_m.GrossExpenses = value.Int64
+			}
+		case monthlyaggregate.FieldRecurringTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recurring_total", values[i])
+			} else if value.Valid {
+				_m.RecurringTotal = value.Int64
+			}
+		case monthlyaggregate.FieldOneTimeTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field one_time_total", values[i])
+			} else if value.Valid {
+				_m.OneTimeTotal = value.Int64
+			}
+		case monthlyaggregate.FieldMonthlyTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_total", values[i])
+			} else if value.Valid {
+				_m.MonthlyTotal = value.Int64
+			}
+		case monthlyaggregate.FieldCategories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field categories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Categories); err != nil {
+					return fmt.Errorf("unmarshal field categories: %w", err)
+				}
+			}
+		case monthlyaggregate.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				_m.ComputedAt = value.Time
+			}
+		case monthlyaggregate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_monthly_aggregates", value)
+			} else if value.Valid {
+				_m.household_monthly_aggregates = new(int)
+				*_m.household_monthly_aggregates = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MonthlyAggregate.
+// This includes values selected through modifiers, order, etc.
+func (_m *MonthlyAggregate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHousehold queries the "household" edge of the MonthlyAggregate entity.
+func (_m *MonthlyAggregate) QueryHousehold() *HouseholdQuery {
+	return NewMonthlyAggregateClient(_m.config).QueryHousehold(_m)
+}
+
+// Update returns a builder for updating this MonthlyAggregate.
+// Note that you need to call MonthlyAggregate.Unwrap() before calling this method if this MonthlyAggregate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MonthlyAggregate) Update() *MonthlyAggregateUpdateOne {
+	return NewMonthlyAggregateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MonthlyAggregate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MonthlyAggregate) Unwrap() *MonthlyAggregate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MonthlyAggregate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MonthlyAggregate) String() string {
+	var builder strings.Builder
+	builder.WriteString("MonthlyAggregate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("month=")
+	builder.WriteString(_m.Month)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("gross_income=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrossIncome))
+	builder.WriteString(", ")
+	builder.WriteString("gross_expenses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrossExpenses))
+	builder.WriteString(", ")
+	builder.WriteString("recurring_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecurringTotal))
+	builder.WriteString(", ")
+	builder.WriteString("one_time_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OneTimeTotal))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthlyTotal))
+	builder.WriteString(", ")
+	builder.WriteString("categories=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Categories))
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(_m.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MonthlyAggregates is a parsable slice of MonthlyAggregate.
+type MonthlyAggregates []*MonthlyAggregate
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monthlyaggregate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the monthlyaggregate type in the database.
+	Label = "monthly_aggregate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMonth holds the string denoting the month field in the database.
+	FieldMonth = "month"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldGrossIncome holds the string denoting the gross_income field in the database.
+	FieldGrossIncome = "gross_income"
+	// FieldGrossExpenses holds the string denoting the gross_expenses field in the database.
+	FieldGrossExpenses = "gross_expenses"
+	// FieldRecurringTotal holds the string denoting the recurring_total field in the database.
+	FieldRecurringTotal = "recurring_total"
+	// FieldOneTimeTotal holds the string denoting the one_time_total field in the database.
+	FieldOneTimeTotal = "one_time_total"
+	// FieldMonthlyTotal holds the string denoting the monthly_total field in the database.
+	FieldMonthlyTotal = "monthly_total"
+	// FieldCategories holds the string denoting the categories field in the database.
+	FieldCategories = "categories"
+	// FieldComputedAt holds the string denoting the computed_at field in the database.
+	FieldComputedAt = "computed_at"
+	// EdgeHousehold holds the string denoting the household edge name in mutations.
+	EdgeHousehold = "household"
+	// Table holds the table name of the monthlyaggregate in the database.
+	Table = "monthly_aggregates"
+	// HouseholdTable is the table that holds the household relation/edge.
+	HouseholdTable = "monthly_aggregates"
+	// HouseholdInverseTable is the table name for the Household entity.
+	// It exists in this package in order to avoid circular dependency with the "household" package.
+	HouseholdInverseTable = "households"
+	// HouseholdColumn is the table column denoting the household relation/edge.
+	HouseholdColumn = "household_monthly_aggregates"
+)
+
+// Columns holds all SQL columns for monthlyaggregate fields.
+var Columns = []string{
+	FieldID,
+	FieldMonth,
+	FieldVersion,
+	FieldGrossIncome,
+	FieldGrossExpenses,
+	FieldRecurringTotal,
+	FieldOneTimeTotal,
+	FieldMonthlyTotal,
+	FieldCategories,
+	FieldComputedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "monthly_aggregates"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"household_monthly_aggregates",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MonthValidator is a validator for the "month" field. It is called by the builders before save.
+	MonthValidator func(string) error
+	// DefaultComputedAt holds the default value on creation for the "computed_at" field.
+	DefaultComputedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the MonthlyAggregate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMonth orders the results by the month field.
+func ByMonth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonth, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByGrossIncome orders the results by the gross_income field.
+func ByGrossIncome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrossIncome, opts...).ToFunc()
+}
+
+// ByGrossExpenses orders the results by the gross_expenses field.
+func ByGrossExpenses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrossExpenses, opts...).ToFunc()
+}
+
+// ByRecurringTotal orders the results by the recurring_total field.
+func ByRecurringTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurringTotal, opts...).ToFunc()
+}
+
+// ByOneTimeTotal orders the results by the one_time_total field.
+func ByOneTimeTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOneTimeTotal, opts...).ToFunc()
+}
+
+// ByMonthlyTotal orders the results by the monthly_total field.
+func ByMonthlyTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyTotal, opts...).ToFunc()
+}
+
+// ByComputedAt orders the results by the computed_at field.
+func ByComputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputedAt, opts...).ToFunc()
+}
+
+// ByHouseholdField orders the results by household field.
+func ByHouseholdField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHouseholdStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHouseholdStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HouseholdInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HouseholdTable, HouseholdColumn),
+	)
+}
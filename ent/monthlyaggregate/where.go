@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monthlyaggregate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldID, id))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldMonth, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldVersion, v))
+}
+
+// GrossIncome applies equality check predicate on the "gross_income" field. It's identical to GrossIncomeEQ.
+func GrossIncome(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldGrossIncome, v))
+}
+
+// GrossExpenses applies equality check predicate on the "gross_expenses" field. It's identical to GrossExpensesEQ.
+func GrossExpenses(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldGrossExpenses, v))
+}
+
+// RecurringTotal applies equality check predicate on the "recurring_total" field. It's identical to RecurringTotalEQ.
+func RecurringTotal(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldRecurringTotal, v))
+}
+
+// OneTimeTotal applies equality check predicate on the "one_time_total" field. It's identical to OneTimeTotalEQ.
+func OneTimeTotal(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldOneTimeTotal, v))
+}
+
+// MonthlyTotal applies equality check predicate on the "monthly_total" field. It's identical to MonthlyTotalEQ.
+func MonthlyTotal(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldMonthlyTotal, v))
+}
+
+// ComputedAt applies equality check predicate on the "computed_at" field. It's identical to ComputedAtEQ.
+func ComputedAt(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldMonth, v))
+}
+
+// MonthContains applies the Contains predicate on the "month" field.
+func MonthContains(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldContains(FieldMonth, v))
+}
+
+// MonthHasPrefix applies the HasPrefix predicate on the "month" field.
+func MonthHasPrefix(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldHasPrefix(FieldMonth, v))
+}
+
+// MonthHasSuffix applies the HasSuffix predicate on the "month" field.
+func MonthHasSuffix(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldHasSuffix(FieldMonth, v))
+}
+
+// MonthEqualFold applies the EqualFold predicate on the "month" field.
+func MonthEqualFold(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEqualFold(FieldMonth, v))
+}
+
+// MonthContainsFold applies the ContainsFold predicate on the "month" field.
+func MonthContainsFold(v string) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldContainsFold(FieldMonth, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldVersion, v))
+}
+
+// GrossIncomeEQ applies the EQ predicate on the "gross_income" field.
+func GrossIncomeEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldGrossIncome, v))
+}
+
+// GrossIncomeNEQ applies the NEQ predicate on the "gross_income" field.
+func GrossIncomeNEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldGrossIncome, v))
+}
+
+// GrossIncomeIn applies the In predicate on the "gross_income" field.
+func GrossIncomeIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldGrossIncome, vs...))
+}
+
+// GrossIncomeNotIn applies the NotIn predicate on the "gross_income" field.
+func GrossIncomeNotIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldGrossIncome, vs...))
+}
+
+// GrossIncomeGT applies the GT predicate on the "gross_income" field.
+func GrossIncomeGT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldGrossIncome, v))
+}
+
+// GrossIncomeGTE applies the GTE predicate on the "gross_income" field.
+func GrossIncomeGTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldGrossIncome, v))
+}
+
+// GrossIncomeLT applies the LT predicate on the "gross_income" field.
+func GrossIncomeLT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldGrossIncome, v))
+}
+
+// GrossIncomeLTE applies the LTE predicate on the "gross_income" field.
+func GrossIncomeLTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldGrossIncome, v))
+}
+
+// GrossExpensesEQ applies the EQ predicate on the "gross_expenses" field.
+func GrossExpensesEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldGrossExpenses, v))
+}
+
+// GrossExpensesNEQ applies the NEQ predicate on the "gross_expenses" field.
+func GrossExpensesNEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldGrossExpenses, v))
+}
+
+// GrossExpensesIn applies the In predicate on the "gross_expenses" field.
+func GrossExpensesIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldGrossExpenses, vs...))
+}
+
+// GrossExpensesNotIn applies the NotIn predicate on the "gross_expenses" field.
+func GrossExpensesNotIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldGrossExpenses, vs...))
+}
+
+// GrossExpensesGT applies the GT predicate on the "gross_expenses" field.
+func GrossExpensesGT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldGrossExpenses, v))
+}
+
+// GrossExpensesGTE applies the GTE predicate on the "gross_expenses" field.
+func GrossExpensesGTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldGrossExpenses, v))
+}
+
+// GrossExpensesLT applies the LT predicate on the "gross_expenses" field.
+func GrossExpensesLT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldGrossExpenses, v))
+}
+
+// GrossExpensesLTE applies the LTE predicate on the "gross_expenses" field.
+func GrossExpensesLTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldGrossExpenses, v))
+}
+
+// RecurringTotalEQ applies the EQ predicate on the "recurring_total" field.
+func RecurringTotalEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldRecurringTotal, v))
+}
+
+// RecurringTotalNEQ applies the NEQ predicate on the "recurring_total" field.
+func RecurringTotalNEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldRecurringTotal, v))
+}
+
+// RecurringTotalIn applies the In predicate on the "recurring_total" field.
+func RecurringTotalIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldRecurringTotal, vs...))
+}
+
+// RecurringTotalNotIn applies the NotIn predicate on the "recurring_total" field.
+func RecurringTotalNotIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldRecurringTotal, vs...))
+}
+
+// RecurringTotalGT applies the GT predicate on the "recurring_total" field.
+func RecurringTotalGT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldRecurringTotal, v))
+}
+
+// RecurringTotalGTE applies the GTE predicate on the "recurring_total" field.
+func RecurringTotalGTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldRecurringTotal, v))
+}
+
+// RecurringTotalLT applies the LT predicate on the "recurring_total" field.
+func RecurringTotalLT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldRecurringTotal, v))
+}
+
+// RecurringTotalLTE applies the LTE predicate on the "recurring_total" field.
+func RecurringTotalLTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldRecurringTotal, v))
+}
+
+// OneTimeTotalEQ applies the EQ predicate on the "one_time_total" field.
+func OneTimeTotalEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldOneTimeTotal, v))
+}
+
+// OneTimeTotalNEQ applies the NEQ predicate on the "one_time_total" field.
+func OneTimeTotalNEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldOneTimeTotal, v))
+}
+
+// OneTimeTotalIn applies the In predicate on the "one_time_total" field.
+func OneTimeTotalIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldOneTimeTotal, vs...))
+}
+
+// OneTimeTotalNotIn applies the NotIn predicate on the "one_time_total" field.
+func OneTimeTotalNotIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldOneTimeTotal, vs...))
+}
+
+// OneTimeTotalGT applies the GT predicate on the "one_time_total" field.
+func OneTimeTotalGT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldOneTimeTotal, v))
+}
+
+// OneTimeTotalGTE applies the GTE predicate on the "one_time_total" field.
+func OneTimeTotalGTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldOneTimeTotal, v))
+}
+
+// OneTimeTotalLT applies the LT predicate on the "one_time_total" field.
+func OneTimeTotalLT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldOneTimeTotal, v))
+}
+
+// OneTimeTotalLTE applies the LTE predicate on the "one_time_total" field.
+func OneTimeTotalLTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldOneTimeTotal, v))
+}
+
+// MonthlyTotalEQ applies the EQ predicate on the "monthly_total" field.
+func MonthlyTotalEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldMonthlyTotal, v))
+}
+
+// MonthlyTotalNEQ applies the NEQ predicate on the "monthly_total" field.
+func MonthlyTotalNEQ(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldMonthlyTotal, v))
+}
+
+// MonthlyTotalIn applies the In predicate on the "monthly_total" field.
+func MonthlyTotalIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldMonthlyTotal, vs...))
+}
+
+// MonthlyTotalNotIn applies the NotIn predicate on the "monthly_total" field.
+func MonthlyTotalNotIn(vs ...int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldMonthlyTotal, vs...))
+}
+
+// MonthlyTotalGT applies the GT predicate on the "monthly_total" field.
+func MonthlyTotalGT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldMonthlyTotal, v))
+}
+
+// MonthlyTotalGTE applies the GTE predicate on the "monthly_total" field.
+func MonthlyTotalGTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldMonthlyTotal, v))
+}
+
+// MonthlyTotalLT applies the LT predicate on the "monthly_total" field.
+func MonthlyTotalLT(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldMonthlyTotal, v))
+}
+
+// MonthlyTotalLTE applies the LTE predicate on the "monthly_total" field.
+func MonthlyTotalLTE(v int64) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldMonthlyTotal, v))
+}
+
+// ComputedAtEQ applies the EQ predicate on the "computed_at" field.
+func ComputedAtEQ(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ComputedAtNEQ applies the NEQ predicate on the "computed_at" field.
+func ComputedAtNEQ(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNEQ(FieldComputedAt, v))
+}
+
+// ComputedAtIn applies the In predicate on the "computed_at" field.
+func ComputedAtIn(vs ...time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtNotIn applies the NotIn predicate on the "computed_at" field.
+func ComputedAtNotIn(vs ...time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldNotIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtGT applies the GT predicate on the "computed_at" field.
+func ComputedAtGT(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGT(FieldComputedAt, v))
+}
+
+// ComputedAtGTE applies the GTE predicate on the "computed_at" field.
+func ComputedAtGTE(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldGTE(FieldComputedAt, v))
+}
+
+// ComputedAtLT applies the LT predicate on the "computed_at" field.
+func ComputedAtLT(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLT(FieldComputedAt, v))
+}
+
+// ComputedAtLTE applies the LTE predicate on the "computed_at" field.
+func ComputedAtLTE(v time.Time) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.FieldLTE(FieldComputedAt, v))
+}
+
+// HasHousehold applies the HasEdge predicate on the "household" edge.
+func HasHousehold() predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HouseholdTable, HouseholdColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHouseholdWith applies the HasEdge predicate on the "household" edge with a given conditions (other predicates).
+func HasHouseholdWith(preds ...predicate.Household) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(func(s *sql.Selector) {
+		step := newHouseholdStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MonthlyAggregate) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MonthlyAggregate) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MonthlyAggregate) predicate.MonthlyAggregate {
+	return predicate.MonthlyAggregate(sql.NotPredicates(p))
+}
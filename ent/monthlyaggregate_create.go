@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/schema"
+)
+
+// MonthlyAggregateCreate is the builder for creating a MonthlyAggregate entity.
+type MonthlyAggregateCreate struct {
+	config
+	mutation *MonthlyAggregateMutation
+	hooks    []Hook
+}
+
+// SetMonth sets the "month" field.
+func (_c *MonthlyAggregateCreate) SetMonth(v string) *MonthlyAggregateCreate {
+	_c.mutation.SetMonth(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *MonthlyAggregateCreate) SetVersion(v int) *MonthlyAggregateCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetGrossIncome sets the "gross_income" field.
+func (_c *MonthlyAggregateCreate) SetGrossIncome(v int64) *MonthlyAggregateCreate {
+	_c.mutation.SetGrossIncome(v)
+	return _c
+}
+
+// SetGrossExpenses sets the "gross_expenses" field.
+func (_c *MonthlyAggregateCreate) SetGrossExpenses(v int64) *MonthlyAggregateCreate {
+	_c.mutation.SetGrossExpenses(v)
+	return _c
+}
+
+// SetRecurringTotal sets the "recurring_total" field.
+func (_c *MonthlyAggregateCreate) SetRecurringTotal(v int64) *MonthlyAggregateCreate {
+	_c.mutation.SetRecurringTotal(v)
+	return _c
+}
+
+// SetOneTimeTotal sets the "one_time_total" field.
+func (_c *MonthlyAggregateCreate) SetOneTimeTotal(v int64) *MonthlyAggregateCreate {
+	_c.mutation.SetOneTimeTotal(v)
+	return _c
+}
+
+// SetMonthlyTotal sets the "monthly_total" field.
+func (_c *MonthlyAggregateCreate) SetMonthlyTotal(v int64) *MonthlyAggregateCreate {
+	_c.mutation.SetMonthlyTotal(v)
+	return _c
+}
+
+// SetCategories sets the "categories" field.
+func (_c *MonthlyAggregateCreate) SetCategories(v []schema.MonthlyAggregateCategory) *MonthlyAggregateCreate {
+	_c.mutation.SetCategories(v)
+	return _c
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_c *MonthlyAggregateCreate) SetComputedAt(v time.Time) *MonthlyAggregateCreate {
+	_c.mutation.SetComputedAt(v)
+	return _c
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_c *MonthlyAggregateCreate) SetNillableComputedAt(v *time.Time) *MonthlyAggregateCreate {
+	if v != nil {
+		_c.SetComputedAt(*v)
+	}
+	return _c
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_c *MonthlyAggregateCreate) SetHouseholdID(id int) *MonthlyAggregateCreate {
+	_c.mutation.SetHouseholdID(id)
+	return _c
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_c *MonthlyAggregateCreate) SetHousehold(v *Household) *MonthlyAggregateCreate {
+	return _c.SetHouseholdID(v.ID)
+}
+
+// Mutation returns the MonthlyAggregateMutation object of the builder.
+func (_c *MonthlyAggregateCreate) Mutation() *MonthlyAggregateMutation {
+	return _c.mutation
+}
+
+// Save creates the MonthlyAggregate in the database.
+func (_c *MonthlyAggregateCreate) Save(ctx context.Context) (*MonthlyAggregate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MonthlyAggregateCreate) SaveX(ctx context.Context) *MonthlyAggregate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MonthlyAggregateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MonthlyAggregateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MonthlyAggregateCreate) defaults() {
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		v := monthlyaggregate.DefaultComputedAt()
+		_c.mutation.SetComputedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MonthlyAggregateCreate) check() error {
+	if _, ok := _c.mutation.Month(); !ok {
+		return &ValidationError{Name: "month", err: errors.New(`ent: missing required field "MonthlyAggregate.month"`)}
+	}
+	if v, ok := _c.mutation.Month(); ok {
+		if err := monthlyaggregate.MonthValidator(v); err != nil {
+			return &ValidationError{Name: "month", err: fmt.Errorf(`ent: validator failed for field "MonthlyAggregate.month": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "MonthlyAggregate.version"`)}
+	}
+	if _, ok := _c.mutation.GrossIncome(); !ok {
+		return &ValidationError{Name: "gross_income", err: errors.New(`ent: missing required field "MonthlyAggregate.gross_income"`)}
+	}
+	if _, ok := _c.mutation.GrossExpenses(); !ok {
+		return &ValidationError{Name: "gross_expenses", err: errors.New(`ent: missing required field "MonthlyAggregate.gross_expenses"`)}
+	}
+	if _, ok := _c.mutation.RecurringTotal(); !ok {
+		return &ValidationError{Name: "recurring_total", err: errors.New(`ent: missing required field "MonthlyAggregate.recurring_total"`)}
+	}
+	if _, ok := _c.mutation.OneTimeTotal(); !ok {
+		return &ValidationError{Name: "one_time_total", err: errors.New(`ent: missing required field "MonthlyAggregate.one_time_total"`)}
+	}
+	if _, ok := _c.mutation.MonthlyTotal(); !ok {
+		return &ValidationError{Name: "monthly_total", err: errors.New(`ent: missing required field "MonthlyAggregate.monthly_total"`)}
+	}
+	if _, ok := _c.mutation.Categories(); !ok {
+		return &ValidationError{Name: "categories", err: errors.New(`ent: missing required field "MonthlyAggregate.categories"`)}
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "MonthlyAggregate.computed_at"`)}
+	}
+	if len(_c.mutation.HouseholdIDs()) == 0 {
+		return &ValidationError{Name: "household", err: errors.New(`ent: missing required edge "MonthlyAggregate.household"`)}
+	}
+	return nil
+}
+
+func (_c *MonthlyAggregateCreate) sqlSave(ctx context.Context) (*MonthlyAggregate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MonthlyAggregateCreate) createSpec() (*MonthlyAggregate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MonthlyAggregate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(monthlyaggregate.Table, sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Month(); ok {
+		_spec.SetField(monthlyaggregate.FieldMonth, field.TypeString, value)
+		_node.Month = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(monthlyaggregate.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.GrossIncome(); ok {
+		_spec.SetField(monthlyaggregate.FieldGrossIncome, field.TypeInt64, value)
+		_node.GrossIncome = value
+	}
+	if value, ok := _c.mutation.GrossExpenses(); ok {
+		_spec.SetField(monthlyaggregate.FieldGrossExpenses, field.TypeInt64, value)
+		_node.GrossExpenses = value
+	}
+	if value, ok := _c.mutation.RecurringTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldRecurringTotal, field.TypeInt64, value)
+		_node.RecurringTotal = value
+	}
+	if value, ok := _c.mutation.OneTimeTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldOneTimeTotal, field.TypeInt64, value)
+		_node.OneTimeTotal = value
+	}
+	if value, ok := _c.mutation.MonthlyTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldMonthlyTotal, field.TypeInt64, value)
+		_node.MonthlyTotal = value
+	}
+	if value, ok := _c.mutation.Categories(); ok {
+		_spec.SetField(monthlyaggregate.FieldCategories, field.TypeJSON, value)
+		_node.Categories = value
+	}
+	if value, ok := _c.mutation.ComputedAt(); ok {
+		_spec.SetField(monthlyaggregate.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monthlyaggregate.HouseholdTable,
+			Columns: []string{monthlyaggregate.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_monthly_aggregates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MonthlyAggregateCreateBulk is the builder for creating many MonthlyAggregate entities in bulk.
+type MonthlyAggregateCreateBulk struct {
+	config
+	err      error
+	builders []*MonthlyAggregateCreate
+}
+
+// Save creates the MonthlyAggregate entities in the database.
+func (_c *MonthlyAggregateCreateBulk) Save(ctx context.Context) ([]*MonthlyAggregate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MonthlyAggregate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MonthlyAggregateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MonthlyAggregateCreateBulk) SaveX(ctx context.Context) []*MonthlyAggregate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MonthlyAggregateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MonthlyAggregateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
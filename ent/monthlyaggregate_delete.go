@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/predicate"
+)
+
+// MonthlyAggregateDelete is the builder for deleting a MonthlyAggregate entity.
+type MonthlyAggregateDelete struct {
+	config
+	hooks    []Hook
+	mutation *MonthlyAggregateMutation
+}
+
+// Where appends a list predicates to the MonthlyAggregateDelete builder.
+func (_d *MonthlyAggregateDelete) Where(ps ...predicate.MonthlyAggregate) *MonthlyAggregateDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *MonthlyAggregateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MonthlyAggregateDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *MonthlyAggregateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(monthlyaggregate.Table, sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// MonthlyAggregateDeleteOne is the builder for deleting a single MonthlyAggregate entity.
+type MonthlyAggregateDeleteOne struct {
+	_d *MonthlyAggregateDelete
+}
+
+// Where appends a list predicates to the MonthlyAggregateDelete builder.
+func (_d *MonthlyAggregateDeleteOne) Where(ps ...predicate.MonthlyAggregate) *MonthlyAggregateDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *MonthlyAggregateDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{monthlyaggregate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *MonthlyAggregateDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
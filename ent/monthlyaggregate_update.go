@@ -0,0 +1,751 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/schema"
+)
+
+// MonthlyAggregateUpdate is the builder for updating MonthlyAggregate entities.
+type MonthlyAggregateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MonthlyAggregateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MonthlyAggregateUpdate builder.
+func (_u *MonthlyAggregateUpdate) Where(ps ...predicate.MonthlyAggregate) *MonthlyAggregateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMonth sets the "month" field.
+func (_u *MonthlyAggregateUpdate) SetMonth(v string) *MonthlyAggregateUpdate {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableMonth(v *string) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *MonthlyAggregateUpdate) SetVersion(v int) *MonthlyAggregateUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableVersion(v *int) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *MonthlyAggregateUpdate) AddVersion(v int) *MonthlyAggregateUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetGrossIncome sets the "gross_income" field.
+func (_u *MonthlyAggregateUpdate) SetGrossIncome(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.ResetGrossIncome()
+	_u.mutation.SetGrossIncome(v)
+	return _u
+}
+
+// SetNillableGrossIncome sets the "gross_income" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableGrossIncome(v *int64) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetGrossIncome(*v)
+	}
+	return _u
+}
+
+// AddGrossIncome adds value to the "gross_income" field.
+func (_u *MonthlyAggregateUpdate) AddGrossIncome(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.AddGrossIncome(v)
+	return _u
+}
+
+// SetGrossExpenses sets the "gross_expenses" field.
+func (_u *MonthlyAggregateUpdate) SetGrossExpenses(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.ResetGrossExpenses()
+	_u.mutation.SetGrossExpenses(v)
+	return _u
+}
+
+// SetNillableGrossExpenses sets the "gross_expenses" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableGrossExpenses(v *int64) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetGrossExpenses(*v)
+	}
+	return _u
+}
+
+// AddGrossExpenses adds value to the "gross_expenses" field.
+func (_u *MonthlyAggregateUpdate) AddGrossExpenses(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.AddGrossExpenses(v)
+	return _u
+}
+
+// SetRecurringTotal sets the "recurring_total" field.
+func (_u *MonthlyAggregateUpdate) SetRecurringTotal(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.ResetRecurringTotal()
+	_u.mutation.SetRecurringTotal(v)
+	return _u
+}
+
+// SetNillableRecurringTotal sets the "recurring_total" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableRecurringTotal(v *int64) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetRecurringTotal(*v)
+	}
+	return _u
+}
+
+// AddRecurringTotal adds value to the "recurring_total" field.
+func (_u *MonthlyAggregateUpdate) AddRecurringTotal(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.AddRecurringTotal(v)
+	return _u
+}
+
+// SetOneTimeTotal sets the "one_time_total" field.
+func (_u *MonthlyAggregateUpdate) SetOneTimeTotal(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.ResetOneTimeTotal()
+	_u.mutation.SetOneTimeTotal(v)
+	return _u
+}
+
+// SetNillableOneTimeTotal sets the "one_time_total" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableOneTimeTotal(v *int64) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetOneTimeTotal(*v)
+	}
+	return _u
+}
+
+// AddOneTimeTotal adds value to the "one_time_total" field.
+func (_u *MonthlyAggregateUpdate) AddOneTimeTotal(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.AddOneTimeTotal(v)
+	return _u
+}
+
+// SetMonthlyTotal sets the "monthly_total" field.
+func (_u *MonthlyAggregateUpdate) SetMonthlyTotal(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.ResetMonthlyTotal()
+	_u.mutation.SetMonthlyTotal(v)
+	return _u
+}
+
+// SetNillableMonthlyTotal sets the "monthly_total" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableMonthlyTotal(v *int64) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetMonthlyTotal(*v)
+	}
+	return _u
+}
+
+// AddMonthlyTotal adds value to the "monthly_total" field.
+func (_u *MonthlyAggregateUpdate) AddMonthlyTotal(v int64) *MonthlyAggregateUpdate {
+	_u.mutation.AddMonthlyTotal(v)
+	return _u
+}
+
+// SetCategories sets the "categories" field.
+func (_u *MonthlyAggregateUpdate) SetCategories(v []schema.MonthlyAggregateCategory) *MonthlyAggregateUpdate {
+	_u.mutation.SetCategories(v)
+	return _u
+}
+
+// AppendCategories appends value to the "categories" field.
+func (_u *MonthlyAggregateUpdate) AppendCategories(v []schema.MonthlyAggregateCategory) *MonthlyAggregateUpdate {
+	_u.mutation.AppendCategories(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *MonthlyAggregateUpdate) SetComputedAt(v time.Time) *MonthlyAggregateUpdate {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdate) SetNillableComputedAt(v *time.Time) *MonthlyAggregateUpdate {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *MonthlyAggregateUpdate) SetHouseholdID(id int) *MonthlyAggregateUpdate {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *MonthlyAggregateUpdate) SetHousehold(v *Household) *MonthlyAggregateUpdate {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// Mutation returns the MonthlyAggregateMutation object of the builder.
+func (_u *MonthlyAggregateUpdate) Mutation() *MonthlyAggregateMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *MonthlyAggregateUpdate) ClearHousehold() *MonthlyAggregateUpdate {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MonthlyAggregateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonthlyAggregateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MonthlyAggregateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonthlyAggregateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonthlyAggregateUpdate) check() error {
+	if v, ok := _u.mutation.Month(); ok {
+		if err := monthlyaggregate.MonthValidator(v); err != nil {
+			return &ValidationError{Name: "month", err: fmt.Errorf(`ent: validator failed for field "MonthlyAggregate.month": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonthlyAggregate.household"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MonthlyAggregateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MonthlyAggregateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MonthlyAggregateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monthlyaggregate.Table, monthlyaggregate.Columns, sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(monthlyaggregate.FieldMonth, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(monthlyaggregate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(monthlyaggregate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GrossIncome(); ok {
+		_spec.SetField(monthlyaggregate.FieldGrossIncome, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossIncome(); ok {
+		_spec.AddField(monthlyaggregate.FieldGrossIncome, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GrossExpenses(); ok {
+		_spec.SetField(monthlyaggregate.FieldGrossExpenses, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossExpenses(); ok {
+		_spec.AddField(monthlyaggregate.FieldGrossExpenses, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecurringTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldRecurringTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRecurringTotal(); ok {
+		_spec.AddField(monthlyaggregate.FieldRecurringTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OneTimeTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldOneTimeTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOneTimeTotal(); ok {
+		_spec.AddField(monthlyaggregate.FieldOneTimeTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MonthlyTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldMonthlyTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyTotal(); ok {
+		_spec.AddField(monthlyaggregate.FieldMonthlyTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Categories(); ok {
+		_spec.SetField(monthlyaggregate.FieldCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monthlyaggregate.FieldCategories, value)
+		})
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(monthlyaggregate.FieldComputedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monthlyaggregate.HouseholdTable,
+			Columns: []string{monthlyaggregate.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monthlyaggregate.HouseholdTable,
+			Columns: []string{monthlyaggregate.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monthlyaggregate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MonthlyAggregateUpdateOne is the builder for updating a single MonthlyAggregate entity.
+type MonthlyAggregateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MonthlyAggregateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMonth sets the "month" field.
+func (_u *MonthlyAggregateUpdateOne) SetMonth(v string) *MonthlyAggregateUpdateOne {
+	_u.mutation.SetMonth(v)
+	return _u
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableMonth(v *string) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetMonth(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *MonthlyAggregateUpdateOne) SetVersion(v int) *MonthlyAggregateUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableVersion(v *int) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *MonthlyAggregateUpdateOne) AddVersion(v int) *MonthlyAggregateUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetGrossIncome sets the "gross_income" field.
+func (_u *MonthlyAggregateUpdateOne) SetGrossIncome(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.ResetGrossIncome()
+	_u.mutation.SetGrossIncome(v)
+	return _u
+}
+
+// SetNillableGrossIncome sets the "gross_income" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableGrossIncome(v *int64) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetGrossIncome(*v)
+	}
+	return _u
+}
+
+// AddGrossIncome adds value to the "gross_income" field.
+func (_u *MonthlyAggregateUpdateOne) AddGrossIncome(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.AddGrossIncome(v)
+	return _u
+}
+
+// SetGrossExpenses sets the "gross_expenses" field.
+func (_u *MonthlyAggregateUpdateOne) SetGrossExpenses(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.ResetGrossExpenses()
+	_u.mutation.SetGrossExpenses(v)
+	return _u
+}
+
+// SetNillableGrossExpenses sets the "gross_expenses" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableGrossExpenses(v *int64) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetGrossExpenses(*v)
+	}
+	return _u
+}
+
+// AddGrossExpenses adds value to the "gross_expenses" field.
+func (_u *MonthlyAggregateUpdateOne) AddGrossExpenses(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.AddGrossExpenses(v)
+	return _u
+}
+
+// SetRecurringTotal sets the "recurring_total" field.
+func (_u *MonthlyAggregateUpdateOne) SetRecurringTotal(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.ResetRecurringTotal()
+	_u.mutation.SetRecurringTotal(v)
+	return _u
+}
+
+// SetNillableRecurringTotal sets the "recurring_total" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableRecurringTotal(v *int64) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetRecurringTotal(*v)
+	}
+	return _u
+}
+
+// AddRecurringTotal adds value to the "recurring_total" field.
+func (_u *MonthlyAggregateUpdateOne) AddRecurringTotal(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.AddRecurringTotal(v)
+	return _u
+}
+
+// SetOneTimeTotal sets the "one_time_total" field.
+func (_u *MonthlyAggregateUpdateOne) SetOneTimeTotal(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.ResetOneTimeTotal()
+	_u.mutation.SetOneTimeTotal(v)
+	return _u
+}
+
+// SetNillableOneTimeTotal sets the "one_time_total" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableOneTimeTotal(v *int64) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetOneTimeTotal(*v)
+	}
+	return _u
+}
+
+// AddOneTimeTotal adds value to the "one_time_total" field.
+func (_u *MonthlyAggregateUpdateOne) AddOneTimeTotal(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.AddOneTimeTotal(v)
+	return _u
+}
+
+// SetMonthlyTotal sets the "monthly_total" field.
+func (_u *MonthlyAggregateUpdateOne) SetMonthlyTotal(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.ResetMonthlyTotal()
+	_u.mutation.SetMonthlyTotal(v)
+	return _u
+}
+
+// SetNillableMonthlyTotal sets the "monthly_total" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableMonthlyTotal(v *int64) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetMonthlyTotal(*v)
+	}
+	return _u
+}
+
+// AddMonthlyTotal adds value to the "monthly_total" field.
+func (_u *MonthlyAggregateUpdateOne) AddMonthlyTotal(v int64) *MonthlyAggregateUpdateOne {
+	_u.mutation.AddMonthlyTotal(v)
+	return _u
+}
+
+// SetCategories sets the "categories" field.
+func (_u *MonthlyAggregateUpdateOne) SetCategories(v []schema.MonthlyAggregateCategory) *MonthlyAggregateUpdateOne {
+	_u.mutation.SetCategories(v)
+	return _u
+}
+
+// AppendCategories appends value to the "categories" field.
+func (_u *MonthlyAggregateUpdateOne) AppendCategories(v []schema.MonthlyAggregateCategory) *MonthlyAggregateUpdateOne {
+	_u.mutation.AppendCategories(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *MonthlyAggregateUpdateOne) SetComputedAt(v time.Time) *MonthlyAggregateUpdateOne {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *MonthlyAggregateUpdateOne) SetNillableComputedAt(v *time.Time) *MonthlyAggregateUpdateOne {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *MonthlyAggregateUpdateOne) SetHouseholdID(id int) *MonthlyAggregateUpdateOne {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *MonthlyAggregateUpdateOne) SetHousehold(v *Household) *MonthlyAggregateUpdateOne {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// Mutation returns the MonthlyAggregateMutation object of the builder.
+func (_u *MonthlyAggregateUpdateOne) Mutation() *MonthlyAggregateMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *MonthlyAggregateUpdateOne) ClearHousehold() *MonthlyAggregateUpdateOne {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// Where appends a list predicates to the MonthlyAggregateUpdate builder.
+func (_u *MonthlyAggregateUpdateOne) Where(ps ...predicate.MonthlyAggregate) *MonthlyAggregateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MonthlyAggregateUpdateOne) Select(field string, fields ...string) *MonthlyAggregateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MonthlyAggregate entity.
+func (_u *MonthlyAggregateUpdateOne) Save(ctx context.Context) (*MonthlyAggregate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonthlyAggregateUpdateOne) SaveX(ctx context.Context) *MonthlyAggregate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MonthlyAggregateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonthlyAggregateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonthlyAggregateUpdateOne) check() error {
+	if v, ok := _u.mutation.Month(); ok {
+		if err := monthlyaggregate.MonthValidator(v); err != nil {
+			return &ValidationError{Name: "month", err: fmt.Errorf(`ent: validator failed for field "MonthlyAggregate.month": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonthlyAggregate.household"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MonthlyAggregateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MonthlyAggregateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MonthlyAggregateUpdateOne) sqlSave(ctx context.Context) (_node *MonthlyAggregate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monthlyaggregate.Table, monthlyaggregate.Columns, sqlgraph.NewFieldSpec(monthlyaggregate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MonthlyAggregate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, monthlyaggregate.FieldID)
+		for _, f := range fields {
+			if !monthlyaggregate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != monthlyaggregate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Month(); ok {
+		_spec.SetField(monthlyaggregate.FieldMonth, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(monthlyaggregate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(monthlyaggregate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GrossIncome(); ok {
+		_spec.SetField(monthlyaggregate.FieldGrossIncome, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossIncome(); ok {
+		_spec.AddField(monthlyaggregate.FieldGrossIncome, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GrossExpenses(); ok {
+		_spec.SetField(monthlyaggregate.FieldGrossExpenses, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGrossExpenses(); ok {
+		_spec.AddField(monthlyaggregate.FieldGrossExpenses, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RecurringTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldRecurringTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRecurringTotal(); ok {
+		_spec.AddField(monthlyaggregate.FieldRecurringTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OneTimeTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldOneTimeTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOneTimeTotal(); ok {
+		_spec.AddField(monthlyaggregate.FieldOneTimeTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MonthlyTotal(); ok {
+		_spec.SetField(monthlyaggregate.FieldMonthlyTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyTotal(); ok {
+		_spec.AddField(monthlyaggregate.FieldMonthlyTotal, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Categories(); ok {
+		_spec.SetField(monthlyaggregate.FieldCategories, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monthlyaggregate.FieldCategories, value)
+		})
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(monthlyaggregate.FieldComputedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monthlyaggregate.HouseholdTable,
+			Columns: []string{monthlyaggregate.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monthlyaggregate.HouseholdTable,
+			Columns: []string{monthlyaggregate.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MonthlyAggregate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monthlyaggregate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
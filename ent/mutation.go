@@ -11,16 +11,27 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/apitoken"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/localcredential"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
 	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/ratelimit"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/revision"
+	"icekalt.dev/money-tracker/ent/schema"
+	"icekalt.dev/money-tracker/ent/securityevent"
 	"icekalt.dev/money-tracker/ent/session"
 	"icekalt.dev/money-tracker/ent/settings"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/ent/useridentity"
 )
 
 const (
@@ -33,33 +44,48 @@ const (
 
 	// Node types.
 	TypeAPIToken                  = "APIToken"
+	TypeAggregateGeneration       = "AggregateGeneration"
 	TypeCategory                  = "Category"
 	TypeHousehold                 = "Household"
+	TypeHouseholdMember           = "HouseholdMember"
+	TypeHouseholdTransfer         = "HouseholdTransfer"
+	TypeLocalCredential           = "LocalCredential"
+	TypeMonthlyAggregate          = "MonthlyAggregate"
+	TypeRateLimit                 = "RateLimit"
 	TypeRecurringExpense          = "RecurringExpense"
 	TypeRecurringScheduleOverride = "RecurringScheduleOverride"
+	TypeRevision                  = "Revision"
+	TypeSecurityEvent             = "SecurityEvent"
 	TypeSession                   = "Session"
 	TypeSettings                  = "Settings"
+	TypeSettlement                = "Settlement"
 	TypeTransaction               = "Transaction"
 	TypeUser                      = "User"
+	TypeUserIdentity              = "UserIdentity"
 )
 
 // APITokenMutation represents an operation that mutates the APIToken nodes in the graph.
 type APITokenMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	token_hash    *string
-	expires_at    *time.Time
-	last_used     *time.Time
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*APIToken, error)
-	predicates    []predicate.APIToken
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	token_hash          *string
+	access              *string
+	household_ids       *[]int
+	appendhousehold_ids []int
+	expires_at          *time.Time
+	rotated_at          *time.Time
+	last_used           *time.Time
+	last_used_ip        *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*APIToken, error)
+	predicates          []predicate.APIToken
 }
 
 var _ ent.Mutation = (*APITokenMutation)(nil)
@@ -232,6 +258,107 @@ func (m *APITokenMutation) ResetTokenHash() {
 	m.token_hash = nil
 }
 
+// SetAccess sets the "access" field.
+func (m *APITokenMutation) SetAccess(s string) {
+	m.access = &s
+}
+
+// Access returns the value of the "access" field in the mutation.
+func (m *APITokenMutation) Access() (r string, exists bool) {
+	v := m.access
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccess returns the old "access" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APITokenMutation) OldAccess(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccess: %w", err)
+	}
+	return oldValue.Access, nil
+}
+
+// ResetAccess resets all changes to the "access" field.
+func (m *APITokenMutation) ResetAccess() {
+	m.access = nil
+}
+
+// SetHouseholdIds sets the "household_ids" field.
+func (m *APITokenMutation) SetHouseholdIds(i []int) {
+	m.household_ids = &i
+	m.appendhousehold_ids = nil
+}
+
+// HouseholdIds returns the value of the "household_ids" field in the mutation.
+func (m *APITokenMutation) HouseholdIds() (r []int, exists bool) {
+	v := m.household_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHouseholdIds returns the old "household_ids" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APITokenMutation) OldHouseholdIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHouseholdIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHouseholdIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHouseholdIds: %w", err)
+	}
+	return oldValue.HouseholdIds, nil
+}
+
+// AppendHouseholdIds adds i to the "household_ids" field.
+func (m *APITokenMutation) AppendHouseholdIds(i []int) {
+	m.appendhousehold_ids = append(m.appendhousehold_ids, i...)
+}
+
+// AppendedHouseholdIds returns the list of values that were appended to the "household_ids" field in this mutation.
+func (m *APITokenMutation) AppendedHouseholdIds() ([]int, bool) {
+	if len(m.appendhousehold_ids) == 0 {
+		return nil, false
+	}
+	return m.appendhousehold_ids, true
+}
+
+// ClearHouseholdIds clears the value of the "household_ids" field.
+func (m *APITokenMutation) ClearHouseholdIds() {
+	m.household_ids = nil
+	m.appendhousehold_ids = nil
+	m.clearedFields[apitoken.FieldHouseholdIds] = struct{}{}
+}
+
+// HouseholdIdsCleared returns if the "household_ids" field was cleared in this mutation.
+func (m *APITokenMutation) HouseholdIdsCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldHouseholdIds]
+	return ok
+}
+
+// ResetHouseholdIds resets all changes to the "household_ids" field.
+func (m *APITokenMutation) ResetHouseholdIds() {
+	m.household_ids = nil
+	m.appendhousehold_ids = nil
+	delete(m.clearedFields, apitoken.FieldHouseholdIds)
+}
+
 // SetExpiresAt sets the "expires_at" field.
 func (m *APITokenMutation) SetExpiresAt(t time.Time) {
 	m.expires_at = &t
@@ -281,6 +408,55 @@ func (m *APITokenMutation) ResetExpiresAt() {
 	delete(m.clearedFields, apitoken.FieldExpiresAt)
 }
 
+// SetRotatedAt sets the "rotated_at" field.
+func (m *APITokenMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
+}
+
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *APITokenMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotatedAt returns the old "rotated_at" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APITokenMutation) OldRotatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
+	}
+	return oldValue.RotatedAt, nil
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (m *APITokenMutation) ClearRotatedAt() {
+	m.rotated_at = nil
+	m.clearedFields[apitoken.FieldRotatedAt] = struct{}{}
+}
+
+// RotatedAtCleared returns if the "rotated_at" field was cleared in this mutation.
+func (m *APITokenMutation) RotatedAtCleared() bool {
+	_, ok := m.clearedFields[apitoken.FieldRotatedAt]
+	return ok
+}
+
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *APITokenMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+	delete(m.clearedFields, apitoken.FieldRotatedAt)
+}
+
 // SetLastUsed sets the "last_used" field.
 func (m *APITokenMutation) SetLastUsed(t time.Time) {
 	m.last_used = &t
@@ -330,6 +506,42 @@ func (m *APITokenMutation) ResetLastUsed() {
 	delete(m.clearedFields, apitoken.FieldLastUsed)
 }
 
+// SetLastUsedIP sets the "last_used_ip" field.
+func (m *APITokenMutation) SetLastUsedIP(s string) {
+	m.last_used_ip = &s
+}
+
+// LastUsedIP returns the value of the "last_used_ip" field in the mutation.
+func (m *APITokenMutation) LastUsedIP() (r string, exists bool) {
+	v := m.last_used_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedIP returns the old "last_used_ip" field's value of the APIToken entity.
+// If the APIToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APITokenMutation) OldLastUsedIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedIP: %w", err)
+	}
+	return oldValue.LastUsedIP, nil
+}
+
+// ResetLastUsedIP resets all changes to the "last_used_ip" field.
+func (m *APITokenMutation) ResetLastUsedIP() {
+	m.last_used_ip = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *APITokenMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -439,19 +651,31 @@ func (m *APITokenMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *APITokenMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 9)
 	if m.name != nil {
 		fields = append(fields, apitoken.FieldName)
 	}
 	if m.token_hash != nil {
 		fields = append(fields, apitoken.FieldTokenHash)
 	}
+	if m.access != nil {
+		fields = append(fields, apitoken.FieldAccess)
+	}
+	if m.household_ids != nil {
+		fields = append(fields, apitoken.FieldHouseholdIds)
+	}
 	if m.expires_at != nil {
 		fields = append(fields, apitoken.FieldExpiresAt)
 	}
+	if m.rotated_at != nil {
+		fields = append(fields, apitoken.FieldRotatedAt)
+	}
 	if m.last_used != nil {
 		fields = append(fields, apitoken.FieldLastUsed)
 	}
+	if m.last_used_ip != nil {
+		fields = append(fields, apitoken.FieldLastUsedIP)
+	}
 	if m.created_at != nil {
 		fields = append(fields, apitoken.FieldCreatedAt)
 	}
@@ -467,10 +691,18 @@ func (m *APITokenMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case apitoken.FieldTokenHash:
 		return m.TokenHash()
+	case apitoken.FieldAccess:
+		return m.Access()
+	case apitoken.FieldHouseholdIds:
+		return m.HouseholdIds()
 	case apitoken.FieldExpiresAt:
 		return m.ExpiresAt()
+	case apitoken.FieldRotatedAt:
+		return m.RotatedAt()
 	case apitoken.FieldLastUsed:
 		return m.LastUsed()
+	case apitoken.FieldLastUsedIP:
+		return m.LastUsedIP()
 	case apitoken.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -486,10 +718,18 @@ func (m *APITokenMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldName(ctx)
 	case apitoken.FieldTokenHash:
 		return m.OldTokenHash(ctx)
+	case apitoken.FieldAccess:
+		return m.OldAccess(ctx)
+	case apitoken.FieldHouseholdIds:
+		return m.OldHouseholdIds(ctx)
 	case apitoken.FieldExpiresAt:
 		return m.OldExpiresAt(ctx)
+	case apitoken.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
 	case apitoken.FieldLastUsed:
 		return m.OldLastUsed(ctx)
+	case apitoken.FieldLastUsedIP:
+		return m.OldLastUsedIP(ctx)
 	case apitoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -515,6 +755,20 @@ func (m *APITokenMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTokenHash(v)
 		return nil
+	case apitoken.FieldAccess:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccess(v)
+		return nil
+	case apitoken.FieldHouseholdIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHouseholdIds(v)
+		return nil
 	case apitoken.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -522,6 +776,13 @@ func (m *APITokenMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetExpiresAt(v)
 		return nil
+	case apitoken.FieldRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotatedAt(v)
+		return nil
 	case apitoken.FieldLastUsed:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -529,6 +790,13 @@ func (m *APITokenMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastUsed(v)
 		return nil
+	case apitoken.FieldLastUsedIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedIP(v)
+		return nil
 	case apitoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -566,9 +834,15 @@ func (m *APITokenMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *APITokenMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(apitoken.FieldHouseholdIds) {
+		fields = append(fields, apitoken.FieldHouseholdIds)
+	}
 	if m.FieldCleared(apitoken.FieldExpiresAt) {
 		fields = append(fields, apitoken.FieldExpiresAt)
 	}
+	if m.FieldCleared(apitoken.FieldRotatedAt) {
+		fields = append(fields, apitoken.FieldRotatedAt)
+	}
 	if m.FieldCleared(apitoken.FieldLastUsed) {
 		fields = append(fields, apitoken.FieldLastUsed)
 	}
@@ -586,9 +860,15 @@ func (m *APITokenMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *APITokenMutation) ClearField(name string) error {
 	switch name {
+	case apitoken.FieldHouseholdIds:
+		m.ClearHouseholdIds()
+		return nil
 	case apitoken.FieldExpiresAt:
 		m.ClearExpiresAt()
 		return nil
+	case apitoken.FieldRotatedAt:
+		m.ClearRotatedAt()
+		return nil
 	case apitoken.FieldLastUsed:
 		m.ClearLastUsed()
 		return nil
@@ -606,12 +886,24 @@ func (m *APITokenMutation) ResetField(name string) error {
 	case apitoken.FieldTokenHash:
 		m.ResetTokenHash()
 		return nil
+	case apitoken.FieldAccess:
+		m.ResetAccess()
+		return nil
+	case apitoken.FieldHouseholdIds:
+		m.ResetHouseholdIds()
+		return nil
 	case apitoken.FieldExpiresAt:
 		m.ResetExpiresAt()
 		return nil
+	case apitoken.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
 	case apitoken.FieldLastUsed:
 		m.ResetLastUsed()
 		return nil
+	case apitoken.FieldLastUsedIP:
+		m.ResetLastUsedIP()
+		return nil
 	case apitoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -693,41 +985,33 @@ func (m *APITokenMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown APIToken edge %s", name)
 }
 
-// CategoryMutation represents an operation that mutates the Category nodes in the graph.
-type CategoryMutation struct {
+// AggregateGenerationMutation represents an operation that mutates the AggregateGeneration nodes in the graph.
+type AggregateGenerationMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	name                      *string
-	icon                      *string
-	created_at                *time.Time
-	updated_at                *time.Time
-	clearedFields             map[string]struct{}
-	household                 *int
-	clearedhousehold          bool
-	transactions              map[int]struct{}
-	removedtransactions       map[int]struct{}
-	clearedtransactions       bool
-	recurring_expenses        map[int]struct{}
-	removedrecurring_expenses map[int]struct{}
-	clearedrecurring_expenses bool
-	done                      bool
-	oldValue                  func(context.Context) (*Category, error)
-	predicates                []predicate.Category
+	op               Op
+	typ              string
+	id               *int
+	generation       *int
+	addgeneration    *int
+	clearedFields    map[string]struct{}
+	household        *int
+	clearedhousehold bool
+	done             bool
+	oldValue         func(context.Context) (*AggregateGeneration, error)
+	predicates       []predicate.AggregateGeneration
 }
 
-var _ ent.Mutation = (*CategoryMutation)(nil)
+var _ ent.Mutation = (*AggregateGenerationMutation)(nil)
 
-// categoryOption allows management of the mutation configuration using functional options.
-type categoryOption func(*CategoryMutation)
+// aggregategenerationOption allows management of the mutation configuration using functional options.
+type aggregategenerationOption func(*AggregateGenerationMutation)
 
-// newCategoryMutation creates new mutation for the Category entity.
-func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
-	m := &CategoryMutation{
+// newAggregateGenerationMutation creates new mutation for the AggregateGeneration entity.
+func newAggregateGenerationMutation(c config, op Op, opts ...aggregategenerationOption) *AggregateGenerationMutation {
+	m := &AggregateGenerationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCategory,
+		typ:           TypeAggregateGeneration,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -736,20 +1020,20 @@ func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutat
 	return m
 }
 
-// withCategoryID sets the ID field of the mutation.
-func withCategoryID(id int) categoryOption {
-	return func(m *CategoryMutation) {
+// withAggregateGenerationID sets the ID field of the mutation.
+func withAggregateGenerationID(id int) aggregategenerationOption {
+	return func(m *AggregateGenerationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Category
+			value *AggregateGeneration
 		)
-		m.oldValue = func(ctx context.Context) (*Category, error) {
+		m.oldValue = func(ctx context.Context) (*AggregateGeneration, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Category.Get(ctx, id)
+					value, err = m.Client().AggregateGeneration.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -758,10 +1042,10 @@ func withCategoryID(id int) categoryOption {
 	}
 }
 
-// withCategory sets the old Category of the mutation.
-func withCategory(node *Category) categoryOption {
-	return func(m *CategoryMutation) {
-		m.oldValue = func(context.Context) (*Category, error) {
+// withAggregateGeneration sets the old AggregateGeneration of the mutation.
+func withAggregateGeneration(node *AggregateGeneration) aggregategenerationOption {
+	return func(m *AggregateGenerationMutation) {
+		m.oldValue = func(context.Context) (*AggregateGeneration, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -770,7 +1054,7 @@ func withCategory(node *Category) categoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CategoryMutation) Client() *Client {
+func (m AggregateGenerationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -778,7 +1062,7 @@ func (m CategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CategoryMutation) Tx() (*Tx, error) {
+func (m AggregateGenerationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -789,7 +1073,7 @@ func (m CategoryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CategoryMutation) ID() (id int, exists bool) {
+func (m *AggregateGenerationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -800,7 +1084,7 @@ func (m *CategoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AggregateGenerationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -809,186 +1093,85 @@ func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AggregateGeneration.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *CategoryMutation) SetName(s string) {
-	m.name = &s
+// SetGeneration sets the "generation" field.
+func (m *AggregateGenerationMutation) SetGeneration(i int) {
+	m.generation = &i
+	m.addgeneration = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// Generation returns the value of the "generation" field in the mutation.
+func (m *AggregateGenerationMutation) Generation() (r int, exists bool) {
+	v := m.generation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldGeneration returns the old "generation" field's value of the AggregateGeneration entity.
+// If the AggregateGeneration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AggregateGenerationMutation) OldGeneration(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldGeneration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldGeneration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldGeneration: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *CategoryMutation) ResetName() {
-	m.name = nil
+	return oldValue.Generation, nil
 }
 
-// SetIcon sets the "icon" field.
-func (m *CategoryMutation) SetIcon(s string) {
-	m.icon = &s
+// AddGeneration adds i to the "generation" field.
+func (m *AggregateGenerationMutation) AddGeneration(i int) {
+	if m.addgeneration != nil {
+		*m.addgeneration += i
+	} else {
+		m.addgeneration = &i
+	}
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *CategoryMutation) Icon() (r string, exists bool) {
-	v := m.icon
+// AddedGeneration returns the value that was added to the "generation" field in this mutation.
+func (m *AggregateGenerationMutation) AddedGeneration() (r int, exists bool) {
+	v := m.addgeneration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIcon returns the old "icon" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldIcon(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
-	}
-	return oldValue.Icon, nil
-}
-
-// ClearIcon clears the value of the "icon" field.
-func (m *CategoryMutation) ClearIcon() {
-	m.icon = nil
-	m.clearedFields[category.FieldIcon] = struct{}{}
+// ResetGeneration resets all changes to the "generation" field.
+func (m *AggregateGenerationMutation) ResetGeneration() {
+	m.generation = nil
+	m.addgeneration = nil
 }
 
-// IconCleared returns if the "icon" field was cleared in this mutation.
-func (m *CategoryMutation) IconCleared() bool {
-	_, ok := m.clearedFields[category.FieldIcon]
-	return ok
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *AggregateGenerationMutation) SetHouseholdID(id int) {
+	m.household = &id
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *CategoryMutation) ResetIcon() {
-	m.icon = nil
-	delete(m.clearedFields, category.FieldIcon)
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *AggregateGenerationMutation) ClearHousehold() {
+	m.clearedhousehold = true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CategoryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CategoryMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CategoryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetHouseholdID sets the "household" edge to the Household entity by id.
-func (m *CategoryMutation) SetHouseholdID(id int) {
-	m.household = &id
-}
-
-// ClearHousehold clears the "household" edge to the Household entity.
-func (m *CategoryMutation) ClearHousehold() {
-	m.clearedhousehold = true
-}
-
-// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
-func (m *CategoryMutation) HouseholdCleared() bool {
-	return m.clearedhousehold
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *AggregateGenerationMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
 }
 
 // HouseholdID returns the "household" edge ID in the mutation.
-func (m *CategoryMutation) HouseholdID() (id int, exists bool) {
+func (m *AggregateGenerationMutation) HouseholdID() (id int, exists bool) {
 	if m.household != nil {
 		return *m.household, true
 	}
@@ -998,7 +1181,7 @@ func (m *CategoryMutation) HouseholdID() (id int, exists bool) {
 // HouseholdIDs returns the "household" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // HouseholdID instead. It exists only for internal usage by the builders.
-func (m *CategoryMutation) HouseholdIDs() (ids []int) {
+func (m *AggregateGenerationMutation) HouseholdIDs() (ids []int) {
 	if id := m.household; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1006,128 +1189,20 @@ func (m *CategoryMutation) HouseholdIDs() (ids []int) {
 }
 
 // ResetHousehold resets all changes to the "household" edge.
-func (m *CategoryMutation) ResetHousehold() {
+func (m *AggregateGenerationMutation) ResetHousehold() {
 	m.household = nil
 	m.clearedhousehold = false
 }
 
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
-func (m *CategoryMutation) AddTransactionIDs(ids ...int) {
-	if m.transactions == nil {
-		m.transactions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.transactions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTransactions clears the "transactions" edge to the Transaction entity.
-func (m *CategoryMutation) ClearTransactions() {
-	m.clearedtransactions = true
-}
-
-// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
-func (m *CategoryMutation) TransactionsCleared() bool {
-	return m.clearedtransactions
-}
-
-// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
-func (m *CategoryMutation) RemoveTransactionIDs(ids ...int) {
-	if m.removedtransactions == nil {
-		m.removedtransactions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.transactions, ids[i])
-		m.removedtransactions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
-func (m *CategoryMutation) RemovedTransactionsIDs() (ids []int) {
-	for id := range m.removedtransactions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// TransactionsIDs returns the "transactions" edge IDs in the mutation.
-func (m *CategoryMutation) TransactionsIDs() (ids []int) {
-	for id := range m.transactions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetTransactions resets all changes to the "transactions" edge.
-func (m *CategoryMutation) ResetTransactions() {
-	m.transactions = nil
-	m.clearedtransactions = false
-	m.removedtransactions = nil
-}
-
-// AddRecurringExpenseIDs adds the "recurring_expenses" edge to the RecurringExpense entity by ids.
-func (m *CategoryMutation) AddRecurringExpenseIDs(ids ...int) {
-	if m.recurring_expenses == nil {
-		m.recurring_expenses = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.recurring_expenses[ids[i]] = struct{}{}
-	}
-}
-
-// ClearRecurringExpenses clears the "recurring_expenses" edge to the RecurringExpense entity.
-func (m *CategoryMutation) ClearRecurringExpenses() {
-	m.clearedrecurring_expenses = true
-}
-
-// RecurringExpensesCleared reports if the "recurring_expenses" edge to the RecurringExpense entity was cleared.
-func (m *CategoryMutation) RecurringExpensesCleared() bool {
-	return m.clearedrecurring_expenses
-}
-
-// RemoveRecurringExpenseIDs removes the "recurring_expenses" edge to the RecurringExpense entity by IDs.
-func (m *CategoryMutation) RemoveRecurringExpenseIDs(ids ...int) {
-	if m.removedrecurring_expenses == nil {
-		m.removedrecurring_expenses = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.recurring_expenses, ids[i])
-		m.removedrecurring_expenses[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedRecurringExpenses returns the removed IDs of the "recurring_expenses" edge to the RecurringExpense entity.
-func (m *CategoryMutation) RemovedRecurringExpensesIDs() (ids []int) {
-	for id := range m.removedrecurring_expenses {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// RecurringExpensesIDs returns the "recurring_expenses" edge IDs in the mutation.
-func (m *CategoryMutation) RecurringExpensesIDs() (ids []int) {
-	for id := range m.recurring_expenses {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetRecurringExpenses resets all changes to the "recurring_expenses" edge.
-func (m *CategoryMutation) ResetRecurringExpenses() {
-	m.recurring_expenses = nil
-	m.clearedrecurring_expenses = false
-	m.removedrecurring_expenses = nil
-}
-
-// Where appends a list predicates to the CategoryMutation builder.
-func (m *CategoryMutation) Where(ps ...predicate.Category) {
+// Where appends a list predicates to the AggregateGenerationMutation builder.
+func (m *AggregateGenerationMutation) Where(ps ...predicate.AggregateGeneration) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AggregateGenerationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Category, len(ps))
+func (m *AggregateGenerationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AggregateGeneration, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1135,36 +1210,27 @@ func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CategoryMutation) Op() Op {
+func (m *AggregateGenerationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CategoryMutation) SetOp(op Op) {
+func (m *AggregateGenerationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Category).
-func (m *CategoryMutation) Type() string {
+// Type returns the node type of this mutation (AggregateGeneration).
+func (m *AggregateGenerationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, category.FieldName)
-	}
-	if m.icon != nil {
-		fields = append(fields, category.FieldIcon)
-	}
-	if m.created_at != nil {
-		fields = append(fields, category.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, category.FieldUpdatedAt)
+func (m *AggregateGenerationMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.generation != nil {
+		fields = append(fields, aggregategeneration.FieldGeneration)
 	}
 	return fields
 }
@@ -1172,16 +1238,10 @@ func (m *CategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *AggregateGenerationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case category.FieldName:
-		return m.Name()
-	case category.FieldIcon:
-		return m.Icon()
-	case category.FieldCreatedAt:
-		return m.CreatedAt()
-	case category.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case aggregategeneration.FieldGeneration:
+		return m.Generation()
 	}
 	return nil, false
 }
@@ -1189,273 +1249,185 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AggregateGenerationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case category.FieldName:
-		return m.OldName(ctx)
-	case category.FieldIcon:
-		return m.OldIcon(ctx)
-	case category.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case category.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case aggregategeneration.FieldGeneration:
+		return m.OldGeneration(ctx)
 	}
-	return nil, fmt.Errorf("unknown Category field %s", name)
+	return nil, fmt.Errorf("unknown AggregateGeneration field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+func (m *AggregateGenerationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case category.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case category.FieldIcon:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIcon(v)
-		return nil
-	case category.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case aggregategeneration.FieldGeneration:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
-		return nil
-	case category.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
+		m.SetGeneration(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown AggregateGeneration field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CategoryMutation) AddedFields() []string {
-	return nil
+func (m *AggregateGenerationMutation) AddedFields() []string {
+	var fields []string
+	if m.addgeneration != nil {
+		fields = append(fields, aggregategeneration.FieldGeneration)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AggregateGenerationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case aggregategeneration.FieldGeneration:
+		return m.AddedGeneration()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+func (m *AggregateGenerationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case aggregategeneration.FieldGeneration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGeneration(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Category numeric field %s", name)
+	return fmt.Errorf("unknown AggregateGeneration numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CategoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(category.FieldIcon) {
-		fields = append(fields, category.FieldIcon)
-	}
-	return fields
+func (m *AggregateGenerationMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CategoryMutation) FieldCleared(name string) bool {
+func (m *AggregateGenerationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CategoryMutation) ClearField(name string) error {
-	switch name {
-	case category.FieldIcon:
-		m.ClearIcon()
-		return nil
-	}
-	return fmt.Errorf("unknown Category nullable field %s", name)
+func (m *AggregateGenerationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AggregateGeneration nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CategoryMutation) ResetField(name string) error {
+func (m *AggregateGenerationMutation) ResetField(name string) error {
 	switch name {
-	case category.FieldName:
-		m.ResetName()
-		return nil
-	case category.FieldIcon:
-		m.ResetIcon()
-		return nil
-	case category.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case category.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case aggregategeneration.FieldGeneration:
+		m.ResetGeneration()
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown AggregateGeneration field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *AggregateGenerationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.household != nil {
-		edges = append(edges, category.EdgeHousehold)
-	}
-	if m.transactions != nil {
-		edges = append(edges, category.EdgeTransactions)
-	}
-	if m.recurring_expenses != nil {
-		edges = append(edges, category.EdgeRecurringExpenses)
+		edges = append(edges, aggregategeneration.EdgeHousehold)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+func (m *AggregateGenerationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case category.EdgeHousehold:
+	case aggregategeneration.EdgeHousehold:
 		if id := m.household; id != nil {
 			return []ent.Value{*id}
 		}
-	case category.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.transactions))
-		for id := range m.transactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case category.EdgeRecurringExpenses:
-		ids := make([]ent.Value, 0, len(m.recurring_expenses))
-		for id := range m.recurring_expenses {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedtransactions != nil {
-		edges = append(edges, category.EdgeTransactions)
-	}
-	if m.removedrecurring_expenses != nil {
-		edges = append(edges, category.EdgeRecurringExpenses)
-	}
+func (m *AggregateGenerationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case category.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.removedtransactions))
-		for id := range m.removedtransactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case category.EdgeRecurringExpenses:
-		ids := make([]ent.Value, 0, len(m.removedrecurring_expenses))
-		for id := range m.removedrecurring_expenses {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AggregateGenerationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *AggregateGenerationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedhousehold {
-		edges = append(edges, category.EdgeHousehold)
-	}
-	if m.clearedtransactions {
-		edges = append(edges, category.EdgeTransactions)
-	}
-	if m.clearedrecurring_expenses {
-		edges = append(edges, category.EdgeRecurringExpenses)
+		edges = append(edges, aggregategeneration.EdgeHousehold)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CategoryMutation) EdgeCleared(name string) bool {
+func (m *AggregateGenerationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case category.EdgeHousehold:
+	case aggregategeneration.EdgeHousehold:
 		return m.clearedhousehold
-	case category.EdgeTransactions:
-		return m.clearedtransactions
-	case category.EdgeRecurringExpenses:
-		return m.clearedrecurring_expenses
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CategoryMutation) ClearEdge(name string) error {
+func (m *AggregateGenerationMutation) ClearEdge(name string) error {
 	switch name {
-	case category.EdgeHousehold:
+	case aggregategeneration.EdgeHousehold:
 		m.ClearHousehold()
 		return nil
 	}
-	return fmt.Errorf("unknown Category unique edge %s", name)
+	return fmt.Errorf("unknown AggregateGeneration unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CategoryMutation) ResetEdge(name string) error {
+func (m *AggregateGenerationMutation) ResetEdge(name string) error {
 	switch name {
-	case category.EdgeHousehold:
+	case aggregategeneration.EdgeHousehold:
 		m.ResetHousehold()
 		return nil
-	case category.EdgeTransactions:
-		m.ResetTransactions()
-		return nil
-	case category.EdgeRecurringExpenses:
-		m.ResetRecurringExpenses()
-		return nil
 	}
-	return fmt.Errorf("unknown Category edge %s", name)
+	return fmt.Errorf("unknown AggregateGeneration edge %s", name)
 }
 
-// HouseholdMutation represents an operation that mutates the Household nodes in the graph.
-type HouseholdMutation struct {
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
 	config
 	op                        Op
 	typ                       string
 	id                        *int
 	name                      *string
-	currency                  *string
-	description               *string
 	icon                      *string
+	tax_class                 *string
 	created_at                *time.Time
 	updated_at                *time.Time
 	clearedFields             map[string]struct{}
-	owner                     *int
-	clearedowner              bool
-	categories                map[int]struct{}
-	removedcategories         map[int]struct{}
-	clearedcategories         bool
+	household                 *int
+	clearedhousehold          bool
 	transactions              map[int]struct{}
 	removedtransactions       map[int]struct{}
 	clearedtransactions       bool
@@ -1463,21 +1435,21 @@ type HouseholdMutation struct {
 	removedrecurring_expenses map[int]struct{}
 	clearedrecurring_expenses bool
 	done                      bool
-	oldValue                  func(context.Context) (*Household, error)
-	predicates                []predicate.Household
+	oldValue                  func(context.Context) (*Category, error)
+	predicates                []predicate.Category
 }
 
-var _ ent.Mutation = (*HouseholdMutation)(nil)
+var _ ent.Mutation = (*CategoryMutation)(nil)
 
-// householdOption allows management of the mutation configuration using functional options.
-type householdOption func(*HouseholdMutation)
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
 
-// newHouseholdMutation creates new mutation for the Household entity.
-func newHouseholdMutation(c config, op Op, opts ...householdOption) *HouseholdMutation {
-	m := &HouseholdMutation{
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHousehold,
+		typ:           TypeCategory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1486,20 +1458,20 @@ func newHouseholdMutation(c config, op Op, opts ...householdOption) *HouseholdMu
 	return m
 }
 
-// withHouseholdID sets the ID field of the mutation.
-func withHouseholdID(id int) householdOption {
-	return func(m *HouseholdMutation) {
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id int) categoryOption {
+	return func(m *CategoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Household
+			value *Category
 		)
-		m.oldValue = func(ctx context.Context) (*Household, error) {
+		m.oldValue = func(ctx context.Context) (*Category, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Household.Get(ctx, id)
+					value, err = m.Client().Category.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1508,10 +1480,10 @@ func withHouseholdID(id int) householdOption {
 	}
 }
 
-// withHousehold sets the old Household of the mutation.
-func withHousehold(node *Household) householdOption {
-	return func(m *HouseholdMutation) {
-		m.oldValue = func(context.Context) (*Household, error) {
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1520,7 +1492,7 @@ func withHousehold(node *Household) householdOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HouseholdMutation) Client() *Client {
+func (m CategoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1528,7 +1500,7 @@ func (m HouseholdMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HouseholdMutation) Tx() (*Tx, error) {
+func (m CategoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1539,7 +1511,7 @@ func (m HouseholdMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HouseholdMutation) ID() (id int, exists bool) {
+func (m *CategoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1550,7 +1522,7 @@ func (m *HouseholdMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HouseholdMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1559,19 +1531,19 @@ func (m *HouseholdMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Household.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *HouseholdMutation) SetName(s string) {
+func (m *CategoryMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *HouseholdMutation) Name() (r string, exists bool) {
+func (m *CategoryMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1579,10 +1551,10 @@ func (m *HouseholdMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Household entity.
-// If the Household object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HouseholdMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1597,151 +1569,115 @@ func (m *HouseholdMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *HouseholdMutation) ResetName() {
+func (m *CategoryMutation) ResetName() {
 	m.name = nil
 }
 
-// SetCurrency sets the "currency" field.
-func (m *HouseholdMutation) SetCurrency(s string) {
-	m.currency = &s
+// SetIcon sets the "icon" field.
+func (m *CategoryMutation) SetIcon(s string) {
+	m.icon = &s
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *HouseholdMutation) Currency() (r string, exists bool) {
-	v := m.currency
+// Icon returns the value of the "icon" field in the mutation.
+func (m *CategoryMutation) Icon() (r string, exists bool) {
+	v := m.icon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the Household entity.
-// If the Household object wasn't provided to the builder, the object is fetched from the database.
+// OldIcon returns the old "icon" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HouseholdMutation) OldCurrency(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldIcon(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	return oldValue.Currency, nil
+	return oldValue.Icon, nil
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *HouseholdMutation) ResetCurrency() {
-	m.currency = nil
+// ClearIcon clears the value of the "icon" field.
+func (m *CategoryMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[category.FieldIcon] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *HouseholdMutation) SetDescription(s string) {
-	m.description = &s
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *CategoryMutation) IconCleared() bool {
+	_, ok := m.clearedFields[category.FieldIcon]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *HouseholdMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetIcon resets all changes to the "icon" field.
+func (m *CategoryMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, category.FieldIcon)
+}
+
+// SetTaxClass sets the "tax_class" field.
+func (m *CategoryMutation) SetTaxClass(s string) {
+	m.tax_class = &s
+}
+
+// TaxClass returns the value of the "tax_class" field in the mutation.
+func (m *CategoryMutation) TaxClass() (r string, exists bool) {
+	v := m.tax_class
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Household entity.
-// If the Household object wasn't provided to the builder, the object is fetched from the database.
+// OldTaxClass returns the old "tax_class" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HouseholdMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *CategoryMutation) OldTaxClass(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaxClass is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldTaxClass requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaxClass: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.TaxClass, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *HouseholdMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[household.FieldDescription] = struct{}{}
+// ClearTaxClass clears the value of the "tax_class" field.
+func (m *CategoryMutation) ClearTaxClass() {
+	m.tax_class = nil
+	m.clearedFields[category.FieldTaxClass] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *HouseholdMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[household.FieldDescription]
+// TaxClassCleared returns if the "tax_class" field was cleared in this mutation.
+func (m *CategoryMutation) TaxClassCleared() bool {
+	_, ok := m.clearedFields[category.FieldTaxClass]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *HouseholdMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, household.FieldDescription)
-}
-
-// SetIcon sets the "icon" field.
-func (m *HouseholdMutation) SetIcon(s string) {
-	m.icon = &s
-}
-
-// Icon returns the value of the "icon" field in the mutation.
-func (m *HouseholdMutation) Icon() (r string, exists bool) {
-	v := m.icon
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIcon returns the old "icon" field's value of the Household entity.
-// If the Household object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HouseholdMutation) OldIcon(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
-	}
-	return oldValue.Icon, nil
-}
-
-// ClearIcon clears the value of the "icon" field.
-func (m *HouseholdMutation) ClearIcon() {
-	m.icon = nil
-	m.clearedFields[household.FieldIcon] = struct{}{}
-}
-
-// IconCleared returns if the "icon" field was cleared in this mutation.
-func (m *HouseholdMutation) IconCleared() bool {
-	_, ok := m.clearedFields[household.FieldIcon]
-	return ok
-}
-
-// ResetIcon resets all changes to the "icon" field.
-func (m *HouseholdMutation) ResetIcon() {
-	m.icon = nil
-	delete(m.clearedFields, household.FieldIcon)
+// ResetTaxClass resets all changes to the "tax_class" field.
+func (m *CategoryMutation) ResetTaxClass() {
+	m.tax_class = nil
+	delete(m.clearedFields, category.FieldTaxClass)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *HouseholdMutation) SetCreatedAt(t time.Time) {
+func (m *CategoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HouseholdMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1749,10 +1685,10 @@ func (m *HouseholdMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Household entity.
-// If the Household object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HouseholdMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1767,17 +1703,17 @@ func (m *HouseholdMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HouseholdMutation) ResetCreatedAt() {
+func (m *CategoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *HouseholdMutation) SetUpdatedAt(t time.Time) {
+func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HouseholdMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1785,10 +1721,10 @@ func (m *HouseholdMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Household entity.
-// If the Household object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HouseholdMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1803,105 +1739,51 @@ func (m *HouseholdMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HouseholdMutation) ResetUpdatedAt() {
+func (m *CategoryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *HouseholdMutation) SetOwnerID(id int) {
-	m.owner = &id
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *CategoryMutation) SetHouseholdID(id int) {
+	m.household = &id
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *HouseholdMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *CategoryMutation) ClearHousehold() {
+	m.clearedhousehold = true
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *HouseholdMutation) OwnerCleared() bool {
-	return m.clearedowner
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *CategoryMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *HouseholdMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *CategoryMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
+// HouseholdIDs returns the "household" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *HouseholdMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *CategoryMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *HouseholdMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// AddCategoryIDs adds the "categories" edge to the Category entity by ids.
-func (m *HouseholdMutation) AddCategoryIDs(ids ...int) {
-	if m.categories == nil {
-		m.categories = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.categories[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCategories clears the "categories" edge to the Category entity.
-func (m *HouseholdMutation) ClearCategories() {
-	m.clearedcategories = true
-}
-
-// CategoriesCleared reports if the "categories" edge to the Category entity was cleared.
-func (m *HouseholdMutation) CategoriesCleared() bool {
-	return m.clearedcategories
-}
-
-// RemoveCategoryIDs removes the "categories" edge to the Category entity by IDs.
-func (m *HouseholdMutation) RemoveCategoryIDs(ids ...int) {
-	if m.removedcategories == nil {
-		m.removedcategories = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.categories, ids[i])
-		m.removedcategories[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedCategories returns the removed IDs of the "categories" edge to the Category entity.
-func (m *HouseholdMutation) RemovedCategoriesIDs() (ids []int) {
-	for id := range m.removedcategories {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// CategoriesIDs returns the "categories" edge IDs in the mutation.
-func (m *HouseholdMutation) CategoriesIDs() (ids []int) {
-	for id := range m.categories {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetCategories resets all changes to the "categories" edge.
-func (m *HouseholdMutation) ResetCategories() {
-	m.categories = nil
-	m.clearedcategories = false
-	m.removedcategories = nil
+// ResetHousehold resets all changes to the "household" edge.
+func (m *CategoryMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
 }
 
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
-func (m *HouseholdMutation) AddTransactionIDs(ids ...int) {
+func (m *CategoryMutation) AddTransactionIDs(ids ...int) {
 	if m.transactions == nil {
 		m.transactions = make(map[int]struct{})
 	}
@@ -1911,17 +1793,17 @@ func (m *HouseholdMutation) AddTransactionIDs(ids ...int) {
 }
 
 // ClearTransactions clears the "transactions" edge to the Transaction entity.
-func (m *HouseholdMutation) ClearTransactions() {
+func (m *CategoryMutation) ClearTransactions() {
 	m.clearedtransactions = true
 }
 
 // TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
-func (m *HouseholdMutation) TransactionsCleared() bool {
+func (m *CategoryMutation) TransactionsCleared() bool {
 	return m.clearedtransactions
 }
 
 // RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
-func (m *HouseholdMutation) RemoveTransactionIDs(ids ...int) {
+func (m *CategoryMutation) RemoveTransactionIDs(ids ...int) {
 	if m.removedtransactions == nil {
 		m.removedtransactions = make(map[int]struct{})
 	}
@@ -1932,7 +1814,7 @@ func (m *HouseholdMutation) RemoveTransactionIDs(ids ...int) {
 }
 
 // RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
-func (m *HouseholdMutation) RemovedTransactionsIDs() (ids []int) {
+func (m *CategoryMutation) RemovedTransactionsIDs() (ids []int) {
 	for id := range m.removedtransactions {
 		ids = append(ids, id)
 	}
@@ -1940,7 +1822,7 @@ func (m *HouseholdMutation) RemovedTransactionsIDs() (ids []int) {
 }
 
 // TransactionsIDs returns the "transactions" edge IDs in the mutation.
-func (m *HouseholdMutation) TransactionsIDs() (ids []int) {
+func (m *CategoryMutation) TransactionsIDs() (ids []int) {
 	for id := range m.transactions {
 		ids = append(ids, id)
 	}
@@ -1948,14 +1830,14 @@ func (m *HouseholdMutation) TransactionsIDs() (ids []int) {
 }
 
 // ResetTransactions resets all changes to the "transactions" edge.
-func (m *HouseholdMutation) ResetTransactions() {
+func (m *CategoryMutation) ResetTransactions() {
 	m.transactions = nil
 	m.clearedtransactions = false
 	m.removedtransactions = nil
 }
 
 // AddRecurringExpenseIDs adds the "recurring_expenses" edge to the RecurringExpense entity by ids.
-func (m *HouseholdMutation) AddRecurringExpenseIDs(ids ...int) {
+func (m *CategoryMutation) AddRecurringExpenseIDs(ids ...int) {
 	if m.recurring_expenses == nil {
 		m.recurring_expenses = make(map[int]struct{})
 	}
@@ -1965,17 +1847,17 @@ func (m *HouseholdMutation) AddRecurringExpenseIDs(ids ...int) {
 }
 
 // ClearRecurringExpenses clears the "recurring_expenses" edge to the RecurringExpense entity.
-func (m *HouseholdMutation) ClearRecurringExpenses() {
+func (m *CategoryMutation) ClearRecurringExpenses() {
 	m.clearedrecurring_expenses = true
 }
 
 // RecurringExpensesCleared reports if the "recurring_expenses" edge to the RecurringExpense entity was cleared.
-func (m *HouseholdMutation) RecurringExpensesCleared() bool {
+func (m *CategoryMutation) RecurringExpensesCleared() bool {
 	return m.clearedrecurring_expenses
 }
 
 // RemoveRecurringExpenseIDs removes the "recurring_expenses" edge to the RecurringExpense entity by IDs.
-func (m *HouseholdMutation) RemoveRecurringExpenseIDs(ids ...int) {
+func (m *CategoryMutation) RemoveRecurringExpenseIDs(ids ...int) {
 	if m.removedrecurring_expenses == nil {
 		m.removedrecurring_expenses = make(map[int]struct{})
 	}
@@ -1986,7 +1868,7 @@ func (m *HouseholdMutation) RemoveRecurringExpenseIDs(ids ...int) {
 }
 
 // RemovedRecurringExpenses returns the removed IDs of the "recurring_expenses" edge to the RecurringExpense entity.
-func (m *HouseholdMutation) RemovedRecurringExpensesIDs() (ids []int) {
+func (m *CategoryMutation) RemovedRecurringExpensesIDs() (ids []int) {
 	for id := range m.removedrecurring_expenses {
 		ids = append(ids, id)
 	}
@@ -1994,7 +1876,7 @@ func (m *HouseholdMutation) RemovedRecurringExpensesIDs() (ids []int) {
 }
 
 // RecurringExpensesIDs returns the "recurring_expenses" edge IDs in the mutation.
-func (m *HouseholdMutation) RecurringExpensesIDs() (ids []int) {
+func (m *CategoryMutation) RecurringExpensesIDs() (ids []int) {
 	for id := range m.recurring_expenses {
 		ids = append(ids, id)
 	}
@@ -2002,21 +1884,21 @@ func (m *HouseholdMutation) RecurringExpensesIDs() (ids []int) {
 }
 
 // ResetRecurringExpenses resets all changes to the "recurring_expenses" edge.
-func (m *HouseholdMutation) ResetRecurringExpenses() {
+func (m *CategoryMutation) ResetRecurringExpenses() {
 	m.recurring_expenses = nil
 	m.clearedrecurring_expenses = false
 	m.removedrecurring_expenses = nil
 }
 
-// Where appends a list predicates to the HouseholdMutation builder.
-func (m *HouseholdMutation) Where(ps ...predicate.Household) {
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HouseholdMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HouseholdMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Household, len(ps))
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2024,42 +1906,39 @@ func (m *HouseholdMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HouseholdMutation) Op() Op {
+func (m *CategoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HouseholdMutation) SetOp(op Op) {
+func (m *CategoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Household).
-func (m *HouseholdMutation) Type() string {
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HouseholdMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.name != nil {
-		fields = append(fields, household.FieldName)
-	}
-	if m.currency != nil {
-		fields = append(fields, household.FieldCurrency)
-	}
-	if m.description != nil {
-		fields = append(fields, household.FieldDescription)
+		fields = append(fields, category.FieldName)
 	}
 	if m.icon != nil {
-		fields = append(fields, household.FieldIcon)
+		fields = append(fields, category.FieldIcon)
+	}
+	if m.tax_class != nil {
+		fields = append(fields, category.FieldTaxClass)
 	}
 	if m.created_at != nil {
-		fields = append(fields, household.FieldCreatedAt)
+		fields = append(fields, category.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, household.FieldUpdatedAt)
+		fields = append(fields, category.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2067,19 +1946,17 @@ func (m *HouseholdMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HouseholdMutation) Field(name string) (ent.Value, bool) {
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case household.FieldName:
+	case category.FieldName:
 		return m.Name()
-	case household.FieldCurrency:
-		return m.Currency()
-	case household.FieldDescription:
-		return m.Description()
-	case household.FieldIcon:
+	case category.FieldIcon:
 		return m.Icon()
-	case household.FieldCreatedAt:
+	case category.FieldTaxClass:
+		return m.TaxClass()
+	case category.FieldCreatedAt:
 		return m.CreatedAt()
-	case household.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2088,65 +1965,56 @@ func (m *HouseholdMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HouseholdMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case household.FieldName:
+	case category.FieldName:
 		return m.OldName(ctx)
-	case household.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case household.FieldDescription:
-		return m.OldDescription(ctx)
-	case household.FieldIcon:
+	case category.FieldIcon:
 		return m.OldIcon(ctx)
-	case household.FieldCreatedAt:
+	case category.FieldTaxClass:
+		return m.OldTaxClass(ctx)
+	case category.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case household.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Household field %s", name)
+	return nil, fmt.Errorf("unknown Category field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HouseholdMutation) SetField(name string, value ent.Value) error {
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case household.FieldName:
+	case category.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case household.FieldCurrency:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrency(v)
-		return nil
-	case household.FieldDescription:
+	case category.FieldIcon:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetIcon(v)
 		return nil
-	case household.FieldIcon:
+	case category.FieldTaxClass:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIcon(v)
+		m.SetTaxClass(v)
 		return nil
-	case household.FieldCreatedAt:
+	case category.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case household.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2154,130 +2022,118 @@ func (m *HouseholdMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Household field %s", name)
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HouseholdMutation) AddedFields() []string {
+func (m *CategoryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HouseholdMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HouseholdMutation) AddField(name string, value ent.Value) error {
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Household numeric field %s", name)
+	return fmt.Errorf("unknown Category numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HouseholdMutation) ClearedFields() []string {
+func (m *CategoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(household.FieldDescription) {
-		fields = append(fields, household.FieldDescription)
+	if m.FieldCleared(category.FieldIcon) {
+		fields = append(fields, category.FieldIcon)
 	}
-	if m.FieldCleared(household.FieldIcon) {
-		fields = append(fields, household.FieldIcon)
+	if m.FieldCleared(category.FieldTaxClass) {
+		fields = append(fields, category.FieldTaxClass)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HouseholdMutation) FieldCleared(name string) bool {
+func (m *CategoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HouseholdMutation) ClearField(name string) error {
+func (m *CategoryMutation) ClearField(name string) error {
 	switch name {
-	case household.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case household.FieldIcon:
+	case category.FieldIcon:
 		m.ClearIcon()
 		return nil
+	case category.FieldTaxClass:
+		m.ClearTaxClass()
+		return nil
 	}
-	return fmt.Errorf("unknown Household nullable field %s", name)
+	return fmt.Errorf("unknown Category nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HouseholdMutation) ResetField(name string) error {
+func (m *CategoryMutation) ResetField(name string) error {
 	switch name {
-	case household.FieldName:
+	case category.FieldName:
 		m.ResetName()
 		return nil
-	case household.FieldCurrency:
-		m.ResetCurrency()
-		return nil
-	case household.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case household.FieldIcon:
+	case category.FieldIcon:
 		m.ResetIcon()
 		return nil
-	case household.FieldCreatedAt:
+	case category.FieldTaxClass:
+		m.ResetTaxClass()
+		return nil
+	case category.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case household.FieldUpdatedAt:
+	case category.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Household field %s", name)
+	return fmt.Errorf("unknown Category field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HouseholdMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.owner != nil {
-		edges = append(edges, household.EdgeOwner)
-	}
-	if m.categories != nil {
-		edges = append(edges, household.EdgeCategories)
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.household != nil {
+		edges = append(edges, category.EdgeHousehold)
 	}
 	if m.transactions != nil {
-		edges = append(edges, household.EdgeTransactions)
+		edges = append(edges, category.EdgeTransactions)
 	}
 	if m.recurring_expenses != nil {
-		edges = append(edges, household.EdgeRecurringExpenses)
+		edges = append(edges, category.EdgeRecurringExpenses)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HouseholdMutation) AddedIDs(name string) []ent.Value {
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case household.EdgeOwner:
-		if id := m.owner; id != nil {
+	case category.EdgeHousehold:
+		if id := m.household; id != nil {
 			return []ent.Value{*id}
 		}
-	case household.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.categories))
-		for id := range m.categories {
-			ids = append(ids, id)
-		}
-		return ids
-	case household.EdgeTransactions:
+	case category.EdgeTransactions:
 		ids := make([]ent.Value, 0, len(m.transactions))
 		for id := range m.transactions {
 			ids = append(ids, id)
 		}
 		return ids
-	case household.EdgeRecurringExpenses:
+	case category.EdgeRecurringExpenses:
 		ids := make([]ent.Value, 0, len(m.recurring_expenses))
 		for id := range m.recurring_expenses {
 			ids = append(ids, id)
@@ -2288,37 +2144,28 @@ func (m *HouseholdMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HouseholdMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedcategories != nil {
-		edges = append(edges, household.EdgeCategories)
-	}
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.removedtransactions != nil {
-		edges = append(edges, household.EdgeTransactions)
+		edges = append(edges, category.EdgeTransactions)
 	}
 	if m.removedrecurring_expenses != nil {
-		edges = append(edges, household.EdgeRecurringExpenses)
+		edges = append(edges, category.EdgeRecurringExpenses)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HouseholdMutation) RemovedIDs(name string) []ent.Value {
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case household.EdgeCategories:
-		ids := make([]ent.Value, 0, len(m.removedcategories))
-		for id := range m.removedcategories {
-			ids = append(ids, id)
-		}
-		return ids
-	case household.EdgeTransactions:
+	case category.EdgeTransactions:
 		ids := make([]ent.Value, 0, len(m.removedtransactions))
 		for id := range m.removedtransactions {
 			ids = append(ids, id)
 		}
 		return ids
-	case household.EdgeRecurringExpenses:
+	case category.EdgeRecurringExpenses:
 		ids := make([]ent.Value, 0, len(m.removedrecurring_expenses))
 		for id := range m.removedrecurring_expenses {
 			ids = append(ids, id)
@@ -2329,34 +2176,29 @@ func (m *HouseholdMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HouseholdMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedowner {
-		edges = append(edges, household.EdgeOwner)
-	}
-	if m.clearedcategories {
-		edges = append(edges, household.EdgeCategories)
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedhousehold {
+		edges = append(edges, category.EdgeHousehold)
 	}
 	if m.clearedtransactions {
-		edges = append(edges, household.EdgeTransactions)
+		edges = append(edges, category.EdgeTransactions)
 	}
 	if m.clearedrecurring_expenses {
-		edges = append(edges, household.EdgeRecurringExpenses)
+		edges = append(edges, category.EdgeRecurringExpenses)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HouseholdMutation) EdgeCleared(name string) bool {
+func (m *CategoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case household.EdgeOwner:
-		return m.clearedowner
-	case household.EdgeCategories:
-		return m.clearedcategories
-	case household.EdgeTransactions:
+	case category.EdgeHousehold:
+		return m.clearedhousehold
+	case category.EdgeTransactions:
 		return m.clearedtransactions
-	case household.EdgeRecurringExpenses:
+	case category.EdgeRecurringExpenses:
 		return m.clearedrecurring_expenses
 	}
 	return false
@@ -2364,75 +2206,86 @@ func (m *HouseholdMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HouseholdMutation) ClearEdge(name string) error {
+func (m *CategoryMutation) ClearEdge(name string) error {
 	switch name {
-	case household.EdgeOwner:
-		m.ClearOwner()
+	case category.EdgeHousehold:
+		m.ClearHousehold()
 		return nil
 	}
-	return fmt.Errorf("unknown Household unique edge %s", name)
+	return fmt.Errorf("unknown Category unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HouseholdMutation) ResetEdge(name string) error {
+func (m *CategoryMutation) ResetEdge(name string) error {
 	switch name {
-	case household.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case household.EdgeCategories:
-		m.ResetCategories()
+	case category.EdgeHousehold:
+		m.ResetHousehold()
 		return nil
-	case household.EdgeTransactions:
+	case category.EdgeTransactions:
 		m.ResetTransactions()
 		return nil
-	case household.EdgeRecurringExpenses:
+	case category.EdgeRecurringExpenses:
 		m.ResetRecurringExpenses()
 		return nil
 	}
-	return fmt.Errorf("unknown Household edge %s", name)
+	return fmt.Errorf("unknown Category edge %s", name)
 }
 
-// RecurringExpenseMutation represents an operation that mutates the RecurringExpense nodes in the graph.
-type RecurringExpenseMutation struct {
+// HouseholdMutation represents an operation that mutates the Household nodes in the graph.
+type HouseholdMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *int
-	name                      *string
-	description               *string
-	details                   *string
-	amount                    *string
-	frequency                 *string
-	active                    *bool
-	start_date                *time.Time
-	end_date                  *time.Time
-	created_at                *time.Time
-	updated_at                *time.Time
-	clearedFields             map[string]struct{}
-	household                 *int
-	clearedhousehold          bool
-	category                  *int
-	clearedcategory           bool
-	schedule_overrides        map[int]struct{}
-	removedschedule_overrides map[int]struct{}
-	clearedschedule_overrides bool
-	done                      bool
-	oldValue                  func(context.Context) (*RecurringExpense, error)
-	predicates                []predicate.RecurringExpense
+	op                          Op
+	typ                         string
+	id                          *int
+	name                        *string
+	currency                    *string
+	description                 *string
+	icon                        *string
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	deleted_at                  *time.Time
+	clearedFields               map[string]struct{}
+	owner                       *int
+	clearedowner                bool
+	categories                  map[int]struct{}
+	removedcategories           map[int]struct{}
+	clearedcategories           bool
+	transactions                map[int]struct{}
+	removedtransactions         map[int]struct{}
+	clearedtransactions         bool
+	recurring_expenses          map[int]struct{}
+	removedrecurring_expenses   map[int]struct{}
+	clearedrecurring_expenses   bool
+	monthly_aggregates          map[int]struct{}
+	removedmonthly_aggregates   map[int]struct{}
+	clearedmonthly_aggregates   bool
+	aggregate_generation        *int
+	clearedaggregate_generation bool
+	members                     map[int]struct{}
+	removedmembers              map[int]struct{}
+	clearedmembers              bool
+	settlements                 map[int]struct{}
+	removedsettlements          map[int]struct{}
+	clearedsettlements          bool
+	transfer                    *int
+	clearedtransfer             bool
+	done                        bool
+	oldValue                    func(context.Context) (*Household, error)
+	predicates                  []predicate.Household
 }
 
-var _ ent.Mutation = (*RecurringExpenseMutation)(nil)
+var _ ent.Mutation = (*HouseholdMutation)(nil)
 
-// recurringexpenseOption allows management of the mutation configuration using functional options.
-type recurringexpenseOption func(*RecurringExpenseMutation)
+// householdOption allows management of the mutation configuration using functional options.
+type householdOption func(*HouseholdMutation)
 
-// newRecurringExpenseMutation creates new mutation for the RecurringExpense entity.
-func newRecurringExpenseMutation(c config, op Op, opts ...recurringexpenseOption) *RecurringExpenseMutation {
-	m := &RecurringExpenseMutation{
+// newHouseholdMutation creates new mutation for the Household entity.
+func newHouseholdMutation(c config, op Op, opts ...householdOption) *HouseholdMutation {
+	m := &HouseholdMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRecurringExpense,
+		typ:           TypeHousehold,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2441,20 +2294,20 @@ func newRecurringExpenseMutation(c config, op Op, opts ...recurringexpenseOption
 	return m
 }
 
-// withRecurringExpenseID sets the ID field of the mutation.
-func withRecurringExpenseID(id int) recurringexpenseOption {
-	return func(m *RecurringExpenseMutation) {
+// withHouseholdID sets the ID field of the mutation.
+func withHouseholdID(id int) householdOption {
+	return func(m *HouseholdMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RecurringExpense
+			value *Household
 		)
-		m.oldValue = func(ctx context.Context) (*RecurringExpense, error) {
+		m.oldValue = func(ctx context.Context) (*Household, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RecurringExpense.Get(ctx, id)
+					value, err = m.Client().Household.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2463,10 +2316,10 @@ func withRecurringExpenseID(id int) recurringexpenseOption {
 	}
 }
 
-// withRecurringExpense sets the old RecurringExpense of the mutation.
-func withRecurringExpense(node *RecurringExpense) recurringexpenseOption {
-	return func(m *RecurringExpenseMutation) {
-		m.oldValue = func(context.Context) (*RecurringExpense, error) {
+// withHousehold sets the old Household of the mutation.
+func withHousehold(node *Household) householdOption {
+	return func(m *HouseholdMutation) {
+		m.oldValue = func(context.Context) (*Household, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2475,7 +2328,7 @@ func withRecurringExpense(node *RecurringExpense) recurringexpenseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RecurringExpenseMutation) Client() *Client {
+func (m HouseholdMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2483,7 +2336,7 @@ func (m RecurringExpenseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RecurringExpenseMutation) Tx() (*Tx, error) {
+func (m HouseholdMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2494,7 +2347,7 @@ func (m RecurringExpenseMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RecurringExpenseMutation) ID() (id int, exists bool) {
+func (m *HouseholdMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2505,7 +2358,7 @@ func (m *RecurringExpenseMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RecurringExpenseMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *HouseholdMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2514,19 +2367,19 @@ func (m *RecurringExpenseMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RecurringExpense.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Household.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *RecurringExpenseMutation) SetName(s string) {
+func (m *HouseholdMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *RecurringExpenseMutation) Name() (r string, exists bool) {
+func (m *HouseholdMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -2534,10 +2387,10 @@ func (m *RecurringExpenseMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *HouseholdMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -2552,17 +2405,53 @@ func (m *RecurringExpenseMutation) OldName(ctx context.Context) (v string, err e
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *RecurringExpenseMutation) ResetName() {
+func (m *HouseholdMutation) ResetName() {
 	m.name = nil
 }
 
+// SetCurrency sets the "currency" field.
+func (m *HouseholdMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *HouseholdMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HouseholdMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *HouseholdMutation) ResetCurrency() {
+	m.currency = nil
+}
+
 // SetDescription sets the "description" field.
-func (m *RecurringExpenseMutation) SetDescription(s string) {
+func (m *HouseholdMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *RecurringExpenseMutation) Description() (r string, exists bool) {
+func (m *HouseholdMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -2570,10 +2459,10 @@ func (m *RecurringExpenseMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *HouseholdMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -2588,478 +2477,643 @@ func (m *RecurringExpenseMutation) OldDescription(ctx context.Context) (v string
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *RecurringExpenseMutation) ClearDescription() {
+func (m *HouseholdMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[recurringexpense.FieldDescription] = struct{}{}
+	m.clearedFields[household.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RecurringExpenseMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[recurringexpense.FieldDescription]
+func (m *HouseholdMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[household.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *RecurringExpenseMutation) ResetDescription() {
+func (m *HouseholdMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, recurringexpense.FieldDescription)
+	delete(m.clearedFields, household.FieldDescription)
 }
 
-// SetDetails sets the "details" field.
-func (m *RecurringExpenseMutation) SetDetails(s string) {
-	m.details = &s
+// SetIcon sets the "icon" field.
+func (m *HouseholdMutation) SetIcon(s string) {
+	m.icon = &s
 }
 
-// Details returns the value of the "details" field in the mutation.
-func (m *RecurringExpenseMutation) Details() (r string, exists bool) {
-	v := m.details
+// Icon returns the value of the "icon" field in the mutation.
+func (m *HouseholdMutation) Icon() (r string, exists bool) {
+	v := m.icon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDetails returns the old "details" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// OldIcon returns the old "icon" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldDetails(ctx context.Context) (v string, err error) {
+func (m *HouseholdMutation) OldIcon(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDetails requires an ID field in the mutation")
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	return oldValue.Details, nil
+	return oldValue.Icon, nil
 }
 
-// ClearDetails clears the value of the "details" field.
-func (m *RecurringExpenseMutation) ClearDetails() {
-	m.details = nil
-	m.clearedFields[recurringexpense.FieldDetails] = struct{}{}
+// ClearIcon clears the value of the "icon" field.
+func (m *HouseholdMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[household.FieldIcon] = struct{}{}
 }
 
-// DetailsCleared returns if the "details" field was cleared in this mutation.
-func (m *RecurringExpenseMutation) DetailsCleared() bool {
-	_, ok := m.clearedFields[recurringexpense.FieldDetails]
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *HouseholdMutation) IconCleared() bool {
+	_, ok := m.clearedFields[household.FieldIcon]
 	return ok
 }
 
-// ResetDetails resets all changes to the "details" field.
-func (m *RecurringExpenseMutation) ResetDetails() {
-	m.details = nil
-	delete(m.clearedFields, recurringexpense.FieldDetails)
+// ResetIcon resets all changes to the "icon" field.
+func (m *HouseholdMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, household.FieldIcon)
 }
 
-// SetAmount sets the "amount" field.
-func (m *RecurringExpenseMutation) SetAmount(s string) {
-	m.amount = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *HouseholdMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *RecurringExpenseMutation) Amount() (r string, exists bool) {
-	v := m.amount
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HouseholdMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldAmount(ctx context.Context) (v string, err error) {
+func (m *HouseholdMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *RecurringExpenseMutation) ResetAmount() {
-	m.amount = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HouseholdMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFrequency sets the "frequency" field.
-func (m *RecurringExpenseMutation) SetFrequency(s string) {
-	m.frequency = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HouseholdMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Frequency returns the value of the "frequency" field in the mutation.
-func (m *RecurringExpenseMutation) Frequency() (r string, exists bool) {
-	v := m.frequency
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HouseholdMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFrequency returns the old "frequency" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldFrequency(ctx context.Context) (v string, err error) {
+func (m *HouseholdMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFrequency requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Frequency, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetFrequency resets all changes to the "frequency" field.
-func (m *RecurringExpenseMutation) ResetFrequency() {
-	m.frequency = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HouseholdMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetActive sets the "active" field.
-func (m *RecurringExpenseMutation) SetActive(b bool) {
-	m.active = &b
+// SetDeletedAt sets the "deleted_at" field.
+func (m *HouseholdMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *RecurringExpenseMutation) Active() (r bool, exists bool) {
-	v := m.active
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *HouseholdMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the Household entity.
+// If the Household object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *HouseholdMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *RecurringExpenseMutation) ResetActive() {
-	m.active = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *HouseholdMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[household.FieldDeletedAt] = struct{}{}
 }
 
-// SetStartDate sets the "start_date" field.
-func (m *RecurringExpenseMutation) SetStartDate(t time.Time) {
-	m.start_date = &t
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *HouseholdMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[household.FieldDeletedAt]
+	return ok
 }
 
-// StartDate returns the value of the "start_date" field in the mutation.
-func (m *RecurringExpenseMutation) StartDate() (r time.Time, exists bool) {
-	v := m.start_date
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *HouseholdMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, household.FieldDeletedAt)
 }
 
-// OldStartDate returns the old "start_date" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
-	}
-	return oldValue.StartDate, nil
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *HouseholdMutation) SetOwnerID(id int) {
+	m.owner = &id
 }
 
-// ResetStartDate resets all changes to the "start_date" field.
-func (m *RecurringExpenseMutation) ResetStartDate() {
-	m.start_date = nil
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *HouseholdMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// SetEndDate sets the "end_date" field.
-func (m *RecurringExpenseMutation) SetEndDate(t time.Time) {
-	m.end_date = &t
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *HouseholdMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// EndDate returns the value of the "end_date" field in the mutation.
-func (m *RecurringExpenseMutation) EndDate() (r time.Time, exists bool) {
-	v := m.end_date
-	if v == nil {
-		return
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *HouseholdMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
-	return *v, true
+	return
 }
 
-// OldEndDate returns the old "end_date" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldEndDate(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *HouseholdMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.EndDate, nil
-}
-
-// ClearEndDate clears the value of the "end_date" field.
-func (m *RecurringExpenseMutation) ClearEndDate() {
-	m.end_date = nil
-	m.clearedFields[recurringexpense.FieldEndDate] = struct{}{}
+	return
 }
 
-// EndDateCleared returns if the "end_date" field was cleared in this mutation.
-func (m *RecurringExpenseMutation) EndDateCleared() bool {
-	_, ok := m.clearedFields[recurringexpense.FieldEndDate]
-	return ok
+// ResetOwner resets all changes to the "owner" edge.
+func (m *HouseholdMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// ResetEndDate resets all changes to the "end_date" field.
-func (m *RecurringExpenseMutation) ResetEndDate() {
-	m.end_date = nil
-	delete(m.clearedFields, recurringexpense.FieldEndDate)
+// AddCategoryIDs adds the "categories" edge to the Category entity by ids.
+func (m *HouseholdMutation) AddCategoryIDs(ids ...int) {
+	if m.categories == nil {
+		m.categories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.categories[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RecurringExpenseMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearCategories clears the "categories" edge to the Category entity.
+func (m *HouseholdMutation) ClearCategories() {
+	m.clearedcategories = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RecurringExpenseMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// CategoriesCleared reports if the "categories" edge to the Category entity was cleared.
+func (m *HouseholdMutation) CategoriesCleared() bool {
+	return m.clearedcategories
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// RemoveCategoryIDs removes the "categories" edge to the Category entity by IDs.
+func (m *HouseholdMutation) RemoveCategoryIDs(ids ...int) {
+	if m.removedcategories == nil {
+		m.removedcategories = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		delete(m.categories, ids[i])
+		m.removedcategories[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RecurringExpenseMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedCategories returns the removed IDs of the "categories" edge to the Category entity.
+func (m *HouseholdMutation) RemovedCategoriesIDs() (ids []int) {
+	for id := range m.removedcategories {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RecurringExpenseMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// CategoriesIDs returns the "categories" edge IDs in the mutation.
+func (m *HouseholdMutation) CategoriesIDs() (ids []int) {
+	for id := range m.categories {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RecurringExpenseMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetCategories resets all changes to the "categories" edge.
+func (m *HouseholdMutation) ResetCategories() {
+	m.categories = nil
+	m.clearedcategories = false
+	m.removedcategories = nil
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RecurringExpense entity.
-// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringExpenseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *HouseholdMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RecurringExpenseMutation) ResetUpdatedAt() {
-	m.updated_at = nil
 }
 
-// SetHouseholdID sets the "household" edge to the Household entity by id.
-func (m *RecurringExpenseMutation) SetHouseholdID(id int) {
-	m.household = &id
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *HouseholdMutation) ClearTransactions() {
+	m.clearedtransactions = true
 }
 
-// ClearHousehold clears the "household" edge to the Household entity.
-func (m *RecurringExpenseMutation) ClearHousehold() {
-	m.clearedhousehold = true
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *HouseholdMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
 }
 
-// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
-func (m *RecurringExpenseMutation) HouseholdCleared() bool {
-	return m.clearedhousehold
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *HouseholdMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
 }
 
-// HouseholdID returns the "household" edge ID in the mutation.
-func (m *RecurringExpenseMutation) HouseholdID() (id int, exists bool) {
-	if m.household != nil {
-		return *m.household, true
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *HouseholdMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// HouseholdIDs returns the "household" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HouseholdID instead. It exists only for internal usage by the builders.
-func (m *RecurringExpenseMutation) HouseholdIDs() (ids []int) {
-	if id := m.household; id != nil {
-		ids = append(ids, *id)
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *HouseholdMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetHousehold resets all changes to the "household" edge.
-func (m *RecurringExpenseMutation) ResetHousehold() {
-	m.household = nil
-	m.clearedhousehold = false
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *HouseholdMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by id.
-func (m *RecurringExpenseMutation) SetCategoryID(id int) {
-	m.category = &id
+// AddRecurringExpenseIDs adds the "recurring_expenses" edge to the RecurringExpense entity by ids.
+func (m *HouseholdMutation) AddRecurringExpenseIDs(ids ...int) {
+	if m.recurring_expenses == nil {
+		m.recurring_expenses = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recurring_expenses[ids[i]] = struct{}{}
+	}
 }
 
-// ClearCategory clears the "category" edge to the Category entity.
-func (m *RecurringExpenseMutation) ClearCategory() {
-	m.clearedcategory = true
+// ClearRecurringExpenses clears the "recurring_expenses" edge to the RecurringExpense entity.
+func (m *HouseholdMutation) ClearRecurringExpenses() {
+	m.clearedrecurring_expenses = true
 }
 
-// CategoryCleared reports if the "category" edge to the Category entity was cleared.
-func (m *RecurringExpenseMutation) CategoryCleared() bool {
-	return m.clearedcategory
+// RecurringExpensesCleared reports if the "recurring_expenses" edge to the RecurringExpense entity was cleared.
+func (m *HouseholdMutation) RecurringExpensesCleared() bool {
+	return m.clearedrecurring_expenses
 }
 
-// CategoryID returns the "category" edge ID in the mutation.
-func (m *RecurringExpenseMutation) CategoryID() (id int, exists bool) {
-	if m.category != nil {
-		return *m.category, true
+// RemoveRecurringExpenseIDs removes the "recurring_expenses" edge to the RecurringExpense entity by IDs.
+func (m *HouseholdMutation) RemoveRecurringExpenseIDs(ids ...int) {
+	if m.removedrecurring_expenses == nil {
+		m.removedrecurring_expenses = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recurring_expenses, ids[i])
+		m.removedrecurring_expenses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurringExpenses returns the removed IDs of the "recurring_expenses" edge to the RecurringExpense entity.
+func (m *HouseholdMutation) RemovedRecurringExpensesIDs() (ids []int) {
+	for id := range m.removedrecurring_expenses {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// CategoryIDs returns the "category" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *RecurringExpenseMutation) CategoryIDs() (ids []int) {
-	if id := m.category; id != nil {
-		ids = append(ids, *id)
+// RecurringExpensesIDs returns the "recurring_expenses" edge IDs in the mutation.
+func (m *HouseholdMutation) RecurringExpensesIDs() (ids []int) {
+	for id := range m.recurring_expenses {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCategory resets all changes to the "category" edge.
-func (m *RecurringExpenseMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
+// ResetRecurringExpenses resets all changes to the "recurring_expenses" edge.
+func (m *HouseholdMutation) ResetRecurringExpenses() {
+	m.recurring_expenses = nil
+	m.clearedrecurring_expenses = false
+	m.removedrecurring_expenses = nil
 }
 
-// AddScheduleOverrideIDs adds the "schedule_overrides" edge to the RecurringScheduleOverride entity by ids.
-func (m *RecurringExpenseMutation) AddScheduleOverrideIDs(ids ...int) {
-	if m.schedule_overrides == nil {
-		m.schedule_overrides = make(map[int]struct{})
+// AddMonthlyAggregateIDs adds the "monthly_aggregates" edge to the MonthlyAggregate entity by ids.
+func (m *HouseholdMutation) AddMonthlyAggregateIDs(ids ...int) {
+	if m.monthly_aggregates == nil {
+		m.monthly_aggregates = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.schedule_overrides[ids[i]] = struct{}{}
+		m.monthly_aggregates[ids[i]] = struct{}{}
 	}
 }
 
-// ClearScheduleOverrides clears the "schedule_overrides" edge to the RecurringScheduleOverride entity.
-func (m *RecurringExpenseMutation) ClearScheduleOverrides() {
-	m.clearedschedule_overrides = true
+// ClearMonthlyAggregates clears the "monthly_aggregates" edge to the MonthlyAggregate entity.
+func (m *HouseholdMutation) ClearMonthlyAggregates() {
+	m.clearedmonthly_aggregates = true
 }
 
-// ScheduleOverridesCleared reports if the "schedule_overrides" edge to the RecurringScheduleOverride entity was cleared.
-func (m *RecurringExpenseMutation) ScheduleOverridesCleared() bool {
-	return m.clearedschedule_overrides
+// MonthlyAggregatesCleared reports if the "monthly_aggregates" edge to the MonthlyAggregate entity was cleared.
+func (m *HouseholdMutation) MonthlyAggregatesCleared() bool {
+	return m.clearedmonthly_aggregates
 }
 
-// RemoveScheduleOverrideIDs removes the "schedule_overrides" edge to the RecurringScheduleOverride entity by IDs.
-func (m *RecurringExpenseMutation) RemoveScheduleOverrideIDs(ids ...int) {
-	if m.removedschedule_overrides == nil {
-		m.removedschedule_overrides = make(map[int]struct{})
+// RemoveMonthlyAggregateIDs removes the "monthly_aggregates" edge to the MonthlyAggregate entity by IDs.
+func (m *HouseholdMutation) RemoveMonthlyAggregateIDs(ids ...int) {
+	if m.removedmonthly_aggregates == nil {
+		m.removedmonthly_aggregates = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.schedule_overrides, ids[i])
-		m.removedschedule_overrides[ids[i]] = struct{}{}
+		delete(m.monthly_aggregates, ids[i])
+		m.removedmonthly_aggregates[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedScheduleOverrides returns the removed IDs of the "schedule_overrides" edge to the RecurringScheduleOverride entity.
-func (m *RecurringExpenseMutation) RemovedScheduleOverridesIDs() (ids []int) {
-	for id := range m.removedschedule_overrides {
+// RemovedMonthlyAggregates returns the removed IDs of the "monthly_aggregates" edge to the MonthlyAggregate entity.
+func (m *HouseholdMutation) RemovedMonthlyAggregatesIDs() (ids []int) {
+	for id := range m.removedmonthly_aggregates {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ScheduleOverridesIDs returns the "schedule_overrides" edge IDs in the mutation.
-func (m *RecurringExpenseMutation) ScheduleOverridesIDs() (ids []int) {
-	for id := range m.schedule_overrides {
+// MonthlyAggregatesIDs returns the "monthly_aggregates" edge IDs in the mutation.
+func (m *HouseholdMutation) MonthlyAggregatesIDs() (ids []int) {
+	for id := range m.monthly_aggregates {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetScheduleOverrides resets all changes to the "schedule_overrides" edge.
-func (m *RecurringExpenseMutation) ResetScheduleOverrides() {
-	m.schedule_overrides = nil
-	m.clearedschedule_overrides = false
-	m.removedschedule_overrides = nil
+// ResetMonthlyAggregates resets all changes to the "monthly_aggregates" edge.
+func (m *HouseholdMutation) ResetMonthlyAggregates() {
+	m.monthly_aggregates = nil
+	m.clearedmonthly_aggregates = false
+	m.removedmonthly_aggregates = nil
 }
 
-// Where appends a list predicates to the RecurringExpenseMutation builder.
-func (m *RecurringExpenseMutation) Where(ps ...predicate.RecurringExpense) {
+// SetAggregateGenerationID sets the "aggregate_generation" edge to the AggregateGeneration entity by id.
+func (m *HouseholdMutation) SetAggregateGenerationID(id int) {
+	m.aggregate_generation = &id
+}
+
+// ClearAggregateGeneration clears the "aggregate_generation" edge to the AggregateGeneration entity.
+func (m *HouseholdMutation) ClearAggregateGeneration() {
+	m.clearedaggregate_generation = true
+}
+
+// AggregateGenerationCleared reports if the "aggregate_generation" edge to the AggregateGeneration entity was cleared.
+func (m *HouseholdMutation) AggregateGenerationCleared() bool {
+	return m.clearedaggregate_generation
+}
+
+// AggregateGenerationID returns the "aggregate_generation" edge ID in the mutation.
+func (m *HouseholdMutation) AggregateGenerationID() (id int, exists bool) {
+	if m.aggregate_generation != nil {
+		return *m.aggregate_generation, true
+	}
+	return
+}
+
+// AggregateGenerationIDs returns the "aggregate_generation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AggregateGenerationID instead. It exists only for internal usage by the builders.
+func (m *HouseholdMutation) AggregateGenerationIDs() (ids []int) {
+	if id := m.aggregate_generation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAggregateGeneration resets all changes to the "aggregate_generation" edge.
+func (m *HouseholdMutation) ResetAggregateGeneration() {
+	m.aggregate_generation = nil
+	m.clearedaggregate_generation = false
+}
+
+// AddMemberIDs adds the "members" edge to the HouseholdMember entity by ids.
+func (m *HouseholdMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the HouseholdMember entity.
+func (m *HouseholdMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the HouseholdMember entity was cleared.
+func (m *HouseholdMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the HouseholdMember entity by IDs.
+func (m *HouseholdMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the HouseholdMember entity.
+func (m *HouseholdMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *HouseholdMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *HouseholdMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by ids.
+func (m *HouseholdMutation) AddSettlementIDs(ids ...int) {
+	if m.settlements == nil {
+		m.settlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.settlements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSettlements clears the "settlements" edge to the Settlement entity.
+func (m *HouseholdMutation) ClearSettlements() {
+	m.clearedsettlements = true
+}
+
+// SettlementsCleared reports if the "settlements" edge to the Settlement entity was cleared.
+func (m *HouseholdMutation) SettlementsCleared() bool {
+	return m.clearedsettlements
+}
+
+// RemoveSettlementIDs removes the "settlements" edge to the Settlement entity by IDs.
+func (m *HouseholdMutation) RemoveSettlementIDs(ids ...int) {
+	if m.removedsettlements == nil {
+		m.removedsettlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.settlements, ids[i])
+		m.removedsettlements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSettlements returns the removed IDs of the "settlements" edge to the Settlement entity.
+func (m *HouseholdMutation) RemovedSettlementsIDs() (ids []int) {
+	for id := range m.removedsettlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SettlementsIDs returns the "settlements" edge IDs in the mutation.
+func (m *HouseholdMutation) SettlementsIDs() (ids []int) {
+	for id := range m.settlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSettlements resets all changes to the "settlements" edge.
+func (m *HouseholdMutation) ResetSettlements() {
+	m.settlements = nil
+	m.clearedsettlements = false
+	m.removedsettlements = nil
+}
+
+// SetTransferID sets the "transfer" edge to the HouseholdTransfer entity by id.
+func (m *HouseholdMutation) SetTransferID(id int) {
+	m.transfer = &id
+}
+
+// ClearTransfer clears the "transfer" edge to the HouseholdTransfer entity.
+func (m *HouseholdMutation) ClearTransfer() {
+	m.clearedtransfer = true
+}
+
+// TransferCleared reports if the "transfer" edge to the HouseholdTransfer entity was cleared.
+func (m *HouseholdMutation) TransferCleared() bool {
+	return m.clearedtransfer
+}
+
+// TransferID returns the "transfer" edge ID in the mutation.
+func (m *HouseholdMutation) TransferID() (id int, exists bool) {
+	if m.transfer != nil {
+		return *m.transfer, true
+	}
+	return
+}
+
+// TransferIDs returns the "transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransferID instead. It exists only for internal usage by the builders.
+func (m *HouseholdMutation) TransferIDs() (ids []int) {
+	if id := m.transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransfer resets all changes to the "transfer" edge.
+func (m *HouseholdMutation) ResetTransfer() {
+	m.transfer = nil
+	m.clearedtransfer = false
+}
+
+// Where appends a list predicates to the HouseholdMutation builder.
+func (m *HouseholdMutation) Where(ps ...predicate.Household) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RecurringExpenseMutation builder. Using this method,
+// WhereP appends storage-level predicates to the HouseholdMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RecurringExpenseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RecurringExpense, len(ps))
+func (m *HouseholdMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Household, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3067,54 +3121,45 @@ func (m *RecurringExpenseMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RecurringExpenseMutation) Op() Op {
+func (m *HouseholdMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RecurringExpenseMutation) SetOp(op Op) {
+func (m *HouseholdMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RecurringExpense).
-func (m *RecurringExpenseMutation) Type() string {
+// Type returns the node type of this mutation (Household).
+func (m *HouseholdMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RecurringExpenseMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *HouseholdMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.name != nil {
-		fields = append(fields, recurringexpense.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, recurringexpense.FieldDescription)
-	}
-	if m.details != nil {
-		fields = append(fields, recurringexpense.FieldDetails)
-	}
-	if m.amount != nil {
-		fields = append(fields, recurringexpense.FieldAmount)
-	}
-	if m.frequency != nil {
-		fields = append(fields, recurringexpense.FieldFrequency)
+		fields = append(fields, household.FieldName)
 	}
-	if m.active != nil {
-		fields = append(fields, recurringexpense.FieldActive)
+	if m.currency != nil {
+		fields = append(fields, household.FieldCurrency)
 	}
-	if m.start_date != nil {
-		fields = append(fields, recurringexpense.FieldStartDate)
+	if m.description != nil {
+		fields = append(fields, household.FieldDescription)
 	}
-	if m.end_date != nil {
-		fields = append(fields, recurringexpense.FieldEndDate)
+	if m.icon != nil {
+		fields = append(fields, household.FieldIcon)
 	}
 	if m.created_at != nil {
-		fields = append(fields, recurringexpense.FieldCreatedAt)
+		fields = append(fields, household.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, recurringexpense.FieldUpdatedAt)
+		fields = append(fields, household.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, household.FieldDeletedAt)
 	}
 	return fields
 }
@@ -3122,28 +3167,22 @@ func (m *RecurringExpenseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RecurringExpenseMutation) Field(name string) (ent.Value, bool) {
+func (m *HouseholdMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case recurringexpense.FieldName:
+	case household.FieldName:
 		return m.Name()
-	case recurringexpense.FieldDescription:
+	case household.FieldCurrency:
+		return m.Currency()
+	case household.FieldDescription:
 		return m.Description()
-	case recurringexpense.FieldDetails:
-		return m.Details()
-	case recurringexpense.FieldAmount:
-		return m.Amount()
-	case recurringexpense.FieldFrequency:
-		return m.Frequency()
-	case recurringexpense.FieldActive:
-		return m.Active()
-	case recurringexpense.FieldStartDate:
-		return m.StartDate()
-	case recurringexpense.FieldEndDate:
-		return m.EndDate()
-	case recurringexpense.FieldCreatedAt:
+	case household.FieldIcon:
+		return m.Icon()
+	case household.FieldCreatedAt:
 		return m.CreatedAt()
-	case recurringexpense.FieldUpdatedAt:
+	case household.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case household.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -3151,264 +3190,325 @@ func (m *RecurringExpenseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RecurringExpenseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *HouseholdMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case recurringexpense.FieldName:
+	case household.FieldName:
 		return m.OldName(ctx)
-	case recurringexpense.FieldDescription:
+	case household.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case household.FieldDescription:
 		return m.OldDescription(ctx)
-	case recurringexpense.FieldDetails:
-		return m.OldDetails(ctx)
-	case recurringexpense.FieldAmount:
-		return m.OldAmount(ctx)
-	case recurringexpense.FieldFrequency:
-		return m.OldFrequency(ctx)
-	case recurringexpense.FieldActive:
-		return m.OldActive(ctx)
-	case recurringexpense.FieldStartDate:
-		return m.OldStartDate(ctx)
-	case recurringexpense.FieldEndDate:
-		return m.OldEndDate(ctx)
-	case recurringexpense.FieldCreatedAt:
+	case household.FieldIcon:
+		return m.OldIcon(ctx)
+	case household.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case recurringexpense.FieldUpdatedAt:
+	case household.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case household.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RecurringExpense field %s", name)
+	return nil, fmt.Errorf("unknown Household field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RecurringExpenseMutation) SetField(name string, value ent.Value) error {
+func (m *HouseholdMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case recurringexpense.FieldName:
+	case household.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case recurringexpense.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case recurringexpense.FieldDetails:
+	case household.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDetails(v)
+		m.SetCurrency(v)
 		return nil
-	case recurringexpense.FieldAmount:
+	case household.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetDescription(v)
 		return nil
-	case recurringexpense.FieldFrequency:
+	case household.FieldIcon:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFrequency(v)
-		return nil
-	case recurringexpense.FieldActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActive(v)
-		return nil
-	case recurringexpense.FieldStartDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartDate(v)
+		m.SetIcon(v)
 		return nil
-	case recurringexpense.FieldEndDate:
+	case household.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndDate(v)
+		m.SetCreatedAt(v)
 		return nil
-	case recurringexpense.FieldCreatedAt:
+	case household.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case recurringexpense.FieldUpdatedAt:
+	case household.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringExpense field %s", name)
+	return fmt.Errorf("unknown Household field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RecurringExpenseMutation) AddedFields() []string {
+func (m *HouseholdMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RecurringExpenseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *HouseholdMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RecurringExpenseMutation) AddField(name string, value ent.Value) error {
+func (m *HouseholdMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RecurringExpense numeric field %s", name)
+	return fmt.Errorf("unknown Household numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RecurringExpenseMutation) ClearedFields() []string {
+func (m *HouseholdMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(recurringexpense.FieldDescription) {
-		fields = append(fields, recurringexpense.FieldDescription)
+	if m.FieldCleared(household.FieldDescription) {
+		fields = append(fields, household.FieldDescription)
 	}
-	if m.FieldCleared(recurringexpense.FieldDetails) {
-		fields = append(fields, recurringexpense.FieldDetails)
+	if m.FieldCleared(household.FieldIcon) {
+		fields = append(fields, household.FieldIcon)
 	}
-	if m.FieldCleared(recurringexpense.FieldEndDate) {
-		fields = append(fields, recurringexpense.FieldEndDate)
+	if m.FieldCleared(household.FieldDeletedAt) {
+		fields = append(fields, household.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RecurringExpenseMutation) FieldCleared(name string) bool {
+func (m *HouseholdMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RecurringExpenseMutation) ClearField(name string) error {
+func (m *HouseholdMutation) ClearField(name string) error {
 	switch name {
-	case recurringexpense.FieldDescription:
+	case household.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case recurringexpense.FieldDetails:
-		m.ClearDetails()
+	case household.FieldIcon:
+		m.ClearIcon()
 		return nil
-	case recurringexpense.FieldEndDate:
-		m.ClearEndDate()
+	case household.FieldDeletedAt:
+		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringExpense nullable field %s", name)
+	return fmt.Errorf("unknown Household nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RecurringExpenseMutation) ResetField(name string) error {
+func (m *HouseholdMutation) ResetField(name string) error {
 	switch name {
-	case recurringexpense.FieldName:
+	case household.FieldName:
 		m.ResetName()
 		return nil
-	case recurringexpense.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case recurringexpense.FieldDetails:
-		m.ResetDetails()
-		return nil
-	case recurringexpense.FieldAmount:
-		m.ResetAmount()
-		return nil
-	case recurringexpense.FieldFrequency:
-		m.ResetFrequency()
-		return nil
-	case recurringexpense.FieldActive:
-		m.ResetActive()
+	case household.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case recurringexpense.FieldStartDate:
-		m.ResetStartDate()
+	case household.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case recurringexpense.FieldEndDate:
-		m.ResetEndDate()
+	case household.FieldIcon:
+		m.ResetIcon()
 		return nil
-	case recurringexpense.FieldCreatedAt:
+	case household.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case recurringexpense.FieldUpdatedAt:
+	case household.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case household.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown RecurringExpense field %s", name)
+	return fmt.Errorf("unknown Household field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RecurringExpenseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.household != nil {
-		edges = append(edges, recurringexpense.EdgeHousehold)
+func (m *HouseholdMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.owner != nil {
+		edges = append(edges, household.EdgeOwner)
 	}
-	if m.category != nil {
-		edges = append(edges, recurringexpense.EdgeCategory)
+	if m.categories != nil {
+		edges = append(edges, household.EdgeCategories)
 	}
-	if m.schedule_overrides != nil {
-		edges = append(edges, recurringexpense.EdgeScheduleOverrides)
+	if m.transactions != nil {
+		edges = append(edges, household.EdgeTransactions)
+	}
+	if m.recurring_expenses != nil {
+		edges = append(edges, household.EdgeRecurringExpenses)
+	}
+	if m.monthly_aggregates != nil {
+		edges = append(edges, household.EdgeMonthlyAggregates)
+	}
+	if m.aggregate_generation != nil {
+		edges = append(edges, household.EdgeAggregateGeneration)
+	}
+	if m.members != nil {
+		edges = append(edges, household.EdgeMembers)
+	}
+	if m.settlements != nil {
+		edges = append(edges, household.EdgeSettlements)
+	}
+	if m.transfer != nil {
+		edges = append(edges, household.EdgeTransfer)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RecurringExpenseMutation) AddedIDs(name string) []ent.Value {
+func (m *HouseholdMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case recurringexpense.EdgeHousehold:
-		if id := m.household; id != nil {
+	case household.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
-	case recurringexpense.EdgeCategory:
-		if id := m.category; id != nil {
+	case household.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.categories))
+		for id := range m.categories {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeRecurringExpenses:
+		ids := make([]ent.Value, 0, len(m.recurring_expenses))
+		for id := range m.recurring_expenses {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeMonthlyAggregates:
+		ids := make([]ent.Value, 0, len(m.monthly_aggregates))
+		for id := range m.monthly_aggregates {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeAggregateGeneration:
+		if id := m.aggregate_generation; id != nil {
 			return []ent.Value{*id}
 		}
-	case recurringexpense.EdgeScheduleOverrides:
-		ids := make([]ent.Value, 0, len(m.schedule_overrides))
-		for id := range m.schedule_overrides {
+	case household.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeSettlements:
+		ids := make([]ent.Value, 0, len(m.settlements))
+		for id := range m.settlements {
 			ids = append(ids, id)
 		}
 		return ids
+	case household.EdgeTransfer:
+		if id := m.transfer; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RecurringExpenseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedschedule_overrides != nil {
-		edges = append(edges, recurringexpense.EdgeScheduleOverrides)
+func (m *HouseholdMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedcategories != nil {
+		edges = append(edges, household.EdgeCategories)
+	}
+	if m.removedtransactions != nil {
+		edges = append(edges, household.EdgeTransactions)
+	}
+	if m.removedrecurring_expenses != nil {
+		edges = append(edges, household.EdgeRecurringExpenses)
+	}
+	if m.removedmonthly_aggregates != nil {
+		edges = append(edges, household.EdgeMonthlyAggregates)
+	}
+	if m.removedmembers != nil {
+		edges = append(edges, household.EdgeMembers)
+	}
+	if m.removedsettlements != nil {
+		edges = append(edges, household.EdgeSettlements)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RecurringExpenseMutation) RemovedIDs(name string) []ent.Value {
+func (m *HouseholdMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case recurringexpense.EdgeScheduleOverrides:
-		ids := make([]ent.Value, 0, len(m.removedschedule_overrides))
-		for id := range m.removedschedule_overrides {
+	case household.EdgeCategories:
+		ids := make([]ent.Value, 0, len(m.removedcategories))
+		for id := range m.removedcategories {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeRecurringExpenses:
+		ids := make([]ent.Value, 0, len(m.removedrecurring_expenses))
+		for id := range m.removedrecurring_expenses {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeMonthlyAggregates:
+		ids := make([]ent.Value, 0, len(m.removedmonthly_aggregates))
+		for id := range m.removedmonthly_aggregates {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case household.EdgeSettlements:
+		ids := make([]ent.Value, 0, len(m.removedsettlements))
+		for id := range m.removedsettlements {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3417,95 +3517,156 @@ func (m *RecurringExpenseMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RecurringExpenseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedhousehold {
-		edges = append(edges, recurringexpense.EdgeHousehold)
+func (m *HouseholdMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedowner {
+		edges = append(edges, household.EdgeOwner)
 	}
-	if m.clearedcategory {
-		edges = append(edges, recurringexpense.EdgeCategory)
+	if m.clearedcategories {
+		edges = append(edges, household.EdgeCategories)
 	}
-	if m.clearedschedule_overrides {
-		edges = append(edges, recurringexpense.EdgeScheduleOverrides)
+	if m.clearedtransactions {
+		edges = append(edges, household.EdgeTransactions)
+	}
+	if m.clearedrecurring_expenses {
+		edges = append(edges, household.EdgeRecurringExpenses)
+	}
+	if m.clearedmonthly_aggregates {
+		edges = append(edges, household.EdgeMonthlyAggregates)
+	}
+	if m.clearedaggregate_generation {
+		edges = append(edges, household.EdgeAggregateGeneration)
+	}
+	if m.clearedmembers {
+		edges = append(edges, household.EdgeMembers)
+	}
+	if m.clearedsettlements {
+		edges = append(edges, household.EdgeSettlements)
+	}
+	if m.clearedtransfer {
+		edges = append(edges, household.EdgeTransfer)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RecurringExpenseMutation) EdgeCleared(name string) bool {
+func (m *HouseholdMutation) EdgeCleared(name string) bool {
 	switch name {
-	case recurringexpense.EdgeHousehold:
-		return m.clearedhousehold
-	case recurringexpense.EdgeCategory:
-		return m.clearedcategory
-	case recurringexpense.EdgeScheduleOverrides:
-		return m.clearedschedule_overrides
+	case household.EdgeOwner:
+		return m.clearedowner
+	case household.EdgeCategories:
+		return m.clearedcategories
+	case household.EdgeTransactions:
+		return m.clearedtransactions
+	case household.EdgeRecurringExpenses:
+		return m.clearedrecurring_expenses
+	case household.EdgeMonthlyAggregates:
+		return m.clearedmonthly_aggregates
+	case household.EdgeAggregateGeneration:
+		return m.clearedaggregate_generation
+	case household.EdgeMembers:
+		return m.clearedmembers
+	case household.EdgeSettlements:
+		return m.clearedsettlements
+	case household.EdgeTransfer:
+		return m.clearedtransfer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RecurringExpenseMutation) ClearEdge(name string) error {
+func (m *HouseholdMutation) ClearEdge(name string) error {
 	switch name {
-	case recurringexpense.EdgeHousehold:
-		m.ClearHousehold()
+	case household.EdgeOwner:
+		m.ClearOwner()
 		return nil
-	case recurringexpense.EdgeCategory:
-		m.ClearCategory()
+	case household.EdgeAggregateGeneration:
+		m.ClearAggregateGeneration()
+		return nil
+	case household.EdgeTransfer:
+		m.ClearTransfer()
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringExpense unique edge %s", name)
+	return fmt.Errorf("unknown Household unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RecurringExpenseMutation) ResetEdge(name string) error {
+func (m *HouseholdMutation) ResetEdge(name string) error {
 	switch name {
-	case recurringexpense.EdgeHousehold:
-		m.ResetHousehold()
+	case household.EdgeOwner:
+		m.ResetOwner()
 		return nil
-	case recurringexpense.EdgeCategory:
-		m.ResetCategory()
+	case household.EdgeCategories:
+		m.ResetCategories()
 		return nil
-	case recurringexpense.EdgeScheduleOverrides:
-		m.ResetScheduleOverrides()
+	case household.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case household.EdgeRecurringExpenses:
+		m.ResetRecurringExpenses()
+		return nil
+	case household.EdgeMonthlyAggregates:
+		m.ResetMonthlyAggregates()
+		return nil
+	case household.EdgeAggregateGeneration:
+		m.ResetAggregateGeneration()
+		return nil
+	case household.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case household.EdgeSettlements:
+		m.ResetSettlements()
+		return nil
+	case household.EdgeTransfer:
+		m.ResetTransfer()
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringExpense edge %s", name)
+	return fmt.Errorf("unknown Household edge %s", name)
 }
 
-// RecurringScheduleOverrideMutation represents an operation that mutates the RecurringScheduleOverride nodes in the graph.
-type RecurringScheduleOverrideMutation struct {
+// HouseholdMemberMutation represents an operation that mutates the HouseholdMember nodes in the graph.
+type HouseholdMemberMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int
-	effective_date           *time.Time
-	amount                   *string
-	frequency                *string
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	recurring_expense        *int
-	clearedrecurring_expense bool
-	done                     bool
-	oldValue                 func(context.Context) (*RecurringScheduleOverride, error)
-	predicates               []predicate.RecurringScheduleOverride
-}
-
-var _ ent.Mutation = (*RecurringScheduleOverrideMutation)(nil)
-
-// recurringscheduleoverrideOption allows management of the mutation configuration using functional options.
-type recurringscheduleoverrideOption func(*RecurringScheduleOverrideMutation)
-
-// newRecurringScheduleOverrideMutation creates new mutation for the RecurringScheduleOverride entity.
-func newRecurringScheduleOverrideMutation(c config, op Op, opts ...recurringscheduleoverrideOption) *RecurringScheduleOverrideMutation {
-	m := &RecurringScheduleOverrideMutation{
+	op                             Op
+	typ                            string
+	id                             *int
+	name                           *string
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	household                      *int
+	clearedhousehold               bool
+	paid_transactions              map[int]struct{}
+	removedpaid_transactions       map[int]struct{}
+	clearedpaid_transactions       bool
+	paid_recurring_expenses        map[int]struct{}
+	removedpaid_recurring_expenses map[int]struct{}
+	clearedpaid_recurring_expenses bool
+	settlements_paid               map[int]struct{}
+	removedsettlements_paid        map[int]struct{}
+	clearedsettlements_paid        bool
+	settlements_received           map[int]struct{}
+	removedsettlements_received    map[int]struct{}
+	clearedsettlements_received    bool
+	done                           bool
+	oldValue                       func(context.Context) (*HouseholdMember, error)
+	predicates                     []predicate.HouseholdMember
+}
+
+var _ ent.Mutation = (*HouseholdMemberMutation)(nil)
+
+// householdmemberOption allows management of the mutation configuration using functional options.
+type householdmemberOption func(*HouseholdMemberMutation)
+
+// newHouseholdMemberMutation creates new mutation for the HouseholdMember entity.
+func newHouseholdMemberMutation(c config, op Op, opts ...householdmemberOption) *HouseholdMemberMutation {
+	m := &HouseholdMemberMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRecurringScheduleOverride,
+		typ:           TypeHouseholdMember,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3514,20 +3675,20 @@ func newRecurringScheduleOverrideMutation(c config, op Op, opts ...recurringsche
 	return m
 }
 
-// withRecurringScheduleOverrideID sets the ID field of the mutation.
-func withRecurringScheduleOverrideID(id int) recurringscheduleoverrideOption {
-	return func(m *RecurringScheduleOverrideMutation) {
+// withHouseholdMemberID sets the ID field of the mutation.
+func withHouseholdMemberID(id int) householdmemberOption {
+	return func(m *HouseholdMemberMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RecurringScheduleOverride
+			value *HouseholdMember
 		)
-		m.oldValue = func(ctx context.Context) (*RecurringScheduleOverride, error) {
+		m.oldValue = func(ctx context.Context) (*HouseholdMember, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RecurringScheduleOverride.Get(ctx, id)
+					value, err = m.Client().HouseholdMember.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3536,10 +3697,10 @@ func withRecurringScheduleOverrideID(id int) recurringscheduleoverrideOption {
 	}
 }
 
-// withRecurringScheduleOverride sets the old RecurringScheduleOverride of the mutation.
-func withRecurringScheduleOverride(node *RecurringScheduleOverride) recurringscheduleoverrideOption {
-	return func(m *RecurringScheduleOverrideMutation) {
-		m.oldValue = func(context.Context) (*RecurringScheduleOverride, error) {
+// withHouseholdMember sets the old HouseholdMember of the mutation.
+func withHouseholdMember(node *HouseholdMember) householdmemberOption {
+	return func(m *HouseholdMemberMutation) {
+		m.oldValue = func(context.Context) (*HouseholdMember, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3548,7 +3709,7 @@ func withRecurringScheduleOverride(node *RecurringScheduleOverride) recurringsch
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RecurringScheduleOverrideMutation) Client() *Client {
+func (m HouseholdMemberMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3556,7 +3717,7 @@ func (m RecurringScheduleOverrideMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RecurringScheduleOverrideMutation) Tx() (*Tx, error) {
+func (m HouseholdMemberMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3567,7 +3728,7 @@ func (m RecurringScheduleOverrideMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RecurringScheduleOverrideMutation) ID() (id int, exists bool) {
+func (m *HouseholdMemberMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3578,7 +3739,7 @@ func (m *RecurringScheduleOverrideMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RecurringScheduleOverrideMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *HouseholdMemberMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3587,127 +3748,55 @@ func (m *RecurringScheduleOverrideMutation) IDs(ctx context.Context) ([]int, err
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RecurringScheduleOverride.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().HouseholdMember.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEffectiveDate sets the "effective_date" field.
-func (m *RecurringScheduleOverrideMutation) SetEffectiveDate(t time.Time) {
-	m.effective_date = &t
-}
-
-// EffectiveDate returns the value of the "effective_date" field in the mutation.
-func (m *RecurringScheduleOverrideMutation) EffectiveDate() (r time.Time, exists bool) {
-	v := m.effective_date
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEffectiveDate returns the old "effective_date" field's value of the RecurringScheduleOverride entity.
-// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringScheduleOverrideMutation) OldEffectiveDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEffectiveDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEffectiveDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEffectiveDate: %w", err)
-	}
-	return oldValue.EffectiveDate, nil
-}
-
-// ResetEffectiveDate resets all changes to the "effective_date" field.
-func (m *RecurringScheduleOverrideMutation) ResetEffectiveDate() {
-	m.effective_date = nil
-}
-
-// SetAmount sets the "amount" field.
-func (m *RecurringScheduleOverrideMutation) SetAmount(s string) {
-	m.amount = &s
-}
-
-// Amount returns the value of the "amount" field in the mutation.
-func (m *RecurringScheduleOverrideMutation) Amount() (r string, exists bool) {
-	v := m.amount
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAmount returns the old "amount" field's value of the RecurringScheduleOverride entity.
-// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringScheduleOverrideMutation) OldAmount(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
-	}
-	return oldValue.Amount, nil
-}
-
-// ResetAmount resets all changes to the "amount" field.
-func (m *RecurringScheduleOverrideMutation) ResetAmount() {
-	m.amount = nil
-}
-
-// SetFrequency sets the "frequency" field.
-func (m *RecurringScheduleOverrideMutation) SetFrequency(s string) {
-	m.frequency = &s
+// SetName sets the "name" field.
+func (m *HouseholdMemberMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Frequency returns the value of the "frequency" field in the mutation.
-func (m *RecurringScheduleOverrideMutation) Frequency() (r string, exists bool) {
-	v := m.frequency
+// Name returns the value of the "name" field in the mutation.
+func (m *HouseholdMemberMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFrequency returns the old "frequency" field's value of the RecurringScheduleOverride entity.
-// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the HouseholdMember entity.
+// If the HouseholdMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringScheduleOverrideMutation) OldFrequency(ctx context.Context) (v string, err error) {
+func (m *HouseholdMemberMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFrequency requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Frequency, nil
+	return oldValue.Name, nil
 }
 
-// ResetFrequency resets all changes to the "frequency" field.
-func (m *RecurringScheduleOverrideMutation) ResetFrequency() {
-	m.frequency = nil
+// ResetName resets all changes to the "name" field.
+func (m *HouseholdMemberMutation) ResetName() {
+	m.name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RecurringScheduleOverrideMutation) SetCreatedAt(t time.Time) {
+func (m *HouseholdMemberMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RecurringScheduleOverrideMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *HouseholdMemberMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3715,10 +3804,10 @@ func (m *RecurringScheduleOverrideMutation) CreatedAt() (r time.Time, exists boo
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RecurringScheduleOverride entity.
-// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the HouseholdMember entity.
+// If the HouseholdMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringScheduleOverrideMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HouseholdMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3733,17 +3822,17 @@ func (m *RecurringScheduleOverrideMutation) OldCreatedAt(ctx context.Context) (v
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RecurringScheduleOverrideMutation) ResetCreatedAt() {
+func (m *HouseholdMemberMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *RecurringScheduleOverrideMutation) SetUpdatedAt(t time.Time) {
+func (m *HouseholdMemberMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RecurringScheduleOverrideMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *HouseholdMemberMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3751,10 +3840,10 @@ func (m *RecurringScheduleOverrideMutation) UpdatedAt() (r time.Time, exists boo
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RecurringScheduleOverride entity.
-// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the HouseholdMember entity.
+// If the HouseholdMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RecurringScheduleOverrideMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HouseholdMemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3769,174 +3858,362 @@ func (m *RecurringScheduleOverrideMutation) OldUpdatedAt(ctx context.Context) (v
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RecurringScheduleOverrideMutation) ResetUpdatedAt() {
+func (m *HouseholdMemberMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetRecurringExpenseID sets the "recurring_expense" edge to the RecurringExpense entity by id.
-func (m *RecurringScheduleOverrideMutation) SetRecurringExpenseID(id int) {
-	m.recurring_expense = &id
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *HouseholdMemberMutation) SetHouseholdID(id int) {
+	m.household = &id
 }
 
-// ClearRecurringExpense clears the "recurring_expense" edge to the RecurringExpense entity.
-func (m *RecurringScheduleOverrideMutation) ClearRecurringExpense() {
-	m.clearedrecurring_expense = true
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *HouseholdMemberMutation) ClearHousehold() {
+	m.clearedhousehold = true
 }
 
-// RecurringExpenseCleared reports if the "recurring_expense" edge to the RecurringExpense entity was cleared.
-func (m *RecurringScheduleOverrideMutation) RecurringExpenseCleared() bool {
-	return m.clearedrecurring_expense
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *HouseholdMemberMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
 }
 
-// RecurringExpenseID returns the "recurring_expense" edge ID in the mutation.
-func (m *RecurringScheduleOverrideMutation) RecurringExpenseID() (id int, exists bool) {
-	if m.recurring_expense != nil {
-		return *m.recurring_expense, true
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *HouseholdMemberMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
 	}
 	return
 }
 
-// RecurringExpenseIDs returns the "recurring_expense" edge IDs in the mutation.
+// HouseholdIDs returns the "household" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RecurringExpenseID instead. It exists only for internal usage by the builders.
-func (m *RecurringScheduleOverrideMutation) RecurringExpenseIDs() (ids []int) {
-	if id := m.recurring_expense; id != nil {
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *HouseholdMemberMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRecurringExpense resets all changes to the "recurring_expense" edge.
-func (m *RecurringScheduleOverrideMutation) ResetRecurringExpense() {
-	m.recurring_expense = nil
-	m.clearedrecurring_expense = false
+// ResetHousehold resets all changes to the "household" edge.
+func (m *HouseholdMemberMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
 }
 
-// Where appends a list predicates to the RecurringScheduleOverrideMutation builder.
-func (m *RecurringScheduleOverrideMutation) Where(ps ...predicate.RecurringScheduleOverride) {
-	m.predicates = append(m.predicates, ps...)
+// AddPaidTransactionIDs adds the "paid_transactions" edge to the Transaction entity by ids.
+func (m *HouseholdMemberMutation) AddPaidTransactionIDs(ids ...int) {
+	if m.paid_transactions == nil {
+		m.paid_transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.paid_transactions[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the RecurringScheduleOverrideMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RecurringScheduleOverrideMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RecurringScheduleOverride, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClearPaidTransactions clears the "paid_transactions" edge to the Transaction entity.
+func (m *HouseholdMemberMutation) ClearPaidTransactions() {
+	m.clearedpaid_transactions = true
+}
+
+// PaidTransactionsCleared reports if the "paid_transactions" edge to the Transaction entity was cleared.
+func (m *HouseholdMemberMutation) PaidTransactionsCleared() bool {
+	return m.clearedpaid_transactions
+}
+
+// RemovePaidTransactionIDs removes the "paid_transactions" edge to the Transaction entity by IDs.
+func (m *HouseholdMemberMutation) RemovePaidTransactionIDs(ids ...int) {
+	if m.removedpaid_transactions == nil {
+		m.removedpaid_transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.paid_transactions, ids[i])
+		m.removedpaid_transactions[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *RecurringScheduleOverrideMutation) Op() Op {
-	return m.op
+// RemovedPaidTransactions returns the removed IDs of the "paid_transactions" edge to the Transaction entity.
+func (m *HouseholdMemberMutation) RemovedPaidTransactionsIDs() (ids []int) {
+	for id := range m.removedpaid_transactions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RecurringScheduleOverrideMutation) SetOp(op Op) {
-	m.op = op
+// PaidTransactionsIDs returns the "paid_transactions" edge IDs in the mutation.
+func (m *HouseholdMemberMutation) PaidTransactionsIDs() (ids []int) {
+	for id := range m.paid_transactions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Type returns the node type of this mutation (RecurringScheduleOverride).
-func (m *RecurringScheduleOverrideMutation) Type() string {
-	return m.typ
+// ResetPaidTransactions resets all changes to the "paid_transactions" edge.
+func (m *HouseholdMemberMutation) ResetPaidTransactions() {
+	m.paid_transactions = nil
+	m.clearedpaid_transactions = false
+	m.removedpaid_transactions = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RecurringScheduleOverrideMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.effective_date != nil {
-		fields = append(fields, recurringscheduleoverride.FieldEffectiveDate)
+// AddPaidRecurringExpenseIDs adds the "paid_recurring_expenses" edge to the RecurringExpense entity by ids.
+func (m *HouseholdMemberMutation) AddPaidRecurringExpenseIDs(ids ...int) {
+	if m.paid_recurring_expenses == nil {
+		m.paid_recurring_expenses = make(map[int]struct{})
 	}
-	if m.amount != nil {
-		fields = append(fields, recurringscheduleoverride.FieldAmount)
+	for i := range ids {
+		m.paid_recurring_expenses[ids[i]] = struct{}{}
 	}
-	if m.frequency != nil {
-		fields = append(fields, recurringscheduleoverride.FieldFrequency)
+}
+
+// ClearPaidRecurringExpenses clears the "paid_recurring_expenses" edge to the RecurringExpense entity.
+func (m *HouseholdMemberMutation) ClearPaidRecurringExpenses() {
+	m.clearedpaid_recurring_expenses = true
+}
+
+// PaidRecurringExpensesCleared reports if the "paid_recurring_expenses" edge to the RecurringExpense entity was cleared.
+func (m *HouseholdMemberMutation) PaidRecurringExpensesCleared() bool {
+	return m.clearedpaid_recurring_expenses
+}
+
+// RemovePaidRecurringExpenseIDs removes the "paid_recurring_expenses" edge to the RecurringExpense entity by IDs.
+func (m *HouseholdMemberMutation) RemovePaidRecurringExpenseIDs(ids ...int) {
+	if m.removedpaid_recurring_expenses == nil {
+		m.removedpaid_recurring_expenses = make(map[int]struct{})
 	}
-	if m.created_at != nil {
-		fields = append(fields, recurringscheduleoverride.FieldCreatedAt)
+	for i := range ids {
+		delete(m.paid_recurring_expenses, ids[i])
+		m.removedpaid_recurring_expenses[ids[i]] = struct{}{}
 	}
-	if m.updated_at != nil {
-		fields = append(fields, recurringscheduleoverride.FieldUpdatedAt)
+}
+
+// RemovedPaidRecurringExpenses returns the removed IDs of the "paid_recurring_expenses" edge to the RecurringExpense entity.
+func (m *HouseholdMemberMutation) RemovedPaidRecurringExpensesIDs() (ids []int) {
+	for id := range m.removedpaid_recurring_expenses {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RecurringScheduleOverrideMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case recurringscheduleoverride.FieldEffectiveDate:
-		return m.EffectiveDate()
-	case recurringscheduleoverride.FieldAmount:
-		return m.Amount()
-	case recurringscheduleoverride.FieldFrequency:
-		return m.Frequency()
-	case recurringscheduleoverride.FieldCreatedAt:
-		return m.CreatedAt()
-	case recurringscheduleoverride.FieldUpdatedAt:
-		return m.UpdatedAt()
+// PaidRecurringExpensesIDs returns the "paid_recurring_expenses" edge IDs in the mutation.
+func (m *HouseholdMemberMutation) PaidRecurringExpensesIDs() (ids []int) {
+	for id := range m.paid_recurring_expenses {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RecurringScheduleOverrideMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+// ResetPaidRecurringExpenses resets all changes to the "paid_recurring_expenses" edge.
+func (m *HouseholdMemberMutation) ResetPaidRecurringExpenses() {
+	m.paid_recurring_expenses = nil
+	m.clearedpaid_recurring_expenses = false
+	m.removedpaid_recurring_expenses = nil
+}
+
+// AddSettlementsPaidIDs adds the "settlements_paid" edge to the Settlement entity by ids.
+func (m *HouseholdMemberMutation) AddSettlementsPaidIDs(ids ...int) {
+	if m.settlements_paid == nil {
+		m.settlements_paid = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.settlements_paid[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSettlementsPaid clears the "settlements_paid" edge to the Settlement entity.
+func (m *HouseholdMemberMutation) ClearSettlementsPaid() {
+	m.clearedsettlements_paid = true
+}
+
+// SettlementsPaidCleared reports if the "settlements_paid" edge to the Settlement entity was cleared.
+func (m *HouseholdMemberMutation) SettlementsPaidCleared() bool {
+	return m.clearedsettlements_paid
+}
+
+// RemoveSettlementsPaidIDs removes the "settlements_paid" edge to the Settlement entity by IDs.
+func (m *HouseholdMemberMutation) RemoveSettlementsPaidIDs(ids ...int) {
+	if m.removedsettlements_paid == nil {
+		m.removedsettlements_paid = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.settlements_paid, ids[i])
+		m.removedsettlements_paid[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSettlementsPaid returns the removed IDs of the "settlements_paid" edge to the Settlement entity.
+func (m *HouseholdMemberMutation) RemovedSettlementsPaidIDs() (ids []int) {
+	for id := range m.removedsettlements_paid {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SettlementsPaidIDs returns the "settlements_paid" edge IDs in the mutation.
+func (m *HouseholdMemberMutation) SettlementsPaidIDs() (ids []int) {
+	for id := range m.settlements_paid {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSettlementsPaid resets all changes to the "settlements_paid" edge.
+func (m *HouseholdMemberMutation) ResetSettlementsPaid() {
+	m.settlements_paid = nil
+	m.clearedsettlements_paid = false
+	m.removedsettlements_paid = nil
+}
+
+// AddSettlementsReceivedIDs adds the "settlements_received" edge to the Settlement entity by ids.
+func (m *HouseholdMemberMutation) AddSettlementsReceivedIDs(ids ...int) {
+	if m.settlements_received == nil {
+		m.settlements_received = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.settlements_received[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSettlementsReceived clears the "settlements_received" edge to the Settlement entity.
+func (m *HouseholdMemberMutation) ClearSettlementsReceived() {
+	m.clearedsettlements_received = true
+}
+
+// SettlementsReceivedCleared reports if the "settlements_received" edge to the Settlement entity was cleared.
+func (m *HouseholdMemberMutation) SettlementsReceivedCleared() bool {
+	return m.clearedsettlements_received
+}
+
+// RemoveSettlementsReceivedIDs removes the "settlements_received" edge to the Settlement entity by IDs.
+func (m *HouseholdMemberMutation) RemoveSettlementsReceivedIDs(ids ...int) {
+	if m.removedsettlements_received == nil {
+		m.removedsettlements_received = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.settlements_received, ids[i])
+		m.removedsettlements_received[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSettlementsReceived returns the removed IDs of the "settlements_received" edge to the Settlement entity.
+func (m *HouseholdMemberMutation) RemovedSettlementsReceivedIDs() (ids []int) {
+	for id := range m.removedsettlements_received {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SettlementsReceivedIDs returns the "settlements_received" edge IDs in the mutation.
+func (m *HouseholdMemberMutation) SettlementsReceivedIDs() (ids []int) {
+	for id := range m.settlements_received {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSettlementsReceived resets all changes to the "settlements_received" edge.
+func (m *HouseholdMemberMutation) ResetSettlementsReceived() {
+	m.settlements_received = nil
+	m.clearedsettlements_received = false
+	m.removedsettlements_received = nil
+}
+
+// Where appends a list predicates to the HouseholdMemberMutation builder.
+func (m *HouseholdMemberMutation) Where(ps ...predicate.HouseholdMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HouseholdMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HouseholdMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HouseholdMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HouseholdMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HouseholdMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HouseholdMember).
+func (m *HouseholdMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HouseholdMemberMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, householdmember.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, householdmember.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, householdmember.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HouseholdMemberMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case recurringscheduleoverride.FieldEffectiveDate:
-		return m.OldEffectiveDate(ctx)
-	case recurringscheduleoverride.FieldAmount:
-		return m.OldAmount(ctx)
-	case recurringscheduleoverride.FieldFrequency:
-		return m.OldFrequency(ctx)
-	case recurringscheduleoverride.FieldCreatedAt:
+	case householdmember.FieldName:
+		return m.Name()
+	case householdmember.FieldCreatedAt:
+		return m.CreatedAt()
+	case householdmember.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HouseholdMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case householdmember.FieldName:
+		return m.OldName(ctx)
+	case householdmember.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case recurringscheduleoverride.FieldUpdatedAt:
+	case householdmember.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RecurringScheduleOverride field %s", name)
+	return nil, fmt.Errorf("unknown HouseholdMember field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RecurringScheduleOverrideMutation) SetField(name string, value ent.Value) error {
+func (m *HouseholdMemberMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case recurringscheduleoverride.FieldEffectiveDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEffectiveDate(v)
-		return nil
-	case recurringscheduleoverride.FieldAmount:
+	case householdmember.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
-		return nil
-	case recurringscheduleoverride.FieldFrequency:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFrequency(v)
+		m.SetName(v)
 		return nil
-	case recurringscheduleoverride.FieldCreatedAt:
+	case householdmember.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case recurringscheduleoverride.FieldUpdatedAt:
+	case householdmember.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3944,177 +4221,276 @@ func (m *RecurringScheduleOverrideMutation) SetField(name string, value ent.Valu
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringScheduleOverride field %s", name)
+	return fmt.Errorf("unknown HouseholdMember field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RecurringScheduleOverrideMutation) AddedFields() []string {
+func (m *HouseholdMemberMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RecurringScheduleOverrideMutation) AddedField(name string) (ent.Value, bool) {
+func (m *HouseholdMemberMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RecurringScheduleOverrideMutation) AddField(name string, value ent.Value) error {
+func (m *HouseholdMemberMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RecurringScheduleOverride numeric field %s", name)
+	return fmt.Errorf("unknown HouseholdMember numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RecurringScheduleOverrideMutation) ClearedFields() []string {
+func (m *HouseholdMemberMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RecurringScheduleOverrideMutation) FieldCleared(name string) bool {
+func (m *HouseholdMemberMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RecurringScheduleOverrideMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RecurringScheduleOverride nullable field %s", name)
+func (m *HouseholdMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HouseholdMember nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RecurringScheduleOverrideMutation) ResetField(name string) error {
+func (m *HouseholdMemberMutation) ResetField(name string) error {
 	switch name {
-	case recurringscheduleoverride.FieldEffectiveDate:
-		m.ResetEffectiveDate()
-		return nil
-	case recurringscheduleoverride.FieldAmount:
-		m.ResetAmount()
-		return nil
-	case recurringscheduleoverride.FieldFrequency:
-		m.ResetFrequency()
+	case householdmember.FieldName:
+		m.ResetName()
 		return nil
-	case recurringscheduleoverride.FieldCreatedAt:
+	case householdmember.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case recurringscheduleoverride.FieldUpdatedAt:
+	case householdmember.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringScheduleOverride field %s", name)
+	return fmt.Errorf("unknown HouseholdMember field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RecurringScheduleOverrideMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.recurring_expense != nil {
-		edges = append(edges, recurringscheduleoverride.EdgeRecurringExpense)
+func (m *HouseholdMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.household != nil {
+		edges = append(edges, householdmember.EdgeHousehold)
+	}
+	if m.paid_transactions != nil {
+		edges = append(edges, householdmember.EdgePaidTransactions)
+	}
+	if m.paid_recurring_expenses != nil {
+		edges = append(edges, householdmember.EdgePaidRecurringExpenses)
+	}
+	if m.settlements_paid != nil {
+		edges = append(edges, householdmember.EdgeSettlementsPaid)
+	}
+	if m.settlements_received != nil {
+		edges = append(edges, householdmember.EdgeSettlementsReceived)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RecurringScheduleOverrideMutation) AddedIDs(name string) []ent.Value {
+func (m *HouseholdMemberMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case recurringscheduleoverride.EdgeRecurringExpense:
-		if id := m.recurring_expense; id != nil {
+	case householdmember.EdgeHousehold:
+		if id := m.household; id != nil {
 			return []ent.Value{*id}
 		}
+	case householdmember.EdgePaidTransactions:
+		ids := make([]ent.Value, 0, len(m.paid_transactions))
+		for id := range m.paid_transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case householdmember.EdgePaidRecurringExpenses:
+		ids := make([]ent.Value, 0, len(m.paid_recurring_expenses))
+		for id := range m.paid_recurring_expenses {
+			ids = append(ids, id)
+		}
+		return ids
+	case householdmember.EdgeSettlementsPaid:
+		ids := make([]ent.Value, 0, len(m.settlements_paid))
+		for id := range m.settlements_paid {
+			ids = append(ids, id)
+		}
+		return ids
+	case householdmember.EdgeSettlementsReceived:
+		ids := make([]ent.Value, 0, len(m.settlements_received))
+		for id := range m.settlements_received {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RecurringScheduleOverrideMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *HouseholdMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedpaid_transactions != nil {
+		edges = append(edges, householdmember.EdgePaidTransactions)
+	}
+	if m.removedpaid_recurring_expenses != nil {
+		edges = append(edges, householdmember.EdgePaidRecurringExpenses)
+	}
+	if m.removedsettlements_paid != nil {
+		edges = append(edges, householdmember.EdgeSettlementsPaid)
+	}
+	if m.removedsettlements_received != nil {
+		edges = append(edges, householdmember.EdgeSettlementsReceived)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RecurringScheduleOverrideMutation) RemovedIDs(name string) []ent.Value {
+func (m *HouseholdMemberMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case householdmember.EdgePaidTransactions:
+		ids := make([]ent.Value, 0, len(m.removedpaid_transactions))
+		for id := range m.removedpaid_transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case householdmember.EdgePaidRecurringExpenses:
+		ids := make([]ent.Value, 0, len(m.removedpaid_recurring_expenses))
+		for id := range m.removedpaid_recurring_expenses {
+			ids = append(ids, id)
+		}
+		return ids
+	case householdmember.EdgeSettlementsPaid:
+		ids := make([]ent.Value, 0, len(m.removedsettlements_paid))
+		for id := range m.removedsettlements_paid {
+			ids = append(ids, id)
+		}
+		return ids
+	case householdmember.EdgeSettlementsReceived:
+		ids := make([]ent.Value, 0, len(m.removedsettlements_received))
+		for id := range m.removedsettlements_received {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RecurringScheduleOverrideMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedrecurring_expense {
-		edges = append(edges, recurringscheduleoverride.EdgeRecurringExpense)
+func (m *HouseholdMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedhousehold {
+		edges = append(edges, householdmember.EdgeHousehold)
+	}
+	if m.clearedpaid_transactions {
+		edges = append(edges, householdmember.EdgePaidTransactions)
+	}
+	if m.clearedpaid_recurring_expenses {
+		edges = append(edges, householdmember.EdgePaidRecurringExpenses)
+	}
+	if m.clearedsettlements_paid {
+		edges = append(edges, householdmember.EdgeSettlementsPaid)
+	}
+	if m.clearedsettlements_received {
+		edges = append(edges, householdmember.EdgeSettlementsReceived)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RecurringScheduleOverrideMutation) EdgeCleared(name string) bool {
+func (m *HouseholdMemberMutation) EdgeCleared(name string) bool {
 	switch name {
-	case recurringscheduleoverride.EdgeRecurringExpense:
-		return m.clearedrecurring_expense
+	case householdmember.EdgeHousehold:
+		return m.clearedhousehold
+	case householdmember.EdgePaidTransactions:
+		return m.clearedpaid_transactions
+	case householdmember.EdgePaidRecurringExpenses:
+		return m.clearedpaid_recurring_expenses
+	case householdmember.EdgeSettlementsPaid:
+		return m.clearedsettlements_paid
+	case householdmember.EdgeSettlementsReceived:
+		return m.clearedsettlements_received
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RecurringScheduleOverrideMutation) ClearEdge(name string) error {
+func (m *HouseholdMemberMutation) ClearEdge(name string) error {
 	switch name {
-	case recurringscheduleoverride.EdgeRecurringExpense:
-		m.ClearRecurringExpense()
+	case householdmember.EdgeHousehold:
+		m.ClearHousehold()
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringScheduleOverride unique edge %s", name)
+	return fmt.Errorf("unknown HouseholdMember unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RecurringScheduleOverrideMutation) ResetEdge(name string) error {
+func (m *HouseholdMemberMutation) ResetEdge(name string) error {
 	switch name {
-	case recurringscheduleoverride.EdgeRecurringExpense:
-		m.ResetRecurringExpense()
+	case householdmember.EdgeHousehold:
+		m.ResetHousehold()
+		return nil
+	case householdmember.EdgePaidTransactions:
+		m.ResetPaidTransactions()
+		return nil
+	case householdmember.EdgePaidRecurringExpenses:
+		m.ResetPaidRecurringExpenses()
+		return nil
+	case householdmember.EdgeSettlementsPaid:
+		m.ResetSettlementsPaid()
+		return nil
+	case householdmember.EdgeSettlementsReceived:
+		m.ResetSettlementsReceived()
 		return nil
 	}
-	return fmt.Errorf("unknown RecurringScheduleOverride edge %s", name)
+	return fmt.Errorf("unknown HouseholdMember edge %s", name)
 }
 
-// SessionMutation represents an operation that mutates the Session nodes in the graph.
-type SessionMutation struct {
+// HouseholdTransferMutation represents an operation that mutates the HouseholdTransfer nodes in the graph.
+type HouseholdTransferMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	token         *string
-	data          *[]byte
-	user_id       *int
-	adduser_id    *int
-	expires_at    *time.Time
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Session, error)
-	predicates    []predicate.Session
+	op               Op
+	typ              string
+	id               *int
+	email            *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	household        *int
+	clearedhousehold bool
+	user             *int
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*HouseholdTransfer, error)
+	predicates       []predicate.HouseholdTransfer
 }
 
-var _ ent.Mutation = (*SessionMutation)(nil)
+var _ ent.Mutation = (*HouseholdTransferMutation)(nil)
 
-// sessionOption allows management of the mutation configuration using functional options.
-type sessionOption func(*SessionMutation)
+// householdtransferOption allows management of the mutation configuration using functional options.
+type householdtransferOption func(*HouseholdTransferMutation)
 
-// newSessionMutation creates new mutation for the Session entity.
-func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
-	m := &SessionMutation{
+// newHouseholdTransferMutation creates new mutation for the HouseholdTransfer entity.
+func newHouseholdTransferMutation(c config, op Op, opts ...householdtransferOption) *HouseholdTransferMutation {
+	m := &HouseholdTransferMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSession,
+		typ:           TypeHouseholdTransfer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4123,20 +4499,20 @@ func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation
 	return m
 }
 
-// withSessionID sets the ID field of the mutation.
-func withSessionID(id int) sessionOption {
-	return func(m *SessionMutation) {
+// withHouseholdTransferID sets the ID field of the mutation.
+func withHouseholdTransferID(id int) householdtransferOption {
+	return func(m *HouseholdTransferMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Session
+			value *HouseholdTransfer
 		)
-		m.oldValue = func(ctx context.Context) (*Session, error) {
+		m.oldValue = func(ctx context.Context) (*HouseholdTransfer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Session.Get(ctx, id)
+					value, err = m.Client().HouseholdTransfer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4145,10 +4521,10 @@ func withSessionID(id int) sessionOption {
 	}
 }
 
-// withSession sets the old Session of the mutation.
-func withSession(node *Session) sessionOption {
-	return func(m *SessionMutation) {
-		m.oldValue = func(context.Context) (*Session, error) {
+// withHouseholdTransfer sets the old HouseholdTransfer of the mutation.
+func withHouseholdTransfer(node *HouseholdTransfer) householdtransferOption {
+	return func(m *HouseholdTransferMutation) {
+		m.oldValue = func(context.Context) (*HouseholdTransfer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4157,7 +4533,7 @@ func withSession(node *Session) sessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SessionMutation) Client() *Client {
+func (m HouseholdTransferMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4165,7 +4541,7 @@ func (m SessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SessionMutation) Tx() (*Tx, error) {
+func (m HouseholdTransferMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4176,7 +4552,7 @@ func (m SessionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SessionMutation) ID() (id int, exists bool) {
+func (m *HouseholdTransferMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4187,7 +4563,7 @@ func (m *SessionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *HouseholdTransferMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4196,335 +4572,215 @@ func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().HouseholdTransfer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetToken sets the "token" field.
-func (m *SessionMutation) SetToken(s string) {
-	m.token = &s
+// SetEmail sets the "email" field.
+func (m *HouseholdTransferMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *SessionMutation) Token() (r string, exists bool) {
-	v := m.token
+// Email returns the value of the "email" field in the mutation.
+func (m *HouseholdTransferMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the HouseholdTransfer entity.
+// If the HouseholdTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *HouseholdTransferMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Email, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *SessionMutation) ResetToken() {
-	m.token = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *HouseholdTransferMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetData sets the "data" field.
-func (m *SessionMutation) SetData(b []byte) {
-	m.data = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *HouseholdTransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *SessionMutation) Data() (r []byte, exists bool) {
-	v := m.data
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HouseholdTransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldData returns the old "data" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the HouseholdTransfer entity.
+// If the HouseholdTransfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldData(ctx context.Context) (v []byte, err error) {
+func (m *HouseholdTransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Data, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *SessionMutation) ResetData() {
-	m.data = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HouseholdTransferMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *SessionMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *HouseholdTransferMutation) SetHouseholdID(id int) {
+	m.household = &id
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *SessionMutation) UserID() (r int, exists bool) {
-	v := m.user_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *HouseholdTransferMutation) ClearHousehold() {
+	m.clearedhousehold = true
 }
 
-// OldUserID returns the old "user_id" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldUserID(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *HouseholdTransferMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *SessionMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *HouseholdTransferMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
 	}
+	return
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *SessionMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
+// HouseholdIDs returns the "household" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *HouseholdTransferMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (m *SessionMutation) ClearUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-	m.clearedFields[session.FieldUserID] = struct{}{}
+// ResetHousehold resets all changes to the "household" edge.
+func (m *HouseholdTransferMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *SessionMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[session.FieldUserID]
-	return ok
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *HouseholdTransferMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *SessionMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
-	delete(m.clearedFields, session.FieldUserID)
+// ClearUser clears the "user" edge to the User entity.
+func (m *HouseholdTransferMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *SessionMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *HouseholdTransferMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *HouseholdTransferMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *HouseholdTransferMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.ExpiresAt, nil
-}
-
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *SessionMutation) ResetExpiresAt() {
-	m.expires_at = nil
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SessionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetUser resets all changes to the "user" edge.
+func (m *HouseholdTransferMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the HouseholdTransferMutation builder.
+func (m *HouseholdTransferMutation) Where(ps ...predicate.HouseholdTransfer) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// WhereP appends storage-level predicates to the HouseholdTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HouseholdTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HouseholdTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.CreatedAt, nil
+	m.Where(p...)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SessionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// Op returns the operation name.
+func (m *HouseholdTransferMutation) Op() Op {
+	return m.op
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SessionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetOp allows setting the mutation operation.
+func (m *HouseholdTransferMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (HouseholdTransfer).
+func (m *HouseholdTransferMutation) Type() string {
+	return m.typ
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HouseholdTransferMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.email != nil {
+		fields = append(fields, householdtransfer.FieldEmail)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, householdtransfer.FieldCreatedAt)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SessionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the SessionMutation builder.
-func (m *SessionMutation) Where(ps ...predicate.Session) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Session, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *SessionMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *SessionMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Session).
-func (m *SessionMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SessionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.token != nil {
-		fields = append(fields, session.FieldToken)
-	}
-	if m.data != nil {
-		fields = append(fields, session.FieldData)
-	}
-	if m.user_id != nil {
-		fields = append(fields, session.FieldUserID)
-	}
-	if m.expires_at != nil {
-		fields = append(fields, session.FieldExpiresAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, session.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, session.FieldUpdatedAt)
-	}
-	return fields
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+func (m *HouseholdTransferMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case session.FieldToken:
-		return m.Token()
-	case session.FieldData:
-		return m.Data()
-	case session.FieldUserID:
-		return m.UserID()
-	case session.FieldExpiresAt:
-		return m.ExpiresAt()
-	case session.FieldCreatedAt:
+	case householdtransfer.FieldEmail:
+		return m.Email()
+	case householdtransfer.FieldCreatedAt:
 		return m.CreatedAt()
-	case session.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4532,241 +4788,215 @@ func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *HouseholdTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case session.FieldToken:
-		return m.OldToken(ctx)
-	case session.FieldData:
-		return m.OldData(ctx)
-	case session.FieldUserID:
-		return m.OldUserID(ctx)
-	case session.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case session.FieldCreatedAt:
+	case householdtransfer.FieldEmail:
+		return m.OldEmail(ctx)
+	case householdtransfer.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case session.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Session field %s", name)
+	return nil, fmt.Errorf("unknown HouseholdTransfer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) SetField(name string, value ent.Value) error {
+func (m *HouseholdTransferMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case session.FieldToken:
+	case householdtransfer.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
-		return nil
-	case session.FieldData:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetData(v)
-		return nil
-	case session.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case session.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
+		m.SetEmail(v)
 		return nil
-	case session.FieldCreatedAt:
+	case householdtransfer.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case session.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown HouseholdTransfer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SessionMutation) AddedFields() []string {
-	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, session.FieldUserID)
-	}
-	return fields
+func (m *HouseholdTransferMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case session.FieldUserID:
-		return m.AddedUserID()
-	}
+func (m *HouseholdTransferMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) AddField(name string, value ent.Value) error {
+func (m *HouseholdTransferMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case session.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUserID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Session numeric field %s", name)
+	return fmt.Errorf("unknown HouseholdTransfer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SessionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(session.FieldUserID) {
-		fields = append(fields, session.FieldUserID)
-	}
-	return fields
+func (m *HouseholdTransferMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SessionMutation) FieldCleared(name string) bool {
+func (m *HouseholdTransferMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SessionMutation) ClearField(name string) error {
-	switch name {
-	case session.FieldUserID:
-		m.ClearUserID()
-		return nil
-	}
-	return fmt.Errorf("unknown Session nullable field %s", name)
+func (m *HouseholdTransferMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HouseholdTransfer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SessionMutation) ResetField(name string) error {
+func (m *HouseholdTransferMutation) ResetField(name string) error {
 	switch name {
-	case session.FieldToken:
-		m.ResetToken()
-		return nil
-	case session.FieldData:
-		m.ResetData()
-		return nil
-	case session.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case session.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case householdtransfer.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case session.FieldCreatedAt:
+	case householdtransfer.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case session.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown HouseholdTransfer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *HouseholdTransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.household != nil {
+		edges = append(edges, householdtransfer.EdgeHousehold)
+	}
+	if m.user != nil {
+		edges = append(edges, householdtransfer.EdgeUser)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+func (m *HouseholdTransferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case householdtransfer.EdgeHousehold:
+		if id := m.household; id != nil {
+			return []ent.Value{*id}
+		}
+	case householdtransfer.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *HouseholdTransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *HouseholdTransferMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *HouseholdTransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedhousehold {
+		edges = append(edges, householdtransfer.EdgeHousehold)
+	}
+	if m.cleareduser {
+		edges = append(edges, householdtransfer.EdgeUser)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SessionMutation) EdgeCleared(name string) bool {
+func (m *HouseholdTransferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case householdtransfer.EdgeHousehold:
+		return m.clearedhousehold
+	case householdtransfer.EdgeUser:
+		return m.cleareduser
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SessionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Session unique edge %s", name)
+func (m *HouseholdTransferMutation) ClearEdge(name string) error {
+	switch name {
+	case householdtransfer.EdgeHousehold:
+		m.ClearHousehold()
+		return nil
+	case householdtransfer.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown HouseholdTransfer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SessionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Session edge %s", name)
+func (m *HouseholdTransferMutation) ResetEdge(name string) error {
+	switch name {
+	case householdtransfer.EdgeHousehold:
+		m.ResetHousehold()
+		return nil
+	case householdtransfer.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown HouseholdTransfer edge %s", name)
 }
 
-// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
-type SettingsMutation struct {
+// LocalCredentialMutation represents an operation that mutates the LocalCredential nodes in the graph.
+type LocalCredentialMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	key           *string
-	value         *string
+	username      *string
+	password_hash *string
 	created_at    *time.Time
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Settings, error)
-	predicates    []predicate.Settings
+	oldValue      func(context.Context) (*LocalCredential, error)
+	predicates    []predicate.LocalCredential
 }
 
-var _ ent.Mutation = (*SettingsMutation)(nil)
+var _ ent.Mutation = (*LocalCredentialMutation)(nil)
 
-// settingsOption allows management of the mutation configuration using functional options.
-type settingsOption func(*SettingsMutation)
+// localcredentialOption allows management of the mutation configuration using functional options.
+type localcredentialOption func(*LocalCredentialMutation)
 
-// newSettingsMutation creates new mutation for the Settings entity.
-func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
-	m := &SettingsMutation{
+// newLocalCredentialMutation creates new mutation for the LocalCredential entity.
+func newLocalCredentialMutation(c config, op Op, opts ...localcredentialOption) *LocalCredentialMutation {
+	m := &LocalCredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSettings,
+		typ:           TypeLocalCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4775,20 +5005,20 @@ func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutat
 	return m
 }
 
-// withSettingsID sets the ID field of the mutation.
-func withSettingsID(id int) settingsOption {
-	return func(m *SettingsMutation) {
+// withLocalCredentialID sets the ID field of the mutation.
+func withLocalCredentialID(id int) localcredentialOption {
+	return func(m *LocalCredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Settings
+			value *LocalCredential
 		)
-		m.oldValue = func(ctx context.Context) (*Settings, error) {
+		m.oldValue = func(ctx context.Context) (*LocalCredential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Settings.Get(ctx, id)
+					value, err = m.Client().LocalCredential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4797,10 +5027,10 @@ func withSettingsID(id int) settingsOption {
 	}
 }
 
-// withSettings sets the old Settings of the mutation.
-func withSettings(node *Settings) settingsOption {
-	return func(m *SettingsMutation) {
-		m.oldValue = func(context.Context) (*Settings, error) {
+// withLocalCredential sets the old LocalCredential of the mutation.
+func withLocalCredential(node *LocalCredential) localcredentialOption {
+	return func(m *LocalCredentialMutation) {
+		m.oldValue = func(context.Context) (*LocalCredential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4809,7 +5039,7 @@ func withSettings(node *Settings) settingsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingsMutation) Client() *Client {
+func (m LocalCredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4817,7 +5047,7 @@ func (m SettingsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingsMutation) Tx() (*Tx, error) {
+func (m LocalCredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4828,7 +5058,7 @@ func (m SettingsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingsMutation) ID() (id int, exists bool) {
+func (m *LocalCredentialMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4839,7 +5069,7 @@ func (m *SettingsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *LocalCredentialMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4848,20 +5078,6756 @@ func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LocalCredential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *SettingsMutation) SetKey(s string) {
-	m.key = &s
+// SetUsername sets the "username" field.
+func (m *LocalCredentialMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SettingsMutation) Key() (r string, exists bool) {
-	v := m.key
+// Username returns the value of the "username" field in the mutation.
+func (m *LocalCredentialMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the LocalCredential entity.
+// If the LocalCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalCredentialMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *LocalCredentialMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *LocalCredentialMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *LocalCredentialMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the LocalCredential entity.
+// If the LocalCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalCredentialMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *LocalCredentialMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LocalCredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LocalCredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LocalCredential entity.
+// If the LocalCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalCredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LocalCredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LocalCredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LocalCredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LocalCredential entity.
+// If the LocalCredential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocalCredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LocalCredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LocalCredentialMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LocalCredentialMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LocalCredentialMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LocalCredentialMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LocalCredentialMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LocalCredentialMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LocalCredentialMutation builder.
+func (m *LocalCredentialMutation) Where(ps ...predicate.LocalCredential) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocalCredentialMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocalCredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LocalCredential, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocalCredentialMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocalCredentialMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LocalCredential).
+func (m *LocalCredentialMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocalCredentialMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.username != nil {
+		fields = append(fields, localcredential.FieldUsername)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, localcredential.FieldPasswordHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, localcredential.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, localcredential.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocalCredentialMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case localcredential.FieldUsername:
+		return m.Username()
+	case localcredential.FieldPasswordHash:
+		return m.PasswordHash()
+	case localcredential.FieldCreatedAt:
+		return m.CreatedAt()
+	case localcredential.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocalCredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case localcredential.FieldUsername:
+		return m.OldUsername(ctx)
+	case localcredential.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case localcredential.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case localcredential.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LocalCredential field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocalCredentialMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case localcredential.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case localcredential.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case localcredential.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case localcredential.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LocalCredential field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocalCredentialMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocalCredentialMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocalCredentialMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LocalCredential numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocalCredentialMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocalCredentialMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocalCredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LocalCredential nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocalCredentialMutation) ResetField(name string) error {
+	switch name {
+	case localcredential.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case localcredential.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case localcredential.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case localcredential.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalCredential field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocalCredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, localcredential.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocalCredentialMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case localcredential.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocalCredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocalCredentialMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocalCredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, localcredential.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocalCredentialMutation) EdgeCleared(name string) bool {
+	switch name {
+	case localcredential.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocalCredentialMutation) ClearEdge(name string) error {
+	switch name {
+	case localcredential.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalCredential unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocalCredentialMutation) ResetEdge(name string) error {
+	switch name {
+	case localcredential.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LocalCredential edge %s", name)
+}
+
+// MonthlyAggregateMutation represents an operation that mutates the MonthlyAggregate nodes in the graph.
+type MonthlyAggregateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	month              *string
+	version            *int
+	addversion         *int
+	gross_income       *int64
+	addgross_income    *int64
+	gross_expenses     *int64
+	addgross_expenses  *int64
+	recurring_total    *int64
+	addrecurring_total *int64
+	one_time_total     *int64
+	addone_time_total  *int64
+	monthly_total      *int64
+	addmonthly_total   *int64
+	categories         *[]schema.MonthlyAggregateCategory
+	appendcategories   []schema.MonthlyAggregateCategory
+	computed_at        *time.Time
+	clearedFields      map[string]struct{}
+	household          *int
+	clearedhousehold   bool
+	done               bool
+	oldValue           func(context.Context) (*MonthlyAggregate, error)
+	predicates         []predicate.MonthlyAggregate
+}
+
+var _ ent.Mutation = (*MonthlyAggregateMutation)(nil)
+
+// monthlyaggregateOption allows management of the mutation configuration using functional options.
+type monthlyaggregateOption func(*MonthlyAggregateMutation)
+
+// newMonthlyAggregateMutation creates new mutation for the MonthlyAggregate entity.
+func newMonthlyAggregateMutation(c config, op Op, opts ...monthlyaggregateOption) *MonthlyAggregateMutation {
+	m := &MonthlyAggregateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonthlyAggregate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonthlyAggregateID sets the ID field of the mutation.
+func withMonthlyAggregateID(id int) monthlyaggregateOption {
+	return func(m *MonthlyAggregateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MonthlyAggregate
+		)
+		m.oldValue = func(ctx context.Context) (*MonthlyAggregate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MonthlyAggregate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonthlyAggregate sets the old MonthlyAggregate of the mutation.
+func withMonthlyAggregate(node *MonthlyAggregate) monthlyaggregateOption {
+	return func(m *MonthlyAggregateMutation) {
+		m.oldValue = func(context.Context) (*MonthlyAggregate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonthlyAggregateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonthlyAggregateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonthlyAggregateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonthlyAggregateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MonthlyAggregate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMonth sets the "month" field.
+func (m *MonthlyAggregateMutation) SetMonth(s string) {
+	m.month = &s
+}
+
+// Month returns the value of the "month" field in the mutation.
+func (m *MonthlyAggregateMutation) Month() (r string, exists bool) {
+	v := m.month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonth returns the old "month" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldMonth(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
+	}
+	return oldValue.Month, nil
+}
+
+// ResetMonth resets all changes to the "month" field.
+func (m *MonthlyAggregateMutation) ResetMonth() {
+	m.month = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *MonthlyAggregateMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *MonthlyAggregateMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *MonthlyAggregateMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *MonthlyAggregateMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *MonthlyAggregateMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetGrossIncome sets the "gross_income" field.
+func (m *MonthlyAggregateMutation) SetGrossIncome(i int64) {
+	m.gross_income = &i
+	m.addgross_income = nil
+}
+
+// GrossIncome returns the value of the "gross_income" field in the mutation.
+func (m *MonthlyAggregateMutation) GrossIncome() (r int64, exists bool) {
+	v := m.gross_income
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrossIncome returns the old "gross_income" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldGrossIncome(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrossIncome is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrossIncome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrossIncome: %w", err)
+	}
+	return oldValue.GrossIncome, nil
+}
+
+// AddGrossIncome adds i to the "gross_income" field.
+func (m *MonthlyAggregateMutation) AddGrossIncome(i int64) {
+	if m.addgross_income != nil {
+		*m.addgross_income += i
+	} else {
+		m.addgross_income = &i
+	}
+}
+
+// AddedGrossIncome returns the value that was added to the "gross_income" field in this mutation.
+func (m *MonthlyAggregateMutation) AddedGrossIncome() (r int64, exists bool) {
+	v := m.addgross_income
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGrossIncome resets all changes to the "gross_income" field.
+func (m *MonthlyAggregateMutation) ResetGrossIncome() {
+	m.gross_income = nil
+	m.addgross_income = nil
+}
+
+// SetGrossExpenses sets the "gross_expenses" field.
+func (m *MonthlyAggregateMutation) SetGrossExpenses(i int64) {
+	m.gross_expenses = &i
+	m.addgross_expenses = nil
+}
+
+// GrossExpenses returns the value of the "gross_expenses" field in the mutation.
+func (m *MonthlyAggregateMutation) GrossExpenses() (r int64, exists bool) {
+	v := m.gross_expenses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrossExpenses returns the old "gross_expenses" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldGrossExpenses(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrossExpenses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrossExpenses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrossExpenses: %w", err)
+	}
+	return oldValue.GrossExpenses, nil
+}
+
+// AddGrossExpenses adds i to the "gross_expenses" field.
+func (m *MonthlyAggregateMutation) AddGrossExpenses(i int64) {
+	if m.addgross_expenses != nil {
+		*m.addgross_expenses += i
+	} else {
+		m.addgross_expenses = &i
+	}
+}
+
+// AddedGrossExpenses returns the value that was added to the "gross_expenses" field in this mutation.
+func (m *MonthlyAggregateMutation) AddedGrossExpenses() (r int64, exists bool) {
+	v := m.addgross_expenses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGrossExpenses resets all changes to the "gross_expenses" field.
+func (m *MonthlyAggregateMutation) ResetGrossExpenses() {
+	m.gross_expenses = nil
+	m.addgross_expenses = nil
+}
+
+// SetRecurringTotal sets the "recurring_total" field.
+func (m *MonthlyAggregateMutation) SetRecurringTotal(i int64) {
+	m.recurring_total = &i
+	m.addrecurring_total = nil
+}
+
+// RecurringTotal returns the value of the "recurring_total" field in the mutation.
+func (m *MonthlyAggregateMutation) RecurringTotal() (r int64, exists bool) {
+	v := m.recurring_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecurringTotal returns the old "recurring_total" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldRecurringTotal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurringTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurringTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurringTotal: %w", err)
+	}
+	return oldValue.RecurringTotal, nil
+}
+
+// AddRecurringTotal adds i to the "recurring_total" field.
+func (m *MonthlyAggregateMutation) AddRecurringTotal(i int64) {
+	if m.addrecurring_total != nil {
+		*m.addrecurring_total += i
+	} else {
+		m.addrecurring_total = &i
+	}
+}
+
+// AddedRecurringTotal returns the value that was added to the "recurring_total" field in this mutation.
+func (m *MonthlyAggregateMutation) AddedRecurringTotal() (r int64, exists bool) {
+	v := m.addrecurring_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRecurringTotal resets all changes to the "recurring_total" field.
+func (m *MonthlyAggregateMutation) ResetRecurringTotal() {
+	m.recurring_total = nil
+	m.addrecurring_total = nil
+}
+
+// SetOneTimeTotal sets the "one_time_total" field.
+func (m *MonthlyAggregateMutation) SetOneTimeTotal(i int64) {
+	m.one_time_total = &i
+	m.addone_time_total = nil
+}
+
+// OneTimeTotal returns the value of the "one_time_total" field in the mutation.
+func (m *MonthlyAggregateMutation) OneTimeTotal() (r int64, exists bool) {
+	v := m.one_time_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOneTimeTotal returns the old "one_time_total" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldOneTimeTotal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOneTimeTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOneTimeTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOneTimeTotal: %w", err)
+	}
+	return oldValue.OneTimeTotal, nil
+}
+
+// AddOneTimeTotal adds i to the "one_time_total" field.
+func (m *MonthlyAggregateMutation) AddOneTimeTotal(i int64) {
+	if m.addone_time_total != nil {
+		*m.addone_time_total += i
+	} else {
+		m.addone_time_total = &i
+	}
+}
+
+// AddedOneTimeTotal returns the value that was added to the "one_time_total" field in this mutation.
+func (m *MonthlyAggregateMutation) AddedOneTimeTotal() (r int64, exists bool) {
+	v := m.addone_time_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOneTimeTotal resets all changes to the "one_time_total" field.
+func (m *MonthlyAggregateMutation) ResetOneTimeTotal() {
+	m.one_time_total = nil
+	m.addone_time_total = nil
+}
+
+// SetMonthlyTotal sets the "monthly_total" field.
+func (m *MonthlyAggregateMutation) SetMonthlyTotal(i int64) {
+	m.monthly_total = &i
+	m.addmonthly_total = nil
+}
+
+// MonthlyTotal returns the value of the "monthly_total" field in the mutation.
+func (m *MonthlyAggregateMutation) MonthlyTotal() (r int64, exists bool) {
+	v := m.monthly_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyTotal returns the old "monthly_total" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldMonthlyTotal(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyTotal: %w", err)
+	}
+	return oldValue.MonthlyTotal, nil
+}
+
+// AddMonthlyTotal adds i to the "monthly_total" field.
+func (m *MonthlyAggregateMutation) AddMonthlyTotal(i int64) {
+	if m.addmonthly_total != nil {
+		*m.addmonthly_total += i
+	} else {
+		m.addmonthly_total = &i
+	}
+}
+
+// AddedMonthlyTotal returns the value that was added to the "monthly_total" field in this mutation.
+func (m *MonthlyAggregateMutation) AddedMonthlyTotal() (r int64, exists bool) {
+	v := m.addmonthly_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonthlyTotal resets all changes to the "monthly_total" field.
+func (m *MonthlyAggregateMutation) ResetMonthlyTotal() {
+	m.monthly_total = nil
+	m.addmonthly_total = nil
+}
+
+// SetCategories sets the "categories" field.
+func (m *MonthlyAggregateMutation) SetCategories(sac []schema.MonthlyAggregateCategory) {
+	m.categories = &sac
+	m.appendcategories = nil
+}
+
+// Categories returns the value of the "categories" field in the mutation.
+func (m *MonthlyAggregateMutation) Categories() (r []schema.MonthlyAggregateCategory, exists bool) {
+	v := m.categories
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategories returns the old "categories" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldCategories(ctx context.Context) (v []schema.MonthlyAggregateCategory, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategories is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategories requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategories: %w", err)
+	}
+	return oldValue.Categories, nil
+}
+
+// AppendCategories adds sac to the "categories" field.
+func (m *MonthlyAggregateMutation) AppendCategories(sac []schema.MonthlyAggregateCategory) {
+	m.appendcategories = append(m.appendcategories, sac...)
+}
+
+// AppendedCategories returns the list of values that were appended to the "categories" field in this mutation.
+func (m *MonthlyAggregateMutation) AppendedCategories() ([]schema.MonthlyAggregateCategory, bool) {
+	if len(m.appendcategories) == 0 {
+		return nil, false
+	}
+	return m.appendcategories, true
+}
+
+// ResetCategories resets all changes to the "categories" field.
+func (m *MonthlyAggregateMutation) ResetCategories() {
+	m.categories = nil
+	m.appendcategories = nil
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (m *MonthlyAggregateMutation) SetComputedAt(t time.Time) {
+	m.computed_at = &t
+}
+
+// ComputedAt returns the value of the "computed_at" field in the mutation.
+func (m *MonthlyAggregateMutation) ComputedAt() (r time.Time, exists bool) {
+	v := m.computed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComputedAt returns the old "computed_at" field's value of the MonthlyAggregate entity.
+// If the MonthlyAggregate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyAggregateMutation) OldComputedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComputedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComputedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComputedAt: %w", err)
+	}
+	return oldValue.ComputedAt, nil
+}
+
+// ResetComputedAt resets all changes to the "computed_at" field.
+func (m *MonthlyAggregateMutation) ResetComputedAt() {
+	m.computed_at = nil
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *MonthlyAggregateMutation) SetHouseholdID(id int) {
+	m.household = &id
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *MonthlyAggregateMutation) ClearHousehold() {
+	m.clearedhousehold = true
+}
+
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *MonthlyAggregateMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
+}
+
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *MonthlyAggregateMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
+	}
+	return
+}
+
+// HouseholdIDs returns the "household" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *MonthlyAggregateMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHousehold resets all changes to the "household" edge.
+func (m *MonthlyAggregateMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
+}
+
+// Where appends a list predicates to the MonthlyAggregateMutation builder.
+func (m *MonthlyAggregateMutation) Where(ps ...predicate.MonthlyAggregate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonthlyAggregateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonthlyAggregateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MonthlyAggregate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonthlyAggregateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonthlyAggregateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MonthlyAggregate).
+func (m *MonthlyAggregateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonthlyAggregateMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.month != nil {
+		fields = append(fields, monthlyaggregate.FieldMonth)
+	}
+	if m.version != nil {
+		fields = append(fields, monthlyaggregate.FieldVersion)
+	}
+	if m.gross_income != nil {
+		fields = append(fields, monthlyaggregate.FieldGrossIncome)
+	}
+	if m.gross_expenses != nil {
+		fields = append(fields, monthlyaggregate.FieldGrossExpenses)
+	}
+	if m.recurring_total != nil {
+		fields = append(fields, monthlyaggregate.FieldRecurringTotal)
+	}
+	if m.one_time_total != nil {
+		fields = append(fields, monthlyaggregate.FieldOneTimeTotal)
+	}
+	if m.monthly_total != nil {
+		fields = append(fields, monthlyaggregate.FieldMonthlyTotal)
+	}
+	if m.categories != nil {
+		fields = append(fields, monthlyaggregate.FieldCategories)
+	}
+	if m.computed_at != nil {
+		fields = append(fields, monthlyaggregate.FieldComputedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonthlyAggregateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monthlyaggregate.FieldMonth:
+		return m.Month()
+	case monthlyaggregate.FieldVersion:
+		return m.Version()
+	case monthlyaggregate.FieldGrossIncome:
+		return m.GrossIncome()
+	case monthlyaggregate.FieldGrossExpenses:
+		return m.GrossExpenses()
+	case monthlyaggregate.FieldRecurringTotal:
+		return m.RecurringTotal()
+	case monthlyaggregate.FieldOneTimeTotal:
+		return m.OneTimeTotal()
+	case monthlyaggregate.FieldMonthlyTotal:
+		return m.MonthlyTotal()
+	case monthlyaggregate.FieldCategories:
+		return m.Categories()
+	case monthlyaggregate.FieldComputedAt:
+		return m.ComputedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonthlyAggregateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monthlyaggregate.FieldMonth:
+		return m.OldMonth(ctx)
+	case monthlyaggregate.FieldVersion:
+		return m.OldVersion(ctx)
+	case monthlyaggregate.FieldGrossIncome:
+		return m.OldGrossIncome(ctx)
+	case monthlyaggregate.FieldGrossExpenses:
+		return m.OldGrossExpenses(ctx)
+	case monthlyaggregate.FieldRecurringTotal:
+		return m.OldRecurringTotal(ctx)
+	case monthlyaggregate.FieldOneTimeTotal:
+		return m.OldOneTimeTotal(ctx)
+	case monthlyaggregate.FieldMonthlyTotal:
+		return m.OldMonthlyTotal(ctx)
+	case monthlyaggregate.FieldCategories:
+		return m.OldCategories(ctx)
+	case monthlyaggregate.FieldComputedAt:
+		return m.OldComputedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MonthlyAggregate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonthlyAggregateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monthlyaggregate.FieldMonth:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonth(v)
+		return nil
+	case monthlyaggregate.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case monthlyaggregate.FieldGrossIncome:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrossIncome(v)
+		return nil
+	case monthlyaggregate.FieldGrossExpenses:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrossExpenses(v)
+		return nil
+	case monthlyaggregate.FieldRecurringTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurringTotal(v)
+		return nil
+	case monthlyaggregate.FieldOneTimeTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOneTimeTotal(v)
+		return nil
+	case monthlyaggregate.FieldMonthlyTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyTotal(v)
+		return nil
+	case monthlyaggregate.FieldCategories:
+		v, ok := value.([]schema.MonthlyAggregateCategory)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategories(v)
+		return nil
+	case monthlyaggregate.FieldComputedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComputedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyAggregate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonthlyAggregateMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, monthlyaggregate.FieldVersion)
+	}
+	if m.addgross_income != nil {
+		fields = append(fields, monthlyaggregate.FieldGrossIncome)
+	}
+	if m.addgross_expenses != nil {
+		fields = append(fields, monthlyaggregate.FieldGrossExpenses)
+	}
+	if m.addrecurring_total != nil {
+		fields = append(fields, monthlyaggregate.FieldRecurringTotal)
+	}
+	if m.addone_time_total != nil {
+		fields = append(fields, monthlyaggregate.FieldOneTimeTotal)
+	}
+	if m.addmonthly_total != nil {
+		fields = append(fields, monthlyaggregate.FieldMonthlyTotal)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonthlyAggregateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monthlyaggregate.FieldVersion:
+		return m.AddedVersion()
+	case monthlyaggregate.FieldGrossIncome:
+		return m.AddedGrossIncome()
+	case monthlyaggregate.FieldGrossExpenses:
+		return m.AddedGrossExpenses()
+	case monthlyaggregate.FieldRecurringTotal:
+		return m.AddedRecurringTotal()
+	case monthlyaggregate.FieldOneTimeTotal:
+		return m.AddedOneTimeTotal()
+	case monthlyaggregate.FieldMonthlyTotal:
+		return m.AddedMonthlyTotal()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonthlyAggregateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monthlyaggregate.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case monthlyaggregate.FieldGrossIncome:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGrossIncome(v)
+		return nil
+	case monthlyaggregate.FieldGrossExpenses:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGrossExpenses(v)
+		return nil
+	case monthlyaggregate.FieldRecurringTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecurringTotal(v)
+		return nil
+	case monthlyaggregate.FieldOneTimeTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOneTimeTotal(v)
+		return nil
+	case monthlyaggregate.FieldMonthlyTotal:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyTotal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyAggregate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonthlyAggregateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonthlyAggregateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonthlyAggregateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MonthlyAggregate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonthlyAggregateMutation) ResetField(name string) error {
+	switch name {
+	case monthlyaggregate.FieldMonth:
+		m.ResetMonth()
+		return nil
+	case monthlyaggregate.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case monthlyaggregate.FieldGrossIncome:
+		m.ResetGrossIncome()
+		return nil
+	case monthlyaggregate.FieldGrossExpenses:
+		m.ResetGrossExpenses()
+		return nil
+	case monthlyaggregate.FieldRecurringTotal:
+		m.ResetRecurringTotal()
+		return nil
+	case monthlyaggregate.FieldOneTimeTotal:
+		m.ResetOneTimeTotal()
+		return nil
+	case monthlyaggregate.FieldMonthlyTotal:
+		m.ResetMonthlyTotal()
+		return nil
+	case monthlyaggregate.FieldCategories:
+		m.ResetCategories()
+		return nil
+	case monthlyaggregate.FieldComputedAt:
+		m.ResetComputedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyAggregate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonthlyAggregateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.household != nil {
+		edges = append(edges, monthlyaggregate.EdgeHousehold)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonthlyAggregateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case monthlyaggregate.EdgeHousehold:
+		if id := m.household; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonthlyAggregateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonthlyAggregateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonthlyAggregateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedhousehold {
+		edges = append(edges, monthlyaggregate.EdgeHousehold)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonthlyAggregateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case monthlyaggregate.EdgeHousehold:
+		return m.clearedhousehold
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonthlyAggregateMutation) ClearEdge(name string) error {
+	switch name {
+	case monthlyaggregate.EdgeHousehold:
+		m.ClearHousehold()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyAggregate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonthlyAggregateMutation) ResetEdge(name string) error {
+	switch name {
+	case monthlyaggregate.EdgeHousehold:
+		m.ResetHousehold()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyAggregate edge %s", name)
+}
+
+// RateLimitMutation represents an operation that mutates the RateLimit nodes in the graph.
+type RateLimitMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	count         *int
+	addcount      *int
+	reset_at      *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RateLimit, error)
+	predicates    []predicate.RateLimit
+}
+
+var _ ent.Mutation = (*RateLimitMutation)(nil)
+
+// ratelimitOption allows management of the mutation configuration using functional options.
+type ratelimitOption func(*RateLimitMutation)
+
+// newRateLimitMutation creates new mutation for the RateLimit entity.
+func newRateLimitMutation(c config, op Op, opts ...ratelimitOption) *RateLimitMutation {
+	m := &RateLimitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRateLimit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRateLimitID sets the ID field of the mutation.
+func withRateLimitID(id int) ratelimitOption {
+	return func(m *RateLimitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RateLimit
+		)
+		m.oldValue = func(ctx context.Context) (*RateLimit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RateLimit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRateLimit sets the old RateLimit of the mutation.
+func withRateLimit(node *RateLimit) ratelimitOption {
+	return func(m *RateLimitMutation) {
+		m.oldValue = func(context.Context) (*RateLimit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RateLimitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RateLimitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RateLimitMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RateLimitMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RateLimit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *RateLimitMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *RateLimitMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the RateLimit entity.
+// If the RateLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *RateLimitMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetCount sets the "count" field.
+func (m *RateLimitMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *RateLimitMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the RateLimit entity.
+// If the RateLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *RateLimitMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *RateLimitMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *RateLimitMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetResetAt sets the "reset_at" field.
+func (m *RateLimitMutation) SetResetAt(t time.Time) {
+	m.reset_at = &t
+}
+
+// ResetAt returns the value of the "reset_at" field in the mutation.
+func (m *RateLimitMutation) ResetAt() (r time.Time, exists bool) {
+	v := m.reset_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResetAt returns the old "reset_at" field's value of the RateLimit entity.
+// If the RateLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitMutation) OldResetAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResetAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResetAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResetAt: %w", err)
+	}
+	return oldValue.ResetAt, nil
+}
+
+// ResetResetAt resets all changes to the "reset_at" field.
+func (m *RateLimitMutation) ResetResetAt() {
+	m.reset_at = nil
+}
+
+// Where appends a list predicates to the RateLimitMutation builder.
+func (m *RateLimitMutation) Where(ps ...predicate.RateLimit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RateLimitMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RateLimitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RateLimit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RateLimitMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RateLimitMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RateLimit).
+func (m *RateLimitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RateLimitMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key != nil {
+		fields = append(fields, ratelimit.FieldKey)
+	}
+	if m.count != nil {
+		fields = append(fields, ratelimit.FieldCount)
+	}
+	if m.reset_at != nil {
+		fields = append(fields, ratelimit.FieldResetAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RateLimitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ratelimit.FieldKey:
+		return m.Key()
+	case ratelimit.FieldCount:
+		return m.Count()
+	case ratelimit.FieldResetAt:
+		return m.ResetAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RateLimitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ratelimit.FieldKey:
+		return m.OldKey(ctx)
+	case ratelimit.FieldCount:
+		return m.OldCount(ctx)
+	case ratelimit.FieldResetAt:
+		return m.OldResetAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RateLimit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RateLimitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ratelimit.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case ratelimit.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case ratelimit.FieldResetAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResetAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RateLimit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RateLimitMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, ratelimit.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RateLimitMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ratelimit.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RateLimitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ratelimit.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RateLimit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RateLimitMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RateLimitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RateLimitMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RateLimit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RateLimitMutation) ResetField(name string) error {
+	switch name {
+	case ratelimit.FieldKey:
+		m.ResetKey()
+		return nil
+	case ratelimit.FieldCount:
+		m.ResetCount()
+		return nil
+	case ratelimit.FieldResetAt:
+		m.ResetResetAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RateLimit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RateLimitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RateLimitMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RateLimitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RateLimitMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RateLimitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RateLimitMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RateLimitMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RateLimit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RateLimitMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RateLimit edge %s", name)
+}
+
+// RecurringExpenseMutation represents an operation that mutates the RecurringExpense nodes in the graph.
+type RecurringExpenseMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	name                      *string
+	description               *string
+	details                   *string
+	amount                    *int64
+	addamount                 *int64
+	frequency                 *string
+	active                    *bool
+	deactivated_at            *time.Time
+	start_date                *time.Time
+	end_date                  *time.Time
+	split_type                *string
+	split_shares              *[]schema.SplitShare
+	appendsplit_shares        []schema.SplitShare
+	created_at                *time.Time
+	updated_at                *time.Time
+	deleted_at                *time.Time
+	clearedFields             map[string]struct{}
+	household                 *int
+	clearedhousehold          bool
+	category                  *int
+	clearedcategory           bool
+	payer                     *int
+	clearedpayer              bool
+	schedule_overrides        map[int]struct{}
+	removedschedule_overrides map[int]struct{}
+	clearedschedule_overrides bool
+	done                      bool
+	oldValue                  func(context.Context) (*RecurringExpense, error)
+	predicates                []predicate.RecurringExpense
+}
+
+var _ ent.Mutation = (*RecurringExpenseMutation)(nil)
+
+// recurringexpenseOption allows management of the mutation configuration using functional options.
+type recurringexpenseOption func(*RecurringExpenseMutation)
+
+// newRecurringExpenseMutation creates new mutation for the RecurringExpense entity.
+func newRecurringExpenseMutation(c config, op Op, opts ...recurringexpenseOption) *RecurringExpenseMutation {
+	m := &RecurringExpenseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurringExpense,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurringExpenseID sets the ID field of the mutation.
+func withRecurringExpenseID(id int) recurringexpenseOption {
+	return func(m *RecurringExpenseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurringExpense
+		)
+		m.oldValue = func(ctx context.Context) (*RecurringExpense, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurringExpense.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurringExpense sets the old RecurringExpense of the mutation.
+func withRecurringExpense(node *RecurringExpense) recurringexpenseOption {
+	return func(m *RecurringExpenseMutation) {
+		m.oldValue = func(context.Context) (*RecurringExpense, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurringExpenseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurringExpenseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurringExpenseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurringExpenseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurringExpense.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RecurringExpenseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RecurringExpenseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RecurringExpenseMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RecurringExpenseMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RecurringExpenseMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RecurringExpenseMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[recurringexpense.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RecurringExpenseMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, recurringexpense.FieldDescription)
+}
+
+// SetDetails sets the "details" field.
+func (m *RecurringExpenseMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *RecurringExpenseMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *RecurringExpenseMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[recurringexpense.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *RecurringExpenseMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, recurringexpense.FieldDetails)
+}
+
+// SetAmount sets the "amount" field.
+func (m *RecurringExpenseMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *RecurringExpenseMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *RecurringExpenseMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *RecurringExpenseMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *RecurringExpenseMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetFrequency sets the "frequency" field.
+func (m *RecurringExpenseMutation) SetFrequency(s string) {
+	m.frequency = &s
+}
+
+// Frequency returns the value of the "frequency" field in the mutation.
+func (m *RecurringExpenseMutation) Frequency() (r string, exists bool) {
+	v := m.frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrequency returns the old "frequency" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldFrequency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+	}
+	return oldValue.Frequency, nil
+}
+
+// ResetFrequency resets all changes to the "frequency" field.
+func (m *RecurringExpenseMutation) ResetFrequency() {
+	m.frequency = nil
+}
+
+// SetActive sets the "active" field.
+func (m *RecurringExpenseMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *RecurringExpenseMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *RecurringExpenseMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (m *RecurringExpenseMutation) SetDeactivatedAt(t time.Time) {
+	m.deactivated_at = &t
+}
+
+// DeactivatedAt returns the value of the "deactivated_at" field in the mutation.
+func (m *RecurringExpenseMutation) DeactivatedAt() (r time.Time, exists bool) {
+	v := m.deactivated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeactivatedAt returns the old "deactivated_at" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldDeactivatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeactivatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeactivatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeactivatedAt: %w", err)
+	}
+	return oldValue.DeactivatedAt, nil
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (m *RecurringExpenseMutation) ClearDeactivatedAt() {
+	m.deactivated_at = nil
+	m.clearedFields[recurringexpense.FieldDeactivatedAt] = struct{}{}
+}
+
+// DeactivatedAtCleared returns if the "deactivated_at" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) DeactivatedAtCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldDeactivatedAt]
+	return ok
+}
+
+// ResetDeactivatedAt resets all changes to the "deactivated_at" field.
+func (m *RecurringExpenseMutation) ResetDeactivatedAt() {
+	m.deactivated_at = nil
+	delete(m.clearedFields, recurringexpense.FieldDeactivatedAt)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *RecurringExpenseMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *RecurringExpenseMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *RecurringExpenseMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *RecurringExpenseMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *RecurringExpenseMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldEndDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (m *RecurringExpenseMutation) ClearEndDate() {
+	m.end_date = nil
+	m.clearedFields[recurringexpense.FieldEndDate] = struct{}{}
+}
+
+// EndDateCleared returns if the "end_date" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) EndDateCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldEndDate]
+	return ok
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *RecurringExpenseMutation) ResetEndDate() {
+	m.end_date = nil
+	delete(m.clearedFields, recurringexpense.FieldEndDate)
+}
+
+// SetSplitType sets the "split_type" field.
+func (m *RecurringExpenseMutation) SetSplitType(s string) {
+	m.split_type = &s
+}
+
+// SplitType returns the value of the "split_type" field in the mutation.
+func (m *RecurringExpenseMutation) SplitType() (r string, exists bool) {
+	v := m.split_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSplitType returns the old "split_type" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldSplitType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSplitType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSplitType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSplitType: %w", err)
+	}
+	return oldValue.SplitType, nil
+}
+
+// ClearSplitType clears the value of the "split_type" field.
+func (m *RecurringExpenseMutation) ClearSplitType() {
+	m.split_type = nil
+	m.clearedFields[recurringexpense.FieldSplitType] = struct{}{}
+}
+
+// SplitTypeCleared returns if the "split_type" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) SplitTypeCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldSplitType]
+	return ok
+}
+
+// ResetSplitType resets all changes to the "split_type" field.
+func (m *RecurringExpenseMutation) ResetSplitType() {
+	m.split_type = nil
+	delete(m.clearedFields, recurringexpense.FieldSplitType)
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (m *RecurringExpenseMutation) SetSplitShares(ss []schema.SplitShare) {
+	m.split_shares = &ss
+	m.appendsplit_shares = nil
+}
+
+// SplitShares returns the value of the "split_shares" field in the mutation.
+func (m *RecurringExpenseMutation) SplitShares() (r []schema.SplitShare, exists bool) {
+	v := m.split_shares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSplitShares returns the old "split_shares" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldSplitShares(ctx context.Context) (v []schema.SplitShare, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSplitShares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSplitShares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSplitShares: %w", err)
+	}
+	return oldValue.SplitShares, nil
+}
+
+// AppendSplitShares adds ss to the "split_shares" field.
+func (m *RecurringExpenseMutation) AppendSplitShares(ss []schema.SplitShare) {
+	m.appendsplit_shares = append(m.appendsplit_shares, ss...)
+}
+
+// AppendedSplitShares returns the list of values that were appended to the "split_shares" field in this mutation.
+func (m *RecurringExpenseMutation) AppendedSplitShares() ([]schema.SplitShare, bool) {
+	if len(m.appendsplit_shares) == 0 {
+		return nil, false
+	}
+	return m.appendsplit_shares, true
+}
+
+// ClearSplitShares clears the value of the "split_shares" field.
+func (m *RecurringExpenseMutation) ClearSplitShares() {
+	m.split_shares = nil
+	m.appendsplit_shares = nil
+	m.clearedFields[recurringexpense.FieldSplitShares] = struct{}{}
+}
+
+// SplitSharesCleared returns if the "split_shares" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) SplitSharesCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldSplitShares]
+	return ok
+}
+
+// ResetSplitShares resets all changes to the "split_shares" field.
+func (m *RecurringExpenseMutation) ResetSplitShares() {
+	m.split_shares = nil
+	m.appendsplit_shares = nil
+	delete(m.clearedFields, recurringexpense.FieldSplitShares)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurringExpenseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurringExpenseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurringExpenseMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurringExpenseMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurringExpenseMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurringExpenseMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *RecurringExpenseMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *RecurringExpenseMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the RecurringExpense entity.
+// If the RecurringExpense object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringExpenseMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *RecurringExpenseMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[recurringexpense.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *RecurringExpenseMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[recurringexpense.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *RecurringExpenseMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, recurringexpense.FieldDeletedAt)
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *RecurringExpenseMutation) SetHouseholdID(id int) {
+	m.household = &id
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *RecurringExpenseMutation) ClearHousehold() {
+	m.clearedhousehold = true
+}
+
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *RecurringExpenseMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
+}
+
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *RecurringExpenseMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
+	}
+	return
+}
+
+// HouseholdIDs returns the "household" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *RecurringExpenseMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHousehold resets all changes to the "household" edge.
+func (m *RecurringExpenseMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by id.
+func (m *RecurringExpenseMutation) SetCategoryID(id int) {
+	m.category = &id
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *RecurringExpenseMutation) ClearCategory() {
+	m.clearedcategory = true
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *RecurringExpenseMutation) CategoryCleared() bool {
+	return m.clearedcategory
+}
+
+// CategoryID returns the "category" edge ID in the mutation.
+func (m *RecurringExpenseMutation) CategoryID() (id int, exists bool) {
+	if m.category != nil {
+		return *m.category, true
+	}
+	return
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *RecurringExpenseMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *RecurringExpenseMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by id.
+func (m *RecurringExpenseMutation) SetPayerID(id int) {
+	m.payer = &id
+}
+
+// ClearPayer clears the "payer" edge to the HouseholdMember entity.
+func (m *RecurringExpenseMutation) ClearPayer() {
+	m.clearedpayer = true
+}
+
+// PayerCleared reports if the "payer" edge to the HouseholdMember entity was cleared.
+func (m *RecurringExpenseMutation) PayerCleared() bool {
+	return m.clearedpayer
+}
+
+// PayerID returns the "payer" edge ID in the mutation.
+func (m *RecurringExpenseMutation) PayerID() (id int, exists bool) {
+	if m.payer != nil {
+		return *m.payer, true
+	}
+	return
+}
+
+// PayerIDs returns the "payer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PayerID instead. It exists only for internal usage by the builders.
+func (m *RecurringExpenseMutation) PayerIDs() (ids []int) {
+	if id := m.payer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPayer resets all changes to the "payer" edge.
+func (m *RecurringExpenseMutation) ResetPayer() {
+	m.payer = nil
+	m.clearedpayer = false
+}
+
+// AddScheduleOverrideIDs adds the "schedule_overrides" edge to the RecurringScheduleOverride entity by ids.
+func (m *RecurringExpenseMutation) AddScheduleOverrideIDs(ids ...int) {
+	if m.schedule_overrides == nil {
+		m.schedule_overrides = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.schedule_overrides[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScheduleOverrides clears the "schedule_overrides" edge to the RecurringScheduleOverride entity.
+func (m *RecurringExpenseMutation) ClearScheduleOverrides() {
+	m.clearedschedule_overrides = true
+}
+
+// ScheduleOverridesCleared reports if the "schedule_overrides" edge to the RecurringScheduleOverride entity was cleared.
+func (m *RecurringExpenseMutation) ScheduleOverridesCleared() bool {
+	return m.clearedschedule_overrides
+}
+
+// RemoveScheduleOverrideIDs removes the "schedule_overrides" edge to the RecurringScheduleOverride entity by IDs.
+func (m *RecurringExpenseMutation) RemoveScheduleOverrideIDs(ids ...int) {
+	if m.removedschedule_overrides == nil {
+		m.removedschedule_overrides = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.schedule_overrides, ids[i])
+		m.removedschedule_overrides[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScheduleOverrides returns the removed IDs of the "schedule_overrides" edge to the RecurringScheduleOverride entity.
+func (m *RecurringExpenseMutation) RemovedScheduleOverridesIDs() (ids []int) {
+	for id := range m.removedschedule_overrides {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScheduleOverridesIDs returns the "schedule_overrides" edge IDs in the mutation.
+func (m *RecurringExpenseMutation) ScheduleOverridesIDs() (ids []int) {
+	for id := range m.schedule_overrides {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScheduleOverrides resets all changes to the "schedule_overrides" edge.
+func (m *RecurringExpenseMutation) ResetScheduleOverrides() {
+	m.schedule_overrides = nil
+	m.clearedschedule_overrides = false
+	m.removedschedule_overrides = nil
+}
+
+// Where appends a list predicates to the RecurringExpenseMutation builder.
+func (m *RecurringExpenseMutation) Where(ps ...predicate.RecurringExpense) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurringExpenseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurringExpenseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurringExpense, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurringExpenseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurringExpenseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurringExpense).
+func (m *RecurringExpenseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurringExpenseMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name != nil {
+		fields = append(fields, recurringexpense.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, recurringexpense.FieldDescription)
+	}
+	if m.details != nil {
+		fields = append(fields, recurringexpense.FieldDetails)
+	}
+	if m.amount != nil {
+		fields = append(fields, recurringexpense.FieldAmount)
+	}
+	if m.frequency != nil {
+		fields = append(fields, recurringexpense.FieldFrequency)
+	}
+	if m.active != nil {
+		fields = append(fields, recurringexpense.FieldActive)
+	}
+	if m.deactivated_at != nil {
+		fields = append(fields, recurringexpense.FieldDeactivatedAt)
+	}
+	if m.start_date != nil {
+		fields = append(fields, recurringexpense.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, recurringexpense.FieldEndDate)
+	}
+	if m.split_type != nil {
+		fields = append(fields, recurringexpense.FieldSplitType)
+	}
+	if m.split_shares != nil {
+		fields = append(fields, recurringexpense.FieldSplitShares)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurringexpense.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurringexpense.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, recurringexpense.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurringExpenseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurringexpense.FieldName:
+		return m.Name()
+	case recurringexpense.FieldDescription:
+		return m.Description()
+	case recurringexpense.FieldDetails:
+		return m.Details()
+	case recurringexpense.FieldAmount:
+		return m.Amount()
+	case recurringexpense.FieldFrequency:
+		return m.Frequency()
+	case recurringexpense.FieldActive:
+		return m.Active()
+	case recurringexpense.FieldDeactivatedAt:
+		return m.DeactivatedAt()
+	case recurringexpense.FieldStartDate:
+		return m.StartDate()
+	case recurringexpense.FieldEndDate:
+		return m.EndDate()
+	case recurringexpense.FieldSplitType:
+		return m.SplitType()
+	case recurringexpense.FieldSplitShares:
+		return m.SplitShares()
+	case recurringexpense.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurringexpense.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case recurringexpense.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurringExpenseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurringexpense.FieldName:
+		return m.OldName(ctx)
+	case recurringexpense.FieldDescription:
+		return m.OldDescription(ctx)
+	case recurringexpense.FieldDetails:
+		return m.OldDetails(ctx)
+	case recurringexpense.FieldAmount:
+		return m.OldAmount(ctx)
+	case recurringexpense.FieldFrequency:
+		return m.OldFrequency(ctx)
+	case recurringexpense.FieldActive:
+		return m.OldActive(ctx)
+	case recurringexpense.FieldDeactivatedAt:
+		return m.OldDeactivatedAt(ctx)
+	case recurringexpense.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case recurringexpense.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case recurringexpense.FieldSplitType:
+		return m.OldSplitType(ctx)
+	case recurringexpense.FieldSplitShares:
+		return m.OldSplitShares(ctx)
+	case recurringexpense.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurringexpense.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case recurringexpense.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurringExpense field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringExpenseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurringexpense.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case recurringexpense.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case recurringexpense.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case recurringexpense.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case recurringexpense.FieldFrequency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrequency(v)
+		return nil
+	case recurringexpense.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case recurringexpense.FieldDeactivatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeactivatedAt(v)
+		return nil
+	case recurringexpense.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case recurringexpense.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case recurringexpense.FieldSplitType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitType(v)
+		return nil
+	case recurringexpense.FieldSplitShares:
+		v, ok := value.([]schema.SplitShare)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitShares(v)
+		return nil
+	case recurringexpense.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurringexpense.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case recurringexpense.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringExpense field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurringExpenseMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, recurringexpense.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurringExpenseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recurringexpense.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringExpenseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recurringexpense.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringExpense numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurringExpenseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurringexpense.FieldDescription) {
+		fields = append(fields, recurringexpense.FieldDescription)
+	}
+	if m.FieldCleared(recurringexpense.FieldDetails) {
+		fields = append(fields, recurringexpense.FieldDetails)
+	}
+	if m.FieldCleared(recurringexpense.FieldDeactivatedAt) {
+		fields = append(fields, recurringexpense.FieldDeactivatedAt)
+	}
+	if m.FieldCleared(recurringexpense.FieldEndDate) {
+		fields = append(fields, recurringexpense.FieldEndDate)
+	}
+	if m.FieldCleared(recurringexpense.FieldSplitType) {
+		fields = append(fields, recurringexpense.FieldSplitType)
+	}
+	if m.FieldCleared(recurringexpense.FieldSplitShares) {
+		fields = append(fields, recurringexpense.FieldSplitShares)
+	}
+	if m.FieldCleared(recurringexpense.FieldDeletedAt) {
+		fields = append(fields, recurringexpense.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurringExpenseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurringExpenseMutation) ClearField(name string) error {
+	switch name {
+	case recurringexpense.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case recurringexpense.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case recurringexpense.FieldDeactivatedAt:
+		m.ClearDeactivatedAt()
+		return nil
+	case recurringexpense.FieldEndDate:
+		m.ClearEndDate()
+		return nil
+	case recurringexpense.FieldSplitType:
+		m.ClearSplitType()
+		return nil
+	case recurringexpense.FieldSplitShares:
+		m.ClearSplitShares()
+		return nil
+	case recurringexpense.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringExpense nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurringExpenseMutation) ResetField(name string) error {
+	switch name {
+	case recurringexpense.FieldName:
+		m.ResetName()
+		return nil
+	case recurringexpense.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case recurringexpense.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case recurringexpense.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case recurringexpense.FieldFrequency:
+		m.ResetFrequency()
+		return nil
+	case recurringexpense.FieldActive:
+		m.ResetActive()
+		return nil
+	case recurringexpense.FieldDeactivatedAt:
+		m.ResetDeactivatedAt()
+		return nil
+	case recurringexpense.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case recurringexpense.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case recurringexpense.FieldSplitType:
+		m.ResetSplitType()
+		return nil
+	case recurringexpense.FieldSplitShares:
+		m.ResetSplitShares()
+		return nil
+	case recurringexpense.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurringexpense.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case recurringexpense.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringExpense field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurringExpenseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.household != nil {
+		edges = append(edges, recurringexpense.EdgeHousehold)
+	}
+	if m.category != nil {
+		edges = append(edges, recurringexpense.EdgeCategory)
+	}
+	if m.payer != nil {
+		edges = append(edges, recurringexpense.EdgePayer)
+	}
+	if m.schedule_overrides != nil {
+		edges = append(edges, recurringexpense.EdgeScheduleOverrides)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurringExpenseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurringexpense.EdgeHousehold:
+		if id := m.household; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurringexpense.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurringexpense.EdgePayer:
+		if id := m.payer; id != nil {
+			return []ent.Value{*id}
+		}
+	case recurringexpense.EdgeScheduleOverrides:
+		ids := make([]ent.Value, 0, len(m.schedule_overrides))
+		for id := range m.schedule_overrides {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurringExpenseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedschedule_overrides != nil {
+		edges = append(edges, recurringexpense.EdgeScheduleOverrides)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurringExpenseMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case recurringexpense.EdgeScheduleOverrides:
+		ids := make([]ent.Value, 0, len(m.removedschedule_overrides))
+		for id := range m.removedschedule_overrides {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurringExpenseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedhousehold {
+		edges = append(edges, recurringexpense.EdgeHousehold)
+	}
+	if m.clearedcategory {
+		edges = append(edges, recurringexpense.EdgeCategory)
+	}
+	if m.clearedpayer {
+		edges = append(edges, recurringexpense.EdgePayer)
+	}
+	if m.clearedschedule_overrides {
+		edges = append(edges, recurringexpense.EdgeScheduleOverrides)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurringExpenseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurringexpense.EdgeHousehold:
+		return m.clearedhousehold
+	case recurringexpense.EdgeCategory:
+		return m.clearedcategory
+	case recurringexpense.EdgePayer:
+		return m.clearedpayer
+	case recurringexpense.EdgeScheduleOverrides:
+		return m.clearedschedule_overrides
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurringExpenseMutation) ClearEdge(name string) error {
+	switch name {
+	case recurringexpense.EdgeHousehold:
+		m.ClearHousehold()
+		return nil
+	case recurringexpense.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	case recurringexpense.EdgePayer:
+		m.ClearPayer()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringExpense unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurringExpenseMutation) ResetEdge(name string) error {
+	switch name {
+	case recurringexpense.EdgeHousehold:
+		m.ResetHousehold()
+		return nil
+	case recurringexpense.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case recurringexpense.EdgePayer:
+		m.ResetPayer()
+		return nil
+	case recurringexpense.EdgeScheduleOverrides:
+		m.ResetScheduleOverrides()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringExpense edge %s", name)
+}
+
+// RecurringScheduleOverrideMutation represents an operation that mutates the RecurringScheduleOverride nodes in the graph.
+type RecurringScheduleOverrideMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	effective_date           *time.Time
+	amount                   *int64
+	addamount                *int64
+	frequency                *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	recurring_expense        *int
+	clearedrecurring_expense bool
+	done                     bool
+	oldValue                 func(context.Context) (*RecurringScheduleOverride, error)
+	predicates               []predicate.RecurringScheduleOverride
+}
+
+var _ ent.Mutation = (*RecurringScheduleOverrideMutation)(nil)
+
+// recurringscheduleoverrideOption allows management of the mutation configuration using functional options.
+type recurringscheduleoverrideOption func(*RecurringScheduleOverrideMutation)
+
+// newRecurringScheduleOverrideMutation creates new mutation for the RecurringScheduleOverride entity.
+func newRecurringScheduleOverrideMutation(c config, op Op, opts ...recurringscheduleoverrideOption) *RecurringScheduleOverrideMutation {
+	m := &RecurringScheduleOverrideMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurringScheduleOverride,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurringScheduleOverrideID sets the ID field of the mutation.
+func withRecurringScheduleOverrideID(id int) recurringscheduleoverrideOption {
+	return func(m *RecurringScheduleOverrideMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurringScheduleOverride
+		)
+		m.oldValue = func(ctx context.Context) (*RecurringScheduleOverride, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurringScheduleOverride.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurringScheduleOverride sets the old RecurringScheduleOverride of the mutation.
+func withRecurringScheduleOverride(node *RecurringScheduleOverride) recurringscheduleoverrideOption {
+	return func(m *RecurringScheduleOverrideMutation) {
+		m.oldValue = func(context.Context) (*RecurringScheduleOverride, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurringScheduleOverrideMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurringScheduleOverrideMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurringScheduleOverrideMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurringScheduleOverrideMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurringScheduleOverride.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEffectiveDate sets the "effective_date" field.
+func (m *RecurringScheduleOverrideMutation) SetEffectiveDate(t time.Time) {
+	m.effective_date = &t
+}
+
+// EffectiveDate returns the value of the "effective_date" field in the mutation.
+func (m *RecurringScheduleOverrideMutation) EffectiveDate() (r time.Time, exists bool) {
+	v := m.effective_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffectiveDate returns the old "effective_date" field's value of the RecurringScheduleOverride entity.
+// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringScheduleOverrideMutation) OldEffectiveDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffectiveDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffectiveDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffectiveDate: %w", err)
+	}
+	return oldValue.EffectiveDate, nil
+}
+
+// ResetEffectiveDate resets all changes to the "effective_date" field.
+func (m *RecurringScheduleOverrideMutation) ResetEffectiveDate() {
+	m.effective_date = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *RecurringScheduleOverrideMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *RecurringScheduleOverrideMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the RecurringScheduleOverride entity.
+// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringScheduleOverrideMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *RecurringScheduleOverrideMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *RecurringScheduleOverrideMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *RecurringScheduleOverrideMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetFrequency sets the "frequency" field.
+func (m *RecurringScheduleOverrideMutation) SetFrequency(s string) {
+	m.frequency = &s
+}
+
+// Frequency returns the value of the "frequency" field in the mutation.
+func (m *RecurringScheduleOverrideMutation) Frequency() (r string, exists bool) {
+	v := m.frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrequency returns the old "frequency" field's value of the RecurringScheduleOverride entity.
+// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringScheduleOverrideMutation) OldFrequency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+	}
+	return oldValue.Frequency, nil
+}
+
+// ResetFrequency resets all changes to the "frequency" field.
+func (m *RecurringScheduleOverrideMutation) ResetFrequency() {
+	m.frequency = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurringScheduleOverrideMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurringScheduleOverrideMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurringScheduleOverride entity.
+// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringScheduleOverrideMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurringScheduleOverrideMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurringScheduleOverrideMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurringScheduleOverrideMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurringScheduleOverride entity.
+// If the RecurringScheduleOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringScheduleOverrideMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurringScheduleOverrideMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRecurringExpenseID sets the "recurring_expense" edge to the RecurringExpense entity by id.
+func (m *RecurringScheduleOverrideMutation) SetRecurringExpenseID(id int) {
+	m.recurring_expense = &id
+}
+
+// ClearRecurringExpense clears the "recurring_expense" edge to the RecurringExpense entity.
+func (m *RecurringScheduleOverrideMutation) ClearRecurringExpense() {
+	m.clearedrecurring_expense = true
+}
+
+// RecurringExpenseCleared reports if the "recurring_expense" edge to the RecurringExpense entity was cleared.
+func (m *RecurringScheduleOverrideMutation) RecurringExpenseCleared() bool {
+	return m.clearedrecurring_expense
+}
+
+// RecurringExpenseID returns the "recurring_expense" edge ID in the mutation.
+func (m *RecurringScheduleOverrideMutation) RecurringExpenseID() (id int, exists bool) {
+	if m.recurring_expense != nil {
+		return *m.recurring_expense, true
+	}
+	return
+}
+
+// RecurringExpenseIDs returns the "recurring_expense" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecurringExpenseID instead. It exists only for internal usage by the builders.
+func (m *RecurringScheduleOverrideMutation) RecurringExpenseIDs() (ids []int) {
+	if id := m.recurring_expense; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecurringExpense resets all changes to the "recurring_expense" edge.
+func (m *RecurringScheduleOverrideMutation) ResetRecurringExpense() {
+	m.recurring_expense = nil
+	m.clearedrecurring_expense = false
+}
+
+// Where appends a list predicates to the RecurringScheduleOverrideMutation builder.
+func (m *RecurringScheduleOverrideMutation) Where(ps ...predicate.RecurringScheduleOverride) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurringScheduleOverrideMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurringScheduleOverrideMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurringScheduleOverride, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurringScheduleOverrideMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurringScheduleOverrideMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurringScheduleOverride).
+func (m *RecurringScheduleOverrideMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurringScheduleOverrideMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.effective_date != nil {
+		fields = append(fields, recurringscheduleoverride.FieldEffectiveDate)
+	}
+	if m.amount != nil {
+		fields = append(fields, recurringscheduleoverride.FieldAmount)
+	}
+	if m.frequency != nil {
+		fields = append(fields, recurringscheduleoverride.FieldFrequency)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurringscheduleoverride.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurringscheduleoverride.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurringScheduleOverrideMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurringscheduleoverride.FieldEffectiveDate:
+		return m.EffectiveDate()
+	case recurringscheduleoverride.FieldAmount:
+		return m.Amount()
+	case recurringscheduleoverride.FieldFrequency:
+		return m.Frequency()
+	case recurringscheduleoverride.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurringscheduleoverride.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurringScheduleOverrideMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurringscheduleoverride.FieldEffectiveDate:
+		return m.OldEffectiveDate(ctx)
+	case recurringscheduleoverride.FieldAmount:
+		return m.OldAmount(ctx)
+	case recurringscheduleoverride.FieldFrequency:
+		return m.OldFrequency(ctx)
+	case recurringscheduleoverride.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurringscheduleoverride.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurringScheduleOverride field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringScheduleOverrideMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurringscheduleoverride.FieldEffectiveDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffectiveDate(v)
+		return nil
+	case recurringscheduleoverride.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case recurringscheduleoverride.FieldFrequency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrequency(v)
+		return nil
+	case recurringscheduleoverride.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurringscheduleoverride.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringScheduleOverride field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurringScheduleOverrideMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, recurringscheduleoverride.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurringScheduleOverrideMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recurringscheduleoverride.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringScheduleOverrideMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recurringscheduleoverride.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringScheduleOverride numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurringScheduleOverrideMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurringScheduleOverrideMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurringScheduleOverrideMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RecurringScheduleOverride nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurringScheduleOverrideMutation) ResetField(name string) error {
+	switch name {
+	case recurringscheduleoverride.FieldEffectiveDate:
+		m.ResetEffectiveDate()
+		return nil
+	case recurringscheduleoverride.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case recurringscheduleoverride.FieldFrequency:
+		m.ResetFrequency()
+		return nil
+	case recurringscheduleoverride.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurringscheduleoverride.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringScheduleOverride field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurringScheduleOverrideMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.recurring_expense != nil {
+		edges = append(edges, recurringscheduleoverride.EdgeRecurringExpense)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurringScheduleOverrideMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurringscheduleoverride.EdgeRecurringExpense:
+		if id := m.recurring_expense; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurringScheduleOverrideMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurringScheduleOverrideMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurringScheduleOverrideMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrecurring_expense {
+		edges = append(edges, recurringscheduleoverride.EdgeRecurringExpense)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurringScheduleOverrideMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurringscheduleoverride.EdgeRecurringExpense:
+		return m.clearedrecurring_expense
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurringScheduleOverrideMutation) ClearEdge(name string) error {
+	switch name {
+	case recurringscheduleoverride.EdgeRecurringExpense:
+		m.ClearRecurringExpense()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringScheduleOverride unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurringScheduleOverrideMutation) ResetEdge(name string) error {
+	switch name {
+	case recurringscheduleoverride.EdgeRecurringExpense:
+		m.ResetRecurringExpense()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringScheduleOverride edge %s", name)
+}
+
+// RevisionMutation represents an operation that mutates the Revision nodes in the graph.
+type RevisionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	entity_type     *string
+	entity_id       *int
+	addentity_id    *int
+	household_id    *int
+	addhousehold_id *int
+	action          *string
+	user_id         *int
+	adduser_id      *int
+	before          *map[string]string
+	after           *map[string]string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Revision, error)
+	predicates      []predicate.Revision
+}
+
+var _ ent.Mutation = (*RevisionMutation)(nil)
+
+// revisionOption allows management of the mutation configuration using functional options.
+type revisionOption func(*RevisionMutation)
+
+// newRevisionMutation creates new mutation for the Revision entity.
+func newRevisionMutation(c config, op Op, opts ...revisionOption) *RevisionMutation {
+	m := &RevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRevisionID sets the ID field of the mutation.
+func withRevisionID(id int) revisionOption {
+	return func(m *RevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Revision
+		)
+		m.oldValue = func(ctx context.Context) (*Revision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Revision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRevision sets the old Revision of the mutation.
+func withRevision(node *Revision) revisionOption {
+	return func(m *RevisionMutation) {
+		m.oldValue = func(context.Context) (*Revision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RevisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Revision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEntityType sets the "entity_type" field.
+func (m *RevisionMutation) SetEntityType(s string) {
+	m.entity_type = &s
+}
+
+// EntityType returns the value of the "entity_type" field in the mutation.
+func (m *RevisionMutation) EntityType() (r string, exists bool) {
+	v := m.entity_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityType returns the old "entity_type" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldEntityType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
+	}
+	return oldValue.EntityType, nil
+}
+
+// ResetEntityType resets all changes to the "entity_type" field.
+func (m *RevisionMutation) ResetEntityType() {
+	m.entity_type = nil
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *RevisionMutation) SetEntityID(i int) {
+	m.entity_id = &i
+	m.addentity_id = nil
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *RevisionMutation) EntityID() (r int, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldEntityID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (m *RevisionMutation) AddEntityID(i int) {
+	if m.addentity_id != nil {
+		*m.addentity_id += i
+	} else {
+		m.addentity_id = &i
+	}
+}
+
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *RevisionMutation) AddedEntityID() (r int, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *RevisionMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+}
+
+// SetHouseholdID sets the "household_id" field.
+func (m *RevisionMutation) SetHouseholdID(i int) {
+	m.household_id = &i
+	m.addhousehold_id = nil
+}
+
+// HouseholdID returns the value of the "household_id" field in the mutation.
+func (m *RevisionMutation) HouseholdID() (r int, exists bool) {
+	v := m.household_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHouseholdID returns the old "household_id" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldHouseholdID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHouseholdID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHouseholdID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHouseholdID: %w", err)
+	}
+	return oldValue.HouseholdID, nil
+}
+
+// AddHouseholdID adds i to the "household_id" field.
+func (m *RevisionMutation) AddHouseholdID(i int) {
+	if m.addhousehold_id != nil {
+		*m.addhousehold_id += i
+	} else {
+		m.addhousehold_id = &i
+	}
+}
+
+// AddedHouseholdID returns the value that was added to the "household_id" field in this mutation.
+func (m *RevisionMutation) AddedHouseholdID() (r int, exists bool) {
+	v := m.addhousehold_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHouseholdID resets all changes to the "household_id" field.
+func (m *RevisionMutation) ResetHouseholdID() {
+	m.household_id = nil
+	m.addhousehold_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *RevisionMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *RevisionMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *RevisionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RevisionMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RevisionMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *RevisionMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RevisionMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *RevisionMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[revision.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *RevisionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[revision.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RevisionMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, revision.FieldUserID)
+}
+
+// SetBefore sets the "before" field.
+func (m *RevisionMutation) SetBefore(value map[string]string) {
+	m.before = &value
+}
+
+// Before returns the value of the "before" field in the mutation.
+func (m *RevisionMutation) Before() (r map[string]string, exists bool) {
+	v := m.before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBefore returns the old "before" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldBefore(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
+	}
+	return oldValue.Before, nil
+}
+
+// ClearBefore clears the value of the "before" field.
+func (m *RevisionMutation) ClearBefore() {
+	m.before = nil
+	m.clearedFields[revision.FieldBefore] = struct{}{}
+}
+
+// BeforeCleared returns if the "before" field was cleared in this mutation.
+func (m *RevisionMutation) BeforeCleared() bool {
+	_, ok := m.clearedFields[revision.FieldBefore]
+	return ok
+}
+
+// ResetBefore resets all changes to the "before" field.
+func (m *RevisionMutation) ResetBefore() {
+	m.before = nil
+	delete(m.clearedFields, revision.FieldBefore)
+}
+
+// SetAfter sets the "after" field.
+func (m *RevisionMutation) SetAfter(value map[string]string) {
+	m.after = &value
+}
+
+// After returns the value of the "after" field in the mutation.
+func (m *RevisionMutation) After() (r map[string]string, exists bool) {
+	v := m.after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfter returns the old "after" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldAfter(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
+	}
+	return oldValue.After, nil
+}
+
+// ClearAfter clears the value of the "after" field.
+func (m *RevisionMutation) ClearAfter() {
+	m.after = nil
+	m.clearedFields[revision.FieldAfter] = struct{}{}
+}
+
+// AfterCleared returns if the "after" field was cleared in this mutation.
+func (m *RevisionMutation) AfterCleared() bool {
+	_, ok := m.clearedFields[revision.FieldAfter]
+	return ok
+}
+
+// ResetAfter resets all changes to the "after" field.
+func (m *RevisionMutation) ResetAfter() {
+	m.after = nil
+	delete(m.clearedFields, revision.FieldAfter)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Revision entity.
+// If the Revision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RevisionMutation builder.
+func (m *RevisionMutation) Where(ps ...predicate.Revision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Revision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Revision).
+func (m *RevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RevisionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.entity_type != nil {
+		fields = append(fields, revision.FieldEntityType)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, revision.FieldEntityID)
+	}
+	if m.household_id != nil {
+		fields = append(fields, revision.FieldHouseholdID)
+	}
+	if m.action != nil {
+		fields = append(fields, revision.FieldAction)
+	}
+	if m.user_id != nil {
+		fields = append(fields, revision.FieldUserID)
+	}
+	if m.before != nil {
+		fields = append(fields, revision.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, revision.FieldAfter)
+	}
+	if m.created_at != nil {
+		fields = append(fields, revision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case revision.FieldEntityType:
+		return m.EntityType()
+	case revision.FieldEntityID:
+		return m.EntityID()
+	case revision.FieldHouseholdID:
+		return m.HouseholdID()
+	case revision.FieldAction:
+		return m.Action()
+	case revision.FieldUserID:
+		return m.UserID()
+	case revision.FieldBefore:
+		return m.Before()
+	case revision.FieldAfter:
+		return m.After()
+	case revision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case revision.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case revision.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case revision.FieldHouseholdID:
+		return m.OldHouseholdID(ctx)
+	case revision.FieldAction:
+		return m.OldAction(ctx)
+	case revision.FieldUserID:
+		return m.OldUserID(ctx)
+	case revision.FieldBefore:
+		return m.OldBefore(ctx)
+	case revision.FieldAfter:
+		return m.OldAfter(ctx)
+	case revision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Revision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case revision.FieldEntityType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityType(v)
+		return nil
+	case revision.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case revision.FieldHouseholdID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHouseholdID(v)
+		return nil
+	case revision.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case revision.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case revision.FieldBefore:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBefore(v)
+		return nil
+	case revision.FieldAfter:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfter(v)
+		return nil
+	case revision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Revision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addentity_id != nil {
+		fields = append(fields, revision.FieldEntityID)
+	}
+	if m.addhousehold_id != nil {
+		fields = append(fields, revision.FieldHouseholdID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, revision.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case revision.FieldEntityID:
+		return m.AddedEntityID()
+	case revision.FieldHouseholdID:
+		return m.AddedHouseholdID()
+	case revision.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case revision.FieldEntityID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
+		return nil
+	case revision.FieldHouseholdID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHouseholdID(v)
+		return nil
+	case revision.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Revision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(revision.FieldUserID) {
+		fields = append(fields, revision.FieldUserID)
+	}
+	if m.FieldCleared(revision.FieldBefore) {
+		fields = append(fields, revision.FieldBefore)
+	}
+	if m.FieldCleared(revision.FieldAfter) {
+		fields = append(fields, revision.FieldAfter)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RevisionMutation) ClearField(name string) error {
+	switch name {
+	case revision.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case revision.FieldBefore:
+		m.ClearBefore()
+		return nil
+	case revision.FieldAfter:
+		m.ClearAfter()
+		return nil
+	}
+	return fmt.Errorf("unknown Revision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RevisionMutation) ResetField(name string) error {
+	switch name {
+	case revision.FieldEntityType:
+		m.ResetEntityType()
+		return nil
+	case revision.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case revision.FieldHouseholdID:
+		m.ResetHouseholdID()
+		return nil
+	case revision.FieldAction:
+		m.ResetAction()
+		return nil
+	case revision.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case revision.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case revision.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case revision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Revision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RevisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RevisionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RevisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Revision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RevisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Revision edge %s", name)
+}
+
+// SecurityEventMutation represents an operation that mutates the SecurityEvent nodes in the graph.
+type SecurityEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_type         *string
+	user_id       *int
+	adduser_id    *int
+	ip            *string
+	user_agent    *string
+	details       *map[string]string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SecurityEvent, error)
+	predicates    []predicate.SecurityEvent
+}
+
+var _ ent.Mutation = (*SecurityEventMutation)(nil)
+
+// securityeventOption allows management of the mutation configuration using functional options.
+type securityeventOption func(*SecurityEventMutation)
+
+// newSecurityEventMutation creates new mutation for the SecurityEvent entity.
+func newSecurityEventMutation(c config, op Op, opts ...securityeventOption) *SecurityEventMutation {
+	m := &SecurityEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSecurityEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSecurityEventID sets the ID field of the mutation.
+func withSecurityEventID(id int) securityeventOption {
+	return func(m *SecurityEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SecurityEvent
+		)
+		m.oldValue = func(ctx context.Context) (*SecurityEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SecurityEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSecurityEvent sets the old SecurityEvent of the mutation.
+func withSecurityEvent(node *SecurityEvent) securityeventOption {
+	return func(m *SecurityEventMutation) {
+		m.oldValue = func(context.Context) (*SecurityEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SecurityEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecurityEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecurityEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecurityEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SecurityEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *SecurityEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SecurityEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SecurityEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SecurityEventMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SecurityEventMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *SecurityEventMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *SecurityEventMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *SecurityEventMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[securityevent.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SecurityEventMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[securityevent.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SecurityEventMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, securityevent.FieldUserID)
+}
+
+// SetIP sets the "ip" field.
+func (m *SecurityEventMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *SecurityEventMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *SecurityEventMutation) ResetIP() {
+	m.ip = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SecurityEventMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SecurityEventMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SecurityEventMutation) ResetUserAgent() {
+	m.user_agent = nil
+}
+
+// SetDetails sets the "details" field.
+func (m *SecurityEventMutation) SetDetails(value map[string]string) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *SecurityEventMutation) Details() (r map[string]string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldDetails(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *SecurityEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[securityevent.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *SecurityEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[securityevent.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *SecurityEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, securityevent.FieldDetails)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SecurityEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SecurityEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SecurityEvent entity.
+// If the SecurityEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecurityEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SecurityEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the SecurityEventMutation builder.
+func (m *SecurityEventMutation) Where(ps ...predicate.SecurityEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SecurityEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SecurityEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecurityEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SecurityEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SecurityEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SecurityEvent).
+func (m *SecurityEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SecurityEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, securityevent.FieldType)
+	}
+	if m.user_id != nil {
+		fields = append(fields, securityevent.FieldUserID)
+	}
+	if m.ip != nil {
+		fields = append(fields, securityevent.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, securityevent.FieldUserAgent)
+	}
+	if m.details != nil {
+		fields = append(fields, securityevent.FieldDetails)
+	}
+	if m.created_at != nil {
+		fields = append(fields, securityevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SecurityEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case securityevent.FieldType:
+		return m.GetType()
+	case securityevent.FieldUserID:
+		return m.UserID()
+	case securityevent.FieldIP:
+		return m.IP()
+	case securityevent.FieldUserAgent:
+		return m.UserAgent()
+	case securityevent.FieldDetails:
+		return m.Details()
+	case securityevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecurityEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case securityevent.FieldType:
+		return m.OldType(ctx)
+	case securityevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case securityevent.FieldIP:
+		return m.OldIP(ctx)
+	case securityevent.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case securityevent.FieldDetails:
+		return m.OldDetails(ctx)
+	case securityevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SecurityEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecurityEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case securityevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case securityevent.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case securityevent.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case securityevent.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case securityevent.FieldDetails:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case securityevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SecurityEventMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, securityevent.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SecurityEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case securityevent.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SecurityEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case securityevent.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SecurityEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(securityevent.FieldUserID) {
+		fields = append(fields, securityevent.FieldUserID)
+	}
+	if m.FieldCleared(securityevent.FieldDetails) {
+		fields = append(fields, securityevent.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SecurityEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SecurityEventMutation) ClearField(name string) error {
+	switch name {
+	case securityevent.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case securityevent.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SecurityEventMutation) ResetField(name string) error {
+	switch name {
+	case securityevent.FieldType:
+		m.ResetType()
+		return nil
+	case securityevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case securityevent.FieldIP:
+		m.ResetIP()
+		return nil
+	case securityevent.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case securityevent.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case securityevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SecurityEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SecurityEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SecurityEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SecurityEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SecurityEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SecurityEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecurityEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecurityEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SecurityEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecurityEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SecurityEvent edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token         *string
+	data          *[]byte
+	user_id       *int
+	adduser_id    *int
+	expires_at    *time.Time
+	user_agent    *string
+	ip            *string
+	last_seen_at  *time.Time
+	oidc_issuer   *string
+	oidc_subject  *string
+	oidc_sid      *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *SessionMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *SessionMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *SessionMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetData sets the "data" field.
+func (m *SessionMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *SessionMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *SessionMutation) ResetData() {
+	m.data = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *SessionMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *SessionMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *SessionMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SessionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, session.FieldUserID)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+}
+
+// SetIP sets the "ip" field.
+func (m *SessionMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *SessionMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *SessionMutation) ResetIP() {
+	m.ip = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *SessionMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *SessionMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *SessionMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[session.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *SessionMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *SessionMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, session.FieldLastSeenAt)
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (m *SessionMutation) SetOidcIssuer(s string) {
+	m.oidc_issuer = &s
+}
+
+// OidcIssuer returns the value of the "oidc_issuer" field in the mutation.
+func (m *SessionMutation) OidcIssuer() (r string, exists bool) {
+	v := m.oidc_issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOidcIssuer returns the old "oidc_issuer" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldOidcIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOidcIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOidcIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOidcIssuer: %w", err)
+	}
+	return oldValue.OidcIssuer, nil
+}
+
+// ResetOidcIssuer resets all changes to the "oidc_issuer" field.
+func (m *SessionMutation) ResetOidcIssuer() {
+	m.oidc_issuer = nil
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (m *SessionMutation) SetOidcSubject(s string) {
+	m.oidc_subject = &s
+}
+
+// OidcSubject returns the value of the "oidc_subject" field in the mutation.
+func (m *SessionMutation) OidcSubject() (r string, exists bool) {
+	v := m.oidc_subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOidcSubject returns the old "oidc_subject" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldOidcSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOidcSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOidcSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOidcSubject: %w", err)
+	}
+	return oldValue.OidcSubject, nil
+}
+
+// ResetOidcSubject resets all changes to the "oidc_subject" field.
+func (m *SessionMutation) ResetOidcSubject() {
+	m.oidc_subject = nil
+}
+
+// SetOidcSid sets the "oidc_sid" field.
+func (m *SessionMutation) SetOidcSid(s string) {
+	m.oidc_sid = &s
+}
+
+// OidcSid returns the value of the "oidc_sid" field in the mutation.
+func (m *SessionMutation) OidcSid() (r string, exists bool) {
+	v := m.oidc_sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOidcSid returns the old "oidc_sid" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldOidcSid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOidcSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOidcSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOidcSid: %w", err)
+	}
+	return oldValue.OidcSid, nil
+}
+
+// ResetOidcSid resets all changes to the "oidc_sid" field.
+func (m *SessionMutation) ResetOidcSid() {
+	m.oidc_sid = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SessionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SessionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.token != nil {
+		fields = append(fields, session.FieldToken)
+	}
+	if m.data != nil {
+		fields = append(fields, session.FieldData)
+	}
+	if m.user_id != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.ip != nil {
+		fields = append(fields, session.FieldIP)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, session.FieldLastSeenAt)
+	}
+	if m.oidc_issuer != nil {
+		fields = append(fields, session.FieldOidcIssuer)
+	}
+	if m.oidc_subject != nil {
+		fields = append(fields, session.FieldOidcSubject)
+	}
+	if m.oidc_sid != nil {
+		fields = append(fields, session.FieldOidcSid)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, session.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldToken:
+		return m.Token()
+	case session.FieldData:
+		return m.Data()
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldIP:
+		return m.IP()
+	case session.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case session.FieldOidcIssuer:
+		return m.OidcIssuer()
+	case session.FieldOidcSubject:
+		return m.OidcSubject()
+	case session.FieldOidcSid:
+		return m.OidcSid()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldToken:
+		return m.OldToken(ctx)
+	case session.FieldData:
+		return m.OldData(ctx)
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldIP:
+		return m.OldIP(ctx)
+	case session.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case session.FieldOidcIssuer:
+		return m.OldOidcIssuer(ctx)
+	case session.FieldOidcSubject:
+		return m.OldOidcSubject(ctx)
+	case session.FieldOidcSid:
+		return m.OldOidcSid(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case session.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case session.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case session.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case session.FieldOidcIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOidcIssuer(v)
+		return nil
+	case session.FieldOidcSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOidcSubject(v)
+		return nil
+	case session.FieldOidcSid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOidcSid(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldUserID) {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.FieldCleared(session.FieldLastSeenAt) {
+		fields = append(fields, session.FieldLastSeenAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case session.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldToken:
+		m.ResetToken()
+		return nil
+	case session.FieldData:
+		m.ResetData()
+		return nil
+	case session.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldIP:
+		m.ResetIP()
+		return nil
+	case session.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case session.FieldOidcIssuer:
+		m.ResetOidcIssuer()
+		return nil
+	case session.FieldOidcSubject:
+		m.ResetOidcSubject()
+		return nil
+	case session.FieldOidcSid:
+		m.ResetOidcSid()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
+type SettingsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Settings, error)
+	predicates    []predicate.Settings
+}
+
+var _ ent.Mutation = (*SettingsMutation)(nil)
+
+// settingsOption allows management of the mutation configuration using functional options.
+type settingsOption func(*SettingsMutation)
+
+// newSettingsMutation creates new mutation for the Settings entity.
+func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
+	m := &SettingsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingsID sets the ID field of the mutation.
+func withSettingsID(id int) settingsOption {
+	return func(m *SettingsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settings
+		)
+		m.oldValue = func(ctx context.Context) (*Settings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettings sets the old Settings of the mutation.
+func withSettings(node *Settings) settingsOption {
+	return func(m *SettingsMutation) {
+		m.oldValue = func(context.Context) (*Settings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *SettingsMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingsMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
@@ -4871,142 +11837,1822 @@ func (m *SettingsMutation) Key() (r string, exists bool) {
 // OldKey returns the old "key" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *SettingsMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SettingsMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SettingsMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingsMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SettingsMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SettingsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SettingsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SettingsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SettingsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SettingsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SettingsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SettingsMutation builder.
+func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settings, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettingsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettingsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settings).
+func (m *SettingsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettingsMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key != nil {
+		fields = append(fields, settings.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, settings.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, settings.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, settings.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case settings.FieldKey:
+		return m.Key()
+	case settings.FieldValue:
+		return m.Value()
+	case settings.FieldCreatedAt:
+		return m.CreatedAt()
+	case settings.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case settings.FieldKey:
+		return m.OldKey(ctx)
+	case settings.FieldValue:
+		return m.OldValue(ctx)
+	case settings.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case settings.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Settings field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case settings.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case settings.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case settings.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case settings.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settings field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettingsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Settings numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettingsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettingsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettingsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Settings nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettingsMutation) ResetField(name string) error {
+	switch name {
+	case settings.FieldKey:
+		m.ResetKey()
+		return nil
+	case settings.FieldValue:
+		m.ResetValue()
+		return nil
+	case settings.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case settings.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Settings field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettingsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettingsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettingsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettingsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Settings unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettingsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Settings edge %s", name)
+}
+
+// SettlementMutation represents an operation that mutates the Settlement nodes in the graph.
+type SettlementMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	amount           *int64
+	addamount        *int64
+	date             *time.Time
+	note             *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	household        *int
+	clearedhousehold bool
+	from             *int
+	clearedfrom      bool
+	to               *int
+	clearedto        bool
+	done             bool
+	oldValue         func(context.Context) (*Settlement, error)
+	predicates       []predicate.Settlement
+}
+
+var _ ent.Mutation = (*SettlementMutation)(nil)
+
+// settlementOption allows management of the mutation configuration using functional options.
+type settlementOption func(*SettlementMutation)
+
+// newSettlementMutation creates new mutation for the Settlement entity.
+func newSettlementMutation(c config, op Op, opts ...settlementOption) *SettlementMutation {
+	m := &SettlementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettlement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettlementID sets the ID field of the mutation.
+func withSettlementID(id int) settlementOption {
+	return func(m *SettlementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settlement
+		)
+		m.oldValue = func(ctx context.Context) (*Settlement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settlement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettlement sets the old Settlement of the mutation.
+func withSettlement(node *Settlement) settlementOption {
+	return func(m *SettlementMutation) {
+		m.oldValue = func(context.Context) (*Settlement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettlementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettlementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettlementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettlementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settlement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAmount sets the "amount" field.
+func (m *SettlementMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *SettlementMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *SettlementMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *SettlementMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *SettlementMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetDate sets the "date" field.
+func (m *SettlementMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *SettlementMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *SettlementMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetNote sets the "note" field.
+func (m *SettlementMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *SettlementMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *SettlementMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[settlement.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *SettlementMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[settlement.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *SettlementMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, settlement.FieldNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SettlementMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SettlementMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SettlementMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *SettlementMutation) SetHouseholdID(id int) {
+	m.household = &id
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *SettlementMutation) ClearHousehold() {
+	m.clearedhousehold = true
+}
+
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *SettlementMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
+}
+
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *SettlementMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
+	}
+	return
+}
+
+// HouseholdIDs returns the "household" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *SettlementMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHousehold resets all changes to the "household" edge.
+func (m *SettlementMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
+}
+
+// SetFromID sets the "from" edge to the HouseholdMember entity by id.
+func (m *SettlementMutation) SetFromID(id int) {
+	m.from = &id
+}
+
+// ClearFrom clears the "from" edge to the HouseholdMember entity.
+func (m *SettlementMutation) ClearFrom() {
+	m.clearedfrom = true
+}
+
+// FromCleared reports if the "from" edge to the HouseholdMember entity was cleared.
+func (m *SettlementMutation) FromCleared() bool {
+	return m.clearedfrom
+}
+
+// FromID returns the "from" edge ID in the mutation.
+func (m *SettlementMutation) FromID() (id int, exists bool) {
+	if m.from != nil {
+		return *m.from, true
+	}
+	return
+}
+
+// FromIDs returns the "from" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromID instead. It exists only for internal usage by the builders.
+func (m *SettlementMutation) FromIDs() (ids []int) {
+	if id := m.from; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFrom resets all changes to the "from" edge.
+func (m *SettlementMutation) ResetFrom() {
+	m.from = nil
+	m.clearedfrom = false
+}
+
+// SetToID sets the "to" edge to the HouseholdMember entity by id.
+func (m *SettlementMutation) SetToID(id int) {
+	m.to = &id
+}
+
+// ClearTo clears the "to" edge to the HouseholdMember entity.
+func (m *SettlementMutation) ClearTo() {
+	m.clearedto = true
+}
+
+// ToCleared reports if the "to" edge to the HouseholdMember entity was cleared.
+func (m *SettlementMutation) ToCleared() bool {
+	return m.clearedto
+}
+
+// ToID returns the "to" edge ID in the mutation.
+func (m *SettlementMutation) ToID() (id int, exists bool) {
+	if m.to != nil {
+		return *m.to, true
+	}
+	return
+}
+
+// ToIDs returns the "to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToID instead. It exists only for internal usage by the builders.
+func (m *SettlementMutation) ToIDs() (ids []int) {
+	if id := m.to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTo resets all changes to the "to" edge.
+func (m *SettlementMutation) ResetTo() {
+	m.to = nil
+	m.clearedto = false
+}
+
+// Where appends a list predicates to the SettlementMutation builder.
+func (m *SettlementMutation) Where(ps ...predicate.Settlement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettlementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettlementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settlement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettlementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettlementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settlement).
+func (m *SettlementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettlementMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.amount != nil {
+		fields = append(fields, settlement.FieldAmount)
+	}
+	if m.date != nil {
+		fields = append(fields, settlement.FieldDate)
+	}
+	if m.note != nil {
+		fields = append(fields, settlement.FieldNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, settlement.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettlementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case settlement.FieldAmount:
+		return m.Amount()
+	case settlement.FieldDate:
+		return m.Date()
+	case settlement.FieldNote:
+		return m.Note()
+	case settlement.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettlementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case settlement.FieldAmount:
+		return m.OldAmount(ctx)
+	case settlement.FieldDate:
+		return m.OldDate(ctx)
+	case settlement.FieldNote:
+		return m.OldNote(ctx)
+	case settlement.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettlementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case settlement.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case settlement.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case settlement.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case settlement.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettlementMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, settlement.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettlementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case settlement.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettlementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case settlement.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettlementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(settlement.FieldNote) {
+		fields = append(fields, settlement.FieldNote)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettlementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettlementMutation) ClearField(name string) error {
+	switch name {
+	case settlement.FieldNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettlementMutation) ResetField(name string) error {
+	switch name {
+	case settlement.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case settlement.FieldDate:
+		m.ResetDate()
+		return nil
+	case settlement.FieldNote:
+		m.ResetNote()
+		return nil
+	case settlement.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettlementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.household != nil {
+		edges = append(edges, settlement.EdgeHousehold)
+	}
+	if m.from != nil {
+		edges = append(edges, settlement.EdgeFrom)
+	}
+	if m.to != nil {
+		edges = append(edges, settlement.EdgeTo)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettlementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case settlement.EdgeHousehold:
+		if id := m.household; id != nil {
+			return []ent.Value{*id}
+		}
+	case settlement.EdgeFrom:
+		if id := m.from; id != nil {
+			return []ent.Value{*id}
+		}
+	case settlement.EdgeTo:
+		if id := m.to; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettlementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettlementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettlementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedhousehold {
+		edges = append(edges, settlement.EdgeHousehold)
+	}
+	if m.clearedfrom {
+		edges = append(edges, settlement.EdgeFrom)
+	}
+	if m.clearedto {
+		edges = append(edges, settlement.EdgeTo)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettlementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case settlement.EdgeHousehold:
+		return m.clearedhousehold
+	case settlement.EdgeFrom:
+		return m.clearedfrom
+	case settlement.EdgeTo:
+		return m.clearedto
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettlementMutation) ClearEdge(name string) error {
+	switch name {
+	case settlement.EdgeHousehold:
+		m.ClearHousehold()
+		return nil
+	case settlement.EdgeFrom:
+		m.ClearFrom()
+		return nil
+	case settlement.EdgeTo:
+		m.ClearTo()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettlementMutation) ResetEdge(name string) error {
+	switch name {
+	case settlement.EdgeHousehold:
+		m.ResetHousehold()
+		return nil
+	case settlement.EdgeFrom:
+		m.ResetFrom()
+		return nil
+	case settlement.EdgeTo:
+		m.ResetTo()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement edge %s", name)
+}
+
+// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
+type TransactionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	amount             *int64
+	addamount          *int64
+	description        *string
+	details            *string
+	date               *time.Time
+	tax_class          *string
+	split_type         *string
+	split_shares       *[]schema.SplitShare
+	appendsplit_shares []schema.SplitShare
+	created_at         *time.Time
+	updated_at         *time.Time
+	deleted_at         *time.Time
+	clearedFields      map[string]struct{}
+	household          *int
+	clearedhousehold   bool
+	category           *int
+	clearedcategory    bool
+	payer              *int
+	clearedpayer       bool
+	done               bool
+	oldValue           func(context.Context) (*Transaction, error)
+	predicates         []predicate.Transaction
+}
+
+var _ ent.Mutation = (*TransactionMutation)(nil)
+
+// transactionOption allows management of the mutation configuration using functional options.
+type transactionOption func(*TransactionMutation)
+
+// newTransactionMutation creates new mutation for the Transaction entity.
+func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
+	m := &TransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransactionID sets the ID field of the mutation.
+func withTransactionID(id int) transactionOption {
+	return func(m *TransactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transaction
+		)
+		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransaction sets the old Transaction of the mutation.
+func withTransaction(node *Transaction) transactionOption {
+	return func(m *TransactionMutation) {
+		m.oldValue = func(context.Context) (*Transaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAmount sets the "amount" field.
+func (m *TransactionMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TransactionMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *TransactionMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *TransactionMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TransactionMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TransactionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TransactionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TransactionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[transaction.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TransactionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TransactionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, transaction.FieldDescription)
+}
+
+// SetDetails sets the "details" field.
+func (m *TransactionMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *TransactionMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *TransactionMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[transaction.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *TransactionMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *TransactionMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, transaction.FieldDetails)
+}
+
+// SetDate sets the "date" field.
+func (m *TransactionMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *TransactionMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Date, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SettingsMutation) ResetKey() {
-	m.key = nil
+// ResetDate resets all changes to the "date" field.
+func (m *TransactionMutation) ResetDate() {
+	m.date = nil
 }
 
-// SetValue sets the "value" field.
-func (m *SettingsMutation) SetValue(s string) {
-	m.value = &s
+// SetTaxClass sets the "tax_class" field.
+func (m *TransactionMutation) SetTaxClass(s string) {
+	m.tax_class = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingsMutation) Value() (r string, exists bool) {
-	v := m.value
+// TaxClass returns the value of the "tax_class" field in the mutation.
+func (m *TransactionMutation) TaxClass() (r string, exists bool) {
+	v := m.tax_class
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldTaxClass returns the old "tax_class" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *TransactionMutation) OldTaxClass(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaxClass is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldTaxClass requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaxClass: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.TaxClass, nil
+}
+
+// ClearTaxClass clears the value of the "tax_class" field.
+func (m *TransactionMutation) ClearTaxClass() {
+	m.tax_class = nil
+	m.clearedFields[transaction.FieldTaxClass] = struct{}{}
+}
+
+// TaxClassCleared returns if the "tax_class" field was cleared in this mutation.
+func (m *TransactionMutation) TaxClassCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldTaxClass]
+	return ok
+}
+
+// ResetTaxClass resets all changes to the "tax_class" field.
+func (m *TransactionMutation) ResetTaxClass() {
+	m.tax_class = nil
+	delete(m.clearedFields, transaction.FieldTaxClass)
+}
+
+// SetSplitType sets the "split_type" field.
+func (m *TransactionMutation) SetSplitType(s string) {
+	m.split_type = &s
+}
+
+// SplitType returns the value of the "split_type" field in the mutation.
+func (m *TransactionMutation) SplitType() (r string, exists bool) {
+	v := m.split_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSplitType returns the old "split_type" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSplitType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSplitType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSplitType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSplitType: %w", err)
+	}
+	return oldValue.SplitType, nil
+}
+
+// ClearSplitType clears the value of the "split_type" field.
+func (m *TransactionMutation) ClearSplitType() {
+	m.split_type = nil
+	m.clearedFields[transaction.FieldSplitType] = struct{}{}
+}
+
+// SplitTypeCleared returns if the "split_type" field was cleared in this mutation.
+func (m *TransactionMutation) SplitTypeCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldSplitType]
+	return ok
+}
+
+// ResetSplitType resets all changes to the "split_type" field.
+func (m *TransactionMutation) ResetSplitType() {
+	m.split_type = nil
+	delete(m.clearedFields, transaction.FieldSplitType)
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (m *TransactionMutation) SetSplitShares(ss []schema.SplitShare) {
+	m.split_shares = &ss
+	m.appendsplit_shares = nil
+}
+
+// SplitShares returns the value of the "split_shares" field in the mutation.
+func (m *TransactionMutation) SplitShares() (r []schema.SplitShare, exists bool) {
+	v := m.split_shares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSplitShares returns the old "split_shares" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldSplitShares(ctx context.Context) (v []schema.SplitShare, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSplitShares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSplitShares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSplitShares: %w", err)
+	}
+	return oldValue.SplitShares, nil
+}
+
+// AppendSplitShares adds ss to the "split_shares" field.
+func (m *TransactionMutation) AppendSplitShares(ss []schema.SplitShare) {
+	m.appendsplit_shares = append(m.appendsplit_shares, ss...)
+}
+
+// AppendedSplitShares returns the list of values that were appended to the "split_shares" field in this mutation.
+func (m *TransactionMutation) AppendedSplitShares() ([]schema.SplitShare, bool) {
+	if len(m.appendsplit_shares) == 0 {
+		return nil, false
+	}
+	return m.appendsplit_shares, true
+}
+
+// ClearSplitShares clears the value of the "split_shares" field.
+func (m *TransactionMutation) ClearSplitShares() {
+	m.split_shares = nil
+	m.appendsplit_shares = nil
+	m.clearedFields[transaction.FieldSplitShares] = struct{}{}
+}
+
+// SplitSharesCleared returns if the "split_shares" field was cleared in this mutation.
+func (m *TransactionMutation) SplitSharesCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldSplitShares]
+	return ok
+}
+
+// ResetSplitShares resets all changes to the "split_shares" field.
+func (m *TransactionMutation) ResetSplitShares() {
+	m.split_shares = nil
+	m.appendsplit_shares = nil
+	delete(m.clearedFields, transaction.FieldSplitShares)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TransactionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TransactionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TransactionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TransactionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TransactionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[transaction.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TransactionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TransactionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, transaction.FieldDeletedAt)
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by id.
+func (m *TransactionMutation) SetHouseholdID(id int) {
+	m.household = &id
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (m *TransactionMutation) ClearHousehold() {
+	m.clearedhousehold = true
+}
+
+// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
+func (m *TransactionMutation) HouseholdCleared() bool {
+	return m.clearedhousehold
+}
+
+// HouseholdID returns the "household" edge ID in the mutation.
+func (m *TransactionMutation) HouseholdID() (id int, exists bool) {
+	if m.household != nil {
+		return *m.household, true
+	}
+	return
+}
+
+// HouseholdIDs returns the "household" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HouseholdID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) HouseholdIDs() (ids []int) {
+	if id := m.household; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHousehold resets all changes to the "household" edge.
+func (m *TransactionMutation) ResetHousehold() {
+	m.household = nil
+	m.clearedhousehold = false
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingsMutation) ResetValue() {
-	m.value = nil
+// SetCategoryID sets the "category" edge to the Category entity by id.
+func (m *TransactionMutation) SetCategoryID(id int) {
+	m.category = &id
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SettingsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *TransactionMutation) ClearCategory() {
+	m.clearedcategory = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SettingsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *TransactionMutation) CategoryCleared() bool {
+	return m.clearedcategory
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// CategoryID returns the "category" edge ID in the mutation.
+func (m *TransactionMutation) CategoryID() (id int, exists bool) {
+	if m.category != nil {
+		return *m.category, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SettingsMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetCategory resets all changes to the "category" edge.
+func (m *TransactionMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SettingsMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by id.
+func (m *TransactionMutation) SetPayerID(id int) {
+	m.payer = &id
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SettingsMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPayer clears the "payer" edge to the HouseholdMember entity.
+func (m *TransactionMutation) ClearPayer() {
+	m.clearedpayer = true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// PayerCleared reports if the "payer" edge to the HouseholdMember entity was cleared.
+func (m *TransactionMutation) PayerCleared() bool {
+	return m.clearedpayer
+}
+
+// PayerID returns the "payer" edge ID in the mutation.
+func (m *TransactionMutation) PayerID() (id int, exists bool) {
+	if m.payer != nil {
+		return *m.payer, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// PayerIDs returns the "payer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PayerID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) PayerIDs() (ids []int) {
+	if id := m.payer; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SettingsMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetPayer resets all changes to the "payer" edge.
+func (m *TransactionMutation) ResetPayer() {
+	m.payer = nil
+	m.clearedpayer = false
 }
 
-// Where appends a list predicates to the SettingsMutation builder.
-func (m *SettingsMutation) Where(ps ...predicate.Settings) {
+// Where appends a list predicates to the TransactionMutation builder.
+func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Settings, len(ps))
+func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transaction, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5014,36 +13660,54 @@ func (m *SettingsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingsMutation) Op() Op {
+func (m *TransactionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingsMutation) SetOp(op Op) {
+func (m *TransactionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Settings).
-func (m *SettingsMutation) Type() string {
+// Type returns the node type of this mutation (Transaction).
+func (m *TransactionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingsMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.key != nil {
-		fields = append(fields, settings.FieldKey)
+func (m *TransactionMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.amount != nil {
+		fields = append(fields, transaction.FieldAmount)
 	}
-	if m.value != nil {
-		fields = append(fields, settings.FieldValue)
+	if m.description != nil {
+		fields = append(fields, transaction.FieldDescription)
+	}
+	if m.details != nil {
+		fields = append(fields, transaction.FieldDetails)
+	}
+	if m.date != nil {
+		fields = append(fields, transaction.FieldDate)
+	}
+	if m.tax_class != nil {
+		fields = append(fields, transaction.FieldTaxClass)
+	}
+	if m.split_type != nil {
+		fields = append(fields, transaction.FieldSplitType)
+	}
+	if m.split_shares != nil {
+		fields = append(fields, transaction.FieldSplitShares)
 	}
 	if m.created_at != nil {
-		fields = append(fields, settings.FieldCreatedAt)
+		fields = append(fields, transaction.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, settings.FieldUpdatedAt)
+		fields = append(fields, transaction.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, transaction.FieldDeletedAt)
 	}
 	return fields
 }
@@ -5051,16 +13715,28 @@ func (m *SettingsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
+func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case settings.FieldKey:
-		return m.Key()
-	case settings.FieldValue:
-		return m.Value()
-	case settings.FieldCreatedAt:
+	case transaction.FieldAmount:
+		return m.Amount()
+	case transaction.FieldDescription:
+		return m.Description()
+	case transaction.FieldDetails:
+		return m.Details()
+	case transaction.FieldDate:
+		return m.Date()
+	case transaction.FieldTaxClass:
+		return m.TaxClass()
+	case transaction.FieldSplitType:
+		return m.SplitType()
+	case transaction.FieldSplitShares:
+		return m.SplitShares()
+	case transaction.FieldCreatedAt:
 		return m.CreatedAt()
-	case settings.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case transaction.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -5068,199 +13744,398 @@ func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case settings.FieldKey:
-		return m.OldKey(ctx)
-	case settings.FieldValue:
-		return m.OldValue(ctx)
-	case settings.FieldCreatedAt:
+	case transaction.FieldAmount:
+		return m.OldAmount(ctx)
+	case transaction.FieldDescription:
+		return m.OldDescription(ctx)
+	case transaction.FieldDetails:
+		return m.OldDetails(ctx)
+	case transaction.FieldDate:
+		return m.OldDate(ctx)
+	case transaction.FieldTaxClass:
+		return m.OldTaxClass(ctx)
+	case transaction.FieldSplitType:
+		return m.OldSplitType(ctx)
+	case transaction.FieldSplitShares:
+		return m.OldSplitShares(ctx)
+	case transaction.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case settings.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case transaction.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Settings field %s", name)
+	return nil, fmt.Errorf("unknown Transaction field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingsMutation) SetField(name string, value ent.Value) error {
+func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case settings.FieldKey:
+	case transaction.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case transaction.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetDescription(v)
 		return nil
-	case settings.FieldValue:
+	case transaction.FieldDetails:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetDetails(v)
 		return nil
-	case settings.FieldCreatedAt:
+	case transaction.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case transaction.FieldTaxClass:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxClass(v)
+		return nil
+	case transaction.FieldSplitType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitType(v)
+		return nil
+	case transaction.FieldSplitShares:
+		v, ok := value.([]schema.SplitShare)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSplitShares(v)
+		return nil
+	case transaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case settings.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case transaction.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Settings field %s", name)
+	return fmt.Errorf("unknown Transaction field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingsMutation) AddedFields() []string {
-	return nil
+func (m *TransactionMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, transaction.FieldAmount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transaction.FieldAmount:
+		return m.AddedAmount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingsMutation) AddField(name string, value ent.Value) error {
+func (m *TransactionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case transaction.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransactionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transaction.FieldDescription) {
+		fields = append(fields, transaction.FieldDescription)
+	}
+	if m.FieldCleared(transaction.FieldDetails) {
+		fields = append(fields, transaction.FieldDetails)
+	}
+	if m.FieldCleared(transaction.FieldTaxClass) {
+		fields = append(fields, transaction.FieldTaxClass)
+	}
+	if m.FieldCleared(transaction.FieldSplitType) {
+		fields = append(fields, transaction.FieldSplitType)
+	}
+	if m.FieldCleared(transaction.FieldSplitShares) {
+		fields = append(fields, transaction.FieldSplitShares)
 	}
-	return fmt.Errorf("unknown Settings numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *SettingsMutation) ClearedFields() []string {
-	return nil
+	if m.FieldCleared(transaction.FieldDeletedAt) {
+		fields = append(fields, transaction.FieldDeletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingsMutation) FieldCleared(name string) bool {
+func (m *TransactionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Settings nullable field %s", name)
+func (m *TransactionMutation) ClearField(name string) error {
+	switch name {
+	case transaction.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case transaction.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case transaction.FieldTaxClass:
+		m.ClearTaxClass()
+		return nil
+	case transaction.FieldSplitType:
+		m.ClearSplitType()
+		return nil
+	case transaction.FieldSplitShares:
+		m.ClearSplitShares()
+		return nil
+	case transaction.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingsMutation) ResetField(name string) error {
+func (m *TransactionMutation) ResetField(name string) error {
 	switch name {
-	case settings.FieldKey:
-		m.ResetKey()
+	case transaction.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case settings.FieldValue:
-		m.ResetValue()
+	case transaction.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case settings.FieldCreatedAt:
+	case transaction.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case transaction.FieldDate:
+		m.ResetDate()
+		return nil
+	case transaction.FieldTaxClass:
+		m.ResetTaxClass()
+		return nil
+	case transaction.FieldSplitType:
+		m.ResetSplitType()
+		return nil
+	case transaction.FieldSplitShares:
+		m.ResetSplitShares()
+		return nil
+	case transaction.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case settings.FieldUpdatedAt:
+	case transaction.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case transaction.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Settings field %s", name)
+	return fmt.Errorf("unknown Transaction field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.household != nil {
+		edges = append(edges, transaction.EdgeHousehold)
+	}
+	if m.category != nil {
+		edges = append(edges, transaction.EdgeCategory)
+	}
+	if m.payer != nil {
+		edges = append(edges, transaction.EdgePayer)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingsMutation) AddedIDs(name string) []ent.Value {
+func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transaction.EdgeHousehold:
+		if id := m.household; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgePayer:
+		if id := m.payer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingsMutation) RemovedIDs(name string) []ent.Value {
+func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedhousehold {
+		edges = append(edges, transaction.EdgeHousehold)
+	}
+	if m.clearedcategory {
+		edges = append(edges, transaction.EdgeCategory)
+	}
+	if m.clearedpayer {
+		edges = append(edges, transaction.EdgePayer)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingsMutation) EdgeCleared(name string) bool {
+func (m *TransactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transaction.EdgeHousehold:
+		return m.clearedhousehold
+	case transaction.EdgeCategory:
+		return m.clearedcategory
+	case transaction.EdgePayer:
+		return m.clearedpayer
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Settings unique edge %s", name)
+func (m *TransactionMutation) ClearEdge(name string) error {
+	switch name {
+	case transaction.EdgeHousehold:
+		m.ClearHousehold()
+		return nil
+	case transaction.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	case transaction.EdgePayer:
+		m.ClearPayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Settings edge %s", name)
+func (m *TransactionMutation) ResetEdge(name string) error {
+	switch name {
+	case transaction.EdgeHousehold:
+		m.ResetHousehold()
+		return nil
+	case transaction.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case transaction.EdgePayer:
+		m.ResetPayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction edge %s", name)
 }
 
-// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
-type TransactionMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	amount           *string
-	description      *string
-	details          *string
-	date             *time.Time
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	household        *int
-	clearedhousehold bool
-	category         *int
-	clearedcategory  bool
-	done             bool
-	oldValue         func(context.Context) (*Transaction, error)
-	predicates       []predicate.Transaction
+	op                         Op
+	typ                        string
+	id                         *int
+	email                      *string
+	name                       *string
+	subject                    *string
+	admin                      *bool
+	disabled_at                *time.Time
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	households                 map[int]struct{}
+	removedhouseholds          map[int]struct{}
+	clearedhouseholds          bool
+	api_tokens                 map[int]struct{}
+	removedapi_tokens          map[int]struct{}
+	clearedapi_tokens          bool
+	local_credential           *int
+	clearedlocal_credential    bool
+	identities                 map[int]struct{}
+	removedidentities          map[int]struct{}
+	clearedidentities          bool
+	household_transfers        map[int]struct{}
+	removedhousehold_transfers map[int]struct{}
+	clearedhousehold_transfers bool
+	done                       bool
+	oldValue                   func(context.Context) (*User, error)
+	predicates                 []predicate.User
 }
 
-var _ ent.Mutation = (*TransactionMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// transactionOption allows management of the mutation configuration using functional options.
-type transactionOption func(*TransactionMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTransactionMutation creates new mutation for the Transaction entity.
-func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
-	m := &TransactionMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTransaction,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5269,20 +14144,20 @@ func newTransactionMutation(c config, op Op, opts ...transactionOption) *Transac
 	return m
 }
 
-// withTransactionID sets the ID field of the mutation.
-func withTransactionID(id int) transactionOption {
-	return func(m *TransactionMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Transaction
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Transaction.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5291,10 +14166,10 @@ func withTransactionID(id int) transactionOption {
 	}
 }
 
-// withTransaction sets the old Transaction of the mutation.
-func withTransaction(node *Transaction) transactionOption {
-	return func(m *TransactionMutation) {
-		m.oldValue = func(context.Context) (*Transaction, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5303,7 +14178,7 @@ func withTransaction(node *Transaction) transactionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransactionMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5311,7 +14186,7 @@ func (m TransactionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TransactionMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5322,7 +14197,7 @@ func (m TransactionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TransactionMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5333,7 +14208,7 @@ func (m *TransactionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5342,189 +14217,212 @@ func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAmount sets the "amount" field.
-func (m *TransactionMutation) SetAmount(s string) {
-	m.amount = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *TransactionMutation) Amount() (r string, exists bool) {
-	v := m.amount
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldAmount(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.Email, nil
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *TransactionMutation) ResetAmount() {
-	m.amount = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TransactionMutation) SetDescription(s string) {
-	m.description = &s
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *UserMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TransactionMutation) Description() (r string, exists bool) {
-	v := m.description
+// Subject returns the value of the "subject" field in the mutation.
+func (m *UserMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *TransactionMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[transaction.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TransactionMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldDescription]
-	return ok
+	return oldValue.Subject, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TransactionMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, transaction.FieldDescription)
+// ResetSubject resets all changes to the "subject" field.
+func (m *UserMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetDetails sets the "details" field.
-func (m *TransactionMutation) SetDetails(s string) {
-	m.details = &s
+// SetAdmin sets the "admin" field.
+func (m *UserMutation) SetAdmin(b bool) {
+	m.admin = &b
 }
 
-// Details returns the value of the "details" field in the mutation.
-func (m *TransactionMutation) Details() (r string, exists bool) {
-	v := m.details
+// Admin returns the value of the "admin" field in the mutation.
+func (m *UserMutation) Admin() (r bool, exists bool) {
+	v := m.admin
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDetails returns the old "details" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldAdmin returns the old "admin" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldDetails(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldAdmin(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdmin is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDetails requires an ID field in the mutation")
+		return v, errors.New("OldAdmin requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdmin: %w", err)
 	}
-	return oldValue.Details, nil
-}
-
-// ClearDetails clears the value of the "details" field.
-func (m *TransactionMutation) ClearDetails() {
-	m.details = nil
-	m.clearedFields[transaction.FieldDetails] = struct{}{}
-}
-
-// DetailsCleared returns if the "details" field was cleared in this mutation.
-func (m *TransactionMutation) DetailsCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldDetails]
-	return ok
+	return oldValue.Admin, nil
 }
 
-// ResetDetails resets all changes to the "details" field.
-func (m *TransactionMutation) ResetDetails() {
-	m.details = nil
-	delete(m.clearedFields, transaction.FieldDetails)
+// ResetAdmin resets all changes to the "admin" field.
+func (m *UserMutation) ResetAdmin() {
+	m.admin = nil
 }
 
-// SetDate sets the "date" field.
-func (m *TransactionMutation) SetDate(t time.Time) {
-	m.date = &t
+// SetDisabledAt sets the "disabled_at" field.
+func (m *UserMutation) SetDisabledAt(t time.Time) {
+	m.disabled_at = &t
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *TransactionMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// DisabledAt returns the value of the "disabled_at" field in the mutation.
+func (m *UserMutation) DisabledAt() (r time.Time, exists bool) {
+	v := m.disabled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldDisabledAt returns the old "disabled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldDisabledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisabledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldDisabledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisabledAt: %w", err)
 	}
-	return oldValue.Date, nil
+	return oldValue.DisabledAt, nil
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *TransactionMutation) ResetDate() {
-	m.date = nil
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (m *UserMutation) ClearDisabledAt() {
+	m.disabled_at = nil
+	m.clearedFields[user.FieldDisabledAt] = struct{}{}
+}
+
+// DisabledAtCleared returns if the "disabled_at" field was cleared in this mutation.
+func (m *UserMutation) DisabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDisabledAt]
+	return ok
+}
+
+// ResetDisabledAt resets all changes to the "disabled_at" field.
+func (m *UserMutation) ResetDisabledAt() {
+	m.disabled_at = nil
+	delete(m.clearedFields, user.FieldDisabledAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TransactionMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5532,10 +14430,10 @@ func (m *TransactionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5550,17 +14448,17 @@ func (m *TransactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TransactionMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TransactionMutation) SetUpdatedAt(t time.Time) {
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5568,10 +14466,10 @@ func (m *TransactionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5586,97 +14484,274 @@ func (m *TransactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TransactionMutation) ResetUpdatedAt() {
+func (m *UserMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetHouseholdID sets the "household" edge to the Household entity by id.
-func (m *TransactionMutation) SetHouseholdID(id int) {
-	m.household = &id
+// AddHouseholdIDs adds the "households" edge to the Household entity by ids.
+func (m *UserMutation) AddHouseholdIDs(ids ...int) {
+	if m.households == nil {
+		m.households = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.households[ids[i]] = struct{}{}
+	}
 }
 
-// ClearHousehold clears the "household" edge to the Household entity.
-func (m *TransactionMutation) ClearHousehold() {
-	m.clearedhousehold = true
+// ClearHouseholds clears the "households" edge to the Household entity.
+func (m *UserMutation) ClearHouseholds() {
+	m.clearedhouseholds = true
 }
 
-// HouseholdCleared reports if the "household" edge to the Household entity was cleared.
-func (m *TransactionMutation) HouseholdCleared() bool {
-	return m.clearedhousehold
+// HouseholdsCleared reports if the "households" edge to the Household entity was cleared.
+func (m *UserMutation) HouseholdsCleared() bool {
+	return m.clearedhouseholds
+}
+
+// RemoveHouseholdIDs removes the "households" edge to the Household entity by IDs.
+func (m *UserMutation) RemoveHouseholdIDs(ids ...int) {
+	if m.removedhouseholds == nil {
+		m.removedhouseholds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.households, ids[i])
+		m.removedhouseholds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHouseholds returns the removed IDs of the "households" edge to the Household entity.
+func (m *UserMutation) RemovedHouseholdsIDs() (ids []int) {
+	for id := range m.removedhouseholds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HouseholdsIDs returns the "households" edge IDs in the mutation.
+func (m *UserMutation) HouseholdsIDs() (ids []int) {
+	for id := range m.households {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHouseholds resets all changes to the "households" edge.
+func (m *UserMutation) ResetHouseholds() {
+	m.households = nil
+	m.clearedhouseholds = false
+	m.removedhouseholds = nil
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by ids.
+func (m *UserMutation) AddAPITokenIDs(ids ...int) {
+	if m.api_tokens == nil {
+		m.api_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.api_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPITokens clears the "api_tokens" edge to the APIToken entity.
+func (m *UserMutation) ClearAPITokens() {
+	m.clearedapi_tokens = true
+}
+
+// APITokensCleared reports if the "api_tokens" edge to the APIToken entity was cleared.
+func (m *UserMutation) APITokensCleared() bool {
+	return m.clearedapi_tokens
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to the APIToken entity by IDs.
+func (m *UserMutation) RemoveAPITokenIDs(ids ...int) {
+	if m.removedapi_tokens == nil {
+		m.removedapi_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.api_tokens, ids[i])
+		m.removedapi_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the APIToken entity.
+func (m *UserMutation) RemovedAPITokensIDs() (ids []int) {
+	for id := range m.removedapi_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
+func (m *UserMutation) APITokensIDs() (ids []int) {
+	for id := range m.api_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPITokens resets all changes to the "api_tokens" edge.
+func (m *UserMutation) ResetAPITokens() {
+	m.api_tokens = nil
+	m.clearedapi_tokens = false
+	m.removedapi_tokens = nil
+}
+
+// SetLocalCredentialID sets the "local_credential" edge to the LocalCredential entity by id.
+func (m *UserMutation) SetLocalCredentialID(id int) {
+	m.local_credential = &id
+}
+
+// ClearLocalCredential clears the "local_credential" edge to the LocalCredential entity.
+func (m *UserMutation) ClearLocalCredential() {
+	m.clearedlocal_credential = true
+}
+
+// LocalCredentialCleared reports if the "local_credential" edge to the LocalCredential entity was cleared.
+func (m *UserMutation) LocalCredentialCleared() bool {
+	return m.clearedlocal_credential
+}
+
+// LocalCredentialID returns the "local_credential" edge ID in the mutation.
+func (m *UserMutation) LocalCredentialID() (id int, exists bool) {
+	if m.local_credential != nil {
+		return *m.local_credential, true
+	}
+	return
+}
+
+// LocalCredentialIDs returns the "local_credential" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocalCredentialID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) LocalCredentialIDs() (ids []int) {
+	if id := m.local_credential; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocalCredential resets all changes to the "local_credential" edge.
+func (m *UserMutation) ResetLocalCredential() {
+	m.local_credential = nil
+	m.clearedlocal_credential = false
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...int) {
+	if m.identities == nil {
+		m.identities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdentities clears the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
+}
+
+// IdentitiesCleared reports if the "identities" edge to the UserIdentity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
+}
+
+// RemoveIdentityIDs removes the "identities" edge to the UserIdentity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...int) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
 }
 
-// HouseholdID returns the "household" edge ID in the mutation.
-func (m *TransactionMutation) HouseholdID() (id int, exists bool) {
-	if m.household != nil {
-		return *m.household, true
+// RemovedIdentities returns the removed IDs of the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []int) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// HouseholdIDs returns the "household" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HouseholdID instead. It exists only for internal usage by the builders.
-func (m *TransactionMutation) HouseholdIDs() (ids []int) {
-	if id := m.household; id != nil {
-		ids = append(ids, *id)
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []int) {
+	for id := range m.identities {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetHousehold resets all changes to the "household" edge.
-func (m *TransactionMutation) ResetHousehold() {
-	m.household = nil
-	m.clearedhousehold = false
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
 }
 
-// SetCategoryID sets the "category" edge to the Category entity by id.
-func (m *TransactionMutation) SetCategoryID(id int) {
-	m.category = &id
+// AddHouseholdTransferIDs adds the "household_transfers" edge to the HouseholdTransfer entity by ids.
+func (m *UserMutation) AddHouseholdTransferIDs(ids ...int) {
+	if m.household_transfers == nil {
+		m.household_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.household_transfers[ids[i]] = struct{}{}
+	}
 }
 
-// ClearCategory clears the "category" edge to the Category entity.
-func (m *TransactionMutation) ClearCategory() {
-	m.clearedcategory = true
+// ClearHouseholdTransfers clears the "household_transfers" edge to the HouseholdTransfer entity.
+func (m *UserMutation) ClearHouseholdTransfers() {
+	m.clearedhousehold_transfers = true
 }
 
-// CategoryCleared reports if the "category" edge to the Category entity was cleared.
-func (m *TransactionMutation) CategoryCleared() bool {
-	return m.clearedcategory
+// HouseholdTransfersCleared reports if the "household_transfers" edge to the HouseholdTransfer entity was cleared.
+func (m *UserMutation) HouseholdTransfersCleared() bool {
+	return m.clearedhousehold_transfers
 }
 
-// CategoryID returns the "category" edge ID in the mutation.
-func (m *TransactionMutation) CategoryID() (id int, exists bool) {
-	if m.category != nil {
-		return *m.category, true
+// RemoveHouseholdTransferIDs removes the "household_transfers" edge to the HouseholdTransfer entity by IDs.
+func (m *UserMutation) RemoveHouseholdTransferIDs(ids ...int) {
+	if m.removedhousehold_transfers == nil {
+		m.removedhousehold_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.household_transfers, ids[i])
+		m.removedhousehold_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHouseholdTransfers returns the removed IDs of the "household_transfers" edge to the HouseholdTransfer entity.
+func (m *UserMutation) RemovedHouseholdTransfersIDs() (ids []int) {
+	for id := range m.removedhousehold_transfers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// CategoryIDs returns the "category" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *TransactionMutation) CategoryIDs() (ids []int) {
-	if id := m.category; id != nil {
-		ids = append(ids, *id)
+// HouseholdTransfersIDs returns the "household_transfers" edge IDs in the mutation.
+func (m *UserMutation) HouseholdTransfersIDs() (ids []int) {
+	for id := range m.household_transfers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCategory resets all changes to the "category" edge.
-func (m *TransactionMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
+// ResetHouseholdTransfers resets all changes to the "household_transfers" edge.
+func (m *UserMutation) ResetHouseholdTransfers() {
+	m.household_transfers = nil
+	m.clearedhousehold_transfers = false
+	m.removedhousehold_transfers = nil
 }
 
-// Where appends a list predicates to the TransactionMutation builder.
-func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Transaction, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5684,42 +14759,45 @@ func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TransactionMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TransactionMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Transaction).
-func (m *TransactionMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TransactionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.amount != nil {
-		fields = append(fields, transaction.FieldAmount)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.description != nil {
-		fields = append(fields, transaction.FieldDescription)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
 	}
-	if m.details != nil {
-		fields = append(fields, transaction.FieldDetails)
+	if m.subject != nil {
+		fields = append(fields, user.FieldSubject)
 	}
-	if m.date != nil {
-		fields = append(fields, transaction.FieldDate)
+	if m.admin != nil {
+		fields = append(fields, user.FieldAdmin)
+	}
+	if m.disabled_at != nil {
+		fields = append(fields, user.FieldDisabledAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, transaction.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, transaction.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5727,19 +14805,21 @@ func (m *TransactionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case transaction.FieldAmount:
-		return m.Amount()
-	case transaction.FieldDescription:
-		return m.Description()
-	case transaction.FieldDetails:
-		return m.Details()
-	case transaction.FieldDate:
-		return m.Date()
-	case transaction.FieldCreatedAt:
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldSubject:
+		return m.Subject()
+	case user.FieldAdmin:
+		return m.Admin()
+	case user.FieldDisabledAt:
+		return m.DisabledAt()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case transaction.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5748,65 +14828,74 @@ func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case transaction.FieldAmount:
-		return m.OldAmount(ctx)
-	case transaction.FieldDescription:
-		return m.OldDescription(ctx)
-	case transaction.FieldDetails:
-		return m.OldDetails(ctx)
-	case transaction.FieldDate:
-		return m.OldDate(ctx)
-	case transaction.FieldCreatedAt:
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldSubject:
+		return m.OldSubject(ctx)
+	case user.FieldAdmin:
+		return m.OldAdmin(ctx)
+	case user.FieldDisabledAt:
+		return m.OldDisabledAt(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case transaction.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Transaction field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransactionMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case transaction.FieldAmount:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAmount(v)
+		m.SetEmail(v)
 		return nil
-	case transaction.FieldDescription:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetName(v)
 		return nil
-	case transaction.FieldDetails:
+	case user.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDetails(v)
+		m.SetSubject(v)
 		return nil
-	case transaction.FieldDate:
+	case user.FieldAdmin:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdmin(v)
+		return nil
+	case user.FieldDisabledAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDate(v)
+		m.SetDisabledAt(v)
 		return nil
-	case transaction.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case transaction.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5814,217 +14903,297 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TransactionMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Transaction numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TransactionMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(transaction.FieldDescription) {
-		fields = append(fields, transaction.FieldDescription)
-	}
-	if m.FieldCleared(transaction.FieldDetails) {
-		fields = append(fields, transaction.FieldDetails)
+	if m.FieldCleared(user.FieldDisabledAt) {
+		fields = append(fields, user.FieldDisabledAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TransactionMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TransactionMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case transaction.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case transaction.FieldDetails:
-		m.ClearDetails()
+	case user.FieldDisabledAt:
+		m.ClearDisabledAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TransactionMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case transaction.FieldAmount:
-		m.ResetAmount()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case transaction.FieldDescription:
-		m.ResetDescription()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case transaction.FieldDetails:
-		m.ResetDetails()
+	case user.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case transaction.FieldDate:
-		m.ResetDate()
+	case user.FieldAdmin:
+		m.ResetAdmin()
 		return nil
-	case transaction.FieldCreatedAt:
+	case user.FieldDisabledAt:
+		m.ResetDisabledAt()
+		return nil
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case transaction.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransactionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.household != nil {
-		edges = append(edges, transaction.EdgeHousehold)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.households != nil {
+		edges = append(edges, user.EdgeHouseholds)
 	}
-	if m.category != nil {
-		edges = append(edges, transaction.EdgeCategory)
+	if m.api_tokens != nil {
+		edges = append(edges, user.EdgeAPITokens)
+	}
+	if m.local_credential != nil {
+		edges = append(edges, user.EdgeLocalCredential)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.household_transfers != nil {
+		edges = append(edges, user.EdgeHouseholdTransfers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case transaction.EdgeHousehold:
-		if id := m.household; id != nil {
-			return []ent.Value{*id}
+	case user.EdgeHouseholds:
+		ids := make([]ent.Value, 0, len(m.households))
+		for id := range m.households {
+			ids = append(ids, id)
 		}
-	case transaction.EdgeCategory:
-		if id := m.category; id != nil {
+		return ids
+	case user.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.api_tokens))
+		for id := range m.api_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLocalCredential:
+		if id := m.local_credential; id != nil {
 			return []ent.Value{*id}
 		}
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHouseholdTransfers:
+		ids := make([]ent.Value, 0, len(m.household_transfers))
+		for id := range m.household_transfers {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransactionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedhouseholds != nil {
+		edges = append(edges, user.EdgeHouseholds)
+	}
+	if m.removedapi_tokens != nil {
+		edges = append(edges, user.EdgeAPITokens)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedhousehold_transfers != nil {
+		edges = append(edges, user.EdgeHouseholdTransfers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeHouseholds:
+		ids := make([]ent.Value, 0, len(m.removedhouseholds))
+		for id := range m.removedhouseholds {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
+		for id := range m.removedapi_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeHouseholdTransfers:
+		ids := make([]ent.Value, 0, len(m.removedhousehold_transfers))
+		for id := range m.removedhousehold_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransactionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedhousehold {
-		edges = append(edges, transaction.EdgeHousehold)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedhouseholds {
+		edges = append(edges, user.EdgeHouseholds)
 	}
-	if m.clearedcategory {
-		edges = append(edges, transaction.EdgeCategory)
+	if m.clearedapi_tokens {
+		edges = append(edges, user.EdgeAPITokens)
+	}
+	if m.clearedlocal_credential {
+		edges = append(edges, user.EdgeLocalCredential)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedhousehold_transfers {
+		edges = append(edges, user.EdgeHouseholdTransfers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TransactionMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case transaction.EdgeHousehold:
-		return m.clearedhousehold
-	case transaction.EdgeCategory:
-		return m.clearedcategory
+	case user.EdgeHouseholds:
+		return m.clearedhouseholds
+	case user.EdgeAPITokens:
+		return m.clearedapi_tokens
+	case user.EdgeLocalCredential:
+		return m.clearedlocal_credential
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeHouseholdTransfers:
+		return m.clearedhousehold_transfers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TransactionMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case transaction.EdgeHousehold:
-		m.ClearHousehold()
-		return nil
-	case transaction.EdgeCategory:
-		m.ClearCategory()
+	case user.EdgeLocalCredential:
+		m.ClearLocalCredential()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TransactionMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case transaction.EdgeHousehold:
-		m.ResetHousehold()
+	case user.EdgeHouseholds:
+		m.ResetHouseholds()
 		return nil
-	case transaction.EdgeCategory:
-		m.ResetCategory()
+	case user.EdgeAPITokens:
+		m.ResetAPITokens()
+		return nil
+	case user.EdgeLocalCredential:
+		m.ResetLocalCredential()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeHouseholdTransfers:
+		m.ResetHouseholdTransfers()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// UserIdentityMutation represents an operation that mutates the UserIdentity nodes in the graph.
+type UserIdentityMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	email             *string
-	name              *string
-	subject           *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	households        map[int]struct{}
-	removedhouseholds map[int]struct{}
-	clearedhouseholds bool
-	api_tokens        map[int]struct{}
-	removedapi_tokens map[int]struct{}
-	clearedapi_tokens bool
-	done              bool
-	oldValue          func(context.Context) (*User, error)
-	predicates        []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	issuer        *string
+	subject       *string
+	email         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*UserIdentity, error)
+	predicates    []predicate.UserIdentity
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*UserIdentityMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// useridentityOption allows management of the mutation configuration using functional options.
+type useridentityOption func(*UserIdentityMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newUserIdentityMutation creates new mutation for the UserIdentity entity.
+func newUserIdentityMutation(c config, op Op, opts ...useridentityOption) *UserIdentityMutation {
+	m := &UserIdentityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUserIdentity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6033,20 +15202,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withUserIdentityID sets the ID field of the mutation.
+func withUserIdentityID(id int) useridentityOption {
+	return func(m *UserIdentityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UserIdentity
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UserIdentity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UserIdentity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6055,10 +15224,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUserIdentity sets the old UserIdentity of the mutation.
+func withUserIdentity(node *UserIdentity) useridentityOption {
+	return func(m *UserIdentityMutation) {
+		m.oldValue = func(context.Context) (*UserIdentity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6067,7 +15236,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UserIdentityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6075,7 +15244,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UserIdentityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6086,7 +15255,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *UserIdentityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6097,7 +15266,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserIdentityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6106,91 +15275,55 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserIdentity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-}
-
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// SetIssuer sets the "issuer" field.
+func (m *UserIdentityMutation) SetIssuer(s string) {
+	m.issuer = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *UserIdentityMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuer returns the old "issuer" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldIssuer(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Issuer, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *UserIdentityMutation) ResetIssuer() {
+	m.issuer = nil
 }
 
 // SetSubject sets the "subject" field.
-func (m *UserMutation) SetSubject(s string) {
+func (m *UserIdentityMutation) SetSubject(s string) {
 	m.subject = &s
 }
 
 // Subject returns the value of the "subject" field in the mutation.
-func (m *UserMutation) Subject() (r string, exists bool) {
+func (m *UserIdentityMutation) Subject() (r string, exists bool) {
 	v := m.subject
 	if v == nil {
 		return
@@ -6198,10 +15331,10 @@ func (m *UserMutation) Subject() (r string, exists bool) {
 	return *v, true
 }
 
-// OldSubject returns the old "subject" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSubject(ctx context.Context) (v string, err error) {
+func (m *UserIdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
@@ -6216,199 +15349,130 @@ func (m *UserMutation) OldSubject(ctx context.Context) (v string, err error) {
 }
 
 // ResetSubject resets all changes to the "subject" field.
-func (m *UserMutation) ResetSubject() {
+func (m *UserIdentityMutation) ResetSubject() {
 	m.subject = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetEmail sets the "email" field.
+func (m *UserIdentityMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Email returns the value of the "email" field in the mutation.
+func (m *UserIdentityMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserIdentityMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddHouseholdIDs adds the "households" edge to the Household entity by ids.
-func (m *UserMutation) AddHouseholdIDs(ids ...int) {
-	if m.households == nil {
-		m.households = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.households[ids[i]] = struct{}{}
-	}
-}
-
-// ClearHouseholds clears the "households" edge to the Household entity.
-func (m *UserMutation) ClearHouseholds() {
-	m.clearedhouseholds = true
-}
-
-// HouseholdsCleared reports if the "households" edge to the Household entity was cleared.
-func (m *UserMutation) HouseholdsCleared() bool {
-	return m.clearedhouseholds
-}
-
-// RemoveHouseholdIDs removes the "households" edge to the Household entity by IDs.
-func (m *UserMutation) RemoveHouseholdIDs(ids ...int) {
-	if m.removedhouseholds == nil {
-		m.removedhouseholds = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.households, ids[i])
-		m.removedhouseholds[ids[i]] = struct{}{}
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// RemovedHouseholds returns the removed IDs of the "households" edge to the Household entity.
-func (m *UserMutation) RemovedHouseholdsIDs() (ids []int) {
-	for id := range m.removedhouseholds {
-		ids = append(ids, id)
-	}
-	return
+// ResetEmail resets all changes to the "email" field.
+func (m *UserIdentityMutation) ResetEmail() {
+	m.email = nil
 }
 
-// HouseholdsIDs returns the "households" edge IDs in the mutation.
-func (m *UserMutation) HouseholdsIDs() (ids []int) {
-	for id := range m.households {
-		ids = append(ids, id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *UserIdentityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetHouseholds resets all changes to the "households" edge.
-func (m *UserMutation) ResetHouseholds() {
-	m.households = nil
-	m.clearedhouseholds = false
-	m.removedhouseholds = nil
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserIdentityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddAPITokenIDs adds the "api_tokens" edge to the APIToken entity by ids.
-func (m *UserMutation) AddAPITokenIDs(ids ...int) {
-	if m.api_tokens == nil {
-		m.api_tokens = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the UserIdentity entity.
+// If the UserIdentity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserIdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.api_tokens[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearAPITokens clears the "api_tokens" edge to the APIToken entity.
-func (m *UserMutation) ClearAPITokens() {
-	m.clearedapi_tokens = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserIdentityMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// APITokensCleared reports if the "api_tokens" edge to the APIToken entity was cleared.
-func (m *UserMutation) APITokensCleared() bool {
-	return m.clearedapi_tokens
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *UserIdentityMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// RemoveAPITokenIDs removes the "api_tokens" edge to the APIToken entity by IDs.
-func (m *UserMutation) RemoveAPITokenIDs(ids ...int) {
-	if m.removedapi_tokens == nil {
-		m.removedapi_tokens = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.api_tokens, ids[i])
-		m.removedapi_tokens[ids[i]] = struct{}{}
-	}
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserIdentityMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the APIToken entity.
-func (m *UserMutation) RemovedAPITokensIDs() (ids []int) {
-	for id := range m.removedapi_tokens {
-		ids = append(ids, id)
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserIdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *UserIdentityMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
-func (m *UserMutation) APITokensIDs() (ids []int) {
-	for id := range m.api_tokens {
-		ids = append(ids, id)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserIdentityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAPITokens resets all changes to the "api_tokens" edge.
-func (m *UserMutation) ResetAPITokens() {
-	m.api_tokens = nil
-	m.clearedapi_tokens = false
-	m.removedapi_tokens = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *UserIdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserIdentityMutation builder.
+func (m *UserIdentityMutation) Where(ps ...predicate.UserIdentity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserIdentityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserIdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserIdentity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6416,39 +15480,36 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserIdentityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserIdentityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserIdentity).
+func (m *UserIdentityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+func (m *UserIdentityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.issuer != nil {
+		fields = append(fields, useridentity.FieldIssuer)
 	}
 	if m.subject != nil {
-		fields = append(fields, user.FieldSubject)
+		fields = append(fields, useridentity.FieldSubject)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.email != nil {
+		fields = append(fields, useridentity.FieldEmail)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, useridentity.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6456,18 +15517,16 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserIdentityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldName:
-		return m.Name()
-	case user.FieldSubject:
+	case useridentity.FieldIssuer:
+		return m.Issuer()
+	case useridentity.FieldSubject:
 		return m.Subject()
-	case user.FieldCreatedAt:
+	case useridentity.FieldEmail:
+		return m.Email()
+	case useridentity.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6475,236 +15534,188 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserIdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldSubject:
+	case useridentity.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case useridentity.FieldSubject:
 		return m.OldSubject(ctx)
-	case user.FieldCreatedAt:
+	case useridentity.FieldEmail:
+		return m.OldEmail(ctx)
+	case useridentity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserIdentityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
+	case useridentity.FieldIssuer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetIssuer(v)
 		return nil
-	case user.FieldName:
+	case useridentity.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSubject(v)
 		return nil
-	case user.FieldSubject:
+	case useridentity.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubject(v)
+		m.SetEmail(v)
 		return nil
-	case user.FieldCreatedAt:
+	case useridentity.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UserIdentityMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserIdentityMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserIdentityMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserIdentity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *UserIdentityMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserIdentityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserIdentityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserIdentity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserIdentityMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldName:
-		m.ResetName()
+	case useridentity.FieldIssuer:
+		m.ResetIssuer()
 		return nil
-	case user.FieldSubject:
+	case useridentity.FieldSubject:
 		m.ResetSubject()
 		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case useridentity.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case useridentity.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserIdentity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.households != nil {
-		edges = append(edges, user.EdgeHouseholds)
-	}
-	if m.api_tokens != nil {
-		edges = append(edges, user.EdgeAPITokens)
+func (m *UserIdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, useridentity.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserIdentityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeHouseholds:
-		ids := make([]ent.Value, 0, len(m.households))
-		for id := range m.households {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAPITokens:
-		ids := make([]ent.Value, 0, len(m.api_tokens))
-		for id := range m.api_tokens {
-			ids = append(ids, id)
+	case useridentity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedhouseholds != nil {
-		edges = append(edges, user.EdgeHouseholds)
-	}
-	if m.removedapi_tokens != nil {
-		edges = append(edges, user.EdgeAPITokens)
-	}
+func (m *UserIdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeHouseholds:
-		ids := make([]ent.Value, 0, len(m.removedhouseholds))
-		for id := range m.removedhouseholds {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAPITokens:
-		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
-		for id := range m.removedapi_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserIdentityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedhouseholds {
-		edges = append(edges, user.EdgeHouseholds)
-	}
-	if m.clearedapi_tokens {
-		edges = append(edges, user.EdgeAPITokens)
+func (m *UserIdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, useridentity.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserIdentityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeHouseholds:
-		return m.clearedhouseholds
-	case user.EdgeAPITokens:
-		return m.clearedapi_tokens
+	case useridentity.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserIdentityMutation) ClearEdge(name string) error {
 	switch name {
+	case useridentity.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserIdentity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserIdentityMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeHouseholds:
-		m.ResetHouseholds()
-		return nil
-	case user.EdgeAPITokens:
-		m.ResetAPITokens()
+	case useridentity.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserIdentity edge %s", name)
 }
@@ -9,26 +9,56 @@ import (
 // APIToken is the predicate function for apitoken builders.
 type APIToken func(*sql.Selector)
 
+// AggregateGeneration is the predicate function for aggregategeneration builders.
+type AggregateGeneration func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
 // Household is the predicate function for household builders.
 type Household func(*sql.Selector)
 
+// HouseholdMember is the predicate function for householdmember builders.
+type HouseholdMember func(*sql.Selector)
+
+// HouseholdTransfer is the predicate function for householdtransfer builders.
+type HouseholdTransfer func(*sql.Selector)
+
+// LocalCredential is the predicate function for localcredential builders.
+type LocalCredential func(*sql.Selector)
+
+// MonthlyAggregate is the predicate function for monthlyaggregate builders.
+type MonthlyAggregate func(*sql.Selector)
+
+// RateLimit is the predicate function for ratelimit builders.
+type RateLimit func(*sql.Selector)
+
 // RecurringExpense is the predicate function for recurringexpense builders.
 type RecurringExpense func(*sql.Selector)
 
 // RecurringScheduleOverride is the predicate function for recurringscheduleoverride builders.
 type RecurringScheduleOverride func(*sql.Selector)
 
+// Revision is the predicate function for revision builders.
+type Revision func(*sql.Selector)
+
+// SecurityEvent is the predicate function for securityevent builders.
+type SecurityEvent func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
 
+// Settlement is the predicate function for settlement builders.
+type Settlement func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/ratelimit"
+)
+
+// RateLimitCreate is the builder for creating a RateLimit entity.
+type RateLimitCreate struct {
+	config
+	mutation *RateLimitMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *RateLimitCreate) SetKey(v string) *RateLimitCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *RateLimitCreate) SetCount(v int) *RateLimitCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_c *RateLimitCreate) SetNillableCount(v *int) *RateLimitCreate {
+	if v != nil {
+		_c.SetCount(*v)
+	}
+	return _c
+}
+
+// SetResetAt sets the "reset_at" field.
+func (_c *RateLimitCreate) SetResetAt(v time.Time) *RateLimitCreate {
+	_c.mutation.SetResetAt(v)
+	return _c
+}
+
+// Mutation returns the RateLimitMutation object of the builder.
+func (_c *RateLimitCreate) Mutation() *RateLimitMutation {
+	return _c.mutation
+}
+
+// Save creates the RateLimit in the database.
+func (_c *RateLimitCreate) Save(ctx context.Context) (*RateLimit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RateLimitCreate) SaveX(ctx context.Context) *RateLimit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RateLimitCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RateLimitCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RateLimitCreate) defaults() {
+	if _, ok := _c.mutation.Count(); !ok {
+		v := ratelimit.DefaultCount
+		_c.mutation.SetCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RateLimitCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "RateLimit.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := ratelimit.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "RateLimit.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "RateLimit.count"`)}
+	}
+	if _, ok := _c.mutation.ResetAt(); !ok {
+		return &ValidationError{Name: "reset_at", err: errors.New(`ent: missing required field "RateLimit.reset_at"`)}
+	}
+	return nil
+}
+
+func (_c *RateLimitCreate) sqlSave(ctx context.Context) (*RateLimit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RateLimitCreate) createSpec() (*RateLimit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateLimit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ratelimit.Table, sqlgraph.NewFieldSpec(ratelimit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(ratelimit.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(ratelimit.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.ResetAt(); ok {
+		_spec.SetField(ratelimit.FieldResetAt, field.TypeTime, value)
+		_node.ResetAt = value
+	}
+	return _node, _spec
+}
+
+// RateLimitCreateBulk is the builder for creating many RateLimit entities in bulk.
+type RateLimitCreateBulk struct {
+	config
+	err      error
+	builders []*RateLimitCreate
+}
+
+// Save creates the RateLimit entities in the database.
+func (_c *RateLimitCreateBulk) Save(ctx context.Context) ([]*RateLimit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RateLimit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateLimitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RateLimitCreateBulk) SaveX(ctx context.Context) []*RateLimit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RateLimitCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RateLimitCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/ratelimit"
+)
+
+// RateLimitUpdate is the builder for updating RateLimit entities.
+type RateLimitUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RateLimitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RateLimitUpdate builder.
+func (_u *RateLimitUpdate) Where(ps ...predicate.RateLimit) *RateLimitUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *RateLimitUpdate) SetKey(v string) *RateLimitUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *RateLimitUpdate) SetNillableKey(v *string) *RateLimitUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *RateLimitUpdate) SetCount(v int) *RateLimitUpdate {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *RateLimitUpdate) SetNillableCount(v *int) *RateLimitUpdate {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *RateLimitUpdate) AddCount(v int) *RateLimitUpdate {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetResetAt sets the "reset_at" field.
+func (_u *RateLimitUpdate) SetResetAt(v time.Time) *RateLimitUpdate {
+	_u.mutation.SetResetAt(v)
+	return _u
+}
+
+// SetNillableResetAt sets the "reset_at" field if the given value is not nil.
+func (_u *RateLimitUpdate) SetNillableResetAt(v *time.Time) *RateLimitUpdate {
+	if v != nil {
+		_u.SetResetAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RateLimitMutation object of the builder.
+func (_u *RateLimitUpdate) Mutation() *RateLimitMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RateLimitUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RateLimitUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RateLimitUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RateLimitUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RateLimitUpdate) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := ratelimit.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "RateLimit.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RateLimitUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RateLimitUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RateLimitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratelimit.Table, ratelimit.Columns, sqlgraph.NewFieldSpec(ratelimit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(ratelimit.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(ratelimit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(ratelimit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResetAt(); ok {
+		_spec.SetField(ratelimit.FieldResetAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RateLimitUpdateOne is the builder for updating a single RateLimit entity.
+type RateLimitUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RateLimitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKey sets the "key" field.
+func (_u *RateLimitUpdateOne) SetKey(v string) *RateLimitUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *RateLimitUpdateOne) SetNillableKey(v *string) *RateLimitUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *RateLimitUpdateOne) SetCount(v int) *RateLimitUpdateOne {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *RateLimitUpdateOne) SetNillableCount(v *int) *RateLimitUpdateOne {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *RateLimitUpdateOne) AddCount(v int) *RateLimitUpdateOne {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetResetAt sets the "reset_at" field.
+func (_u *RateLimitUpdateOne) SetResetAt(v time.Time) *RateLimitUpdateOne {
+	_u.mutation.SetResetAt(v)
+	return _u
+}
+
+// SetNillableResetAt sets the "reset_at" field if the given value is not nil.
+func (_u *RateLimitUpdateOne) SetNillableResetAt(v *time.Time) *RateLimitUpdateOne {
+	if v != nil {
+		_u.SetResetAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RateLimitMutation object of the builder.
+func (_u *RateLimitUpdateOne) Mutation() *RateLimitMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RateLimitUpdate builder.
+func (_u *RateLimitUpdateOne) Where(ps ...predicate.RateLimit) *RateLimitUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RateLimitUpdateOne) Select(field string, fields ...string) *RateLimitUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RateLimit entity.
+func (_u *RateLimitUpdateOne) Save(ctx context.Context) (*RateLimit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RateLimitUpdateOne) SaveX(ctx context.Context) *RateLimit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RateLimitUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RateLimitUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RateLimitUpdateOne) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := ratelimit.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "RateLimit.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RateLimitUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RateLimitUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RateLimitUpdateOne) sqlSave(ctx context.Context) (_node *RateLimit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratelimit.Table, ratelimit.Columns, sqlgraph.NewFieldSpec(ratelimit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateLimit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimit.FieldID)
+		for _, f := range fields {
+			if !ratelimit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratelimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(ratelimit.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(ratelimit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(ratelimit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResetAt(); ok {
+		_spec.SetField(ratelimit.FieldResetAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RateLimit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
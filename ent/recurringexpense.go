@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/schema"
 )
 
 // RecurringExpense is the model entity for the RecurringExpense schema.
@@ -25,26 +28,35 @@ type RecurringExpense struct {
 	Description string `json:"description,omitempty"`
 	// Details holds the value of the "details" field.
 	Details string `json:"details,omitempty"`
-	// Amount holds the value of the "amount" field.
-	Amount string `json:"amount,omitempty"`
+	// Minor units (cents)
+	Amount int64 `json:"amount,omitempty"`
 	// Frequency holds the value of the "frequency" field.
 	Frequency string `json:"frequency,omitempty"`
 	// Active holds the value of the "active" field.
 	Active bool `json:"active,omitempty"`
+	// Set while the expense is inactive
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
 	// StartDate holds the value of the "start_date" field.
 	StartDate time.Time `json:"start_date,omitempty"`
 	// EndDate holds the value of the "end_date" field.
 	EndDate *time.Time `json:"end_date,omitempty"`
+	// Empty if not split between members
+	SplitType string `json:"split_type,omitempty"`
+	// SplitShares holds the value of the "split_shares" field.
+	SplitShares []schema.SplitShare `json:"split_shares,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set while the record is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RecurringExpenseQuery when eager-loading is set.
-	Edges                        RecurringExpenseEdges `json:"edges"`
-	category_recurring_expenses  *int
-	household_recurring_expenses *int
-	selectValues                 sql.SelectValues
+	Edges                                    RecurringExpenseEdges `json:"edges"`
+	category_recurring_expenses              *int
+	household_recurring_expenses             *int
+	household_member_paid_recurring_expenses *int
+	selectValues                             sql.SelectValues
 }
 
 // RecurringExpenseEdges holds the relations/edges for other nodes in the graph.
@@ -53,11 +65,13 @@ type RecurringExpenseEdges struct {
 	Household *Household `json:"household,omitempty"`
 	// Category holds the value of the category edge.
 	Category *Category `json:"category,omitempty"`
+	// Payer holds the value of the payer edge.
+	Payer *HouseholdMember `json:"payer,omitempty"`
 	// ScheduleOverrides holds the value of the schedule_overrides edge.
 	ScheduleOverrides []*RecurringScheduleOverride `json:"schedule_overrides,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // HouseholdOrErr returns the Household value or an error if the edge
@@ -82,10 +96,21 @@ func (e RecurringExpenseEdges) CategoryOrErr() (*Category, error) {
 	return nil, &NotLoadedError{edge: "category"}
 }
 
+// PayerOrErr returns the Payer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurringExpenseEdges) PayerOrErr() (*HouseholdMember, error) {
+	if e.Payer != nil {
+		return e.Payer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: householdmember.Label}
+	}
+	return nil, &NotLoadedError{edge: "payer"}
+}
+
 // ScheduleOverridesOrErr returns the ScheduleOverrides value or an error if the edge
 // was not loaded in eager-loading.
 func (e RecurringExpenseEdges) ScheduleOverridesOrErr() ([]*RecurringScheduleOverride, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.ScheduleOverrides, nil
 	}
 	return nil, &NotLoadedError{edge: "schedule_overrides"}
@@ -96,18 +121,22 @@ func (*RecurringExpense) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case recurringexpense.FieldSplitShares:
+			values[i] = new([]byte)
 		case recurringexpense.FieldActive:
 			values[i] = new(sql.NullBool)
-		case recurringexpense.FieldID:
+		case recurringexpense.FieldID, recurringexpense.FieldAmount:
 			values[i] = new(sql.NullInt64)
-		case recurringexpense.FieldName, recurringexpense.FieldDescription, recurringexpense.FieldDetails, recurringexpense.FieldAmount, recurringexpense.FieldFrequency:
+		case recurringexpense.FieldName, recurringexpense.FieldDescription, recurringexpense.FieldDetails, recurringexpense.FieldFrequency, recurringexpense.FieldSplitType:
 			values[i] = new(sql.NullString)
-		case recurringexpense.FieldStartDate, recurringexpense.FieldEndDate, recurringexpense.FieldCreatedAt, recurringexpense.FieldUpdatedAt:
+		case recurringexpense.FieldDeactivatedAt, recurringexpense.FieldStartDate, recurringexpense.FieldEndDate, recurringexpense.FieldCreatedAt, recurringexpense.FieldUpdatedAt, recurringexpense.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case recurringexpense.ForeignKeys[0]: // category_recurring_expenses
 			values[i] = new(sql.NullInt64)
 		case recurringexpense.ForeignKeys[1]: // household_recurring_expenses
 			values[i] = new(sql.NullInt64)
+		case recurringexpense.ForeignKeys[2]: // household_member_paid_recurring_expenses
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -148,10 +177,10 @@ func (_m *RecurringExpense) assignValues(columns []string, values []any) error {
 				_m.Details = value.String
 			}
 		case recurringexpense.FieldAmount:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
 			} else if value.Valid {
-				_m.Amount = value.String
+				_m.Amount = value.Int64
 			}
 		case recurringexpense.FieldFrequency:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -165,6 +194,13 @@ func (_m *RecurringExpense) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Active = value.Bool
 			}
+		case recurringexpense.FieldDeactivatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deactivated_at", values[i])
+			} else if value.Valid {
+				_m.DeactivatedAt = new(time.Time)
+				*_m.DeactivatedAt = value.Time
+			}
 		case recurringexpense.FieldStartDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field start_date", values[i])
@@ -178,6 +214,20 @@ func (_m *RecurringExpense) assignValues(columns []string, values []any) error {
 				_m.EndDate = new(time.Time)
 				*_m.EndDate = value.Time
 			}
+		case recurringexpense.FieldSplitType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field split_type", values[i])
+			} else if value.Valid {
+				_m.SplitType = value.String
+			}
+		case recurringexpense.FieldSplitShares:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field split_shares", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SplitShares); err != nil {
+					return fmt.Errorf("unmarshal field split_shares: %w", err)
+				}
+			}
 		case recurringexpense.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -190,6 +240,13 @@ func (_m *RecurringExpense) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case recurringexpense.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case recurringexpense.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field category_recurring_expenses", value)
@@ -204,6 +261,13 @@ func (_m *RecurringExpense) assignValues(columns []string, values []any) error {
 				_m.household_recurring_expenses = new(int)
 				*_m.household_recurring_expenses = int(value.Int64)
 			}
+		case recurringexpense.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_member_paid_recurring_expenses", value)
+			} else if value.Valid {
+				_m.household_member_paid_recurring_expenses = new(int)
+				*_m.household_member_paid_recurring_expenses = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -227,6 +291,11 @@ func (_m *RecurringExpense) QueryCategory() *CategoryQuery {
 	return NewRecurringExpenseClient(_m.config).QueryCategory(_m)
 }
 
+// QueryPayer queries the "payer" edge of the RecurringExpense entity.
+func (_m *RecurringExpense) QueryPayer() *HouseholdMemberQuery {
+	return NewRecurringExpenseClient(_m.config).QueryPayer(_m)
+}
+
 // QueryScheduleOverrides queries the "schedule_overrides" edge of the RecurringExpense entity.
 func (_m *RecurringExpense) QueryScheduleOverrides() *RecurringScheduleOverrideQuery {
 	return NewRecurringExpenseClient(_m.config).QueryScheduleOverrides(_m)
@@ -265,7 +334,7 @@ func (_m *RecurringExpense) String() string {
 	builder.WriteString(_m.Details)
 	builder.WriteString(", ")
 	builder.WriteString("amount=")
-	builder.WriteString(_m.Amount)
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
 	builder.WriteString("frequency=")
 	builder.WriteString(_m.Frequency)
@@ -273,6 +342,11 @@ func (_m *RecurringExpense) String() string {
 	builder.WriteString("active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Active))
 	builder.WriteString(", ")
+	if v := _m.DeactivatedAt; v != nil {
+		builder.WriteString("deactivated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("start_date=")
 	builder.WriteString(_m.StartDate.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -281,11 +355,22 @@ func (_m *RecurringExpense) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("split_type=")
+	builder.WriteString(_m.SplitType)
+	builder.WriteString(", ")
+	builder.WriteString("split_shares=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SplitShares))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
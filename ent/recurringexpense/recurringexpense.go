@@ -26,18 +26,28 @@ const (
 	FieldFrequency = "frequency"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
+	// FieldDeactivatedAt holds the string denoting the deactivated_at field in the database.
+	FieldDeactivatedAt = "deactivated_at"
 	// FieldStartDate holds the string denoting the start_date field in the database.
 	FieldStartDate = "start_date"
 	// FieldEndDate holds the string denoting the end_date field in the database.
 	FieldEndDate = "end_date"
+	// FieldSplitType holds the string denoting the split_type field in the database.
+	FieldSplitType = "split_type"
+	// FieldSplitShares holds the string denoting the split_shares field in the database.
+	FieldSplitShares = "split_shares"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeHousehold holds the string denoting the household edge name in mutations.
 	EdgeHousehold = "household"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
+	// EdgePayer holds the string denoting the payer edge name in mutations.
+	EdgePayer = "payer"
 	// EdgeScheduleOverrides holds the string denoting the schedule_overrides edge name in mutations.
 	EdgeScheduleOverrides = "schedule_overrides"
 	// Table holds the table name of the recurringexpense in the database.
@@ -56,6 +66,13 @@ const (
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
 	CategoryColumn = "category_recurring_expenses"
+	// PayerTable is the table that holds the payer relation/edge.
+	PayerTable = "recurring_expenses"
+	// PayerInverseTable is the table name for the HouseholdMember entity.
+	// It exists in this package in order to avoid circular dependency with the "householdmember" package.
+	PayerInverseTable = "household_members"
+	// PayerColumn is the table column denoting the payer relation/edge.
+	PayerColumn = "household_member_paid_recurring_expenses"
 	// ScheduleOverridesTable is the table that holds the schedule_overrides relation/edge.
 	ScheduleOverridesTable = "recurring_schedule_overrides"
 	// ScheduleOverridesInverseTable is the table name for the RecurringScheduleOverride entity.
@@ -74,10 +91,14 @@ var Columns = []string{
 	FieldAmount,
 	FieldFrequency,
 	FieldActive,
+	FieldDeactivatedAt,
 	FieldStartDate,
 	FieldEndDate,
+	FieldSplitType,
+	FieldSplitShares,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "recurring_expenses"
@@ -85,6 +106,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"category_recurring_expenses",
 	"household_recurring_expenses",
+	"household_member_paid_recurring_expenses",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -113,12 +135,12 @@ var (
 	DefaultDetails string
 	// DetailsValidator is a validator for the "details" field. It is called by the builders before save.
 	DetailsValidator func(string) error
-	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	AmountValidator func(string) error
 	// FrequencyValidator is a validator for the "frequency" field. It is called by the builders before save.
 	FrequencyValidator func(string) error
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
+	// SplitTypeValidator is a validator for the "split_type" field. It is called by the builders before save.
+	SplitTypeValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -165,6 +187,11 @@ func ByActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActive, opts...).ToFunc()
 }
 
+// ByDeactivatedAt orders the results by the deactivated_at field.
+func ByDeactivatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeactivatedAt, opts...).ToFunc()
+}
+
 // ByStartDate orders the results by the start_date field.
 func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
@@ -175,6 +202,11 @@ func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
 }
 
+// BySplitType orders the results by the split_type field.
+func BySplitType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitType, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -185,6 +217,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByHouseholdField orders the results by household field.
 func ByHouseholdField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -199,6 +236,13 @@ func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByPayerField orders the results by payer field.
+func ByPayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByScheduleOverridesCount orders the results by schedule_overrides count.
 func ByScheduleOverridesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -226,6 +270,13 @@ func newCategoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
 	)
 }
+func newPayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PayerTable, PayerColumn),
+	)
+}
 func newScheduleOverridesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
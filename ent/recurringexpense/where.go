@@ -71,7 +71,7 @@ func Details(v string) predicate.RecurringExpense {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v string) predicate.RecurringExpense {
+func Amount(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldAmount, v))
 }
 
@@ -85,6 +85,11 @@ func Active(v bool) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldActive, v))
 }
 
+// DeactivatedAt applies equality check predicate on the "deactivated_at" field. It's identical to DeactivatedAtEQ.
+func DeactivatedAt(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEQ(FieldDeactivatedAt, v))
+}
+
 // StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
 func StartDate(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldStartDate, v))
@@ -95,6 +100,11 @@ func EndDate(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldEndDate, v))
 }
 
+// SplitType applies equality check predicate on the "split_type" field. It's identical to SplitTypeEQ.
+func SplitType(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEQ(FieldSplitType, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldCreatedAt, v))
@@ -105,6 +115,11 @@ func UpdatedAt(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldName, v))
@@ -321,70 +336,45 @@ func DetailsContainsFold(v string) predicate.RecurringExpense {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v string) predicate.RecurringExpense {
+func AmountEQ(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldAmount, v))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v string) predicate.RecurringExpense {
+func AmountNEQ(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldNEQ(FieldAmount, v))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...string) predicate.RecurringExpense {
+func AmountIn(vs ...int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldIn(FieldAmount, vs...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...string) predicate.RecurringExpense {
+func AmountNotIn(vs ...int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldNotIn(FieldAmount, vs...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v string) predicate.RecurringExpense {
+func AmountGT(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldGT(FieldAmount, v))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v string) predicate.RecurringExpense {
+func AmountGTE(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldGTE(FieldAmount, v))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v string) predicate.RecurringExpense {
+func AmountLT(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldLT(FieldAmount, v))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v string) predicate.RecurringExpense {
+func AmountLTE(v int64) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldLTE(FieldAmount, v))
 }
 
-// AmountContains applies the Contains predicate on the "amount" field.
-func AmountContains(v string) predicate.RecurringExpense {
-	return predicate.RecurringExpense(sql.FieldContains(FieldAmount, v))
-}
-
-// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
-func AmountHasPrefix(v string) predicate.RecurringExpense {
-	return predicate.RecurringExpense(sql.FieldHasPrefix(FieldAmount, v))
-}
-
-// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
-func AmountHasSuffix(v string) predicate.RecurringExpense {
-	return predicate.RecurringExpense(sql.FieldHasSuffix(FieldAmount, v))
-}
-
-// AmountEqualFold applies the EqualFold predicate on the "amount" field.
-func AmountEqualFold(v string) predicate.RecurringExpense {
-	return predicate.RecurringExpense(sql.FieldEqualFold(FieldAmount, v))
-}
-
-// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
-func AmountContainsFold(v string) predicate.RecurringExpense {
-	return predicate.RecurringExpense(sql.FieldContainsFold(FieldAmount, v))
-}
-
 // FrequencyEQ applies the EQ predicate on the "frequency" field.
 func FrequencyEQ(v string) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldFrequency, v))
@@ -460,6 +450,56 @@ func ActiveNEQ(v bool) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldNEQ(FieldActive, v))
 }
 
+// DeactivatedAtEQ applies the EQ predicate on the "deactivated_at" field.
+func DeactivatedAtEQ(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEQ(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtNEQ applies the NEQ predicate on the "deactivated_at" field.
+func DeactivatedAtNEQ(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNEQ(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtIn applies the In predicate on the "deactivated_at" field.
+func DeactivatedAtIn(vs ...time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIn(FieldDeactivatedAt, vs...))
+}
+
+// DeactivatedAtNotIn applies the NotIn predicate on the "deactivated_at" field.
+func DeactivatedAtNotIn(vs ...time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotIn(FieldDeactivatedAt, vs...))
+}
+
+// DeactivatedAtGT applies the GT predicate on the "deactivated_at" field.
+func DeactivatedAtGT(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldGT(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtGTE applies the GTE predicate on the "deactivated_at" field.
+func DeactivatedAtGTE(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldGTE(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtLT applies the LT predicate on the "deactivated_at" field.
+func DeactivatedAtLT(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldLT(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtLTE applies the LTE predicate on the "deactivated_at" field.
+func DeactivatedAtLTE(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldLTE(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtIsNil applies the IsNil predicate on the "deactivated_at" field.
+func DeactivatedAtIsNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIsNull(FieldDeactivatedAt))
+}
+
+// DeactivatedAtNotNil applies the NotNil predicate on the "deactivated_at" field.
+func DeactivatedAtNotNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotNull(FieldDeactivatedAt))
+}
+
 // StartDateEQ applies the EQ predicate on the "start_date" field.
 func StartDateEQ(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldStartDate, v))
@@ -550,6 +590,91 @@ func EndDateNotNil() predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldNotNull(FieldEndDate))
 }
 
+// SplitTypeEQ applies the EQ predicate on the "split_type" field.
+func SplitTypeEQ(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEQ(FieldSplitType, v))
+}
+
+// SplitTypeNEQ applies the NEQ predicate on the "split_type" field.
+func SplitTypeNEQ(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNEQ(FieldSplitType, v))
+}
+
+// SplitTypeIn applies the In predicate on the "split_type" field.
+func SplitTypeIn(vs ...string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIn(FieldSplitType, vs...))
+}
+
+// SplitTypeNotIn applies the NotIn predicate on the "split_type" field.
+func SplitTypeNotIn(vs ...string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotIn(FieldSplitType, vs...))
+}
+
+// SplitTypeGT applies the GT predicate on the "split_type" field.
+func SplitTypeGT(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldGT(FieldSplitType, v))
+}
+
+// SplitTypeGTE applies the GTE predicate on the "split_type" field.
+func SplitTypeGTE(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldGTE(FieldSplitType, v))
+}
+
+// SplitTypeLT applies the LT predicate on the "split_type" field.
+func SplitTypeLT(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldLT(FieldSplitType, v))
+}
+
+// SplitTypeLTE applies the LTE predicate on the "split_type" field.
+func SplitTypeLTE(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldLTE(FieldSplitType, v))
+}
+
+// SplitTypeContains applies the Contains predicate on the "split_type" field.
+func SplitTypeContains(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldContains(FieldSplitType, v))
+}
+
+// SplitTypeHasPrefix applies the HasPrefix predicate on the "split_type" field.
+func SplitTypeHasPrefix(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldHasPrefix(FieldSplitType, v))
+}
+
+// SplitTypeHasSuffix applies the HasSuffix predicate on the "split_type" field.
+func SplitTypeHasSuffix(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldHasSuffix(FieldSplitType, v))
+}
+
+// SplitTypeIsNil applies the IsNil predicate on the "split_type" field.
+func SplitTypeIsNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIsNull(FieldSplitType))
+}
+
+// SplitTypeNotNil applies the NotNil predicate on the "split_type" field.
+func SplitTypeNotNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotNull(FieldSplitType))
+}
+
+// SplitTypeEqualFold applies the EqualFold predicate on the "split_type" field.
+func SplitTypeEqualFold(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEqualFold(FieldSplitType, v))
+}
+
+// SplitTypeContainsFold applies the ContainsFold predicate on the "split_type" field.
+func SplitTypeContainsFold(v string) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldContainsFold(FieldSplitType, v))
+}
+
+// SplitSharesIsNil applies the IsNil predicate on the "split_shares" field.
+func SplitSharesIsNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIsNull(FieldSplitShares))
+}
+
+// SplitSharesNotNil applies the NotNil predicate on the "split_shares" field.
+func SplitSharesNotNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotNull(FieldSplitShares))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldEQ(FieldCreatedAt, v))
@@ -630,6 +755,56 @@ func UpdatedAtLTE(v time.Time) predicate.RecurringExpense {
 	return predicate.RecurringExpense(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.RecurringExpense {
+	return predicate.RecurringExpense(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasHousehold applies the HasEdge predicate on the "household" edge.
 func HasHousehold() predicate.RecurringExpense {
 	return predicate.RecurringExpense(func(s *sql.Selector) {
@@ -676,6 +851,29 @@ func HasCategoryWith(preds ...predicate.Category) predicate.RecurringExpense {
 	})
 }
 
+// HasPayer applies the HasEdge predicate on the "payer" edge.
+func HasPayer() predicate.RecurringExpense {
+	return predicate.RecurringExpense(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PayerTable, PayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPayerWith applies the HasEdge predicate on the "payer" edge with a given conditions (other predicates).
+func HasPayerWith(preds ...predicate.HouseholdMember) predicate.RecurringExpense {
+	return predicate.RecurringExpense(func(s *sql.Selector) {
+		step := newPayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasScheduleOverrides applies the HasEdge predicate on the "schedule_overrides" edge.
 func HasScheduleOverrides() predicate.RecurringExpense {
 	return predicate.RecurringExpense(func(s *sql.Selector) {
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/schema"
 )
 
 // RecurringExpenseCreate is the builder for creating a RecurringExpense entity.
@@ -58,7 +60,7 @@ func (_c *RecurringExpenseCreate) SetNillableDetails(v *string) *RecurringExpens
 }
 
 // SetAmount sets the "amount" field.
-func (_c *RecurringExpenseCreate) SetAmount(v string) *RecurringExpenseCreate {
+func (_c *RecurringExpenseCreate) SetAmount(v int64) *RecurringExpenseCreate {
 	_c.mutation.SetAmount(v)
 	return _c
 }
@@ -83,6 +85,20 @@ func (_c *RecurringExpenseCreate) SetNillableActive(v *bool) *RecurringExpenseCr
 	return _c
 }
 
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_c *RecurringExpenseCreate) SetDeactivatedAt(v time.Time) *RecurringExpenseCreate {
+	_c.mutation.SetDeactivatedAt(v)
+	return _c
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_c *RecurringExpenseCreate) SetNillableDeactivatedAt(v *time.Time) *RecurringExpenseCreate {
+	if v != nil {
+		_c.SetDeactivatedAt(*v)
+	}
+	return _c
+}
+
 // SetStartDate sets the "start_date" field.
 func (_c *RecurringExpenseCreate) SetStartDate(v time.Time) *RecurringExpenseCreate {
 	_c.mutation.SetStartDate(v)
@@ -103,6 +119,26 @@ func (_c *RecurringExpenseCreate) SetNillableEndDate(v *time.Time) *RecurringExp
 	return _c
 }
 
+// SetSplitType sets the "split_type" field.
+func (_c *RecurringExpenseCreate) SetSplitType(v string) *RecurringExpenseCreate {
+	_c.mutation.SetSplitType(v)
+	return _c
+}
+
+// SetNillableSplitType sets the "split_type" field if the given value is not nil.
+func (_c *RecurringExpenseCreate) SetNillableSplitType(v *string) *RecurringExpenseCreate {
+	if v != nil {
+		_c.SetSplitType(*v)
+	}
+	return _c
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (_c *RecurringExpenseCreate) SetSplitShares(v []schema.SplitShare) *RecurringExpenseCreate {
+	_c.mutation.SetSplitShares(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *RecurringExpenseCreate) SetCreatedAt(v time.Time) *RecurringExpenseCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -131,6 +167,20 @@ func (_c *RecurringExpenseCreate) SetNillableUpdatedAt(v *time.Time) *RecurringE
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *RecurringExpenseCreate) SetDeletedAt(v time.Time) *RecurringExpenseCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *RecurringExpenseCreate) SetNillableDeletedAt(v *time.Time) *RecurringExpenseCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetHouseholdID sets the "household" edge to the Household entity by ID.
 func (_c *RecurringExpenseCreate) SetHouseholdID(id int) *RecurringExpenseCreate {
 	_c.mutation.SetHouseholdID(id)
@@ -153,6 +203,25 @@ func (_c *RecurringExpenseCreate) SetCategory(v *Category) *RecurringExpenseCrea
 	return _c.SetCategoryID(v.ID)
 }
 
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by ID.
+func (_c *RecurringExpenseCreate) SetPayerID(id int) *RecurringExpenseCreate {
+	_c.mutation.SetPayerID(id)
+	return _c
+}
+
+// SetNillablePayerID sets the "payer" edge to the HouseholdMember entity by ID if the given value is not nil.
+func (_c *RecurringExpenseCreate) SetNillablePayerID(id *int) *RecurringExpenseCreate {
+	if id != nil {
+		_c = _c.SetPayerID(*id)
+	}
+	return _c
+}
+
+// SetPayer sets the "payer" edge to the HouseholdMember entity.
+func (_c *RecurringExpenseCreate) SetPayer(v *HouseholdMember) *RecurringExpenseCreate {
+	return _c.SetPayerID(v.ID)
+}
+
 // AddScheduleOverrideIDs adds the "schedule_overrides" edge to the RecurringScheduleOverride entity by IDs.
 func (_c *RecurringExpenseCreate) AddScheduleOverrideIDs(ids ...int) *RecurringExpenseCreate {
 	_c.mutation.AddScheduleOverrideIDs(ids...)
@@ -248,11 +317,6 @@ func (_c *RecurringExpenseCreate) check() error {
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "RecurringExpense.amount"`)}
 	}
-	if v, ok := _c.mutation.Amount(); ok {
-		if err := recurringexpense.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.amount": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.Frequency(); !ok {
 		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "RecurringExpense.frequency"`)}
 	}
@@ -267,6 +331,11 @@ func (_c *RecurringExpenseCreate) check() error {
 	if _, ok := _c.mutation.StartDate(); !ok {
 		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "RecurringExpense.start_date"`)}
 	}
+	if v, ok := _c.mutation.SplitType(); ok {
+		if err := recurringexpense.SplitTypeValidator(v); err != nil {
+			return &ValidationError{Name: "split_type", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.split_type": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurringExpense.created_at"`)}
 	}
@@ -318,7 +387,7 @@ func (_c *RecurringExpenseCreate) createSpec() (*RecurringExpense, *sqlgraph.Cre
 		_node.Details = value
 	}
 	if value, ok := _c.mutation.Amount(); ok {
-		_spec.SetField(recurringexpense.FieldAmount, field.TypeString, value)
+		_spec.SetField(recurringexpense.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
 	if value, ok := _c.mutation.Frequency(); ok {
@@ -329,6 +398,10 @@ func (_c *RecurringExpenseCreate) createSpec() (*RecurringExpense, *sqlgraph.Cre
 		_spec.SetField(recurringexpense.FieldActive, field.TypeBool, value)
 		_node.Active = value
 	}
+	if value, ok := _c.mutation.DeactivatedAt(); ok {
+		_spec.SetField(recurringexpense.FieldDeactivatedAt, field.TypeTime, value)
+		_node.DeactivatedAt = &value
+	}
 	if value, ok := _c.mutation.StartDate(); ok {
 		_spec.SetField(recurringexpense.FieldStartDate, field.TypeTime, value)
 		_node.StartDate = value
@@ -337,6 +410,14 @@ func (_c *RecurringExpenseCreate) createSpec() (*RecurringExpense, *sqlgraph.Cre
 		_spec.SetField(recurringexpense.FieldEndDate, field.TypeTime, value)
 		_node.EndDate = &value
 	}
+	if value, ok := _c.mutation.SplitType(); ok {
+		_spec.SetField(recurringexpense.FieldSplitType, field.TypeString, value)
+		_node.SplitType = value
+	}
+	if value, ok := _c.mutation.SplitShares(); ok {
+		_spec.SetField(recurringexpense.FieldSplitShares, field.TypeJSON, value)
+		_node.SplitShares = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(recurringexpense.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -345,6 +426,10 @@ func (_c *RecurringExpenseCreate) createSpec() (*RecurringExpense, *sqlgraph.Cre
 		_spec.SetField(recurringexpense.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(recurringexpense.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -379,6 +464,23 @@ func (_c *RecurringExpenseCreate) createSpec() (*RecurringExpense, *sqlgraph.Cre
 		_node.category_recurring_expenses = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringexpense.PayerTable,
+			Columns: []string{recurringexpense.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_member_paid_recurring_expenses = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ScheduleOverridesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
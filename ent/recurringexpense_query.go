@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
 	"icekalt.dev/money-tracker/ent/predicate"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
@@ -28,8 +29,10 @@ type RecurringExpenseQuery struct {
 	predicates            []predicate.RecurringExpense
 	withHousehold         *HouseholdQuery
 	withCategory          *CategoryQuery
+	withPayer             *HouseholdMemberQuery
 	withScheduleOverrides *RecurringScheduleOverrideQuery
 	withFKs               bool
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +113,28 @@ func (_q *RecurringExpenseQuery) QueryCategory() *CategoryQuery {
 	return query
 }
 
+// QueryPayer chains the current query on the "payer" edge.
+func (_q *RecurringExpenseQuery) QueryPayer() *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringexpense.Table, recurringexpense.FieldID, selector),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurringexpense.PayerTable, recurringexpense.PayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryScheduleOverrides chains the current query on the "schedule_overrides" edge.
 func (_q *RecurringExpenseQuery) QueryScheduleOverrides() *RecurringScheduleOverrideQuery {
 	query := (&RecurringScheduleOverrideClient{config: _q.config}).Query()
@@ -326,10 +351,12 @@ func (_q *RecurringExpenseQuery) Clone() *RecurringExpenseQuery {
 		predicates:            append([]predicate.RecurringExpense{}, _q.predicates...),
 		withHousehold:         _q.withHousehold.Clone(),
 		withCategory:          _q.withCategory.Clone(),
+		withPayer:             _q.withPayer.Clone(),
 		withScheduleOverrides: _q.withScheduleOverrides.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -355,6 +382,17 @@ func (_q *RecurringExpenseQuery) WithCategory(opts ...func(*CategoryQuery)) *Rec
 	return _q
 }
 
+// WithPayer tells the query-builder to eager-load the nodes that are connected to
+// the "payer" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RecurringExpenseQuery) WithPayer(opts ...func(*HouseholdMemberQuery)) *RecurringExpenseQuery {
+	query := (&HouseholdMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayer = query
+	return _q
+}
+
 // WithScheduleOverrides tells the query-builder to eager-load the nodes that are connected to
 // the "schedule_overrides" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *RecurringExpenseQuery) WithScheduleOverrides(opts ...func(*RecurringScheduleOverrideQuery)) *RecurringExpenseQuery {
@@ -445,13 +483,14 @@ func (_q *RecurringExpenseQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*RecurringExpense{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withHousehold != nil,
 			_q.withCategory != nil,
+			_q.withPayer != nil,
 			_q.withScheduleOverrides != nil,
 		}
 	)
-	if _q.withHousehold != nil || _q.withCategory != nil {
+	if _q.withHousehold != nil || _q.withCategory != nil || _q.withPayer != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -466,6 +505,9 @@ func (_q *RecurringExpenseQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -487,6 +529,12 @@ func (_q *RecurringExpenseQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withPayer; query != nil {
+		if err := _q.loadPayer(ctx, query, nodes, nil,
+			func(n *RecurringExpense, e *HouseholdMember) { n.Edges.Payer = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withScheduleOverrides; query != nil {
 		if err := _q.loadScheduleOverrides(ctx, query, nodes,
 			func(n *RecurringExpense) { n.Edges.ScheduleOverrides = []*RecurringScheduleOverride{} },
@@ -563,6 +611,38 @@ func (_q *RecurringExpenseQuery) loadCategory(ctx context.Context, query *Catego
 	}
 	return nil
 }
+func (_q *RecurringExpenseQuery) loadPayer(ctx context.Context, query *HouseholdMemberQuery, nodes []*RecurringExpense, init func(*RecurringExpense), assign func(*RecurringExpense, *HouseholdMember)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RecurringExpense)
+	for i := range nodes {
+		if nodes[i].household_member_paid_recurring_expenses == nil {
+			continue
+		}
+		fk := *nodes[i].household_member_paid_recurring_expenses
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(householdmember.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "household_member_paid_recurring_expenses" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *RecurringExpenseQuery) loadScheduleOverrides(ctx context.Context, query *RecurringScheduleOverrideQuery, nodes []*RecurringExpense, init func(*RecurringExpense), assign func(*RecurringExpense, *RecurringScheduleOverride)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*RecurringExpense)
@@ -597,6 +677,9 @@ func (_q *RecurringExpenseQuery) loadScheduleOverrides(ctx context.Context, quer
 
 func (_q *RecurringExpenseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -659,6 +742,9 @@ func (_q *RecurringExpenseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -676,6 +762,12 @@ func (_q *RecurringExpenseQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *RecurringExpenseQuery) Modify(modifiers ...func(s *sql.Selector)) *RecurringExpenseSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // RecurringExpenseGroupBy is the group-by builder for RecurringExpense entities.
 type RecurringExpenseGroupBy struct {
 	selector
@@ -765,3 +857,9 @@ func (_s *RecurringExpenseSelect) sqlScan(ctx context.Context, root *RecurringEx
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *RecurringExpenseSelect) Modify(modifiers ...func(s *sql.Selector)) *RecurringExpenseSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -10,19 +10,23 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
 	"icekalt.dev/money-tracker/ent/predicate"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/schema"
 )
 
 // RecurringExpenseUpdate is the builder for updating RecurringExpense entities.
 type RecurringExpenseUpdate struct {
 	config
-	hooks    []Hook
-	mutation *RecurringExpenseMutation
+	hooks     []Hook
+	mutation  *RecurringExpenseMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the RecurringExpenseUpdate builder.
@@ -86,19 +90,26 @@ func (_u *RecurringExpenseUpdate) ClearDetails() *RecurringExpenseUpdate {
 }
 
 // SetAmount sets the "amount" field.
-func (_u *RecurringExpenseUpdate) SetAmount(v string) *RecurringExpenseUpdate {
+func (_u *RecurringExpenseUpdate) SetAmount(v int64) *RecurringExpenseUpdate {
+	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *RecurringExpenseUpdate) SetNillableAmount(v *string) *RecurringExpenseUpdate {
+func (_u *RecurringExpenseUpdate) SetNillableAmount(v *int64) *RecurringExpenseUpdate {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
 	return _u
 }
 
+// AddAmount adds value to the "amount" field.
+func (_u *RecurringExpenseUpdate) AddAmount(v int64) *RecurringExpenseUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
 // SetFrequency sets the "frequency" field.
 func (_u *RecurringExpenseUpdate) SetFrequency(v string) *RecurringExpenseUpdate {
 	_u.mutation.SetFrequency(v)
@@ -127,6 +138,26 @@ func (_u *RecurringExpenseUpdate) SetNillableActive(v *bool) *RecurringExpenseUp
 	return _u
 }
 
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_u *RecurringExpenseUpdate) SetDeactivatedAt(v time.Time) *RecurringExpenseUpdate {
+	_u.mutation.SetDeactivatedAt(v)
+	return _u
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_u *RecurringExpenseUpdate) SetNillableDeactivatedAt(v *time.Time) *RecurringExpenseUpdate {
+	if v != nil {
+		_u.SetDeactivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (_u *RecurringExpenseUpdate) ClearDeactivatedAt() *RecurringExpenseUpdate {
+	_u.mutation.ClearDeactivatedAt()
+	return _u
+}
+
 // SetStartDate sets the "start_date" field.
 func (_u *RecurringExpenseUpdate) SetStartDate(v time.Time) *RecurringExpenseUpdate {
 	_u.mutation.SetStartDate(v)
@@ -161,12 +192,70 @@ func (_u *RecurringExpenseUpdate) ClearEndDate() *RecurringExpenseUpdate {
 	return _u
 }
 
+// SetSplitType sets the "split_type" field.
+func (_u *RecurringExpenseUpdate) SetSplitType(v string) *RecurringExpenseUpdate {
+	_u.mutation.SetSplitType(v)
+	return _u
+}
+
+// SetNillableSplitType sets the "split_type" field if the given value is not nil.
+func (_u *RecurringExpenseUpdate) SetNillableSplitType(v *string) *RecurringExpenseUpdate {
+	if v != nil {
+		_u.SetSplitType(*v)
+	}
+	return _u
+}
+
+// ClearSplitType clears the value of the "split_type" field.
+func (_u *RecurringExpenseUpdate) ClearSplitType() *RecurringExpenseUpdate {
+	_u.mutation.ClearSplitType()
+	return _u
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (_u *RecurringExpenseUpdate) SetSplitShares(v []schema.SplitShare) *RecurringExpenseUpdate {
+	_u.mutation.SetSplitShares(v)
+	return _u
+}
+
+// AppendSplitShares appends value to the "split_shares" field.
+func (_u *RecurringExpenseUpdate) AppendSplitShares(v []schema.SplitShare) *RecurringExpenseUpdate {
+	_u.mutation.AppendSplitShares(v)
+	return _u
+}
+
+// ClearSplitShares clears the value of the "split_shares" field.
+func (_u *RecurringExpenseUpdate) ClearSplitShares() *RecurringExpenseUpdate {
+	_u.mutation.ClearSplitShares()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *RecurringExpenseUpdate) SetUpdatedAt(v time.Time) *RecurringExpenseUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *RecurringExpenseUpdate) SetDeletedAt(v time.Time) *RecurringExpenseUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *RecurringExpenseUpdate) SetNillableDeletedAt(v *time.Time) *RecurringExpenseUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *RecurringExpenseUpdate) ClearDeletedAt() *RecurringExpenseUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetHouseholdID sets the "household" edge to the Household entity by ID.
 func (_u *RecurringExpenseUpdate) SetHouseholdID(id int) *RecurringExpenseUpdate {
 	_u.mutation.SetHouseholdID(id)
@@ -189,6 +278,25 @@ func (_u *RecurringExpenseUpdate) SetCategory(v *Category) *RecurringExpenseUpda
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by ID.
+func (_u *RecurringExpenseUpdate) SetPayerID(id int) *RecurringExpenseUpdate {
+	_u.mutation.SetPayerID(id)
+	return _u
+}
+
+// SetNillablePayerID sets the "payer" edge to the HouseholdMember entity by ID if the given value is not nil.
+func (_u *RecurringExpenseUpdate) SetNillablePayerID(id *int) *RecurringExpenseUpdate {
+	if id != nil {
+		_u = _u.SetPayerID(*id)
+	}
+	return _u
+}
+
+// SetPayer sets the "payer" edge to the HouseholdMember entity.
+func (_u *RecurringExpenseUpdate) SetPayer(v *HouseholdMember) *RecurringExpenseUpdate {
+	return _u.SetPayerID(v.ID)
+}
+
 // AddScheduleOverrideIDs adds the "schedule_overrides" edge to the RecurringScheduleOverride entity by IDs.
 func (_u *RecurringExpenseUpdate) AddScheduleOverrideIDs(ids ...int) *RecurringExpenseUpdate {
 	_u.mutation.AddScheduleOverrideIDs(ids...)
@@ -221,6 +329,12 @@ func (_u *RecurringExpenseUpdate) ClearCategory() *RecurringExpenseUpdate {
 	return _u
 }
 
+// ClearPayer clears the "payer" edge to the HouseholdMember entity.
+func (_u *RecurringExpenseUpdate) ClearPayer() *RecurringExpenseUpdate {
+	_u.mutation.ClearPayer()
+	return _u
+}
+
 // ClearScheduleOverrides clears all "schedule_overrides" edges to the RecurringScheduleOverride entity.
 func (_u *RecurringExpenseUpdate) ClearScheduleOverrides() *RecurringExpenseUpdate {
 	_u.mutation.ClearScheduleOverrides()
@@ -295,16 +409,16 @@ func (_u *RecurringExpenseUpdate) check() error {
 			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.details": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Amount(); ok {
-		if err := recurringexpense.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.amount": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Frequency(); ok {
 		if err := recurringexpense.FrequencyValidator(v); err != nil {
 			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.frequency": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SplitType(); ok {
+		if err := recurringexpense.SplitTypeValidator(v); err != nil {
+			return &ValidationError{Name: "split_type", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.split_type": %w`, err)}
+		}
+	}
 	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "RecurringExpense.household"`)
 	}
@@ -314,6 +428,12 @@ func (_u *RecurringExpenseUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurringExpenseUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurringExpenseUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -342,7 +462,10 @@ func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err e
 		_spec.ClearField(recurringexpense.FieldDetails, field.TypeString)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(recurringexpense.FieldAmount, field.TypeString, value)
+		_spec.SetField(recurringexpense.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(recurringexpense.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Frequency(); ok {
 		_spec.SetField(recurringexpense.FieldFrequency, field.TypeString, value)
@@ -350,6 +473,12 @@ func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(recurringexpense.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DeactivatedAt(); ok {
+		_spec.SetField(recurringexpense.FieldDeactivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeactivatedAtCleared() {
+		_spec.ClearField(recurringexpense.FieldDeactivatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.StartDate(); ok {
 		_spec.SetField(recurringexpense.FieldStartDate, field.TypeTime, value)
 	}
@@ -359,9 +488,32 @@ func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.EndDateCleared() {
 		_spec.ClearField(recurringexpense.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SplitType(); ok {
+		_spec.SetField(recurringexpense.FieldSplitType, field.TypeString, value)
+	}
+	if _u.mutation.SplitTypeCleared() {
+		_spec.ClearField(recurringexpense.FieldSplitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitShares(); ok {
+		_spec.SetField(recurringexpense.FieldSplitShares, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSplitShares(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringexpense.FieldSplitShares, value)
+		})
+	}
+	if _u.mutation.SplitSharesCleared() {
+		_spec.ClearField(recurringexpense.FieldSplitShares, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(recurringexpense.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(recurringexpense.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(recurringexpense.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.HouseholdCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -420,6 +572,35 @@ func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringexpense.PayerTable,
+			Columns: []string{recurringexpense.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringexpense.PayerTable,
+			Columns: []string{recurringexpense.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ScheduleOverridesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -465,6 +646,7 @@ func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{recurringexpense.Label}
@@ -480,9 +662,10 @@ func (_u *RecurringExpenseUpdate) sqlSave(ctx context.Context) (_node int, err e
 // RecurringExpenseUpdateOne is the builder for updating a single RecurringExpense entity.
 type RecurringExpenseUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RecurringExpenseMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *RecurringExpenseMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -540,19 +723,26 @@ func (_u *RecurringExpenseUpdateOne) ClearDetails() *RecurringExpenseUpdateOne {
 }
 
 // SetAmount sets the "amount" field.
-func (_u *RecurringExpenseUpdateOne) SetAmount(v string) *RecurringExpenseUpdateOne {
+func (_u *RecurringExpenseUpdateOne) SetAmount(v int64) *RecurringExpenseUpdateOne {
+	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *RecurringExpenseUpdateOne) SetNillableAmount(v *string) *RecurringExpenseUpdateOne {
+func (_u *RecurringExpenseUpdateOne) SetNillableAmount(v *int64) *RecurringExpenseUpdateOne {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
 	return _u
 }
 
+// AddAmount adds value to the "amount" field.
+func (_u *RecurringExpenseUpdateOne) AddAmount(v int64) *RecurringExpenseUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
 // SetFrequency sets the "frequency" field.
 func (_u *RecurringExpenseUpdateOne) SetFrequency(v string) *RecurringExpenseUpdateOne {
 	_u.mutation.SetFrequency(v)
@@ -581,6 +771,26 @@ func (_u *RecurringExpenseUpdateOne) SetNillableActive(v *bool) *RecurringExpens
 	return _u
 }
 
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_u *RecurringExpenseUpdateOne) SetDeactivatedAt(v time.Time) *RecurringExpenseUpdateOne {
+	_u.mutation.SetDeactivatedAt(v)
+	return _u
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_u *RecurringExpenseUpdateOne) SetNillableDeactivatedAt(v *time.Time) *RecurringExpenseUpdateOne {
+	if v != nil {
+		_u.SetDeactivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (_u *RecurringExpenseUpdateOne) ClearDeactivatedAt() *RecurringExpenseUpdateOne {
+	_u.mutation.ClearDeactivatedAt()
+	return _u
+}
+
 // SetStartDate sets the "start_date" field.
 func (_u *RecurringExpenseUpdateOne) SetStartDate(v time.Time) *RecurringExpenseUpdateOne {
 	_u.mutation.SetStartDate(v)
@@ -615,12 +825,70 @@ func (_u *RecurringExpenseUpdateOne) ClearEndDate() *RecurringExpenseUpdateOne {
 	return _u
 }
 
+// SetSplitType sets the "split_type" field.
+func (_u *RecurringExpenseUpdateOne) SetSplitType(v string) *RecurringExpenseUpdateOne {
+	_u.mutation.SetSplitType(v)
+	return _u
+}
+
+// SetNillableSplitType sets the "split_type" field if the given value is not nil.
+func (_u *RecurringExpenseUpdateOne) SetNillableSplitType(v *string) *RecurringExpenseUpdateOne {
+	if v != nil {
+		_u.SetSplitType(*v)
+	}
+	return _u
+}
+
+// ClearSplitType clears the value of the "split_type" field.
+func (_u *RecurringExpenseUpdateOne) ClearSplitType() *RecurringExpenseUpdateOne {
+	_u.mutation.ClearSplitType()
+	return _u
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (_u *RecurringExpenseUpdateOne) SetSplitShares(v []schema.SplitShare) *RecurringExpenseUpdateOne {
+	_u.mutation.SetSplitShares(v)
+	return _u
+}
+
+// AppendSplitShares appends value to the "split_shares" field.
+func (_u *RecurringExpenseUpdateOne) AppendSplitShares(v []schema.SplitShare) *RecurringExpenseUpdateOne {
+	_u.mutation.AppendSplitShares(v)
+	return _u
+}
+
+// ClearSplitShares clears the value of the "split_shares" field.
+func (_u *RecurringExpenseUpdateOne) ClearSplitShares() *RecurringExpenseUpdateOne {
+	_u.mutation.ClearSplitShares()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *RecurringExpenseUpdateOne) SetUpdatedAt(v time.Time) *RecurringExpenseUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *RecurringExpenseUpdateOne) SetDeletedAt(v time.Time) *RecurringExpenseUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *RecurringExpenseUpdateOne) SetNillableDeletedAt(v *time.Time) *RecurringExpenseUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *RecurringExpenseUpdateOne) ClearDeletedAt() *RecurringExpenseUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetHouseholdID sets the "household" edge to the Household entity by ID.
 func (_u *RecurringExpenseUpdateOne) SetHouseholdID(id int) *RecurringExpenseUpdateOne {
 	_u.mutation.SetHouseholdID(id)
@@ -643,6 +911,25 @@ func (_u *RecurringExpenseUpdateOne) SetCategory(v *Category) *RecurringExpenseU
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by ID.
+func (_u *RecurringExpenseUpdateOne) SetPayerID(id int) *RecurringExpenseUpdateOne {
+	_u.mutation.SetPayerID(id)
+	return _u
+}
+
+// SetNillablePayerID sets the "payer" edge to the HouseholdMember entity by ID if the given value is not nil.
+func (_u *RecurringExpenseUpdateOne) SetNillablePayerID(id *int) *RecurringExpenseUpdateOne {
+	if id != nil {
+		_u = _u.SetPayerID(*id)
+	}
+	return _u
+}
+
+// SetPayer sets the "payer" edge to the HouseholdMember entity.
+func (_u *RecurringExpenseUpdateOne) SetPayer(v *HouseholdMember) *RecurringExpenseUpdateOne {
+	return _u.SetPayerID(v.ID)
+}
+
 // AddScheduleOverrideIDs adds the "schedule_overrides" edge to the RecurringScheduleOverride entity by IDs.
 func (_u *RecurringExpenseUpdateOne) AddScheduleOverrideIDs(ids ...int) *RecurringExpenseUpdateOne {
 	_u.mutation.AddScheduleOverrideIDs(ids...)
@@ -675,6 +962,12 @@ func (_u *RecurringExpenseUpdateOne) ClearCategory() *RecurringExpenseUpdateOne
 	return _u
 }
 
+// ClearPayer clears the "payer" edge to the HouseholdMember entity.
+func (_u *RecurringExpenseUpdateOne) ClearPayer() *RecurringExpenseUpdateOne {
+	_u.mutation.ClearPayer()
+	return _u
+}
+
 // ClearScheduleOverrides clears all "schedule_overrides" edges to the RecurringScheduleOverride entity.
 func (_u *RecurringExpenseUpdateOne) ClearScheduleOverrides() *RecurringExpenseUpdateOne {
 	_u.mutation.ClearScheduleOverrides()
@@ -762,16 +1055,16 @@ func (_u *RecurringExpenseUpdateOne) check() error {
 			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.details": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Amount(); ok {
-		if err := recurringexpense.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.amount": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Frequency(); ok {
 		if err := recurringexpense.FrequencyValidator(v); err != nil {
 			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.frequency": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SplitType(); ok {
+		if err := recurringexpense.SplitTypeValidator(v); err != nil {
+			return &ValidationError{Name: "split_type", err: fmt.Errorf(`ent: validator failed for field "RecurringExpense.split_type": %w`, err)}
+		}
+	}
 	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "RecurringExpense.household"`)
 	}
@@ -781,6 +1074,12 @@ func (_u *RecurringExpenseUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurringExpenseUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurringExpenseUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *RecurringExpenseUpdateOne) sqlSave(ctx context.Context) (_node *RecurringExpense, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -826,7 +1125,10 @@ func (_u *RecurringExpenseUpdateOne) sqlSave(ctx context.Context) (_node *Recurr
 		_spec.ClearField(recurringexpense.FieldDetails, field.TypeString)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(recurringexpense.FieldAmount, field.TypeString, value)
+		_spec.SetField(recurringexpense.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(recurringexpense.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Frequency(); ok {
 		_spec.SetField(recurringexpense.FieldFrequency, field.TypeString, value)
@@ -834,6 +1136,12 @@ func (_u *RecurringExpenseUpdateOne) sqlSave(ctx context.Context) (_node *Recurr
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(recurringexpense.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DeactivatedAt(); ok {
+		_spec.SetField(recurringexpense.FieldDeactivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeactivatedAtCleared() {
+		_spec.ClearField(recurringexpense.FieldDeactivatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.StartDate(); ok {
 		_spec.SetField(recurringexpense.FieldStartDate, field.TypeTime, value)
 	}
@@ -843,9 +1151,32 @@ func (_u *RecurringExpenseUpdateOne) sqlSave(ctx context.Context) (_node *Recurr
 	if _u.mutation.EndDateCleared() {
 		_spec.ClearField(recurringexpense.FieldEndDate, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SplitType(); ok {
+		_spec.SetField(recurringexpense.FieldSplitType, field.TypeString, value)
+	}
+	if _u.mutation.SplitTypeCleared() {
+		_spec.ClearField(recurringexpense.FieldSplitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitShares(); ok {
+		_spec.SetField(recurringexpense.FieldSplitShares, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSplitShares(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringexpense.FieldSplitShares, value)
+		})
+	}
+	if _u.mutation.SplitSharesCleared() {
+		_spec.ClearField(recurringexpense.FieldSplitShares, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(recurringexpense.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(recurringexpense.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(recurringexpense.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.HouseholdCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -904,6 +1235,35 @@ func (_u *RecurringExpenseUpdateOne) sqlSave(ctx context.Context) (_node *Recurr
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringexpense.PayerTable,
+			Columns: []string{recurringexpense.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringexpense.PayerTable,
+			Columns: []string{recurringexpense.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ScheduleOverridesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -949,6 +1309,7 @@ func (_u *RecurringExpenseUpdateOne) sqlSave(ctx context.Context) (_node *Recurr
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &RecurringExpense{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
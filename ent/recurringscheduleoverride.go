@@ -20,8 +20,8 @@ type RecurringScheduleOverride struct {
 	ID int `json:"id,omitempty"`
 	// EffectiveDate holds the value of the "effective_date" field.
 	EffectiveDate time.Time `json:"effective_date,omitempty"`
-	// Amount holds the value of the "amount" field.
-	Amount string `json:"amount,omitempty"`
+	// Minor units (cents)
+	Amount int64 `json:"amount,omitempty"`
 	// Frequency holds the value of the "frequency" field.
 	Frequency string `json:"frequency,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -60,9 +60,9 @@ func (*RecurringScheduleOverride) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case recurringscheduleoverride.FieldID:
+		case recurringscheduleoverride.FieldID, recurringscheduleoverride.FieldAmount:
 			values[i] = new(sql.NullInt64)
-		case recurringscheduleoverride.FieldAmount, recurringscheduleoverride.FieldFrequency:
+		case recurringscheduleoverride.FieldFrequency:
 			values[i] = new(sql.NullString)
 		case recurringscheduleoverride.FieldEffectiveDate, recurringscheduleoverride.FieldCreatedAt, recurringscheduleoverride.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -96,10 +96,10 @@ func (_m *RecurringScheduleOverride) assignValues(columns []string, values []any
 				_m.EffectiveDate = value.Time
 			}
 		case recurringscheduleoverride.FieldAmount:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
 			} else if value.Valid {
-				_m.Amount = value.String
+				_m.Amount = value.Int64
 			}
 		case recurringscheduleoverride.FieldFrequency:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -171,7 +171,7 @@ func (_m *RecurringScheduleOverride) String() string {
 	builder.WriteString(_m.EffectiveDate.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("amount=")
-	builder.WriteString(_m.Amount)
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
 	builder.WriteString("frequency=")
 	builder.WriteString(_m.Frequency)
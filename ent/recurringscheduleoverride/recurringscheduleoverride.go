@@ -69,8 +69,6 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	AmountValidator func(string) error
 	// FrequencyValidator is a validator for the "frequency" field. It is called by the builders before save.
 	FrequencyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
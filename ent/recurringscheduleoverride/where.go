@@ -61,7 +61,7 @@ func EffectiveDate(v time.Time) predicate.RecurringScheduleOverride {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v string) predicate.RecurringScheduleOverride {
+func Amount(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldEQ(FieldAmount, v))
 }
 
@@ -121,70 +121,45 @@ func EffectiveDateLTE(v time.Time) predicate.RecurringScheduleOverride {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v string) predicate.RecurringScheduleOverride {
+func AmountEQ(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldEQ(FieldAmount, v))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v string) predicate.RecurringScheduleOverride {
+func AmountNEQ(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldNEQ(FieldAmount, v))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...string) predicate.RecurringScheduleOverride {
+func AmountIn(vs ...int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldIn(FieldAmount, vs...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...string) predicate.RecurringScheduleOverride {
+func AmountNotIn(vs ...int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldNotIn(FieldAmount, vs...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v string) predicate.RecurringScheduleOverride {
+func AmountGT(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldGT(FieldAmount, v))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v string) predicate.RecurringScheduleOverride {
+func AmountGTE(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldGTE(FieldAmount, v))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v string) predicate.RecurringScheduleOverride {
+func AmountLT(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldLT(FieldAmount, v))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v string) predicate.RecurringScheduleOverride {
+func AmountLTE(v int64) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldLTE(FieldAmount, v))
 }
 
-// AmountContains applies the Contains predicate on the "amount" field.
-func AmountContains(v string) predicate.RecurringScheduleOverride {
-	return predicate.RecurringScheduleOverride(sql.FieldContains(FieldAmount, v))
-}
-
-// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
-func AmountHasPrefix(v string) predicate.RecurringScheduleOverride {
-	return predicate.RecurringScheduleOverride(sql.FieldHasPrefix(FieldAmount, v))
-}
-
-// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
-func AmountHasSuffix(v string) predicate.RecurringScheduleOverride {
-	return predicate.RecurringScheduleOverride(sql.FieldHasSuffix(FieldAmount, v))
-}
-
-// AmountEqualFold applies the EqualFold predicate on the "amount" field.
-func AmountEqualFold(v string) predicate.RecurringScheduleOverride {
-	return predicate.RecurringScheduleOverride(sql.FieldEqualFold(FieldAmount, v))
-}
-
-// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
-func AmountContainsFold(v string) predicate.RecurringScheduleOverride {
-	return predicate.RecurringScheduleOverride(sql.FieldContainsFold(FieldAmount, v))
-}
-
 // FrequencyEQ applies the EQ predicate on the "frequency" field.
 func FrequencyEQ(v string) predicate.RecurringScheduleOverride {
 	return predicate.RecurringScheduleOverride(sql.FieldEQ(FieldFrequency, v))
@@ -28,7 +28,7 @@ func (_c *RecurringScheduleOverrideCreate) SetEffectiveDate(v time.Time) *Recurr
 }
 
 // SetAmount sets the "amount" field.
-func (_c *RecurringScheduleOverrideCreate) SetAmount(v string) *RecurringScheduleOverrideCreate {
+func (_c *RecurringScheduleOverrideCreate) SetAmount(v int64) *RecurringScheduleOverrideCreate {
 	_c.mutation.SetAmount(v)
 	return _c
 }
@@ -131,11 +131,6 @@ func (_c *RecurringScheduleOverrideCreate) check() error {
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "RecurringScheduleOverride.amount"`)}
 	}
-	if v, ok := _c.mutation.Amount(); ok {
-		if err := recurringscheduleoverride.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "RecurringScheduleOverride.amount": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.Frequency(); !ok {
 		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "RecurringScheduleOverride.frequency"`)}
 	}
@@ -184,7 +179,7 @@ func (_c *RecurringScheduleOverrideCreate) createSpec() (*RecurringScheduleOverr
 		_node.EffectiveDate = value
 	}
 	if value, ok := _c.mutation.Amount(); ok {
-		_spec.SetField(recurringscheduleoverride.FieldAmount, field.TypeString, value)
+		_spec.SetField(recurringscheduleoverride.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
 	if value, ok := _c.mutation.Frequency(); ok {
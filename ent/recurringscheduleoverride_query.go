@@ -25,6 +25,7 @@ type RecurringScheduleOverrideQuery struct {
 	predicates           []predicate.RecurringScheduleOverride
 	withRecurringExpense *RecurringExpenseQuery
 	withFKs              bool
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -277,8 +278,9 @@ func (_q *RecurringScheduleOverrideQuery) Clone() *RecurringScheduleOverrideQuer
 		predicates:           append([]predicate.RecurringScheduleOverride{}, _q.predicates...),
 		withRecurringExpense: _q.withRecurringExpense.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -391,6 +393,9 @@ func (_q *RecurringScheduleOverrideQuery) sqlAll(ctx context.Context, hooks ...q
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +449,9 @@ func (_q *RecurringScheduleOverrideQuery) loadRecurringExpense(ctx context.Conte
 
 func (_q *RecurringScheduleOverrideQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -506,6 +514,9 @@ func (_q *RecurringScheduleOverrideQuery) sqlQuery(ctx context.Context) *sql.Sel
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -523,6 +534,12 @@ func (_q *RecurringScheduleOverrideQuery) sqlQuery(ctx context.Context) *sql.Sel
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *RecurringScheduleOverrideQuery) Modify(modifiers ...func(s *sql.Selector)) *RecurringScheduleOverrideSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // RecurringScheduleOverrideGroupBy is the group-by builder for RecurringScheduleOverride entities.
 type RecurringScheduleOverrideGroupBy struct {
 	selector
@@ -612,3 +629,9 @@ func (_s *RecurringScheduleOverrideSelect) sqlScan(ctx context.Context, root *Re
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *RecurringScheduleOverrideSelect) Modify(modifiers ...func(s *sql.Selector)) *RecurringScheduleOverrideSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
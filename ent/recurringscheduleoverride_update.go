@@ -19,8 +19,9 @@ import (
 // RecurringScheduleOverrideUpdate is the builder for updating RecurringScheduleOverride entities.
 type RecurringScheduleOverrideUpdate struct {
 	config
-	hooks    []Hook
-	mutation *RecurringScheduleOverrideMutation
+	hooks     []Hook
+	mutation  *RecurringScheduleOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the RecurringScheduleOverrideUpdate builder.
@@ -44,19 +45,26 @@ func (_u *RecurringScheduleOverrideUpdate) SetNillableEffectiveDate(v *time.Time
 }
 
 // SetAmount sets the "amount" field.
-func (_u *RecurringScheduleOverrideUpdate) SetAmount(v string) *RecurringScheduleOverrideUpdate {
+func (_u *RecurringScheduleOverrideUpdate) SetAmount(v int64) *RecurringScheduleOverrideUpdate {
+	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *RecurringScheduleOverrideUpdate) SetNillableAmount(v *string) *RecurringScheduleOverrideUpdate {
+func (_u *RecurringScheduleOverrideUpdate) SetNillableAmount(v *int64) *RecurringScheduleOverrideUpdate {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
 	return _u
 }
 
+// AddAmount adds value to the "amount" field.
+func (_u *RecurringScheduleOverrideUpdate) AddAmount(v int64) *RecurringScheduleOverrideUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
 // SetFrequency sets the "frequency" field.
 func (_u *RecurringScheduleOverrideUpdate) SetFrequency(v string) *RecurringScheduleOverrideUpdate {
 	_u.mutation.SetFrequency(v)
@@ -137,11 +145,6 @@ func (_u *RecurringScheduleOverrideUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *RecurringScheduleOverrideUpdate) check() error {
-	if v, ok := _u.mutation.Amount(); ok {
-		if err := recurringscheduleoverride.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "RecurringScheduleOverride.amount": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Frequency(); ok {
 		if err := recurringscheduleoverride.FrequencyValidator(v); err != nil {
 			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringScheduleOverride.frequency": %w`, err)}
@@ -153,6 +156,12 @@ func (_u *RecurringScheduleOverrideUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurringScheduleOverrideUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurringScheduleOverrideUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *RecurringScheduleOverrideUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -169,7 +178,10 @@ func (_u *RecurringScheduleOverrideUpdate) sqlSave(ctx context.Context) (_node i
 		_spec.SetField(recurringscheduleoverride.FieldEffectiveDate, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(recurringscheduleoverride.FieldAmount, field.TypeString, value)
+		_spec.SetField(recurringscheduleoverride.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(recurringscheduleoverride.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Frequency(); ok {
 		_spec.SetField(recurringscheduleoverride.FieldFrequency, field.TypeString, value)
@@ -206,6 +218,7 @@ func (_u *RecurringScheduleOverrideUpdate) sqlSave(ctx context.Context) (_node i
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{recurringscheduleoverride.Label}
@@ -221,9 +234,10 @@ func (_u *RecurringScheduleOverrideUpdate) sqlSave(ctx context.Context) (_node i
 // RecurringScheduleOverrideUpdateOne is the builder for updating a single RecurringScheduleOverride entity.
 type RecurringScheduleOverrideUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *RecurringScheduleOverrideMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *RecurringScheduleOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetEffectiveDate sets the "effective_date" field.
@@ -241,19 +255,26 @@ func (_u *RecurringScheduleOverrideUpdateOne) SetNillableEffectiveDate(v *time.T
 }
 
 // SetAmount sets the "amount" field.
-func (_u *RecurringScheduleOverrideUpdateOne) SetAmount(v string) *RecurringScheduleOverrideUpdateOne {
+func (_u *RecurringScheduleOverrideUpdateOne) SetAmount(v int64) *RecurringScheduleOverrideUpdateOne {
+	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *RecurringScheduleOverrideUpdateOne) SetNillableAmount(v *string) *RecurringScheduleOverrideUpdateOne {
+func (_u *RecurringScheduleOverrideUpdateOne) SetNillableAmount(v *int64) *RecurringScheduleOverrideUpdateOne {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
 	return _u
 }
 
+// AddAmount adds value to the "amount" field.
+func (_u *RecurringScheduleOverrideUpdateOne) AddAmount(v int64) *RecurringScheduleOverrideUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
 // SetFrequency sets the "frequency" field.
 func (_u *RecurringScheduleOverrideUpdateOne) SetFrequency(v string) *RecurringScheduleOverrideUpdateOne {
 	_u.mutation.SetFrequency(v)
@@ -347,11 +368,6 @@ func (_u *RecurringScheduleOverrideUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *RecurringScheduleOverrideUpdateOne) check() error {
-	if v, ok := _u.mutation.Amount(); ok {
-		if err := recurringscheduleoverride.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "RecurringScheduleOverride.amount": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Frequency(); ok {
 		if err := recurringscheduleoverride.FrequencyValidator(v); err != nil {
 			return &ValidationError{Name: "frequency", err: fmt.Errorf(`ent: validator failed for field "RecurringScheduleOverride.frequency": %w`, err)}
@@ -363,6 +379,12 @@ func (_u *RecurringScheduleOverrideUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RecurringScheduleOverrideUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RecurringScheduleOverrideUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *RecurringScheduleOverrideUpdateOne) sqlSave(ctx context.Context) (_node *RecurringScheduleOverride, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -396,7 +418,10 @@ func (_u *RecurringScheduleOverrideUpdateOne) sqlSave(ctx context.Context) (_nod
 		_spec.SetField(recurringscheduleoverride.FieldEffectiveDate, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(recurringscheduleoverride.FieldAmount, field.TypeString, value)
+		_spec.SetField(recurringscheduleoverride.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(recurringscheduleoverride.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Frequency(); ok {
 		_spec.SetField(recurringscheduleoverride.FieldFrequency, field.TypeString, value)
@@ -433,6 +458,7 @@ func (_u *RecurringScheduleOverrideUpdateOne) sqlSave(ctx context.Context) (_nod
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &RecurringScheduleOverride{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/revision"
+)
+
+// RevisionCreate is the builder for creating a Revision entity.
+type RevisionCreate struct {
+	config
+	mutation *RevisionMutation
+	hooks    []Hook
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_c *RevisionCreate) SetEntityType(v string) *RevisionCreate {
+	_c.mutation.SetEntityType(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *RevisionCreate) SetEntityID(v int) *RevisionCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetHouseholdID sets the "household_id" field.
+func (_c *RevisionCreate) SetHouseholdID(v int) *RevisionCreate {
+	_c.mutation.SetHouseholdID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *RevisionCreate) SetAction(v string) *RevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RevisionCreate) SetUserID(v int) *RevisionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableUserID(v *int) *RevisionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *RevisionCreate) SetBefore(v map[string]string) *RevisionCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *RevisionCreate) SetAfter(v map[string]string) *RevisionCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RevisionCreate) SetCreatedAt(v time.Time) *RevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RevisionCreate) SetNillableCreatedAt(v *time.Time) *RevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RevisionMutation object of the builder.
+func (_c *RevisionCreate) Mutation() *RevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the Revision in the database.
+func (_c *RevisionCreate) Save(ctx context.Context) (*Revision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RevisionCreate) SaveX(ctx context.Context) *Revision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := revision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RevisionCreate) check() error {
+	if _, ok := _c.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "Revision.entity_type"`)}
+	}
+	if v, ok := _c.mutation.EntityType(); ok {
+		if err := revision.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Revision.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "Revision.entity_id"`)}
+	}
+	if _, ok := _c.mutation.HouseholdID(); !ok {
+		return &ValidationError{Name: "household_id", err: errors.New(`ent: missing required field "Revision.household_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Revision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := revision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Revision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Revision.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RevisionCreate) sqlSave(ctx context.Context) (*Revision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RevisionCreate) createSpec() (*Revision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Revision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(revision.Table, sqlgraph.NewFieldSpec(revision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EntityType(); ok {
+		_spec.SetField(revision.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(revision.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.HouseholdID(); ok {
+		_spec.SetField(revision.FieldHouseholdID, field.TypeInt, value)
+		_node.HouseholdID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(revision.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(revision.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(revision.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(revision.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(revision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// RevisionCreateBulk is the builder for creating many Revision entities in bulk.
+type RevisionCreateBulk struct {
+	config
+	err      error
+	builders []*RevisionCreate
+}
+
+// Save creates the Revision entities in the database.
+func (_c *RevisionCreateBulk) Save(ctx context.Context) ([]*Revision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Revision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RevisionCreateBulk) SaveX(ctx context.Context) []*Revision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -5,16 +5,26 @@ package ent
 import (
 	"time"
 
+	"icekalt.dev/money-tracker/ent/aggregategeneration"
 	"icekalt.dev/money-tracker/ent/apitoken"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/householdtransfer"
+	"icekalt.dev/money-tracker/ent/localcredential"
+	"icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/ent/ratelimit"
 	"icekalt.dev/money-tracker/ent/recurringexpense"
 	"icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/revision"
 	"icekalt.dev/money-tracker/ent/schema"
+	"icekalt.dev/money-tracker/ent/securityevent"
 	"icekalt.dev/money-tracker/ent/session"
 	"icekalt.dev/money-tracker/ent/settings"
+	"icekalt.dev/money-tracker/ent/settlement"
 	"icekalt.dev/money-tracker/ent/transaction"
 	"icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/ent/useridentity"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -45,10 +55,28 @@ func init() {
 	apitokenDescTokenHash := apitokenFields[1].Descriptor()
 	// apitoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
 	apitoken.TokenHashValidator = apitokenDescTokenHash.Validators[0].(func(string) error)
+	// apitokenDescAccess is the schema descriptor for access field.
+	apitokenDescAccess := apitokenFields[2].Descriptor()
+	// apitoken.DefaultAccess holds the default value on creation for the access field.
+	apitoken.DefaultAccess = apitokenDescAccess.Default.(string)
+	// apitoken.AccessValidator is a validator for the "access" field. It is called by the builders before save.
+	apitoken.AccessValidator = apitokenDescAccess.Validators[0].(func(string) error)
+	// apitokenDescLastUsedIP is the schema descriptor for last_used_ip field.
+	apitokenDescLastUsedIP := apitokenFields[7].Descriptor()
+	// apitoken.DefaultLastUsedIP holds the default value on creation for the last_used_ip field.
+	apitoken.DefaultLastUsedIP = apitokenDescLastUsedIP.Default.(string)
+	// apitoken.LastUsedIPValidator is a validator for the "last_used_ip" field. It is called by the builders before save.
+	apitoken.LastUsedIPValidator = apitokenDescLastUsedIP.Validators[0].(func(string) error)
 	// apitokenDescCreatedAt is the schema descriptor for created_at field.
-	apitokenDescCreatedAt := apitokenFields[4].Descriptor()
+	apitokenDescCreatedAt := apitokenFields[8].Descriptor()
 	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	aggregategenerationFields := schema.AggregateGeneration{}.Fields()
+	_ = aggregategenerationFields
+	// aggregategenerationDescGeneration is the schema descriptor for generation field.
+	aggregategenerationDescGeneration := aggregategenerationFields[0].Descriptor()
+	// aggregategeneration.DefaultGeneration holds the default value on creation for the generation field.
+	aggregategeneration.DefaultGeneration = aggregategenerationDescGeneration.Default.(int)
 	categoryFields := schema.Category{}.Fields()
 	_ = categoryFields
 	// categoryDescName is the schema descriptor for name field.
@@ -75,12 +103,16 @@ func init() {
 	category.DefaultIcon = categoryDescIcon.Default.(string)
 	// category.IconValidator is a validator for the "icon" field. It is called by the builders before save.
 	category.IconValidator = categoryDescIcon.Validators[0].(func(string) error)
+	// categoryDescTaxClass is the schema descriptor for tax_class field.
+	categoryDescTaxClass := categoryFields[2].Descriptor()
+	// category.TaxClassValidator is a validator for the "tax_class" field. It is called by the builders before save.
+	category.TaxClassValidator = categoryDescTaxClass.Validators[0].(func(string) error)
 	// categoryDescCreatedAt is the schema descriptor for created_at field.
-	categoryDescCreatedAt := categoryFields[2].Descriptor()
+	categoryDescCreatedAt := categoryFields[3].Descriptor()
 	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
 	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
 	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
-	categoryDescUpdatedAt := categoryFields[3].Descriptor()
+	categoryDescUpdatedAt := categoryFields[4].Descriptor()
 	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
 	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -147,6 +179,142 @@ func init() {
 	household.DefaultUpdatedAt = householdDescUpdatedAt.Default.(func() time.Time)
 	// household.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	household.UpdateDefaultUpdatedAt = householdDescUpdatedAt.UpdateDefault.(func() time.Time)
+	householdmemberFields := schema.HouseholdMember{}.Fields()
+	_ = householdmemberFields
+	// householdmemberDescName is the schema descriptor for name field.
+	householdmemberDescName := householdmemberFields[0].Descriptor()
+	// householdmember.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	householdmember.NameValidator = func() func(string) error {
+		validators := householdmemberDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// householdmemberDescCreatedAt is the schema descriptor for created_at field.
+	householdmemberDescCreatedAt := householdmemberFields[1].Descriptor()
+	// householdmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	householdmember.DefaultCreatedAt = householdmemberDescCreatedAt.Default.(func() time.Time)
+	// householdmemberDescUpdatedAt is the schema descriptor for updated_at field.
+	householdmemberDescUpdatedAt := householdmemberFields[2].Descriptor()
+	// householdmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	householdmember.DefaultUpdatedAt = householdmemberDescUpdatedAt.Default.(func() time.Time)
+	// householdmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	householdmember.UpdateDefaultUpdatedAt = householdmemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	householdtransferFields := schema.HouseholdTransfer{}.Fields()
+	_ = householdtransferFields
+	// householdtransferDescEmail is the schema descriptor for email field.
+	householdtransferDescEmail := householdtransferFields[0].Descriptor()
+	// householdtransfer.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	householdtransfer.EmailValidator = func() func(string) error {
+		validators := householdtransferDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// householdtransferDescCreatedAt is the schema descriptor for created_at field.
+	householdtransferDescCreatedAt := householdtransferFields[1].Descriptor()
+	// householdtransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	householdtransfer.DefaultCreatedAt = householdtransferDescCreatedAt.Default.(func() time.Time)
+	localcredentialFields := schema.LocalCredential{}.Fields()
+	_ = localcredentialFields
+	// localcredentialDescUsername is the schema descriptor for username field.
+	localcredentialDescUsername := localcredentialFields[0].Descriptor()
+	// localcredential.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	localcredential.UsernameValidator = func() func(string) error {
+		validators := localcredentialDescUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username string) error {
+			for _, fn := range fns {
+				if err := fn(username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// localcredentialDescPasswordHash is the schema descriptor for password_hash field.
+	localcredentialDescPasswordHash := localcredentialFields[1].Descriptor()
+	// localcredential.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	localcredential.PasswordHashValidator = localcredentialDescPasswordHash.Validators[0].(func(string) error)
+	// localcredentialDescCreatedAt is the schema descriptor for created_at field.
+	localcredentialDescCreatedAt := localcredentialFields[2].Descriptor()
+	// localcredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	localcredential.DefaultCreatedAt = localcredentialDescCreatedAt.Default.(func() time.Time)
+	// localcredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	localcredentialDescUpdatedAt := localcredentialFields[3].Descriptor()
+	// localcredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	localcredential.DefaultUpdatedAt = localcredentialDescUpdatedAt.Default.(func() time.Time)
+	// localcredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	localcredential.UpdateDefaultUpdatedAt = localcredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	monthlyaggregateFields := schema.MonthlyAggregate{}.Fields()
+	_ = monthlyaggregateFields
+	// monthlyaggregateDescMonth is the schema descriptor for month field.
+	monthlyaggregateDescMonth := monthlyaggregateFields[0].Descriptor()
+	// monthlyaggregate.MonthValidator is a validator for the "month" field. It is called by the builders before save.
+	monthlyaggregate.MonthValidator = func() func(string) error {
+		validators := monthlyaggregateDescMonth.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(month string) error {
+			for _, fn := range fns {
+				if err := fn(month); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// monthlyaggregateDescComputedAt is the schema descriptor for computed_at field.
+	monthlyaggregateDescComputedAt := monthlyaggregateFields[8].Descriptor()
+	// monthlyaggregate.DefaultComputedAt holds the default value on creation for the computed_at field.
+	monthlyaggregate.DefaultComputedAt = monthlyaggregateDescComputedAt.Default.(func() time.Time)
+	ratelimitFields := schema.RateLimit{}.Fields()
+	_ = ratelimitFields
+	// ratelimitDescKey is the schema descriptor for key field.
+	ratelimitDescKey := ratelimitFields[0].Descriptor()
+	// ratelimit.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	ratelimit.KeyValidator = func() func(string) error {
+		validators := ratelimitDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ratelimitDescCount is the schema descriptor for count field.
+	ratelimitDescCount := ratelimitFields[1].Descriptor()
+	// ratelimit.DefaultCount holds the default value on creation for the count field.
+	ratelimit.DefaultCount = ratelimitDescCount.Default.(int)
 	recurringexpenseFields := schema.RecurringExpense{}.Fields()
 	_ = recurringexpenseFields
 	// recurringexpenseDescName is the schema descriptor for name field.
@@ -179,10 +347,6 @@ func init() {
 	recurringexpense.DefaultDetails = recurringexpenseDescDetails.Default.(string)
 	// recurringexpense.DetailsValidator is a validator for the "details" field. It is called by the builders before save.
 	recurringexpense.DetailsValidator = recurringexpenseDescDetails.Validators[0].(func(string) error)
-	// recurringexpenseDescAmount is the schema descriptor for amount field.
-	recurringexpenseDescAmount := recurringexpenseFields[3].Descriptor()
-	// recurringexpense.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	recurringexpense.AmountValidator = recurringexpenseDescAmount.Validators[0].(func(string) error)
 	// recurringexpenseDescFrequency is the schema descriptor for frequency field.
 	recurringexpenseDescFrequency := recurringexpenseFields[4].Descriptor()
 	// recurringexpense.FrequencyValidator is a validator for the "frequency" field. It is called by the builders before save.
@@ -191,22 +355,22 @@ func init() {
 	recurringexpenseDescActive := recurringexpenseFields[5].Descriptor()
 	// recurringexpense.DefaultActive holds the default value on creation for the active field.
 	recurringexpense.DefaultActive = recurringexpenseDescActive.Default.(bool)
+	// recurringexpenseDescSplitType is the schema descriptor for split_type field.
+	recurringexpenseDescSplitType := recurringexpenseFields[9].Descriptor()
+	// recurringexpense.SplitTypeValidator is a validator for the "split_type" field. It is called by the builders before save.
+	recurringexpense.SplitTypeValidator = recurringexpenseDescSplitType.Validators[0].(func(string) error)
 	// recurringexpenseDescCreatedAt is the schema descriptor for created_at field.
-	recurringexpenseDescCreatedAt := recurringexpenseFields[8].Descriptor()
+	recurringexpenseDescCreatedAt := recurringexpenseFields[11].Descriptor()
 	// recurringexpense.DefaultCreatedAt holds the default value on creation for the created_at field.
 	recurringexpense.DefaultCreatedAt = recurringexpenseDescCreatedAt.Default.(func() time.Time)
 	// recurringexpenseDescUpdatedAt is the schema descriptor for updated_at field.
-	recurringexpenseDescUpdatedAt := recurringexpenseFields[9].Descriptor()
+	recurringexpenseDescUpdatedAt := recurringexpenseFields[12].Descriptor()
 	// recurringexpense.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	recurringexpense.DefaultUpdatedAt = recurringexpenseDescUpdatedAt.Default.(func() time.Time)
 	// recurringexpense.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	recurringexpense.UpdateDefaultUpdatedAt = recurringexpenseDescUpdatedAt.UpdateDefault.(func() time.Time)
 	recurringscheduleoverrideFields := schema.RecurringScheduleOverride{}.Fields()
 	_ = recurringscheduleoverrideFields
-	// recurringscheduleoverrideDescAmount is the schema descriptor for amount field.
-	recurringscheduleoverrideDescAmount := recurringscheduleoverrideFields[1].Descriptor()
-	// recurringscheduleoverride.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	recurringscheduleoverride.AmountValidator = recurringscheduleoverrideDescAmount.Validators[0].(func(string) error)
 	// recurringscheduleoverrideDescFrequency is the schema descriptor for frequency field.
 	recurringscheduleoverrideDescFrequency := recurringscheduleoverrideFields[2].Descriptor()
 	// recurringscheduleoverride.FrequencyValidator is a validator for the "frequency" field. It is called by the builders before save.
@@ -221,18 +385,126 @@ func init() {
 	recurringscheduleoverride.DefaultUpdatedAt = recurringscheduleoverrideDescUpdatedAt.Default.(func() time.Time)
 	// recurringscheduleoverride.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	recurringscheduleoverride.UpdateDefaultUpdatedAt = recurringscheduleoverrideDescUpdatedAt.UpdateDefault.(func() time.Time)
+	revisionFields := schema.Revision{}.Fields()
+	_ = revisionFields
+	// revisionDescEntityType is the schema descriptor for entity_type field.
+	revisionDescEntityType := revisionFields[0].Descriptor()
+	// revision.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	revision.EntityTypeValidator = func() func(string) error {
+		validators := revisionDescEntityType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(entity_type string) error {
+			for _, fn := range fns {
+				if err := fn(entity_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revisionDescAction is the schema descriptor for action field.
+	revisionDescAction := revisionFields[3].Descriptor()
+	// revision.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	revision.ActionValidator = func() func(string) error {
+		validators := revisionDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// revisionDescCreatedAt is the schema descriptor for created_at field.
+	revisionDescCreatedAt := revisionFields[7].Descriptor()
+	// revision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revision.DefaultCreatedAt = revisionDescCreatedAt.Default.(func() time.Time)
+	securityeventFields := schema.SecurityEvent{}.Fields()
+	_ = securityeventFields
+	// securityeventDescType is the schema descriptor for type field.
+	securityeventDescType := securityeventFields[0].Descriptor()
+	// securityevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	securityevent.TypeValidator = func() func(string) error {
+		validators := securityeventDescType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(_type string) error {
+			for _, fn := range fns {
+				if err := fn(_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// securityeventDescIP is the schema descriptor for ip field.
+	securityeventDescIP := securityeventFields[2].Descriptor()
+	// securityevent.DefaultIP holds the default value on creation for the ip field.
+	securityevent.DefaultIP = securityeventDescIP.Default.(string)
+	// securityevent.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	securityevent.IPValidator = securityeventDescIP.Validators[0].(func(string) error)
+	// securityeventDescUserAgent is the schema descriptor for user_agent field.
+	securityeventDescUserAgent := securityeventFields[3].Descriptor()
+	// securityevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	securityevent.DefaultUserAgent = securityeventDescUserAgent.Default.(string)
+	// securityevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	securityevent.UserAgentValidator = securityeventDescUserAgent.Validators[0].(func(string) error)
+	// securityeventDescCreatedAt is the schema descriptor for created_at field.
+	securityeventDescCreatedAt := securityeventFields[5].Descriptor()
+	// securityevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityevent.DefaultCreatedAt = securityeventDescCreatedAt.Default.(func() time.Time)
 	sessionFields := schema.Session{}.Fields()
 	_ = sessionFields
 	// sessionDescToken is the schema descriptor for token field.
 	sessionDescToken := sessionFields[0].Descriptor()
 	// session.TokenValidator is a validator for the "token" field. It is called by the builders before save.
 	session.TokenValidator = sessionDescToken.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[4].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescIP is the schema descriptor for ip field.
+	sessionDescIP := sessionFields[5].Descriptor()
+	// session.DefaultIP holds the default value on creation for the ip field.
+	session.DefaultIP = sessionDescIP.Default.(string)
+	// session.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	session.IPValidator = sessionDescIP.Validators[0].(func(string) error)
+	// sessionDescOidcIssuer is the schema descriptor for oidc_issuer field.
+	sessionDescOidcIssuer := sessionFields[7].Descriptor()
+	// session.DefaultOidcIssuer holds the default value on creation for the oidc_issuer field.
+	session.DefaultOidcIssuer = sessionDescOidcIssuer.Default.(string)
+	// session.OidcIssuerValidator is a validator for the "oidc_issuer" field. It is called by the builders before save.
+	session.OidcIssuerValidator = sessionDescOidcIssuer.Validators[0].(func(string) error)
+	// sessionDescOidcSubject is the schema descriptor for oidc_subject field.
+	sessionDescOidcSubject := sessionFields[8].Descriptor()
+	// session.DefaultOidcSubject holds the default value on creation for the oidc_subject field.
+	session.DefaultOidcSubject = sessionDescOidcSubject.Default.(string)
+	// session.OidcSubjectValidator is a validator for the "oidc_subject" field. It is called by the builders before save.
+	session.OidcSubjectValidator = sessionDescOidcSubject.Validators[0].(func(string) error)
+	// sessionDescOidcSid is the schema descriptor for oidc_sid field.
+	sessionDescOidcSid := sessionFields[9].Descriptor()
+	// session.DefaultOidcSid holds the default value on creation for the oidc_sid field.
+	session.DefaultOidcSid = sessionDescOidcSid.Default.(string)
+	// session.OidcSidValidator is a validator for the "oidc_sid" field. It is called by the builders before save.
+	session.OidcSidValidator = sessionDescOidcSid.Validators[0].(func(string) error)
 	// sessionDescCreatedAt is the schema descriptor for created_at field.
-	sessionDescCreatedAt := sessionFields[4].Descriptor()
+	sessionDescCreatedAt := sessionFields[10].Descriptor()
 	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
 	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
 	// sessionDescUpdatedAt is the schema descriptor for updated_at field.
-	sessionDescUpdatedAt := sessionFields[5].Descriptor()
+	sessionDescUpdatedAt := sessionFields[11].Descriptor()
 	// session.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	session.DefaultUpdatedAt = sessionDescUpdatedAt.Default.(func() time.Time)
 	// session.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -257,12 +529,24 @@ func init() {
 	settings.DefaultUpdatedAt = settingsDescUpdatedAt.Default.(func() time.Time)
 	// settings.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	settings.UpdateDefaultUpdatedAt = settingsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	settlementFields := schema.Settlement{}.Fields()
+	_ = settlementFields
+	// settlementDescAmount is the schema descriptor for amount field.
+	settlementDescAmount := settlementFields[0].Descriptor()
+	// settlement.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	settlement.AmountValidator = settlementDescAmount.Validators[0].(func(int64) error)
+	// settlementDescNote is the schema descriptor for note field.
+	settlementDescNote := settlementFields[2].Descriptor()
+	// settlement.DefaultNote holds the default value on creation for the note field.
+	settlement.DefaultNote = settlementDescNote.Default.(string)
+	// settlement.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	settlement.NoteValidator = settlementDescNote.Validators[0].(func(string) error)
+	// settlementDescCreatedAt is the schema descriptor for created_at field.
+	settlementDescCreatedAt := settlementFields[3].Descriptor()
+	// settlement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlement.DefaultCreatedAt = settlementDescCreatedAt.Default.(func() time.Time)
 	transactionFields := schema.Transaction{}.Fields()
 	_ = transactionFields
-	// transactionDescAmount is the schema descriptor for amount field.
-	transactionDescAmount := transactionFields[0].Descriptor()
-	// transaction.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	transaction.AmountValidator = transactionDescAmount.Validators[0].(func(string) error)
 	// transactionDescDescription is the schema descriptor for description field.
 	transactionDescDescription := transactionFields[1].Descriptor()
 	// transaction.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
@@ -271,12 +555,20 @@ func init() {
 	transactionDescDetails := transactionFields[2].Descriptor()
 	// transaction.DetailsValidator is a validator for the "details" field. It is called by the builders before save.
 	transaction.DetailsValidator = transactionDescDetails.Validators[0].(func(string) error)
+	// transactionDescTaxClass is the schema descriptor for tax_class field.
+	transactionDescTaxClass := transactionFields[4].Descriptor()
+	// transaction.TaxClassValidator is a validator for the "tax_class" field. It is called by the builders before save.
+	transaction.TaxClassValidator = transactionDescTaxClass.Validators[0].(func(string) error)
+	// transactionDescSplitType is the schema descriptor for split_type field.
+	transactionDescSplitType := transactionFields[5].Descriptor()
+	// transaction.SplitTypeValidator is a validator for the "split_type" field. It is called by the builders before save.
+	transaction.SplitTypeValidator = transactionDescSplitType.Validators[0].(func(string) error)
 	// transactionDescCreatedAt is the schema descriptor for created_at field.
-	transactionDescCreatedAt := transactionFields[4].Descriptor()
+	transactionDescCreatedAt := transactionFields[7].Descriptor()
 	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
 	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
 	// transactionDescUpdatedAt is the schema descriptor for updated_at field.
-	transactionDescUpdatedAt := transactionFields[5].Descriptor()
+	transactionDescUpdatedAt := transactionFields[8].Descriptor()
 	// transaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	transaction.DefaultUpdatedAt = transactionDescUpdatedAt.Default.(func() time.Time)
 	// transaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -295,14 +587,36 @@ func init() {
 	userDescSubject := userFields[2].Descriptor()
 	// user.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
 	user.SubjectValidator = userDescSubject.Validators[0].(func(string) error)
+	// userDescAdmin is the schema descriptor for admin field.
+	userDescAdmin := userFields[3].Descriptor()
+	// user.DefaultAdmin holds the default value on creation for the admin field.
+	user.DefaultAdmin = userDescAdmin.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[3].Descriptor()
+	userDescCreatedAt := userFields[5].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[4].Descriptor()
+	userDescUpdatedAt := userFields[6].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescIssuer is the schema descriptor for issuer field.
+	useridentityDescIssuer := useridentityFields[0].Descriptor()
+	// useridentity.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	useridentity.IssuerValidator = useridentityDescIssuer.Validators[0].(func(string) error)
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[1].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = useridentityDescSubject.Validators[0].(func(string) error)
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[2].Descriptor()
+	// useridentity.DefaultEmail holds the default value on creation for the email field.
+	useridentity.DefaultEmail = useridentityDescEmail.Default.(string)
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[3].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AggregateGeneration counts the invalidations of a household's monthly
+// aggregates. Aggregates computed while the generation changed are not
+// stored, so a write racing with the computation can't leave stale values.
+type AggregateGeneration struct {
+	ent.Schema
+}
+
+func (AggregateGeneration) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("generation").Default(0),
+	}
+}
+
+func (AggregateGeneration) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("household", Household.Type).Ref("aggregate_generation").Unique().Required(),
+	}
+}
@@ -14,8 +14,12 @@ func (APIToken) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").NotEmpty().MaxLen(100),
 		field.String("token_hash").NotEmpty().Unique(),
+		field.String("access").MaxLen(10).Default("write").Comment("read or write"),
+		field.JSON("household_ids", []int{}).Optional().Comment("Households the token is limited to; empty for all"),
 		field.Time("expires_at").Optional().Nillable(),
+		field.Time("rotated_at").Optional().Nillable().Comment("Set once the token was replaced by a new one"),
 		field.Time("last_used").Optional().Nillable(),
+		field.String("last_used_ip").MaxLen(64).Default(""),
 		field.Time("created_at").Immutable().Default(timeNow),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Household struct {
@@ -18,6 +19,7 @@ func (Household) Fields() []ent.Field {
 		field.String("icon").Optional().MaxLen(50).Default("home"),
 		field.Time("created_at").Immutable().Default(timeNow),
 		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
+		field.Time("deleted_at").Optional().Nillable().Comment("Set while the record is in the trash"),
 	}
 }
 
@@ -27,5 +29,16 @@ func (Household) Edges() []ent.Edge {
 		edge.To("categories", Category.Type),
 		edge.To("transactions", Transaction.Type),
 		edge.To("recurring_expenses", RecurringExpense.Type),
+		edge.To("monthly_aggregates", MonthlyAggregate.Type),
+		edge.To("aggregate_generation", AggregateGeneration.Type).Unique(),
+		edge.To("members", HouseholdMember.Type),
+		edge.To("settlements", Settlement.Type),
+		edge.To("transfer", HouseholdTransfer.Type).Unique(),
+	}
+}
+
+func (Household) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
 	}
 }
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HouseholdMember is a person sharing the costs of a household, such as a
+// flatmate. Members are names only; they don't need a user account.
+type HouseholdMember struct {
+	ent.Schema
+}
+
+func (HouseholdMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().MaxLen(50),
+		field.Time("created_at").Immutable().Default(timeNow),
+		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
+	}
+}
+
+func (HouseholdMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("household", Household.Type).Ref("members").Unique().Required(),
+		edge.To("paid_transactions", Transaction.Type),
+		edge.To("paid_recurring_expenses", RecurringExpense.Type),
+		edge.To("settlements_paid", Settlement.Type),
+		edge.To("settlements_received", Settlement.Type),
+	}
+}
+
+func (HouseholdMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("household").Fields("name").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HouseholdTransfer offers a household to the user with the given email.
+// The household changes owner once that user accepts. Offers are keyed by
+// email rather than by user, so making one doesn't tell whether the email
+// belongs to an account.
+type HouseholdTransfer struct {
+	ent.Schema
+}
+
+func (HouseholdTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").NotEmpty().MaxLen(255).Comment("Lower-case email of the recipient"),
+		field.Time("created_at").Immutable().Default(timeNow),
+	}
+}
+
+func (HouseholdTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("household", Household.Type).Ref("transfer").Unique().Required(),
+		edge.From("user", User.Type).Ref("household_transfers").Unique().Required(),
+	}
+}
+
+func (HouseholdTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email"),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// LocalCredential holds the username and password hash of a user who logs in
+// with a local account instead of OIDC.
+type LocalCredential struct {
+	ent.Schema
+}
+
+func (LocalCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").NotEmpty().MaxLen(64).Unique(),
+		field.String("password_hash").NotEmpty().Sensitive().Comment("argon2id in PHC string format"),
+		field.Time("created_at").Immutable().Default(timeNow),
+		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
+	}
+}
+
+func (LocalCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("local_credential").Unique().Required(),
+	}
+}
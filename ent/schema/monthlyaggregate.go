@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MonthlyAggregate stores the computed totals of one household month so that
+// multi-month views don't have to recompute them from raw rows. Rows are
+// deleted whenever the underlying data changes and recomputed on demand.
+type MonthlyAggregate struct {
+	ent.Schema
+}
+
+// MonthlyAggregateCategory is one entry of the per-category breakdown of a
+// monthly aggregate. Amounts are minor units.
+type MonthlyAggregateCategory struct {
+	CategoryID   int    `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	Recurring    int64  `json:"recurring"`
+	OneTime      int64  `json:"one_time"`
+	Total        int64  `json:"total"`
+}
+
+func (MonthlyAggregate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("month").NotEmpty().MaxLen(7),
+		field.Int("version"),
+		field.Int64("gross_income"),
+		field.Int64("gross_expenses"),
+		field.Int64("recurring_total"),
+		field.Int64("one_time_total"),
+		field.Int64("monthly_total"),
+		field.JSON("categories", []MonthlyAggregateCategory{}),
+		field.Time("computed_at").Default(timeNow),
+	}
+}
+
+func (MonthlyAggregate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("household", Household.Type).Ref("monthly_aggregates").Unique().Required(),
+	}
+}
+
+func (MonthlyAggregate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("household").Fields("month").Unique(),
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimit is a request counter of the database-backed rate limit store,
+// shared by all instances using the same database.
+type RateLimit struct {
+	ent.Schema
+}
+
+func (RateLimit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").NotEmpty().MaxLen(128).Unique(),
+		field.Int("count").Default(0),
+		field.Time("reset_at"),
+	}
+}
+
+func (RateLimit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("reset_at"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type RecurringExpense struct {
@@ -15,13 +16,17 @@ func (RecurringExpense) Fields() []ent.Field {
 		field.String("name").NotEmpty().MaxLen(100),
 		field.String("description").Optional().MaxLen(500).Default(""),
 		field.String("details").Optional().MaxLen(5000).Default(""),
-		field.String("amount").NotEmpty(),
+		field.Int64("amount").Comment("Minor units (cents)"),
 		field.String("frequency").NotEmpty(),
 		field.Bool("active").Default(true),
+		field.Time("deactivated_at").Optional().Nillable().Comment("Set while the expense is inactive"),
 		field.Time("start_date"),
 		field.Time("end_date").Optional().Nillable(),
+		field.String("split_type").Optional().MaxLen(20).Comment("Empty if not split between members"),
+		field.JSON("split_shares", []SplitShare{}).Optional(),
 		field.Time("created_at").Immutable().Default(timeNow),
 		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
+		field.Time("deleted_at").Optional().Nillable().Comment("Set while the record is in the trash"),
 	}
 }
 
@@ -29,6 +34,13 @@ func (RecurringExpense) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("household", Household.Type).Ref("recurring_expenses").Unique().Required(),
 		edge.From("category", Category.Type).Ref("recurring_expenses").Unique().Required(),
+		edge.From("payer", HouseholdMember.Type).Ref("paid_recurring_expenses").Unique(),
 		edge.To("schedule_overrides", RecurringScheduleOverride.Type),
 	}
 }
+
+func (RecurringExpense) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
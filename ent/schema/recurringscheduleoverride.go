@@ -13,7 +13,7 @@ type RecurringScheduleOverride struct {
 func (RecurringScheduleOverride) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("effective_date"),
-		field.String("amount").NotEmpty(),
+		field.Int64("amount").Comment("Minor units (cents)"),
 		field.String("frequency").NotEmpty(),
 		field.Time("created_at").Immutable().Default(timeNow),
 		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
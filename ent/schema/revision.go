@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Revision records one create, update or delete of a household, category,
+// transaction, recurring expense or schedule override with the field values
+// before and after. Like security events it keeps plain IDs without edges,
+// so revisions outlive what they describe.
+type Revision struct {
+	ent.Schema
+}
+
+func (Revision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("entity_type").NotEmpty().MaxLen(32).Immutable(),
+		field.Int("entity_id").Immutable(),
+		field.Int("household_id").Immutable(),
+		field.String("action").NotEmpty().MaxLen(16).Immutable(),
+		field.Int("user_id").Optional().Nillable().Comment("Nil for changes made outside of requests and once the user is deleted"),
+		field.JSON("before", map[string]string{}).Optional().Immutable(),
+		field.JSON("after", map[string]string{}).Optional().Immutable(),
+		field.Time("created_at").Immutable().Default(timeNow),
+	}
+}
+
+func (Revision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("household_id", "entity_type", "entity_id"),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SecurityEvent is an entry of the append-only security log. It keeps the
+// plain user ID without an edge, so events outlive the user.
+type SecurityEvent struct {
+	ent.Schema
+}
+
+func (SecurityEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").NotEmpty().MaxLen(32).Immutable(),
+		field.Int("user_id").Optional().Nillable().Immutable(),
+		field.String("ip").MaxLen(64).Default("").Immutable(),
+		field.String("user_agent").MaxLen(512).Default("").Immutable(),
+		field.JSON("details", map[string]string{}).Optional().Immutable(),
+		field.Time("created_at").Immutable().Default(timeNow),
+	}
+}
+
+func (SecurityEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("created_at"),
+	}
+}
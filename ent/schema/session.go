@@ -16,6 +16,14 @@ func (Session) Fields() []ent.Field {
 		field.Bytes("data"),
 		field.Int("user_id").Optional().Nillable(),
 		field.Time("expires_at"),
+		field.String("user_agent").MaxLen(512).Default(""),
+		field.String("ip").MaxLen(64).Default(""),
+		field.Time("last_seen_at").Optional().Nillable(),
+		// Issuer, subject and session ID at the OIDC provider, for
+		// back-channel logout
+		field.String("oidc_issuer").MaxLen(255).Default(""),
+		field.String("oidc_subject").MaxLen(255).Default(""),
+		field.String("oidc_sid").MaxLen(255).Default(""),
 		field.Time("created_at").Immutable().Default(timeNow),
 		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
 	}
@@ -24,5 +32,8 @@ func (Session) Fields() []ent.Field {
 func (Session) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("expires_at"),
+		index.Fields("user_id"),
+		index.Fields("oidc_subject"),
+		index.Fields("oidc_sid"),
 	}
 }
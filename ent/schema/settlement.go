@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Settlement records a payment between two household members that settles
+// shared costs. It moves money between members only and is not part of the
+// household's income or expenses.
+type Settlement struct {
+	ent.Schema
+}
+
+func (Settlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("amount").Positive().Comment("Minor units (cents)"),
+		field.Time("date"),
+		field.String("note").Optional().MaxLen(500).Default(""),
+		field.Time("created_at").Immutable().Default(timeNow),
+	}
+}
+
+func (Settlement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("household", Household.Type).Ref("settlements").Unique().Required(),
+		edge.From("from", HouseholdMember.Type).Ref("settlements_paid").Unique().Required(),
+		edge.From("to", HouseholdMember.Type).Ref("settlements_received").Unique().Required(),
+	}
+}
+
+func (Settlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("household").Fields("date"),
+	}
+}
@@ -0,0 +1,9 @@
+package schema
+
+// SplitShare is one member's part of a split transaction or recurring
+// expense. Value is in minor units for fixed splits and in hundredths of a
+// percent for percentage splits; equal splits only use MemberID.
+type SplitShare struct {
+	MemberID int   `json:"member_id"`
+	Value    int64 `json:"value"`
+}
@@ -13,12 +13,16 @@ type Transaction struct {
 
 func (Transaction) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("amount").NotEmpty(),
+		field.Int64("amount").Comment("Minor units (cents)"),
 		field.String("description").Optional().MaxLen(500),
 		field.String("details").Optional().MaxLen(5000),
 		field.Time("date"),
+		field.String("tax_class").Optional().MaxLen(50),
+		field.String("split_type").Optional().MaxLen(20).Comment("Empty if not split between members"),
+		field.JSON("split_shares", []SplitShare{}).Optional(),
 		field.Time("created_at").Immutable().Default(timeNow),
 		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
+		field.Time("deleted_at").Optional().Nillable().Comment("Set while the record is in the trash"),
 	}
 }
 
@@ -26,11 +30,13 @@ func (Transaction) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("household", Household.Type).Ref("transactions").Unique().Required(),
 		edge.From("category", Category.Type).Ref("transactions").Unique().Required(),
+		edge.From("payer", HouseholdMember.Type).Ref("paid_transactions").Unique(),
 	}
 }
 
 func (Transaction) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Edges("household").Fields("date"),
+		index.Fields("deleted_at"),
 	}
 }
@@ -14,7 +14,9 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("email").NotEmpty().Unique(),
 		field.String("name").NotEmpty(),
-		field.String("subject").NotEmpty().Unique(),
+		field.String("subject").NotEmpty().Unique().Comment("Internal login key; OIDC logins are linked through identities"),
+		field.Bool("admin").Default(false).Comment("Instance admin"),
+		field.Time("disabled_at").Optional().Nillable().Comment("Disabled users can't log in"),
 		field.Time("created_at").Immutable().Default(timeNow),
 		field.Time("updated_at").Default(timeNow).UpdateDefault(timeNow),
 	}
@@ -24,5 +26,8 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("households", Household.Type),
 		edge.To("api_tokens", APIToken.Type),
+		edge.To("local_credential", LocalCredential.Type).Unique(),
+		edge.To("identities", UserIdentity.Type),
+		edge.To("household_transfers", HouseholdTransfer.Type),
 	}
 }
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity links a user to their account at an OIDC provider. Users are
+// found by issuer and subject, so subjects of different providers can't
+// collide.
+type UserIdentity struct {
+	ent.Schema
+}
+
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("issuer").NotEmpty(),
+		field.String("subject").NotEmpty(),
+		field.String("email").Default("").Comment("Email the provider sent when the identity was linked"),
+		field.Time("created_at").Immutable().Default(timeNow),
+	}
+}
+
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("identities").Unique().Required(),
+	}
+}
+
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("issuer", "subject").Unique(),
+	}
+}
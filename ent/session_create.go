@@ -52,6 +52,90 @@ func (_c *SessionCreate) SetExpiresAt(v time.Time) *SessionCreate {
 	return _c
 }
 
+// SetUserAgent sets the "user_agent" field.
+func (_c *SessionCreate) SetUserAgent(v string) *SessionCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableUserAgent(v *string) *SessionCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *SessionCreate) SetIP(v string) *SessionCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableIP(v *string) *SessionCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *SessionCreate) SetLastSeenAt(v time.Time) *SessionCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableLastSeenAt(v *time.Time) *SessionCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_c *SessionCreate) SetOidcIssuer(v string) *SessionCreate {
+	_c.mutation.SetOidcIssuer(v)
+	return _c
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableOidcIssuer(v *string) *SessionCreate {
+	if v != nil {
+		_c.SetOidcIssuer(*v)
+	}
+	return _c
+}
+
+// SetOidcSubject sets the "oidc_subject" field.
+func (_c *SessionCreate) SetOidcSubject(v string) *SessionCreate {
+	_c.mutation.SetOidcSubject(v)
+	return _c
+}
+
+// SetNillableOidcSubject sets the "oidc_subject" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableOidcSubject(v *string) *SessionCreate {
+	if v != nil {
+		_c.SetOidcSubject(*v)
+	}
+	return _c
+}
+
+// SetOidcSid sets the "oidc_sid" field.
+func (_c *SessionCreate) SetOidcSid(v string) *SessionCreate {
+	_c.mutation.SetOidcSid(v)
+	return _c
+}
+
+// SetNillableOidcSid sets the "oidc_sid" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableOidcSid(v *string) *SessionCreate {
+	if v != nil {
+		_c.SetOidcSid(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SessionCreate) SetCreatedAt(v time.Time) *SessionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -115,6 +199,26 @@ func (_c *SessionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *SessionCreate) defaults() {
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := session.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		v := session.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.OidcIssuer(); !ok {
+		v := session.DefaultOidcIssuer
+		_c.mutation.SetOidcIssuer(v)
+	}
+	if _, ok := _c.mutation.OidcSubject(); !ok {
+		v := session.DefaultOidcSubject
+		_c.mutation.SetOidcSubject(v)
+	}
+	if _, ok := _c.mutation.OidcSid(); !ok {
+		v := session.DefaultOidcSid
+		_c.mutation.SetOidcSid(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := session.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -141,6 +245,46 @@ func (_c *SessionCreate) check() error {
 	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Session.expires_at"`)}
 	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "Session.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := session.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Session.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "Session.ip"`)}
+	}
+	if v, ok := _c.mutation.IP(); ok {
+		if err := session.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Session.ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OidcIssuer(); !ok {
+		return &ValidationError{Name: "oidc_issuer", err: errors.New(`ent: missing required field "Session.oidc_issuer"`)}
+	}
+	if v, ok := _c.mutation.OidcIssuer(); ok {
+		if err := session.OidcIssuerValidator(v); err != nil {
+			return &ValidationError{Name: "oidc_issuer", err: fmt.Errorf(`ent: validator failed for field "Session.oidc_issuer": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OidcSubject(); !ok {
+		return &ValidationError{Name: "oidc_subject", err: errors.New(`ent: missing required field "Session.oidc_subject"`)}
+	}
+	if v, ok := _c.mutation.OidcSubject(); ok {
+		if err := session.OidcSubjectValidator(v); err != nil {
+			return &ValidationError{Name: "oidc_subject", err: fmt.Errorf(`ent: validator failed for field "Session.oidc_subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OidcSid(); !ok {
+		return &ValidationError{Name: "oidc_sid", err: errors.New(`ent: missing required field "Session.oidc_sid"`)}
+	}
+	if v, ok := _c.mutation.OidcSid(); ok {
+		if err := session.OidcSidValidator(v); err != nil {
+			return &ValidationError{Name: "oidc_sid", err: fmt.Errorf(`ent: validator failed for field "Session.oidc_sid": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Session.created_at"`)}
 	}
@@ -189,6 +333,30 @@ func (_c *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(session.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(session.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := _c.mutation.OidcIssuer(); ok {
+		_spec.SetField(session.FieldOidcIssuer, field.TypeString, value)
+		_node.OidcIssuer = value
+	}
+	if value, ok := _c.mutation.OidcSubject(); ok {
+		_spec.SetField(session.FieldOidcSubject, field.TypeString, value)
+		_node.OidcSubject = value
+	}
+	if value, ok := _c.mutation.OidcSid(); ok {
+		_spec.SetField(session.FieldOidcSid, field.TypeString, value)
+		_node.OidcSid = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(session.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/settlement"
+)
+
+// Settlement is the model entity for the Settlement schema.
+type Settlement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Minor units (cents)
+	Amount int64 `json:"amount,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SettlementQuery when eager-loading is set.
+	Edges                                 SettlementEdges `json:"edges"`
+	household_settlements                 *int
+	household_member_settlements_paid     *int
+	household_member_settlements_received *int
+	selectValues                          sql.SelectValues
+}
+
+// SettlementEdges holds the relations/edges for other nodes in the graph.
+type SettlementEdges struct {
+	// Household holds the value of the household edge.
+	Household *Household `json:"household,omitempty"`
+	// From holds the value of the from edge.
+	From *HouseholdMember `json:"from,omitempty"`
+	// To holds the value of the to edge.
+	To *HouseholdMember `json:"to,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// HouseholdOrErr returns the Household value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) HouseholdOrErr() (*Household, error) {
+	if e.Household != nil {
+		return e.Household, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: household.Label}
+	}
+	return nil, &NotLoadedError{edge: "household"}
+}
+
+// FromOrErr returns the From value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) FromOrErr() (*HouseholdMember, error) {
+	if e.From != nil {
+		return e.From, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: householdmember.Label}
+	}
+	return nil, &NotLoadedError{edge: "from"}
+}
+
+// ToOrErr returns the To value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) ToOrErr() (*HouseholdMember, error) {
+	if e.To != nil {
+		return e.To, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: householdmember.Label}
+	}
+	return nil, &NotLoadedError{edge: "to"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Settlement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlement.FieldID, settlement.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case settlement.FieldNote:
+			values[i] = new(sql.NullString)
+		case settlement.FieldDate, settlement.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case settlement.ForeignKeys[0]: // household_settlements
+			values[i] = new(sql.NullInt64)
+		case settlement.ForeignKeys[1]: // household_member_settlements_paid
+			values[i] = new(sql.NullInt64)
+		case settlement.ForeignKeys[2]: // household_member_settlements_received
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Settlement fields.
+func (_m *Settlement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case settlement.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case settlement.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case settlement.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case settlement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case settlement.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_settlements", value)
+			} else if value.Valid {
+				_m.household_settlements = new(int)
+				*_m.household_settlements = int(value.Int64)
+			}
+		case settlement.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_member_settlements_paid", value)
+			} else if value.Valid {
+				_m.household_member_settlements_paid = new(int)
+				*_m.household_member_settlements_paid = int(value.Int64)
+			}
+		case settlement.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_member_settlements_received", value)
+			} else if value.Valid {
+				_m.household_member_settlements_received = new(int)
+				*_m.household_member_settlements_received = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Settlement.
+// This includes values selected through modifiers, order, etc.
+func (_m *Settlement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHousehold queries the "household" edge of the Settlement entity.
+func (_m *Settlement) QueryHousehold() *HouseholdQuery {
+	return NewSettlementClient(_m.config).QueryHousehold(_m)
+}
+
+// QueryFrom queries the "from" edge of the Settlement entity.
+func (_m *Settlement) QueryFrom() *HouseholdMemberQuery {
+	return NewSettlementClient(_m.config).QueryFrom(_m)
+}
+
+// QueryTo queries the "to" edge of the Settlement entity.
+func (_m *Settlement) QueryTo() *HouseholdMemberQuery {
+	return NewSettlementClient(_m.config).QueryTo(_m)
+}
+
+// Update returns a builder for updating this Settlement.
+// Note that you need to call Settlement.Unwrap() before calling this method if this Settlement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Settlement) Update() *SettlementUpdateOne {
+	return NewSettlementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Settlement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Settlement) Unwrap() *Settlement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Settlement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Settlement) String() string {
+	var builder strings.Builder
+	builder.WriteString("Settlement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Settlements is a parsable slice of Settlement.
+type Settlements []*Settlement
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the settlement type in the database.
+	Label = "settlement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeHousehold holds the string denoting the household edge name in mutations.
+	EdgeHousehold = "household"
+	// EdgeFrom holds the string denoting the from edge name in mutations.
+	EdgeFrom = "from"
+	// EdgeTo holds the string denoting the to edge name in mutations.
+	EdgeTo = "to"
+	// Table holds the table name of the settlement in the database.
+	Table = "settlements"
+	// HouseholdTable is the table that holds the household relation/edge.
+	HouseholdTable = "settlements"
+	// HouseholdInverseTable is the table name for the Household entity.
+	// It exists in this package in order to avoid circular dependency with the "household" package.
+	HouseholdInverseTable = "households"
+	// HouseholdColumn is the table column denoting the household relation/edge.
+	HouseholdColumn = "household_settlements"
+	// FromTable is the table that holds the from relation/edge.
+	FromTable = "settlements"
+	// FromInverseTable is the table name for the HouseholdMember entity.
+	// It exists in this package in order to avoid circular dependency with the "householdmember" package.
+	FromInverseTable = "household_members"
+	// FromColumn is the table column denoting the from relation/edge.
+	FromColumn = "household_member_settlements_paid"
+	// ToTable is the table that holds the to relation/edge.
+	ToTable = "settlements"
+	// ToInverseTable is the table name for the HouseholdMember entity.
+	// It exists in this package in order to avoid circular dependency with the "householdmember" package.
+	ToInverseTable = "household_members"
+	// ToColumn is the table column denoting the to relation/edge.
+	ToColumn = "household_member_settlements_received"
+)
+
+// Columns holds all SQL columns for settlement fields.
+var Columns = []string{
+	FieldID,
+	FieldAmount,
+	FieldDate,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "settlements"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"household_settlements",
+	"household_member_settlements_paid",
+	"household_member_settlements_received",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int64) error
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Settlement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByHouseholdField orders the results by household field.
+func ByHouseholdField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHouseholdStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFromField orders the results by from field.
+func ByFromField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFromStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByToField orders the results by to field.
+func ByToField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newToStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHouseholdStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HouseholdInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HouseholdTable, HouseholdColumn),
+	)
+}
+func newFromStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FromInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FromTable, FromColumn),
+	)
+}
+func newToStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ToInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ToTable, ToColumn),
+	)
+}
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/settlement"
+)
+
+// SettlementCreate is the builder for creating a Settlement entity.
+type SettlementCreate struct {
+	config
+	mutation *SettlementMutation
+	hooks    []Hook
+}
+
+// SetAmount sets the "amount" field.
+func (_c *SettlementCreate) SetAmount(v int64) *SettlementCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *SettlementCreate) SetDate(v time.Time) *SettlementCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *SettlementCreate) SetNote(v string) *SettlementCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *SettlementCreate) SetNillableNote(v *string) *SettlementCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SettlementCreate) SetCreatedAt(v time.Time) *SettlementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SettlementCreate) SetNillableCreatedAt(v *time.Time) *SettlementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_c *SettlementCreate) SetHouseholdID(id int) *SettlementCreate {
+	_c.mutation.SetHouseholdID(id)
+	return _c
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_c *SettlementCreate) SetHousehold(v *Household) *SettlementCreate {
+	return _c.SetHouseholdID(v.ID)
+}
+
+// SetFromID sets the "from" edge to the HouseholdMember entity by ID.
+func (_c *SettlementCreate) SetFromID(id int) *SettlementCreate {
+	_c.mutation.SetFromID(id)
+	return _c
+}
+
+// SetFrom sets the "from" edge to the HouseholdMember entity.
+func (_c *SettlementCreate) SetFrom(v *HouseholdMember) *SettlementCreate {
+	return _c.SetFromID(v.ID)
+}
+
+// SetToID sets the "to" edge to the HouseholdMember entity by ID.
+func (_c *SettlementCreate) SetToID(id int) *SettlementCreate {
+	_c.mutation.SetToID(id)
+	return _c
+}
+
+// SetTo sets the "to" edge to the HouseholdMember entity.
+func (_c *SettlementCreate) SetTo(v *HouseholdMember) *SettlementCreate {
+	return _c.SetToID(v.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (_c *SettlementCreate) Mutation() *SettlementMutation {
+	return _c.mutation
+}
+
+// Save creates the Settlement in the database.
+func (_c *SettlementCreate) Save(ctx context.Context) (*Settlement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SettlementCreate) SaveX(ctx context.Context) *Settlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SettlementCreate) defaults() {
+	if _, ok := _c.mutation.Note(); !ok {
+		v := settlement.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := settlement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SettlementCreate) check() error {
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Settlement.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := settlement.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Settlement.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Settlement.date"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := settlement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Settlement.note": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Settlement.created_at"`)}
+	}
+	if len(_c.mutation.HouseholdIDs()) == 0 {
+		return &ValidationError{Name: "household", err: errors.New(`ent: missing required edge "Settlement.household"`)}
+	}
+	if len(_c.mutation.FromIDs()) == 0 {
+		return &ValidationError{Name: "from", err: errors.New(`ent: missing required edge "Settlement.from"`)}
+	}
+	if len(_c.mutation.ToIDs()) == 0 {
+		return &ValidationError{Name: "to", err: errors.New(`ent: missing required edge "Settlement.to"`)}
+	}
+	return nil
+}
+
+func (_c *SettlementCreate) sqlSave(ctx context.Context) (*Settlement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SettlementCreate) createSpec() (*Settlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Settlement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(settlement.Table, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(settlement.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(settlement.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(settlement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.HouseholdTable,
+			Columns: []string{settlement.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_settlements = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.FromTable,
+			Columns: []string{settlement.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_member_settlements_paid = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.ToTable,
+			Columns: []string{settlement.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_member_settlements_received = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SettlementCreateBulk is the builder for creating many Settlement entities in bulk.
+type SettlementCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementCreate
+}
+
+// Save creates the Settlement entities in the database.
+func (_c *SettlementCreateBulk) Save(ctx context.Context) ([]*Settlement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Settlement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SettlementCreateBulk) SaveX(ctx context.Context) []*Settlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SettlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SettlementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
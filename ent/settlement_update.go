@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/settlement"
+)
+
+// SettlementUpdate is the builder for updating Settlement entities.
+type SettlementUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SettlementMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SettlementUpdate builder.
+func (_u *SettlementUpdate) Where(ps ...predicate.Settlement) *SettlementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SettlementUpdate) SetAmount(v int64) *SettlementUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableAmount(v *int64) *SettlementUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SettlementUpdate) AddAmount(v int64) *SettlementUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *SettlementUpdate) SetDate(v time.Time) *SettlementUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableDate(v *time.Time) *SettlementUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *SettlementUpdate) SetNote(v string) *SettlementUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *SettlementUpdate) SetNillableNote(v *string) *SettlementUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *SettlementUpdate) ClearNote() *SettlementUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *SettlementUpdate) SetHouseholdID(id int) *SettlementUpdate {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *SettlementUpdate) SetHousehold(v *Household) *SettlementUpdate {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// SetFromID sets the "from" edge to the HouseholdMember entity by ID.
+func (_u *SettlementUpdate) SetFromID(id int) *SettlementUpdate {
+	_u.mutation.SetFromID(id)
+	return _u
+}
+
+// SetFrom sets the "from" edge to the HouseholdMember entity.
+func (_u *SettlementUpdate) SetFrom(v *HouseholdMember) *SettlementUpdate {
+	return _u.SetFromID(v.ID)
+}
+
+// SetToID sets the "to" edge to the HouseholdMember entity by ID.
+func (_u *SettlementUpdate) SetToID(id int) *SettlementUpdate {
+	_u.mutation.SetToID(id)
+	return _u
+}
+
+// SetTo sets the "to" edge to the HouseholdMember entity.
+func (_u *SettlementUpdate) SetTo(v *HouseholdMember) *SettlementUpdate {
+	return _u.SetToID(v.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (_u *SettlementUpdate) Mutation() *SettlementMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *SettlementUpdate) ClearHousehold() *SettlementUpdate {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// ClearFrom clears the "from" edge to the HouseholdMember entity.
+func (_u *SettlementUpdate) ClearFrom() *SettlementUpdate {
+	_u.mutation.ClearFrom()
+	return _u
+}
+
+// ClearTo clears the "to" edge to the HouseholdMember entity.
+func (_u *SettlementUpdate) ClearTo() *SettlementUpdate {
+	_u.mutation.ClearTo()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SettlementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SettlementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementUpdate) check() error {
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := settlement.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Settlement.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := settlement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Settlement.note": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.household"`)
+	}
+	if _u.mutation.FromCleared() && len(_u.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.from"`)
+	}
+	if _u.mutation.ToCleared() && len(_u.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.to"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SettlementUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SettlementUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SettlementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(settlement.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(settlement.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(settlement.FieldNote, field.TypeString)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.HouseholdTable,
+			Columns: []string{settlement.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.HouseholdTable,
+			Columns: []string{settlement.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.FromTable,
+			Columns: []string{settlement.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.FromTable,
+			Columns: []string{settlement.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.ToTable,
+			Columns: []string{settlement.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.ToTable,
+			Columns: []string{settlement.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SettlementUpdateOne is the builder for updating a single Settlement entity.
+type SettlementUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SettlementMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SettlementUpdateOne) SetAmount(v int64) *SettlementUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableAmount(v *int64) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SettlementUpdateOne) AddAmount(v int64) *SettlementUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *SettlementUpdateOne) SetDate(v time.Time) *SettlementUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableDate(v *time.Time) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *SettlementUpdateOne) SetNote(v string) *SettlementUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *SettlementUpdateOne) SetNillableNote(v *string) *SettlementUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *SettlementUpdateOne) ClearNote() *SettlementUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetHouseholdID sets the "household" edge to the Household entity by ID.
+func (_u *SettlementUpdateOne) SetHouseholdID(id int) *SettlementUpdateOne {
+	_u.mutation.SetHouseholdID(id)
+	return _u
+}
+
+// SetHousehold sets the "household" edge to the Household entity.
+func (_u *SettlementUpdateOne) SetHousehold(v *Household) *SettlementUpdateOne {
+	return _u.SetHouseholdID(v.ID)
+}
+
+// SetFromID sets the "from" edge to the HouseholdMember entity by ID.
+func (_u *SettlementUpdateOne) SetFromID(id int) *SettlementUpdateOne {
+	_u.mutation.SetFromID(id)
+	return _u
+}
+
+// SetFrom sets the "from" edge to the HouseholdMember entity.
+func (_u *SettlementUpdateOne) SetFrom(v *HouseholdMember) *SettlementUpdateOne {
+	return _u.SetFromID(v.ID)
+}
+
+// SetToID sets the "to" edge to the HouseholdMember entity by ID.
+func (_u *SettlementUpdateOne) SetToID(id int) *SettlementUpdateOne {
+	_u.mutation.SetToID(id)
+	return _u
+}
+
+// SetTo sets the "to" edge to the HouseholdMember entity.
+func (_u *SettlementUpdateOne) SetTo(v *HouseholdMember) *SettlementUpdateOne {
+	return _u.SetToID(v.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (_u *SettlementUpdateOne) Mutation() *SettlementMutation {
+	return _u.mutation
+}
+
+// ClearHousehold clears the "household" edge to the Household entity.
+func (_u *SettlementUpdateOne) ClearHousehold() *SettlementUpdateOne {
+	_u.mutation.ClearHousehold()
+	return _u
+}
+
+// ClearFrom clears the "from" edge to the HouseholdMember entity.
+func (_u *SettlementUpdateOne) ClearFrom() *SettlementUpdateOne {
+	_u.mutation.ClearFrom()
+	return _u
+}
+
+// ClearTo clears the "to" edge to the HouseholdMember entity.
+func (_u *SettlementUpdateOne) ClearTo() *SettlementUpdateOne {
+	_u.mutation.ClearTo()
+	return _u
+}
+
+// Where appends a list predicates to the SettlementUpdate builder.
+func (_u *SettlementUpdateOne) Where(ps ...predicate.Settlement) *SettlementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SettlementUpdateOne) Select(field string, fields ...string) *SettlementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Settlement entity.
+func (_u *SettlementUpdateOne) Save(ctx context.Context) (*Settlement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SettlementUpdateOne) SaveX(ctx context.Context) *Settlement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SettlementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SettlementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SettlementUpdateOne) check() error {
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := settlement.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Settlement.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := settlement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "Settlement.note": %w`, err)}
+		}
+	}
+	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.household"`)
+	}
+	if _u.mutation.FromCleared() && len(_u.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.from"`)
+	}
+	if _u.mutation.ToCleared() && len(_u.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.to"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SettlementUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SettlementUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SettlementUpdateOne) sqlSave(ctx context.Context) (_node *Settlement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Settlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlement.FieldID)
+		for _, f := range fields {
+			if !settlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(settlement.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(settlement.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(settlement.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(settlement.FieldNote, field.TypeString)
+	}
+	if _u.mutation.HouseholdCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.HouseholdTable,
+			Columns: []string{settlement.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HouseholdIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.HouseholdTable,
+			Columns: []string{settlement.HouseholdColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(household.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.FromTable,
+			Columns: []string{settlement.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.FromTable,
+			Columns: []string{settlement.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.ToTable,
+			Columns: []string{settlement.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.ToTable,
+			Columns: []string{settlement.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Settlement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
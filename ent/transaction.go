@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/schema"
 	"icekalt.dev/money-tracker/ent/transaction"
 )
 
@@ -19,24 +22,33 @@ type Transaction struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// Amount holds the value of the "amount" field.
-	Amount string `json:"amount,omitempty"`
+	// Minor units (cents)
+	Amount int64 `json:"amount,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// Details holds the value of the "details" field.
 	Details string `json:"details,omitempty"`
 	// Date holds the value of the "date" field.
 	Date time.Time `json:"date,omitempty"`
+	// TaxClass holds the value of the "tax_class" field.
+	TaxClass string `json:"tax_class,omitempty"`
+	// Empty if not split between members
+	SplitType string `json:"split_type,omitempty"`
+	// SplitShares holds the value of the "split_shares" field.
+	SplitShares []schema.SplitShare `json:"split_shares,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set while the record is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransactionQuery when eager-loading is set.
-	Edges                  TransactionEdges `json:"edges"`
-	category_transactions  *int
-	household_transactions *int
-	selectValues           sql.SelectValues
+	Edges                              TransactionEdges `json:"edges"`
+	category_transactions              *int
+	household_transactions             *int
+	household_member_paid_transactions *int
+	selectValues                       sql.SelectValues
 }
 
 // TransactionEdges holds the relations/edges for other nodes in the graph.
@@ -45,9 +57,11 @@ type TransactionEdges struct {
 	Household *Household `json:"household,omitempty"`
 	// Category holds the value of the category edge.
 	Category *Category `json:"category,omitempty"`
+	// Payer holds the value of the payer edge.
+	Payer *HouseholdMember `json:"payer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // HouseholdOrErr returns the Household value or an error if the edge
@@ -72,21 +86,36 @@ func (e TransactionEdges) CategoryOrErr() (*Category, error) {
 	return nil, &NotLoadedError{edge: "category"}
 }
 
+// PayerOrErr returns the Payer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) PayerOrErr() (*HouseholdMember, error) {
+	if e.Payer != nil {
+		return e.Payer, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: householdmember.Label}
+	}
+	return nil, &NotLoadedError{edge: "payer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldID:
+		case transaction.FieldSplitShares:
+			values[i] = new([]byte)
+		case transaction.FieldID, transaction.FieldAmount:
 			values[i] = new(sql.NullInt64)
-		case transaction.FieldAmount, transaction.FieldDescription, transaction.FieldDetails:
+		case transaction.FieldDescription, transaction.FieldDetails, transaction.FieldTaxClass, transaction.FieldSplitType:
 			values[i] = new(sql.NullString)
-		case transaction.FieldDate, transaction.FieldCreatedAt, transaction.FieldUpdatedAt:
+		case transaction.FieldDate, transaction.FieldCreatedAt, transaction.FieldUpdatedAt, transaction.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case transaction.ForeignKeys[0]: // category_transactions
 			values[i] = new(sql.NullInt64)
 		case transaction.ForeignKeys[1]: // household_transactions
 			values[i] = new(sql.NullInt64)
+		case transaction.ForeignKeys[2]: // household_member_paid_transactions
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -109,10 +138,10 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			}
 			_m.ID = int(value.Int64)
 		case transaction.FieldAmount:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
 			} else if value.Valid {
-				_m.Amount = value.String
+				_m.Amount = value.Int64
 			}
 		case transaction.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -132,6 +161,26 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Date = value.Time
 			}
+		case transaction.FieldTaxClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_class", values[i])
+			} else if value.Valid {
+				_m.TaxClass = value.String
+			}
+		case transaction.FieldSplitType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field split_type", values[i])
+			} else if value.Valid {
+				_m.SplitType = value.String
+			}
+		case transaction.FieldSplitShares:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field split_shares", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SplitShares); err != nil {
+					return fmt.Errorf("unmarshal field split_shares: %w", err)
+				}
+			}
 		case transaction.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -144,6 +193,13 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case transaction.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case transaction.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field category_transactions", value)
@@ -158,6 +214,13 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 				_m.household_transactions = new(int)
 				*_m.household_transactions = int(value.Int64)
 			}
+		case transaction.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field household_member_paid_transactions", value)
+			} else if value.Valid {
+				_m.household_member_paid_transactions = new(int)
+				*_m.household_member_paid_transactions = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -181,6 +244,11 @@ func (_m *Transaction) QueryCategory() *CategoryQuery {
 	return NewTransactionClient(_m.config).QueryCategory(_m)
 }
 
+// QueryPayer queries the "payer" edge of the Transaction entity.
+func (_m *Transaction) QueryPayer() *HouseholdMemberQuery {
+	return NewTransactionClient(_m.config).QueryPayer(_m)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -205,7 +273,7 @@ func (_m *Transaction) String() string {
 	builder.WriteString("Transaction(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("amount=")
-	builder.WriteString(_m.Amount)
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
@@ -216,11 +284,25 @@ func (_m *Transaction) String() string {
 	builder.WriteString("date=")
 	builder.WriteString(_m.Date.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("tax_class=")
+	builder.WriteString(_m.TaxClass)
+	builder.WriteString(", ")
+	builder.WriteString("split_type=")
+	builder.WriteString(_m.SplitType)
+	builder.WriteString(", ")
+	builder.WriteString("split_shares=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SplitShares))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,14 +22,24 @@ const (
 	FieldDetails = "details"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
+	// FieldTaxClass holds the string denoting the tax_class field in the database.
+	FieldTaxClass = "tax_class"
+	// FieldSplitType holds the string denoting the split_type field in the database.
+	FieldSplitType = "split_type"
+	// FieldSplitShares holds the string denoting the split_shares field in the database.
+	FieldSplitShares = "split_shares"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeHousehold holds the string denoting the household edge name in mutations.
 	EdgeHousehold = "household"
 	// EdgeCategory holds the string denoting the category edge name in mutations.
 	EdgeCategory = "category"
+	// EdgePayer holds the string denoting the payer edge name in mutations.
+	EdgePayer = "payer"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 	// HouseholdTable is the table that holds the household relation/edge.
@@ -46,6 +56,13 @@ const (
 	CategoryInverseTable = "categories"
 	// CategoryColumn is the table column denoting the category relation/edge.
 	CategoryColumn = "category_transactions"
+	// PayerTable is the table that holds the payer relation/edge.
+	PayerTable = "transactions"
+	// PayerInverseTable is the table name for the HouseholdMember entity.
+	// It exists in this package in order to avoid circular dependency with the "householdmember" package.
+	PayerInverseTable = "household_members"
+	// PayerColumn is the table column denoting the payer relation/edge.
+	PayerColumn = "household_member_paid_transactions"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -55,8 +72,12 @@ var Columns = []string{
 	FieldDescription,
 	FieldDetails,
 	FieldDate,
+	FieldTaxClass,
+	FieldSplitType,
+	FieldSplitShares,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "transactions"
@@ -64,6 +85,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"category_transactions",
 	"household_transactions",
+	"household_member_paid_transactions",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -82,12 +104,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
-	AmountValidator func(string) error
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
 	// DetailsValidator is a validator for the "details" field. It is called by the builders before save.
 	DetailsValidator func(string) error
+	// TaxClassValidator is a validator for the "tax_class" field. It is called by the builders before save.
+	TaxClassValidator func(string) error
+	// SplitTypeValidator is a validator for the "split_type" field. It is called by the builders before save.
+	SplitTypeValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -124,6 +148,16 @@ func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
 }
 
+// ByTaxClass orders the results by the tax_class field.
+func ByTaxClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxClass, opts...).ToFunc()
+}
+
+// BySplitType orders the results by the split_type field.
+func BySplitType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitType, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -134,6 +168,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByHouseholdField orders the results by household field.
 func ByHouseholdField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -147,6 +186,13 @@ func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPayerField orders the results by payer field.
+func ByPayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPayerStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newHouseholdStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -161,3 +207,10 @@ func newCategoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
 	)
 }
+func newPayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PayerTable, PayerColumn),
+	)
+}
@@ -56,7 +56,7 @@ func IDLTE(id int) predicate.Transaction {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v string) predicate.Transaction {
+func Amount(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
@@ -75,6 +75,16 @@ func Date(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDate, v))
 }
 
+// TaxClass applies equality check predicate on the "tax_class" field. It's identical to TaxClassEQ.
+func TaxClass(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTaxClass, v))
+}
+
+// SplitType applies equality check predicate on the "split_type" field. It's identical to SplitTypeEQ.
+func SplitType(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldSplitType, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -85,71 +95,51 @@ func UpdatedAt(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v string) predicate.Transaction {
+func AmountEQ(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v string) predicate.Transaction {
+func AmountNEQ(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNEQ(FieldAmount, v))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...string) predicate.Transaction {
+func AmountIn(vs ...int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldIn(FieldAmount, vs...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...string) predicate.Transaction {
+func AmountNotIn(vs ...int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldAmount, vs...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v string) predicate.Transaction {
+func AmountGT(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldGT(FieldAmount, v))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v string) predicate.Transaction {
+func AmountGTE(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldGTE(FieldAmount, v))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v string) predicate.Transaction {
+func AmountLT(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLT(FieldAmount, v))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v string) predicate.Transaction {
+func AmountLTE(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldAmount, v))
 }
 
-// AmountContains applies the Contains predicate on the "amount" field.
-func AmountContains(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldContains(FieldAmount, v))
-}
-
-// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
-func AmountHasPrefix(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldHasPrefix(FieldAmount, v))
-}
-
-// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
-func AmountHasSuffix(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldHasSuffix(FieldAmount, v))
-}
-
-// AmountEqualFold applies the EqualFold predicate on the "amount" field.
-func AmountEqualFold(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEqualFold(FieldAmount, v))
-}
-
-// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
-func AmountContainsFold(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldContainsFold(FieldAmount, v))
-}
-
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
@@ -340,6 +330,166 @@ func DateLTE(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldDate, v))
 }
 
+// TaxClassEQ applies the EQ predicate on the "tax_class" field.
+func TaxClassEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTaxClass, v))
+}
+
+// TaxClassNEQ applies the NEQ predicate on the "tax_class" field.
+func TaxClassNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldTaxClass, v))
+}
+
+// TaxClassIn applies the In predicate on the "tax_class" field.
+func TaxClassIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldTaxClass, vs...))
+}
+
+// TaxClassNotIn applies the NotIn predicate on the "tax_class" field.
+func TaxClassNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldTaxClass, vs...))
+}
+
+// TaxClassGT applies the GT predicate on the "tax_class" field.
+func TaxClassGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldTaxClass, v))
+}
+
+// TaxClassGTE applies the GTE predicate on the "tax_class" field.
+func TaxClassGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldTaxClass, v))
+}
+
+// TaxClassLT applies the LT predicate on the "tax_class" field.
+func TaxClassLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldTaxClass, v))
+}
+
+// TaxClassLTE applies the LTE predicate on the "tax_class" field.
+func TaxClassLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldTaxClass, v))
+}
+
+// TaxClassContains applies the Contains predicate on the "tax_class" field.
+func TaxClassContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldTaxClass, v))
+}
+
+// TaxClassHasPrefix applies the HasPrefix predicate on the "tax_class" field.
+func TaxClassHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldTaxClass, v))
+}
+
+// TaxClassHasSuffix applies the HasSuffix predicate on the "tax_class" field.
+func TaxClassHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldTaxClass, v))
+}
+
+// TaxClassIsNil applies the IsNil predicate on the "tax_class" field.
+func TaxClassIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldTaxClass))
+}
+
+// TaxClassNotNil applies the NotNil predicate on the "tax_class" field.
+func TaxClassNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldTaxClass))
+}
+
+// TaxClassEqualFold applies the EqualFold predicate on the "tax_class" field.
+func TaxClassEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldTaxClass, v))
+}
+
+// TaxClassContainsFold applies the ContainsFold predicate on the "tax_class" field.
+func TaxClassContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldTaxClass, v))
+}
+
+// SplitTypeEQ applies the EQ predicate on the "split_type" field.
+func SplitTypeEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldSplitType, v))
+}
+
+// SplitTypeNEQ applies the NEQ predicate on the "split_type" field.
+func SplitTypeNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldSplitType, v))
+}
+
+// SplitTypeIn applies the In predicate on the "split_type" field.
+func SplitTypeIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldSplitType, vs...))
+}
+
+// SplitTypeNotIn applies the NotIn predicate on the "split_type" field.
+func SplitTypeNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldSplitType, vs...))
+}
+
+// SplitTypeGT applies the GT predicate on the "split_type" field.
+func SplitTypeGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldSplitType, v))
+}
+
+// SplitTypeGTE applies the GTE predicate on the "split_type" field.
+func SplitTypeGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldSplitType, v))
+}
+
+// SplitTypeLT applies the LT predicate on the "split_type" field.
+func SplitTypeLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldSplitType, v))
+}
+
+// SplitTypeLTE applies the LTE predicate on the "split_type" field.
+func SplitTypeLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldSplitType, v))
+}
+
+// SplitTypeContains applies the Contains predicate on the "split_type" field.
+func SplitTypeContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldSplitType, v))
+}
+
+// SplitTypeHasPrefix applies the HasPrefix predicate on the "split_type" field.
+func SplitTypeHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldSplitType, v))
+}
+
+// SplitTypeHasSuffix applies the HasSuffix predicate on the "split_type" field.
+func SplitTypeHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldSplitType, v))
+}
+
+// SplitTypeIsNil applies the IsNil predicate on the "split_type" field.
+func SplitTypeIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldSplitType))
+}
+
+// SplitTypeNotNil applies the NotNil predicate on the "split_type" field.
+func SplitTypeNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldSplitType))
+}
+
+// SplitTypeEqualFold applies the EqualFold predicate on the "split_type" field.
+func SplitTypeEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldSplitType, v))
+}
+
+// SplitTypeContainsFold applies the ContainsFold predicate on the "split_type" field.
+func SplitTypeContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldSplitType, v))
+}
+
+// SplitSharesIsNil applies the IsNil predicate on the "split_shares" field.
+func SplitSharesIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldSplitShares))
+}
+
+// SplitSharesNotNil applies the NotNil predicate on the "split_shares" field.
+func SplitSharesNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldSplitShares))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -420,6 +570,56 @@ func UpdatedAtLTE(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasHousehold applies the HasEdge predicate on the "household" edge.
 func HasHousehold() predicate.Transaction {
 	return predicate.Transaction(func(s *sql.Selector) {
@@ -466,6 +666,29 @@ func HasCategoryWith(preds ...predicate.Category) predicate.Transaction {
 	})
 }
 
+// HasPayer applies the HasEdge predicate on the "payer" edge.
+func HasPayer() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PayerTable, PayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPayerWith applies the HasEdge predicate on the "payer" edge with a given conditions (other predicates).
+func HasPayerWith(preds ...predicate.HouseholdMember) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newPayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Transaction) predicate.Transaction {
 	return predicate.Transaction(sql.AndPredicates(predicates...))
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
+	"icekalt.dev/money-tracker/ent/schema"
 	"icekalt.dev/money-tracker/ent/transaction"
 )
 
@@ -23,7 +25,7 @@ type TransactionCreate struct {
 }
 
 // SetAmount sets the "amount" field.
-func (_c *TransactionCreate) SetAmount(v string) *TransactionCreate {
+func (_c *TransactionCreate) SetAmount(v int64) *TransactionCreate {
 	_c.mutation.SetAmount(v)
 	return _c
 }
@@ -62,6 +64,40 @@ func (_c *TransactionCreate) SetDate(v time.Time) *TransactionCreate {
 	return _c
 }
 
+// SetTaxClass sets the "tax_class" field.
+func (_c *TransactionCreate) SetTaxClass(v string) *TransactionCreate {
+	_c.mutation.SetTaxClass(v)
+	return _c
+}
+
+// SetNillableTaxClass sets the "tax_class" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableTaxClass(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetTaxClass(*v)
+	}
+	return _c
+}
+
+// SetSplitType sets the "split_type" field.
+func (_c *TransactionCreate) SetSplitType(v string) *TransactionCreate {
+	_c.mutation.SetSplitType(v)
+	return _c
+}
+
+// SetNillableSplitType sets the "split_type" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableSplitType(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetSplitType(*v)
+	}
+	return _c
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (_c *TransactionCreate) SetSplitShares(v []schema.SplitShare) *TransactionCreate {
+	_c.mutation.SetSplitShares(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TransactionCreate) SetCreatedAt(v time.Time) *TransactionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -90,6 +126,20 @@ func (_c *TransactionCreate) SetNillableUpdatedAt(v *time.Time) *TransactionCrea
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TransactionCreate) SetDeletedAt(v time.Time) *TransactionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableDeletedAt(v *time.Time) *TransactionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetHouseholdID sets the "household" edge to the Household entity by ID.
 func (_c *TransactionCreate) SetHouseholdID(id int) *TransactionCreate {
 	_c.mutation.SetHouseholdID(id)
@@ -112,6 +162,25 @@ func (_c *TransactionCreate) SetCategory(v *Category) *TransactionCreate {
 	return _c.SetCategoryID(v.ID)
 }
 
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by ID.
+func (_c *TransactionCreate) SetPayerID(id int) *TransactionCreate {
+	_c.mutation.SetPayerID(id)
+	return _c
+}
+
+// SetNillablePayerID sets the "payer" edge to the HouseholdMember entity by ID if the given value is not nil.
+func (_c *TransactionCreate) SetNillablePayerID(id *int) *TransactionCreate {
+	if id != nil {
+		_c = _c.SetPayerID(*id)
+	}
+	return _c
+}
+
+// SetPayer sets the "payer" edge to the HouseholdMember entity.
+func (_c *TransactionCreate) SetPayer(v *HouseholdMember) *TransactionCreate {
+	return _c.SetPayerID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_c *TransactionCreate) Mutation() *TransactionMutation {
 	return _c.mutation
@@ -162,11 +231,6 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Transaction.amount"`)}
 	}
-	if v, ok := _c.mutation.Amount(); ok {
-		if err := transaction.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Transaction.amount": %w`, err)}
-		}
-	}
 	if v, ok := _c.mutation.Description(); ok {
 		if err := transaction.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
@@ -180,6 +244,16 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.Date(); !ok {
 		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Transaction.date"`)}
 	}
+	if v, ok := _c.mutation.TaxClass(); ok {
+		if err := transaction.TaxClassValidator(v); err != nil {
+			return &ValidationError{Name: "tax_class", err: fmt.Errorf(`ent: validator failed for field "Transaction.tax_class": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SplitType(); ok {
+		if err := transaction.SplitTypeValidator(v); err != nil {
+			return &ValidationError{Name: "split_type", err: fmt.Errorf(`ent: validator failed for field "Transaction.split_type": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transaction.created_at"`)}
 	}
@@ -219,7 +293,7 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec = sqlgraph.NewCreateSpec(transaction.Table, sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt))
 	)
 	if value, ok := _c.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeString, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
 	if value, ok := _c.mutation.Description(); ok {
@@ -234,6 +308,18 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldDate, field.TypeTime, value)
 		_node.Date = value
 	}
+	if value, ok := _c.mutation.TaxClass(); ok {
+		_spec.SetField(transaction.FieldTaxClass, field.TypeString, value)
+		_node.TaxClass = value
+	}
+	if value, ok := _c.mutation.SplitType(); ok {
+		_spec.SetField(transaction.FieldSplitType, field.TypeString, value)
+		_node.SplitType = value
+	}
+	if value, ok := _c.mutation.SplitShares(); ok {
+		_spec.SetField(transaction.FieldSplitShares, field.TypeJSON, value)
+		_node.SplitShares = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(transaction.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -242,6 +328,10 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(transaction.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.HouseholdIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -276,6 +366,23 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.category_transactions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.PayerTable,
+			Columns: []string{transaction.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.household_member_paid_transactions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
 	"icekalt.dev/money-tracker/ent/predicate"
 	"icekalt.dev/money-tracker/ent/transaction"
 )
@@ -26,7 +27,9 @@ type TransactionQuery struct {
 	predicates    []predicate.Transaction
 	withHousehold *HouseholdQuery
 	withCategory  *CategoryQuery
+	withPayer     *HouseholdMemberQuery
 	withFKs       bool
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +110,28 @@ func (_q *TransactionQuery) QueryCategory() *CategoryQuery {
 	return query
 }
 
+// QueryPayer chains the current query on the "payer" edge.
+func (_q *TransactionQuery) QueryPayer() *HouseholdMemberQuery {
+	query := (&HouseholdMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(householdmember.Table, householdmember.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.PayerTable, transaction.PayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (_q *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -301,9 +326,11 @@ func (_q *TransactionQuery) Clone() *TransactionQuery {
 		predicates:    append([]predicate.Transaction{}, _q.predicates...),
 		withHousehold: _q.withHousehold.Clone(),
 		withCategory:  _q.withCategory.Clone(),
+		withPayer:     _q.withPayer.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -329,13 +356,24 @@ func (_q *TransactionQuery) WithCategory(opts ...func(*CategoryQuery)) *Transact
 	return _q
 }
 
+// WithPayer tells the query-builder to eager-load the nodes that are connected to
+// the "payer" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TransactionQuery) WithPayer(opts ...func(*HouseholdMemberQuery)) *TransactionQuery {
+	query := (&HouseholdMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPayer = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Amount string `json:"amount,omitempty"`
+//		Amount int64 `json:"amount,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
@@ -358,7 +396,7 @@ func (_q *TransactionQuery) GroupBy(field string, fields ...string) *Transaction
 // Example:
 //
 //	var v []struct {
-//		Amount string `json:"amount,omitempty"`
+//		Amount int64 `json:"amount,omitempty"`
 //	}
 //
 //	client.Transaction.Query().
@@ -408,12 +446,13 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*Transaction{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withHousehold != nil,
 			_q.withCategory != nil,
+			_q.withPayer != nil,
 		}
 	)
-	if _q.withHousehold != nil || _q.withCategory != nil {
+	if _q.withHousehold != nil || _q.withCategory != nil || _q.withPayer != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -428,6 +467,9 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -449,6 +491,12 @@ func (_q *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withPayer; query != nil {
+		if err := _q.loadPayer(ctx, query, nodes, nil,
+			func(n *Transaction, e *HouseholdMember) { n.Edges.Payer = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -516,9 +564,44 @@ func (_q *TransactionQuery) loadCategory(ctx context.Context, query *CategoryQue
 	}
 	return nil
 }
+func (_q *TransactionQuery) loadPayer(ctx context.Context, query *HouseholdMemberQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *HouseholdMember)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].household_member_paid_transactions == nil {
+			continue
+		}
+		fk := *nodes[i].household_member_paid_transactions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(householdmember.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "household_member_paid_transactions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -581,6 +664,9 @@ func (_q *TransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -598,6 +684,12 @@ func (_q *TransactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *TransactionQuery) Modify(modifiers ...func(s *sql.Selector)) *TransactionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // TransactionGroupBy is the group-by builder for Transaction entities.
 type TransactionGroupBy struct {
 	selector
@@ -687,3 +779,9 @@ func (_s *TransactionSelect) sqlScan(ctx context.Context, root *TransactionQuery
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *TransactionSelect) Modify(modifiers ...func(s *sql.Selector)) *TransactionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
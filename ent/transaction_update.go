@@ -10,18 +10,22 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"icekalt.dev/money-tracker/ent/category"
 	"icekalt.dev/money-tracker/ent/household"
+	"icekalt.dev/money-tracker/ent/householdmember"
 	"icekalt.dev/money-tracker/ent/predicate"
+	"icekalt.dev/money-tracker/ent/schema"
 	"icekalt.dev/money-tracker/ent/transaction"
 )
 
 // TransactionUpdate is the builder for updating Transaction entities.
 type TransactionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *TransactionMutation
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the TransactionUpdate builder.
@@ -31,19 +35,26 @@ func (_u *TransactionUpdate) Where(ps ...predicate.Transaction) *TransactionUpda
 }
 
 // SetAmount sets the "amount" field.
-func (_u *TransactionUpdate) SetAmount(v string) *TransactionUpdate {
+func (_u *TransactionUpdate) SetAmount(v int64) *TransactionUpdate {
+	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *TransactionUpdate) SetNillableAmount(v *string) *TransactionUpdate {
+func (_u *TransactionUpdate) SetNillableAmount(v *int64) *TransactionUpdate {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
 	return _u
 }
 
+// AddAmount adds value to the "amount" field.
+func (_u *TransactionUpdate) AddAmount(v int64) *TransactionUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *TransactionUpdate) SetDescription(v string) *TransactionUpdate {
 	_u.mutation.SetDescription(v)
@@ -98,12 +109,90 @@ func (_u *TransactionUpdate) SetNillableDate(v *time.Time) *TransactionUpdate {
 	return _u
 }
 
+// SetTaxClass sets the "tax_class" field.
+func (_u *TransactionUpdate) SetTaxClass(v string) *TransactionUpdate {
+	_u.mutation.SetTaxClass(v)
+	return _u
+}
+
+// SetNillableTaxClass sets the "tax_class" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableTaxClass(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetTaxClass(*v)
+	}
+	return _u
+}
+
+// ClearTaxClass clears the value of the "tax_class" field.
+func (_u *TransactionUpdate) ClearTaxClass() *TransactionUpdate {
+	_u.mutation.ClearTaxClass()
+	return _u
+}
+
+// SetSplitType sets the "split_type" field.
+func (_u *TransactionUpdate) SetSplitType(v string) *TransactionUpdate {
+	_u.mutation.SetSplitType(v)
+	return _u
+}
+
+// SetNillableSplitType sets the "split_type" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableSplitType(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetSplitType(*v)
+	}
+	return _u
+}
+
+// ClearSplitType clears the value of the "split_type" field.
+func (_u *TransactionUpdate) ClearSplitType() *TransactionUpdate {
+	_u.mutation.ClearSplitType()
+	return _u
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (_u *TransactionUpdate) SetSplitShares(v []schema.SplitShare) *TransactionUpdate {
+	_u.mutation.SetSplitShares(v)
+	return _u
+}
+
+// AppendSplitShares appends value to the "split_shares" field.
+func (_u *TransactionUpdate) AppendSplitShares(v []schema.SplitShare) *TransactionUpdate {
+	_u.mutation.AppendSplitShares(v)
+	return _u
+}
+
+// ClearSplitShares clears the value of the "split_shares" field.
+func (_u *TransactionUpdate) ClearSplitShares() *TransactionUpdate {
+	_u.mutation.ClearSplitShares()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TransactionUpdate) SetUpdatedAt(v time.Time) *TransactionUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TransactionUpdate) SetDeletedAt(v time.Time) *TransactionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableDeletedAt(v *time.Time) *TransactionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TransactionUpdate) ClearDeletedAt() *TransactionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetHouseholdID sets the "household" edge to the Household entity by ID.
 func (_u *TransactionUpdate) SetHouseholdID(id int) *TransactionUpdate {
 	_u.mutation.SetHouseholdID(id)
@@ -126,6 +215,25 @@ func (_u *TransactionUpdate) SetCategory(v *Category) *TransactionUpdate {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by ID.
+func (_u *TransactionUpdate) SetPayerID(id int) *TransactionUpdate {
+	_u.mutation.SetPayerID(id)
+	return _u
+}
+
+// SetNillablePayerID sets the "payer" edge to the HouseholdMember entity by ID if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePayerID(id *int) *TransactionUpdate {
+	if id != nil {
+		_u = _u.SetPayerID(*id)
+	}
+	return _u
+}
+
+// SetPayer sets the "payer" edge to the HouseholdMember entity.
+func (_u *TransactionUpdate) SetPayer(v *HouseholdMember) *TransactionUpdate {
+	return _u.SetPayerID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdate) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -143,6 +251,12 @@ func (_u *TransactionUpdate) ClearCategory() *TransactionUpdate {
 	return _u
 }
 
+// ClearPayer clears the "payer" edge to the HouseholdMember entity.
+func (_u *TransactionUpdate) ClearPayer() *TransactionUpdate {
+	_u.mutation.ClearPayer()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -181,11 +295,6 @@ func (_u *TransactionUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TransactionUpdate) check() error {
-	if v, ok := _u.mutation.Amount(); ok {
-		if err := transaction.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Transaction.amount": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Description(); ok {
 		if err := transaction.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
@@ -196,6 +305,16 @@ func (_u *TransactionUpdate) check() error {
 			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "Transaction.details": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaxClass(); ok {
+		if err := transaction.TaxClassValidator(v); err != nil {
+			return &ValidationError{Name: "tax_class", err: fmt.Errorf(`ent: validator failed for field "Transaction.tax_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SplitType(); ok {
+		if err := transaction.SplitTypeValidator(v); err != nil {
+			return &ValidationError{Name: "split_type", err: fmt.Errorf(`ent: validator failed for field "Transaction.split_type": %w`, err)}
+		}
+	}
 	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.household"`)
 	}
@@ -205,6 +324,12 @@ func (_u *TransactionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -218,7 +343,10 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeString, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(transaction.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
@@ -235,9 +363,38 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(transaction.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.TaxClass(); ok {
+		_spec.SetField(transaction.FieldTaxClass, field.TypeString, value)
+	}
+	if _u.mutation.TaxClassCleared() {
+		_spec.ClearField(transaction.FieldTaxClass, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitType(); ok {
+		_spec.SetField(transaction.FieldSplitType, field.TypeString, value)
+	}
+	if _u.mutation.SplitTypeCleared() {
+		_spec.ClearField(transaction.FieldSplitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitShares(); ok {
+		_spec.SetField(transaction.FieldSplitShares, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSplitShares(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transaction.FieldSplitShares, value)
+		})
+	}
+	if _u.mutation.SplitSharesCleared() {
+		_spec.ClearField(transaction.FieldSplitShares, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(transaction.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(transaction.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.HouseholdCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -296,6 +453,36 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.PayerTable,
+			Columns: []string{transaction.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.PayerTable,
+			Columns: []string{transaction.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transaction.Label}
@@ -311,25 +498,33 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // TransactionUpdateOne is the builder for updating a single Transaction entity.
 type TransactionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *TransactionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *TransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetAmount sets the "amount" field.
-func (_u *TransactionUpdateOne) SetAmount(v string) *TransactionUpdateOne {
+func (_u *TransactionUpdateOne) SetAmount(v int64) *TransactionUpdateOne {
+	_u.mutation.ResetAmount()
 	_u.mutation.SetAmount(v)
 	return _u
 }
 
 // SetNillableAmount sets the "amount" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillableAmount(v *string) *TransactionUpdateOne {
+func (_u *TransactionUpdateOne) SetNillableAmount(v *int64) *TransactionUpdateOne {
 	if v != nil {
 		_u.SetAmount(*v)
 	}
 	return _u
 }
 
+// AddAmount adds value to the "amount" field.
+func (_u *TransactionUpdateOne) AddAmount(v int64) *TransactionUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
 // SetDescription sets the "description" field.
 func (_u *TransactionUpdateOne) SetDescription(v string) *TransactionUpdateOne {
 	_u.mutation.SetDescription(v)
@@ -384,12 +579,90 @@ func (_u *TransactionUpdateOne) SetNillableDate(v *time.Time) *TransactionUpdate
 	return _u
 }
 
+// SetTaxClass sets the "tax_class" field.
+func (_u *TransactionUpdateOne) SetTaxClass(v string) *TransactionUpdateOne {
+	_u.mutation.SetTaxClass(v)
+	return _u
+}
+
+// SetNillableTaxClass sets the "tax_class" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableTaxClass(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetTaxClass(*v)
+	}
+	return _u
+}
+
+// ClearTaxClass clears the value of the "tax_class" field.
+func (_u *TransactionUpdateOne) ClearTaxClass() *TransactionUpdateOne {
+	_u.mutation.ClearTaxClass()
+	return _u
+}
+
+// SetSplitType sets the "split_type" field.
+func (_u *TransactionUpdateOne) SetSplitType(v string) *TransactionUpdateOne {
+	_u.mutation.SetSplitType(v)
+	return _u
+}
+
+// SetNillableSplitType sets the "split_type" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableSplitType(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetSplitType(*v)
+	}
+	return _u
+}
+
+// ClearSplitType clears the value of the "split_type" field.
+func (_u *TransactionUpdateOne) ClearSplitType() *TransactionUpdateOne {
+	_u.mutation.ClearSplitType()
+	return _u
+}
+
+// SetSplitShares sets the "split_shares" field.
+func (_u *TransactionUpdateOne) SetSplitShares(v []schema.SplitShare) *TransactionUpdateOne {
+	_u.mutation.SetSplitShares(v)
+	return _u
+}
+
+// AppendSplitShares appends value to the "split_shares" field.
+func (_u *TransactionUpdateOne) AppendSplitShares(v []schema.SplitShare) *TransactionUpdateOne {
+	_u.mutation.AppendSplitShares(v)
+	return _u
+}
+
+// ClearSplitShares clears the value of the "split_shares" field.
+func (_u *TransactionUpdateOne) ClearSplitShares() *TransactionUpdateOne {
+	_u.mutation.ClearSplitShares()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TransactionUpdateOne) SetUpdatedAt(v time.Time) *TransactionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TransactionUpdateOne) SetDeletedAt(v time.Time) *TransactionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableDeletedAt(v *time.Time) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TransactionUpdateOne) ClearDeletedAt() *TransactionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetHouseholdID sets the "household" edge to the Household entity by ID.
 func (_u *TransactionUpdateOne) SetHouseholdID(id int) *TransactionUpdateOne {
 	_u.mutation.SetHouseholdID(id)
@@ -412,6 +685,25 @@ func (_u *TransactionUpdateOne) SetCategory(v *Category) *TransactionUpdateOne {
 	return _u.SetCategoryID(v.ID)
 }
 
+// SetPayerID sets the "payer" edge to the HouseholdMember entity by ID.
+func (_u *TransactionUpdateOne) SetPayerID(id int) *TransactionUpdateOne {
+	_u.mutation.SetPayerID(id)
+	return _u
+}
+
+// SetNillablePayerID sets the "payer" edge to the HouseholdMember entity by ID if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePayerID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		_u = _u.SetPayerID(*id)
+	}
+	return _u
+}
+
+// SetPayer sets the "payer" edge to the HouseholdMember entity.
+func (_u *TransactionUpdateOne) SetPayer(v *HouseholdMember) *TransactionUpdateOne {
+	return _u.SetPayerID(v.ID)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (_u *TransactionUpdateOne) Mutation() *TransactionMutation {
 	return _u.mutation
@@ -429,6 +721,12 @@ func (_u *TransactionUpdateOne) ClearCategory() *TransactionUpdateOne {
 	return _u
 }
 
+// ClearPayer clears the "payer" edge to the HouseholdMember entity.
+func (_u *TransactionUpdateOne) ClearPayer() *TransactionUpdateOne {
+	_u.mutation.ClearPayer()
+	return _u
+}
+
 // Where appends a list predicates to the TransactionUpdate builder.
 func (_u *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -480,11 +778,6 @@ func (_u *TransactionUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TransactionUpdateOne) check() error {
-	if v, ok := _u.mutation.Amount(); ok {
-		if err := transaction.AmountValidator(v); err != nil {
-			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Transaction.amount": %w`, err)}
-		}
-	}
 	if v, ok := _u.mutation.Description(); ok {
 		if err := transaction.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
@@ -495,6 +788,16 @@ func (_u *TransactionUpdateOne) check() error {
 			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "Transaction.details": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TaxClass(); ok {
+		if err := transaction.TaxClassValidator(v); err != nil {
+			return &ValidationError{Name: "tax_class", err: fmt.Errorf(`ent: validator failed for field "Transaction.tax_class": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SplitType(); ok {
+		if err := transaction.SplitTypeValidator(v); err != nil {
+			return &ValidationError{Name: "split_type", err: fmt.Errorf(`ent: validator failed for field "Transaction.split_type": %w`, err)}
+		}
+	}
 	if _u.mutation.HouseholdCleared() && len(_u.mutation.HouseholdIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.household"`)
 	}
@@ -504,6 +807,12 @@ func (_u *TransactionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -534,7 +843,10 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		}
 	}
 	if value, ok := _u.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeString, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(transaction.FieldAmount, field.TypeInt64, value)
 	}
 	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
@@ -551,9 +863,38 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(transaction.FieldDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.TaxClass(); ok {
+		_spec.SetField(transaction.FieldTaxClass, field.TypeString, value)
+	}
+	if _u.mutation.TaxClassCleared() {
+		_spec.ClearField(transaction.FieldTaxClass, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitType(); ok {
+		_spec.SetField(transaction.FieldSplitType, field.TypeString, value)
+	}
+	if _u.mutation.SplitTypeCleared() {
+		_spec.ClearField(transaction.FieldSplitType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SplitShares(); ok {
+		_spec.SetField(transaction.FieldSplitShares, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSplitShares(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transaction.FieldSplitShares, value)
+		})
+	}
+	if _u.mutation.SplitSharesCleared() {
+		_spec.ClearField(transaction.FieldSplitShares, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(transaction.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(transaction.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.HouseholdCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -612,6 +953,36 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.PayerTable,
+			Columns: []string{transaction.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.PayerTable,
+			Columns: []string{transaction.PayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(householdmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Transaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -14,22 +14,42 @@ type Tx struct {
 	config
 	// APIToken is the client for interacting with the APIToken builders.
 	APIToken *APITokenClient
+	// AggregateGeneration is the client for interacting with the AggregateGeneration builders.
+	AggregateGeneration *AggregateGenerationClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// Household is the client for interacting with the Household builders.
 	Household *HouseholdClient
+	// HouseholdMember is the client for interacting with the HouseholdMember builders.
+	HouseholdMember *HouseholdMemberClient
+	// HouseholdTransfer is the client for interacting with the HouseholdTransfer builders.
+	HouseholdTransfer *HouseholdTransferClient
+	// LocalCredential is the client for interacting with the LocalCredential builders.
+	LocalCredential *LocalCredentialClient
+	// MonthlyAggregate is the client for interacting with the MonthlyAggregate builders.
+	MonthlyAggregate *MonthlyAggregateClient
+	// RateLimit is the client for interacting with the RateLimit builders.
+	RateLimit *RateLimitClient
 	// RecurringExpense is the client for interacting with the RecurringExpense builders.
 	RecurringExpense *RecurringExpenseClient
 	// RecurringScheduleOverride is the client for interacting with the RecurringScheduleOverride builders.
 	RecurringScheduleOverride *RecurringScheduleOverrideClient
+	// Revision is the client for interacting with the Revision builders.
+	Revision *RevisionClient
+	// SecurityEvent is the client for interacting with the SecurityEvent builders.
+	SecurityEvent *SecurityEventClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// Settlement is the client for interacting with the Settlement builders.
+	Settlement *SettlementClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 
 	// lazily loaded.
 	client     *Client
@@ -162,14 +182,24 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.APIToken = NewAPITokenClient(tx.config)
+	tx.AggregateGeneration = NewAggregateGenerationClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.Household = NewHouseholdClient(tx.config)
+	tx.HouseholdMember = NewHouseholdMemberClient(tx.config)
+	tx.HouseholdTransfer = NewHouseholdTransferClient(tx.config)
+	tx.LocalCredential = NewLocalCredentialClient(tx.config)
+	tx.MonthlyAggregate = NewMonthlyAggregateClient(tx.config)
+	tx.RateLimit = NewRateLimitClient(tx.config)
 	tx.RecurringExpense = NewRecurringExpenseClient(tx.config)
 	tx.RecurringScheduleOverride = NewRecurringScheduleOverrideClient(tx.config)
+	tx.Revision = NewRevisionClient(tx.config)
+	tx.SecurityEvent = NewSecurityEventClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.Settings = NewSettingsClient(tx.config)
+	tx.Settlement = NewSettlementClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
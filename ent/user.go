@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent/localcredential"
 	"icekalt.dev/money-tracker/ent/user"
 )
 
@@ -21,8 +22,12 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
-	// Subject holds the value of the "subject" field.
+	// Internal login key; OIDC logins are linked through identities
 	Subject string `json:"subject,omitempty"`
+	// Instance admin
+	Admin bool `json:"admin,omitempty"`
+	// Disabled users can't log in
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -39,9 +44,15 @@ type UserEdges struct {
 	Households []*Household `json:"households,omitempty"`
 	// APITokens holds the value of the api_tokens edge.
 	APITokens []*APIToken `json:"api_tokens,omitempty"`
+	// LocalCredential holds the value of the local_credential edge.
+	LocalCredential *LocalCredential `json:"local_credential,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*UserIdentity `json:"identities,omitempty"`
+	// HouseholdTransfers holds the value of the household_transfers edge.
+	HouseholdTransfers []*HouseholdTransfer `json:"household_transfers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 }
 
 // HouseholdsOrErr returns the Households value or an error if the edge
@@ -62,16 +73,47 @@ func (e UserEdges) APITokensOrErr() ([]*APIToken, error) {
 	return nil, &NotLoadedError{edge: "api_tokens"}
 }
 
+// LocalCredentialOrErr returns the LocalCredential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) LocalCredentialOrErr() (*LocalCredential, error) {
+	if e.LocalCredential != nil {
+		return e.LocalCredential, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: localcredential.Label}
+	}
+	return nil, &NotLoadedError{edge: "local_credential"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[3] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// HouseholdTransfersOrErr returns the HouseholdTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) HouseholdTransfersOrErr() ([]*HouseholdTransfer, error) {
+	if e.loadedTypes[4] {
+		return e.HouseholdTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "household_transfers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldAdmin:
+			values[i] = new(sql.NullBool)
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldName, user.FieldSubject:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldDisabledAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -112,6 +154,19 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Subject = value.String
 			}
+		case user.FieldAdmin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field admin", values[i])
+			} else if value.Valid {
+				_m.Admin = value.Bool
+			}
+		case user.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				_m.DisabledAt = new(time.Time)
+				*_m.DisabledAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -147,6 +202,21 @@ func (_m *User) QueryAPITokens() *APITokenQuery {
 	return NewUserClient(_m.config).QueryAPITokens(_m)
 }
 
+// QueryLocalCredential queries the "local_credential" edge of the User entity.
+func (_m *User) QueryLocalCredential() *LocalCredentialQuery {
+	return NewUserClient(_m.config).QueryLocalCredential(_m)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (_m *User) QueryIdentities() *UserIdentityQuery {
+	return NewUserClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryHouseholdTransfers queries the "household_transfers" edge of the User entity.
+func (_m *User) QueryHouseholdTransfers() *HouseholdTransferQuery {
+	return NewUserClient(_m.config).QueryHouseholdTransfers(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -179,6 +249,14 @@ func (_m *User) String() string {
 	builder.WriteString("subject=")
 	builder.WriteString(_m.Subject)
 	builder.WriteString(", ")
+	builder.WriteString("admin=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Admin))
+	builder.WriteString(", ")
+	if v := _m.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -20,6 +20,10 @@ const (
 	FieldName = "name"
 	// FieldSubject holds the string denoting the subject field in the database.
 	FieldSubject = "subject"
+	// FieldAdmin holds the string denoting the admin field in the database.
+	FieldAdmin = "admin"
+	// FieldDisabledAt holds the string denoting the disabled_at field in the database.
+	FieldDisabledAt = "disabled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -28,6 +32,12 @@ const (
 	EdgeHouseholds = "households"
 	// EdgeAPITokens holds the string denoting the api_tokens edge name in mutations.
 	EdgeAPITokens = "api_tokens"
+	// EdgeLocalCredential holds the string denoting the local_credential edge name in mutations.
+	EdgeLocalCredential = "local_credential"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeHouseholdTransfers holds the string denoting the household_transfers edge name in mutations.
+	EdgeHouseholdTransfers = "household_transfers"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// HouseholdsTable is the table that holds the households relation/edge.
@@ -44,6 +54,27 @@ const (
 	APITokensInverseTable = "api_tokens"
 	// APITokensColumn is the table column denoting the api_tokens relation/edge.
 	APITokensColumn = "user_api_tokens"
+	// LocalCredentialTable is the table that holds the local_credential relation/edge.
+	LocalCredentialTable = "local_credentials"
+	// LocalCredentialInverseTable is the table name for the LocalCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "localcredential" package.
+	LocalCredentialInverseTable = "local_credentials"
+	// LocalCredentialColumn is the table column denoting the local_credential relation/edge.
+	LocalCredentialColumn = "user_local_credential"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "user_identities"
+	// IdentitiesInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	IdentitiesInverseTable = "user_identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "user_identities"
+	// HouseholdTransfersTable is the table that holds the household_transfers relation/edge.
+	HouseholdTransfersTable = "household_transfers"
+	// HouseholdTransfersInverseTable is the table name for the HouseholdTransfer entity.
+	// It exists in this package in order to avoid circular dependency with the "householdtransfer" package.
+	HouseholdTransfersInverseTable = "household_transfers"
+	// HouseholdTransfersColumn is the table column denoting the household_transfers relation/edge.
+	HouseholdTransfersColumn = "user_household_transfers"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -52,6 +83,8 @@ var Columns = []string{
 	FieldEmail,
 	FieldName,
 	FieldSubject,
+	FieldAdmin,
+	FieldDisabledAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -73,6 +106,8 @@ var (
 	NameValidator func(string) error
 	// SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
 	SubjectValidator func(string) error
+	// DefaultAdmin holds the default value on creation for the "admin" field.
+	DefaultAdmin bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -104,6 +139,16 @@ func BySubject(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubject, opts...).ToFunc()
 }
 
+// ByAdmin orders the results by the admin field.
+func ByAdmin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdmin, opts...).ToFunc()
+}
+
+// ByDisabledAt orders the results by the disabled_at field.
+func ByDisabledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -141,6 +186,41 @@ func ByAPITokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAPITokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLocalCredentialField orders the results by local_credential field.
+func ByLocalCredentialField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocalCredentialStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHouseholdTransfersCount orders the results by household_transfers count.
+func ByHouseholdTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHouseholdTransfersStep(), opts...)
+	}
+}
+
+// ByHouseholdTransfers orders the results by household_transfers terms.
+func ByHouseholdTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHouseholdTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newHouseholdsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -155,3 +235,24 @@ func newAPITokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
 	)
 }
+func newLocalCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocalCredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, LocalCredentialTable, LocalCredentialColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdentitiesTable, IdentitiesColumn),
+	)
+}
+func newHouseholdTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HouseholdTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HouseholdTransfersTable, HouseholdTransfersColumn),
+	)
+}
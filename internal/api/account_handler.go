@@ -0,0 +1,226 @@
+package api
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type DeleteAccountRequest struct {
+	Confirm    string `json:"confirm"`
+	Households string `json:"households,omitempty"`
+	TransferTo string `json:"transfer_to,omitempty"`
+}
+
+// HouseholdTransferResponse is a household offered to the user with the
+// email To.
+type HouseholdTransferResponse struct {
+	ID            int       `json:"id"`
+	HouseholdID   int       `json:"household_id"`
+	HouseholdName string    `json:"household_name"`
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type HouseholdTransfersResponse struct {
+	Incoming []HouseholdTransferResponse `json:"incoming"`
+	Outgoing []HouseholdTransferResponse `json:"outgoing"`
+}
+
+// ProfileExport is profile.json of the data export.
+type ProfileExport struct {
+	ID        int       `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	Username  string    `json:"username,omitempty"`
+	Admin     bool      `json:"admin"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HouseholdExport is households/<id>.json of the data export.
+type HouseholdExport struct {
+	Household         HouseholdResponse          `json:"household"`
+	Categories        []CategoryResponse         `json:"categories"`
+	Members           []MemberResponse           `json:"members"`
+	Transactions      []TransactionResponse      `json:"transactions"`
+	RecurringExpenses []RecurringExpenseResponse `json:"recurring_expenses"`
+	ScheduleOverrides []ScheduleOverrideResponse `json:"schedule_overrides"`
+	Settlements       []SettlementResponse       `json:"settlements"`
+}
+
+func (s *Server) handleExportAccount(c echo.Context) error {
+	data, err := s.services.Account.Export(c.Request().Context())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "application/zip")
+	res.Header().Set(echo.HeaderContentDisposition, `attachment; filename="money-tracker-export.zip"`)
+	res.WriteHeader(http.StatusOK)
+	return writeAccountExport(res, data, time.Now())
+}
+
+func (s *Server) handleDeleteAccount(c echo.Context) error {
+	var req DeleteAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
+	}
+
+	pending, err := s.services.Account.Delete(c.Request().Context(), domain.AccountDeletion{
+		Confirm:    req.Confirm,
+		Households: domain.OwnedHouseholds(req.Households),
+		TransferTo: req.TransferTo,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if pending {
+		return c.NoContent(http.StatusAccepted)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) handleListTransfers(c echo.Context) error {
+	incoming, outgoing, err := s.services.Account.Transfers(c.Request().Context())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, HouseholdTransfersResponse{
+		Incoming: toResponses(incoming, toHouseholdTransferResponse),
+		Outgoing: toResponses(outgoing, toHouseholdTransferResponse),
+	})
+}
+
+func (s *Server) handleAcceptTransfer(c echo.Context) error {
+	id, err := parseID(c, "transferId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Account.AcceptTransfer(c.Request().Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) handleDeclineTransfer(c echo.Context) error {
+	id, err := parseID(c, "transferId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Account.DeclineTransfer(c.Request().Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) handleCancelTransfer(c echo.Context) error {
+	id, err := parseID(c, "transferId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Account.CancelTransfer(c.Request().Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toHouseholdTransferResponse(t *domain.HouseholdTransfer) HouseholdTransferResponse {
+	return HouseholdTransferResponse{
+		ID:            t.ID,
+		HouseholdID:   t.HouseholdID,
+		HouseholdName: t.HouseholdName,
+		From:          t.FromEmail,
+		To:            t.Email,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+// writeAccountExport writes the data export as a ZIP of JSON files: the
+// profile, one file per household, the revisions the user made, the linked
+// OIDC identities, API tokens, sessions and security events. Token hashes and
+// session tokens are left out.
+func writeAccountExport(w io.Writer, data *domain.PersonalData, now time.Time) error {
+	zw := zip.NewWriter(w)
+	add := func(name string, v any) error {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: now})
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	user := data.User
+	if err := add("profile.json", ProfileExport{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Username:  data.Username,
+		Admin:     user.Admin,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}); err != nil {
+		return err
+	}
+
+	for _, hd := range data.Households {
+		if err := add(fmt.Sprintf("households/%d.json", hd.Household.ID), HouseholdExport{
+			Household:         toHouseholdResponse(hd.Household),
+			Categories:        toResponses(hd.Categories, toCategoryResponse),
+			Members:           toResponses(hd.Members, toMemberResponse),
+			Transactions:      toResponses(hd.Transactions, toTransactionResponse),
+			RecurringExpenses: toResponses(hd.RecurringExpenses, toRecurringExpenseResponse),
+			ScheduleOverrides: toResponses(hd.ScheduleOverrides, toScheduleOverrideResponse),
+			Settlements:       toResponses(hd.Settlements, toSettlementResponse),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := add("revisions.json", toResponses(data.Revisions, toRevisionResponse)); err != nil {
+		return err
+	}
+	if err := add("identities.json", toResponses(data.Identities, toIdentityResponse)); err != nil {
+		return err
+	}
+	if err := add("api_tokens.json", toResponses(data.APITokens, toTokenResponse)); err != nil {
+		return err
+	}
+	if err := add("sessions.json", toResponses(data.Sessions, func(sess *domain.Session) SessionResponse {
+		return toSessionResponse(sess, "")
+	})); err != nil {
+		return err
+	}
+	if err := add("security_events.json", toResponses(data.SecurityEvents, toSecurityEventResponse)); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// toResponses converts a list for a response. Empty lists become [] rather
+// than null.
+func toResponses[T, R any](items []T, convert func(T) R) []R {
+	resp := make([]R, len(items))
+	for i, item := range items {
+		resp[i] = convert(item)
+	}
+	return resp
+}
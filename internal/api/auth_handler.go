@@ -4,49 +4,102 @@ import (
 	"crypto/rand"
 	"crypto/subtle"
 	"encoding/hex"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/sessions"
 	"github.com/labstack/echo/v4"
 	"icekalt.dev/money-tracker/internal/auth"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
 )
 
 type AuthHandler struct {
-	oidcCfg *auth.OIDCConfig
+	providers []*auth.OIDCConfig
+	localAuth bool
 	store   sessions.Store
 	services *Services
 }
 
-func NewAuthHandler(oidcCfg *auth.OIDCConfig, store sessions.Store, services *Services) *AuthHandler {
+func NewAuthHandler(providers []*auth.OIDCConfig, localAuth bool, store sessions.Store, services *Services) *AuthHandler {
 	return &AuthHandler{
-		oidcCfg:  oidcCfg,
-		store:    store,
-		services: services,
+		providers: providers,
+		localAuth: localAuth,
+		store:     store,
+		services:  services,
 	}
 }
 
+// Session keys of account linking. The link user is set while a logged-in
+// user links another provider from the settings. The pending keys hold an
+// OIDC identity whose email belongs to an existing account until its owner
+// logs in another way and confirms or dismisses linking it.
+const (
+	sessionKeyLinkUser        = "link_user"
+	sessionKeyPendingProvider = "pending_provider"
+	sessionKeyPendingIssuer   = "pending_issuer"
+	sessionKeyPendingSubject  = "pending_subject"
+	sessionKeyPendingEmail    = "pending_email"
+)
+
+// provider returns the OIDC provider with the name, nil if there is none.
+// The provider at /auth/login has no name.
+func (h *AuthHandler) provider(name string) *auth.OIDCConfig {
+	for _, p := range h.providers {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
 func (h *AuthHandler) HandleLogin(c echo.Context) error {
-	if h.oidcCfg == nil {
-		return c.JSON(http.StatusServiceUnavailable, ErrorResponse{Error: "OIDC not configured"})
+	p := h.provider(c.Param("provider"))
+	if p == nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "unknown OIDC provider"})
+	}
+
+	session, _ := h.store.Get(c.Request(), auth.SessionName)
+	delete(session.Values, sessionKeyLinkUser)
+	return h.redirectToProvider(c, session, p)
+}
+
+// HandleLink starts linking a provider to the logged-in user's account. The
+// callback links the identity the user logs in with instead of logging in.
+func (h *AuthHandler) HandleLink(c echo.Context) error {
+	p := h.provider(c.Param("provider"))
+	if p == nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "unknown OIDC provider"})
+	}
+	userID, ok := service.UserIDFromContext(c.Request().Context())
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "unauthorized"})
 	}
 
+	session, _ := h.store.Get(c.Request(), auth.SessionName)
+	session.Values[sessionKeyLinkUser] = userID
+	return h.redirectToProvider(c, session, p)
+}
+
+func (h *AuthHandler) redirectToProvider(c echo.Context, session *sessions.Session, p *auth.OIDCConfig) error {
 	state, err := generateState()
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to generate state"})
 	}
 
-	session, _ := h.store.Get(c.Request(), auth.SessionName)
 	session.Values["oauth_state"] = state
 	if err := session.Save(c.Request(), c.Response()); err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to save session"})
 	}
 
-	return c.Redirect(http.StatusFound, h.oidcCfg.OAuth2Config.AuthCodeURL(state))
+	return c.Redirect(http.StatusFound, p.OAuth2Config.AuthCodeURL(state))
 }
 
 func (h *AuthHandler) HandleCallback(c echo.Context) error {
-	if h.oidcCfg == nil {
-		return c.JSON(http.StatusServiceUnavailable, ErrorResponse{Error: "OIDC not configured"})
+	p := h.provider(c.Param("provider"))
+	if p == nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "unknown OIDC provider"})
 	}
 
 	session, _ := h.store.Get(c.Request(), auth.SessionName)
@@ -56,7 +109,7 @@ func (h *AuthHandler) HandleCallback(c echo.Context) error {
 	}
 	delete(session.Values, "oauth_state")
 
-	token, err := h.oidcCfg.OAuth2Config.Exchange(c.Request().Context(), c.QueryParam("code"))
+	token, err := p.OAuth2Config.Exchange(c.Request().Context(), c.QueryParam("code"))
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "token exchange failed"})
 	}
@@ -66,24 +119,126 @@ func (h *AuthHandler) HandleCallback(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "no id_token"})
 	}
 
-	idToken, err := h.oidcCfg.Verifier.Verify(c.Request().Context(), rawIDToken)
+	idToken, err := p.Verifier.Verify(c.Request().Context(), rawIDToken)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "token verification failed"})
 	}
 
-	var claims struct {
-		Email string `json:"email"`
-		Name  string `json:"name"`
-		Sub   string `json:"sub"`
-	}
-	if err := idToken.Claims(&claims); err != nil {
+	identity, err := p.Identity(idToken)
+	if err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to parse claims"})
 	}
 
-	user, err := h.services.User.GetOrCreate(c.Request().Context(), claims.Sub, claims.Email, claims.Name)
-	if err != nil {
+	if userID, ok := session.Values[sessionKeyLinkUser].(int); ok {
+		delete(session.Values, sessionKeyLinkUser)
+		return h.linkIdentity(c, session, p, userID, identity)
+	}
+
+	user, err := h.services.User.SignInOIDC(c.Request().Context(), p.Policy, identity)
+	var reason string
+	switch {
+	case errors.Is(err, domain.ErrLinkRequired):
+		// The email belongs to another account. Its owner has to log in
+		// another way first and confirm linking this identity.
+		session.Values[sessionKeyPendingProvider] = p.Name
+		session.Values[sessionKeyPendingIssuer] = identity.Issuer
+		session.Values[sessionKeyPendingSubject] = identity.Subject
+		session.Values[sessionKeyPendingEmail] = identity.Email
+		if err := session.Save(c.Request(), c.Response()); err != nil {
+			return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to save session"})
+		}
+		return c.Redirect(http.StatusFound, "/login")
+	case errors.Is(err, domain.ErrSignInDenied):
+		reason = "not_allowed"
+	case errors.Is(err, domain.ErrRegistrationClosed):
+		reason = "registration_closed"
+	case errors.Is(err, domain.ErrUserDisabled):
+		reason = "disabled"
+	case err != nil:
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to create user"})
 	}
+	if reason != "" {
+		h.recordLoginFailure(c, 0, map[string]string{
+			"method":  "oidc",
+			"reason":  reason,
+			"issuer":  identity.Issuer,
+			"subject": identity.Subject,
+			"email":   identity.Email,
+		})
+		return c.Redirect(http.StatusFound, "/login/denied?reason="+reason)
+	}
+
+	session.Values[auth.SessionKeyOIDCProvider] = p.Name
+	session.Values[auth.SessionKeyIDToken] = rawIDToken
+	session.Values[auth.SessionKeyOIDCIssuer] = idToken.Issuer
+	session.Values[auth.SessionKeyOIDCSubject] = idToken.Subject
+	session.Values[auth.SessionKeyOIDCSID] = auth.SessionID(idToken)
+	return h.login(c, session, user, "oidc")
+}
+
+// linkIdentity links the identity the user logged in with at the provider
+// to their account, after they started linking from the settings.
+func (h *AuthHandler) linkIdentity(c echo.Context, session *sessions.Session, p *auth.OIDCConfig, userID int, identity domain.OIDCIdentity) error {
+	if err := session.Save(c.Request(), c.Response()); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to save session"})
+	}
+	if !p.Policy.Allows(identity) {
+		return c.Redirect(http.StatusFound, "/settings?error=identity_denied")
+	}
+
+	ctx := service.WithUserID(c.Request().Context(), userID)
+	_, err := h.services.Identity.Link(ctx, identity)
+	if errors.Is(err, domain.ErrConflict) {
+		return c.Redirect(http.StatusFound, "/settings?error=identity_taken")
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to link identity"})
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+// HandleLocalLogin checks the username and password from the login form.
+// Failed attempts go back to the login page without saying which of the
+// two was wrong.
+func (h *AuthHandler) HandleLocalLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+	username := c.FormValue("username")
+	user, err := h.services.User.Authenticate(ctx, username, c.FormValue("password"))
+	if errors.Is(err, domain.ErrInvalidCredentials) || errors.Is(err, domain.ErrUserDisabled) {
+		reason, target := "credentials", "/login?error=credentials"
+		if errors.Is(err, domain.ErrUserDisabled) {
+			reason, target = "disabled", "/login/denied?reason=disabled"
+		}
+		h.recordLoginFailure(c, h.services.User.LocalUserID(ctx, username), map[string]string{
+			"method":   "local",
+			"reason":   reason,
+			"username": username,
+		})
+		return c.Redirect(http.StatusFound, target)
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "login failed"})
+	}
+
+	session, _ := h.store.Get(c.Request(), auth.SessionName)
+	return h.login(c, session, user, "local")
+}
+
+// login stores the user in the session and redirects to the dashboard. The
+// login is recorded first, so there is no session without its event.
+func (h *AuthHandler) login(c echo.Context, session *sessions.Session, user *domain.User, method string) error {
+	if err := h.services.Security.Record(c.Request().Context(), domain.EventLoginSucceeded, user.ID, map[string]string{"method": method}); err != nil {
+		return err
+	}
+
+	// A pending identity may come from someone else who used this browser,
+	// so its owner has to confirm linking it
+	target := "/"
+	if _, _, ok := pendingIdentity(session, user); ok {
+		target = "/settings/identities/pending"
+	} else {
+		clearPending(session)
+	}
 
 	session.Values[auth.SessionKeyUser] = user.ID
 	session.Values[auth.SessionKeyEmail] = user.Email
@@ -92,19 +247,124 @@ func (h *AuthHandler) HandleCallback(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to save session"})
 	}
 
-	return c.Redirect(http.StatusFound, "/")
+	return c.Redirect(http.StatusFound, target)
+}
+
+// pendingIdentity returns the identity of an earlier OIDC login that was
+// refused because its email belongs to the user's account, and the name of
+// its provider.
+func pendingIdentity(session *sessions.Session, user *domain.User) (string, domain.OIDCIdentity, bool) {
+	provider, _ := session.Values[sessionKeyPendingProvider].(string)
+	issuer, _ := session.Values[sessionKeyPendingIssuer].(string)
+	subject, _ := session.Values[sessionKeyPendingSubject].(string)
+	email, _ := session.Values[sessionKeyPendingEmail].(string)
+	if subject == "" || !strings.EqualFold(email, user.Email) {
+		return "", domain.OIDCIdentity{}, false
+	}
+	return provider, domain.OIDCIdentity{Issuer: issuer, Subject: subject, Email: email}, true
+}
+
+func clearPending(session *sessions.Session) {
+	for _, key := range []string{sessionKeyPendingProvider, sessionKeyPendingIssuer, sessionKeyPendingSubject, sessionKeyPendingEmail} {
+		delete(session.Values, key)
+	}
+}
+
+// HandleLinkPending links the pending identity to the logged-in user's
+// account once they confirmed it. See pendingIdentity.
+func (h *AuthHandler) HandleLinkPending(c echo.Context) error {
+	ctx := c.Request().Context()
+	userID, ok := service.UserIDFromContext(ctx)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "unauthorized"})
+	}
+	user, err := h.services.User.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	session, _ := h.store.Get(c.Request(), auth.SessionName)
+	_, identity, ok := pendingIdentity(session, user)
+	clearPending(session)
+	if err := session.Save(c.Request(), c.Response()); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to save session"})
+	}
+	if !ok {
+		return c.Redirect(http.StatusFound, "/settings")
+	}
+
+	_, err = h.services.Identity.Link(ctx, identity)
+	if errors.Is(err, domain.ErrConflict) {
+		// Linked to another account in the meantime
+		return c.Redirect(http.StatusFound, "/settings?error=identity_taken")
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to link identity"})
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+// HandleDismissPending drops the pending identity without linking it.
+func (h *AuthHandler) HandleDismissPending(c echo.Context) error {
+	session, _ := h.store.Get(c.Request(), auth.SessionName)
+	clearPending(session)
+	if err := session.Save(c.Request(), c.Response()); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to save session"})
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+// recordLoginFailure records a rejected login. It is best-effort, so the
+// response doesn't depend on whether the write succeeded.
+func (h *AuthHandler) recordLoginFailure(c echo.Context, userID int, details map[string]string) {
+	_ = h.services.Security.Record(c.Request().Context(), domain.EventLoginFailed, userID, details)
 }
 
 func (h *AuthHandler) HandleLogout(c echo.Context) error {
 	session, _ := h.store.Get(c.Request(), auth.SessionName)
+	if userID, ok := session.Values[auth.SessionKeyUser].(int); ok {
+		if err := h.services.Security.Record(c.Request().Context(), domain.EventLogout, userID, nil); err != nil {
+			return err
+		}
+	}
+	provider, _ := session.Values[auth.SessionKeyOIDCProvider].(string)
+	idToken, _ := session.Values[auth.SessionKeyIDToken].(string)
 	session.Options.MaxAge = -1
 	if err := session.Save(c.Request(), c.Response()); err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "failed to clear session"})
 	}
 
+	// Log out at the provider too, or the next login would go through
+	// without asking
+	if p := h.provider(provider); p != nil && idToken != "" {
+		if target := p.LogoutURL(idToken); target != "" {
+			return c.Redirect(http.StatusFound, target)
+		}
+	}
 	return c.Redirect(http.StatusFound, "/")
 }
 
+// HandleBackchannelLogout ends sessions when the OIDC provider asks for it
+// (OpenID Connect Back-Channel Logout). The provider posts a signed logout
+// token naming the user's subject or their session at the provider. Each
+// provider has its own endpoint, like its callback.
+func (h *AuthHandler) HandleBackchannelLogout(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	p := h.provider(c.Param("provider"))
+	if p == nil {
+		return c.JSON(http.StatusNotFound, ErrorResponse{Error: "unknown OIDC provider"})
+	}
+
+	token, err := p.VerifyLogoutToken(c.Request().Context(), c.FormValue("logout_token"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid logout token"})
+	}
+	if _, err := h.services.Session.LogoutOIDC(c.Request().Context(), p.Issuer, token.Subject, token.SessionID); err != nil {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "logout failed"})
+	}
+	return c.NoContent(http.StatusOK)
+}
+
 func generateState() (string, error) {
 	b := make([]byte, 16)
 	if _, err := rand.Read(b); err != nil {
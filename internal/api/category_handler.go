@@ -36,7 +36,7 @@ func (s *Server) handleCreateCategory(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
 	}
 
-	cat, err := s.services.Category.Create(c.Request().Context(), householdID, req.Name, req.Icon)
+	cat, err := s.services.Category.Create(c.Request().Context(), householdID, req.Name, req.Icon, domain.TaxClass(req.TaxClass))
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -60,7 +60,7 @@ func (s *Server) handleUpdateCategory(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
 	}
 
-	cat, err := s.services.Category.Update(c.Request().Context(), categoryID, req.Name, req.Icon)
+	cat, err := s.services.Category.Update(c.Request().Context(), categoryID, req.Name, req.Icon, domain.TaxClass(req.TaxClass))
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -92,6 +92,7 @@ func toCategoryResponse(cat *domain.Category) CategoryResponse {
 		HouseholdID: cat.HouseholdID,
 		Name:        cat.Name,
 		Icon:        cat.Icon,
+		TaxClass:    string(cat.TaxClass),
 		CreatedAt:   cat.CreatedAt,
 		UpdatedAt:   cat.UpdatedAt,
 	}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"icekalt.dev/money-tracker/internal/chart"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/i18n"
+)
+
+const (
+	chartWidth  = 480
+	chartHeight = 240
+	trendMonths = 12
+)
+
+// queryLocale returns the request locale, which can be overridden with ?lang=
+// so that chart and export links render the same for everyone.
+func (s *Server) queryLocale(c echo.Context) i18n.Locale {
+	if lang := c.QueryParam("lang"); lang != "" {
+		return s.i18nBundle.ParseLocale(lang)
+	}
+	return s.getLocale(c)
+}
+
+func (s *Server) renderChart(c echo.Context, ch chart.Chart) error {
+	c.Response().Header().Set("Cache-Control", "private, max-age=60")
+	return c.Blob(http.StatusOK, "image/svg+xml", chart.RenderSVG(ch, chartWidth, chartHeight))
+}
+
+func (s *Server) handleChartExpensesByCategory(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	year, month, err := parseMonth(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	hh, err := s.services.Household.GetByID(ctx, id)
+	if err != nil {
+		return respondError(c, err)
+	}
+	summary, err := s.services.Summary.GetMonthlySummary(ctx, id, year, month)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	locale := s.queryLocale(c)
+	return s.renderChart(c, expensesByCategoryChart(summary, s.i18nBundle, locale, s.moneyFormatter(locale, hh.Currency)))
+}
+
+func (s *Server) handleChartIncomeExpenses(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	year, month, err := parseMonth(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	hh, err := s.services.Household.GetByID(ctx, id)
+	if err != nil {
+		return respondError(c, err)
+	}
+	to := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	summary, err := s.services.Summary.GetRangeSummary(ctx, id, to.AddDate(0, -(trendMonths-1), 0), to)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	locale := s.queryLocale(c)
+	return s.renderChart(c, incomeExpensesChart(summary, s.i18nBundle, locale, s.moneyFormatter(locale, hh.Currency)))
+}
+
+func (s *Server) handleChartRecurringByFrequency(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	year, month, err := parseMonth(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	hh, err := s.services.Household.GetByID(ctx, id)
+	if err != nil {
+		return respondError(c, err)
+	}
+	summary, err := s.services.Summary.GetMonthlySummary(ctx, id, year, month)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	locale := s.queryLocale(c)
+	return s.renderChart(c, recurringByFrequencyChart(summary, s.i18nBundle, locale, s.moneyFormatter(locale, hh.Currency)))
+}
+
+// axisFormatter formats axis ticks as whole amounts with locale grouping.
+func axisFormatter(locale i18n.Locale, bundle *i18n.Bundle) func(float64) string {
+	format := formatMoneyForLocale(locale, bundle)
+	decimalSep := bundle.DecimalSep(locale)
+	return func(v float64) string {
+		s := format(decimal.NewFromFloat(v).Round(0))
+		if i := strings.LastIndex(s, decimalSep); i >= 0 {
+			s = s[:i]
+		}
+		return s
+	}
+}
+
+// moneyFormatter formats amounts like the templates do, with the household currency.
+func (s *Server) moneyFormatter(locale i18n.Locale, currency string) func(domain.Money) string {
+	format := formatMoneyWithCurrencyForLocale(locale, s.i18nBundle, s.renderer.currencyByCode)
+	return func(m domain.Money) string {
+		return format(m, currency)
+	}
+}
+
+// expensesByCategoryChart shows the expense share of each category in a month.
+func expensesByCategoryChart(summary *domain.MonthlySummary, bundle *i18n.Bundle, locale i18n.Locale, money func(domain.Money) string) chart.Donut {
+	breakdown := append([]domain.CategorySummary(nil), summary.CategoryBreakdown...)
+	sort.Slice(breakdown, func(i, j int) bool {
+		return breakdown[i].Total.LessThan(breakdown[j].Total)
+	})
+
+	var slices []chart.Slice
+	total := domain.ZeroMoney()
+	for _, cs := range breakdown {
+		if !cs.Total.IsNegative() {
+			continue
+		}
+		amount := cs.Total.Abs()
+		total = total.Add(amount)
+		slices = append(slices, chart.Slice{
+			Label:      cs.CategoryName,
+			Value:      amount.InexactFloat64(),
+			ValueLabel: money(amount),
+		})
+	}
+
+	return chart.Donut{
+		Title:       bundle.T(locale, "chart_expenses_by_category") + " " + summary.Month,
+		Slices:      slices,
+		CenterLabel: money(total),
+		EmptyText:   bundle.T(locale, "chart_no_data"),
+	}
+}
+
+// incomeExpensesChart compares gross income and expenses month by month.
+func incomeExpensesChart(summary *domain.RangeSummary, bundle *i18n.Bundle, locale i18n.Locale, money func(domain.Money) string) chart.BarChart {
+	labels := make([]string, len(summary.Months))
+	income := make([]float64, len(summary.Months))
+	expenses := make([]float64, len(summary.Months))
+	for i, m := range summary.Months {
+		labels[i] = m.Month
+		income[i] = m.Income.InexactFloat64()
+		expenses[i] = m.Expenses.Abs().InexactFloat64()
+	}
+
+	return chart.BarChart{
+		Title:  bundle.T(locale, "chart_income_vs_expenses"),
+		Labels: labels,
+		Series: []chart.Series{
+			{Name: bundle.T(locale, "chart_income"), Color: "#198754", Values: income},
+			{Name: bundle.T(locale, "chart_expenses"), Color: "#dc3545", Values: expenses},
+		},
+		EmptyText: bundle.T(locale, "chart_no_data"),
+		Format:    axisFormatter(locale, bundle),
+	}
+}
+
+// recurringByFrequencyChart breaks the monthly recurring amount down by frequency.
+func recurringByFrequencyChart(summary *domain.MonthlySummary, bundle *i18n.Bundle, locale i18n.Locale, money func(domain.Money) string) chart.HBarChart {
+	bars := make([]chart.Slice, 0, len(summary.RecurringGroups))
+	for _, g := range summary.RecurringGroups {
+		bars = append(bars, chart.Slice{
+			Label:      bundle.FrequencyName(locale, string(g.Frequency)),
+			Value:      g.Total.InexactFloat64(),
+			ValueLabel: money(g.Total),
+		})
+	}
+
+	return chart.HBarChart{
+		Title:     bundle.T(locale, "chart_recurring_by_frequency") + " " + summary.Month,
+		Bars:      bars,
+		EmptyText: bundle.T(locale, "chart_no_data"),
+	}
+}
@@ -18,25 +18,28 @@ type UpdateHouseholdRequest struct {
 }
 
 type HouseholdResponse struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Currency    string    `json:"currency"`
-	Icon        string    `json:"icon"`
-	OwnerID     int       `json:"owner_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Currency    string     `json:"currency"`
+	Icon        string     `json:"icon"`
+	OwnerID     int        `json:"owner_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
 }
 
 // Category DTOs
 type CreateCategoryRequest struct {
-	Name string `json:"name"`
-	Icon string `json:"icon"`
+	Name     string `json:"name"`
+	Icon     string `json:"icon"`
+	TaxClass string `json:"tax_class"`
 }
 
 type UpdateCategoryRequest struct {
-	Name string `json:"name"`
-	Icon string `json:"icon"`
+	Name     string `json:"name"`
+	Icon     string `json:"icon"`
+	TaxClass string `json:"tax_class"`
 }
 
 type CategoryResponse struct {
@@ -44,77 +47,108 @@ type CategoryResponse struct {
 	HouseholdID int      `json:"household_id"`
 	Name        string    `json:"name"`
 	Icon        string    `json:"icon"`
+	TaxClass    string    `json:"tax_class"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 // Transaction DTOs
 type CreateTransactionRequest struct {
-	CategoryID  int    `json:"category_id"`
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
-	Details     string `json:"details"`
-	Date        string `json:"date"`
+	CategoryID     int             `json:"category_id"`
+	Amount         string          `json:"amount"`
+	Description    string          `json:"description"`
+	Details        string          `json:"details"`
+	TaxClass       string          `json:"tax_class"`
+	Date           string          `json:"date"`
+	PaidByMemberID *int            `json:"paid_by_member_id,omitempty"`
+	SplitType      string          `json:"split_type,omitempty"`
+	Shares         []SplitShareDTO `json:"shares,omitempty"`
 }
 
 type UpdateTransactionRequest struct {
-	CategoryID  int    `json:"category_id"`
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
-	Details     string `json:"details"`
-	Date        string `json:"date"`
+	CategoryID     int             `json:"category_id"`
+	Amount         string          `json:"amount"`
+	Description    string          `json:"description"`
+	Details        string          `json:"details"`
+	TaxClass       string          `json:"tax_class"`
+	Date           string          `json:"date"`
+	PaidByMemberID *int            `json:"paid_by_member_id,omitempty"`
+	SplitType      string          `json:"split_type,omitempty"`
+	Shares         []SplitShareDTO `json:"shares,omitempty"`
 }
 
 type TransactionResponse struct {
-	ID          int       `json:"id"`
-	HouseholdID int      `json:"household_id"`
-	CategoryID  int       `json:"category_id"`
-	Amount      string    `json:"amount"`
-	Description string    `json:"description"`
-	Details     string    `json:"details"`
-	Date        string    `json:"date"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID             int             `json:"id"`
+	HouseholdID    int             `json:"household_id"`
+	CategoryID     int             `json:"category_id"`
+	Amount         string          `json:"amount"`
+	Description    string          `json:"description"`
+	Details        string          `json:"details"`
+	TaxClass       string          `json:"tax_class"`
+	Date           string          `json:"date"`
+	PaidByMemberID *int            `json:"paid_by_member_id"`
+	SplitType      string          `json:"split_type,omitempty"`
+	Shares         []SplitShareDTO `json:"shares,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeletedAt      *time.Time      `json:"deleted_at,omitempty"`
 }
 
 // RecurringExpense DTOs
 type CreateRecurringExpenseRequest struct {
-	CategoryID  int     `json:"category_id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Details     string  `json:"details"`
-	Amount      string  `json:"amount"`
-	Frequency   string  `json:"frequency"`
-	StartDate   string  `json:"start_date"`
-	EndDate     *string `json:"end_date,omitempty"`
+	CategoryID     int             `json:"category_id"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	Details        string          `json:"details"`
+	Amount         string          `json:"amount"`
+	Frequency      string          `json:"frequency"`
+	StartDate      string          `json:"start_date"`
+	EndDate        *string         `json:"end_date,omitempty"`
+	PaidByMemberID *int            `json:"paid_by_member_id,omitempty"`
+	SplitType      string          `json:"split_type,omitempty"`
+	Shares         []SplitShareDTO `json:"shares,omitempty"`
 }
 
 type UpdateRecurringExpenseRequest struct {
-	CategoryID  int     `json:"category_id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Details     string  `json:"details"`
-	Amount      string  `json:"amount"`
-	Frequency   string  `json:"frequency"`
-	Active      bool    `json:"active"`
-	StartDate   string  `json:"start_date"`
-	EndDate     *string `json:"end_date,omitempty"`
+	CategoryID     int             `json:"category_id"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	Details        string          `json:"details"`
+	Amount         string          `json:"amount"`
+	Frequency      string          `json:"frequency"`
+	Active         bool            `json:"active"`
+	StartDate      string          `json:"start_date"`
+	EndDate        *string         `json:"end_date,omitempty"`
+	PaidByMemberID *int            `json:"paid_by_member_id,omitempty"`
+	SplitType      string          `json:"split_type,omitempty"`
+	Shares         []SplitShareDTO `json:"shares,omitempty"`
 }
 
 type RecurringExpenseResponse struct {
-	ID          int       `json:"id"`
-	HouseholdID int      `json:"household_id"`
-	CategoryID  int       `json:"category_id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Details     string    `json:"details"`
-	Amount      string    `json:"amount"`
-	Frequency   string    `json:"frequency"`
-	Active      bool      `json:"active"`
-	StartDate   string    `json:"start_date"`
-	EndDate     *string   `json:"end_date,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID             int             `json:"id"`
+	HouseholdID    int             `json:"household_id"`
+	CategoryID     int             `json:"category_id"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	Details        string          `json:"details"`
+	Amount         string          `json:"amount"`
+	Frequency      string          `json:"frequency"`
+	Active         bool            `json:"active"`
+	StartDate      string          `json:"start_date"`
+	EndDate        *string         `json:"end_date,omitempty"`
+	PaidByMemberID *int            `json:"paid_by_member_id"`
+	SplitType      string          `json:"split_type,omitempty"`
+	Shares         []SplitShareDTO `json:"shares,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeletedAt      *time.Time      `json:"deleted_at,omitempty"`
+}
+
+// SplitShareDTO is one member's share of a split. Value is a percentage for
+// percentage splits, an amount for fixed splits and omitted for equal splits.
+type SplitShareDTO struct {
+	MemberID int    `json:"member_id"`
+	Value    string `json:"value,omitempty"`
 }
 
 // ScheduleOverride DTOs
@@ -163,3 +197,155 @@ type CategorySummaryResponse struct {
 	OneTime      string `json:"one_time"`
 	Total        string `json:"total"`
 }
+
+type RangeSummaryResponse struct {
+	HouseholdID     int                      `json:"household_id"`
+	From            string                   `json:"from"`
+	To              string                   `json:"to"`
+	Months          []MonthTotalsResponse    `json:"months"`
+	Categories      []CategorySeriesResponse `json:"categories"`
+	TotalIncome     string                   `json:"total_income"`
+	TotalExpenses   string                   `json:"total_expenses"`
+	Total           string                   `json:"total"`
+	AverageIncome   string                   `json:"average_income"`
+	AverageExpenses string                   `json:"average_expenses"`
+	AverageTotal    string                   `json:"average_total"`
+}
+
+type MonthTotalsResponse struct {
+	Month     string `json:"month"`
+	Income    string `json:"income"`
+	Expenses  string `json:"expenses"`
+	Recurring string `json:"recurring"`
+	OneTime   string `json:"one_time"`
+	Total     string `json:"total"`
+}
+
+type PeriodComparisonResponse struct {
+	HouseholdID        int                          `json:"household_id"`
+	Base               string                       `json:"base"`
+	Current            string                       `json:"current"`
+	Income             AmountDeltaResponse          `json:"income"`
+	Expenses           AmountDeltaResponse          `json:"expenses"`
+	Total              AmountDeltaResponse          `json:"total"`
+	Categories         []CategoryComparisonResponse `json:"categories"`
+	NewCategories      []CategoryComparisonResponse `json:"new_categories"`
+	VanishedCategories []CategoryComparisonResponse `json:"vanished_categories"`
+}
+
+type CategoryComparisonResponse struct {
+	CategoryID   int                 `json:"category_id"`
+	CategoryName string              `json:"category_name"`
+	Status       string              `json:"status"`
+	Recurring    AmountDeltaResponse `json:"recurring"`
+	OneTime      AmountDeltaResponse `json:"one_time"`
+	Total        AmountDeltaResponse `json:"total"`
+}
+
+type AmountDeltaResponse struct {
+	Base    string  `json:"base"`
+	Current string  `json:"current"`
+	Delta   string  `json:"delta"`
+	Percent *string `json:"percent"`
+}
+
+type CategorySeriesResponse struct {
+	CategoryID   int      `json:"category_id"`
+	CategoryName string   `json:"category_name"`
+	Values       []string `json:"values"`
+	Total        string   `json:"total"`
+	Average      string   `json:"average"`
+}
+
+type TaxSummaryResponse struct {
+	HouseholdID int                       `json:"household_id"`
+	Year        int                       `json:"year"`
+	Classes     []TaxClassSummaryResponse `json:"classes"`
+	Total       string                    `json:"total"`
+}
+
+type TaxClassSummaryResponse struct {
+	Class        string                           `json:"class"`
+	OneTime      string                           `json:"one_time"`
+	Recurring    string                           `json:"recurring"`
+	Total        string                           `json:"total"`
+	Transactions []TaxTransactionResponse         `json:"transactions"`
+	Occurrences  []TaxRecurringOccurrenceResponse `json:"recurring_occurrences"`
+}
+
+type TaxTransactionResponse struct {
+	TransactionID int    `json:"transaction_id"`
+	Date          string `json:"date"`
+	CategoryID    int    `json:"category_id"`
+	CategoryName  string `json:"category_name"`
+	Description   string `json:"description"`
+	Amount        string `json:"amount"`
+	Inherited     bool   `json:"inherited"`
+}
+
+type TaxRecurringOccurrenceResponse struct {
+	RecurringExpenseID int    `json:"recurring_expense_id"`
+	Name               string `json:"name"`
+	CategoryID         int    `json:"category_id"`
+	CategoryName       string `json:"category_name"`
+	Date               string `json:"date"`
+	Amount             string `json:"amount"`
+	Frequency          string `json:"frequency"`
+}
+
+// Member DTOs
+type CreateMemberRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateMemberRequest struct {
+	Name string `json:"name"`
+}
+
+type MemberResponse struct {
+	ID          int       `json:"id"`
+	HouseholdID int       `json:"household_id"`
+	Name        string    `json:"name"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Settlement DTOs
+type CreateSettlementRequest struct {
+	FromMemberID int    `json:"from_member_id"`
+	ToMemberID   int    `json:"to_member_id"`
+	Amount       string `json:"amount"`
+	Date         string `json:"date"`
+	Note         string `json:"note"`
+}
+
+type SettlementResponse struct {
+	ID           int       `json:"id"`
+	HouseholdID  int       `json:"household_id"`
+	FromMemberID int       `json:"from_member_id"`
+	ToMemberID   int       `json:"to_member_id"`
+	Amount       string    `json:"amount"`
+	Date         string    `json:"date"`
+	Note         string    `json:"note"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BalancesResponse struct {
+	HouseholdID int                     `json:"household_id"`
+	Members     []MemberBalanceResponse `json:"members"`
+	Transfers   []TransferResponse      `json:"transfers"`
+}
+
+type MemberBalanceResponse struct {
+	MemberID   int    `json:"member_id"`
+	MemberName string `json:"member_name"`
+	Paid       string `json:"paid"`
+	Share      string `json:"share"`
+	Balance    string `json:"balance"`
+}
+
+type TransferResponse struct {
+	FromMemberID int    `json:"from_member_id"`
+	ToMemberID   int    `json:"to_member_id"`
+	Amount       string `json:"amount"`
+}
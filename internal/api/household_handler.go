@@ -80,5 +80,6 @@ func toHouseholdResponse(h *domain.Household) HouseholdResponse {
 		OwnerID:     h.OwnerID,
 		CreatedAt:   h.CreatedAt,
 		UpdatedAt:   h.UpdatedAt,
+		DeletedAt:   h.DeletedAt,
 	}
 }
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type IdentityResponse struct {
+	ID        int       `json:"id"`
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toIdentityResponse(i *domain.UserIdentity) IdentityResponse {
+	return IdentityResponse{
+		ID:        i.ID,
+		Issuer:    i.Issuer,
+		Subject:   i.Subject,
+		Email:     i.Email,
+		CreatedAt: i.CreatedAt,
+	}
+}
+
+func (s *Server) handleListIdentities(c echo.Context) error {
+	identities, err := s.services.Identity.List(c.Request().Context())
+	if err != nil {
+		return respondError(c, err)
+	}
+	return c.JSON(http.StatusOK, toResponses(identities, toIdentityResponse))
+}
+
+func (s *Server) handleUnlinkIdentity(c echo.Context) error {
+	id, err := parseID(c, "identityId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Identity.Unlink(c.Request().Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+func (s *Server) handleListMembers(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	members, err := s.services.Member.List(c.Request().Context(), householdID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := make([]MemberResponse, len(members))
+	for i, m := range members {
+		resp[i] = toMemberResponse(m)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (s *Server) handleCreateMember(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req CreateMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
+	}
+
+	member, err := s.services.Member.Create(c.Request().Context(), householdID, req.Name)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toMemberResponse(member))
+}
+
+func (s *Server) handleUpdateMember(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	memberID, err := parseID(c, "memberId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req UpdateMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
+	}
+
+	member, err := s.services.Member.Update(c.Request().Context(), householdID, memberID, req.Name)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toMemberResponse(member))
+}
+
+func (s *Server) handleDeleteMember(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	memberID, err := parseID(c, "memberId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Member.Delete(c.Request().Context(), householdID, memberID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toMemberResponse(m *domain.HouseholdMember) MemberResponse {
+	return MemberResponse{
+		ID:          m.ID,
+		HouseholdID: m.HouseholdID,
+		Name:        m.Name,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -9,6 +9,7 @@ import (
 func (s *Server) setupMiddleware() {
 	s.echo.Use(mw.Recovery(s.logger))
 	s.echo.Use(mw.RequestID())
+	s.echo.Use(mw.Client())
 	s.echo.Use(middleware.SecureWithConfig(middleware.SecureConfig{
 		XSSProtection:         "1; mode=block",
 		ContentTypeNosniff:    "nosniff",
@@ -62,7 +62,12 @@ func (s *Server) handleCreateRecurringExpense(c echo.Context) error {
 		endDate = &t
 	}
 
-	re, err := s.services.RecurringExpense.Create(c.Request().Context(), householdID, req.CategoryID, req.Name, req.Description, req.Details, amount, freq, startDate, endDate)
+	split, err := parseSplit(req.PaidByMemberID, req.SplitType, req.Shares)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	re, err := s.services.RecurringExpense.Create(c.Request().Context(), householdID, req.CategoryID, req.Name, req.Description, req.Details, amount, freq, startDate, endDate, split)
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -110,7 +115,12 @@ func (s *Server) handleUpdateRecurringExpense(c echo.Context) error {
 		endDate = &t
 	}
 
-	re, err := s.services.RecurringExpense.Update(c.Request().Context(), recurringID, req.CategoryID, req.Name, req.Description, req.Details, amount, freq, req.Active, startDate, endDate)
+	split, err := parseSplit(req.PaidByMemberID, req.SplitType, req.Shares)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	re, err := s.services.RecurringExpense.Update(c.Request().Context(), recurringID, req.CategoryID, req.Name, req.Description, req.Details, amount, freq, req.Active, startDate, endDate, split)
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -137,19 +147,24 @@ func (s *Server) handleDeleteRecurringExpense(c echo.Context) error {
 }
 
 func toRecurringExpenseResponse(re *domain.RecurringExpense) RecurringExpenseResponse {
+	paidBy, splitType, shares := toSplitFields(re.Split)
 	resp := RecurringExpenseResponse{
-		ID:          re.ID,
-		HouseholdID: re.HouseholdID,
-		CategoryID:  re.CategoryID,
-		Name:        re.Name,
-		Description: re.Description,
-		Details:     re.Details,
-		Amount:      re.Amount.String(),
-		Frequency:   string(re.Frequency),
-		Active:      re.Active,
-		StartDate:   re.StartDate.Format("2006-01-02"),
-		CreatedAt:   re.CreatedAt,
-		UpdatedAt:   re.UpdatedAt,
+		ID:             re.ID,
+		HouseholdID:    re.HouseholdID,
+		CategoryID:     re.CategoryID,
+		Name:           re.Name,
+		Description:    re.Description,
+		Details:        re.Details,
+		Amount:         re.Amount.String(),
+		Frequency:      string(re.Frequency),
+		Active:         re.Active,
+		StartDate:      re.StartDate.Format("2006-01-02"),
+		PaidByMemberID: paidBy,
+		SplitType:      splitType,
+		Shares:         shares,
+		CreatedAt:      re.CreatedAt,
+		UpdatedAt:      re.UpdatedAt,
+		DeletedAt:      re.DeletedAt,
 	}
 	if re.EndDate != nil {
 		s := re.EndDate.Format("2006-01-02")
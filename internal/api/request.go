@@ -31,3 +31,65 @@ func parseMonth(c echo.Context) (int, time.Month, error) {
 
 	return t.Year(), t.Month(), nil
 }
+
+// parseMonthParam parses a required YYYY-MM query parameter.
+func parseMonthParam(c echo.Context, param string) (time.Time, error) {
+	value := c.QueryParam(param)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("%w: %s is required", domain.ErrValidation, param)
+	}
+	t, err := time.Parse("2006-01", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid %s format, expected YYYY-MM", domain.ErrValidation, param)
+	}
+	return t, nil
+}
+
+// parsePeriods reads the "current" and "base" period query parameters. The
+// current period defaults to the current year, the base period to the same
+// period one year earlier.
+func parsePeriods(c echo.Context) (base, current domain.Period, err error) {
+	currentStr := c.QueryParam("current")
+	if currentStr == "" {
+		currentStr = time.Now().Format("2006")
+	}
+	current, err = domain.ParsePeriod(currentStr)
+	if err != nil {
+		return base, current, err
+	}
+
+	baseStr := c.QueryParam("base")
+	if baseStr == "" {
+		return current.PreviousYear(), current, nil
+	}
+	base, err = domain.ParsePeriod(baseStr)
+	return base, current, err
+}
+
+// parseYear reads the "year" query parameter. It defaults to the previous
+// calendar year, the one usually being prepared for the tax return.
+func parseYear(c echo.Context) (int, error) {
+	value := c.QueryParam("year")
+	if value == "" {
+		return time.Now().Year() - 1, nil
+	}
+	year, err := strconv.Atoi(value)
+	if err != nil || year < 1 || year > 9999 {
+		return 0, fmt.Errorf("%w: invalid year format, expected YYYY", domain.ErrValidation)
+	}
+	return year, nil
+}
+
+// parseSplit builds the split of a transaction or recurring expense request.
+// It returns nil if the request doesn't share the amount between members.
+func parseSplit(paidBy *int, splitType string, shares []SplitShareDTO) (*domain.Split, error) {
+	parsed := make([]domain.SplitShare, 0, len(shares))
+	for _, sh := range shares {
+		share, err := domain.NewSplitShare(sh.MemberID, sh.Value)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, share)
+	}
+	return domain.NewSplit(paidBy, splitType, parsed), nil
+}
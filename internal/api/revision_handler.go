@@ -0,0 +1,76 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type RevisionResponse struct {
+	ID          int                      `json:"id"`
+	HouseholdID int                      `json:"household_id"`
+	EntityType  string                   `json:"entity_type"`
+	EntityID    int                      `json:"entity_id"`
+	Action      string                   `json:"action"`
+	UserID      *int                     `json:"user_id"`
+	UserName    string                   `json:"user_name,omitempty"`
+	Before      map[string]string        `json:"before,omitempty"`
+	After       map[string]string        `json:"after,omitempty"`
+	Changes     []RevisionChangeResponse `json:"changes"`
+	CreatedAt   time.Time                `json:"created_at"`
+}
+
+type RevisionChangeResponse struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// handleHistory returns a handler listing the revisions of the record whose
+// ID is in param. The household is always taken from "id".
+func (s *Server) handleHistory(entityType domain.EntityType, param string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		householdID, err := parseID(c, "id")
+		if err != nil {
+			return respondError(c, err)
+		}
+		entityID, err := parseID(c, param)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		revisions, err := s.services.Revision.History(c.Request().Context(), householdID, entityType, entityID)
+		if err != nil {
+			return respondError(c, err)
+		}
+
+		resp := make([]RevisionResponse, len(revisions))
+		for i, r := range revisions {
+			resp[i] = toRevisionResponse(r)
+		}
+		return c.JSON(http.StatusOK, resp)
+	}
+}
+
+func toRevisionResponse(r *domain.Revision) RevisionResponse {
+	changes := r.Changes()
+	resp := RevisionResponse{
+		ID:          r.ID,
+		HouseholdID: r.HouseholdID,
+		EntityType:  string(r.EntityType),
+		EntityID:    r.EntityID,
+		Action:      string(r.Action),
+		UserID:      r.UserID,
+		UserName:    r.UserName,
+		Before:      r.Before,
+		After:       r.After,
+		Changes:     make([]RevisionChangeResponse, len(changes)),
+		CreatedAt:   r.CreatedAt,
+	}
+	for i, ch := range changes {
+		resp.Changes[i] = RevisionChangeResponse{Field: ch.Field, Before: ch.Before, After: ch.After}
+	}
+	return resp
+}
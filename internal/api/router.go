@@ -1,6 +1,9 @@
 package api
 
 import (
+	"net"
+	"net/http"
+
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gorilla/sessions"
@@ -8,8 +11,10 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/devmode"
+	"icekalt.dev/money-tracker/internal/domain"
 	gql "icekalt.dev/money-tracker/internal/graphql"
 	mw "icekalt.dev/money-tracker/internal/middleware"
+	"icekalt.dev/money-tracker/internal/ratelimit"
 )
 
 func (s *Server) setupRoutes() {
@@ -24,19 +29,44 @@ func (s *Server) setupRoutes() {
 	// Static files
 	s.setupStatic()
 
+	// Locale middleware
+	localeMW := mw.Locale(s.defaultLocale)
+
+	csrfMW := middleware.CSRFWithConfig(middleware.CSRFConfig{
+		TokenLookup: "form:_csrf",
+		CookieName:  "_csrf",
+		CookiePath:  "/",
+		ContextKey:  "csrf",
+	})
+
+	// Rate limiting, a no-op without a limiter
+	rateLimitMW := mw.RateLimit(s.rateLimiter)
+	authRateLimitMW := mw.AuthRateLimit(s.rateLimiter)
+
 	// Auth routes (no auth middleware)
 	if s.authHandler != nil {
-		s.echo.GET("/login", s.handleLoginPage)
-		s.echo.GET("/auth/login", s.authHandler.HandleLogin)
-		s.echo.GET("/auth/callback", s.authHandler.HandleCallback)
+		loginGroup := s.echo.Group("", localeMW, csrfMW)
+		loginGroup.GET("/login", s.handleLoginPage)
+		loginGroup.GET("/login/denied", s.handleLoginDenied)
+		if s.authHandler.localAuth {
+			loginGroup.POST("/auth/local/login", s.authHandler.HandleLocalLogin, authRateLimitMW)
+		}
+		// The provider without a name has the plain paths, the others
+		// their name appended
+		if len(s.authHandler.providers) > 0 {
+			for _, suffix := range []string{"", "/:provider"} {
+				s.echo.GET("/auth/login"+suffix, s.authHandler.HandleLogin, authRateLimitMW)
+				s.echo.GET("/auth/callback"+suffix, s.authHandler.HandleCallback, authRateLimitMW)
+				s.echo.POST("/auth/backchannel-logout"+suffix, s.authHandler.HandleBackchannelLogout, authRateLimitMW)
+			}
+		}
 		s.echo.GET("/auth/logout", s.authHandler.HandleLogout)
+	} else if s.headerAuth != nil {
+		s.echo.GET("/auth/logout", s.handleProxyLogout)
 	}
 
 	// Auth middleware for all protected routes
-	authMW := mw.Auth(s.sessionStore, s.services.APIToken, s.devUserID)
-
-	// Locale middleware
-	localeMW := mw.Locale(s.defaultLocale)
+	authMW := mw.Auth(s.sessionStore, s.services.APIToken, s.headerAuth, s.devUserID)
 
 	// --- API Routes ---
 	apiGroup := s.echo.Group("/api/v1")
@@ -50,46 +80,94 @@ func (s *Server) setupRoutes() {
 	}
 	apiGroup.GET("/health", s.handleHealth)
 	apiGroup.GET("/openapi.yaml", s.handleOpenAPISpec)
-	apiGroup.Use(authMW)
+	apiGroup.Use(rateLimitMW, authMW)
 
 	// Households
 	apiGroup.GET("/households", s.handleListHouseholds)
 	apiGroup.POST("/households", s.handleCreateHousehold)
 	apiGroup.PUT("/households/:id", s.handleUpdateHousehold)
 	apiGroup.DELETE("/households/:id", s.handleDeleteHousehold)
+	apiGroup.GET("/households/:id/history", s.handleHistory(domain.EntityHousehold, "id"))
 
 	// Categories
 	apiGroup.GET("/households/:id/categories", s.handleListCategories)
 	apiGroup.POST("/households/:id/categories", s.handleCreateCategory)
 	apiGroup.PUT("/households/:id/categories/:categoryId", s.handleUpdateCategory)
 	apiGroup.DELETE("/households/:id/categories/:categoryId", s.handleDeleteCategory)
+	apiGroup.GET("/households/:id/categories/:categoryId/history", s.handleHistory(domain.EntityCategory, "categoryId"))
 
 	// Transactions
 	apiGroup.GET("/households/:id/transactions", s.handleListTransactions)
 	apiGroup.POST("/households/:id/transactions", s.handleCreateTransaction)
 	apiGroup.PUT("/households/:id/transactions/:transactionId", s.handleUpdateTransaction)
 	apiGroup.DELETE("/households/:id/transactions/:transactionId", s.handleDeleteTransaction)
+	apiGroup.GET("/households/:id/transactions/:transactionId/history", s.handleHistory(domain.EntityTransaction, "transactionId"))
 
 	// Recurring Expenses
 	apiGroup.GET("/households/:id/recurring-expenses", s.handleListRecurringExpenses)
 	apiGroup.POST("/households/:id/recurring-expenses", s.handleCreateRecurringExpense)
 	apiGroup.PUT("/households/:id/recurring-expenses/:recurringId", s.handleUpdateRecurringExpense)
 	apiGroup.DELETE("/households/:id/recurring-expenses/:recurringId", s.handleDeleteRecurringExpense)
+	apiGroup.GET("/households/:id/recurring-expenses/:recurringId/history", s.handleHistory(domain.EntityRecurringExpense, "recurringId"))
 
 	// Schedule Overrides
 	apiGroup.GET("/households/:id/recurring-expenses/:recurringId/overrides", s.handleListScheduleOverrides)
 	apiGroup.POST("/households/:id/recurring-expenses/:recurringId/overrides", s.handleCreateScheduleOverride)
 	apiGroup.PUT("/households/:id/recurring-expenses/:recurringId/overrides/:overrideId", s.handleUpdateScheduleOverride)
 	apiGroup.DELETE("/households/:id/recurring-expenses/:recurringId/overrides/:overrideId", s.handleDeleteScheduleOverride)
+	apiGroup.GET("/households/:id/recurring-expenses/:recurringId/overrides/:overrideId/history", s.handleHistory(domain.EntityScheduleOverride, "overrideId"))
+
+	// Trash
+	apiGroup.GET("/households/trash", s.handleListDeletedHouseholds)
+	apiGroup.POST("/households/:id/restore", s.handleRestoreHousehold)
+	apiGroup.GET("/households/:id/trash", s.handleGetTrash)
+	apiGroup.POST("/households/:id/transactions/:transactionId/restore", s.handleRestoreTransaction)
+	apiGroup.POST("/households/:id/recurring-expenses/:recurringId/restore", s.handleRestoreRecurringExpense)
+
+	// Members and settlements
+	apiGroup.GET("/households/:id/members", s.handleListMembers)
+	apiGroup.POST("/households/:id/members", s.handleCreateMember)
+	apiGroup.PUT("/households/:id/members/:memberId", s.handleUpdateMember)
+	apiGroup.DELETE("/households/:id/members/:memberId", s.handleDeleteMember)
+	apiGroup.GET("/households/:id/balances", s.handleGetBalances)
+	apiGroup.GET("/households/:id/settlements", s.handleListSettlements)
+	apiGroup.POST("/households/:id/settlements", s.handleCreateSettlement)
+	apiGroup.DELETE("/households/:id/settlements/:settlementId", s.handleDeleteSettlement)
 
 	// Summary
 	apiGroup.GET("/households/:id/summary", s.handleGetSummary)
+	apiGroup.GET("/households/:id/summary/range", s.handleGetRangeSummary)
+	apiGroup.GET("/households/:id/summary/compare", s.handleGetPeriodComparison)
+	apiGroup.GET("/households/:id/summary/tax", s.handleGetTaxSummary)
+	apiGroup.GET("/households/:id/summary/tax/export", s.handleExportTaxSummary)
 
 	// API Tokens
 	apiGroup.GET("/tokens", s.handleListTokens)
 	apiGroup.POST("/tokens", s.handleCreateToken)
+	apiGroup.POST("/tokens/:tokenId/rotate", s.handleRotateToken)
 	apiGroup.DELETE("/tokens/:tokenId", s.handleDeleteToken)
 
+	// Sessions
+	apiGroup.GET("/sessions", s.handleListSessions)
+	apiGroup.DELETE("/sessions", s.handleRevokeAllSessions)
+	apiGroup.DELETE("/sessions/:sessionId", s.handleRevokeSession)
+
+	// Linked OIDC identities
+	apiGroup.GET("/identities", s.handleListIdentities)
+	apiGroup.DELETE("/identities/:identityId", s.handleUnlinkIdentity)
+
+	// Security events
+	apiGroup.GET("/security-events", s.handleListSecurityEvents)
+	apiGroup.GET("/security-events/export", s.handleExportSecurityEvents)
+
+	// Account
+	apiGroup.GET("/account/export", s.handleExportAccount)
+	apiGroup.DELETE("/account", s.handleDeleteAccount)
+	apiGroup.GET("/account/transfers", s.handleListTransfers)
+	apiGroup.POST("/account/transfers/:transferId/accept", s.handleAcceptTransfer)
+	apiGroup.POST("/account/transfers/:transferId/decline", s.handleDeclineTransfer)
+	apiGroup.DELETE("/account/transfers/:transferId", s.handleCancelTransfer)
+
 	// --- GraphQL ---
 	gqlHandler := handler.NewDefaultServer(gql.NewExecutableSchema(gql.Config{
 		Resolvers: &gql.Resolver{
@@ -97,7 +175,10 @@ func (s *Server) setupRoutes() {
 			CategorySvc:         s.services.Category,
 			TransactionSvc:      s.services.Transaction,
 			RecurringExpenseSvc: s.services.RecurringExpense,
+			MemberSvc:           s.services.Member,
+			SettlementSvc:       s.services.Settlement,
 			SummarySvc:          s.services.Summary,
+			RevisionSvc:         s.services.Revision,
 		},
 	}))
 
@@ -110,7 +191,7 @@ func (s *Server) setupRoutes() {
 			AllowCredentials: true,
 		}))
 	}
-	graphqlGroup.Use(authMW)
+	graphqlGroup.Use(rateLimitMW, authMW)
 	graphqlGroup.POST("", echo.WrapHandler(gqlHandler))
 
 	// Playground (behind auth so it works with browser sessions)
@@ -128,23 +209,33 @@ func (s *Server) setupRoutes() {
 	// --- Web Routes ---
 	webGroup := s.echo.Group("")
 	webGroup.Use(localeMW)
-	webAuthMW := mw.WebAuth(s.sessionStore, s.services.APIToken, s.devUserID)
+	webAuthMW := mw.WebAuth(s.sessionStore, s.services.APIToken, s.headerAuth, s.devUserID)
+	if s.authHandler == nil && s.headerAuth != nil {
+		// Only the proxy logs users in, there is no login page to go to
+		webAuthMW = authMW
+	}
 	webGroup.Use(webAuthMW)
-	webGroup.Use(middleware.CSRFWithConfig(middleware.CSRFConfig{
-		TokenLookup: "form:_csrf",
-		CookieName:  "_csrf",
-		CookiePath:  "/",
-		ContextKey:  "csrf",
-	}))
+	webGroup.Use(csrfMW)
 
 	webGroup.GET("/", s.handleWebDashboard)
 	webGroup.GET("/households/new", s.handleWebHouseholdNew)
 	webGroup.POST("/households", s.handleWebHouseholdCreate)
 	webGroup.GET("/households/:id", s.handleWebHouseholdDetail)
+	webGroup.POST("/households/:id/restore", s.handleWebHouseholdRestore)
 	webGroup.GET("/households/:id/transactions/new", s.handleWebTransactionNew)
 	webGroup.POST("/households/:id/transactions", s.handleWebTransactionCreate)
 	webGroup.GET("/households/:id/transactions/:transactionId/edit", s.handleWebTransactionEdit)
 	webGroup.POST("/households/:id/transactions/:transactionId", s.handleWebTransactionUpdate)
+	webGroup.POST("/households/:id/transactions/:transactionId/restore", s.handleWebTransactionRestore)
+	webGroup.GET("/households/:id/compare", s.handleWebHouseholdCompare)
+	webGroup.GET("/households/:id/tax", s.handleWebHouseholdTax)
+	webGroup.GET("/households/:id/tax/export", s.handleExportTaxSummary)
+	webGroup.GET("/households/:id/charts/expenses-by-category.svg", s.handleChartExpensesByCategory)
+	webGroup.GET("/households/:id/charts/income-expenses.svg", s.handleChartIncomeExpenses)
+	webGroup.GET("/households/:id/charts/recurring-by-frequency.svg", s.handleChartRecurringByFrequency)
+	webGroup.GET("/households/:id/balances", s.handleWebHouseholdBalances)
+	webGroup.POST("/households/:id/members", s.handleWebMemberCreate)
+	webGroup.POST("/households/:id/settlements", s.handleWebSettlementCreate)
 	webGroup.GET("/households/:id/settings", s.handleWebHouseholdSettings)
 	webGroup.POST("/households/:id/settings", s.handleWebHouseholdSettingsUpdate)
 	webGroup.GET("/households/:id/categories", s.handleWebCategoryList)
@@ -156,19 +247,87 @@ func (s *Server) setupRoutes() {
 	webGroup.POST("/households/:id/recurring", s.handleWebRecurringCreate)
 	webGroup.GET("/households/:id/recurring/:recurringId/edit", s.handleWebRecurringEdit)
 	webGroup.POST("/households/:id/recurring/:recurringId", s.handleWebRecurringUpdate)
+	webGroup.POST("/households/:id/recurring/:recurringId/restore", s.handleWebRecurringRestore)
 	webGroup.POST("/households/:id/recurring/:recurringId/overrides", s.handleWebOverrideCreate)
 	webGroup.POST("/households/:id/recurring/:recurringId/overrides/:overrideId/delete", s.handleWebOverrideDelete)
 	webGroup.GET("/settings", s.handleWebUserSettings)
 	webGroup.POST("/settings", s.handleWebUserSettingsUpdate)
+	webGroup.POST("/settings/password", s.handleWebPasswordChange)
+	webGroup.GET("/settings/export", s.handleExportAccount)
+	webGroup.POST("/settings/delete", s.handleWebAccountDelete)
+	webGroup.POST("/settings/transfers/:transferId/accept", s.handleWebTransferAccept)
+	webGroup.POST("/settings/transfers/:transferId/decline", s.handleWebTransferDecline)
+	webGroup.POST("/settings/transfers/:transferId/cancel", s.handleWebTransferCancel)
+	webGroup.POST("/settings/identities/:identityId/delete", s.handleWebIdentityUnlink)
+	if s.authHandler != nil && len(s.authHandler.providers) > 0 {
+		webGroup.POST("/settings/identities/link", s.authHandler.HandleLink)
+		webGroup.POST("/settings/identities/link/:provider", s.authHandler.HandleLink)
+		webGroup.GET("/settings/identities/pending", s.handleWebPendingIdentity)
+		webGroup.POST("/settings/identities/pending", s.authHandler.HandleLinkPending)
+		webGroup.POST("/settings/identities/pending/dismiss", s.authHandler.HandleDismissPending)
+	}
 	webGroup.GET("/tokens", s.handleWebTokenList)
 	webGroup.POST("/tokens", s.handleWebTokenCreate)
+	webGroup.POST("/tokens/:tokenId/rotate", s.handleWebTokenRotate)
+	webGroup.POST("/tokens/:tokenId/revoke", s.handleWebTokenRevoke)
+	webGroup.GET("/sessions", s.handleWebSessionList)
+	webGroup.POST("/sessions/revoke-all", s.handleWebSessionRevokeAll)
+	webGroup.POST("/sessions/:sessionId/revoke", s.handleWebSessionRevoke)
+	webGroup.GET("/security", s.handleWebSecurityEvents)
+	webGroup.GET("/security/export", s.handleExportSecurityEvents)
+	webGroup.GET("/admin", s.handleWebAdmin)
+	webGroup.GET("/admin/events", s.handleWebAdminEvents)
+	webGroup.GET("/admin/events/export", s.handleExportAllSecurityEvents)
+}
+
+// SetupRateLimit enables rate limiting for the API, GraphQL and login
+// endpoints.
+func (s *Server) SetupRateLimit(limiter *ratelimit.Limiter) {
+	s.rateLimiter = limiter
+}
+
+// SetTrustedProxies makes the client address of requests from the given
+// networks come from X-Forwarded-For. Without trusted proxies the direct
+// peer address is used.
+func (s *Server) SetTrustedProxies(proxies []*net.IPNet) {
+	if len(proxies) == 0 {
+		s.echo.IPExtractor = echo.ExtractIPDirect()
+		return
+	}
+	opts := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, p := range proxies {
+		opts = append(opts, echo.TrustIPRange(p))
+	}
+	s.echo.IPExtractor = echo.ExtractIPFromXFFHeader(opts...)
+}
+
+// SetupHeaderAuth makes the server accept users from the headers of an
+// authenticating reverse proxy, next to the methods of SetupAuth.
+func (s *Server) SetupHeaderAuth(h *mw.HeaderAuth) {
+	s.headerAuth = h
+}
+
+// handleProxyLogout sends users to the proxy's logout when it is the only
+// login method; the proxy would log them right back in otherwise.
+func (s *Server) handleProxyLogout(c echo.Context) error {
+	target := s.headerAuth.LogoutURL
+	if target == "" {
+		target = "/"
+	}
+	return c.Redirect(http.StatusFound, target)
 }
 
-// SetupAuth configures authentication for the server.
-func (s *Server) SetupAuth(oidcCfg *auth.OIDCConfig, store sessions.Store, devUserID int) {
+// SetupAuth configures authentication for the server. providers are the
+// OIDC providers users can log in with, none when OIDC isn't configured;
+// localAuth enables username/password logins.
+func (s *Server) SetupAuth(providers []*auth.OIDCConfig, localAuth bool, store sessions.Store, devUserID int) {
 	s.sessionStore = store
 	s.devUserID = devUserID
-	if oidcCfg != nil {
-		s.authHandler = NewAuthHandler(oidcCfg, store, s.services)
+	if len(providers) > 0 || localAuth {
+		s.authHandler = NewAuthHandler(providers, localAuth, store, s.services)
 	}
 }
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type SecurityEventResponse struct {
+	ID        int               `json:"id"`
+	Type      string            `json:"type"`
+	UserID    *int              `json:"user_id"`
+	IP        string            `json:"ip"`
+	UserAgent string            `json:"user_agent"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+func (s *Server) handleListSecurityEvents(c echo.Context) error {
+	events, err := s.services.Security.List(c.Request().Context())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := make([]SecurityEventResponse, len(events))
+	for i, e := range events {
+		resp[i] = toSecurityEventResponse(e)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (s *Server) handleExportSecurityEvents(c echo.Context) error {
+	return writeSecurityEvents(c, "security-events.jsonl", s.services.Security.Each)
+}
+
+func (s *Server) handleExportAllSecurityEvents(c echo.Context) error {
+	return writeSecurityEvents(c, "security-events-all.jsonl", s.services.Admin.EachEvent)
+}
+
+// writeSecurityEvents streams the events as JSON lines, oldest first. The
+// response is only committed with the first event, so errors before that,
+// such as a missing permission, still get a proper status.
+func writeSecurityEvents(c echo.Context, filename string, each func(context.Context, func(*domain.SecurityEvent) error) error) error {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	enc := json.NewEncoder(res)
+	err := each(c.Request().Context(), func(e *domain.SecurityEvent) error {
+		return enc.Encode(toSecurityEventResponse(e))
+	})
+	if err != nil {
+		if res.Committed {
+			return err
+		}
+		res.Header().Del(echo.HeaderContentDisposition)
+		return respondError(c, err)
+	}
+	if !res.Committed {
+		res.WriteHeader(http.StatusOK)
+	}
+	return nil
+}
+
+func toSecurityEventResponse(e *domain.SecurityEvent) SecurityEventResponse {
+	return SecurityEventResponse{
+		ID:        e.ID,
+		Type:      string(e.Type),
+		UserID:    e.UserID,
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		Details:   e.Details,
+		CreatedAt: e.CreatedAt,
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"icekalt.dev/money-tracker/internal/i18n"
+	mw "icekalt.dev/money-tracker/internal/middleware"
+	"icekalt.dev/money-tracker/internal/ratelimit"
 	"icekalt.dev/money-tracker/internal/service"
 
 	"github.com/gorilla/sessions"
@@ -25,6 +27,8 @@ type Server struct {
 	services      *Services
 	sessionStore  sessions.Store
 	authHandler   *AuthHandler
+	headerAuth    *mw.HeaderAuth
+	rateLimiter   *ratelimit.Limiter
 	devUserID     int
 	renderer      *TemplateRenderer
 	i18nBundle    *i18n.Bundle
@@ -37,14 +41,25 @@ type Services struct {
 	Category         *service.CategoryService
 	Transaction      *service.TransactionService
 	RecurringExpense *service.RecurringExpenseService
+	Member           *service.MemberService
+	Settlement       *service.SettlementService
 	Summary          *service.SummaryService
 	APIToken         *service.APITokenService
+	Session          *service.SessionService
+	Admin            *service.AdminService
+	Security         *service.SecurityEventService
+	Revision         *service.RevisionService
+	Trash            *service.TrashService
+	Account          *service.AccountService
+	Identity         *service.IdentityService
 }
 
 func NewServer(logger *zap.Logger, host string, port int, corsOrigins []string, svc *Services, language string) *Server {
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
+	// Forwarded headers can be set by any client, see SetTrustedProxies
+	e.IPExtractor = echo.ExtractIPDirect()
 
 	locale := i18n.ParseLocale(language)
 	bundle := i18n.NewBundle(locale)
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/auth"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type SessionResponse struct {
+	ID         int       `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+func (s *Server) handleListSessions(c echo.Context) error {
+	sessions, err := s.services.Session.List(c.Request().Context())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	current := s.currentSessionToken(c)
+	resp := make([]SessionResponse, len(sessions))
+	for i, sess := range sessions {
+		resp[i] = toSessionResponse(sess, current)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (s *Server) handleRevokeSession(c echo.Context) error {
+	id, err := parseID(c, "sessionId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Session.Revoke(c.Request().Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) handleRevokeAllSessions(c echo.Context) error {
+	if _, err := s.services.Session.RevokeAll(c.Request().Context()); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// currentSessionToken returns the token of the session the request was made
+// with, or "" for requests authenticated otherwise.
+func (s *Server) currentSessionToken(c echo.Context) string {
+	if s.sessionStore == nil {
+		return ""
+	}
+	session, err := s.sessionStore.Get(c.Request(), auth.SessionName)
+	if err != nil || session.IsNew {
+		return ""
+	}
+	return session.ID
+}
+
+func toSessionResponse(sess *domain.Session, currentToken string) SessionResponse {
+	return SessionResponse{
+		ID:         sess.ID,
+		UserAgent:  sess.UserAgent,
+		IP:         sess.IP,
+		CreatedAt:  sess.CreatedAt,
+		LastSeenAt: sess.LastSeenAt,
+		ExpiresAt:  sess.ExpiresAt,
+		Current:    currentToken != "" && sess.Token == currentToken,
+	}
+}
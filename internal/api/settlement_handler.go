@@ -0,0 +1,127 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+func (s *Server) handleGetBalances(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	balances, err := s.services.Settlement.Balances(c.Request().Context(), householdID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toBalancesResponse(balances))
+}
+
+func (s *Server) handleListSettlements(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	settlements, err := s.services.Settlement.List(c.Request().Context(), householdID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := make([]SettlementResponse, len(settlements))
+	for i, st := range settlements {
+		resp[i] = toSettlementResponse(st)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (s *Server) handleCreateSettlement(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	var req CreateSettlementRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
+	}
+
+	amount, err := domain.NewMoney(req.Amount)
+	if err != nil {
+		return respondError(c, fmt.Errorf("%w: invalid amount", domain.ErrValidation))
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return respondError(c, fmt.Errorf("%w: invalid date format, expected YYYY-MM-DD", domain.ErrValidation))
+	}
+
+	settlement, err := s.services.Settlement.Record(c.Request().Context(), householdID, req.FromMemberID, req.ToMemberID, amount, date, req.Note)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, toSettlementResponse(settlement))
+}
+
+func (s *Server) handleDeleteSettlement(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	settlementID, err := parseID(c, "settlementId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Settlement.Delete(c.Request().Context(), householdID, settlementID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toSettlementResponse(st *domain.Settlement) SettlementResponse {
+	return SettlementResponse{
+		ID:           st.ID,
+		HouseholdID:  st.HouseholdID,
+		FromMemberID: st.FromMemberID,
+		ToMemberID:   st.ToMemberID,
+		Amount:       st.Amount.String(),
+		Date:         st.Date.Format("2006-01-02"),
+		Note:         st.Note,
+		CreatedAt:    st.CreatedAt,
+	}
+}
+
+func toBalancesResponse(b *domain.Balances) BalancesResponse {
+	resp := BalancesResponse{
+		HouseholdID: b.HouseholdID,
+		Members:     make([]MemberBalanceResponse, len(b.Members)),
+		Transfers:   make([]TransferResponse, len(b.Transfers)),
+	}
+	for i, m := range b.Members {
+		resp.Members[i] = MemberBalanceResponse{
+			MemberID:   m.MemberID,
+			MemberName: m.MemberName,
+			Paid:       m.Paid.String(),
+			Share:      m.Share.String(),
+			Balance:    m.Balance.String(),
+		}
+	}
+	for i, t := range b.Transfers {
+		resp.Transfers[i] = TransferResponse{
+			FromMemberID: t.FromMemberID,
+			ToMemberID:   t.ToMemberID,
+			Amount:       t.Amount.String(),
+		}
+	}
+	return resp
+}
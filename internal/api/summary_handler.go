@@ -26,6 +26,48 @@ func (s *Server) handleGetSummary(c echo.Context) error {
 	return c.JSON(http.StatusOK, toSummaryResponse(summary))
 }
 
+func (s *Server) handleGetRangeSummary(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	from, err := parseMonthParam(c, "from")
+	if err != nil {
+		return respondError(c, err)
+	}
+	to, err := parseMonthParam(c, "to")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	summary, err := s.services.Summary.GetRangeSummary(c.Request().Context(), householdID, from, to)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toRangeSummaryResponse(summary))
+}
+
+func (s *Server) handleGetPeriodComparison(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	base, current, err := parsePeriods(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	cmp, err := s.services.Summary.ComparePeriods(c.Request().Context(), householdID, base, current)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toPeriodComparisonResponse(cmp))
+}
+
 func toSummaryResponse(s *domain.MonthlySummary) SummaryResponse {
 	breakdown := make([]CategorySummaryResponse, len(s.CategoryBreakdown))
 	for i, cs := range s.CategoryBreakdown {
@@ -53,3 +95,88 @@ func toSummaryResponse(s *domain.MonthlySummary) SummaryResponse {
 		CategoryBreakdown: breakdown,
 	}
 }
+
+func toRangeSummaryResponse(s *domain.RangeSummary) RangeSummaryResponse {
+	months := make([]MonthTotalsResponse, len(s.Months))
+	for i, m := range s.Months {
+		months[i] = MonthTotalsResponse{
+			Month:     m.Month,
+			Income:    m.Income.String(),
+			Expenses:  m.Expenses.String(),
+			Recurring: m.Recurring.String(),
+			OneTime:   m.OneTime.String(),
+			Total:     m.Total.String(),
+		}
+	}
+
+	categories := make([]CategorySeriesResponse, len(s.Categories))
+	for i, cs := range s.Categories {
+		values := make([]string, len(cs.Values))
+		for j, v := range cs.Values {
+			values[j] = v.String()
+		}
+		categories[i] = CategorySeriesResponse{
+			CategoryID:   cs.CategoryID,
+			CategoryName: cs.CategoryName,
+			Values:       values,
+			Total:        cs.Total.String(),
+			Average:      cs.Average.String(),
+		}
+	}
+
+	return RangeSummaryResponse{
+		HouseholdID:     s.HouseholdID,
+		From:            s.From,
+		To:              s.To,
+		Months:          months,
+		Categories:      categories,
+		TotalIncome:     s.TotalIncome.String(),
+		TotalExpenses:   s.TotalExpenses.String(),
+		Total:           s.Total.String(),
+		AverageIncome:   s.AverageIncome.String(),
+		AverageExpenses: s.AverageExpenses.String(),
+		AverageTotal:    s.AverageTotal.String(),
+	}
+}
+
+func toPeriodComparisonResponse(cmp *domain.PeriodComparison) PeriodComparisonResponse {
+	return PeriodComparisonResponse{
+		HouseholdID:        cmp.HouseholdID,
+		Base:               cmp.Base,
+		Current:            cmp.Current,
+		Income:             toAmountDeltaResponse(cmp.Income),
+		Expenses:           toAmountDeltaResponse(cmp.Expenses),
+		Total:              toAmountDeltaResponse(cmp.Total),
+		Categories:         toCategoryComparisonResponses(cmp.Categories),
+		NewCategories:      toCategoryComparisonResponses(cmp.NewCategories),
+		VanishedCategories: toCategoryComparisonResponses(cmp.VanishedCategories),
+	}
+}
+
+func toCategoryComparisonResponses(list []domain.CategoryComparison) []CategoryComparisonResponse {
+	result := make([]CategoryComparisonResponse, len(list))
+	for i, cc := range list {
+		result[i] = CategoryComparisonResponse{
+			CategoryID:   cc.CategoryID,
+			CategoryName: cc.CategoryName,
+			Status:       string(cc.Status),
+			Recurring:    toAmountDeltaResponse(cc.Recurring),
+			OneTime:      toAmountDeltaResponse(cc.OneTime),
+			Total:        toAmountDeltaResponse(cc.Total),
+		}
+	}
+	return result
+}
+
+func toAmountDeltaResponse(d domain.AmountDelta) AmountDeltaResponse {
+	resp := AmountDeltaResponse{
+		Base:    d.Base.String(),
+		Current: d.Current.String(),
+		Delta:   d.Delta.String(),
+	}
+	if d.Percent != nil {
+		p := d.Percent.String()
+		resp.Percent = &p
+	}
+	return resp
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"icekalt.dev/money-tracker/internal/chart"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/i18n"
+	"icekalt.dev/money-tracker/internal/pdf"
+)
+
+// taxExport renders a tax summary as CSV or PDF in the requested locale.
+type taxExport struct {
+	summary   *domain.TaxSummary
+	household *domain.Household
+	bundle    *i18n.Bundle
+	locale    i18n.Locale
+	money     func(domain.Money) string
+}
+
+func (e taxExport) t(key string, args ...interface{}) string {
+	return e.bundle.T(e.locale, key, args...)
+}
+
+func (e taxExport) className(tc domain.TaxClass) string {
+	return e.t("tax_class_" + string(tc))
+}
+
+// writeCSV writes one row per supporting transaction and recurring
+// occurrence. Amounts and dates are machine readable; headers and labels are
+// localized.
+func (e taxExport) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		e.t("tax_class"), e.t("type"), e.t("date"), e.t("category"),
+		e.t("description"), e.t("frequency"), e.t("amount"),
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, cs := range e.summary.Classes {
+		class := e.className(cs.Class)
+		for _, tx := range cs.Transactions {
+			if err := cw.Write([]string{
+				class, e.t("one_time"), tx.Date.Format("2006-01-02"), tx.CategoryName,
+				tx.Description, "", tx.Amount.StringFixed(2),
+			}); err != nil {
+				return err
+			}
+		}
+		for _, o := range cs.Occurrences {
+			if err := cw.Write([]string{
+				class, e.t("recurring"), o.Date.Format("2006-01-02"), o.CategoryName,
+				o.Name, e.bundle.FrequencyName(e.locale, string(o.Frequency)), o.Amount.StringFixed(2),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+const (
+	pdfMargin     = 50.0
+	pdfFontSize   = 9.0
+	pdfRowHeight  = 14.0
+	pdfHeaderSize = 12.0
+)
+
+// pdfColumn describes one column of a report table.
+type pdfColumn struct {
+	width float64
+	align chart.Anchor
+}
+
+// pdfReport lays out text and tables top to bottom and starts new pages as
+// needed.
+type pdfReport struct {
+	doc *pdf.Document
+	y   float64
+}
+
+func (r *pdfReport) ensure(height float64) {
+	if r.doc.PageCount() == 0 || r.y+height > pdf.PageHeight-pdfMargin {
+		r.doc.AddPage()
+		r.y = pdfMargin
+	}
+}
+
+func (r *pdfReport) heading(s string, size float64) {
+	r.ensure(size + pdfRowHeight*2)
+	r.y += size
+	r.doc.Text(pdfMargin, r.y, s, chart.TextStyle{Size: size, Color: chart.TextColor, Bold: true})
+	r.y += size * 0.6
+}
+
+func (r *pdfReport) row(cols []pdfColumn, values []string, bold bool) {
+	r.ensure(pdfRowHeight)
+	r.y += pdfRowHeight
+	x := pdfMargin
+	for i, col := range cols {
+		if values[i] == "" {
+			x += col.width
+			continue
+		}
+		style := chart.TextStyle{Size: pdfFontSize, Color: chart.TextColor, Bold: bold, Anchor: col.align}
+		text := pdf.Truncate(values[i], col.width-6, pdfFontSize, bold)
+		tx := x
+		if col.align == chart.AnchorEnd {
+			tx = x + col.width
+		}
+		r.doc.Text(tx, r.y, text, style)
+		x += col.width
+	}
+	r.doc.Line(pdfMargin, r.y+4, pdf.PageWidth-pdfMargin, r.y+4, chart.GridColor, 0.5)
+}
+
+// writePDF writes an overview of all classes with a chart, followed by the
+// supporting transactions and recurring occurrences of each class.
+func (e taxExport) writePDF(w io.Writer) error {
+	title := e.t("tax_summary") + " " + strconv.Itoa(e.summary.Year)
+	doc := pdf.New(title + " – " + e.household.Name)
+	r := &pdfReport{doc: doc}
+
+	r.heading(title, 18)
+	r.ensure(pdfRowHeight)
+	r.y += pdfRowHeight
+	doc.Text(pdfMargin, r.y, e.household.Name, chart.TextStyle{Size: 11, Color: chart.MutedColor})
+	r.y += pdfRowHeight
+
+	if len(e.summary.Classes) == 0 {
+		r.row([]pdfColumn{{width: pdf.PageWidth - 2*pdfMargin}}, []string{e.t("no_tax_data")}, false)
+		_, err := doc.WriteTo(w)
+		return err
+	}
+
+	full := pdf.PageWidth - 2*pdfMargin
+	overview := []pdfColumn{
+		{width: full - 3*90},
+		{width: 90, align: chart.AnchorEnd},
+		{width: 90, align: chart.AnchorEnd},
+		{width: 90, align: chart.AnchorEnd},
+	}
+	r.row(overview, []string{e.t("tax_class"), e.t("one_time"), e.t("recurring"), e.t("total")}, true)
+	bars := make([]chart.Slice, 0, len(e.summary.Classes))
+	for _, cs := range e.summary.Classes {
+		r.row(overview, []string{e.className(cs.Class), e.money(cs.OneTime), e.money(cs.Recurring), e.money(cs.Total)}, false)
+		bars = append(bars, chart.Slice{
+			Label:      e.className(cs.Class),
+			Value:      cs.Total.InexactFloat64(),
+			ValueLabel: e.money(cs.Total),
+		})
+	}
+	r.row(overview, []string{e.t("total"), "", "", e.money(e.summary.Total)}, true)
+
+	chartHeight := 40 + float64(len(bars))*28
+	r.ensure(chartHeight + pdfRowHeight)
+	r.y += pdfRowHeight
+	doc.Draw(chart.HBarChart{Bars: bars}, pdfMargin, r.y, full, chartHeight)
+	r.y += chartHeight
+
+	transactionCols := []pdfColumn{
+		{width: 70},
+		{width: 120},
+		{width: full - 70 - 120 - 100},
+		{width: 100, align: chart.AnchorEnd},
+	}
+	occurrenceCols := []pdfColumn{
+		{width: 70},
+		{width: 120},
+		{width: full - 70 - 120 - 100 - 100},
+		{width: 100},
+		{width: 100, align: chart.AnchorEnd},
+	}
+	formatDate := formatDateForLocale(e.locale, e.bundle)
+
+	for _, cs := range e.summary.Classes {
+		r.y += pdfRowHeight
+		r.heading(fmt.Sprintf("%s: %s", e.className(cs.Class), e.money(cs.Total)), pdfHeaderSize)
+
+		if len(cs.Transactions) > 0 {
+			r.row(transactionCols, []string{e.t("date"), e.t("category"), e.t("description"), e.t("amount")}, true)
+			for _, tx := range cs.Transactions {
+				r.row(transactionCols, []string{formatDate(tx.Date), tx.CategoryName, tx.Description, e.money(tx.Amount)}, false)
+			}
+			r.row(transactionCols, []string{e.t("one_time"), "", "", e.money(cs.OneTime)}, true)
+			r.y += pdfRowHeight / 2
+		}
+
+		if len(cs.Occurrences) > 0 {
+			r.row(occurrenceCols, []string{e.t("date"), e.t("category"), e.t("name"), e.t("frequency"), e.t("amount")}, true)
+			for _, o := range cs.Occurrences {
+				r.row(occurrenceCols, []string{
+					formatDate(o.Date), o.CategoryName, o.Name,
+					e.bundle.FrequencyName(e.locale, string(o.Frequency)), e.money(o.Amount),
+				}, false)
+			}
+			r.row(occurrenceCols, []string{e.t("recurring"), "", "", "", e.money(cs.Recurring)}, true)
+		}
+	}
+
+	_, err := doc.WriteTo(w)
+	return err
+}
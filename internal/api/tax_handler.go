@@ -0,0 +1,131 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+func (s *Server) handleGetTaxSummary(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	year, err := parseYear(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	summary, err := s.services.Summary.GetTaxSummary(c.Request().Context(), householdID, year)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, toTaxSummaryResponse(summary))
+}
+
+// handleExportTaxSummary serves the tax summary as CSV or PDF download. It is
+// registered for both the REST API and the web UI.
+func (s *Server) handleExportTaxSummary(c echo.Context) error {
+	ctx := c.Request().Context()
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	year, err := parseYear(c)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "pdf" {
+		return respondError(c, fmt.Errorf("%w: format must be csv or pdf", domain.ErrValidation))
+	}
+
+	hh, err := s.services.Household.GetByID(ctx, householdID)
+	if err != nil {
+		return respondError(c, err)
+	}
+	summary, err := s.services.Summary.GetTaxSummary(ctx, householdID, year)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	locale := s.queryLocale(c)
+	export := taxExport{
+		summary:   summary,
+		household: hh,
+		bundle:    s.i18nBundle,
+		locale:    locale,
+		money:     s.moneyFormatter(locale, hh.Currency),
+	}
+
+	var buf bytes.Buffer
+	contentType := "text/csv; charset=utf-8"
+	if format == "pdf" {
+		contentType = "application/pdf"
+		err = export.writePDF(&buf)
+	} else {
+		err = export.writeCSV(&buf)
+	}
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	filename := fmt.Sprintf("tax-summary-%d-%d.%s", householdID, year, format)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.Blob(http.StatusOK, contentType, buf.Bytes())
+}
+
+func toTaxSummaryResponse(s *domain.TaxSummary) TaxSummaryResponse {
+	classes := make([]TaxClassSummaryResponse, len(s.Classes))
+	for i, cs := range s.Classes {
+		transactions := make([]TaxTransactionResponse, len(cs.Transactions))
+		for j, tx := range cs.Transactions {
+			transactions[j] = TaxTransactionResponse{
+				TransactionID: tx.TransactionID,
+				Date:          tx.Date.Format("2006-01-02"),
+				CategoryID:    tx.CategoryID,
+				CategoryName:  tx.CategoryName,
+				Description:   tx.Description,
+				Amount:        tx.Amount.String(),
+				Inherited:     tx.Inherited,
+			}
+		}
+		occurrences := make([]TaxRecurringOccurrenceResponse, len(cs.Occurrences))
+		for j, o := range cs.Occurrences {
+			occurrences[j] = TaxRecurringOccurrenceResponse{
+				RecurringExpenseID: o.RecurringExpenseID,
+				Name:               o.Name,
+				CategoryID:         o.CategoryID,
+				CategoryName:       o.CategoryName,
+				Date:               o.Date.Format("2006-01-02"),
+				Amount:             o.Amount.String(),
+				Frequency:          string(o.Frequency),
+			}
+		}
+		classes[i] = TaxClassSummaryResponse{
+			Class:        string(cs.Class),
+			OneTime:      cs.OneTime.String(),
+			Recurring:    cs.Recurring.String(),
+			Total:        cs.Total.String(),
+			Transactions: transactions,
+			Occurrences:  occurrences,
+		}
+	}
+
+	return TaxSummaryResponse{
+		HouseholdID: s.HouseholdID,
+		Year:        s.Year,
+		Classes:     classes,
+		Total:       s.Total.String(),
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
+	"icekalt.dev/money-tracker/internal/domain"
 	"icekalt.dev/money-tracker/internal/i18n"
 	mw "icekalt.dev/money-tracker/internal/middleware"
 	"icekalt.dev/money-tracker/web"
@@ -72,14 +73,19 @@ func NewTemplateRenderer(bundle *i18n.Bundle, defaultLocale i18n.Locale) (*Templ
 		"tf": func(freq string) string {
 			return bundle.FrequencyName(defaultLocale, freq)
 		},
-		"formatMoney": formatMoneyForLocale(defaultLocale, bundle),
-		"formatDate":  formatDateForLocale(defaultLocale, bundle),
+		"formatMoney":    formatMoneyForLocale(defaultLocale, bundle),
+		"formatDate":     formatDateForLocale(defaultLocale, bundle),
+		"formatDateTime": formatDateTimeForLocale(defaultLocale, bundle),
 		"derefTime": func(t *time.Time) time.Time {
 			if t == nil {
 				return time.Time{}
 			}
 			return *t
 		},
+		"isPast": func(t time.Time) bool {
+			return t.Before(time.Now())
+		},
+		"formatBytes": formatBytes,
 		"or": func(a, b string) string {
 			if a != "" {
 				return a
@@ -127,6 +133,11 @@ func NewTemplateRenderer(bundle *i18n.Bundle, defaultLocale i18n.Locale) (*Templ
 		"addDecimal": func(a, b decimal.Decimal) decimal.Decimal {
 			return a.Add(b)
 		},
+		"formatPercent": formatPercentForLocale(defaultLocale, bundle),
+		"deltaClass":    deltaClass,
+		"isSignificant": isSignificantDelta,
+		"splitIncludes": splitIncludes,
+		"splitValue":    splitValue,
 	}
 
 	templatesFS, err := fs.Sub(web.Content, "templates")
@@ -143,6 +154,8 @@ func NewTemplateRenderer(bundle *i18n.Bundle, defaultLocale i18n.Locale) (*Templ
 	partials := []string{
 		"household/tabs.html",
 		"partials/icon-picker.html",
+		"partials/split-fields.html",
+		"partials/history.html",
 	}
 	var partialBytes [][]byte
 	for _, p := range partials {
@@ -156,6 +169,8 @@ func NewTemplateRenderer(bundle *i18n.Bundle, defaultLocale i18n.Locale) (*Templ
 	pages := map[string]string{
 		"dashboard":          "dashboard.html",
 		"login":              "auth/login.html",
+		"login_denied":       "auth/denied.html",
+		"identity_pending":   "auth/pending.html",
 		"household_detail":   "household/detail.html",
 		"household_form":     "household/form.html",
 		"category_list":      "category/list.html",
@@ -165,7 +180,13 @@ func NewTemplateRenderer(bundle *i18n.Bundle, defaultLocale i18n.Locale) (*Templ
 		"recurring_form":     "recurring/form.html",
 		"transaction_form":   "transaction/form.html",
 		"token_list":         "token/list.html",
+		"session_list":       "session/list.html",
+		"security_events":    "security/events.html",
 		"user_settings":      "user/settings.html",
+		"admin":              "admin/index.html",
+		"household_compare":  "household/compare.html",
+		"household_tax":      "household/tax.html",
+		"household_balances": "household/balances.html",
 	}
 
 	templates := make(map[string]*template.Template)
@@ -238,6 +259,8 @@ func (r *TemplateRenderer) Render(w io.Writer, name string, data interface{}, c
 		"formatMoney":             formatMoneyForLocale(locale, r.bundle),
 		"formatMoneyWithCurrency": formatMoneyWithCurrencyForLocale(locale, r.bundle, r.currencyByCode),
 		"formatDate":              formatDateForLocale(locale, r.bundle),
+		"formatDateTime":          formatDateTimeForLocale(locale, r.bundle),
+		"formatPercent":           formatPercentForLocale(locale, r.bundle),
 	})
 
 	var buf bytes.Buffer
@@ -307,3 +330,97 @@ func formatDateForLocale(locale i18n.Locale, bundle *i18n.Bundle) func(time.Time
 		return t.Format(dateFormat)
 	}
 }
+
+// formatDateTimeForLocale formats a timestamp as the locale's date with the
+// time in minutes.
+func formatDateTimeForLocale(locale i18n.Locale, bundle *i18n.Bundle) func(time.Time) string {
+	formatDate := formatDateForLocale(locale, bundle)
+	return func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return formatDate(t) + " " + t.Format("15:04")
+	}
+}
+
+// formatPercentForLocale formats a signed percentage with one decimal, e.g.
+// "+12.5 %". A nil percentage (no base value) is rendered as "–".
+func formatPercentForLocale(locale i18n.Locale, bundle *i18n.Bundle) func(*decimal.Decimal) string {
+	decimalSep := bundle.DecimalSep(locale)
+	return func(p *decimal.Decimal) string {
+		if p == nil {
+			return "–"
+		}
+		s := strings.Replace(p.StringFixed(1), ".", decimalSep, 1)
+		if p.IsPositive() {
+			s = "+" + s
+		}
+		return s + " %"
+	}
+}
+
+// significantChangePercent is the relative change from which a comparison
+// row is highlighted.
+var significantChangePercent = decimal.NewFromInt(10)
+
+func isSignificantDelta(d domain.AmountDelta) bool {
+	if d.Percent == nil {
+		return !d.Delta.IsZero()
+	}
+	return d.Percent.Abs().GreaterThanOrEqual(significantChangePercent)
+}
+
+// deltaClass colors a delta by its effect on the balance: more income or
+// less spending is shown as income, the opposite as expense.
+func deltaClass(d domain.AmountDelta) string {
+	switch {
+	case d.Delta.IsPositive():
+		return "text-income"
+	case d.Delta.IsNegative():
+		return "text-expense"
+	default:
+		return "text-neutral"
+	}
+}
+
+// splitIncludes reports whether a member takes part in a split. Without a
+// split all members do, so the form starts with everyone selected.
+func splitIncludes(split *domain.Split, memberID int) bool {
+	if split == nil {
+		return true
+	}
+	for _, sh := range split.Shares {
+		if sh.MemberID == memberID {
+			return true
+		}
+	}
+	return false
+}
+
+// splitValue returns the share value of a member for percentage and fixed
+// splits.
+func splitValue(split *domain.Split, memberID int) string {
+	if split == nil || split.Type == domain.SplitEqual {
+		return ""
+	}
+	for _, sh := range split.Shares {
+		if sh.MemberID == memberID {
+			return sh.Value.StringFixed(2)
+		}
+	}
+	return ""
+}
+
+// formatBytes formats a size with binary prefixes, e.g. "1.5 MiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -5,19 +5,26 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
 )
 
 type CreateTokenRequest struct {
-	Name string `json:"name"`
+	Name         string     `json:"name"`
+	Access       string     `json:"access,omitempty"`
+	HouseholdIDs []int      `json:"household_ids,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
 }
 
 type TokenResponse struct {
-	ID        int        `json:"id"`
-	Name      string     `json:"name"`
-	Token     string     `json:"token,omitempty"`
-	ExpiresAt *time.Time `json:"expires_at,omitempty"`
-	CreatedAt time.Time  `json:"created_at"`
-	LastUsed  *time.Time `json:"last_used,omitempty"`
+	ID           int        `json:"id"`
+	Name         string     `json:"name"`
+	Token        string     `json:"token,omitempty"`
+	Access       string     `json:"access"`
+	HouseholdIDs []int      `json:"household_ids"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	RotatedAt    *time.Time `json:"rotated_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	LastUsed     *time.Time `json:"last_used,omitempty"`
 }
 
 func (s *Server) handleListTokens(c echo.Context) error {
@@ -28,13 +35,7 @@ func (s *Server) handleListTokens(c echo.Context) error {
 
 	resp := make([]TokenResponse, len(tokens))
 	for i, t := range tokens {
-		resp[i] = TokenResponse{
-			ID:        t.ID,
-			Name:      t.Name,
-			ExpiresAt: t.ExpiresAt,
-			CreatedAt: t.CreatedAt,
-			LastUsed:  t.LastUsed,
-		}
+		resp[i] = toTokenResponse(t)
 	}
 	return c.JSON(http.StatusOK, resp)
 }
@@ -45,18 +46,34 @@ func (s *Server) handleCreateToken(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid request body"})
 	}
 
-	plaintext, token, err := s.services.APIToken.Create(c.Request().Context(), req.Name)
+	scope := domain.TokenScope{
+		Access:       domain.TokenAccess(req.Access),
+		HouseholdIDs: req.HouseholdIDs,
+	}
+	plaintext, token, err := s.services.APIToken.Create(c.Request().Context(), req.Name, scope, req.ExpiresAt)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := toTokenResponse(token)
+	resp.Token = plaintext
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (s *Server) handleRotateToken(c echo.Context) error {
+	id, err := parseID(c, "tokenId")
 	if err != nil {
 		return respondError(c, err)
 	}
 
-	return c.JSON(http.StatusCreated, TokenResponse{
-		ID:        token.ID,
-		Name:      token.Name,
-		Token:     plaintext,
-		ExpiresAt: token.ExpiresAt,
-		CreatedAt: token.CreatedAt,
-	})
+	plaintext, token, err := s.services.APIToken.Rotate(c.Request().Context(), id)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := toTokenResponse(token)
+	resp.Token = plaintext
+	return c.JSON(http.StatusCreated, resp)
 }
 
 func (s *Server) handleDeleteToken(c echo.Context) error {
@@ -71,3 +88,20 @@ func (s *Server) handleDeleteToken(c echo.Context) error {
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+func toTokenResponse(t *domain.APIToken) TokenResponse {
+	householdIDs := t.Scope.HouseholdIDs
+	if householdIDs == nil {
+		householdIDs = []int{}
+	}
+	return TokenResponse{
+		ID:           t.ID,
+		Name:         t.Name,
+		Access:       string(t.Scope.Access),
+		HouseholdIDs: householdIDs,
+		ExpiresAt:    t.ExpiresAt,
+		RotatedAt:    t.RotatedAt,
+		CreatedAt:    t.CreatedAt,
+		LastUsed:     t.LastUsed,
+	}
+}
@@ -20,7 +20,12 @@ func (s *Server) handleListTransactions(c echo.Context) error {
 		return respondError(c, err)
 	}
 
-	transactions, err := s.services.Transaction.ListByMonth(c.Request().Context(), householdID, year, month)
+	filter, err := domain.NewAmountFilter(c.QueryParam("min_amount"), c.QueryParam("max_amount"))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	transactions, err := s.services.Transaction.ListByMonth(c.Request().Context(), householdID, year, month, filter)
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -53,7 +58,12 @@ func (s *Server) handleCreateTransaction(c echo.Context) error {
 		return respondError(c, fmt.Errorf("%w: invalid date format, expected YYYY-MM-DD", domain.ErrValidation))
 	}
 
-	tx, err := s.services.Transaction.Create(c.Request().Context(), householdID, req.CategoryID, amount, req.Description, req.Details, date)
+	split, err := parseSplit(req.PaidByMemberID, req.SplitType, req.Shares)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	tx, err := s.services.Transaction.Create(c.Request().Context(), householdID, req.CategoryID, amount, req.Description, req.Details, domain.TaxClass(req.TaxClass), date, split)
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -87,7 +97,12 @@ func (s *Server) handleUpdateTransaction(c echo.Context) error {
 		return respondError(c, fmt.Errorf("%w: invalid date format, expected YYYY-MM-DD", domain.ErrValidation))
 	}
 
-	tx, err := s.services.Transaction.Update(c.Request().Context(), householdID, txID, req.CategoryID, amount, req.Description, req.Details, date)
+	split, err := parseSplit(req.PaidByMemberID, req.SplitType, req.Shares)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	tx, err := s.services.Transaction.Update(c.Request().Context(), householdID, txID, req.CategoryID, amount, req.Description, req.Details, domain.TaxClass(req.TaxClass), date, split)
 	if err != nil {
 		return respondError(c, err)
 	}
@@ -114,15 +129,39 @@ func (s *Server) handleDeleteTransaction(c echo.Context) error {
 }
 
 func toTransactionResponse(tx *domain.Transaction) TransactionResponse {
+	paidBy, splitType, shares := toSplitFields(tx.Split)
 	return TransactionResponse{
-		ID:          tx.ID,
-		HouseholdID: tx.HouseholdID,
-		CategoryID:  tx.CategoryID,
-		Amount:      tx.Amount.String(),
-		Description: tx.Description,
-		Details:     tx.Details,
-		Date:        tx.Date.Format("2006-01-02"),
-		CreatedAt:   tx.CreatedAt,
-		UpdatedAt:   tx.UpdatedAt,
+		ID:             tx.ID,
+		HouseholdID:    tx.HouseholdID,
+		CategoryID:     tx.CategoryID,
+		Amount:         tx.Amount.String(),
+		Description:    tx.Description,
+		Details:        tx.Details,
+		TaxClass:       string(tx.TaxClass),
+		Date:           tx.Date.Format("2006-01-02"),
+		PaidByMemberID: paidBy,
+		SplitType:      splitType,
+		Shares:         shares,
+		CreatedAt:      tx.CreatedAt,
+		UpdatedAt:      tx.UpdatedAt,
+		DeletedAt:      tx.DeletedAt,
 	}
 }
+
+// toSplitFields returns the payer, split type and shares of a response.
+// Share values are omitted for equal splits.
+func toSplitFields(split *domain.Split) (*int, string, []SplitShareDTO) {
+	if split == nil {
+		return nil, "", nil
+	}
+	paidBy := split.PaidBy
+	shares := make([]SplitShareDTO, 0, len(split.Shares))
+	for _, sh := range split.Shares {
+		dto := SplitShareDTO{MemberID: sh.MemberID}
+		if split.Type != domain.SplitEqual {
+			dto.Value = sh.Value.String()
+		}
+		shares = append(shares, dto)
+	}
+	return &paidBy, string(split.Type), shares
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TrashResponse struct {
+	Transactions      []TransactionResponse      `json:"transactions"`
+	RecurringExpenses []RecurringExpenseResponse `json:"recurring_expenses"`
+	RetentionDays     int                        `json:"retention_days"` // 0 if the trash is kept until restored
+}
+
+func (s *Server) handleListDeletedHouseholds(c echo.Context) error {
+	households, err := s.services.Trash.Households(c.Request().Context())
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := make([]HouseholdResponse, len(households))
+	for i, h := range households {
+		resp[i] = toHouseholdResponse(h)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (s *Server) handleRestoreHousehold(c echo.Context) error {
+	id, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Trash.RestoreHousehold(c.Request().Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) handleGetTrash(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	trash, err := s.services.Trash.List(c.Request().Context(), householdID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	resp := TrashResponse{
+		Transactions:      make([]TransactionResponse, len(trash.Transactions)),
+		RecurringExpenses: make([]RecurringExpenseResponse, len(trash.RecurringExpenses)),
+		RetentionDays:     s.trashRetentionDays(),
+	}
+	for i, tx := range trash.Transactions {
+		resp.Transactions[i] = toTransactionResponse(tx)
+	}
+	for i, re := range trash.RecurringExpenses {
+		resp.RecurringExpenses[i] = toRecurringExpenseResponse(re)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (s *Server) handleRestoreTransaction(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	txID, err := parseID(c, "transactionId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Trash.RestoreTransaction(c.Request().Context(), householdID, txID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) handleRestoreRecurringExpense(c echo.Context) error {
+	householdID, err := parseID(c, "id")
+	if err != nil {
+		return respondError(c, err)
+	}
+	recurringID, err := parseID(c, "recurringId")
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	if err := s.services.Trash.RestoreRecurringExpense(c.Request().Context(), householdID, recurringID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -1,12 +1,15 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/domain"
 	"icekalt.dev/money-tracker/internal/i18n"
 	mw "icekalt.dev/money-tracker/internal/middleware"
@@ -45,6 +48,36 @@ type pageData struct {
 	ErrorMessage       string
 	CategoryMap        map[int]string
 	RecurringMonthlyMap map[int]domain.Money
+	Comparison         *domain.PeriodComparison
+	BasePeriod         string
+	CurrentPeriod      string
+	TaxClasses         []domain.TaxClass
+	TaxSummary         *domain.TaxSummary
+	Year               int
+	Members            []*domain.HouseholdMember
+	MemberMap          map[int]string
+	SplitTypes         []domain.SplitType
+	Balances           *domain.Balances
+	Settlements        []*domain.Settlement
+	HouseholdMap       map[int]string
+	Sessions           []*domain.Session
+	CurrentSessionID   int
+	LoginProviders     []loginProvider
+	LinkEmail          string
+	LinkProvider       string
+	Identities         []linkedIdentity
+	LocalLogin         bool
+	LocalAccount       bool
+	DeniedReason       string
+	Stats              *domain.InstanceStats
+	Events             []*domain.SecurityEvent
+	AllUsers           bool
+	History            []*domain.Revision
+	Trash              *domain.Trash
+	DeletedHouseholds  []*domain.Household
+	RetentionDays      int
+	IncomingTransfers  []*domain.HouseholdTransfer
+	OutgoingTransfers  []*domain.HouseholdTransfer
 }
 
 func (s *Server) getLocale(c echo.Context) i18n.Locale {
@@ -55,7 +88,75 @@ func (s *Server) getLocale(c echo.Context) i18n.Locale {
 }
 
 func (s *Server) handleLoginPage(c echo.Context) error {
-	return c.Render(http.StatusOK, "login", nil)
+	locale := s.getLocale(c)
+	data := pageData{
+		Title:      "login",
+		Lang:       string(locale),
+		LoginProviders: s.loginProviders("/auth/login"),
+		LocalLogin: s.authHandler.localAuth,
+	}
+	if c.QueryParam("error") == "credentials" {
+		data.ErrorMessage = s.i18nBundle.T(locale, "error_invalid_credentials")
+	}
+	// An OIDC login whose email belongs to an existing account waits for
+	// its owner to log in; the same provider would be refused again
+	if session, err := s.sessionStore.Get(c.Request(), auth.SessionName); err == nil {
+		if email, ok := session.Values[sessionKeyPendingEmail].(string); ok {
+			pending, _ := session.Values[sessionKeyPendingProvider].(string)
+			data.LinkEmail = email
+			data.LoginProviders = slices.DeleteFunc(data.LoginProviders, func(p loginProvider) bool {
+				return p.Name == pending
+			})
+		}
+	}
+	return c.Render(http.StatusOK, "login", data)
+}
+
+// loginProvider is an OIDC provider on the login and settings pages.
+type loginProvider struct {
+	Name   string
+	Label  string // empty for the provider without name or display name
+	Issuer string
+	Path   string // its login, or linking it from the settings
+}
+
+// linkedIdentity is an identity on the settings page with the label of its
+// provider.
+type linkedIdentity struct {
+	*domain.UserIdentity
+	Label string
+}
+
+// loginProviders lists the OIDC providers with their paths under base.
+func (s *Server) loginProviders(base string) []loginProvider {
+	if s.authHandler == nil {
+		return nil
+	}
+	providers := make([]loginProvider, len(s.authHandler.providers))
+	for i, p := range s.authHandler.providers {
+		label := p.DisplayName
+		if label == "" {
+			label = p.Name
+		}
+		providers[i] = loginProvider{Name: p.Name, Label: label, Issuer: p.Issuer, Path: p.Path(base)}
+	}
+	return providers
+}
+
+// handleLoginDenied explains why an OIDC login was rejected.
+func (s *Server) handleLoginDenied(c echo.Context) error {
+	reason := "sign_in_not_allowed"
+	switch c.QueryParam("reason") {
+	case "registration_closed":
+		reason = "sign_in_registration_closed"
+	case "disabled":
+		reason = "sign_in_disabled"
+	}
+	return c.Render(http.StatusForbidden, "login_denied", pageData{
+		Title:        "sign_in_denied",
+		Lang:         string(s.getLocale(c)),
+		DeniedReason: reason,
+	})
 }
 
 func (s *Server) handleWebDashboard(c echo.Context) error {
@@ -67,21 +168,36 @@ func (s *Server) handleWebDashboard(c echo.Context) error {
 
 	now := time.Now()
 	year, month := now.Year(), now.Month()
-	summaries := make(map[int]*domain.MonthlySummary, len(households))
-	for _, hh := range households {
-		summary, err := s.services.Summary.GetMonthlySummary(ctx, hh.ID, year, month)
-		if err == nil {
-			summaries[hh.ID] = summary
+	ids := make([]int, len(households))
+	for i, hh := range households {
+		ids[i] = hh.ID
+	}
+	summaries, err := s.services.Summary.GetMonthlySummaries(ctx, ids, year, month)
+	if err != nil {
+		// Load the households one by one, so a household whose summary
+		// fails is shown without one instead of failing the whole page
+		summaries = make(map[int]*domain.MonthlySummary, len(households))
+		for _, hh := range households {
+			if summary, err := s.services.Summary.GetMonthlySummary(ctx, hh.ID, year, month); err == nil {
+				summaries[hh.ID] = summary
+			}
 		}
 	}
 
+	deleted, err := s.services.Trash.Households(ctx)
+	if err != nil {
+		return err
+	}
+
 	return c.Render(http.StatusOK, "dashboard", pageData{
-		Title:      "dashboard",
-		User:       s.getUserFromContext(c),
-		Households: households,
-		Summaries:  summaries,
-		Month:      fmt.Sprintf("%d-%02d", year, month),
-		Lang:       string(s.getLocale(c)),
+		Title:             "dashboard",
+		User:              s.getUserFromContext(c),
+		Households:        households,
+		Summaries:         summaries,
+		Month:             fmt.Sprintf("%d-%02d", year, month),
+		Lang:              string(s.getLocale(c)),
+		DeletedHouseholds: deleted,
+		RetentionDays:     s.trashRetentionDays(),
 	})
 }
 
@@ -107,7 +223,7 @@ func (s *Server) handleWebHouseholdDetail(c echo.Context) error {
 	prev := ref.AddDate(0, -1, 0)
 	next := ref.AddDate(0, 1, 0)
 
-	transactions, err := s.services.Transaction.ListByMonth(ctx, id, year, month)
+	transactions, err := s.services.Transaction.ListByMonth(ctx, id, year, month, domain.AmountFilter{})
 	if err != nil {
 		return err
 	}
@@ -194,6 +310,11 @@ func (s *Server) handleWebTransactionNew(c echo.Context) error {
 		return err
 	}
 
+	members, err := s.services.Member.List(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	month := c.QueryParam("month")
 	if month == "" {
 		now := time.Now()
@@ -205,6 +326,9 @@ func (s *Server) handleWebTransactionNew(c echo.Context) error {
 		User:       s.getUserFromContext(c),
 		Household:  hh,
 		Categories: categories,
+		Members:    members,
+		SplitTypes: domain.AllSplitTypes(),
+		TaxClasses: domain.AllTaxClasses(),
 		Month:      month,
 		Lang:       string(s.getLocale(c)),
 	})
@@ -240,8 +364,14 @@ func (s *Server) handleWebTransactionCreate(c echo.Context) error {
 
 	description := c.FormValue("description")
 	details := c.FormValue("details")
+	taxClass := domain.TaxClass(c.FormValue("tax_class"))
 
-	_, err = s.services.Transaction.Create(ctx, id, categoryID, amount, description, details, date)
+	split, err := s.parseWebSplit(c, id)
+	if err != nil {
+		return s.renderTransactionForm(c, id, nil, locale, s.i18nBundle.T(locale, "error_invalid_split"))
+	}
+
+	_, err = s.services.Transaction.Create(ctx, id, categoryID, amount, description, details, taxClass, date, split)
 	if err != nil {
 		return err
 	}
@@ -277,6 +407,16 @@ func (s *Server) handleWebTransactionEdit(c echo.Context) error {
 		return err
 	}
 
+	members, err := s.services.Member.List(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	history, err := s.services.Revision.History(ctx, id, domain.EntityTransaction, txID)
+	if err != nil {
+		return err
+	}
+
 	month := fmt.Sprintf("%d-%02d", tx.Date.Year(), tx.Date.Month())
 
 	return c.Render(http.StatusOK, "transaction_form", pageData{
@@ -285,8 +425,12 @@ func (s *Server) handleWebTransactionEdit(c echo.Context) error {
 		Household:   hh,
 		Transaction: tx,
 		Categories:  categories,
+		Members:     members,
+		SplitTypes:  domain.AllSplitTypes(),
+		TaxClasses:  domain.AllTaxClasses(),
 		Month:       month,
 		Lang:        string(s.getLocale(c)),
+		History:     history,
 	})
 }
 
@@ -330,8 +474,14 @@ func (s *Server) handleWebTransactionUpdate(c echo.Context) error {
 
 	description := c.FormValue("description")
 	details := c.FormValue("details")
+	taxClass := domain.TaxClass(c.FormValue("tax_class"))
+
+	split, err := s.parseWebSplit(c, id)
+	if err != nil {
+		return s.renderTransactionForm(c, id, tx, locale, s.i18nBundle.T(locale, "error_invalid_split"))
+	}
 
-	_, err = s.services.Transaction.Update(ctx, id, txID, categoryID, amount, description, details, date)
+	_, err = s.services.Transaction.Update(ctx, id, txID, categoryID, amount, description, details, taxClass, date, split)
 	if err != nil {
 		return err
 	}
@@ -356,7 +506,8 @@ func (s *Server) handleWebCategoryCreate(c echo.Context) error {
 
 	name := c.FormValue("name")
 	icon := c.FormValue("icon")
-	_, err = s.services.Category.Create(c.Request().Context(), id, name, icon)
+	taxClass := domain.TaxClass(c.FormValue("tax_class"))
+	_, err = s.services.Category.Create(c.Request().Context(), id, name, icon, taxClass)
 	if err != nil {
 		return err
 	}
@@ -446,11 +597,18 @@ func (s *Server) handleWebRecurringNew(c echo.Context) error {
 		return err
 	}
 
+	members, err := s.services.Member.List(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	return c.Render(http.StatusOK, "recurring_form", pageData{
 		Title:       "new_recurring",
 		User:        s.getUserFromContext(c),
 		Household:   hh,
 		Categories:  categories,
+		Members:     members,
+		SplitTypes:  domain.AllSplitTypes(),
 		Frequencies: domain.AllFrequencies(),
 		Lang:        string(s.getLocale(c)),
 	})
@@ -499,7 +657,12 @@ func (s *Server) handleWebRecurringCreate(c echo.Context) error {
 	description := c.FormValue("description")
 	details := c.FormValue("details")
 
-	_, err = s.services.RecurringExpense.Create(c.Request().Context(), id, categoryID, name, description, details, amount, freq, startDate, endDate)
+	split, err := s.parseWebSplit(c, id)
+	if err != nil {
+		return s.renderRecurringForm(c, id, nil, locale, s.i18nBundle.T(locale, "error_invalid_split"))
+	}
+
+	_, err = s.services.RecurringExpense.Create(c.Request().Context(), id, categoryID, name, description, details, amount, freq, startDate, endDate, split)
 	if err != nil {
 		return err
 	}
@@ -534,20 +697,33 @@ func (s *Server) handleWebRecurringEdit(c echo.Context) error {
 		return err
 	}
 
+	members, err := s.services.Member.List(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	overrides, err := s.services.RecurringExpense.ListOverrides(ctx, recurringID)
 	if err != nil {
 		return err
 	}
 
+	history, err := s.services.Revision.History(ctx, id, domain.EntityRecurringExpense, recurringID)
+	if err != nil {
+		return err
+	}
+
 	return c.Render(http.StatusOK, "recurring_form", pageData{
 		Title:             "edit_recurring",
 		User:              s.getUserFromContext(c),
 		Household:         hh,
 		RecurringExpense:  re,
 		Categories:        categories,
+		Members:           members,
+		SplitTypes:        domain.AllSplitTypes(),
 		ScheduleOverrides: overrides,
 		Frequencies:       domain.AllFrequencies(),
 		Lang:              string(s.getLocale(c)),
+		History:           history,
 	})
 }
 
@@ -605,7 +781,12 @@ func (s *Server) handleWebRecurringUpdate(c echo.Context) error {
 	details := c.FormValue("details")
 	active := c.FormValue("active") == "on"
 
-	_, err = s.services.RecurringExpense.Update(ctx, recurringID, categoryID, name, description, details, amount, freq, active, startDate, endDate)
+	split, err := s.parseWebSplit(c, id)
+	if err != nil {
+		return s.renderRecurringForm(c, id, re, locale, s.i18nBundle.T(locale, "error_invalid_split"))
+	}
+
+	_, err = s.services.RecurringExpense.Update(ctx, recurringID, categoryID, name, description, details, amount, freq, active, startDate, endDate, split)
 	if err != nil {
 		return err
 	}
@@ -613,6 +794,136 @@ func (s *Server) handleWebRecurringUpdate(c echo.Context) error {
 	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d/recurring", id))
 }
 
+func (s *Server) handleWebHouseholdCompare(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	hh, err := s.services.Household.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	data := pageData{
+		Title:         "compare",
+		User:          s.getUserFromContext(c),
+		Household:     hh,
+		BasePeriod:    c.QueryParam("base"),
+		CurrentPeriod: c.QueryParam("current"),
+		ActiveTab:     "compare",
+		Lang:          string(s.getLocale(c)),
+	}
+
+	base, current, err := parsePeriods(c)
+	if err == nil {
+		data.BasePeriod = base.String()
+		data.CurrentPeriod = current.String()
+		data.Comparison, err = s.services.Summary.ComparePeriods(ctx, id, base, current)
+	}
+	if err != nil {
+		if !errors.Is(err, domain.ErrValidation) {
+			return err
+		}
+		data.ErrorMessage = s.i18nBundle.T(s.getLocale(c), "error_invalid_period")
+	}
+
+	return c.Render(http.StatusOK, "household_compare", data)
+}
+
+func (s *Server) handleWebHouseholdTax(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	hh, err := s.services.Household.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	data := pageData{
+		Title:     "tax_summary",
+		User:      s.getUserFromContext(c),
+		Household: hh,
+		ActiveTab: "tax",
+		Lang:      string(s.getLocale(c)),
+	}
+
+	data.Year, err = parseYear(c)
+	if err == nil {
+		data.TaxSummary, err = s.services.Summary.GetTaxSummary(ctx, id, data.Year)
+	}
+	if err != nil {
+		if !errors.Is(err, domain.ErrValidation) {
+			return err
+		}
+		data.ErrorMessage = s.i18nBundle.T(s.getLocale(c), "error_invalid_year")
+	}
+
+	return c.Render(http.StatusOK, "household_tax", data)
+}
+
+func (s *Server) handleWebHouseholdBalances(c echo.Context) error {
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+	return s.renderBalances(c, id, "")
+}
+
+func (s *Server) handleWebMemberCreate(c echo.Context) error {
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	_, err = s.services.Member.Create(c.Request().Context(), id, c.FormValue("name"))
+	if errors.Is(err, domain.ErrValidation) || errors.Is(err, domain.ErrConflict) {
+		return s.renderBalances(c, id, s.i18nBundle.T(s.getLocale(c), "error_invalid_member"))
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d/balances", id))
+}
+
+func (s *Server) handleWebSettlementCreate(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	locale := s.getLocale(c)
+
+	amount, err := domain.NewMoney(c.FormValue("amount"))
+	if err != nil {
+		return s.renderBalances(c, id, s.i18nBundle.T(locale, "error_invalid_amount"))
+	}
+
+	date, err := time.Parse("2006-01-02", c.FormValue("date"))
+	if err != nil {
+		return s.renderBalances(c, id, s.i18nBundle.T(locale, "error_invalid_date"))
+	}
+
+	fromID, _ := strconv.Atoi(c.FormValue("from_member_id"))
+	toID, _ := strconv.Atoi(c.FormValue("to_member_id"))
+
+	_, err = s.services.Settlement.Record(ctx, id, fromID, toID, amount, date, c.FormValue("note"))
+	if errors.Is(err, domain.ErrValidation) {
+		return s.renderBalances(c, id, s.i18nBundle.T(locale, "error_invalid_settlement"))
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d/balances", id))
+}
+
 func (s *Server) handleWebHouseholdSettings(c echo.Context) error {
 	ctx := c.Request().Context()
 	id, err := parseID(c, "id")
@@ -643,11 +954,24 @@ func (s *Server) handleWebHouseholdSettings(c echo.Context) error {
 		section = "household"
 	}
 
+	var history []*domain.Revision
+	var trash *domain.Trash
+	switch section {
+	case "household":
+		history, err = s.services.Revision.History(ctx, id, domain.EntityHousehold, id)
+	case "trash":
+		trash, err = s.services.Trash.List(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+
 	return c.Render(http.StatusOK, "household_settings", pageData{
 		Title:         "settings",
 		User:          s.getUserFromContext(c),
 		Household:     hh,
 		Categories:    categories,
+		TaxClasses:    domain.AllTaxClasses(),
 		Summary:       summary,
 		Currencies:    s.renderer.Currencies,
 		Icons:         s.renderer.Icons,
@@ -655,6 +979,10 @@ func (s *Server) handleWebHouseholdSettings(c echo.Context) error {
 		ActiveTab:     "settings",
 		ActiveSection: section,
 		Lang:          string(s.getLocale(c)),
+		History:       history,
+		CategoryMap:   buildCategoryMap(categories),
+		Trash:         trash,
+		RetentionDays: s.trashRetentionDays(),
 	})
 }
 
@@ -699,18 +1027,25 @@ func (s *Server) handleWebCategoryEdit(c echo.Context) error {
 		return err
 	}
 
+	history, err := s.services.Revision.History(ctx, id, domain.EntityCategory, categoryID)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	month := fmt.Sprintf("%d-%02d", now.Year(), now.Month())
 
 	return c.Render(http.StatusOK, "category_form", pageData{
-		Title:     "edit_category",
-		User:      s.getUserFromContext(c),
-		Household: hh,
-		Category:  cat,
-		Icons:     s.renderer.Icons,
-		Month:     month,
-		ActiveTab: "settings",
-		Lang:      string(s.getLocale(c)),
+		Title:      "edit_category",
+		User:       s.getUserFromContext(c),
+		Household:  hh,
+		Category:   cat,
+		Icons:      s.renderer.Icons,
+		TaxClasses: domain.AllTaxClasses(),
+		Month:      month,
+		ActiveTab:  "settings",
+		Lang:       string(s.getLocale(c)),
+		History:    history,
 	})
 }
 
@@ -727,8 +1062,9 @@ func (s *Server) handleWebCategoryUpdate(c echo.Context) error {
 
 	name := c.FormValue("name")
 	icon := c.FormValue("icon")
+	taxClass := domain.TaxClass(c.FormValue("tax_class"))
 
-	_, err = s.services.Category.Update(c.Request().Context(), categoryID, name, icon)
+	_, err = s.services.Category.Update(c.Request().Context(), categoryID, name, icon, taxClass)
 	if err != nil {
 		return err
 	}
@@ -744,7 +1080,7 @@ func (s *Server) resolveCategory(c echo.Context, householdID int) (int, error) {
 		if name == "" {
 			return 0, echo.NewHTTPError(http.StatusBadRequest, "category name required")
 		}
-		cat, err := s.services.Category.Create(c.Request().Context(), householdID, name, "category")
+		cat, err := s.services.Category.Create(c.Request().Context(), householdID, name, "category", "")
 		if err != nil {
 			return 0, err
 		}
@@ -758,40 +1094,172 @@ func (s *Server) resolveCategory(c echo.Context, householdID int) (int, error) {
 }
 
 func (s *Server) handleWebTokenList(c echo.Context) error {
-	tokens, err := s.services.APIToken.List(c.Request().Context())
+	return s.renderTokenList(c, "", "")
+}
+
+func (s *Server) handleWebTokenCreate(c echo.Context) error {
+	form, err := c.FormParams()
 	if err != nil {
 		return err
 	}
 
-	return c.Render(http.StatusOK, "token_list", pageData{
-		Title:  "api_tokens",
-		User:   s.getUserFromContext(c),
-		Tokens: tokens,
-		Lang:   string(s.getLocale(c)),
+	scope := domain.TokenScope{Access: domain.TokenAccess(c.FormValue("access"))}
+	for _, v := range form["household_ids"] {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		scope.HouseholdIDs = append(scope.HouseholdIDs, id)
+	}
+
+	var expiresAt *time.Time
+	if v := c.FormValue("expires_in"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil {
+			return s.renderTokenList(c, "", s.i18nBundle.T(s.getLocale(c), "error_invalid_token_scope"))
+		}
+		t := time.Now().AddDate(0, 0, days)
+		expiresAt = &t
+	}
+
+	plaintext, _, err := s.services.APIToken.Create(c.Request().Context(), c.FormValue("name"), scope, expiresAt)
+	if errors.Is(err, domain.ErrValidation) {
+		return s.renderTokenList(c, "", s.i18nBundle.T(s.getLocale(c), "error_invalid_token_scope"))
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.renderTokenList(c, plaintext, "")
+}
+
+func (s *Server) handleWebTokenRotate(c echo.Context) error {
+	id, err := parseID(c, "tokenId")
+	if err != nil {
+		return err
+	}
+
+	plaintext, _, err := s.services.APIToken.Rotate(c.Request().Context(), id)
+	if errors.Is(err, domain.ErrConflict) {
+		return s.renderTokenList(c, "", s.i18nBundle.T(s.getLocale(c), "error_token_rotated"))
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.renderTokenList(c, plaintext, "")
+}
+
+func (s *Server) handleWebTokenRevoke(c echo.Context) error {
+	id, err := parseID(c, "tokenId")
+	if err != nil {
+		return err
+	}
+
+	if err := s.services.APIToken.Delete(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, "/tokens")
+}
+
+func (s *Server) handleWebSessionList(c echo.Context) error {
+	sessions, err := s.services.Session.List(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	var currentID int
+	if current := s.currentSessionToken(c); current != "" {
+		for _, sess := range sessions {
+			if sess.Token == current {
+				currentID = sess.ID
+			}
+		}
+	}
+
+	return c.Render(http.StatusOK, "session_list", pageData{
+		Title:            "sessions",
+		User:             s.getUserFromContext(c),
+		Sessions:         sessions,
+		CurrentSessionID: currentID,
+		Lang:             string(s.getLocale(c)),
 	})
 }
 
-func (s *Server) handleWebTokenCreate(c echo.Context) error {
-	name := c.FormValue("name")
-	plaintext, _, err := s.services.APIToken.Create(c.Request().Context(), name)
+func (s *Server) handleWebAdmin(c echo.Context) error {
+	stats, err := s.services.Admin.Stats(c.Request().Context())
+	if errors.Is(err, domain.ErrForbidden) {
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
 	if err != nil {
 		return err
 	}
+	return c.Render(http.StatusOK, "admin", pageData{
+		Title: "admin",
+		User:  s.getUserFromContext(c),
+		Stats: stats,
+		Lang:  string(s.getLocale(c)),
+	})
+}
 
-	tokens, err := s.services.APIToken.List(c.Request().Context())
+func (s *Server) handleWebSecurityEvents(c echo.Context) error {
+	events, err := s.services.Security.List(c.Request().Context())
 	if err != nil {
 		return err
 	}
+	return c.Render(http.StatusOK, "security_events", pageData{
+		Title:  "security_events",
+		User:   s.getUserFromContext(c),
+		Events: events,
+		Lang:   string(s.getLocale(c)),
+	})
+}
 
-	return c.Render(http.StatusOK, "token_list", pageData{
-		Title:    "api_tokens",
+func (s *Server) handleWebAdminEvents(c echo.Context) error {
+	events, err := s.services.Admin.Events(c.Request().Context())
+	if errors.Is(err, domain.ErrForbidden) {
+		return echo.NewHTTPError(http.StatusForbidden)
+	}
+	if err != nil {
+		return err
+	}
+	return c.Render(http.StatusOK, "security_events", pageData{
+		Title:    "security_events",
 		User:     s.getUserFromContext(c),
-		Tokens:   tokens,
-		NewToken: plaintext,
+		Events:   events,
+		AllUsers: true,
 		Lang:     string(s.getLocale(c)),
 	})
 }
 
+func (s *Server) handleWebSessionRevoke(c echo.Context) error {
+	id, err := parseID(c, "sessionId")
+	if err != nil {
+		return err
+	}
+
+	if err := s.services.Session.Revoke(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	// Revoking the current session logs out; the next page asks to log in
+	return c.Redirect(http.StatusFound, "/sessions")
+}
+
+func (s *Server) handleWebSessionRevokeAll(c echo.Context) error {
+	if _, err := s.services.Session.RevokeAll(c.Request().Context()); err != nil {
+		return err
+	}
+
+	if session, err := s.sessionStore.Get(c.Request(), auth.SessionName); err == nil {
+		session.Options.MaxAge = -1
+		session.Save(c.Request(), c.Response())
+	}
+
+	return c.Redirect(http.StatusFound, "/")
+}
+
 func (s *Server) handleWebOverrideCreate(c echo.Context) error {
 	ctx := c.Request().Context()
 	id, err := parseID(c, "id")
@@ -855,11 +1323,143 @@ func (s *Server) handleWebOverrideDelete(c echo.Context) error {
 	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d/recurring/%d/edit", id, recurringID))
 }
 
+func (s *Server) handleWebHouseholdRestore(c echo.Context) error {
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if err := s.services.Trash.RestoreHousehold(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d", id))
+}
+
+func (s *Server) handleWebTransactionRestore(c echo.Context) error {
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	txID, err := parseID(c, "transactionId")
+	if err != nil {
+		return err
+	}
+
+	if err := s.services.Trash.RestoreTransaction(c.Request().Context(), id, txID); err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d/settings?section=trash", id))
+}
+
+func (s *Server) handleWebRecurringRestore(c echo.Context) error {
+	id, err := parseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	recurringID, err := parseID(c, "recurringId")
+	if err != nil {
+		return err
+	}
+
+	if err := s.services.Trash.RestoreRecurringExpense(c.Request().Context(), id, recurringID); err != nil {
+		return err
+	}
+
+	return c.Redirect(http.StatusFound, fmt.Sprintf("/households/%d/settings?section=trash", id))
+}
+
+// trashRetentionDays returns after how many days deleted records are
+// purged, 0 if never.
+func (s *Server) trashRetentionDays() int {
+	return int(s.services.Trash.Retention() / (24 * time.Hour))
+}
+
 func (s *Server) handleWebUserSettings(c echo.Context) error {
+	// Set by the callback after linking a provider
+	var errorMsg string
+	switch c.QueryParam("error") {
+	case "identity_taken":
+		errorMsg = s.i18nBundle.T(s.getLocale(c), "error_identity_taken")
+	case "identity_denied":
+		errorMsg = s.i18nBundle.T(s.getLocale(c), "error_identity_denied")
+	}
+	return s.renderUserSettings(c, errorMsg)
+}
+
+// handleWebPendingIdentity asks the user to confirm linking the identity of
+// an OIDC login that was refused because it uses their email.
+func (s *Server) handleWebPendingIdentity(c echo.Context) error {
+	user := s.getUserFromContext(c)
+	session, err := s.sessionStore.Get(c.Request(), auth.SessionName)
+	if user == nil || err != nil {
+		return c.Redirect(http.StatusFound, "/settings")
+	}
+	provider, identity, ok := pendingIdentity(session, user)
+	if !ok {
+		return c.Redirect(http.StatusFound, "/settings")
+	}
+
+	label := identity.Issuer
+	for _, p := range s.loginProviders("") {
+		if p.Name == provider && p.Label != "" {
+			label = p.Label
+		}
+	}
+	return c.Render(http.StatusOK, "identity_pending", pageData{
+		Title:        "identity_pending",
+		User:         user,
+		Lang:         string(s.getLocale(c)),
+		LinkEmail:    identity.Email,
+		LinkProvider: label,
+	})
+}
+
+func (s *Server) renderUserSettings(c echo.Context, errorMsg string) error {
+	ctx := c.Request().Context()
+	localAccount, err := s.services.User.HasLocalAccount(ctx)
+	if err != nil {
+		return err
+	}
+	households, err := s.services.Household.List(ctx)
+	if err != nil {
+		return err
+	}
+	providers := s.loginProviders("/settings/identities/link")
+	var identities []linkedIdentity
+	if len(providers) > 0 {
+		list, err := s.services.Identity.List(ctx)
+		if err != nil {
+			return err
+		}
+		for _, identity := range list {
+			linked := linkedIdentity{UserIdentity: identity}
+			for _, p := range providers {
+				if p.Issuer == identity.Issuer {
+					linked.Label = p.Label
+				}
+			}
+			identities = append(identities, linked)
+		}
+	}
+	incoming, outgoing, err := s.services.Account.Transfers(ctx)
+	if err != nil {
+		return err
+	}
 	return c.Render(http.StatusOK, "user_settings", pageData{
-		Title: "user_settings",
-		User:  s.getUserFromContext(c),
-		Lang:  string(s.getLocale(c)),
+		Title:          "user_settings",
+		User:           s.getUserFromContext(c),
+		Lang:           string(s.getLocale(c)),
+		ErrorMessage:   errorMsg,
+		LocalAccount:   localAccount,
+		Households:     households,
+		LoginProviders: providers,
+		Identities:     identities,
+		IncomingTransfers: incoming,
+		OutgoingTransfers: outgoing,
 	})
 }
 
@@ -872,6 +1472,120 @@ func (s *Server) handleWebUserSettingsUpdate(c echo.Context) error {
 	return c.Redirect(http.StatusFound, "/settings")
 }
 
+func (s *Server) handleWebPasswordChange(c echo.Context) error {
+	locale := s.getLocale(c)
+	password := c.FormValue("new_password")
+	if password != c.FormValue("confirm_password") {
+		return s.renderUserSettings(c, s.i18nBundle.T(locale, "error_password_mismatch"))
+	}
+
+	err := s.services.User.ChangePassword(c.Request().Context(), c.FormValue("current_password"), password)
+	var verr *domain.ValidationError
+	if errors.As(err, &verr) {
+		key := "error_password_invalid"
+		if verr.Field == "current_password" {
+			key = "error_current_password"
+		}
+		return s.renderUserSettings(c, s.i18nBundle.T(locale, key))
+	}
+	if err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+// handleWebIdentityUnlink removes a login method. Removing the last one
+// shows an error on the settings page.
+func (s *Server) handleWebIdentityUnlink(c echo.Context) error {
+	id, err := parseID(c, "identityId")
+	if err != nil {
+		return err
+	}
+
+	err = s.services.Identity.Unlink(c.Request().Context(), id)
+	if errors.Is(err, domain.ErrConflict) {
+		return s.renderUserSettings(c, s.i18nBundle.T(s.getLocale(c), "error_identity_last"))
+	}
+	if err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+// handleWebAccountDelete deletes the current user's account and ends the
+// session. Mistakes in the form are shown on the settings page. Households
+// offered to another user keep the account until they are accepted; the
+// settings page lists them.
+func (s *Server) handleWebAccountDelete(c echo.Context) error {
+	locale := s.getLocale(c)
+	pending, err := s.services.Account.Delete(c.Request().Context(), domain.AccountDeletion{
+		Confirm:    c.FormValue("confirm"),
+		Households: domain.OwnedHouseholds(c.FormValue("households")),
+		TransferTo: c.FormValue("transfer_to"),
+	})
+	var verr *domain.ValidationError
+	if errors.As(err, &verr) {
+		key := "error_account_confirm"
+		if verr.Field == "transfer_to" {
+			key = "error_account_transfer"
+		}
+		return s.renderUserSettings(c, s.i18nBundle.T(locale, key))
+	}
+	if errors.Is(err, domain.ErrConflict) {
+		return s.renderUserSettings(c, s.i18nBundle.T(locale, "error_account_households"))
+	}
+	if err != nil {
+		return err
+	}
+	if pending {
+		return c.Redirect(http.StatusFound, "/settings")
+	}
+
+	if s.sessionStore != nil {
+		if session, err := s.sessionStore.Get(c.Request(), auth.SessionName); err == nil {
+			session.Options.MaxAge = -1
+			session.Save(c.Request(), c.Response())
+		}
+	}
+	return c.Redirect(http.StatusFound, "/")
+}
+
+// handleWebTransferAccept takes over a household offered to the user.
+func (s *Server) handleWebTransferAccept(c echo.Context) error {
+	id, err := parseID(c, "transferId")
+	if err != nil {
+		return err
+	}
+	if err := s.services.Account.AcceptTransfer(c.Request().Context(), id); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+func (s *Server) handleWebTransferDecline(c echo.Context) error {
+	id, err := parseID(c, "transferId")
+	if err != nil {
+		return err
+	}
+	if err := s.services.Account.DeclineTransfer(c.Request().Context(), id); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
+// handleWebTransferCancel withdraws a household the user offered, which
+// also stops the pending deletion of their account.
+func (s *Server) handleWebTransferCancel(c echo.Context) error {
+	id, err := parseID(c, "transferId")
+	if err != nil {
+		return err
+	}
+	if err := s.services.Account.CancelTransfer(c.Request().Context(), id); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, "/settings")
+}
+
 func buildCategoryMap(categories []*domain.Category) map[int]string {
 	m := make(map[int]string, len(categories))
 	for _, c := range categories {
@@ -880,6 +1594,58 @@ func buildCategoryMap(categories []*domain.Category) map[int]string {
 	return m
 }
 
+func buildMemberMap(members []*domain.HouseholdMember) map[int]string {
+	m := make(map[int]string, len(members))
+	for _, mb := range members {
+		m[mb.ID] = mb.Name
+	}
+	return m
+}
+
+// parseWebSplit reads the split fields of the transaction and recurring
+// forms. Without a payer the amount isn't shared. Members are included by
+// the split_member checkboxes, their share values come from share_<id>.
+func (s *Server) parseWebSplit(c echo.Context, householdID int) (*domain.Split, error) {
+	paidBy, err := strconv.Atoi(c.FormValue("paid_by"))
+	if err != nil {
+		return nil, nil
+	}
+
+	members, err := s.services.Member.List(c.Request().Context(), householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := c.FormParams()
+	if err != nil {
+		return nil, err
+	}
+	included := make(map[string]bool)
+	for _, v := range form["split_member"] {
+		included[v] = true
+	}
+
+	splitType := c.FormValue("split_type")
+	var shares []domain.SplitShare
+	for _, m := range members {
+		key := strconv.Itoa(m.ID)
+		if !included[key] {
+			continue
+		}
+		value := ""
+		if splitType != string(domain.SplitEqual) {
+			value = c.FormValue("share_" + key)
+		}
+		share, err := domain.NewSplitShare(m.ID, value)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+
+	return domain.NewSplit(&paidBy, splitType, shares), nil
+}
+
 func (s *Server) getUserFromContext(c echo.Context) *domain.User {
 	userID, ok := service.UserIDFromContext(c.Request().Context())
 	if !ok {
@@ -902,6 +1668,10 @@ func (s *Server) renderTransactionForm(c echo.Context, householdID int, tx *doma
 	if err != nil {
 		return err
 	}
+	members, err := s.services.Member.List(ctx, householdID)
+	if err != nil {
+		return err
+	}
 	title := "new_transaction"
 	month := c.QueryParam("month")
 	if month == "" {
@@ -918,6 +1688,9 @@ func (s *Server) renderTransactionForm(c echo.Context, householdID int, tx *doma
 		Household:    hh,
 		Transaction:  tx,
 		Categories:   categories,
+		Members:      members,
+		SplitTypes:   domain.AllSplitTypes(),
+		TaxClasses:   domain.AllTaxClasses(),
 		Month:        month,
 		Lang:         string(locale),
 		ErrorMessage: errorMsg,
@@ -934,6 +1707,10 @@ func (s *Server) renderRecurringForm(c echo.Context, householdID int, re *domain
 	if err != nil {
 		return err
 	}
+	members, err := s.services.Member.List(ctx, householdID)
+	if err != nil {
+		return err
+	}
 	title := "new_recurring"
 	var overrides []*domain.RecurringScheduleOverride
 	if re != nil {
@@ -946,9 +1723,69 @@ func (s *Server) renderRecurringForm(c echo.Context, householdID int, re *domain
 		Household:         hh,
 		RecurringExpense:  re,
 		Categories:        categories,
+		Members:           members,
+		SplitTypes:        domain.AllSplitTypes(),
 		ScheduleOverrides: overrides,
 		Frequencies:       domain.AllFrequencies(),
 		Lang:              string(locale),
 		ErrorMessage:      errorMsg,
 	})
 }
+
+func (s *Server) renderBalances(c echo.Context, householdID int, errorMsg string) error {
+	ctx := c.Request().Context()
+	hh, err := s.services.Household.GetByID(ctx, householdID)
+	if err != nil {
+		return err
+	}
+	members, err := s.services.Member.List(ctx, householdID)
+	if err != nil {
+		return err
+	}
+	balances, err := s.services.Settlement.Balances(ctx, householdID)
+	if err != nil {
+		return err
+	}
+	settlements, err := s.services.Settlement.List(ctx, householdID)
+	if err != nil {
+		return err
+	}
+	return c.Render(http.StatusOK, "household_balances", pageData{
+		Title:        "balances",
+		User:         s.getUserFromContext(c),
+		Household:    hh,
+		Members:      members,
+		MemberMap:    buildMemberMap(members),
+		Balances:     balances,
+		Settlements:  settlements,
+		ActiveTab:    "balances",
+		Lang:         string(s.getLocale(c)),
+		ErrorMessage: errorMsg,
+	})
+}
+
+func (s *Server) renderTokenList(c echo.Context, newToken, errorMsg string) error {
+	ctx := c.Request().Context()
+	tokens, err := s.services.APIToken.List(ctx)
+	if err != nil {
+		return err
+	}
+	households, err := s.services.Household.List(ctx)
+	if err != nil {
+		return err
+	}
+	householdMap := make(map[int]string, len(households))
+	for _, hh := range households {
+		householdMap[hh.ID] = hh.Name
+	}
+	return c.Render(http.StatusOK, "token_list", pageData{
+		Title:        "api_tokens",
+		User:         s.getUserFromContext(c),
+		Tokens:       tokens,
+		NewToken:     newToken,
+		Households:   households,
+		HouseholdMap: householdMap,
+		Lang:         string(s.getLocale(c)),
+		ErrorMessage: errorMsg,
+	})
+}
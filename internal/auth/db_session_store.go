@@ -6,15 +6,22 @@ import (
 	"crypto/rand"
 	"encoding/gob"
 	"encoding/hex"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/securecookie"
 	"github.com/gorilla/sessions"
 	"icekalt.dev/money-tracker/ent"
 	entsession "icekalt.dev/money-tracker/ent/session"
+	"icekalt.dev/money-tracker/internal/service"
 )
 
+// lastSeenInterval limits how often loading a session records its last-seen
+// time, so not every request causes a write.
+const lastSeenInterval = 5 * time.Minute
+
 // DBSessionStore implements sessions.Store with server-side DB storage.
 // The cookie contains only a signed session token; all data lives in the DB.
 type DBSessionStore struct {
@@ -81,6 +88,15 @@ func (s *DBSessionStore) New(r *http.Request, name string) (*sessions.Session, e
 		return session, nil
 	}
 
+	if row.LastSeenAt == nil || time.Since(*row.LastSeenAt) > lastSeenInterval {
+		// Best-effort, like the last-used time of API tokens
+		s.client.Session.UpdateOne(row).
+			SetLastSeenAt(time.Now()).
+			SetUserAgent(userAgent(r)).
+			SetIP(clientIP(r)).
+			Exec(context.Background())
+	}
+
 	session.ID = token
 	session.IsNew = false
 	return session, nil
@@ -126,7 +142,13 @@ func (s *DBSessionStore) Save(r *http.Request, w http.ResponseWriter, session *s
 		creator := s.client.Session.Create().
 			SetToken(token).
 			SetData(buf.Bytes()).
-			SetExpiresAt(expiresAt)
+			SetExpiresAt(expiresAt).
+			SetUserAgent(userAgent(r)).
+			SetIP(clientIP(r)).
+			SetLastSeenAt(time.Now()).
+			SetOidcIssuer(stringValue(session, SessionKeyOIDCIssuer)).
+			SetOidcSubject(stringValue(session, SessionKeyOIDCSubject)).
+			SetOidcSid(stringValue(session, SessionKeyOIDCSID))
 		if userID != nil {
 			creator = creator.SetUserID(*userID)
 		}
@@ -143,7 +165,10 @@ func (s *DBSessionStore) Save(r *http.Request, w http.ResponseWriter, session *s
 		updater := s.client.Session.Update().
 			Where(entsession.TokenEQ(session.ID)).
 			SetData(buf.Bytes()).
-			SetExpiresAt(expiresAt)
+			SetExpiresAt(expiresAt).
+			SetOidcIssuer(stringValue(session, SessionKeyOIDCIssuer)).
+			SetOidcSubject(stringValue(session, SessionKeyOIDCSubject)).
+			SetOidcSid(stringValue(session, SessionKeyOIDCSID))
 		if userID != nil {
 			updater = updater.SetUserID(*userID)
 		} else {
@@ -162,6 +187,43 @@ func (s *DBSessionStore) Save(r *http.Request, w http.ResponseWriter, session *s
 	return nil
 }
 
+// stringValue returns a string value of the session, shortened to fit the
+// columns it is copied to.
+func stringValue(session *sessions.Session, key string) string {
+	v, _ := session.Values[key].(string)
+	if len(v) > 255 {
+		v = strings.ToValidUTF8(v[:255], "")
+	}
+	return v
+}
+
+// userAgent returns the request's user agent, shortened to fit the column.
+func userAgent(r *http.Request) string {
+	ua := r.UserAgent()
+	if len(ua) > 512 {
+		ua = strings.ToValidUTF8(ua[:512], "")
+	}
+	return ua
+}
+
+// clientIP returns the client address the Client middleware stored in the
+// request context, which only follows X-Forwarded-For for trusted proxies.
+// Without it, e.g. outside the server, it is the address of the direct peer.
+func clientIP(r *http.Request) string {
+	host := service.ClientFromContext(r.Context()).IP
+	if host == "" {
+		var err error
+		host, _, err = net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+	}
+	if len(host) > 64 {
+		host = host[:64]
+	}
+	return host
+}
+
 func generateToken() (string, error) {
 	b := make([]byte, 32)
 	if _, err := rand.Read(b); err != nil {
@@ -10,6 +10,7 @@ import (
 	"icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/config"
 	"icekalt.dev/money-tracker/internal/repository"
+	"icekalt.dev/money-tracker/internal/service"
 
 	_ "modernc.org/sqlite"
 )
@@ -153,3 +154,102 @@ func TestDBSessionStore_VerifyRowCount(t *testing.T) {
 		t.Errorf("expected 2 session rows, got %d", count)
 	}
 }
+
+func TestDBSessionStore_RecordsClient(t *testing.T) {
+	client := setupClient(t)
+	store := auth.NewDBSessionStore(client, "test-secret-32-bytes-long-xxxxx", 3600, false)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("User-Agent", "TestBrowser/1.0")
+	req.Header.Set("X-Forwarded-For", "203.0.113.9")
+	rec := httptest.NewRecorder()
+
+	session, _ := store.New(req, auth.SessionName)
+	session.Values[auth.SessionKeyUser] = 42
+	if err := store.Save(req, rec, session); err != nil {
+		t.Fatalf("failed to save session: %v", err)
+	}
+
+	row := client.Session.Query().OnlyX(context.Background())
+	if row.UserAgent != "TestBrowser/1.0" {
+		t.Errorf("expected user agent TestBrowser/1.0, got %q", row.UserAgent)
+	}
+	if row.IP != "192.0.2.1" {
+		t.Errorf("expected IP of the peer 192.0.2.1, got %q", row.IP)
+	}
+	if row.LastSeenAt == nil {
+		t.Error("expected last seen to be set")
+	}
+}
+
+func TestDBSessionStore_RecordsClientBehindProxy(t *testing.T) {
+	client := setupClient(t)
+	store := auth.NewDBSessionStore(client, "test-secret-32-bytes-long-xxxxx", 3600, false)
+
+	// The Client middleware resolved the address from a trusted proxy.
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req = req.WithContext(service.WithClient(req.Context(), service.Client{IP: "203.0.113.9"}))
+	rec := httptest.NewRecorder()
+
+	session, _ := store.New(req, auth.SessionName)
+	session.Values[auth.SessionKeyUser] = 42
+	if err := store.Save(req, rec, session); err != nil {
+		t.Fatalf("failed to save session: %v", err)
+	}
+
+	row := client.Session.Query().OnlyX(context.Background())
+	if row.IP != "203.0.113.9" {
+		t.Errorf("expected the client address 203.0.113.9, got %q", row.IP)
+	}
+}
+
+func TestDBSessionStore_RevokedSession(t *testing.T) {
+	client := setupClient(t)
+	store := auth.NewDBSessionStore(client, "test-secret-32-bytes-long-xxxxx", 3600, false)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	session, _ := store.New(req, auth.SessionName)
+	session.Values[auth.SessionKeyUser] = 42
+	if err := store.Save(req, rec, session); err != nil {
+		t.Fatalf("failed to save session: %v", err)
+	}
+
+	// Deleting the row, as revoking does, invalidates the cookie
+	client.Session.Delete().ExecX(context.Background())
+
+	req2 := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, c := range rec.Result().Cookies() {
+		req2.AddCookie(c)
+	}
+	session2, _ := store.New(req2, auth.SessionName)
+	if !session2.IsNew {
+		t.Error("expected a new session after revoking")
+	}
+}
+
+func TestDBSessionStore_RecordsOIDCSession(t *testing.T) {
+	client := setupClient(t)
+	store := auth.NewDBSessionStore(client, "test-secret-32-bytes-long-xxxxx", 3600, false)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	session, _ := store.New(req, auth.SessionName)
+	if err := store.Save(req, rec, session); err != nil {
+		t.Fatalf("failed to save session: %v", err)
+	}
+
+	// The login callback adds the OIDC values to the existing session
+	session.Values[auth.SessionKeyUser] = 42
+	session.Values[auth.SessionKeyOIDCIssuer] = "https://idp.example.com"
+	session.Values[auth.SessionKeyOIDCSubject] = "user-1"
+	session.Values[auth.SessionKeyOIDCSID] = "session-1"
+	if err := store.Save(req, rec, session); err != nil {
+		t.Fatalf("failed to save session: %v", err)
+	}
+
+	row := client.Session.Query().OnlyX(context.Background())
+	if row.OidcIssuer != "https://idp.example.com" || row.OidcSubject != "user-1" || row.OidcSid != "session-1" {
+		t.Errorf("expected issuer, subject and sid to be stored, got %q, %q and %q", row.OidcIssuer, row.OidcSubject, row.OidcSid)
+	}
+}
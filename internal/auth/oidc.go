@@ -2,16 +2,42 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/oauth2"
+	"icekalt.dev/money-tracker/internal/domain"
 )
 
+// OIDCConfig is one OIDC provider users can log in with.
 type OIDCConfig struct {
+	// Name identifies the provider in its URLs, empty for the provider at
+	// /auth/login. DisplayName labels its login button.
+	Name        string
+	DisplayName string
+	// Issuer is the provider's issuer identifier; with the subject it
+	// identifies users.
+	Issuer string
+
 	Provider     *oidc.Provider
 	OAuth2Config oauth2.Config
 	Verifier     *oidc.IDTokenVerifier
+
+	// Policy decides who may sign in; GroupsClaim names the ID token claim
+	// that lists the user's groups.
+	Policy      domain.SignInPolicy
+	GroupsClaim string
+	// TrustUnverifiedEmail trusts the email of ID tokens without
+	// email_verified, see domain.OIDCIdentity.EmailTrusted.
+	TrustUnverifiedEmail bool
+
+	// EndSessionURL is the provider's end_session_endpoint, empty if it
+	// has none. PostLogoutRedirectURL is where the provider sends users
+	// after logging them out; it must be registered there.
+	EndSessionURL         string
+	PostLogoutRedirectURL string
 }
 
 func NewOIDC(ctx context.Context, issuer, clientID, clientSecret, redirectURL string) (*OIDCConfig, error) {
@@ -30,9 +56,150 @@ func NewOIDC(ctx context.Context, issuer, clientID, clientSecret, redirectURL st
 
 	verifier := provider.Verifier(&oidc.Config{ClientID: clientID})
 
+	var metadata struct {
+		Issuer             string `json:"issuer"`
+		EndSessionEndpoint string `json:"end_session_endpoint"`
+	}
+	if err := provider.Claims(&metadata); err != nil {
+		return nil, fmt.Errorf("reading OIDC provider metadata: %w", err)
+	}
+
 	return &OIDCConfig{
-		Provider:     provider,
-		OAuth2Config: oauth2Config,
-		Verifier:     verifier,
+		Provider:      provider,
+		OAuth2Config:  oauth2Config,
+		Verifier:      verifier,
+		Policy:        domain.SignInPolicy{Registration: domain.RegistrationOpen},
+		GroupsClaim:   "groups",
+		Issuer:        metadata.Issuer,
+		EndSessionURL: metadata.EndSessionEndpoint,
+	}, nil
+}
+
+// Path returns the path of one of the provider's endpoints, like
+// /auth/login, which the provider's name is appended to.
+func (c *OIDCConfig) Path(base string) string {
+	if c.Name == "" {
+		return base
+	}
+	return base + "/" + c.Name
+}
+
+// Identity extracts the user's identity from verified ID token claims.
+func (c *OIDCConfig) Identity(idToken *oidc.IDToken) (domain.OIDCIdentity, error) {
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return domain.OIDCIdentity{}, err
+	}
+	var raw map[string]any
+	if err := idToken.Claims(&raw); err != nil {
+		return domain.OIDCIdentity{}, err
+	}
+
+	return domain.OIDCIdentity{
+		Issuer:        idToken.Issuer,
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+		Groups:        stringList(raw[c.GroupsClaim]),
+
+		TrustUnverifiedEmail: c.TrustUnverifiedEmail,
 	}, nil
 }
+
+// SessionID returns the sid claim of an ID token, the user's session at the
+// provider. It is empty if the provider doesn't support back-channel logout.
+func SessionID(idToken *oidc.IDToken) string {
+	var claims struct {
+		SID string `json:"sid"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return ""
+	}
+	return claims.SID
+}
+
+// LogoutURL returns the URL that logs the user out at the provider as well
+// (RP-initiated logout), or "" if the provider has no end_session_endpoint.
+func (c *OIDCConfig) LogoutURL(rawIDToken string) string {
+	if c.EndSessionURL == "" {
+		return ""
+	}
+	u, err := url.Parse(c.EndSessionURL)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("client_id", c.OAuth2Config.ClientID)
+	if rawIDToken != "" {
+		q.Set("id_token_hint", rawIDToken)
+	}
+	if c.PostLogoutRedirectURL != "" {
+		q.Set("post_logout_redirect_uri", c.PostLogoutRedirectURL)
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// backchannelLogoutEvent is the member of the events claim that marks a
+// logout token.
+const backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+// LogoutToken is a verified back-channel logout token. At least one of
+// Subject and SessionID is set.
+type LogoutToken struct {
+	Subject   string
+	SessionID string
+}
+
+// VerifyLogoutToken verifies a logout token sent by the provider for
+// back-channel logout: signature, issuer, audience and expiry like an ID
+// token, plus the checks of the back-channel logout spec.
+func (c *OIDCConfig) VerifyLogoutToken(ctx context.Context, raw string) (*LogoutToken, error) {
+	token, err := c.Verifier.Verify(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims struct {
+		SID    string         `json:"sid"`
+		Events map[string]any `json:"events"`
+		Nonce  *string        `json:"nonce"`
+	}
+	if err := token.Claims(&claims); err != nil {
+		return nil, err
+	}
+	if _, ok := claims.Events[backchannelLogoutEvent]; !ok {
+		return nil, errors.New("not a logout token: missing back-channel logout event")
+	}
+	if claims.Nonce != nil {
+		// Keeps ID tokens from being used as logout tokens
+		return nil, errors.New("logout token must not contain a nonce")
+	}
+	if token.Subject == "" && claims.SID == "" {
+		return nil, errors.New("logout token has neither sub nor sid")
+	}
+	return &LogoutToken{Subject: token.Subject, SessionID: claims.SID}, nil
+}
+
+// stringList accepts a claim that is either a list of strings or a single
+// string, as providers differ.
+func stringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package auth_test
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/auth"
+	"icekalt.dev/money-tracker/internal/devidp"
+)
+
+// startIssuer serves a development provider for the tests.
+func startIssuer(t *testing.T) *devidp.Provider {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(nil)
+	p, err := devidp.New("http://" + srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	srv.Config.Handler = p
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return p
+}
+
+// sign returns a JWT with the claims, signed by the provider.
+func sign(t *testing.T, p *devidp.Provider, claims map[string]any) string {
+	t.Helper()
+	token, err := p.Sign(claims)
+	if err != nil {
+		t.Fatalf("signing: %v", err)
+	}
+	return token
+}
+
+func TestOIDCConfig_LogoutURL(t *testing.T) {
+	issuer := startIssuer(t)
+	cfg, err := auth.NewOIDC(t.Context(), issuer.Issuer(), "money-tracker", "secret", "https://money.example.com/auth/callback")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg.PostLogoutRedirectURL = "https://money.example.com/"
+	if cfg.Issuer != issuer.Issuer() {
+		t.Errorf("expected the issuer from the metadata, got %q", cfg.Issuer)
+	}
+
+	u, err := url.Parse(cfg.LogoutURL("id-token"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	q := u.Query()
+	if u.String() != issuer.Issuer()+"/logout?"+q.Encode() {
+		t.Errorf("expected the end_session_endpoint, got %s", u)
+	}
+	if q.Get("id_token_hint") != "id-token" || q.Get("client_id") != "money-tracker" || q.Get("post_logout_redirect_uri") != "https://money.example.com/" {
+		t.Errorf("unexpected parameters %v", q)
+	}
+
+	without := startIssuer(t)
+	without.DisableEndSession = true
+	cfg, err = auth.NewOIDC(t.Context(), without.Issuer(), "money-tracker", "secret", "https://money.example.com/auth/callback")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := cfg.LogoutURL("id-token"); got != "" {
+		t.Errorf("expected no logout URL without end_session_endpoint, got %s", got)
+	}
+}
+
+func TestOIDCConfig_VerifyLogoutToken(t *testing.T) {
+	issuer := startIssuer(t)
+	cfg, err := auth.NewOIDC(t.Context(), issuer.Issuer(), "money-tracker", "secret", "https://money.example.com/auth/callback")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	other := startIssuer(t)
+
+	claims := func(change func(map[string]any)) map[string]any {
+		c := map[string]any{
+			"iss":    issuer.Issuer(),
+			"aud":    "money-tracker",
+			"sub":    "user-1",
+			"sid":    "session-1",
+			"iat":    time.Now().Unix(),
+			"exp":    time.Now().Add(2 * time.Minute).Unix(),
+			"jti":    "logout-1",
+			"events": map[string]any{"http://schemas.openid.net/event/backchannel-logout": map[string]any{}},
+		}
+		if change != nil {
+			change(c)
+		}
+		return c
+	}
+
+	token, err := cfg.VerifyLogoutToken(t.Context(), sign(t, issuer, claims(nil)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.Subject != "user-1" || token.SessionID != "session-1" {
+		t.Errorf("unexpected token %+v", token)
+	}
+
+	token, err = cfg.VerifyLogoutToken(t.Context(), sign(t, issuer, claims(func(c map[string]any) { delete(c, "sub") })))
+	if err != nil || token.SessionID != "session-1" {
+		t.Errorf("expected a token with only sid to be accepted, got %+v, %v", token, err)
+	}
+
+	raw, err := issuer.LogoutToken("money-tracker", "user-1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token, err := cfg.VerifyLogoutToken(t.Context(), raw); err != nil || token.Subject != "user-1" {
+		t.Errorf("expected the provider's logout token to be accepted, got %+v, %v", token, err)
+	}
+
+	invalid := map[string]string{
+		"other key":      sign(t, other, claims(nil)),
+		"wrong audience": sign(t, issuer, claims(func(c map[string]any) { c["aud"] = "other-client" })),
+		"expired":        sign(t, issuer, claims(func(c map[string]any) { c["exp"] = time.Now().Add(-time.Minute).Unix() })),
+		"no event":       sign(t, issuer, claims(func(c map[string]any) { delete(c, "events") })),
+		"nonce":          sign(t, issuer, claims(func(c map[string]any) { c["nonce"] = "n" })),
+		"no sub and sid": sign(t, issuer, claims(func(c map[string]any) { delete(c, "sub"); delete(c, "sid") })),
+		"not a JWT":      "logout",
+		"other issuer":   sign(t, issuer, claims(func(c map[string]any) { c["iss"] = "https://evil.example.com" })),
+	}
+	for name, raw := range invalid {
+		if _, err := cfg.VerifyLogoutToken(t.Context(), raw); err == nil {
+			t.Errorf("%s: expected the token to be rejected", name)
+		}
+	}
+}
@@ -11,6 +11,15 @@ const (
 	SessionKeyUser  = "user_id"
 	SessionKeyEmail = "email"
 	SessionKeyName  = "name"
+
+	// Set for OIDC logins: the provider's name and the ID token for
+	// RP-initiated logout, issuer, subject and session ID at the provider
+	// for back-channel logout.
+	SessionKeyOIDCProvider = "oidc_provider"
+	SessionKeyIDToken      = "id_token"
+	SessionKeyOIDCIssuer   = "oidc_issuer"
+	SessionKeyOIDCSubject  = "oidc_subject"
+	SessionKeyOIDCSID      = "oidc_sid"
 )
 
 func NewSessionStore(secret string, maxAge int, secure bool) sessions.Store {
@@ -0,0 +1,97 @@
+package chart
+
+import "math"
+
+// BarChart draws grouped vertical bars, one group per label and one bar per
+// series. Values are drawn as magnitudes; negative values use their absolute value.
+type BarChart struct {
+	Title     string
+	Labels    []string
+	Series    []Series
+	EmptyText string
+	Format    func(float64) string // axis tick labels
+}
+
+func (b BarChart) Draw(c Canvas, width, height float64) {
+	top := padding
+	if b.Title != "" {
+		c.Text(padding, padding+titleSize, b.Title, TextStyle{Size: titleSize, Color: TextColor, Bold: true})
+		top += titleSize + padding
+	}
+
+	format := b.Format
+	if format == nil {
+		format = DefaultFormat
+	}
+
+	var max float64
+	for _, s := range b.Series {
+		for _, v := range s.Values {
+			max = math.Max(max, math.Abs(v))
+		}
+	}
+	if max == 0 || len(b.Labels) == 0 {
+		c.Text(width/2, top+(height-top)/2, b.EmptyText, TextStyle{Size: labelSize, Color: MutedColor, Anchor: AnchorMiddle})
+		return
+	}
+
+	// Legend
+	lx := padding
+	for i, s := range b.Series {
+		color := s.Color
+		if color == "" {
+			color = ColorAt(i)
+		}
+		c.Rect(lx, top, labelSize, labelSize, color)
+		c.Text(lx+labelSize+4, top+labelSize-1, s.Name, TextStyle{Size: labelSize, Color: TextColor})
+		lx += labelSize + 4 + textWidth(s.Name, labelSize) + 2*padding
+	}
+	top += labelSize + padding
+
+	axisMax, step := niceScale(max, 4)
+	axisWidth := 0.0
+	for v := 0.0; v <= axisMax+step/2; v += step {
+		axisWidth = math.Max(axisWidth, textWidth(format(v), labelSize))
+	}
+
+	left := padding + axisWidth + 6
+	bottom := height - padding - labelSize - 4
+	plotHeight := bottom - top
+	plotWidth := width - left - padding
+
+	for v := 0.0; v <= axisMax+step/2; v += step {
+		y := bottom - plotHeight*v/axisMax
+		c.Line(left, y, width-padding, y, GridColor, 1)
+		c.Text(left-6, y+labelSize/3, format(v), TextStyle{Size: labelSize, Color: MutedColor, Anchor: AnchorEnd})
+	}
+
+	groupWidth := plotWidth / float64(len(b.Labels))
+	barWidth := groupWidth * 0.8 / float64(len(b.Series))
+	// Skip labels when they would overlap.
+	maxLabel := 0.0
+	for _, label := range b.Labels {
+		maxLabel = math.Max(maxLabel, textWidth(label, labelSize))
+	}
+	labelEvery := 1
+	for maxLabel*1.1 > groupWidth*float64(labelEvery) && labelEvery < len(b.Labels) {
+		labelEvery++
+	}
+
+	for i, label := range b.Labels {
+		gx := left + float64(i)*groupWidth + groupWidth*0.1
+		for j, s := range b.Series {
+			if i >= len(s.Values) {
+				continue
+			}
+			color := s.Color
+			if color == "" {
+				color = ColorAt(j)
+			}
+			h := plotHeight * math.Abs(s.Values[i]) / axisMax
+			c.Rect(gx+float64(j)*barWidth, bottom-h, barWidth*0.95, h, color)
+		}
+		if i%labelEvery == 0 {
+			c.Text(left+float64(i)*groupWidth+groupWidth/2, height-padding, label, TextStyle{Size: labelSize, Color: MutedColor, Anchor: AnchorMiddle})
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package chart
+
+// Canvas is the drawing surface charts render onto. Coordinates start at the
+// top left corner, y grows downwards.
+type Canvas interface {
+	Rect(x, y, w, h float64, fill string)
+	Path(p *Path, fill string)
+	Line(x1, y1, x2, y2 float64, stroke string, width float64)
+	Text(x, y float64, s string, style TextStyle)
+}
+
+type Anchor int
+
+const (
+	AnchorStart Anchor = iota
+	AnchorMiddle
+	AnchorEnd
+)
+
+type TextStyle struct {
+	Size   float64
+	Color  string
+	Anchor Anchor
+	Bold   bool
+}
+
+type Point struct {
+	X, Y float64
+}
+
+type OpKind int
+
+const (
+	OpMoveTo OpKind = iota
+	OpLineTo
+	OpCubicTo // two control points followed by the end point
+	OpClose
+)
+
+type PathOp struct {
+	Kind   OpKind
+	Points []Point
+}
+
+// Path is a sequence of straight and cubic Bézier segments. Arcs are
+// approximated with Béziers so every backend can draw them.
+type Path struct {
+	Ops []PathOp
+}
+
+func (p *Path) MoveTo(x, y float64) *Path {
+	p.Ops = append(p.Ops, PathOp{Kind: OpMoveTo, Points: []Point{{x, y}}})
+	return p
+}
+
+func (p *Path) LineTo(x, y float64) *Path {
+	p.Ops = append(p.Ops, PathOp{Kind: OpLineTo, Points: []Point{{x, y}}})
+	return p
+}
+
+func (p *Path) CubicTo(c1x, c1y, c2x, c2y, x, y float64) *Path {
+	p.Ops = append(p.Ops, PathOp{Kind: OpCubicTo, Points: []Point{{c1x, c1y}, {c2x, c2y}, {x, y}}})
+	return p
+}
+
+func (p *Path) Close() *Path {
+	p.Ops = append(p.Ops, PathOp{Kind: OpClose})
+	return p
+}
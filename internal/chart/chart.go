@@ -0,0 +1,94 @@
+// Package chart draws simple business charts (donut, bar, horizontal bar) onto
+// an abstract Canvas. The SVG canvas in this package is used for the web UI;
+// other backends (e.g. PDF) only need to implement Canvas.
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Chart is anything that can draw itself onto a canvas of the given size.
+type Chart interface {
+	Draw(c Canvas, width, height float64)
+}
+
+// Slice is a labelled value of a donut or horizontal bar chart. ValueLabel is
+// shown instead of the formatted value when set, so callers can pass already
+// localized amounts.
+type Slice struct {
+	Label      string
+	Value      float64
+	ValueLabel string
+}
+
+// Series is one named row of values of a bar chart.
+type Series struct {
+	Name   string
+	Color  string
+	Values []float64
+}
+
+// Palette is the default color sequence.
+var Palette = []string{
+	"#0d6efd", "#dc3545", "#198754", "#fd7e14", "#6f42c1",
+	"#20c997", "#ffc107", "#d63384", "#0dcaf0", "#6c757d",
+}
+
+const (
+	TextColor  = "#212529"
+	MutedColor = "#6c757d"
+	GridColor  = "#dee2e6"
+
+	titleSize = 14.0
+	labelSize = 11.0
+	padding   = 10.0
+)
+
+// ColorAt returns the palette color for index i.
+func ColorAt(i int) string {
+	return Palette[i%len(Palette)]
+}
+
+// DefaultFormat formats a value without decimals.
+func DefaultFormat(v float64) string {
+	return fmt.Sprintf("%.0f", v)
+}
+
+// textWidth estimates the rendered width of s. It is only used for layout
+// decisions, so an average glyph width is good enough.
+func textWidth(s string, size float64) float64 {
+	return float64(len([]rune(s))) * size * 0.55
+}
+
+// niceScale returns an axis maximum and tick step for values up to max.
+func niceScale(max float64, ticks int) (float64, float64) {
+	if max <= 0 || ticks < 1 {
+		return 1, 1
+	}
+	raw := max / float64(ticks)
+	mag := math.Pow(10, math.Floor(math.Log10(raw)))
+	var step float64
+	switch r := raw / mag; {
+	case r <= 1:
+		step = mag
+	case r <= 2:
+		step = 2 * mag
+	case r <= 5:
+		step = 5 * mag
+	default:
+		step = 10 * mag
+	}
+	return math.Ceil(max/step) * step, step
+}
+
+func truncate(s string, maxWidth, size float64) string {
+	if textWidth(s, size) <= maxWidth {
+		return s
+	}
+	r := []rune(s)
+	for len(r) > 1 && textWidth(string(r)+"…", size) > maxWidth {
+		r = r[:len(r)-1]
+	}
+	return string(r) + "…"
+}
@@ -0,0 +1,129 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+// recorder is a Canvas that records what was drawn.
+type recorder struct {
+	rects []string
+	paths []*Path
+	texts []string
+}
+
+func (r *recorder) Rect(x, y, w, h float64, fill string)                  { r.rects = append(r.rects, fill) }
+func (r *recorder) Path(p *Path, fill string)                             { r.paths = append(r.paths, p) }
+func (r *recorder) Line(x1, y1, x2, y2 float64, stroke string, w float64) {}
+func (r *recorder) Text(x, y float64, s string, style TextStyle)          { r.texts = append(r.texts, s) }
+
+func assertWellFormed(t *testing.T, doc []byte) {
+	t.Helper()
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+	for {
+		_, err := dec.Token()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatalf("invalid SVG: %v\n%s", err, doc)
+		}
+	}
+}
+
+func TestDonut(t *testing.T) {
+	d := Donut{
+		Title: "Expenses",
+		Slices: []Slice{
+			{Label: "Rent", Value: 800, ValueLabel: "800,00 €"},
+			{Label: "Food & Drinks", Value: 200},
+			{Label: "Refund", Value: -50},
+		},
+		CenterLabel: "1.000,00 €",
+	}
+
+	rec := &recorder{}
+	d.Draw(rec, 400, 200)
+	if len(rec.paths) != 2 {
+		t.Errorf("drew %d segments, want 2 (negative slice skipped)", len(rec.paths))
+	}
+	joined := strings.Join(rec.texts, "|")
+	for _, want := range []string{"Expenses", "800,00 € · 80 %", "200 · 20 %", "1.000,00 €"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("missing text %q in %q", want, joined)
+		}
+	}
+
+	doc := RenderSVG(d, 400, 200)
+	assertWellFormed(t, doc)
+	if !bytes.Contains(doc, []byte("Food &amp; Drinks")) {
+		t.Error("expected escaped label in SVG")
+	}
+}
+
+func TestDonutEmpty(t *testing.T) {
+	rec := &recorder{}
+	Donut{EmptyText: "No data"}.Draw(rec, 300, 150)
+	if len(rec.paths) != 0 || len(rec.texts) != 1 || rec.texts[0] != "No data" {
+		t.Errorf("unexpected output: paths=%d texts=%v", len(rec.paths), rec.texts)
+	}
+}
+
+func TestDonutFullCircle(t *testing.T) {
+	doc := RenderSVG(Donut{Slices: []Slice{{Label: "Only", Value: 1}}}, 300, 150)
+	assertWellFormed(t, doc)
+	if bytes.Contains(doc, []byte("NaN")) {
+		t.Error("SVG contains NaN")
+	}
+}
+
+func TestBarChart(t *testing.T) {
+	b := BarChart{
+		Title:  "Income vs. expenses",
+		Labels: []string{"2025-01", "2025-02", "2025-03"},
+		Series: []Series{
+			{Name: "Income", Values: []float64{3000, 3100, 2900}},
+			{Name: "Expenses", Values: []float64{-2500, -2800, -3500}},
+		},
+	}
+	rec := &recorder{}
+	b.Draw(rec, 600, 250)
+	// 2 legend swatches + 6 bars
+	if len(rec.rects) != 8 {
+		t.Errorf("drew %d rects, want 8", len(rec.rects))
+	}
+	assertWellFormed(t, RenderSVG(b, 600, 250))
+}
+
+func TestHBarChart(t *testing.T) {
+	h := HBarChart{Bars: []Slice{{Label: "Monthly", Value: -900}, {Label: "Yearly", Value: -100, ValueLabel: "-100 €"}}}
+	rec := &recorder{}
+	h.Draw(rec, 400, 120)
+	if len(rec.rects) != 2 {
+		t.Errorf("drew %d rects, want 2", len(rec.rects))
+	}
+	if !strings.Contains(strings.Join(rec.texts, "|"), "-100 €") {
+		t.Errorf("expected value label, got %v", rec.texts)
+	}
+}
+
+func TestNiceScale(t *testing.T) {
+	tests := []struct {
+		max, wantMax, wantStep float64
+	}{
+		{3500, 4000, 1000},
+		{95, 100, 50},
+		{0, 1, 1},
+		{7, 8, 2},
+	}
+	for _, tt := range tests {
+		gotMax, gotStep := niceScale(tt.max, 4)
+		if math.Abs(gotMax-tt.wantMax) > 1e-9 || math.Abs(gotStep-tt.wantStep) > 1e-9 {
+			t.Errorf("niceScale(%v) = (%v, %v), want (%v, %v)", tt.max, gotMax, gotStep, tt.wantMax, tt.wantStep)
+		}
+	}
+}
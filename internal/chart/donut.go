@@ -0,0 +1,117 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+)
+
+// Donut draws the share of each slice as a ring segment with a legend on the
+// right. Slices with non-positive values are skipped.
+type Donut struct {
+	Title       string
+	Slices      []Slice
+	CenterLabel string // e.g. the formatted total
+	EmptyText   string // shown when there is nothing to draw
+	Format      func(float64) string
+}
+
+func (d Donut) Draw(c Canvas, width, height float64) {
+	top := padding
+	if d.Title != "" {
+		c.Text(padding, padding+titleSize, d.Title, TextStyle{Size: titleSize, Color: TextColor, Bold: true})
+		top += titleSize + padding
+	}
+
+	var total float64
+	var slices []Slice
+	for _, s := range d.Slices {
+		if s.Value > 0 {
+			total += s.Value
+			slices = append(slices, s)
+		}
+	}
+	if total == 0 {
+		c.Text(width/2, top+(height-top)/2, d.EmptyText, TextStyle{Size: labelSize, Color: MutedColor, Anchor: AnchorMiddle})
+		return
+	}
+
+	format := d.Format
+	if format == nil {
+		format = DefaultFormat
+	}
+
+	size := math.Min(height-top-padding, width*0.45)
+	outer := size / 2
+	inner := outer * 0.6
+	cx := padding + outer
+	cy := top + outer
+
+	angle := -math.Pi / 2
+	for i, s := range slices {
+		sweep := 2 * math.Pi * s.Value / total
+		c.Path(ringSegment(cx, cy, inner, outer, angle, angle+sweep), ColorAt(i))
+		angle += sweep
+	}
+	if d.CenterLabel != "" {
+		c.Text(cx, cy+labelSize/3, d.CenterLabel, TextStyle{Size: labelSize, Color: TextColor, Anchor: AnchorMiddle, Bold: true})
+	}
+
+	// Legend
+	lx := cx + outer + 2*padding
+	lineHeight := labelSize + 7
+	maxRows := int((height - top) / lineHeight)
+	legendWidth := width - lx - padding
+	for i, s := range slices {
+		if i >= maxRows {
+			break
+		}
+		y := top + float64(i)*lineHeight
+		c.Rect(lx, y+2, labelSize, labelSize, ColorAt(i))
+		value := s.ValueLabel
+		if value == "" {
+			value = format(s.Value)
+		}
+		pct := fmt.Sprintf("%.0f %%", 100*s.Value/total)
+		label := truncate(s.Label, legendWidth*0.45, labelSize)
+		c.Text(lx+labelSize+5, y+labelSize, label, TextStyle{Size: labelSize, Color: TextColor})
+		c.Text(width-padding, y+labelSize, value+" · "+pct, TextStyle{Size: labelSize, Color: MutedColor, Anchor: AnchorEnd})
+	}
+}
+
+// ringSegment returns the closed outline of a ring segment between the angles
+// a0 and a1 (radians, clockwise from the x axis in screen coordinates).
+func ringSegment(cx, cy, inner, outer, a0, a1 float64) *Path {
+	// A full circle cannot be expressed as a single closed segment; leave a
+	// hairline gap so the outline stays well-formed.
+	if a1-a0 >= 2*math.Pi {
+		a1 = a0 + 2*math.Pi - 1e-4
+	}
+	p := &Path{}
+	p.MoveTo(cx+outer*math.Cos(a0), cy+outer*math.Sin(a0))
+	arc(p, cx, cy, outer, a0, a1)
+	p.LineTo(cx+inner*math.Cos(a1), cy+inner*math.Sin(a1))
+	arc(p, cx, cy, inner, a1, a0)
+	return p.Close()
+}
+
+// arc appends a circular arc from a0 to a1 using cubic Béziers of at most
+// 90° each. The current point must be the start of the arc.
+func arc(p *Path, cx, cy, r, a0, a1 float64) {
+	segments := int(math.Ceil(math.Abs(a1-a0) / (math.Pi / 2)))
+	if segments < 1 {
+		segments = 1
+	}
+	step := (a1 - a0) / float64(segments)
+	k := 4.0 / 3.0 * math.Tan(step/4)
+	for i := 0; i < segments; i++ {
+		s := a0 + float64(i)*step
+		e := s + step
+		x0, y0 := cx+r*math.Cos(s), cy+r*math.Sin(s)
+		x1, y1 := cx+r*math.Cos(e), cy+r*math.Sin(e)
+		p.CubicTo(
+			x0-k*r*math.Sin(s), y0+k*r*math.Cos(s),
+			x1+k*r*math.Sin(e), y1-k*r*math.Cos(e),
+			x1, y1,
+		)
+	}
+}
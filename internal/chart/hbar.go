@@ -0,0 +1,59 @@
+package chart
+
+import "math"
+
+// HBarChart draws one horizontal bar per slice, labelled on the left and with
+// the value on the right. Values are drawn as magnitudes.
+type HBarChart struct {
+	Title     string
+	Bars      []Slice
+	EmptyText string
+	Format    func(float64) string
+}
+
+func (h HBarChart) Draw(c Canvas, width, height float64) {
+	top := padding
+	if h.Title != "" {
+		c.Text(padding, padding+titleSize, h.Title, TextStyle{Size: titleSize, Color: TextColor, Bold: true})
+		top += titleSize + padding
+	}
+
+	format := h.Format
+	if format == nil {
+		format = DefaultFormat
+	}
+
+	var max float64
+	for _, b := range h.Bars {
+		max = math.Max(max, math.Abs(b.Value))
+	}
+	if max == 0 {
+		c.Text(width/2, top+(height-top)/2, h.EmptyText, TextStyle{Size: labelSize, Color: MutedColor, Anchor: AnchorMiddle})
+		return
+	}
+
+	labelWidth, valueWidth := 0.0, 0.0
+	values := make([]string, len(h.Bars))
+	for i, b := range h.Bars {
+		labelWidth = math.Max(labelWidth, textWidth(b.Label, labelSize))
+		values[i] = b.ValueLabel
+		if values[i] == "" {
+			values[i] = format(b.Value)
+		}
+		valueWidth = math.Max(valueWidth, textWidth(values[i], labelSize))
+	}
+	labelWidth = math.Min(labelWidth, width*0.3)
+
+	left := padding + labelWidth + 8
+	right := width - padding - valueWidth - 8
+	rowHeight := math.Min((height-top-padding)/float64(len(h.Bars)), 28)
+	barHeight := rowHeight * 0.65
+
+	for i, b := range h.Bars {
+		y := top + float64(i)*rowHeight
+		w := (right - left) * math.Abs(b.Value) / max
+		c.Text(left-8, y+barHeight/2+labelSize/3, truncate(b.Label, labelWidth, labelSize), TextStyle{Size: labelSize, Color: TextColor, Anchor: AnchorEnd})
+		c.Rect(left, y, w, barHeight, ColorAt(i))
+		c.Text(left+w+8, y+barHeight/2+labelSize/3, values[i], TextStyle{Size: labelSize, Color: MutedColor})
+	}
+}
@@ -0,0 +1,86 @@
+package chart
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// SVG is a Canvas that produces a standalone SVG document.
+type SVG struct {
+	width, height float64
+	body          strings.Builder
+}
+
+func NewSVG(width, height float64) *SVG {
+	return &SVG{width: width, height: height}
+}
+
+// RenderSVG draws ch onto a new SVG canvas and returns the document.
+func RenderSVG(ch Chart, width, height float64) []byte {
+	s := NewSVG(width, height)
+	ch.Draw(s, width, height)
+	return s.Bytes()
+}
+
+func (s *SVG) Rect(x, y, w, h float64, fill string) {
+	fmt.Fprintf(&s.body, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`,
+		num(x), num(y), num(w), num(h), html.EscapeString(fill))
+}
+
+func (s *SVG) Path(p *Path, fill string) {
+	var d strings.Builder
+	for _, op := range p.Ops {
+		switch op.Kind {
+		case OpMoveTo:
+			fmt.Fprintf(&d, "M%s %s", num(op.Points[0].X), num(op.Points[0].Y))
+		case OpLineTo:
+			fmt.Fprintf(&d, "L%s %s", num(op.Points[0].X), num(op.Points[0].Y))
+		case OpCubicTo:
+			fmt.Fprintf(&d, "C%s %s %s %s %s %s",
+				num(op.Points[0].X), num(op.Points[0].Y),
+				num(op.Points[1].X), num(op.Points[1].Y),
+				num(op.Points[2].X), num(op.Points[2].Y))
+		case OpClose:
+			d.WriteString("Z")
+		}
+	}
+	fmt.Fprintf(&s.body, `<path d="%s" fill="%s"/>`, d.String(), html.EscapeString(fill))
+}
+
+func (s *SVG) Line(x1, y1, x2, y2 float64, stroke string, width float64) {
+	fmt.Fprintf(&s.body, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s"/>`,
+		num(x1), num(y1), num(x2), num(y2), html.EscapeString(stroke), num(width))
+}
+
+func (s *SVG) Text(x, y float64, text string, style TextStyle) {
+	anchor := "start"
+	switch style.Anchor {
+	case AnchorMiddle:
+		anchor = "middle"
+	case AnchorEnd:
+		anchor = "end"
+	}
+	weight := ""
+	if style.Bold {
+		weight = ` font-weight="bold"`
+	}
+	fmt.Fprintf(&s.body, `<text x="%s" y="%s" font-size="%s" fill="%s" text-anchor="%s"%s>%s</text>`,
+		num(x), num(y), num(style.Size), html.EscapeString(style.Color), anchor, weight, html.EscapeString(text))
+}
+
+// Bytes returns the complete SVG document.
+func (s *SVG) Bytes() []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s" font-family="system-ui, -apple-system, 'Segoe UI', Roboto, sans-serif">`,
+		num(s.width), num(s.height), num(s.width), num(s.height))
+	b.WriteString(s.body.String())
+	b.WriteString("</svg>")
+	return []byte(b.String())
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
@@ -1,12 +1,14 @@
 package config
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Auth     AuthConfig     `mapstructure:"auth"`
-	Logging  LoggingConfig  `mapstructure:"logging"`
-	Language string         `mapstructure:"language"`
-	MCP      MCPConfig      `mapstructure:"mcp"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Auth      AuthConfig      `mapstructure:"auth"`
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	Trash     TrashConfig     `mapstructure:"trash"`
+	Logging   LoggingConfig   `mapstructure:"logging"`
+	Language  string          `mapstructure:"language"`
+	MCP       MCPConfig       `mapstructure:"mcp"`
 }
 
 type MCPConfig struct {
@@ -15,9 +17,10 @@ type MCPConfig struct {
 }
 
 type ServerConfig struct {
-	Port        int      `mapstructure:"port"`
-	Host        string   `mapstructure:"host"`
-	CORSOrigins []string `mapstructure:"cors_origins"`
+	Port           int      `mapstructure:"port"`
+	Host           string   `mapstructure:"host"`
+	CORSOrigins    []string `mapstructure:"cors_origins"`
+	TrustedProxies []string `mapstructure:"trusted_proxies"` // CIDRs allowed to set X-Forwarded-For
 }
 
 type DatabaseConfig struct {
@@ -26,15 +29,79 @@ type DatabaseConfig struct {
 }
 
 type AuthConfig struct {
-	OIDC    OIDCConfig    `mapstructure:"oidc"`
-	Session SessionConfig `mapstructure:"session"`
+	OIDC    OIDCConfig       `mapstructure:"oidc"`
+	Local   LocalAuthConfig  `mapstructure:"local"`
+	Header  HeaderAuthConfig `mapstructure:"header"`
+	Session SessionConfig    `mapstructure:"session"`
 }
 
+// OIDCConfig configures logins through OpenID Connect. The provider set
+// directly under auth.oidc logs in at /auth/login; further providers are
+// listed in Providers and configured under auth.oidc.<name>. The sign-in
+// restrictions apply to all of them.
 type OIDCConfig struct {
+	OIDCProviderConfig `mapstructure:",squash"`
+
+	// Names of further providers
+	Providers []string `mapstructure:"providers"`
+	// Settings of the further providers, in the order of Providers
+	Named []OIDCProviderConfig `mapstructure:"-"`
+	// Issuer that users from before multiple providers logged in at, needed
+	// if all providers are named and there are several
+	LegacyIssuer string `mapstructure:"legacy_issuer"`
+
+	// Sign-in restrictions, empty lists allow everyone
+	AllowedEmails  []string `mapstructure:"allowed_emails"`
+	AllowedDomains []string `mapstructure:"allowed_domains"`
+	GroupsClaim    string   `mapstructure:"groups_claim"`
+	AllowedGroups  []string `mapstructure:"allowed_groups"`
+	AdminGroups    []string `mapstructure:"admin_groups"` // members are instance admins
+	Registration   string   `mapstructure:"registration"` // open or closed
+}
+
+// OIDCProviderConfig is one OIDC provider users can log in with.
+type OIDCProviderConfig struct {
+	Name         string `mapstructure:"-"` // empty for the provider directly under auth.oidc
+	DisplayName  string `mapstructure:"display_name"`
 	Issuer       string `mapstructure:"issuer"`
 	ClientID     string `mapstructure:"client_id"`
 	ClientSecret string `mapstructure:"client_secret"`
 	RedirectURL  string `mapstructure:"redirect_url"`
+
+	// Where the provider sends users after logging them out
+	PostLogoutRedirectURL string `mapstructure:"post_logout_redirect_url"`
+
+	// Trust emails without email_verified, for providers that only issue
+	// addresses they checked but don't send the claim
+	TrustUnverifiedEmail bool `mapstructure:"trust_unverified_email"`
+}
+
+// All returns the configured providers: the one directly under auth.oidc,
+// if it has an issuer, followed by the named ones.
+func (c OIDCConfig) All() []OIDCProviderConfig {
+	var all []OIDCProviderConfig
+	if c.Issuer != "" {
+		all = append(all, c.OIDCProviderConfig)
+	}
+	return append(all, c.Named...)
+}
+
+// LocalAuthConfig enables username/password logins against accounts
+// stored in the database.
+type LocalAuthConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// HeaderAuthConfig takes the user from headers set by an authenticating
+// reverse proxy such as Authelia or oauth2-proxy. The headers are only
+// trusted on requests coming directly from TrustedProxies.
+type HeaderAuthConfig struct {
+	Enabled        bool     `mapstructure:"enabled"`
+	TrustedProxies []string `mapstructure:"trusted_proxies"` // CIDRs or addresses of the proxy
+	UserHeader     string   `mapstructure:"user_header"`     // required, identifies the user
+	EmailHeader    string   `mapstructure:"email_header"`
+	NameHeader     string   `mapstructure:"name_header"`
+	LogoutURL      string   `mapstructure:"logout_url"` // where the logout link leads, e.g. the proxy's logout
 }
 
 type SessionConfig struct {
@@ -43,6 +110,28 @@ type SessionConfig struct {
 	Secure *bool  `mapstructure:"secure"`
 }
 
+// RateLimitConfig limits requests to the API, GraphQL and login endpoints.
+// Limits are per minute; 0 disables a limit.
+type RateLimitConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Store   string `mapstructure:"store"` // memory or database
+
+	RequestsPerMinute      int `mapstructure:"requests_per_minute"`       // per client address
+	TokenRequestsPerMinute int `mapstructure:"token_requests_per_minute"` // per API token
+	AuthRequestsPerMinute  int `mapstructure:"auth_requests_per_minute"`  // login endpoints per client address
+
+	// MaxFailures invalid tokens from one address lock it out for Lockout
+	// seconds
+	MaxFailures int `mapstructure:"max_failures"`
+	Lockout     int `mapstructure:"lockout"`
+}
+
+// TrashConfig controls how long deleted households, transactions and
+// recurring expenses stay in the trash. 0 keeps them until restored.
+type TrashConfig struct {
+	RetentionDays int `mapstructure:"retention_days"`
+}
+
 type LoggingConfig struct {
 	Level string `mapstructure:"level"`
 }
@@ -58,10 +147,31 @@ func Defaults() Config {
 			DSN:    "money-tracker.db?_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)",
 		},
 		Auth: AuthConfig{
+			OIDC: OIDCConfig{
+				GroupsClaim:  "groups",
+				Registration: "open",
+			},
+			Header: HeaderAuthConfig{
+				UserHeader:  "Remote-User",
+				EmailHeader: "Remote-Email",
+				NameHeader:  "Remote-Name",
+			},
 			Session: SessionConfig{
 				MaxAge: 86400,
 			},
 		},
+		RateLimit: RateLimitConfig{
+			Enabled:                true,
+			Store:                  "memory",
+			RequestsPerMinute:      600,
+			TokenRequestsPerMinute: 300,
+			AuthRequestsPerMinute:  20,
+			MaxFailures:            10,
+			Lockout:                900,
+		},
+		Trash: TrashConfig{
+			RetentionDays: 30,
+		},
 		Logging: LoggingConfig{
 			Level: "info",
 		},
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -18,15 +21,42 @@ func Load(configFile string) (Config, error) {
 	v.SetDefault("server.port", cfg.Server.Port)
 	v.SetDefault("server.host", cfg.Server.Host)
 	v.SetDefault("server.cors_origins", cfg.Server.CORSOrigins)
+	v.SetDefault("server.trusted_proxies", cfg.Server.TrustedProxies)
 	v.SetDefault("database.driver", cfg.Database.Driver)
 	v.SetDefault("database.dsn", cfg.Database.DSN)
+	v.SetDefault("auth.oidc.display_name", cfg.Auth.OIDC.DisplayName)
 	v.SetDefault("auth.oidc.issuer", cfg.Auth.OIDC.Issuer)
 	v.SetDefault("auth.oidc.client_id", cfg.Auth.OIDC.ClientID)
 	v.SetDefault("auth.oidc.client_secret", cfg.Auth.OIDC.ClientSecret)
 	v.SetDefault("auth.oidc.redirect_url", cfg.Auth.OIDC.RedirectURL)
+	v.SetDefault("auth.oidc.post_logout_redirect_url", cfg.Auth.OIDC.PostLogoutRedirectURL)
+	v.SetDefault("auth.oidc.trust_unverified_email", cfg.Auth.OIDC.TrustUnverifiedEmail)
+	v.SetDefault("auth.oidc.allowed_emails", cfg.Auth.OIDC.AllowedEmails)
+	v.SetDefault("auth.oidc.allowed_domains", cfg.Auth.OIDC.AllowedDomains)
+	v.SetDefault("auth.oidc.groups_claim", cfg.Auth.OIDC.GroupsClaim)
+	v.SetDefault("auth.oidc.allowed_groups", cfg.Auth.OIDC.AllowedGroups)
+	v.SetDefault("auth.oidc.admin_groups", cfg.Auth.OIDC.AdminGroups)
+	v.SetDefault("auth.oidc.registration", cfg.Auth.OIDC.Registration)
+	v.SetDefault("auth.oidc.providers", cfg.Auth.OIDC.Providers)
+	v.SetDefault("auth.oidc.legacy_issuer", cfg.Auth.OIDC.LegacyIssuer)
+	v.SetDefault("auth.local.enabled", cfg.Auth.Local.Enabled)
+	v.SetDefault("auth.header.enabled", cfg.Auth.Header.Enabled)
+	v.SetDefault("auth.header.trusted_proxies", cfg.Auth.Header.TrustedProxies)
+	v.SetDefault("auth.header.user_header", cfg.Auth.Header.UserHeader)
+	v.SetDefault("auth.header.email_header", cfg.Auth.Header.EmailHeader)
+	v.SetDefault("auth.header.name_header", cfg.Auth.Header.NameHeader)
+	v.SetDefault("auth.header.logout_url", cfg.Auth.Header.LogoutURL)
 	v.SetDefault("auth.session.secret", cfg.Auth.Session.Secret)
 	v.SetDefault("auth.session.max_age", cfg.Auth.Session.MaxAge)
 	v.SetDefault("auth.session.secure", true)
+	v.SetDefault("rate_limit.enabled", cfg.RateLimit.Enabled)
+	v.SetDefault("rate_limit.store", cfg.RateLimit.Store)
+	v.SetDefault("rate_limit.requests_per_minute", cfg.RateLimit.RequestsPerMinute)
+	v.SetDefault("rate_limit.token_requests_per_minute", cfg.RateLimit.TokenRequestsPerMinute)
+	v.SetDefault("rate_limit.auth_requests_per_minute", cfg.RateLimit.AuthRequestsPerMinute)
+	v.SetDefault("rate_limit.max_failures", cfg.RateLimit.MaxFailures)
+	v.SetDefault("rate_limit.lockout", cfg.RateLimit.Lockout)
+	v.SetDefault("trash.retention_days", cfg.Trash.RetentionDays)
 	v.SetDefault("logging.level", cfg.Logging.Level)
 	v.SetDefault("mcp.url", "http://localhost:8080")
 	v.SetDefault("mcp.token", "")
@@ -42,5 +72,37 @@ func Load(configFile string) (Config, error) {
 		return cfg, err
 	}
 
+	// The keys of named providers are only known now
+	for _, name := range cfg.Auth.OIDC.Providers {
+		if !providerName.MatchString(name) || slices.Contains(oidcKeys, name) {
+			return cfg, fmt.Errorf("invalid auth.oidc.providers entry %q: use lowercase letters, digits and underscores, not an auth.oidc option", name)
+		}
+		key := func(k string) string {
+			key := "auth.oidc." + name + "." + k
+			_ = v.BindEnv(key)
+			return key
+		}
+		get := func(k string) string { return v.GetString(key(k)) }
+		cfg.Auth.OIDC.Named = append(cfg.Auth.OIDC.Named, OIDCProviderConfig{
+			Name:                  name,
+			DisplayName:           get("display_name"),
+			Issuer:                get("issuer"),
+			ClientID:              get("client_id"),
+			ClientSecret:          get("client_secret"),
+			RedirectURL:           get("redirect_url"),
+			PostLogoutRedirectURL: get("post_logout_redirect_url"),
+			TrustUnverifiedEmail:  v.GetBool(key("trust_unverified_email")),
+		})
+	}
+
 	return cfg, nil
 }
+
+var (
+	providerName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	// Names a provider can't have since its settings would clash with them
+	oidcKeys = []string{
+		"display_name", "issuer", "client_id", "client_secret", "redirect_url", "post_logout_redirect_url", "trust_unverified_email",
+		"providers", "legacy_issuer", "allowed_emails", "allowed_domains", "groups_claim", "allowed_groups", "admin_groups", "registration",
+	}
+)
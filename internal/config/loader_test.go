@@ -21,12 +21,36 @@ func TestDefaults(t *testing.T) {
 	if cfg.Logging.Level != "info" {
 		t.Errorf("expected level info, got %s", cfg.Logging.Level)
 	}
+	if cfg.Auth.Local.Enabled {
+		t.Error("expected local auth to be disabled by default")
+	}
+	if cfg.Auth.Header.Enabled || cfg.Auth.Header.UserHeader != "Remote-User" || cfg.Auth.Header.EmailHeader != "Remote-Email" {
+		t.Errorf("unexpected header auth defaults: %+v", cfg.Auth.Header)
+	}
+	if cfg.Auth.OIDC.Registration != "open" || cfg.Auth.OIDC.GroupsClaim != "groups" {
+		t.Errorf("unexpected OIDC defaults: registration %q, groups claim %q", cfg.Auth.OIDC.Registration, cfg.Auth.OIDC.GroupsClaim)
+	}
+	if !cfg.RateLimit.Enabled || cfg.RateLimit.Store != "memory" {
+		t.Errorf("expected in-memory rate limiting by default, got %+v", cfg.RateLimit)
+	}
+	if cfg.Trash.RetentionDays != 30 {
+		t.Errorf("expected a trash retention of 30 days, got %d", cfg.Trash.RetentionDays)
+	}
 }
 
 func TestENVOverride(t *testing.T) {
 	t.Setenv("MONEY_TRACKER_SERVER_PORT", "9090")
 	t.Setenv("MONEY_TRACKER_DATABASE_DRIVER", "postgres")
 	t.Setenv("MONEY_TRACKER_LOGGING_LEVEL", "debug")
+	t.Setenv("MONEY_TRACKER_AUTH_LOCAL_ENABLED", "true")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_ALLOWED_DOMAINS", "example.com,example.org")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_REGISTRATION", "closed")
+	t.Setenv("MONEY_TRACKER_RATE_LIMIT_STORE", "database")
+	t.Setenv("MONEY_TRACKER_SERVER_TRUSTED_PROXIES", "10.0.0.0/8")
+	t.Setenv("MONEY_TRACKER_TRASH_RETENTION_DAYS", "0")
+	t.Setenv("MONEY_TRACKER_AUTH_HEADER_ENABLED", "true")
+	t.Setenv("MONEY_TRACKER_AUTH_HEADER_TRUSTED_PROXIES", "172.16.0.0/12,10.1.2.3")
+	t.Setenv("MONEY_TRACKER_AUTH_HEADER_USER_HEADER", "X-Forwarded-User")
 
 	cfg, err := Load("")
 	if err != nil {
@@ -42,6 +66,27 @@ func TestENVOverride(t *testing.T) {
 	if cfg.Logging.Level != "debug" {
 		t.Errorf("expected level debug, got %s", cfg.Logging.Level)
 	}
+	if !cfg.Auth.Local.Enabled {
+		t.Error("expected local auth to be enabled")
+	}
+	if len(cfg.Auth.OIDC.AllowedDomains) != 2 || cfg.Auth.OIDC.AllowedDomains[1] != "example.org" {
+		t.Errorf("expected two allowed domains, got %v", cfg.Auth.OIDC.AllowedDomains)
+	}
+	if cfg.Auth.OIDC.Registration != "closed" {
+		t.Errorf("expected registration closed, got %s", cfg.Auth.OIDC.Registration)
+	}
+	if cfg.RateLimit.Store != "database" {
+		t.Errorf("expected rate limit store database, got %s", cfg.RateLimit.Store)
+	}
+	if len(cfg.Server.TrustedProxies) != 1 || cfg.Server.TrustedProxies[0] != "10.0.0.0/8" {
+		t.Errorf("expected one trusted proxy, got %v", cfg.Server.TrustedProxies)
+	}
+	if cfg.Trash.RetentionDays != 0 {
+		t.Errorf("expected the trash to be kept, got %d days", cfg.Trash.RetentionDays)
+	}
+	if h := cfg.Auth.Header; !h.Enabled || len(h.TrustedProxies) != 2 || h.UserHeader != "X-Forwarded-User" || h.NameHeader != "Remote-Name" {
+		t.Errorf("unexpected header auth config: %+v", h)
+	}
 }
 
 func TestFileOverride(t *testing.T) {
@@ -68,3 +113,47 @@ func TestFileOverride(t *testing.T) {
 		t.Errorf("expected driver sqlite, got %s", cfg.Database.Driver)
 	}
 }
+
+func TestOIDCProviders(t *testing.T) {
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_ISSUER", "https://keycloak.example.com/realms/home")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_PROVIDERS", "google,work_sso")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_GOOGLE_ISSUER", "https://accounts.google.com")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_GOOGLE_CLIENT_ID", "google-client")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_GOOGLE_DISPLAY_NAME", "Google")
+	t.Setenv("MONEY_TRACKER_AUTH_OIDC_LEGACY_ISSUER", "https://accounts.google.com")
+
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "config.yaml")
+	content := []byte("auth:\n  oidc:\n    work_sso:\n      issuer: https://sso.example.com\n      client_id: money\n      trust_unverified_email: true\n")
+	if err := os.WriteFile(cfgPath, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(cfgPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	all := cfg.Auth.OIDC.All()
+	if len(all) != 3 {
+		t.Fatalf("expected three providers, got %+v", all)
+	}
+	if all[0].Name != "" || all[0].Issuer != "https://keycloak.example.com/realms/home" || all[0].TrustUnverifiedEmail {
+		t.Errorf("expected the unnamed provider first, got %+v", all[0])
+	}
+	if g := all[1]; g.Name != "google" || g.Issuer != "https://accounts.google.com" || g.ClientID != "google-client" || g.DisplayName != "Google" || g.TrustUnverifiedEmail {
+		t.Errorf("unexpected provider %+v", g)
+	}
+	if w := all[2]; w.Name != "work_sso" || w.Issuer != "https://sso.example.com" || w.ClientID != "money" || !w.TrustUnverifiedEmail {
+		t.Errorf("unexpected provider %+v", w)
+	}
+	if cfg.Auth.OIDC.LegacyIssuer != "https://accounts.google.com" {
+		t.Errorf("unexpected legacy issuer %q", cfg.Auth.OIDC.LegacyIssuer)
+	}
+
+	for _, name := range []string{"Google", "my-idp", "issuer", "legacy_issuer"} {
+		t.Setenv("MONEY_TRACKER_AUTH_OIDC_PROVIDERS", name)
+		if _, err := Load(""); err == nil {
+			t.Errorf("%s: expected an invalid provider name to be rejected", name)
+		}
+	}
+}
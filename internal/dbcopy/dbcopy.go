@@ -0,0 +1,328 @@
+// Package dbcopy copies all ent-managed tables from one database to another,
+// e.g. to move an installation from SQLite to Postgres.
+package dbcopy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"go.uber.org/zap"
+
+	"icekalt.dev/money-tracker/ent/migrate"
+)
+
+const DefaultBatchSize = 500
+
+// ErrTargetNotEmpty is returned when the target database already contains rows.
+var ErrTargetNotEmpty = errors.New("target database is not empty")
+
+type Options struct {
+	BatchSize int
+	Logger    *zap.Logger
+}
+
+type TableReport struct {
+	Table  string
+	Source int
+	Target int
+}
+
+type Report struct {
+	Tables []TableReport
+}
+
+// Copy streams every row of every ent table from src to dst. The schema must
+// already exist on dst and all of its tables must be empty. Tables are copied
+// in foreign key dependency order, IDs are preserved and Postgres identity
+// sequences are advanced past the copied IDs. Row counts are verified at the end.
+func Copy(ctx context.Context, src, dst *entsql.Driver, opts Options) (*Report, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	tables, err := sortTables(migrate.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tables {
+		n, err := countRows(ctx, dst, t.Name)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			return nil, fmt.Errorf("%w: table %s has %d rows", ErrTargetNotEmpty, t.Name, n)
+		}
+	}
+
+	tx, err := dst.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	for _, t := range tables {
+		n, err := copyTable(ctx, src, tx, dst.Dialect(), t, opts.BatchSize)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("copying %s: %w", t.Name, err)
+		}
+		opts.Logger.Info("copied table", zap.String("table", t.Name), zap.Int("rows", n))
+	}
+	if dst.Dialect() == dialect.Postgres {
+		for _, t := range tables {
+			if err := resetSequence(ctx, tx, t); err != nil {
+				_ = tx.Rollback()
+				return nil, fmt.Errorf("resetting sequence for %s: %w", t.Name, err)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing: %w", err)
+	}
+
+	report := &Report{}
+	var mismatched []string
+	for _, t := range tables {
+		srcCount, err := countRows(ctx, src, t.Name)
+		if err != nil {
+			return nil, err
+		}
+		dstCount, err := countRows(ctx, dst, t.Name)
+		if err != nil {
+			return nil, err
+		}
+		report.Tables = append(report.Tables, TableReport{Table: t.Name, Source: srcCount, Target: dstCount})
+		if srcCount != dstCount {
+			mismatched = append(mismatched, fmt.Sprintf("%s (source %d, target %d)", t.Name, srcCount, dstCount))
+		}
+	}
+	if len(mismatched) > 0 {
+		return report, fmt.Errorf("row count mismatch: %v", mismatched)
+	}
+	return report, nil
+}
+
+// sortTables orders tables so that every table comes after the tables it
+// references. Self-references are ignored. The input order is kept where
+// dependencies allow it, which keeps the output deterministic.
+func sortTables(tables []*schema.Table) ([]*schema.Table, error) {
+	done := make(map[string]bool, len(tables))
+	sorted := make([]*schema.Table, 0, len(tables))
+	for len(sorted) < len(tables) {
+		progressed := false
+		for _, t := range tables {
+			if done[t.Name] {
+				continue
+			}
+			ready := true
+			for _, fk := range t.ForeignKeys {
+				if fk.RefTable != nil && fk.RefTable.Name != t.Name && !done[fk.RefTable.Name] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				done[t.Name] = true
+				sorted = append(sorted, t)
+				progressed = true
+			}
+		}
+		if !progressed {
+			return nil, fmt.Errorf("cyclic foreign keys between tables")
+		}
+	}
+	return sorted, nil
+}
+
+func countRows(ctx context.Context, drv *entsql.Driver, table string) (int, error) {
+	query, args := entsql.Dialect(drv.Dialect()).
+		Select(entsql.Count("*")).
+		From(entsql.Table(table)).
+		Query()
+	var n int
+	if err := drv.DB().QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+		return 0, fmt.Errorf("counting %s: %w", table, err)
+	}
+	return n, nil
+}
+
+// copyTable pages through the source table by primary key and inserts each
+// page into the target with a single multi-row insert.
+func copyTable(ctx context.Context, src *entsql.Driver, tx *sql.Tx, dstDialect string, t *schema.Table, batchSize int) (int, error) {
+	columns := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		columns[i] = c.Name
+	}
+	if len(t.PrimaryKey) != 1 {
+		return 0, fmt.Errorf("expected a single primary key column, got %d", len(t.PrimaryKey))
+	}
+	pk := t.PrimaryKey[0].Name
+	pkIndex := -1
+	for i, c := range columns {
+		if c == pk {
+			pkIndex = i
+			break
+		}
+	}
+	if pkIndex < 0 {
+		return 0, fmt.Errorf("primary key column %s not found", pk)
+	}
+
+	var (
+		total  int
+		lastID any
+		first  = true
+	)
+	for {
+		sel := entsql.Dialect(src.Dialect()).
+			Select(columns...).
+			From(entsql.Table(t.Name)).
+			OrderBy(pk).
+			Limit(batchSize)
+		if !first {
+			sel.Where(entsql.GT(pk, lastID))
+		}
+		query, args := sel.Query()
+
+		rows, err := readRows(ctx, src.DB(), query, args, t.Columns)
+		if err != nil {
+			return total, err
+		}
+		if len(rows) == 0 {
+			return total, nil
+		}
+
+		ins := entsql.Dialect(dstDialect).Insert(t.Name).Columns(columns...)
+		for _, row := range rows {
+			ins.Values(row...)
+		}
+		query, args = ins.Query()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return total, fmt.Errorf("inserting rows: %w", err)
+		}
+
+		total += len(rows)
+		switch id := rows[len(rows)-1][pkIndex].(type) {
+		case int64, string:
+			lastID = id
+		default:
+			return total, fmt.Errorf("unsupported primary key type %T", id)
+		}
+		first = false
+		if len(rows) < batchSize {
+			return total, nil
+		}
+	}
+}
+
+func readRows(ctx context.Context, db *sql.DB, query string, args []any, columns []*schema.Column) ([][]any, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("reading rows: %w", err)
+	}
+	defer rows.Close()
+
+	var result [][]any
+	for rows.Next() {
+		dest := make([]any, len(columns))
+		for i, c := range columns {
+			dest[i] = scanTarget(c.Type)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("scanning row: %w", err)
+		}
+		values := make([]any, len(columns))
+		for i := range dest {
+			values[i] = scannedValue(dest[i], columns[i].Nullable)
+		}
+		result = append(result, values)
+	}
+	return result, rows.Err()
+}
+
+// scanTarget returns a scan destination that normalizes driver specific
+// representations (e.g. SQLite booleans stored as integers) to Go types both
+// drivers accept on insert.
+func scanTarget(t field.Type) any {
+	switch t {
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		return &sql.NullInt64{}
+	case field.TypeFloat32, field.TypeFloat64:
+		return &sql.NullFloat64{}
+	case field.TypeBool:
+		return &sql.NullBool{}
+	case field.TypeTime:
+		return &sql.NullTime{}
+	case field.TypeString, field.TypeEnum, field.TypeJSON:
+		return &sql.NullString{}
+	case field.TypeBytes:
+		return &[]byte{}
+	default:
+		var v any
+		return &v
+	}
+}
+
+func scannedValue(dest any, nullable bool) any {
+	switch v := dest.(type) {
+	case *sql.NullInt64:
+		if !v.Valid {
+			return nil
+		}
+		return v.Int64
+	case *sql.NullFloat64:
+		if !v.Valid {
+			return nil
+		}
+		return v.Float64
+	case *sql.NullBool:
+		if !v.Valid {
+			return nil
+		}
+		return v.Bool
+	case *sql.NullTime:
+		if !v.Valid {
+			return nil
+		}
+		return v.Time
+	case *sql.NullString:
+		if !v.Valid {
+			return nil
+		}
+		return v.String
+	case *[]byte:
+		if *v == nil && nullable {
+			return nil
+		}
+		if *v == nil {
+			return []byte{}
+		}
+		return *v
+	case *any:
+		return *v
+	default:
+		return nil
+	}
+}
+
+func resetSequence(ctx context.Context, tx *sql.Tx, t *schema.Table) error {
+	pk := t.PrimaryKey[0]
+	if !pk.Increment {
+		return nil
+	}
+	query := fmt.Sprintf(
+		`SELECT setval(pg_get_serial_sequence('%[1]q', '%[2]s'), MAX(%[2]q)) FROM %[1]q HAVING MAX(%[2]q) IS NOT NULL`,
+		t.Name, pk.Name,
+	)
+	_, err := tx.ExecContext(ctx, query)
+	return err
+}
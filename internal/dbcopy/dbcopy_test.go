@@ -0,0 +1,142 @@
+package dbcopy_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"icekalt.dev/money-tracker/ent"
+	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/dbcopy"
+	"icekalt.dev/money-tracker/internal/repository"
+)
+
+func openTestDB(t *testing.T, name string) (*entsql.Driver, *ent.Client) {
+	t.Helper()
+
+	dsn := filepath.Join(t.TempDir(), name) + "?_pragma=foreign_keys(1)"
+	drv, err := repository.OpenDriver(config.DatabaseConfig{Driver: "sqlite", DSN: dsn})
+	if err != nil {
+		t.Fatalf("failed to open driver: %v", err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return drv, client
+}
+
+func seed(t *testing.T, client *ent.Client) {
+	t.Helper()
+	ctx := context.Background()
+
+	u := client.User.Create().SetEmail("a@example.com").SetName("A").SetSubject("sub-a").SaveX(ctx)
+	h := client.Household.Create().SetName("Home").SetOwner(u).SaveX(ctx)
+	cat := client.Category.Create().SetName("Food").SetHousehold(h).SaveX(ctx)
+	for i := range 5 {
+		client.Transaction.Create().
+			SetAmount(-1250).
+			SetDate(time.Date(2025, 1, i+1, 0, 0, 0, 0, time.UTC)).
+			SetHousehold(h).
+			SetCategory(cat).
+			SaveX(ctx)
+	}
+	// Leave a gap in the transaction IDs to verify they are preserved.
+	client.Transaction.DeleteOneID(2).ExecX(ctx)
+
+	re := client.RecurringExpense.Create().
+		SetName("Rent").
+		SetAmount(-90000).
+		SetFrequency("monthly").
+		SetActive(false).
+		SetStartDate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		SetHousehold(h).
+		SetCategory(cat).
+		SaveX(ctx)
+	client.RecurringScheduleOverride.Create().
+		SetEffectiveDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+		SetAmount(-95000).
+		SetFrequency("monthly").
+		SetRecurringExpense(re).
+		SaveX(ctx)
+	client.APIToken.Create().SetName("cli").SetTokenHash("hash").SetUser(u).SaveX(ctx)
+	client.Session.Create().SetToken("tok").SetData([]byte("payload")).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+	client.Settings.Create().SetKey("session_secret").SetValue("s3cret").SaveX(ctx)
+}
+
+func TestCopy(t *testing.T) {
+	ctx := context.Background()
+	srcDrv, srcClient := openTestDB(t, "src.db")
+	dstDrv, dstClient := openTestDB(t, "dst.db")
+	seed(t, srcClient)
+
+	report, err := dbcopy.Copy(ctx, srcDrv, dstDrv, dbcopy.Options{BatchSize: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	counts := make(map[string]int)
+	for _, tr := range report.Tables {
+		if tr.Source != tr.Target {
+			t.Errorf("%s: source %d != target %d", tr.Table, tr.Source, tr.Target)
+		}
+		counts[tr.Table] = tr.Target
+	}
+	if counts["transactions"] != 4 {
+		t.Errorf("transactions = %d, want 4", counts["transactions"])
+	}
+	if counts["sessions"] != 1 || counts["settings"] != 1 || counts["api_tokens"] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+
+	ids := dstClient.Transaction.Query().IDsX(ctx)
+	want := []int{1, 3, 4, 5}
+	if len(ids) != len(want) {
+		t.Fatalf("transaction ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("transaction ids = %v, want %v", ids, want)
+		}
+	}
+
+	re := dstClient.RecurringExpense.Query().OnlyX(ctx)
+	if re.Active {
+		t.Error("expected recurring expense to stay inactive")
+	}
+	sess := dstClient.Session.Query().OnlyX(ctx)
+	if string(sess.Data) != "payload" {
+		t.Errorf("session data = %q, want %q", sess.Data, "payload")
+	}
+
+	// New rows must not collide with copied IDs.
+	h := dstClient.Household.Query().OnlyX(ctx)
+	cat := dstClient.Category.Query().OnlyX(ctx)
+	tx := dstClient.Transaction.Create().
+		SetAmount(100).
+		SetDate(time.Now()).
+		SetHousehold(h).
+		SetCategory(cat).
+		SaveX(ctx)
+	if tx.ID <= 5 {
+		t.Errorf("new transaction id = %d, want > 5", tx.ID)
+	}
+}
+
+func TestCopyRefusesNonEmptyTarget(t *testing.T) {
+	ctx := context.Background()
+	srcDrv, srcClient := openTestDB(t, "src.db")
+	dstDrv, dstClient := openTestDB(t, "dst.db")
+	seed(t, srcClient)
+	dstClient.Settings.Create().SetKey("k").SetValue("v").SaveX(ctx)
+
+	_, err := dbcopy.Copy(ctx, srcDrv, dstDrv, dbcopy.Options{})
+	if !errors.Is(err, dbcopy.ErrTargetNotEmpty) {
+		t.Errorf("expected ErrTargetNotEmpty, got %v", err)
+	}
+}
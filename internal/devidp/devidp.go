@@ -0,0 +1,325 @@
+// Package devidp is a small OpenID Connect provider for tests and local
+// demos. It serves discovery, JWKS, authorization and token endpoints and
+// signs ID tokens for a fixed list of users. There are no passwords: the
+// authorization page lets anyone pick a user.
+package devidp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// User is someone who can log in at the provider.
+type User struct {
+	Subject       string   `json:"sub"`
+	Email         string   `json:"email,omitempty"`
+	EmailVerified *bool    `json:"email_verified,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Groups        []string `json:"groups,omitempty"`
+	// Claims are added to the ID token and override the claims above.
+	Claims map[string]any `json:"claims,omitempty"`
+}
+
+// Client is an application registered at the provider.
+type Client struct {
+	ID     string
+	Secret string
+	// RedirectURIs the client may use; empty allows all.
+	RedirectURIs []string
+}
+
+// DemoUsers are the users of `money-tracker dev-idp` without a users file.
+var DemoUsers = []User{
+	{Subject: "alice", Email: "alice@example.com", EmailVerified: &verified, Name: "Alice Example", Groups: []string{"admins"}},
+	{Subject: "bob", Email: "bob@example.com", EmailVerified: &verified, Name: "Bob Example"},
+}
+
+var verified = true
+
+// Provider is the OIDC provider. It is an http.Handler serving its
+// endpoints under the issuer URL's path. Set Users and Clients before it
+// serves requests.
+type Provider struct {
+	issuer string
+	key    *rsa.PrivateKey
+	keyID  string
+
+	// Users who can log in.
+	Users []User
+	// Clients that may use the provider; empty accepts any client.
+	Clients []Client
+	// TokenTTL is how long ID tokens are valid, an hour by default.
+	TokenTTL time.Duration
+	// DisableEndSession leaves the end_session_endpoint out of the
+	// discovery document.
+	DisableEndSession bool
+
+	mux *http.ServeMux
+
+	mu      sync.Mutex
+	codes   map[string]grant
+	loginAs string
+}
+
+// grant is an authorization code waiting to be exchanged for tokens.
+type grant struct {
+	user        User
+	clientID    string
+	redirectURI string
+	nonce       string
+	expires     time.Time
+}
+
+// codeTTL is how long authorization codes can be exchanged.
+const codeTTL = time.Minute
+
+// New creates a provider with a fresh signing key. issuer is the URL the
+// provider is reached at, like http://localhost:9000.
+func New(issuer string, users ...User) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generating signing key: %w", err)
+	}
+	keyID, err := randomString(8)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		issuer:   strings.TrimSuffix(issuer, "/"),
+		key:      key,
+		keyID:    keyID,
+		Users:    users,
+		TokenTTL: time.Hour,
+		codes:    make(map[string]grant),
+	}
+
+	p.mux = http.NewServeMux()
+	p.mux.HandleFunc("GET "+p.path("/.well-known/openid-configuration"), p.handleDiscovery)
+	p.mux.HandleFunc("GET "+p.path("/jwks"), p.handleJWKS)
+	p.mux.HandleFunc("GET "+p.path("/authorize"), p.handleAuthorize)
+	p.mux.HandleFunc("POST "+p.path("/authorize"), p.handleAuthorize)
+	p.mux.HandleFunc("POST "+p.path("/token"), p.handleToken)
+	p.mux.HandleFunc("GET "+p.path("/logout"), p.handleLogout)
+	return p, nil
+}
+
+// Issuer returns the provider's issuer identifier.
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+// LoginAs makes authorization requests log in as the user with the subject
+// without showing the page to pick a user, so tests can follow the whole
+// login with an HTTP client. An empty subject shows the page again.
+func (p *Provider) LoginAs(subject string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.loginAs = subject
+}
+
+// Sign returns a JWT with the claims, signed with the provider's key. It
+// lets tests build tokens the provider wouldn't issue.
+func (p *Provider) Sign(claims map[string]any) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": p.keyID, "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	input := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return input + "." + b64(sig), nil
+}
+
+// LogoutToken returns a back-channel logout token for the client that ends
+// the user's session sid, or all their sessions if sid is empty.
+func (p *Provider) LogoutToken(clientID, subject, sid string) (string, error) {
+	jti, err := randomString(16)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"iss":    p.issuer,
+		"aud":    clientID,
+		"iat":    now.Unix(),
+		"exp":    now.Add(2 * time.Minute).Unix(),
+		"jti":    jti,
+		"events": map[string]any{"http://schemas.openid.net/event/backchannel-logout": map[string]any{}},
+	}
+	if subject != "" {
+		claims["sub"] = subject
+	}
+	if sid != "" {
+		claims["sid"] = sid
+	}
+	return p.Sign(claims)
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	metadata := map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{"openid", "profile", "email"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"claims_supported":                      []string{"sub", "email", "email_verified", "name", "groups", "sid"},
+		"backchannel_logout_supported":          true,
+		"backchannel_logout_session_supported":  true,
+	}
+	if !p.DisableEndSession {
+		metadata["end_session_endpoint"] = p.issuer + "/logout"
+	}
+	writeJSON(w, http.StatusOK, metadata)
+}
+
+func (p *Provider) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": p.keyID,
+		"alg": "RS256",
+		"use": "sig",
+		"n":   b64(p.key.N.Bytes()),
+		"e":   b64(big.NewInt(int64(p.key.E)).Bytes()),
+	}}})
+}
+
+// idToken returns a signed ID token for the user. Each token starts a new
+// session at the provider, named by its sid claim.
+func (p *Provider) idToken(user User, clientID, nonce string) (string, error) {
+	sid, err := randomString(16)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"iss": p.issuer,
+		"sub": user.Subject,
+		"aud": clientID,
+		"iat": now.Unix(),
+		"exp": now.Add(p.TokenTTL).Unix(),
+		"sid": sid,
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	if user.Email != "" {
+		claims["email"] = user.Email
+	}
+	if user.EmailVerified != nil {
+		claims["email_verified"] = *user.EmailVerified
+	}
+	if user.Name != "" {
+		claims["name"] = user.Name
+	}
+	if len(user.Groups) > 0 {
+		claims["groups"] = user.Groups
+	}
+	for k, v := range user.Claims {
+		claims[k] = v
+	}
+	return p.Sign(claims)
+}
+
+func (p *Provider) user(subject string) (User, bool) {
+	i := slices.IndexFunc(p.Users, func(u User) bool { return u.Subject == subject })
+	if i < 0 {
+		return User{}, false
+	}
+	return p.Users[i], true
+}
+
+// client checks a client and its redirect URI. With no registered clients,
+// every client is accepted.
+func (p *Provider) client(id, redirectURI string) error {
+	if id == "" {
+		return errors.New("missing client_id")
+	}
+	if len(p.Clients) == 0 {
+		return nil
+	}
+	i := slices.IndexFunc(p.Clients, func(c Client) bool { return c.ID == id })
+	if i < 0 {
+		return fmt.Errorf("unknown client %q", id)
+	}
+	uris := p.Clients[i].RedirectURIs
+	if len(uris) > 0 && !slices.Contains(uris, redirectURI) {
+		return fmt.Errorf("redirect_uri %q is not registered", redirectURI)
+	}
+	return nil
+}
+
+// authenticate checks the client's credentials at the token endpoint.
+func (p *Provider) authenticate(r *http.Request) (string, bool) {
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id, secret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	}
+	if id == "" {
+		return "", false
+	}
+	if len(p.Clients) == 0 {
+		return id, true
+	}
+	i := slices.IndexFunc(p.Clients, func(c Client) bool { return c.ID == id })
+	return id, i >= 0 && p.Clients[i].Secret == secret
+}
+
+// path returns the path of an endpoint below the issuer URL.
+func (p *Provider) path(endpoint string) string {
+	path := p.issuer
+	if i := strings.Index(path, "://"); i >= 0 {
+		path = path[i+3:]
+	}
+	if i := strings.Index(path, "/"); i >= 0 {
+		return path[i:] + endpoint
+	}
+	return endpoint
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
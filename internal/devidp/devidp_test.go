@@ -0,0 +1,214 @@
+package devidp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	"icekalt.dev/money-tracker/internal/devidp"
+)
+
+const redirectURL = "https://money.example.com/auth/callback"
+
+// start serves a provider at a path below the test server, like issuers
+// of Keycloak realms.
+func start(t *testing.T, users ...devidp.User) *devidp.Provider {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(nil)
+	p, err := devidp.New("http://"+srv.Listener.Addr().String()+"/realms/test", users...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Clients = []devidp.Client{{ID: "money-tracker", Secret: "secret", RedirectURIs: []string{redirectURL}}}
+	srv.Config.Handler = p
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return p
+}
+
+// noRedirects is a client that returns redirects instead of following them.
+var noRedirects = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// authorize sends an authorization request and returns the parameters of
+// the redirect back to the client.
+func authorize(t *testing.T, authURL string) url.Values {
+	t.Helper()
+	resp, err := noRedirects.Get(authURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("expected a redirect, got %d", resp.StatusCode)
+	}
+	loc, _ := url.Parse(resp.Header.Get("Location"))
+	if !strings.HasPrefix(loc.String(), redirectURL) {
+		t.Fatalf("expected a redirect to the client, got %s", loc)
+	}
+	return loc.Query()
+}
+
+func TestProvider_CodeFlow(t *testing.T) {
+	verified := false
+	p := start(t, devidp.User{
+		Subject:       "alice",
+		Email:         "alice@example.com",
+		EmailVerified: &verified,
+		Name:          "Alice",
+		Groups:        []string{"admins"},
+		Claims:        map[string]any{"locale": "de"},
+	})
+	ctx := context.Background()
+
+	provider, err := oidc.NewProvider(ctx, p.Issuer())
+	if err != nil {
+		t.Fatalf("discovery failed: %v", err)
+	}
+	config := oauth2.Config{
+		ClientID:     "money-tracker",
+		ClientSecret: "secret",
+		RedirectURL:  redirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       []string{oidc.ScopeOpenID, "email"},
+	}
+	authURL := config.AuthCodeURL("state-1", oidc.Nonce("nonce-1"))
+
+	// Without LoginAs the user is picked on a page
+	resp, err := http.Get(authURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") {
+		t.Errorf("expected the login page, got %d", resp.StatusCode)
+	}
+
+	p.LoginAs("alice")
+	params := authorize(t, authURL)
+	if params.Get("state") != "state-1" || params.Get("code") == "" {
+		t.Fatalf("unexpected redirect parameters %v", params)
+	}
+
+	token, err := config.Exchange(ctx, params.Get("code"))
+	if err != nil {
+		t.Fatalf("exchange failed: %v", err)
+	}
+	raw, _ := token.Extra("id_token").(string)
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: "money-tracker"}).Verify(ctx, raw)
+	if err != nil {
+		t.Fatalf("verification failed: %v", err)
+	}
+	var claims struct {
+		Email         string   `json:"email"`
+		EmailVerified *bool    `json:"email_verified"`
+		Name          string   `json:"name"`
+		Groups        []string `json:"groups"`
+		Locale        string   `json:"locale"`
+		SID           string   `json:"sid"`
+	}
+	idToken.Claims(&claims)
+	if idToken.Subject != "alice" || idToken.Nonce != "nonce-1" || claims.Email != "alice@example.com" || claims.Name != "Alice" {
+		t.Errorf("unexpected token %+v, %+v", idToken, claims)
+	}
+	if claims.EmailVerified == nil || *claims.EmailVerified || len(claims.Groups) != 1 || claims.Locale != "de" || claims.SID == "" {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+
+	if _, err := config.Exchange(ctx, params.Get("code")); err == nil {
+		t.Error("expected codes to work only once")
+	}
+
+	wrongSecret := config
+	wrongSecret.ClientSecret = "wrong"
+	if _, err := wrongSecret.Exchange(ctx, authorize(t, authURL).Get("code")); err == nil {
+		t.Error("expected a wrong client secret to be rejected")
+	}
+}
+
+func TestProvider_Authorize(t *testing.T) {
+	p := start(t, devidp.User{Subject: "alice"})
+	authURL := func(change func(url.Values)) string {
+		q := url.Values{
+			"response_type": {"code"},
+			"client_id":     {"money-tracker"},
+			"redirect_uri":  {redirectURL},
+			"state":         {"state-1"},
+		}
+		if change != nil {
+			change(q)
+		}
+		return p.Issuer() + "/authorize?" + q.Encode()
+	}
+
+	// The user picked on the page
+	form := url.Values{
+		"response_type": {"code"},
+		"client_id":     {"money-tracker"},
+		"redirect_uri":  {redirectURL},
+		"login":         {"alice"},
+	}
+	resp, err := noRedirects.PostForm(p.Issuer()+"/authorize", form)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if loc, _ := url.Parse(resp.Header.Get("Location")); loc == nil || loc.Query().Get("code") == "" {
+		t.Errorf("expected a code for the picked user, got %s", resp.Header.Get("Location"))
+	}
+
+	p.LoginAs("nobody")
+	if params := authorize(t, authURL(nil)); params.Get("error") != "access_denied" {
+		t.Errorf("expected unknown users to be denied, got %v", params)
+	}
+	p.LoginAs("alice")
+	if params := authorize(t, authURL(func(q url.Values) { q.Set("response_type", "token") })); params.Get("error") != "unsupported_response_type" {
+		t.Errorf("expected only the code flow, got %v", params)
+	}
+
+	for name, u := range map[string]string{
+		"unknown client":        authURL(func(q url.Values) { q.Set("client_id", "other") }),
+		"unregistered redirect": authURL(func(q url.Values) { q.Set("redirect_uri", "https://evil.example.com/") }),
+	} {
+		resp, err := noRedirects.Get(u)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: expected 400 without redirect, got %d", name, resp.StatusCode)
+		}
+	}
+}
+
+func TestProvider_Discovery(t *testing.T) {
+	p := start(t)
+	provider, err := oidc.NewProvider(context.Background(), p.Issuer())
+	if err != nil {
+		t.Fatalf("discovery failed: %v", err)
+	}
+	var metadata struct {
+		EndSession string `json:"end_session_endpoint"`
+	}
+	provider.Claims(&metadata)
+	if metadata.EndSession != p.Issuer()+"/logout" {
+		t.Errorf("unexpected end_session_endpoint %q", metadata.EndSession)
+	}
+
+	resp, err := noRedirects.Get(metadata.EndSession + "?post_logout_redirect_uri=" + url.QueryEscape("https://money.example.com/"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.Header.Get("Location") != "https://money.example.com/" {
+		t.Errorf("expected a redirect back after logout, got %q", resp.Header.Get("Location"))
+	}
+}
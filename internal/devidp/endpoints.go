@@ -0,0 +1,157 @@
+package devidp
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// authorizeParams are the parameters of an authorization request that are
+// carried through the page to pick a user.
+var authorizeParams = []string{"response_type", "client_id", "redirect_uri", "scope", "state", "nonce"}
+
+var loginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Development login</title></head>
+<body style="font-family: sans-serif; max-width: 30em; margin: 3em auto;">
+<h1>Development login</h1>
+<p>This provider is for development only. Pick a user to log in as:</p>
+{{range .Users}}
+<form method="POST" style="margin-bottom: 0.5em;">
+    {{range $k, $v := $.Params}}<input type="hidden" name="{{$k}}" value="{{$v}}">{{end}}
+    <input type="hidden" name="login" value="{{.Subject}}">
+    <button type="submit">{{if .Name}}{{.Name}}{{else}}{{.Subject}}{{end}}{{if .Email}} &lt;{{.Email}}&gt;{{end}}</button>
+</form>
+{{else}}
+<p>There are no users.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// handleAuthorize shows the page to pick a user, or logs in as the user
+// picked there or set with LoginAs and sends the code to the client.
+func (p *Provider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	clientID, redirectURI := r.Form.Get("client_id"), r.Form.Get("redirect_uri")
+	if err := p.client(clientID, redirectURI); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	target, err := url.Parse(redirectURI)
+	if err != nil || !target.IsAbs() {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	// From here on errors go back to the client
+	q := target.Query()
+	if state := r.Form.Get("state"); state != "" {
+		q.Set("state", state)
+	}
+	if r.Form.Get("response_type") != "code" {
+		q.Set("error", "unsupported_response_type")
+		target.RawQuery = q.Encode()
+		http.Redirect(w, r, target.String(), http.StatusFound)
+		return
+	}
+
+	p.mu.Lock()
+	subject := p.loginAs
+	p.mu.Unlock()
+	if r.Method == http.MethodPost {
+		subject = r.PostForm.Get("login")
+	}
+	if subject == "" {
+		params := make(map[string]string)
+		for _, k := range authorizeParams {
+			params[k] = r.Form.Get(k)
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		loginPage.Execute(w, map[string]any{"Users": p.Users, "Params": params})
+		return
+	}
+
+	user, ok := p.user(subject)
+	if !ok {
+		q.Set("error", "access_denied")
+		target.RawQuery = q.Encode()
+		http.Redirect(w, r, target.String(), http.StatusFound)
+		return
+	}
+
+	code, err := randomString(16)
+	if err != nil {
+		http.Error(w, "failed to create code", http.StatusInternalServerError)
+		return
+	}
+	p.mu.Lock()
+	p.codes[code] = grant{
+		user:        user,
+		clientID:    clientID,
+		redirectURI: redirectURI,
+		nonce:       r.Form.Get("nonce"),
+		expires:     time.Now().Add(codeTTL),
+	}
+	p.mu.Unlock()
+
+	q.Set("code", code)
+	target.RawQuery = q.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+// handleToken exchanges an authorization code for an ID token. Codes can
+// be used once.
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	clientID, ok := p.authenticate(r)
+	if !ok {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	code := r.PostFormValue("code")
+	p.mu.Lock()
+	g, ok := p.codes[code]
+	delete(p.codes, code)
+	p.mu.Unlock()
+	if !ok || time.Now().After(g.expires) || g.clientID != clientID || g.redirectURI != r.PostFormValue("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	idToken, err := p.idToken(g.user, clientID, g.nonce)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	accessToken, err := randomString(16)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(p.TokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// handleLogout is the end_session_endpoint. The provider keeps no sessions,
+// so it only sends the user on to post_logout_redirect_uri.
+func (p *Provider) handleLogout(w http.ResponseWriter, r *http.Request) {
+	if target := r.URL.Query().Get("post_logout_redirect_uri"); target != "" {
+		http.Redirect(w, r, target, http.StatusFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte("Logged out.\n"))
+}
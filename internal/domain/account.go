@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// PersonalData is everything stored about a user, for the data export.
+type PersonalData struct {
+	User           *User
+	Username       string // local account, empty for OIDC users
+	Identities     []*UserIdentity
+	Households     []*HouseholdData
+	Revisions      []*Revision // made by the user, in any household
+	APITokens      []*APIToken
+	Sessions       []*Session
+	SecurityEvents []*SecurityEvent
+}
+
+// HouseholdData is one owned household with its records, including those in
+// the trash.
+type HouseholdData struct {
+	Household         *Household
+	Categories        []*Category
+	Members           []*HouseholdMember
+	Transactions      []*Transaction
+	RecurringExpenses []*RecurringExpense
+	ScheduleOverrides []*RecurringScheduleOverride
+	Settlements       []*Settlement
+}
+
+// OwnedHouseholds says what happens to the households of a user who deletes
+// their account.
+type OwnedHouseholds string
+
+const (
+	OwnedHouseholdsBlock    OwnedHouseholds = "block"    // refuse while the user owns households
+	OwnedHouseholdsTransfer OwnedHouseholds = "transfer" // offer them to another user
+	OwnedHouseholdsDelete   OwnedHouseholds = "delete"   // delete them with the account
+)
+
+// AccountDeletion describes a request to delete the current user's account.
+type AccountDeletion struct {
+	Confirm    string          // must repeat the account's email
+	Households OwnedHouseholds // OwnedHouseholdsBlock if empty
+	TransferTo string          // email of the new owner for OwnedHouseholdsTransfer
+}
+
+// HouseholdTransfer offers a household to whoever has an account with Email.
+// The household changes owner once they accept.
+type HouseholdTransfer struct {
+	ID            int
+	HouseholdID   int
+	HouseholdName string
+	FromUserID    int
+	FromEmail     string
+	Email         string // lower case
+	CreatedAt     time.Time
+}
+
+func ValidateOwnedHouseholds(h OwnedHouseholds) error {
+	switch h {
+	case OwnedHouseholdsBlock, OwnedHouseholdsTransfer, OwnedHouseholdsDelete:
+		return nil
+	}
+	return NewValidationError("households", fmt.Sprintf("unknown option %q", h))
+}
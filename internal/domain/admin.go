@@ -0,0 +1,17 @@
+package domain
+
+// InstanceStats is an overview of the whole installation for admins.
+type InstanceStats struct {
+	Users             int
+	Admins            int
+	DisabledUsers     int
+	LocalUsers        int
+	InvitedUsers      int
+	Households        int
+	Categories        int
+	Transactions      int
+	RecurringExpenses int
+	APITokens         int
+	Sessions          int
+	DatabaseBytes     int64 // 0 if the database can't tell
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// AggregateVersion is stored with every monthly aggregate. Bump it whenever
+// the way summaries are computed changes; stored aggregates of other versions
+// are treated as stale and recomputed.
+const AggregateVersion = 2
+
+// MonthlyAggregate holds the totals of one household month that multi-month
+// views need. Unlike MonthlySummary it has no per-item details, so it can be
+// persisted and reused until the underlying data changes.
+type MonthlyAggregate struct {
+	HouseholdID    int
+	Month          string // YYYY-MM
+	GrossIncome    Money
+	GrossExpenses  Money
+	RecurringTotal Money
+	OneTimeTotal   Money
+	MonthlyTotal   Money
+	Categories     []CategorySummary
+	ComputedAt     time.Time
+}
+
+// NewMonthlyAggregate extracts the aggregate of a monthly summary.
+func NewMonthlyAggregate(s *MonthlySummary) *MonthlyAggregate {
+	return &MonthlyAggregate{
+		HouseholdID:    s.HouseholdID,
+		Month:          s.Month,
+		GrossIncome:    s.GrossIncome,
+		GrossExpenses:  s.GrossExpenses,
+		RecurringTotal: s.RecurringTotal,
+		OneTimeTotal:   s.OneTimeTotal,
+		MonthlyTotal:   s.MonthlyTotal,
+		Categories:     s.CategoryBreakdown,
+	}
+}
+
+// ApplyAggregate takes the totals and the category breakdown of the month
+// from its aggregate. One-time income and expenses are the part of the gross
+// amounts that the recurring items don't cover.
+func (s *MonthlySummary) ApplyAggregate(a *MonthlyAggregate) {
+	s.GrossIncome = a.GrossIncome
+	s.GrossExpenses = a.GrossExpenses
+	s.RecurringTotal = a.RecurringTotal
+	s.OneTimeTotal = a.OneTimeTotal
+	s.MonthlyTotal = a.MonthlyTotal
+	s.OneTimeIncome = a.GrossIncome.Sub(s.RecurringIncome)
+	s.OneTimeExpenses = a.GrossExpenses.Sub(s.RecurringExpenses)
+	s.TotalIncome = s.OneTimeIncome
+	s.TotalExpenses = s.OneTimeExpenses
+	s.CategoryBreakdown = a.Categories
+}
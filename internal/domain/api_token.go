@@ -3,11 +3,88 @@ package domain
 import "time"
 
 type APIToken struct {
-	ID        int
-	UserID    int
-	Name      string
-	TokenHash string
-	ExpiresAt *time.Time
-	CreatedAt time.Time
-	LastUsed  *time.Time
+	ID         int
+	UserID     int
+	Name       string
+	TokenHash  string
+	Scope      TokenScope
+	ExpiresAt  *time.Time
+	RotatedAt  *time.Time // set once the token was replaced by a new one
+	CreatedAt  time.Time
+	LastUsed   *time.Time
+	LastUsedIP string
+}
+
+// TokenRotationGrace is how long a rotated token stays valid, so clients can
+// switch to its replacement.
+const TokenRotationGrace = time.Hour
+
+// Expired reports whether the token has expired at now.
+func (t *APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && t.ExpiresAt.Before(now)
+}
+
+// ValidateTokenExpiry checks that the expiry chosen for a new token lies in
+// the future. nil means the token never expires.
+func ValidateTokenExpiry(expiresAt *time.Time, now time.Time) error {
+	if expiresAt != nil && !expiresAt.After(now) {
+		return NewValidationError("expires_at", "must be in the future")
+	}
+	return nil
+}
+
+// TokenAccess defines whether an API token may change data.
+type TokenAccess string
+
+const (
+	TokenAccessRead  TokenAccess = "read"
+	TokenAccessWrite TokenAccess = "write"
+)
+
+// TokenScope limits what a request authenticated with an API token may do.
+// The zero value grants full access, like a session.
+type TokenScope struct {
+	Access       TokenAccess // empty means write
+	HouseholdIDs []int       // empty means all households of the user
+}
+
+// CanWrite reports whether the scope allows changing data.
+func (s TokenScope) CanWrite() bool {
+	return s.Access != TokenAccessRead
+}
+
+// AllowsHousehold reports whether the scope covers the household.
+func (s TokenScope) AllowsHousehold(id int) bool {
+	if len(s.HouseholdIDs) == 0 {
+		return true
+	}
+	for _, hid := range s.HouseholdIDs {
+		if hid == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Restricted reports whether the scope grants less than full access.
+func (s TokenScope) Restricted() bool {
+	return !s.CanWrite() || len(s.HouseholdIDs) > 0
+}
+
+// ValidateTokenScope checks the access level and household list of a scope.
+func ValidateTokenScope(s TokenScope) error {
+	if s.Access != TokenAccessRead && s.Access != TokenAccessWrite {
+		return NewValidationError("access", "must be read or write")
+	}
+	seen := make(map[int]bool, len(s.HouseholdIDs))
+	for _, id := range s.HouseholdIDs {
+		if id <= 0 {
+			return NewValidationError("household_ids", "must be valid household IDs")
+		}
+		if seen[id] {
+			return NewValidationError("household_ids", "must not contain duplicates")
+		}
+		seen[id] = true
+	}
+	return nil
 }
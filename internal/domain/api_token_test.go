@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateTokenScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   TokenScope
+		wantErr bool
+	}{
+		{"write", TokenScope{Access: TokenAccessWrite}, false},
+		{"read", TokenScope{Access: TokenAccessRead}, false},
+		{"with households", TokenScope{Access: TokenAccessRead, HouseholdIDs: []int{1, 2}}, false},
+		{"empty access", TokenScope{}, true},
+		{"unknown access", TokenScope{Access: "admin"}, true},
+		{"invalid household", TokenScope{Access: TokenAccessWrite, HouseholdIDs: []int{0}}, true},
+		{"duplicate household", TokenScope{Access: TokenAccessWrite, HouseholdIDs: []int{3, 3}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTokenScope(tt.scope)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTokenScope(%+v) error = %v, wantErr %v", tt.scope, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTokenScope(t *testing.T) {
+	full := TokenScope{}
+	if !full.CanWrite() || !full.AllowsHousehold(7) || full.Restricted() {
+		t.Error("zero scope should grant full access")
+	}
+
+	read := TokenScope{Access: TokenAccessRead}
+	if read.CanWrite() {
+		t.Error("read scope should not allow writes")
+	}
+	if !read.Restricted() {
+		t.Error("read scope should be restricted")
+	}
+
+	limited := TokenScope{Access: TokenAccessWrite, HouseholdIDs: []int{1, 2}}
+	if !limited.AllowsHousehold(2) || limited.AllowsHousehold(3) {
+		t.Error("limited scope should only allow listed households")
+	}
+	if !limited.Restricted() {
+		t.Error("limited scope should be restricted")
+	}
+}
+
+func TestValidateTokenExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	if err := ValidateTokenExpiry(nil, now); err != nil {
+		t.Errorf("nil expiry: unexpected error: %v", err)
+	}
+	if err := ValidateTokenExpiry(&future, now); err != nil {
+		t.Errorf("future expiry: unexpected error: %v", err)
+	}
+	if err := ValidateTokenExpiry(&past, now); !errors.Is(err, ErrValidation) {
+		t.Errorf("past expiry: expected ErrValidation, got %v", err)
+	}
+	if err := ValidateTokenExpiry(&now, now); !errors.Is(err, ErrValidation) {
+		t.Errorf("expiry now: expected ErrValidation, got %v", err)
+	}
+}
+
+func TestAPITokenExpired(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	if (&APIToken{}).Expired(now) {
+		t.Error("token without expiry should not expire")
+	}
+	if !(&APIToken{ExpiresAt: &past}).Expired(now) {
+		t.Error("expected token to be expired")
+	}
+	if (&APIToken{ExpiresAt: &future}).Expired(now) {
+		t.Error("expected token to be valid")
+	}
+}
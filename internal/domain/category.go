@@ -7,6 +7,7 @@ type Category struct {
 	HouseholdID int
 	Name        string
 	Icon        string
+	TaxClass    TaxClass // default for its transactions, empty if not tax relevant
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -0,0 +1,50 @@
+package domain
+
+type ComparisonStatus string
+
+const (
+	ComparisonCommon   ComparisonStatus = "common"   // present in both periods
+	ComparisonNew      ComparisonStatus = "new"      // only in the current period
+	ComparisonVanished ComparisonStatus = "vanished" // only in the base period
+)
+
+// AmountDelta compares one amount between two periods. Percent is the change
+// relative to the base amount (Delta / Base * 100), so growing expenses and
+// growing income both yield a positive percentage. It is nil when the base is zero.
+type AmountDelta struct {
+	Base    Money
+	Current Money
+	Delta   Money
+	Percent *Money
+}
+
+type CategoryComparison struct {
+	CategoryID   int
+	CategoryName string
+	Status       ComparisonStatus
+	Recurring    AmountDelta
+	OneTime      AmountDelta
+	Total        AmountDelta
+}
+
+type PeriodComparison struct {
+	HouseholdID        int
+	Base               string // period label, e.g. "2024", "2024-Q1" or "2024-03"
+	Current            string
+	Income             AmountDelta
+	Expenses           AmountDelta
+	Total              AmountDelta
+	Categories         []CategoryComparison // present in both periods
+	NewCategories      []CategoryComparison
+	VanishedCategories []CategoryComparison
+}
+
+// NewAmountDelta computes the difference between base and current.
+func NewAmountDelta(base, current Money) AmountDelta {
+	d := AmountDelta{Base: base, Current: current, Delta: current.Sub(base)}
+	if !base.IsZero() {
+		p := d.Delta.Div(base).Shift(2).Round(1)
+		d.Percent = &p
+	}
+	return d
+}
@@ -11,4 +11,5 @@ type Household struct {
 	OwnerID   int
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time // nil unless the household is in the trash
 }
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// UserIdentity links a user to their account at an OIDC provider. A user can
+// have one at each provider; issuer and subject together are unique.
+type UserIdentity struct {
+	ID        int
+	UserID    int
+	Issuer    string
+	Subject   string
+	Email     string // as sent by the provider when the identity was linked
+	CreatedAt time.Time
+}
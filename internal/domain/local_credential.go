@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrInvalidCredentials is returned when a username or password doesn't
+// match. It doesn't tell which of the two was wrong.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// LocalCredential is the login of a user with a local account.
+type LocalCredential struct {
+	UserID       int
+	Username     string
+	PasswordHash string
+	UpdatedAt    time.Time
+}
+
+const (
+	MinPasswordLength = 10
+	MaxPasswordLength = 256
+)
+
+var usernameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,62}[a-z0-9]$`)
+
+// ValidateUsername accepts 3 to 64 lowercase letters, digits, dots,
+// underscores and hyphens, starting and ending with a letter or digit.
+func ValidateUsername(username string) error {
+	if !usernameRegex.MatchString(username) {
+		return NewValidationError("username", "must be 3-64 lowercase letters, digits, '.', '_' or '-'")
+	}
+	return nil
+}
+
+func ValidatePassword(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < MinPasswordLength {
+		return NewValidationError("password", "must be at least 10 characters")
+	}
+	if n > MaxPasswordLength {
+		return NewValidationError("password", "must be at most 256 characters")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateUsername(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		wantErr  bool
+	}{
+		{"simple", "alice", false},
+		{"with separators", "alice.b_c-d", false},
+		{"digits", "a42", false},
+		{"max length", strings.Repeat("a", 64), false},
+		{"too short", "ab", true},
+		{"too long", strings.Repeat("a", 65), true},
+		{"uppercase", "Alice", true},
+		{"leading dot", ".alice", true},
+		{"trailing hyphen", "alice-", true},
+		{"space", "al ice", true},
+		{"empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUsername(tt.username)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUsername(%q) error = %v, wantErr %v", tt.username, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{"min length", strings.Repeat("x", MinPasswordLength), false},
+		{"max length", strings.Repeat("x", MaxPasswordLength), false},
+		{"multibyte counts runes", strings.Repeat("ü", MinPasswordLength), false},
+		{"too short", strings.Repeat("x", MinPasswordLength-1), true},
+		{"too long", strings.Repeat("x", MaxPasswordLength+1), true},
+		{"empty", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePassword(tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePassword(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// HouseholdMember is a person sharing the costs of a household, such as a
+// flatmate. Members don't need a user account.
+type HouseholdMember struct {
+	ID          int
+	HouseholdID int
+	Name        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ValidateMemberName checks the name of a household member.
+func ValidateMemberName(name string) error {
+	if len(name) == 0 || len(name) > 50 {
+		return NewValidationError("name", "must be 1-50 characters")
+	}
+	return nil
+}
@@ -13,8 +13,17 @@ func NewMoney(value string) (Money, error) {
 	return decimal.NewFromString(value)
 }
 
+// MoneyDecimals is the number of decimal places amounts are stored with.
+const MoneyDecimals = 2
+
+// MoneyFromInt converts an amount in minor units (cents) to Money.
 func MoneyFromInt(cents int64) Money {
-	return decimal.New(cents, -2)
+	return decimal.New(cents, -MoneyDecimals)
+}
+
+// MinorUnits converts m to minor units (cents), rounding to MoneyDecimals.
+func MinorUnits(m Money) int64 {
+	return m.Round(MoneyDecimals).Shift(MoneyDecimals).IntPart()
 }
 
 func ZeroMoney() Money {
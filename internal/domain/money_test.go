@@ -61,6 +61,31 @@ func TestMoneyFromInt(t *testing.T) {
 	}
 }
 
+func TestMinorUnits(t *testing.T) {
+	tests := []struct {
+		amount string
+		want   int64
+	}{
+		{"1", 100},
+		{"-12.34", -1234},
+		{"0.1", 10},
+		{"999999999.99", 99999999999},
+		{"0.005", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			m, _ := NewMoney(tt.amount)
+			if got := MinorUnits(m); got != tt.want {
+				t.Errorf("MinorUnits(%s) = %d, want %d", tt.amount, got, tt.want)
+			}
+			if got := MoneyFromInt(MinorUnits(m)); !got.Equal(m.Round(MoneyDecimals)) {
+				t.Errorf("round trip of %s = %s", tt.amount, got)
+			}
+		})
+	}
+}
+
 func TestZeroMoney(t *testing.T) {
 	z := ZeroMoney()
 	if !z.IsZero() {
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type PeriodKind string
+
+const (
+	PeriodMonth   PeriodKind = "month"
+	PeriodQuarter PeriodKind = "quarter"
+	PeriodYear    PeriodKind = "year"
+)
+
+// Period is a calendar month, quarter or year.
+type Period struct {
+	Kind  PeriodKind
+	Start time.Time // first day of the period (UTC)
+}
+
+// ParsePeriod parses "2025-03" (month), "2025-Q1" (quarter) or "2025" (year).
+func ParsePeriod(s string) (Period, error) {
+	s = strings.TrimSpace(s)
+	invalid := fmt.Errorf("%w: invalid period %q, expected YYYY, YYYY-Qn or YYYY-MM", ErrValidation, s)
+
+	switch {
+	case len(s) == 4:
+		year, err := strconv.Atoi(s)
+		if err != nil {
+			return Period{}, invalid
+		}
+		return Period{Kind: PeriodYear, Start: time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)}, nil
+	case len(s) == 7 && (s[5] == 'Q' || s[5] == 'q'):
+		year, err := strconv.Atoi(s[:4])
+		if err != nil || s[4] != '-' {
+			return Period{}, invalid
+		}
+		q, err := strconv.Atoi(s[6:])
+		if err != nil || q < 1 || q > 4 {
+			return Period{}, invalid
+		}
+		return Period{Kind: PeriodQuarter, Start: time.Date(year, time.Month((q-1)*3+1), 1, 0, 0, 0, 0, time.UTC)}, nil
+	default:
+		t, err := time.Parse("2006-01", s)
+		if err != nil {
+			return Period{}, invalid
+		}
+		return Period{Kind: PeriodMonth, Start: t}, nil
+	}
+}
+
+// Months returns the number of months covered by the period.
+func (p Period) Months() int {
+	switch p.Kind {
+	case PeriodYear:
+		return 12
+	case PeriodQuarter:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// End returns the first day after the period.
+func (p Period) End() time.Time {
+	return p.Start.AddDate(0, p.Months(), 0)
+}
+
+// LastMonth returns the first day of the last month in the period.
+func (p Period) LastMonth() time.Time {
+	return p.Start.AddDate(0, p.Months()-1, 0)
+}
+
+// MonthStarts returns the first day of every month in the period.
+func (p Period) MonthStarts() []time.Time {
+	months := make([]time.Time, p.Months())
+	for i := range months {
+		months[i] = p.Start.AddDate(0, i, 0)
+	}
+	return months
+}
+
+// PreviousYear returns the same period one year earlier.
+func (p Period) PreviousYear() Period {
+	return Period{Kind: p.Kind, Start: p.Start.AddDate(-1, 0, 0)}
+}
+
+func (p Period) String() string {
+	switch p.Kind {
+	case PeriodYear:
+		return p.Start.Format("2006")
+	case PeriodQuarter:
+		return fmt.Sprintf("%d-Q%d", p.Start.Year(), (int(p.Start.Month())-1)/3+1)
+	default:
+		return p.Start.Format("2006-01")
+	}
+}
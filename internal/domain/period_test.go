@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		input  string
+		kind   PeriodKind
+		start  time.Time
+		months int
+		label  string
+	}{
+		{"2025", PeriodYear, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 12, "2025"},
+		{"2025-Q1", PeriodQuarter, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 3, "2025-Q1"},
+		{"2025-q4", PeriodQuarter, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), 3, "2025-Q4"},
+		{"2025-03", PeriodMonth, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), 1, "2025-03"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			p, err := ParsePeriod(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.Kind != tt.kind {
+				t.Errorf("Kind = %q, want %q", p.Kind, tt.kind)
+			}
+			if !p.Start.Equal(tt.start) {
+				t.Errorf("Start = %v, want %v", p.Start, tt.start)
+			}
+			if p.Months() != tt.months {
+				t.Errorf("Months() = %d, want %d", p.Months(), tt.months)
+			}
+			if !p.End().Equal(tt.start.AddDate(0, tt.months, 0)) {
+				t.Errorf("End() = %v", p.End())
+			}
+			if p.String() != tt.label {
+				t.Errorf("String() = %q, want %q", p.String(), tt.label)
+			}
+		})
+	}
+}
+
+func TestParsePeriodInvalid(t *testing.T) {
+	for _, input := range []string{"", "25", "2025-Q5", "2025-Q0", "2025-13", "2025/03", "abcd"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParsePeriod(input); !errors.Is(err, ErrValidation) {
+				t.Errorf("ParsePeriod(%q) error = %v, want ErrValidation", input, err)
+			}
+		})
+	}
+}
+
+func TestPeriodPreviousYear(t *testing.T) {
+	p, _ := ParsePeriod("2025-Q2")
+	if got := p.PreviousYear().String(); got != "2024-Q2" {
+		t.Errorf("PreviousYear() = %q, want %q", got, "2024-Q2")
+	}
+}
+
+func TestNewAmountDelta(t *testing.T) {
+	base, _ := NewMoney("-100")
+	current, _ := NewMoney("-120")
+	d := NewAmountDelta(base, current)
+	if d.Delta.String() != "-20" {
+		t.Errorf("Delta = %s, want -20", d.Delta.String())
+	}
+	if d.Percent == nil || d.Percent.String() != "20" {
+		t.Errorf("Percent = %v, want 20", d.Percent)
+	}
+
+	zero, _ := NewMoney("0")
+	if d := NewAmountDelta(zero, current); d.Percent != nil {
+		t.Errorf("Percent = %v, want nil for zero base", d.Percent)
+	}
+}
+
+func TestPeriodMonthStarts(t *testing.T) {
+	p, _ := ParsePeriod("2025-Q4")
+	months := p.MonthStarts()
+	want := []string{"2025-10", "2025-11", "2025-12"}
+	if len(months) != len(want) {
+		t.Fatalf("len(MonthStarts()) = %d, want %d", len(months), len(want))
+	}
+	for i, m := range months {
+		if got := m.Format("2006-01"); got != want[i] {
+			t.Errorf("MonthStarts()[%d] = %s, want %s", i, got, want[i])
+		}
+	}
+}
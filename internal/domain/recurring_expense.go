@@ -6,19 +6,22 @@ import (
 )
 
 type RecurringExpense struct {
-	ID          int
-	HouseholdID int
-	CategoryID  int
-	Name        string
-	Description string
-	Details     string
-	Amount      Money
-	Frequency   Frequency
-	Active      bool
-	StartDate   time.Time
-	EndDate     *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID            int
+	HouseholdID   int
+	CategoryID    int
+	Name          string
+	Description   string
+	Details       string
+	Amount        Money
+	Frequency     Frequency
+	Active        bool
+	StartDate     time.Time
+	EndDate       *time.Time
+	DeactivatedAt *time.Time // when it was deactivated, nil while active
+	Split         *Split     // nil if not shared between members
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     *time.Time // nil unless the expense is in the trash
 }
 
 type RecurringScheduleOverride struct {
@@ -46,6 +49,120 @@ func (re *RecurringExpense) IsActiveInMonth(year int, month time.Month) bool {
 	return true
 }
 
+// ActiveUntil returns the last day the expense ran: its end date, or the day
+// it was deactivated if that is earlier. An inactive expense without a
+// deactivation date stopped at its last update. It returns nil if the
+// expense runs on.
+func (re *RecurringExpense) ActiveUntil() *time.Time {
+	until := re.EndDate
+	if !re.Active {
+		stopped := re.UpdatedAt
+		if re.DeactivatedAt != nil {
+			stopped = *re.DeactivatedAt
+		}
+		day := time.Date(stopped.Year(), stopped.Month(), stopped.Day(), 0, 0, 0, 0, time.UTC)
+		if until == nil || day.Before(*until) {
+			until = &day
+		}
+	}
+	return until
+}
+
+// Payment is a payment of a recurring expense on the day it is due.
+type Payment struct {
+	Date      time.Time
+	Amount    Money
+	Frequency Frequency
+}
+
+// Payments returns the payments of a recurring expense due on days with
+// from <= day < to, up to the day returned by ActiveUntil. Payments fall on
+// the start date and repeat at its frequency; monthly, quarterly and yearly
+// payments move to the last day of shorter months. A schedule override
+// applies from its effective date on, and one that changes the frequency
+// starts the new schedule at that date.
+func Payments(re *RecurringExpense, overrides []*RecurringScheduleOverride, from, to time.Time) ([]Payment, error) {
+	type segment struct {
+		start  time.Time
+		anchor time.Time
+		amount Money
+		freq   Frequency
+	}
+	segments := []segment{{start: re.StartDate, anchor: re.StartDate, amount: re.Amount, freq: re.Frequency}}
+
+	sorted := make([]*RecurringScheduleOverride, len(overrides))
+	copy(sorted, overrides)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveDate.Before(sorted[j].EffectiveDate)
+	})
+	for _, o := range sorted {
+		prev := &segments[len(segments)-1]
+		if !o.EffectiveDate.After(prev.start) {
+			// Takes effect before anything was due under the previous schedule
+			prev.amount, prev.freq = o.Amount, o.Frequency
+			continue
+		}
+		anchor := o.EffectiveDate
+		if o.Frequency == prev.freq {
+			anchor = prev.anchor
+		}
+		segments = append(segments, segment{start: o.EffectiveDate, anchor: anchor, amount: o.Amount, freq: o.Frequency})
+	}
+
+	until := re.ActiveUntil()
+	var payments []Payment
+	for i, seg := range segments {
+		if err := seg.freq.Validate(); err != nil {
+			return nil, err
+		}
+		end := to
+		if i+1 < len(segments) && segments[i+1].start.Before(end) {
+			end = segments[i+1].start
+		}
+		for n := 0; ; n++ {
+			day := dueDate(seg.anchor, seg.freq, n)
+			if !day.Before(end) || (until != nil && day.After(*until)) {
+				break
+			}
+			if day.Before(from) || day.Before(seg.start) {
+				continue
+			}
+			if seg.freq == FrequencyWeekday && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+				continue
+			}
+			payments = append(payments, Payment{Date: day, Amount: seg.amount, Frequency: seg.freq})
+		}
+	}
+	return payments, nil
+}
+
+// dueDate returns the n-th due date of a schedule starting at anchor.
+// Weekday schedules return every day; the caller skips weekends.
+func dueDate(anchor time.Time, freq Frequency, n int) time.Time {
+	switch freq {
+	case FrequencyWeekly:
+		return anchor.AddDate(0, 0, 7*n)
+	case FrequencyBiweekly:
+		return anchor.AddDate(0, 0, 14*n)
+	case FrequencyMonthly:
+		return addMonthsClamped(anchor, n)
+	case FrequencyQuarterly:
+		return addMonthsClamped(anchor, 3*n)
+	case FrequencyYearly:
+		return addMonthsClamped(anchor, 12*n)
+	default:
+		return anchor.AddDate(0, 0, n)
+	}
+}
+
+// addMonthsClamped adds months to t, keeping the day of the month but not
+// going past the end of the resulting month.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := min(t.Day(), daysInMonth(first))
+	return first.AddDate(0, 0, day-1)
+}
+
 // EffectiveSchedule returns the amount and frequency in effect for a given month,
 // considering any schedule overrides. Overrides are sorted by effective_date ascending.
 // The latest override where effective_date <= last day of queried month is used.
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -251,3 +252,93 @@ func TestEffectiveSchedule(t *testing.T) {
 		}
 	})
 }
+
+func TestPayments(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	amount, _ := NewMoney("-100.00")
+	year := func(re *RecurringExpense, overrides ...*RecurringScheduleOverride) []Payment {
+		t.Helper()
+		payments, err := Payments(re, overrides, date(2025, 1, 1), date(2026, 1, 1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return payments
+	}
+	dates := func(payments []Payment) []string {
+		var result []string
+		for _, p := range payments {
+			result = append(result, p.Date.Format("2006-01-02"))
+		}
+		return result
+	}
+
+	t.Run("monthly at the end of the month", func(t *testing.T) {
+		re := &RecurringExpense{Amount: amount, Frequency: FrequencyMonthly, Active: true, StartDate: date(2024, 10, 31)}
+		got := dates(year(re))
+		if len(got) != 12 || got[0] != "2025-01-31" || got[1] != "2025-02-28" || got[2] != "2025-03-31" {
+			t.Errorf("payments = %v", got)
+		}
+	})
+
+	t.Run("quarterly starting mid-year", func(t *testing.T) {
+		re := &RecurringExpense{Amount: amount, Frequency: FrequencyQuarterly, Active: true, StartDate: date(2025, 5, 15)}
+		got := dates(year(re))
+		if len(got) != 3 || got[0] != "2025-05-15" || got[2] != "2025-11-15" {
+			t.Errorf("payments = %v", got)
+		}
+	})
+
+	t.Run("weekdays", func(t *testing.T) {
+		end := date(2025, 1, 12)
+		re := &RecurringExpense{Amount: amount, Frequency: FrequencyWeekday, Active: true, StartDate: date(2025, 1, 4), EndDate: &end}
+		got := dates(year(re))
+		if len(got) != 5 || got[0] != "2025-01-06" || got[4] != "2025-01-10" {
+			t.Errorf("payments = %v", got)
+		}
+	})
+
+	t.Run("deactivated", func(t *testing.T) {
+		deactivated := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+		re := &RecurringExpense{Amount: amount, Frequency: FrequencyMonthly, StartDate: date(2025, 1, 10), DeactivatedAt: &deactivated}
+		if got := dates(year(re)); len(got) != 3 || got[2] != "2025-03-10" {
+			t.Errorf("payments = %v", got)
+		}
+		re.Active = true
+		if got := year(re); len(got) != 12 {
+			t.Errorf("expected active expenses to ignore a stale deactivation, got %d payments", len(got))
+		}
+	})
+
+	t.Run("deactivated without a date", func(t *testing.T) {
+		re := &RecurringExpense{Amount: amount, Frequency: FrequencyMonthly, StartDate: date(2025, 1, 10), UpdatedAt: time.Date(2025, 5, 20, 8, 0, 0, 0, time.UTC)}
+		if got := dates(year(re)); len(got) != 5 || got[4] != "2025-05-10" {
+			t.Errorf("expected the payments to stop at the last update, got %v", got)
+		}
+		if until := re.ActiveUntil(); until == nil || !until.Equal(date(2025, 5, 20)) {
+			t.Errorf("ActiveUntil() = %v, want 2025-05-20", until)
+		}
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		higher, _ := NewMoney("-120.00")
+		re := &RecurringExpense{Amount: amount, Frequency: FrequencyMonthly, Active: true, StartDate: date(2025, 1, 15)}
+		got := year(re,
+			&RecurringScheduleOverride{EffectiveDate: date(2025, 4, 1), Amount: higher, Frequency: FrequencyMonthly},
+			&RecurringScheduleOverride{EffectiveDate: date(2025, 10, 1), Amount: higher, Frequency: FrequencyYearly},
+		)
+		want := []string{"2025-01-15", "2025-02-15", "2025-03-15", "2025-04-15", "2025-05-15", "2025-06-15", "2025-07-15", "2025-08-15", "2025-09-15", "2025-10-01"}
+		if d := dates(got); len(d) != len(want) || d[3] != want[3] || d[9] != want[9] {
+			t.Fatalf("payments = %v, want %v", d, want)
+		}
+		if !got[2].Amount.Equal(amount) || !got[3].Amount.Equal(higher) || got[9].Frequency != FrequencyYearly {
+			t.Errorf("unexpected amounts %+v", got)
+		}
+	})
+
+	t.Run("invalid frequency", func(t *testing.T) {
+		re := &RecurringExpense{Amount: amount, Frequency: "hourly", Active: true, StartDate: date(2025, 1, 1)}
+		if _, err := Payments(re, nil, date(2025, 1, 1), date(2026, 1, 1)); !errors.Is(err, ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
@@ -9,14 +9,46 @@ type UserRepo interface {
 	Create(ctx context.Context, user *User) (*User, error)
 	GetByID(ctx context.Context, id int) (*User, error)
 	GetBySubject(ctx context.Context, subject string) (*User, error)
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	List(ctx context.Context) ([]*User, error)
 	Update(ctx context.Context, user *User) (*User, error)
+	// Delete removes the user with its login, API tokens and the household
+	// transfers they offered. Households must be deleted first.
+	Delete(ctx context.Context, id int) error
+}
+
+type LocalCredentialRepo interface {
+	// Create stores the user together with the credential.
+	Create(ctx context.Context, user *User, cred *LocalCredential) (*User, error)
+	GetByUsername(ctx context.Context, username string) (*LocalCredential, error)
+	GetByUserID(ctx context.Context, userID int) (*LocalCredential, error)
+	UpdatePasswordHash(ctx context.Context, userID int, hash string) error
 }
 
 type HouseholdRepo interface {
 	Create(ctx context.Context, household *Household) (*Household, error)
 	GetByID(ctx context.Context, id int) (*Household, error)
 	ListByOwner(ctx context.Context, ownerID int) ([]*Household, error)
+	ListAll(ctx context.Context) ([]*Household, error)
 	Update(ctx context.Context, household *Household) (*Household, error)
+	SetOwner(ctx context.Context, id, ownerID int) error
+	// Delete moves the household to the trash; Purge deletes it for good.
+	Delete(ctx context.Context, id int) error
+	GetDeletedByID(ctx context.Context, id int) (*Household, error)
+	ListDeletedByOwner(ctx context.Context, ownerID int) ([]*Household, error)
+	Restore(ctx context.Context, id int) error
+	Purge(ctx context.Context, id int) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// HouseholdTransferRepo stores household transfer offers. Offers for
+// households in the trash are left out until the household is restored.
+type HouseholdTransferRepo interface {
+	// Create offers the household, replacing an earlier offer for it.
+	Create(ctx context.Context, transfer *HouseholdTransfer) (*HouseholdTransfer, error)
+	GetByID(ctx context.Context, id int) (*HouseholdTransfer, error)
+	ListByEmail(ctx context.Context, email string) ([]*HouseholdTransfer, error)
+	ListByUser(ctx context.Context, userID int) ([]*HouseholdTransfer, error)
 	Delete(ctx context.Context, id int) error
 }
 
@@ -24,6 +56,7 @@ type CategoryRepo interface {
 	Create(ctx context.Context, category *Category) (*Category, error)
 	GetByID(ctx context.Context, id int) (*Category, error)
 	ListByHousehold(ctx context.Context, householdID int) ([]*Category, error)
+	ListByHouseholds(ctx context.Context, householdIDs []int) ([]*Category, error)
 	Update(ctx context.Context, category *Category) (*Category, error)
 	Delete(ctx context.Context, id int) error
 }
@@ -31,9 +64,18 @@ type CategoryRepo interface {
 type TransactionRepo interface {
 	Create(ctx context.Context, tx *Transaction) (*Transaction, error)
 	GetByID(ctx context.Context, id int) (*Transaction, error)
-	ListByHouseholdAndMonth(ctx context.Context, householdID int, year int, month time.Month) ([]*Transaction, error)
+	ListByHouseholdAndMonth(ctx context.Context, householdID int, year int, month time.Month, filter AmountFilter) ([]*Transaction, error)
+	ListByHouseholdAndDateRange(ctx context.Context, householdID int, from, to time.Time) ([]*Transaction, error)
+	SumByMonthAndCategory(ctx context.Context, householdIDs []int, from, to time.Time) ([]*TransactionSum, error)
+	ListSplitByHousehold(ctx context.Context, householdID int) ([]*Transaction, error)
+	ListByHousehold(ctx context.Context, householdID int) ([]*Transaction, error)
 	Update(ctx context.Context, tx *Transaction) (*Transaction, error)
+	// Delete moves the transaction to the trash.
 	Delete(ctx context.Context, id int) error
+	GetDeletedByID(ctx context.Context, id int) (*Transaction, error)
+	ListDeletedByHousehold(ctx context.Context, householdID int) ([]*Transaction, error)
+	Restore(ctx context.Context, id int) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
 }
 
 type RecurringExpenseRepo interface {
@@ -41,22 +83,113 @@ type RecurringExpenseRepo interface {
 	GetByID(ctx context.Context, id int) (*RecurringExpense, error)
 	ListByHousehold(ctx context.Context, householdID int) ([]*RecurringExpense, error)
 	ListActiveByHousehold(ctx context.Context, householdID int) ([]*RecurringExpense, error)
+	ListActiveByHouseholds(ctx context.Context, householdIDs []int) ([]*RecurringExpense, error)
+	// ListSplitByHousehold also returns inactive expenses and those in the trash.
+	ListSplitByHousehold(ctx context.Context, householdID int) ([]*RecurringExpense, error)
 	Update(ctx context.Context, expense *RecurringExpense) (*RecurringExpense, error)
+	// Delete moves the recurring expense to the trash.
 	Delete(ctx context.Context, id int) error
+	GetDeletedByID(ctx context.Context, id int) (*RecurringExpense, error)
+	ListDeletedByHousehold(ctx context.Context, householdID int) ([]*RecurringExpense, error)
+	Restore(ctx context.Context, id int) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
 }
 
 type RecurringScheduleOverrideRepo interface {
 	Create(ctx context.Context, override *RecurringScheduleOverride) (*RecurringScheduleOverride, error)
 	GetByID(ctx context.Context, id int) (*RecurringScheduleOverride, error)
 	ListByRecurringExpense(ctx context.Context, recurringExpenseID int) ([]*RecurringScheduleOverride, error)
+	ListByRecurringExpenses(ctx context.Context, recurringExpenseIDs []int) ([]*RecurringScheduleOverride, error)
 	Update(ctx context.Context, override *RecurringScheduleOverride) (*RecurringScheduleOverride, error)
 	Delete(ctx context.Context, id int) error
 }
 
+type HouseholdMemberRepo interface {
+	Create(ctx context.Context, member *HouseholdMember) (*HouseholdMember, error)
+	GetByID(ctx context.Context, id int) (*HouseholdMember, error)
+	ListByHousehold(ctx context.Context, householdID int) ([]*HouseholdMember, error)
+	Update(ctx context.Context, member *HouseholdMember) (*HouseholdMember, error)
+	Delete(ctx context.Context, id int) error
+	IsReferenced(ctx context.Context, id int) (bool, error)
+}
+
+type SettlementRepo interface {
+	Create(ctx context.Context, settlement *Settlement) (*Settlement, error)
+	GetByID(ctx context.Context, id int) (*Settlement, error)
+	ListByHousehold(ctx context.Context, householdID int) ([]*Settlement, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type MonthlyAggregateRepo interface {
+	// Generation changes whenever aggregates of the household are invalidated.
+	Generation(ctx context.Context, householdID int) (int, error)
+	ListByHouseholdAndMonths(ctx context.Context, householdID int, months []string) ([]*MonthlyAggregate, error)
+	// Save stores aggregates of the household unless its generation changed
+	// since it was read, and reports whether it did.
+	Save(ctx context.Context, householdID, generation int, aggregates []*MonthlyAggregate) (bool, error)
+	DeleteAll(ctx context.Context) (int, error)
+}
+
 type APITokenRepo interface {
 	Create(ctx context.Context, token *APIToken) (*APIToken, error)
 	GetByHash(ctx context.Context, hash string) (*APIToken, error)
 	ListByUser(ctx context.Context, userID int) ([]*APIToken, error)
-	UpdateLastUsed(ctx context.Context, id int, t time.Time) error
+	UpdateLastUsed(ctx context.Context, id int, t time.Time, ip string) error
+	// MarkRotated returns false if the token was already rotated.
+	MarkRotated(ctx context.Context, id int, rotatedAt time.Time, expiresAt *time.Time) (bool, error)
 	Delete(ctx context.Context, id int) error
+	DeleteByUser(ctx context.Context, userID int) (int, error)
+}
+
+// SecurityEventRepo is append-only: events can't be changed or deleted.
+type SecurityEventRepo interface {
+	Create(ctx context.Context, event *SecurityEvent) (*SecurityEvent, error)
+	// List returns up to limit events, newest first, of one user or of
+	// everyone if userID is nil.
+	List(ctx context.Context, userID *int, limit int) ([]*SecurityEvent, error)
+	// Each calls fn for every event of one user, or of everyone if userID is
+	// nil, in the order they were recorded.
+	Each(ctx context.Context, userID *int, fn func(*SecurityEvent) error) error
+}
+
+// RevisionRepo doesn't create revisions: they are written by hooks on the
+// repositories of the entities they describe.
+type RevisionRepo interface {
+	// List returns the revisions of one record of a household, newest first.
+	List(ctx context.Context, householdID int, entityType EntityType, entityID int) ([]*Revision, error)
+	// ListByUser returns the revisions the user made, oldest first.
+	ListByUser(ctx context.Context, userID int) ([]*Revision, error)
+	// ClearUser removes the user as the author of their revisions.
+	ClearUser(ctx context.Context, userID int) error
+}
+
+// Transactor runs fn in a database transaction. The household, household
+// transfer, user, API token, session, security event and revision
+// repositories and all purges take part in it when called with the context
+// passed to fn.
+type Transactor interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type UserIdentityRepo interface {
+	Create(ctx context.Context, identity *UserIdentity) (*UserIdentity, error)
+	CreateWithUser(ctx context.Context, user *User, identity *UserIdentity) (*User, error)
+	Claim(ctx context.Context, identity *UserIdentity, subject string) error
+	GetByIssuerSubject(ctx context.Context, issuer, subject string) (*UserIdentity, error)
+	ListByUser(ctx context.Context, userID int) ([]*UserIdentity, error)
+	Delete(ctx context.Context, userID, id int) error
+}
+
+type SessionRepo interface {
+	ListByUser(ctx context.Context, userID int) ([]*Session, error)
+	Delete(ctx context.Context, userID, id int) error
+	DeleteByUser(ctx context.Context, userID int) (int, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+	// DeleteByOIDC deletes the sessions of an OIDC login and returns the
+	// users they belonged to, one entry per session.
+	DeleteByOIDC(ctx context.Context, issuer, subject, sid string) ([]int, error)
+}
+
+type StatsRepo interface {
+	Stats(ctx context.Context) (*InstanceStats, error)
 }
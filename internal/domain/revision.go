@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// EntityType names the kinds of records whose changes are kept as revisions.
+type EntityType string
+
+const (
+	EntityHousehold        EntityType = "household"
+	EntityCategory         EntityType = "category"
+	EntityTransaction      EntityType = "transaction"
+	EntityRecurringExpense EntityType = "recurring_expense"
+	EntityScheduleOverride EntityType = "schedule_override"
+)
+
+func ValidateEntityType(t EntityType) error {
+	switch t {
+	case EntityHousehold, EntityCategory, EntityTransaction, EntityRecurringExpense, EntityScheduleOverride:
+		return nil
+	}
+	return NewValidationError("entity_type", fmt.Sprintf("unknown entity type %q", t))
+}
+
+// RevisionAction is the kind of change a Revision records.
+type RevisionAction string
+
+const (
+	RevisionCreate  RevisionAction = "create"
+	RevisionUpdate  RevisionAction = "update"
+	RevisionDelete  RevisionAction = "delete"  // deleted or moved to the trash
+	RevisionRestore RevisionAction = "restore" // restored from the trash
+)
+
+// Revision is one change of a record. Before is empty for creations and
+// restores, After for deletions. Values are formatted for display: amounts
+// as decimals, dates as YYYY-MM-DD and references by name where there is
+// one.
+type Revision struct {
+	ID          int
+	EntityType  EntityType
+	EntityID    int
+	HouseholdID int
+	Action      RevisionAction
+	UserID      *int   // nil for changes made outside of requests, for example on the CLI
+	UserName    string // empty if the user is unknown or deleted
+	Before      map[string]string
+	After       map[string]string
+	CreatedAt   time.Time
+}
+
+// RevisionChange is one field of a Revision that differs between before
+// and after.
+type RevisionChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// Changes returns the fields that differ between before and after, sorted by
+// name. Creations and deletions list every field that has a value.
+func (r *Revision) Changes() []RevisionChange {
+	var changes []RevisionChange
+	for field, before := range r.Before {
+		if after := r.After[field]; after != before {
+			changes = append(changes, RevisionChange{Field: field, Before: before, After: after})
+		}
+	}
+	for field, after := range r.After {
+		if _, ok := r.Before[field]; !ok && after != "" {
+			changes = append(changes, RevisionChange{Field: field, After: after})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+type actorKey struct{}
+
+// WithActor records which user makes the changes done with ctx. Revisions
+// take their author from it.
+func WithActor(ctx context.Context, userID int) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+func ActorFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(actorKey{}).(int)
+	return id, ok
+}
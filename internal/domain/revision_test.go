@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRevisionChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		rev  Revision
+		want []RevisionChange
+	}{
+		{
+			"create lists set fields",
+			Revision{After: map[string]string{"name": "Rent", "details": ""}},
+			[]RevisionChange{{Field: "name", After: "Rent"}},
+		},
+		{
+			"update lists changed fields",
+			Revision{
+				Before: map[string]string{"amount": "-10.00", "description": "Food", "details": ""},
+				After:  map[string]string{"amount": "-12.50", "description": "Food", "details": "Market"},
+			},
+			[]RevisionChange{
+				{Field: "amount", Before: "-10.00", After: "-12.50"},
+				{Field: "details", After: "Market"},
+			},
+		},
+		{
+			"delete lists set fields",
+			Revision{Before: map[string]string{"name": "Rent", "icon": ""}},
+			[]RevisionChange{{Field: "name", Before: "Rent"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rev.Changes(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
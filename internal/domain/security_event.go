@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// SecurityEventType names what happened in a SecurityEvent.
+type SecurityEventType string
+
+const (
+	EventLoginSucceeded            SecurityEventType = "login_succeeded"
+	EventLoginFailed               SecurityEventType = "login_failed"
+	EventLogout                    SecurityEventType = "logout"
+	EventTokenCreated              SecurityEventType = "token_created"
+	EventTokenRotated              SecurityEventType = "token_rotated"
+	EventTokenDeleted              SecurityEventType = "token_deleted"
+	EventTokenUsed                 SecurityEventType = "token_used" // first use from a new address
+	EventTokenRejected             SecurityEventType = "token_rejected"
+	EventSessionRevoked            SecurityEventType = "session_revoked"
+	EventSessionsRevoked           SecurityEventType = "sessions_revoked"
+	EventHouseholdDeleted          SecurityEventType = "household_deleted" // moved to the trash
+	EventHouseholdRestored         SecurityEventType = "household_restored"
+	EventUserDisabled              SecurityEventType = "user_disabled"
+	EventUserEnabled               SecurityEventType = "user_enabled"
+	EventUserDeleted               SecurityEventType = "user_deleted"
+	EventAdminGranted              SecurityEventType = "admin_granted"
+	EventAdminRevoked              SecurityEventType = "admin_revoked"
+	EventTokensRevoked             SecurityEventType = "tokens_revoked"
+	EventDataExported              SecurityEventType = "data_exported"
+	EventHouseholdReceived         SecurityEventType = "household_received"    // accepted from another user's offer
+	EventHouseholdHandedOver       SecurityEventType = "household_handed_over" // offered and accepted by another user
+	EventHouseholdTransferDeclined SecurityEventType = "household_transfer_declined"
+	EventIdentityLinked            SecurityEventType = "identity_linked"
+	EventIdentityUnlinked          SecurityEventType = "identity_unlinked"
+)
+
+// SecurityEvent is an entry of the security log. Events are only ever
+// appended. UserID is the user the event concerns, nil if unknown, for
+// example after a login with an unknown username.
+type SecurityEvent struct {
+	ID        int
+	Type      SecurityEventType
+	UserID    *int
+	IP        string
+	UserAgent string
+	Details   map[string]string
+	CreatedAt time.Time
+}
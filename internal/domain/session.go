@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Session is a browser login kept server-side by the session store.
+type Session struct {
+	ID         int
+	UserID     int
+	Token      string // value referenced by the session cookie
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Settlement is a payment from one household member to another that settles
+// shared costs. It is not part of the household's income or expenses.
+type Settlement struct {
+	ID           int
+	HouseholdID  int
+	FromMemberID int
+	ToMemberID   int
+	Amount       Money // always positive
+	Date         time.Time
+	Note         string
+	CreatedAt    time.Time
+}
+
+// ValidateSettlement checks the amount, members and note of a settlement.
+func ValidateSettlement(s *Settlement) error {
+	if !s.Amount.IsPositive() {
+		return NewValidationError("amount", "must be positive")
+	}
+	if err := ValidateAmount(s.Amount); err != nil {
+		return err
+	}
+	if s.FromMemberID == s.ToMemberID {
+		return NewValidationError("to_member_id", "must differ from from_member_id")
+	}
+	if len(s.Note) > 500 {
+		return NewValidationError("note", "must be at most 500 characters")
+	}
+	return nil
+}
+
+// MemberBalance sums up the shared costs of one member. Paid is what the
+// member paid for shared costs and settlements, Share their own part of the
+// costs and of the settlements they received. A positive balance means the
+// others owe the member money.
+type MemberBalance struct {
+	MemberID   int
+	MemberName string
+	Paid       Money
+	Share      Money
+	Balance    Money
+}
+
+// Transfer is a suggested payment that settles open balances.
+type Transfer struct {
+	FromMemberID int
+	ToMemberID   int
+	Amount       Money
+}
+
+// Balances are the open balances of all members of a household and the
+// transfers that would settle them.
+type Balances struct {
+	HouseholdID int
+	Members     []MemberBalance
+	Transfers   []Transfer
+}
+
+// Ledger accumulates who paid what and whose share it was, in minor units.
+type Ledger struct {
+	paid  map[int]int64
+	share map[int]int64
+}
+
+func NewLedger() *Ledger {
+	return &Ledger{paid: make(map[int]int64), share: make(map[int]int64)}
+}
+
+// AddSplit books a transaction amount. Expenses are negative amounts, so the
+// payer's costs are the negated amount; for income the payer received money
+// that partly belongs to the others.
+func (l *Ledger) AddSplit(split *Split, amount Money) {
+	cost := amount.Neg()
+	l.paid[split.PaidBy] += MinorUnits(cost)
+	for id, part := range split.Allocate(cost) {
+		l.share[id] += MinorUnits(part)
+	}
+}
+
+// AddSettlement books a payment from one member to another.
+func (l *Ledger) AddSettlement(s *Settlement) {
+	amount := MinorUnits(s.Amount)
+	l.paid[s.FromMemberID] += amount
+	l.share[s.ToMemberID] += amount
+}
+
+// Balances returns the balances of the members in the given order and the
+// transfers settling them. Members that don't appear in the ledger have a
+// zero balance.
+func (l *Ledger) Balances(householdID int, members []*HouseholdMember) *Balances {
+	result := &Balances{
+		HouseholdID: householdID,
+		Members:     make([]MemberBalance, 0, len(members)),
+		Transfers:   []Transfer{},
+	}
+	type open struct {
+		id     int
+		amount int64
+	}
+	var creditors, debtors []open
+	for _, m := range members {
+		balance := l.paid[m.ID] - l.share[m.ID]
+		result.Members = append(result.Members, MemberBalance{
+			MemberID:   m.ID,
+			MemberName: m.Name,
+			Paid:       MoneyFromInt(l.paid[m.ID]),
+			Share:      MoneyFromInt(l.share[m.ID]),
+			Balance:    MoneyFromInt(balance),
+		})
+		switch {
+		case balance > 0:
+			creditors = append(creditors, open{m.ID, balance})
+		case balance < 0:
+			debtors = append(debtors, open{m.ID, -balance})
+		}
+	}
+
+	// Settle the largest amounts first, which keeps the number of transfers
+	// low for the handful of members a household has.
+	byAmount := func(s []open) {
+		sort.SliceStable(s, func(i, j int) bool { return s[i].amount > s[j].amount })
+	}
+	byAmount(creditors)
+	byAmount(debtors)
+	for len(creditors) > 0 && len(debtors) > 0 {
+		c, d := &creditors[0], &debtors[0]
+		amount := min(c.amount, d.amount)
+		result.Transfers = append(result.Transfers, Transfer{
+			FromMemberID: d.id,
+			ToMemberID:   c.id,
+			Amount:       MoneyFromInt(amount),
+		})
+		c.amount -= amount
+		d.amount -= amount
+		if c.amount == 0 {
+			creditors = creditors[1:]
+		}
+		if d.amount == 0 {
+			debtors = debtors[1:]
+		}
+	}
+	return result
+}
+
+// AccrueRecurring returns the amount a recurring expense has accrued from its
+// start up to and including the month of until, using the normalized monthly
+// amount of every month like the summaries do. Each month is rounded to
+// cents. Expenses that were deactivated or moved to the trash stop accruing
+// with the month they stopped in.
+func AccrueRecurring(re *RecurringExpense, overrides []*RecurringScheduleOverride, until time.Time) Money {
+	if end := re.ActiveUntil(); end != nil && end.Before(until) {
+		until = *end
+	}
+	if re.DeletedAt != nil && re.DeletedAt.Before(until) {
+		until = *re.DeletedAt
+	}
+
+	total := decimal.Zero
+	month := time.Date(re.StartDate.Year(), re.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(until.Year(), until.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for ; !month.After(last); month = month.AddDate(0, 1, 0) {
+		if !re.IsActiveInMonth(month.Year(), month.Month()) {
+			continue
+		}
+		amount, freq := EffectiveSchedule(re.Amount, re.Frequency, overrides, month.Year(), month.Month())
+		monthly, err := NormalizeToMonthly(amount, freq, month)
+		if err != nil {
+			continue
+		}
+		total = total.Add(monthly.Round(MoneyDecimals))
+	}
+	return total
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestValidateSettlement(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       Settlement
+		wantErr bool
+	}{
+		{"valid", Settlement{FromMemberID: 1, ToMemberID: 2, Amount: decimal.NewFromInt(10)}, false},
+		{"zero amount", Settlement{FromMemberID: 1, ToMemberID: 2, Amount: decimal.Zero}, true},
+		{"negative amount", Settlement{FromMemberID: 1, ToMemberID: 2, Amount: decimal.NewFromInt(-10)}, true},
+		{"same member", Settlement{FromMemberID: 1, ToMemberID: 1, Amount: decimal.NewFromInt(10)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSettlement(&tt.s)
+			if tt.wantErr && !errors.Is(err, ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestLedgerBalances(t *testing.T) {
+	members := []*HouseholdMember{{ID: 1, Name: "Anna"}, {ID: 2, Name: "Ben"}, {ID: 3, Name: "Cem"}}
+
+	l := NewLedger()
+	// Anna pays 90 for groceries, shared equally.
+	l.AddSplit(&Split{PaidBy: 1, Type: SplitEqual, Shares: equal(1, 2, 3)}, MoneyFromInt(-9000))
+	// Ben pays 30 for cleaning supplies shared by Ben and Cem.
+	l.AddSplit(&Split{PaidBy: 2, Type: SplitEqual, Shares: equal(2, 3)}, MoneyFromInt(-3000))
+	// Cem already paid Anna 10.
+	l.AddSettlement(&Settlement{FromMemberID: 3, ToMemberID: 1, Amount: MoneyFromInt(1000)})
+
+	b := l.Balances(7, members)
+	if b.HouseholdID != 7 || len(b.Members) != 3 {
+		t.Fatalf("unexpected balances %+v", b)
+	}
+	wantBalance := map[int]int64{1: 5000, 2: -1500, 3: -3500}
+	for _, m := range b.Members {
+		if MinorUnits(m.Balance) != wantBalance[m.MemberID] {
+			t.Errorf("%s: balance %s, want %d cents", m.MemberName, m.Balance, wantBalance[m.MemberID])
+		}
+	}
+
+	want := []Transfer{
+		{FromMemberID: 3, ToMemberID: 1, Amount: MoneyFromInt(3500)},
+		{FromMemberID: 2, ToMemberID: 1, Amount: MoneyFromInt(1500)},
+	}
+	if len(b.Transfers) != len(want) {
+		t.Fatalf("got transfers %+v, want %+v", b.Transfers, want)
+	}
+	for i, tr := range b.Transfers {
+		if tr.FromMemberID != want[i].FromMemberID || tr.ToMemberID != want[i].ToMemberID || !tr.Amount.Equal(want[i].Amount) {
+			t.Errorf("transfer %d: got %+v, want %+v", i, tr, want[i])
+		}
+	}
+
+	// Paying the suggested transfers settles everything.
+	for _, tr := range b.Transfers {
+		l.AddSettlement(&Settlement{FromMemberID: tr.FromMemberID, ToMemberID: tr.ToMemberID, Amount: tr.Amount})
+	}
+	b = l.Balances(7, members)
+	for _, m := range b.Members {
+		if !m.Balance.IsZero() {
+			t.Errorf("%s: balance %s after settling, want 0", m.MemberName, m.Balance)
+		}
+	}
+	if len(b.Transfers) != 0 {
+		t.Errorf("expected no transfers after settling, got %+v", b.Transfers)
+	}
+}
+
+func TestAccrueRecurring(t *testing.T) {
+	end := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+	re := &RecurringExpense{
+		Amount:    decimal.NewFromInt(-600),
+		Frequency: FrequencyMonthly,
+		Active:    true,
+		StartDate: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+		EndDate:   &end,
+	}
+	overrides := []*RecurringScheduleOverride{{
+		EffectiveDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		Amount:        decimal.NewFromInt(-650),
+		Frequency:     FrequencyMonthly,
+	}}
+
+	tests := []struct {
+		name  string
+		until time.Time
+		want  string
+	}{
+		{"before start", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), "0"},
+		{"first month", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "-600"},
+		{"with override", time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC), "-1850"},
+		{"after end", time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), "-2500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AccrueRecurring(re, overrides, tt.until)
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	until := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+	deactivated := *re
+	deactivated.Active = false
+	deactivated.DeactivatedAt = timePtr(time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC))
+	if got := AccrueRecurring(&deactivated, overrides, until); !got.Equal(decimal.NewFromInt(-1200)) {
+		t.Errorf("deactivated: got %s, want -1200", got)
+	}
+	deleted := *re
+	deleted.DeletedAt = timePtr(time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC))
+	if got := AccrueRecurring(&deleted, overrides, until); !got.Equal(decimal.NewFromInt(-1850)) {
+		t.Errorf("deleted: got %s, want -1850", got)
+	}
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrSignInDenied is returned when the allow-lists reject an OIDC login.
+	ErrSignInDenied = errors.New("sign-in not allowed")
+	// ErrUserDisabled is returned when a disabled user tries to log in.
+	ErrUserDisabled = errors.New("user disabled")
+	// ErrRegistrationClosed is returned when an unknown user tries to sign in
+	// while registration is closed.
+	ErrRegistrationClosed = errors.New("registration closed")
+	// ErrLinkRequired is returned when an unknown OIDC identity has the email
+	// of an existing account. The user has to log in to that account to link
+	// the identity. Identities with an unverified email are denied instead.
+	ErrLinkRequired = errors.New("account with this email exists")
+)
+
+// Registration controls whether OIDC logins of unknown users create accounts.
+type Registration string
+
+const (
+	RegistrationOpen   Registration = "open"
+	RegistrationClosed Registration = "closed" // only existing and invited users
+)
+
+func ValidateRegistration(r Registration) error {
+	switch r {
+	case RegistrationOpen, RegistrationClosed:
+		return nil
+	}
+	return NewValidationError("registration", "must be open or closed")
+}
+
+// OIDCIdentity is what the identity provider says about a user signing in.
+type OIDCIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified *bool // nil if the provider doesn't send the claim
+	Name          string
+	Groups        []string
+	// TrustUnverifiedEmail is set for providers configured to only issue
+	// addresses they checked, even without email_verified.
+	TrustUnverifiedEmail bool
+}
+
+// EmailTrusted reports whether the email may be used to decide about access,
+// to claim invites and to find an existing account. Only emails the provider
+// marks as verified are trusted, unless the provider is trusted as a whole.
+func (id OIDCIdentity) EmailTrusted() bool {
+	if id.Email == "" {
+		return false
+	}
+	return id.TrustUnverifiedEmail || (id.EmailVerified != nil && *id.EmailVerified)
+}
+
+// SignInPolicy restricts who may log in via OIDC. Empty lists don't restrict
+// anything.
+type SignInPolicy struct {
+	AllowedEmails  []string
+	AllowedDomains []string
+	AllowedGroups  []string
+	AdminGroups    []string // if set, admin follows membership in these groups
+	Registration   Registration
+}
+
+// Allows reports whether the allow-lists accept the identity. A listed email
+// or a listed domain is enough; with allowed groups the identity must also
+// be in one of them.
+func (p SignInPolicy) Allows(id OIDCIdentity) bool {
+	if len(p.AllowedEmails) > 0 || len(p.AllowedDomains) > 0 {
+		if !id.EmailTrusted() || !p.emailAllowed(id.Email) {
+			return false
+		}
+	}
+	if len(p.AllowedGroups) > 0 {
+		if !inAnyGroup(id.Groups, p.AllowedGroups) {
+			return false
+		}
+	}
+	return true
+}
+
+// Admin reports whether the identity is in one of the admin groups, and
+// whether the policy decides about admins at all.
+func (p SignInPolicy) Admin(id OIDCIdentity) (admin, managed bool) {
+	if len(p.AdminGroups) == 0 {
+		return false, false
+	}
+	return inAnyGroup(id.Groups, p.AdminGroups), true
+}
+
+func inAnyGroup(groups, wanted []string) bool {
+	return slices.ContainsFunc(groups, func(g string) bool { return slices.Contains(wanted, g) })
+}
+
+func (p SignInPolicy) emailAllowed(email string) bool {
+	for _, allowed := range p.AllowedEmails {
+		if strings.EqualFold(email, allowed) {
+			return true
+		}
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+	for _, allowed := range p.AllowedDomains {
+		if strings.EqualFold(domain, strings.TrimPrefix(allowed, "@")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package domain
+
+import "testing"
+
+func TestSignInPolicyAllows(t *testing.T) {
+	verified, unverified := true, false
+	tests := []struct {
+		name   string
+		policy SignInPolicy
+		id     OIDCIdentity
+		want   bool
+	}{
+		{"no restrictions", SignInPolicy{}, OIDCIdentity{Email: "a@example.com"}, true},
+		{"listed email", SignInPolicy{AllowedEmails: []string{"A@example.com"}}, OIDCIdentity{Email: "a@example.com", EmailVerified: &verified}, true},
+		{"unlisted email", SignInPolicy{AllowedEmails: []string{"a@example.com"}}, OIDCIdentity{Email: "b@example.com", EmailVerified: &verified}, false},
+		{"listed domain", SignInPolicy{AllowedDomains: []string{"example.com"}}, OIDCIdentity{Email: "b@Example.com", EmailVerified: &verified}, true},
+		{"domain with at sign", SignInPolicy{AllowedDomains: []string{"@example.com"}}, OIDCIdentity{Email: "b@example.com", EmailVerified: &verified}, true},
+		{"subdomain", SignInPolicy{AllowedDomains: []string{"example.com"}}, OIDCIdentity{Email: "b@evil.example.com", EmailVerified: &verified}, false},
+		{"email or domain", SignInPolicy{AllowedEmails: []string{"x@other.org"}, AllowedDomains: []string{"example.com"}}, OIDCIdentity{Email: "x@other.org", EmailVerified: &verified}, true},
+		{"unverified email", SignInPolicy{AllowedDomains: []string{"example.com"}}, OIDCIdentity{Email: "b@example.com", EmailVerified: &unverified}, false},
+		{"email without claim", SignInPolicy{AllowedDomains: []string{"example.com"}}, OIDCIdentity{Email: "b@example.com"}, false},
+		{"trusted provider", SignInPolicy{AllowedDomains: []string{"example.com"}}, OIDCIdentity{Email: "b@example.com", TrustUnverifiedEmail: true}, true},
+		{"no email", SignInPolicy{AllowedDomains: []string{"example.com"}}, OIDCIdentity{}, false},
+		{"in group", SignInPolicy{AllowedGroups: []string{"family"}}, OIDCIdentity{Groups: []string{"staff", "family"}}, true},
+		{"not in group", SignInPolicy{AllowedGroups: []string{"family"}}, OIDCIdentity{Groups: []string{"staff"}}, false},
+		{"email and group", SignInPolicy{AllowedDomains: []string{"example.com"}, AllowedGroups: []string{"family"}}, OIDCIdentity{Email: "b@example.com", Groups: []string{"staff"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Allows(tt.id); got != tt.want {
+				t.Errorf("Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCIdentityEmailTrusted(t *testing.T) {
+	verified, unverified := true, false
+	tests := []struct {
+		name string
+		id   OIDCIdentity
+		want bool
+	}{
+		{"verified", OIDCIdentity{Email: "a@example.com", EmailVerified: &verified}, true},
+		{"unverified", OIDCIdentity{Email: "a@example.com", EmailVerified: &unverified}, false},
+		{"no claim", OIDCIdentity{Email: "a@example.com"}, false},
+		{"trusted provider without claim", OIDCIdentity{Email: "a@example.com", TrustUnverifiedEmail: true}, true},
+		{"trusted provider, unverified", OIDCIdentity{Email: "a@example.com", EmailVerified: &unverified, TrustUnverifiedEmail: true}, true},
+		{"no email", OIDCIdentity{EmailVerified: &verified, TrustUnverifiedEmail: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.id.EmailTrusted(); got != tt.want {
+				t.Errorf("EmailTrusted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignInPolicyAdmin(t *testing.T) {
+	id := OIDCIdentity{Groups: []string{"family", "admins"}}
+
+	if admin, managed := (SignInPolicy{}).Admin(id); admin || managed {
+		t.Errorf("without admin groups: admin=%v managed=%v, want false false", admin, managed)
+	}
+	if admin, managed := (SignInPolicy{AdminGroups: []string{"admins"}}).Admin(id); !admin || !managed {
+		t.Errorf("in admin group: admin=%v managed=%v, want true true", admin, managed)
+	}
+	if admin, managed := (SignInPolicy{AdminGroups: []string{"root"}}).Admin(id); admin || !managed {
+		t.Errorf("not in admin group: admin=%v managed=%v, want false true", admin, managed)
+	}
+}
+
+func TestValidateRegistration(t *testing.T) {
+	for _, r := range []Registration{RegistrationOpen, RegistrationClosed} {
+		if err := ValidateRegistration(r); err != nil {
+			t.Errorf("ValidateRegistration(%q) = %v", r, err)
+		}
+	}
+	for _, r := range []Registration{"", "invite"} {
+		if err := ValidateRegistration(r); err == nil {
+			t.Errorf("ValidateRegistration(%q) should fail", r)
+		}
+	}
+}
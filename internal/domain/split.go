@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// SplitType defines how the amount of a split is divided.
+type SplitType string
+
+const (
+	SplitEqual      SplitType = "equal"
+	SplitPercentage SplitType = "percentage"
+	SplitFixed      SplitType = "fixed"
+)
+
+var validSplitTypes = map[SplitType]bool{
+	SplitEqual:      true,
+	SplitPercentage: true,
+	SplitFixed:      true,
+}
+
+func (t SplitType) Valid() bool {
+	return validSplitTypes[t]
+}
+
+func AllSplitTypes() []SplitType {
+	return []SplitType{SplitEqual, SplitPercentage, SplitFixed}
+}
+
+// Split attributes a transaction or recurring expense to the member who paid
+// it and defines how its amount is shared between members.
+type Split struct {
+	PaidBy int // member ID
+	Type   SplitType
+	Shares []SplitShare
+}
+
+// SplitShare is one member's part of a split. Value is unused for equal
+// splits, a percentage for percentage splits and an amount (without sign)
+// for fixed splits.
+type SplitShare struct {
+	MemberID int
+	Value    Money
+}
+
+// NewSplit builds a split from request values. Without payer and split type
+// the amount isn't shared; a payer without a split type shares it equally.
+// A split type without payer is left for ValidateSplit to reject.
+func NewSplit(paidBy *int, splitType string, shares []SplitShare) *Split {
+	if paidBy == nil && splitType == "" && len(shares) == 0 {
+		return nil
+	}
+	split := &Split{Type: SplitType(splitType), Shares: shares}
+	if paidBy != nil {
+		split.PaidBy = *paidBy
+	}
+	if split.Type == "" {
+		split.Type = SplitEqual
+	}
+	return split
+}
+
+// NewSplitShare parses the value of a share. An empty value is allowed for
+// equal splits.
+func NewSplitShare(memberID int, value string) (SplitShare, error) {
+	share := SplitShare{MemberID: memberID, Value: ZeroMoney()}
+	if value == "" {
+		return share, nil
+	}
+	v, err := NewMoney(value)
+	if err != nil {
+		return SplitShare{}, NewValidationError("shares", "invalid value")
+	}
+	share.Value = v
+	return share, nil
+}
+
+// MemberIDs returns the payer and all members with a share.
+func (s *Split) MemberIDs() []int {
+	ids := []int{s.PaidBy}
+	for _, sh := range s.Shares {
+		ids = append(ids, sh.MemberID)
+	}
+	return ids
+}
+
+// Involves reports whether the member paid or has a share.
+func (s *Split) Involves(memberID int) bool {
+	for _, id := range s.MemberIDs() {
+		if id == memberID {
+			return true
+		}
+	}
+	return false
+}
+
+var hundred = decimal.NewFromInt(100)
+
+// ValidateSplit checks a split of amount. A nil split is valid; members are
+// not checked against the household.
+func ValidateSplit(split *Split, amount Money) error {
+	if split == nil {
+		return nil
+	}
+	if split.PaidBy == 0 {
+		return NewValidationError("paid_by", "is required for a split")
+	}
+	if !split.Type.Valid() {
+		return NewValidationError("split_type", "must be equal, percentage or fixed")
+	}
+	if len(split.Shares) == 0 {
+		return NewValidationError("shares", fmt.Sprintf("are required for a %s split", split.Type))
+	}
+
+	seen := make(map[int]bool, len(split.Shares))
+	sum := decimal.Zero
+	for _, sh := range split.Shares {
+		if seen[sh.MemberID] {
+			return NewValidationError("shares", "must not list a member twice")
+		}
+		seen[sh.MemberID] = true
+		if split.Type == SplitEqual {
+			continue
+		}
+		if !sh.Value.IsPositive() {
+			return NewValidationError("shares", "values must be positive")
+		}
+		if !sh.Value.Equal(sh.Value.Round(MoneyDecimals)) {
+			return NewValidationError("shares", "values must have at most 2 decimal places")
+		}
+		sum = sum.Add(sh.Value)
+	}
+
+	switch split.Type {
+	case SplitPercentage:
+		if !sum.Equal(hundred) {
+			return NewValidationError("shares", "percentages must add up to 100")
+		}
+	case SplitFixed:
+		if !sum.Equal(amount.Abs()) {
+			return NewValidationError("shares", "fixed shares must add up to the amount")
+		}
+	}
+	return nil
+}
+
+// Allocate divides amount between the members of the split. Fixed shares
+// are used as weights, so they come out exactly for the amount they were
+// defined for and proportionally for others, such as the accrued total of a
+// recurring expense. Cents that can't be divided evenly go to the first members, so the
+// parts always add up to amount.
+func (s *Split) Allocate(amount Money) map[int]Money {
+	total := MinorUnits(amount)
+	sign := int64(1)
+	if total < 0 {
+		sign, total = -1, -total
+	}
+
+	ids := make([]int, 0, len(s.Shares))
+	weights := make([]int64, 0, len(s.Shares))
+	for _, sh := range s.Shares {
+		ids = append(ids, sh.MemberID)
+		if s.Type == SplitEqual {
+			weights = append(weights, 1)
+		} else {
+			weights = append(weights, MinorUnits(sh.Value))
+		}
+	}
+
+	var weightSum int64
+	for _, w := range weights {
+		weightSum += w
+	}
+	parts := make(map[int]Money, len(ids))
+	if weightSum == 0 {
+		return parts
+	}
+	cents := make([]int64, len(ids))
+	rest := total
+	for i, w := range weights {
+		cents[i] = total * w / weightSum
+		rest -= cents[i]
+	}
+	for i := 0; rest > 0; i = (i + 1) % len(cents) {
+		cents[i]++
+		rest--
+	}
+	for i, id := range ids {
+		parts[id] = MoneyFromInt(sign * cents[i])
+	}
+	return parts
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestValidateSplit(t *testing.T) {
+	amount := MoneyFromInt(-9000)
+	share := func(id int, value int64) SplitShare {
+		return SplitShare{MemberID: id, Value: decimal.NewFromInt(value)}
+	}
+
+	tests := []struct {
+		name    string
+		split   *Split
+		wantErr bool
+	}{
+		{"none", nil, false},
+		{"equal some members", &Split{PaidBy: 1, Type: SplitEqual, Shares: []SplitShare{{MemberID: 1}, {MemberID: 2}}}, false},
+		{"percentage", &Split{PaidBy: 1, Type: SplitPercentage, Shares: []SplitShare{share(1, 60), share(2, 40)}}, false},
+		{"fixed", &Split{PaidBy: 1, Type: SplitFixed, Shares: []SplitShare{share(1, 30), share(2, 60)}}, false},
+		{"no payer", &Split{Type: SplitEqual, Shares: []SplitShare{{MemberID: 1}}}, true},
+		{"equal without shares", &Split{PaidBy: 1, Type: SplitEqual}, true},
+		{"bad type", &Split{PaidBy: 1, Type: "half"}, true},
+		{"percentage without shares", &Split{PaidBy: 1, Type: SplitPercentage}, true},
+		{"percentage not 100", &Split{PaidBy: 1, Type: SplitPercentage, Shares: []SplitShare{share(1, 60), share(2, 30)}}, true},
+		{"fixed not amount", &Split{PaidBy: 1, Type: SplitFixed, Shares: []SplitShare{share(1, 30), share(2, 50)}}, true},
+		{"duplicate member", &Split{PaidBy: 1, Type: SplitPercentage, Shares: []SplitShare{share(1, 50), share(1, 50)}}, true},
+		{"zero share", &Split{PaidBy: 1, Type: SplitFixed, Shares: []SplitShare{share(1, 90), share(2, 0)}}, true},
+		{"too many decimals", &Split{PaidBy: 1, Type: SplitPercentage, Shares: []SplitShare{
+			{MemberID: 1, Value: decimal.RequireFromString("33.333")},
+			{MemberID: 2, Value: decimal.RequireFromString("66.667")},
+		}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSplit(tt.split, amount)
+			if tt.wantErr && !errors.Is(err, ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestSplitAllocate(t *testing.T) {
+	tests := []struct {
+		name   string
+		split  Split
+		amount int64
+		want   map[int]int64
+	}{
+		{"equal", Split{Type: SplitEqual, Shares: equal(1, 2, 3)}, 9000, map[int]int64{1: 3000, 2: 3000, 3: 3000}},
+		{"equal remainder to first", Split{Type: SplitEqual, Shares: equal(1, 2, 3)}, 1000, map[int]int64{1: 334, 2: 333, 3: 333}},
+		{"equal listed members", Split{Type: SplitEqual, Shares: equal(2, 3)}, 1001, map[int]int64{2: 501, 3: 500}},
+		{"negative amount", Split{Type: SplitEqual, Shares: equal(1, 2)}, -1001, map[int]int64{1: -501, 2: -500}},
+		{"percentage", Split{Type: SplitPercentage, Shares: []SplitShare{
+			{MemberID: 1, Value: decimal.NewFromInt(70)},
+			{MemberID: 2, Value: decimal.NewFromInt(30)},
+		}}, 1999, map[int]int64{1: 1400, 2: 599}},
+		{"fixed exact", Split{Type: SplitFixed, Shares: []SplitShare{
+			{MemberID: 1, Value: decimal.RequireFromString("12.34")},
+			{MemberID: 2, Value: decimal.RequireFromString("7.66")},
+		}}, -2000, map[int]int64{1: -1234, 2: -766}},
+		{"fixed proportional", Split{Type: SplitFixed, Shares: []SplitShare{
+			{MemberID: 1, Value: decimal.NewFromInt(30)},
+			{MemberID: 2, Value: decimal.NewFromInt(10)},
+		}}, 8000, map[int]int64{1: 6000, 2: 2000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.split.Allocate(MoneyFromInt(tt.amount))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d parts, want %d", len(got), len(tt.want))
+			}
+			for id, want := range tt.want {
+				if MinorUnits(got[id]) != want {
+					t.Errorf("member %d: got %s, want %d cents", id, got[id], want)
+				}
+			}
+		})
+	}
+}
+
+// equal returns the shares of an equal split between the given members.
+func equal(ids ...int) []SplitShare {
+	shares := make([]SplitShare, 0, len(ids))
+	for _, id := range ids {
+		shares = append(shares, SplitShare{MemberID: id})
+	}
+	return shares
+}
@@ -44,3 +44,37 @@ type RecurringEntry struct {
 	MonthlyAmount Money
 	EffectiveDate time.Time
 }
+
+// MaxRangeMonths limits the number of months a range summary may span.
+const MaxRangeMonths = 120
+
+type RangeSummary struct {
+	HouseholdID     int
+	From            string // YYYY-MM
+	To              string // YYYY-MM
+	Months          []MonthTotals
+	Categories      []CategorySeries
+	TotalIncome     Money // gross income over the period
+	TotalExpenses   Money // gross expenses over the period
+	Total           Money
+	AverageIncome   Money // per month
+	AverageExpenses Money // per month
+	AverageTotal    Money // per month
+}
+
+type MonthTotals struct {
+	Month     string // YYYY-MM
+	Income    Money  // one-time + recurring income
+	Expenses  Money  // one-time + recurring expenses
+	Recurring Money
+	OneTime   Money
+	Total     Money
+}
+
+type CategorySeries struct {
+	CategoryID   int
+	CategoryName string
+	Values       []Money // one value per entry in RangeSummary.Months
+	Total        Money
+	Average      Money
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaxClass classifies costs that are relevant for the annual income tax
+// return. Categories carry a default class, transactions may override it.
+type TaxClass string
+
+const (
+	// TaxClassNone is only used on transactions to exclude them from the
+	// class of their category.
+	TaxClassNone TaxClass = "none"
+
+	TaxClassCraftsmanServices    TaxClass = "craftsman_services"      // Handwerkerleistungen (§ 35a Abs. 3 EStG)
+	TaxClassHouseholdServices    TaxClass = "household_services"      // haushaltsnahe Dienstleistungen (§ 35a Abs. 2 EStG)
+	TaxClassIncomeRelated        TaxClass = "income_related_expenses" // Werbungskosten
+	TaxClassSpecialExpenses      TaxClass = "special_expenses"        // Sonderausgaben
+	TaxClassExtraordinaryBurdens TaxClass = "extraordinary_burdens"   // außergewöhnliche Belastungen
+)
+
+var validTaxClasses = map[TaxClass]bool{
+	TaxClassCraftsmanServices:    true,
+	TaxClassHouseholdServices:    true,
+	TaxClassIncomeRelated:        true,
+	TaxClassSpecialExpenses:      true,
+	TaxClassExtraordinaryBurdens: true,
+}
+
+// Valid reports whether t is a tax class. The empty class (not tax relevant)
+// and TaxClassNone are not classes.
+func (t TaxClass) Valid() bool {
+	return validTaxClasses[t]
+}
+
+// ValidateCategoryTaxClass accepts the empty class or a tax class.
+func ValidateCategoryTaxClass(t TaxClass) error {
+	if t != "" && !t.Valid() {
+		return fmt.Errorf("%w: invalid tax class %q", ErrValidation, t)
+	}
+	return nil
+}
+
+// ValidateTransactionTaxClass accepts the empty class (inherit from the
+// category), TaxClassNone or a tax class.
+func ValidateTransactionTaxClass(t TaxClass) error {
+	if t != "" && t != TaxClassNone && !t.Valid() {
+		return fmt.Errorf("%w: invalid tax class %q", ErrValidation, t)
+	}
+	return nil
+}
+
+func AllTaxClasses() []TaxClass {
+	return []TaxClass{
+		TaxClassCraftsmanServices, TaxClassHouseholdServices, TaxClassIncomeRelated,
+		TaxClassSpecialExpenses, TaxClassExtraordinaryBurdens,
+	}
+}
+
+// EffectiveTaxClass returns the class a transaction counts towards: its own
+// class if set, otherwise the class of its category. The empty class means
+// not tax relevant.
+func EffectiveTaxClass(transaction, category TaxClass) TaxClass {
+	switch transaction {
+	case "":
+		return category
+	case TaxClassNone:
+		return ""
+	default:
+		return transaction
+	}
+}
+
+// TaxSummary lists all tax relevant amounts of a household in a calendar year.
+type TaxSummary struct {
+	HouseholdID int
+	Year        int
+	Classes     []TaxClassSummary // only classes with entries, in AllTaxClasses order
+	Total       Money
+}
+
+type TaxClassSummary struct {
+	Class        TaxClass
+	OneTime      Money
+	Recurring    Money
+	Total        Money
+	Transactions []TaxTransaction
+	Occurrences  []TaxRecurringOccurrence
+}
+
+// TaxTransaction is a one-time transaction supporting a tax class.
+type TaxTransaction struct {
+	TransactionID int
+	Date          time.Time
+	CategoryID    int
+	CategoryName  string
+	Description   string
+	Amount        Money
+	Inherited     bool // class comes from the category
+}
+
+// TaxRecurringOccurrence is a payment of a recurring item on the day it was
+// due.
+type TaxRecurringOccurrence struct {
+	RecurringExpenseID int
+	Name               string
+	CategoryID         int
+	CategoryName       string
+	Date               time.Time
+	Amount             Money
+	Frequency          Frequency // frequency in effect on that day
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEffectiveTaxClass(t *testing.T) {
+	tests := []struct {
+		name        string
+		transaction TaxClass
+		category    TaxClass
+		want        TaxClass
+	}{
+		{"inherit", "", TaxClassCraftsmanServices, TaxClassCraftsmanServices},
+		{"inherit none", "", "", ""},
+		{"override", TaxClassHouseholdServices, TaxClassCraftsmanServices, TaxClassHouseholdServices},
+		{"override uncategorized", TaxClassIncomeRelated, "", TaxClassIncomeRelated},
+		{"exclude", TaxClassNone, TaxClassCraftsmanServices, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EffectiveTaxClass(tt.transaction, tt.category); got != tt.want {
+				t.Errorf("EffectiveTaxClass(%q, %q) = %q, want %q", tt.transaction, tt.category, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTaxClass(t *testing.T) {
+	for _, tc := range AllTaxClasses() {
+		if err := ValidateCategoryTaxClass(tc); err != nil {
+			t.Errorf("category %q: unexpected error %v", tc, err)
+		}
+		if err := ValidateTransactionTaxClass(tc); err != nil {
+			t.Errorf("transaction %q: unexpected error %v", tc, err)
+		}
+	}
+	if err := ValidateCategoryTaxClass(""); err != nil {
+		t.Errorf("category empty: unexpected error %v", err)
+	}
+	if err := ValidateTransactionTaxClass(TaxClassNone); err != nil {
+		t.Errorf("transaction none: unexpected error %v", err)
+	}
+	if err := ValidateCategoryTaxClass(TaxClassNone); !errors.Is(err, ErrValidation) {
+		t.Errorf("category none: expected ErrValidation, got %v", err)
+	}
+	if err := ValidateTransactionTaxClass("bogus"); !errors.Is(err, ErrValidation) {
+		t.Errorf("transaction bogus: expected ErrValidation, got %v", err)
+	}
+}
@@ -9,7 +9,52 @@ type Transaction struct {
 	Amount      Money
 	Description string
 	Details     string
+	TaxClass    TaxClass // empty to inherit from the category, TaxClassNone to exclude
+	Split       *Split   // nil if not shared between members
 	Date        time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   *time.Time // nil unless the transaction is in the trash
+}
+
+// AmountFilter restricts a transaction listing to amounts within the given
+// bounds (inclusive). A nil bound is open.
+type AmountFilter struct {
+	Min *Money
+	Max *Money
+}
+
+// TransactionSum holds the one-time income and expenses of one household in
+// one category and month, as summed up by the database.
+type TransactionSum struct {
+	HouseholdID int
+	CategoryID  int
+	Month       string // YYYY-MM
+	Income      Money
+	Expenses    Money
+}
+
+// NewAmountFilter parses the optional bounds of an amount filter. Empty
+// strings leave the bound open.
+func NewAmountFilter(min, max string) (AmountFilter, error) {
+	var filter AmountFilter
+	var err error
+	if filter.Min, err = parseAmountBound("min_amount", min); err != nil {
+		return AmountFilter{}, err
+	}
+	if filter.Max, err = parseAmountBound("max_amount", max); err != nil {
+		return AmountFilter{}, err
+	}
+	return filter, ValidateAmountFilter(filter)
+}
+
+func parseAmountBound(field, value string) (*Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+	m, err := NewMoney(value)
+	if err != nil {
+		return nil, NewValidationError(field, "invalid amount")
+	}
+	return &m, nil
 }
@@ -0,0 +1,8 @@
+package domain
+
+// Trash holds the records of a household that were deleted but not purged
+// yet, most recently deleted first.
+type Trash struct {
+	Transactions      []*Transaction
+	RecurringExpenses []*RecurringExpense
+}
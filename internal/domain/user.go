@@ -1,12 +1,40 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type User struct {
-	ID        int
-	Email     string
-	Name      string
-	Subject   string // OIDC subject
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID         int
+	Email      string
+	Name       string
+	Subject    string     // internal login key, see the prefixes below
+	Admin      bool       // instance admin
+	DisabledAt *time.Time // disabled users can't log in
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
+
+func (u *User) Disabled() bool {
+	return u.DisabledAt != nil
+}
+
+// InvitedSubjectPrefix marks users that were invited by email and haven't
+// signed in yet. The first OIDC login with that email replaces the subject.
+const InvitedSubjectPrefix = "invite:"
+
+func (u *User) Invited() bool {
+	return strings.HasPrefix(u.Subject, InvitedSubjectPrefix)
+}
+
+// OIDCSubjectPrefix marks users that sign in with OIDC. The rest of the
+// subject is random; their logins are the UserIdentity records. Users from
+// before multiple providers have the plain OIDC subject until it is moved
+// to an identity.
+const OIDCSubjectPrefix = "oidc:"
+
+// HeaderSubjectPrefix marks users that sign in through the headers of an
+// authenticating reverse proxy. The rest of the subject is the user name the
+// proxy sends.
+const HeaderSubjectPrefix = "header:"
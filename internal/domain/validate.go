@@ -35,6 +35,16 @@ func ValidateAmount(amount Money) error {
 	if amount.Abs().GreaterThan(maxAmount) {
 		return NewValidationError("amount", "exceeds maximum (999999999.99)")
 	}
+	if !amount.Equal(amount.Round(MoneyDecimals)) {
+		return NewValidationError("amount", "must have at most 2 decimal places")
+	}
+	return nil
+}
+
+func ValidateAmountFilter(filter AmountFilter) error {
+	if filter.Min != nil && filter.Max != nil && filter.Min.GreaterThan(*filter.Max) {
+		return NewValidationError("min_amount", "must not be greater than max_amount")
+	}
 	return nil
 }
 
@@ -76,6 +76,8 @@ func TestValidateAmount(t *testing.T) {
 		{"zero", "0", true},
 		{"exceeds max", "1000000000.00", true},
 		{"exceeds max negative", "-1000000000.00", true},
+		{"trailing zeros", "12.500", false},
+		{"three decimals", "12.345", true},
 	}
 
 	for _, tt := range tests {
@@ -92,6 +94,52 @@ func TestValidateAmount(t *testing.T) {
 	}
 }
 
+func TestNewAmountFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max string
+		wantMin  string
+		wantMax  string
+		wantErr  bool
+	}{
+		{"open", "", "", "", "", false},
+		{"min only", "-10", "", "-10", "", false},
+		{"max only", "", "5,50", "", "5.5", false},
+		{"equal bounds", "3", "3", "3", "3", false},
+		{"min greater than max", "10", "5", "", "", true},
+		{"invalid min", "abc", "", "", "", true},
+		{"invalid max", "", "1x", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewAmountFilter(tt.min, tt.max)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAmountFilter(%q, %q) error = %v, wantErr %v", tt.min, tt.max, err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+				return
+			}
+			if got := boundString(filter.Min); got != tt.wantMin {
+				t.Errorf("Min = %q, want %q", got, tt.wantMin)
+			}
+			if got := boundString(filter.Max); got != tt.wantMax {
+				t.Errorf("Max = %q, want %q", got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func boundString(m *Money) string {
+	if m == nil {
+		return ""
+	}
+	return m.String()
+}
+
 func TestValidateDateRange(t *testing.T) {
 	now := time.Now()
 	tests := []struct {
@@ -24,39 +24,49 @@ func toGQLCategory(c *domain.Category) *model.Category {
 		HouseholdID: c.HouseholdID,
 		Name:        c.Name,
 		Icon:        c.Icon,
+		TaxClass:    string(c.TaxClass),
 		CreatedAt:   c.CreatedAt.Format("2006-01-02T15:04:05Z"),
 		UpdatedAt:   c.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 }
 
 func toGQLTransaction(tx *domain.Transaction) *model.Transaction {
+	paidBy, splitType, shares := toGQLSplit(tx.Split)
 	return &model.Transaction{
-		ID:          tx.ID,
-		HouseholdID: tx.HouseholdID,
-		CategoryID:  tx.CategoryID,
-		Amount:      tx.Amount.String(),
-		Description: tx.Description,
-		Details:     tx.Details,
-		Date:        tx.Date.Format("2006-01-02"),
-		CreatedAt:   tx.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:   tx.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		ID:             tx.ID,
+		HouseholdID:    tx.HouseholdID,
+		CategoryID:     tx.CategoryID,
+		Amount:         tx.Amount.String(),
+		Description:    tx.Description,
+		Details:        tx.Details,
+		TaxClass:       string(tx.TaxClass),
+		Date:           tx.Date.Format("2006-01-02"),
+		PaidByMemberID: paidBy,
+		SplitType:      splitType,
+		Shares:         shares,
+		CreatedAt:      tx.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:      tx.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 }
 
 func toGQLRecurringExpense(re *domain.RecurringExpense) *model.RecurringExpense {
+	paidBy, splitType, shares := toGQLSplit(re.Split)
 	resp := &model.RecurringExpense{
-		ID:          re.ID,
-		HouseholdID: re.HouseholdID,
-		CategoryID:  re.CategoryID,
-		Name:        re.Name,
-		Description: re.Description,
-		Details:     re.Details,
-		Amount:      re.Amount.String(),
-		Frequency:   string(re.Frequency),
-		Active:      re.Active,
-		StartDate:   re.StartDate.Format("2006-01-02"),
-		CreatedAt:   re.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:   re.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		ID:             re.ID,
+		HouseholdID:    re.HouseholdID,
+		CategoryID:     re.CategoryID,
+		Name:           re.Name,
+		Description:    re.Description,
+		Details:        re.Details,
+		Amount:         re.Amount.String(),
+		Frequency:      string(re.Frequency),
+		Active:         re.Active,
+		StartDate:      re.StartDate.Format("2006-01-02"),
+		PaidByMemberID: paidBy,
+		SplitType:      splitType,
+		Shares:         shares,
+		CreatedAt:      re.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:      re.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 	if re.EndDate != nil {
 		s := re.EndDate.Format("2006-01-02")
@@ -65,6 +75,89 @@ func toGQLRecurringExpense(re *domain.RecurringExpense) *model.RecurringExpense
 	return resp
 }
 
+// toGQLSplit returns the payer, split type and shares of a transaction or
+// recurring expense. Shares are empty if it isn't split.
+func toGQLSplit(split *domain.Split) (*int, *string, []model.SplitShare) {
+	shares := []model.SplitShare{}
+	if split == nil {
+		return nil, nil, shares
+	}
+	paidBy := split.PaidBy
+	splitType := string(split.Type)
+	for _, sh := range split.Shares {
+		share := model.SplitShare{MemberID: sh.MemberID}
+		if split.Type != domain.SplitEqual {
+			v := sh.Value.String()
+			share.Value = &v
+		}
+		shares = append(shares, share)
+	}
+	return &paidBy, &splitType, shares
+}
+
+// splitFromInput builds the split of a transaction or recurring expense
+// input. It returns nil if the amount isn't shared between members.
+func splitFromInput(paidBy *int, splitType *string, shares []model.SplitShareInput) (*domain.Split, error) {
+	parsed := make([]domain.SplitShare, 0, len(shares))
+	for _, sh := range shares {
+		share, err := domain.NewSplitShare(sh.MemberID, derefString(sh.Value))
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, share)
+	}
+	return domain.NewSplit(paidBy, derefString(splitType), parsed), nil
+}
+
+func toGQLMember(m *domain.HouseholdMember) *model.Member {
+	return &model.Member{
+		ID:          m.ID,
+		HouseholdID: m.HouseholdID,
+		Name:        m.Name,
+		CreatedAt:   m.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   m.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func toGQLSettlement(st *domain.Settlement) *model.Settlement {
+	return &model.Settlement{
+		ID:           st.ID,
+		HouseholdID:  st.HouseholdID,
+		FromMemberID: st.FromMemberID,
+		ToMemberID:   st.ToMemberID,
+		Amount:       st.Amount.String(),
+		Date:         st.Date.Format("2006-01-02"),
+		Note:         st.Note,
+		CreatedAt:    st.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func toGQLBalances(b *domain.Balances) *model.Balances {
+	members := make([]model.MemberBalance, len(b.Members))
+	for i, m := range b.Members {
+		members[i] = model.MemberBalance{
+			MemberID:   m.MemberID,
+			MemberName: m.MemberName,
+			Paid:       m.Paid.String(),
+			Share:      m.Share.String(),
+			Balance:    m.Balance.String(),
+		}
+	}
+	transfers := make([]model.Transfer, len(b.Transfers))
+	for i, t := range b.Transfers {
+		transfers[i] = model.Transfer{
+			FromMemberID: t.FromMemberID,
+			ToMemberID:   t.ToMemberID,
+			Amount:       t.Amount.String(),
+		}
+	}
+	return &model.Balances{
+		HouseholdID: b.HouseholdID,
+		Members:     members,
+		Transfers:   transfers,
+	}
+}
+
 func toGQLMonthlySummary(s *domain.MonthlySummary) *model.MonthlySummary {
 	breakdown := make([]model.CategorySummary, len(s.CategoryBreakdown))
 	for i, cb := range s.CategoryBreakdown {
@@ -92,6 +185,134 @@ func toGQLMonthlySummary(s *domain.MonthlySummary) *model.MonthlySummary {
 	}
 }
 
+func toGQLRangeSummary(s *domain.RangeSummary) *model.RangeSummary {
+	months := make([]model.MonthTotals, len(s.Months))
+	for i, m := range s.Months {
+		months[i] = model.MonthTotals{
+			Month:     m.Month,
+			Income:    m.Income.String(),
+			Expenses:  m.Expenses.String(),
+			Recurring: m.Recurring.String(),
+			OneTime:   m.OneTime.String(),
+			Total:     m.Total.String(),
+		}
+	}
+	categories := make([]model.CategorySeries, len(s.Categories))
+	for i, cs := range s.Categories {
+		values := make([]string, len(cs.Values))
+		for j, v := range cs.Values {
+			values[j] = v.String()
+		}
+		categories[i] = model.CategorySeries{
+			CategoryID:   cs.CategoryID,
+			CategoryName: cs.CategoryName,
+			Values:       values,
+			Total:        cs.Total.String(),
+			Average:      cs.Average.String(),
+		}
+	}
+	return &model.RangeSummary{
+		HouseholdID:     s.HouseholdID,
+		From:            s.From,
+		To:              s.To,
+		Months:          months,
+		Categories:      categories,
+		TotalIncome:     s.TotalIncome.String(),
+		TotalExpenses:   s.TotalExpenses.String(),
+		Total:           s.Total.String(),
+		AverageIncome:   s.AverageIncome.String(),
+		AverageExpenses: s.AverageExpenses.String(),
+		AverageTotal:    s.AverageTotal.String(),
+	}
+}
+
+func toGQLPeriodComparison(cmp *domain.PeriodComparison) *model.PeriodComparison {
+	return &model.PeriodComparison{
+		HouseholdID:        cmp.HouseholdID,
+		Base:               cmp.Base,
+		Current:            cmp.Current,
+		Income:             toGQLAmountDelta(cmp.Income),
+		Expenses:           toGQLAmountDelta(cmp.Expenses),
+		Total:              toGQLAmountDelta(cmp.Total),
+		Categories:         toGQLCategoryComparisons(cmp.Categories),
+		NewCategories:      toGQLCategoryComparisons(cmp.NewCategories),
+		VanishedCategories: toGQLCategoryComparisons(cmp.VanishedCategories),
+	}
+}
+
+func toGQLTaxSummary(s *domain.TaxSummary) *model.TaxSummary {
+	classes := make([]model.TaxClassSummary, len(s.Classes))
+	for i, cs := range s.Classes {
+		transactions := make([]model.TaxTransaction, len(cs.Transactions))
+		for j, tx := range cs.Transactions {
+			transactions[j] = model.TaxTransaction{
+				TransactionID: tx.TransactionID,
+				Date:          tx.Date.Format("2006-01-02"),
+				CategoryID:    tx.CategoryID,
+				CategoryName:  tx.CategoryName,
+				Description:   tx.Description,
+				Amount:        tx.Amount.String(),
+				Inherited:     tx.Inherited,
+			}
+		}
+		occurrences := make([]model.TaxRecurringOccurrence, len(cs.Occurrences))
+		for j, o := range cs.Occurrences {
+			occurrences[j] = model.TaxRecurringOccurrence{
+				RecurringExpenseID: o.RecurringExpenseID,
+				Name:               o.Name,
+				CategoryID:         o.CategoryID,
+				CategoryName:       o.CategoryName,
+				Date:               o.Date.Format("2006-01-02"),
+				Amount:             o.Amount.String(),
+				Frequency:          string(o.Frequency),
+			}
+		}
+		classes[i] = model.TaxClassSummary{
+			Class:                string(cs.Class),
+			OneTime:              cs.OneTime.String(),
+			Recurring:            cs.Recurring.String(),
+			Total:                cs.Total.String(),
+			Transactions:         transactions,
+			RecurringOccurrences: occurrences,
+		}
+	}
+
+	return &model.TaxSummary{
+		HouseholdID: s.HouseholdID,
+		Year:        s.Year,
+		Classes:     classes,
+		Total:       s.Total.String(),
+	}
+}
+
+func toGQLCategoryComparisons(list []domain.CategoryComparison) []model.CategoryComparison {
+	result := make([]model.CategoryComparison, len(list))
+	for i, cc := range list {
+		result[i] = model.CategoryComparison{
+			CategoryID:   cc.CategoryID,
+			CategoryName: cc.CategoryName,
+			Status:       string(cc.Status),
+			Recurring:    toGQLAmountDelta(cc.Recurring),
+			OneTime:      toGQLAmountDelta(cc.OneTime),
+			Total:        toGQLAmountDelta(cc.Total),
+		}
+	}
+	return result
+}
+
+func toGQLAmountDelta(d domain.AmountDelta) *model.AmountDelta {
+	resp := &model.AmountDelta{
+		Base:    d.Base.String(),
+		Current: d.Current.String(),
+		Delta:   d.Delta.String(),
+	}
+	if d.Percent != nil {
+		p := d.Percent.String()
+		resp.Percent = &p
+	}
+	return resp
+}
+
 func toGQLScheduleOverride(o *domain.RecurringScheduleOverride) *model.ScheduleOverride {
 	return &model.ScheduleOverride{
 		ID:                 o.ID,
@@ -104,6 +325,24 @@ func toGQLScheduleOverride(o *domain.RecurringScheduleOverride) *model.ScheduleO
 	}
 }
 
+func toGQLRevision(r *domain.Revision) *model.Revision {
+	changes := r.Changes()
+	rev := &model.Revision{
+		ID:         r.ID,
+		EntityType: string(r.EntityType),
+		EntityID:   r.EntityID,
+		Action:     string(r.Action),
+		UserID:     r.UserID,
+		UserName:   r.UserName,
+		Changes:    make([]model.RevisionChange, len(changes)),
+		CreatedAt:  r.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	for i, ch := range changes {
+		rev.Changes[i] = model.RevisionChange{Field: ch.Field, Before: ch.Before, After: ch.After}
+	}
+	return rev
+}
+
 func derefString(s *string) string {
 	if s == nil {
 		return ""
@@ -2,15 +2,46 @@
 
 package model
 
+type AmountDelta struct {
+	Base    string  `json:"base"`
+	Current string  `json:"current"`
+	Delta   string  `json:"delta"`
+	Percent *string `json:"percent,omitempty"`
+}
+
+type Balances struct {
+	HouseholdID int             `json:"householdID"`
+	Members     []MemberBalance `json:"members"`
+	Transfers   []Transfer      `json:"transfers"`
+}
+
 type Category struct {
 	ID          int    `json:"id"`
 	HouseholdID int    `json:"householdID"`
 	Name        string `json:"name"`
 	Icon        string `json:"icon"`
+	TaxClass    string `json:"taxClass"`
 	CreatedAt   string `json:"createdAt"`
 	UpdatedAt   string `json:"updatedAt"`
 }
 
+type CategoryComparison struct {
+	CategoryID   int          `json:"categoryID"`
+	CategoryName string       `json:"categoryName"`
+	Status       string       `json:"status"`
+	Recurring    *AmountDelta `json:"recurring"`
+	OneTime      *AmountDelta `json:"oneTime"`
+	Total        *AmountDelta `json:"total"`
+}
+
+type CategorySeries struct {
+	CategoryID   int      `json:"categoryID"`
+	CategoryName string   `json:"categoryName"`
+	Values       []string `json:"values"`
+	Total        string   `json:"total"`
+	Average      string   `json:"average"`
+}
+
 type CategorySummary struct {
 	CategoryID   int    `json:"categoryID"`
 	CategoryName string `json:"categoryName"`
@@ -23,6 +54,7 @@ type CreateCategoryInput struct {
 	HouseholdID int     `json:"householdID"`
 	Name        string  `json:"name"`
 	Icon        *string `json:"icon,omitempty"`
+	TaxClass    *string `json:"taxClass,omitempty"`
 }
 
 type CreateHouseholdInput struct {
@@ -32,16 +64,24 @@ type CreateHouseholdInput struct {
 	Icon        *string `json:"icon,omitempty"`
 }
 
+type CreateMemberInput struct {
+	HouseholdID int    `json:"householdID"`
+	Name        string `json:"name"`
+}
+
 type CreateRecurringExpenseInput struct {
-	HouseholdID int     `json:"householdID"`
-	CategoryID  int     `json:"categoryID"`
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
-	Details     *string `json:"details,omitempty"`
-	Amount      string  `json:"amount"`
-	Frequency   string  `json:"frequency"`
-	StartDate   string  `json:"startDate"`
-	EndDate     *string `json:"endDate,omitempty"`
+	HouseholdID    int               `json:"householdID"`
+	CategoryID     int               `json:"categoryID"`
+	Name           string            `json:"name"`
+	Description    *string           `json:"description,omitempty"`
+	Details        *string           `json:"details,omitempty"`
+	Amount         string            `json:"amount"`
+	Frequency      string            `json:"frequency"`
+	StartDate      string            `json:"startDate"`
+	EndDate        *string           `json:"endDate,omitempty"`
+	PaidByMemberID *int              `json:"paidByMemberID,omitempty"`
+	SplitType      *string           `json:"splitType,omitempty"`
+	Shares         []SplitShareInput `json:"shares,omitempty"`
 }
 
 type CreateScheduleOverrideInput struct {
@@ -52,12 +92,16 @@ type CreateScheduleOverrideInput struct {
 }
 
 type CreateTransactionInput struct {
-	HouseholdID int     `json:"householdID"`
-	CategoryID  int     `json:"categoryID"`
-	Amount      string  `json:"amount"`
-	Description *string `json:"description,omitempty"`
-	Details     *string `json:"details,omitempty"`
-	Date        string  `json:"date"`
+	HouseholdID    int               `json:"householdID"`
+	CategoryID     int               `json:"categoryID"`
+	Amount         string            `json:"amount"`
+	Description    *string           `json:"description,omitempty"`
+	Details        *string           `json:"details,omitempty"`
+	TaxClass       *string           `json:"taxClass,omitempty"`
+	Date           string            `json:"date"`
+	PaidByMemberID *int              `json:"paidByMemberID,omitempty"`
+	SplitType      *string           `json:"splitType,omitempty"`
+	Shares         []SplitShareInput `json:"shares,omitempty"`
 }
 
 type Household struct {
@@ -71,6 +115,31 @@ type Household struct {
 	UpdatedAt   string `json:"updatedAt"`
 }
 
+type Member struct {
+	ID          int    `json:"id"`
+	HouseholdID int    `json:"householdID"`
+	Name        string `json:"name"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+type MemberBalance struct {
+	MemberID   int    `json:"memberID"`
+	MemberName string `json:"memberName"`
+	Paid       string `json:"paid"`
+	Share      string `json:"share"`
+	Balance    string `json:"balance"`
+}
+
+type MonthTotals struct {
+	Month     string `json:"month"`
+	Income    string `json:"income"`
+	Expenses  string `json:"expenses"`
+	Recurring string `json:"recurring"`
+	OneTime   string `json:"oneTime"`
+	Total     string `json:"total"`
+}
+
 type MonthlySummary struct {
 	Month             string            `json:"month"`
 	HouseholdID       int               `json:"householdID"`
@@ -89,23 +158,78 @@ type MonthlySummary struct {
 type Mutation struct {
 }
 
+type PeriodComparison struct {
+	HouseholdID        int                  `json:"householdID"`
+	Base               string               `json:"base"`
+	Current            string               `json:"current"`
+	Income             *AmountDelta         `json:"income"`
+	Expenses           *AmountDelta         `json:"expenses"`
+	Total              *AmountDelta         `json:"total"`
+	Categories         []CategoryComparison `json:"categories"`
+	NewCategories      []CategoryComparison `json:"newCategories"`
+	VanishedCategories []CategoryComparison `json:"vanishedCategories"`
+}
+
 type Query struct {
 }
 
+type RangeSummary struct {
+	HouseholdID     int              `json:"householdID"`
+	From            string           `json:"from"`
+	To              string           `json:"to"`
+	Months          []MonthTotals    `json:"months"`
+	Categories      []CategorySeries `json:"categories"`
+	TotalIncome     string           `json:"totalIncome"`
+	TotalExpenses   string           `json:"totalExpenses"`
+	Total           string           `json:"total"`
+	AverageIncome   string           `json:"averageIncome"`
+	AverageExpenses string           `json:"averageExpenses"`
+	AverageTotal    string           `json:"averageTotal"`
+}
+
+type RecordSettlementInput struct {
+	HouseholdID  int     `json:"householdID"`
+	FromMemberID int     `json:"fromMemberID"`
+	ToMemberID   int     `json:"toMemberID"`
+	Amount       string  `json:"amount"`
+	Date         string  `json:"date"`
+	Note         *string `json:"note,omitempty"`
+}
+
 type RecurringExpense struct {
-	ID          int     `json:"id"`
-	HouseholdID int     `json:"householdID"`
-	CategoryID  int     `json:"categoryID"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Details     string  `json:"details"`
-	Amount      string  `json:"amount"`
-	Frequency   string  `json:"frequency"`
-	Active      bool    `json:"active"`
-	StartDate   string  `json:"startDate"`
-	EndDate     *string `json:"endDate,omitempty"`
-	CreatedAt   string  `json:"createdAt"`
-	UpdatedAt   string  `json:"updatedAt"`
+	ID             int          `json:"id"`
+	HouseholdID    int          `json:"householdID"`
+	CategoryID     int          `json:"categoryID"`
+	Name           string       `json:"name"`
+	Description    string       `json:"description"`
+	Details        string       `json:"details"`
+	Amount         string       `json:"amount"`
+	Frequency      string       `json:"frequency"`
+	Active         bool         `json:"active"`
+	StartDate      string       `json:"startDate"`
+	EndDate        *string      `json:"endDate,omitempty"`
+	PaidByMemberID *int         `json:"paidByMemberID,omitempty"`
+	SplitType      *string      `json:"splitType,omitempty"`
+	Shares         []SplitShare `json:"shares"`
+	CreatedAt      string       `json:"createdAt"`
+	UpdatedAt      string       `json:"updatedAt"`
+}
+
+type Revision struct {
+	ID         int              `json:"id"`
+	EntityType string           `json:"entityType"`
+	EntityID   int              `json:"entityID"`
+	Action     string           `json:"action"`
+	UserID     *int             `json:"userID,omitempty"`
+	UserName   string           `json:"userName"`
+	Changes    []RevisionChange `json:"changes"`
+	CreatedAt  string           `json:"createdAt"`
+}
+
+type RevisionChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
 }
 
 type ScheduleOverride struct {
@@ -118,22 +242,90 @@ type ScheduleOverride struct {
 	UpdatedAt          string `json:"updatedAt"`
 }
 
+type Settlement struct {
+	ID           int    `json:"id"`
+	HouseholdID  int    `json:"householdID"`
+	FromMemberID int    `json:"fromMemberID"`
+	ToMemberID   int    `json:"toMemberID"`
+	Amount       string `json:"amount"`
+	Date         string `json:"date"`
+	Note         string `json:"note"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type SplitShare struct {
+	MemberID int     `json:"memberID"`
+	Value    *string `json:"value,omitempty"`
+}
+
+type SplitShareInput struct {
+	MemberID int     `json:"memberID"`
+	Value    *string `json:"value,omitempty"`
+}
+
+type TaxClassSummary struct {
+	Class                string                   `json:"class"`
+	OneTime              string                   `json:"oneTime"`
+	Recurring            string                   `json:"recurring"`
+	Total                string                   `json:"total"`
+	Transactions         []TaxTransaction         `json:"transactions"`
+	RecurringOccurrences []TaxRecurringOccurrence `json:"recurringOccurrences"`
+}
+
+type TaxRecurringOccurrence struct {
+	RecurringExpenseID int    `json:"recurringExpenseID"`
+	Name               string `json:"name"`
+	CategoryID         int    `json:"categoryID"`
+	CategoryName       string `json:"categoryName"`
+	Date               string `json:"date"`
+	Amount             string `json:"amount"`
+	Frequency          string `json:"frequency"`
+}
+
+type TaxSummary struct {
+	HouseholdID int               `json:"householdID"`
+	Year        int               `json:"year"`
+	Classes     []TaxClassSummary `json:"classes"`
+	Total       string            `json:"total"`
+}
+
+type TaxTransaction struct {
+	TransactionID int    `json:"transactionID"`
+	Date          string `json:"date"`
+	CategoryID    int    `json:"categoryID"`
+	CategoryName  string `json:"categoryName"`
+	Description   string `json:"description"`
+	Amount        string `json:"amount"`
+	Inherited     bool   `json:"inherited"`
+}
+
 type Transaction struct {
-	ID          int    `json:"id"`
-	HouseholdID int    `json:"householdID"`
-	CategoryID  int    `json:"categoryID"`
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
-	Details     string `json:"details"`
-	Date        string `json:"date"`
-	CreatedAt   string `json:"createdAt"`
-	UpdatedAt   string `json:"updatedAt"`
+	ID             int          `json:"id"`
+	HouseholdID    int          `json:"householdID"`
+	CategoryID     int          `json:"categoryID"`
+	Amount         string       `json:"amount"`
+	Description    string       `json:"description"`
+	Details        string       `json:"details"`
+	TaxClass       string       `json:"taxClass"`
+	Date           string       `json:"date"`
+	PaidByMemberID *int         `json:"paidByMemberID,omitempty"`
+	SplitType      *string      `json:"splitType,omitempty"`
+	Shares         []SplitShare `json:"shares"`
+	CreatedAt      string       `json:"createdAt"`
+	UpdatedAt      string       `json:"updatedAt"`
+}
+
+type Transfer struct {
+	FromMemberID int    `json:"fromMemberID"`
+	ToMemberID   int    `json:"toMemberID"`
+	Amount       string `json:"amount"`
 }
 
 type UpdateCategoryInput struct {
-	ID   int     `json:"id"`
-	Name string  `json:"name"`
-	Icon *string `json:"icon,omitempty"`
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Icon     *string `json:"icon,omitempty"`
+	TaxClass *string `json:"taxClass,omitempty"`
 }
 
 type UpdateHouseholdInput struct {
@@ -144,17 +336,26 @@ type UpdateHouseholdInput struct {
 	Icon        *string `json:"icon,omitempty"`
 }
 
+type UpdateMemberInput struct {
+	ID          int    `json:"id"`
+	HouseholdID int    `json:"householdID"`
+	Name        string `json:"name"`
+}
+
 type UpdateRecurringExpenseInput struct {
-	ID          int     `json:"id"`
-	CategoryID  int     `json:"categoryID"`
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
-	Details     *string `json:"details,omitempty"`
-	Amount      string  `json:"amount"`
-	Frequency   string  `json:"frequency"`
-	Active      bool    `json:"active"`
-	StartDate   string  `json:"startDate"`
-	EndDate     *string `json:"endDate,omitempty"`
+	ID             int               `json:"id"`
+	CategoryID     int               `json:"categoryID"`
+	Name           string            `json:"name"`
+	Description    *string           `json:"description,omitempty"`
+	Details        *string           `json:"details,omitempty"`
+	Amount         string            `json:"amount"`
+	Frequency      string            `json:"frequency"`
+	Active         bool              `json:"active"`
+	StartDate      string            `json:"startDate"`
+	EndDate        *string           `json:"endDate,omitempty"`
+	PaidByMemberID *int              `json:"paidByMemberID,omitempty"`
+	SplitType      *string           `json:"splitType,omitempty"`
+	Shares         []SplitShareInput `json:"shares,omitempty"`
 }
 
 type UpdateScheduleOverrideInput struct {
@@ -165,11 +366,15 @@ type UpdateScheduleOverrideInput struct {
 }
 
 type UpdateTransactionInput struct {
-	ID          int     `json:"id"`
-	HouseholdID int     `json:"householdID"`
-	CategoryID  int     `json:"categoryID"`
-	Amount      string  `json:"amount"`
-	Description *string `json:"description,omitempty"`
-	Details     *string `json:"details,omitempty"`
-	Date        string  `json:"date"`
+	ID             int               `json:"id"`
+	HouseholdID    int               `json:"householdID"`
+	CategoryID     int               `json:"categoryID"`
+	Amount         string            `json:"amount"`
+	Description    *string           `json:"description,omitempty"`
+	Details        *string           `json:"details,omitempty"`
+	TaxClass       *string           `json:"taxClass,omitempty"`
+	Date           string            `json:"date"`
+	PaidByMemberID *int              `json:"paidByMemberID,omitempty"`
+	SplitType      *string           `json:"splitType,omitempty"`
+	Shares         []SplitShareInput `json:"shares,omitempty"`
 }
@@ -8,5 +8,8 @@ type Resolver struct {
 	CategorySvc         *service.CategoryService
 	TransactionSvc      *service.TransactionService
 	RecurringExpenseSvc *service.RecurringExpenseService
+	MemberSvc           *service.MemberService
+	SettlementSvc       *service.SettlementService
 	SummarySvc          *service.SummaryService
+	RevisionSvc         *service.RevisionService
 }
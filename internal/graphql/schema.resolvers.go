@@ -34,7 +34,7 @@ func (r *mutationResolver) UpdateHousehold(ctx context.Context, input model.Upda
 
 // CreateCategory is the resolver for the createCategory field.
 func (r *mutationResolver) CreateCategory(ctx context.Context, input model.CreateCategoryInput) (*model.Category, error) {
-	cat, err := r.CategorySvc.Create(ctx, input.HouseholdID, input.Name, derefString(input.Icon))
+	cat, err := r.CategorySvc.Create(ctx, input.HouseholdID, input.Name, derefString(input.Icon), domain.TaxClass(derefString(input.TaxClass)))
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ func (r *mutationResolver) CreateCategory(ctx context.Context, input model.Creat
 
 // UpdateCategory is the resolver for the updateCategory field.
 func (r *mutationResolver) UpdateCategory(ctx context.Context, input model.UpdateCategoryInput) (*model.Category, error) {
-	cat, err := r.CategorySvc.Update(ctx, input.ID, input.Name, derefString(input.Icon))
+	cat, err := r.CategorySvc.Update(ctx, input.ID, input.Name, derefString(input.Icon), domain.TaxClass(derefString(input.TaxClass)))
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +62,12 @@ func (r *mutationResolver) CreateTransaction(ctx context.Context, input model.Cr
 		return nil, fmt.Errorf("%w: invalid date format, expected YYYY-MM-DD", domain.ErrValidation)
 	}
 
-	tx, err := r.TransactionSvc.Create(ctx, input.HouseholdID, input.CategoryID, amount, derefString(input.Description), derefString(input.Details), date)
+	split, err := splitFromInput(input.PaidByMemberID, input.SplitType, input.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.TransactionSvc.Create(ctx, input.HouseholdID, input.CategoryID, amount, derefString(input.Description), derefString(input.Details), domain.TaxClass(derefString(input.TaxClass)), date, split)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +86,12 @@ func (r *mutationResolver) UpdateTransaction(ctx context.Context, input model.Up
 		return nil, fmt.Errorf("%w: invalid date format, expected YYYY-MM-DD", domain.ErrValidation)
 	}
 
-	tx, err := r.TransactionSvc.Update(ctx, input.HouseholdID, input.ID, input.CategoryID, amount, derefString(input.Description), derefString(input.Details), date)
+	split, err := splitFromInput(input.PaidByMemberID, input.SplitType, input.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.TransactionSvc.Update(ctx, input.HouseholdID, input.ID, input.CategoryID, amount, derefString(input.Description), derefString(input.Details), domain.TaxClass(derefString(input.TaxClass)), date, split)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +124,12 @@ func (r *mutationResolver) CreateRecurringExpense(ctx context.Context, input mod
 		endDate = &t
 	}
 
-	re, err := r.RecurringExpenseSvc.Create(ctx, input.HouseholdID, input.CategoryID, input.Name, derefString(input.Description), derefString(input.Details), amount, freq, startDate, endDate)
+	split, err := splitFromInput(input.PaidByMemberID, input.SplitType, input.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := r.RecurringExpenseSvc.Create(ctx, input.HouseholdID, input.CategoryID, input.Name, derefString(input.Description), derefString(input.Details), amount, freq, startDate, endDate, split)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +162,12 @@ func (r *mutationResolver) UpdateRecurringExpense(ctx context.Context, input mod
 		endDate = &t
 	}
 
-	re, err := r.RecurringExpenseSvc.Update(ctx, input.ID, input.CategoryID, input.Name, derefString(input.Description), derefString(input.Details), amount, freq, input.Active, startDate, endDate)
+	split, err := splitFromInput(input.PaidByMemberID, input.SplitType, input.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := r.RecurringExpenseSvc.Update(ctx, input.ID, input.CategoryID, input.Name, derefString(input.Description), derefString(input.Details), amount, freq, input.Active, startDate, endDate, split)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +230,59 @@ func (r *mutationResolver) DeleteScheduleOverride(ctx context.Context, id int) (
 	return true, nil
 }
 
+// CreateMember is the resolver for the createMember field.
+func (r *mutationResolver) CreateMember(ctx context.Context, input model.CreateMemberInput) (*model.Member, error) {
+	member, err := r.MemberSvc.Create(ctx, input.HouseholdID, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toGQLMember(member), nil
+}
+
+// UpdateMember is the resolver for the updateMember field.
+func (r *mutationResolver) UpdateMember(ctx context.Context, input model.UpdateMemberInput) (*model.Member, error) {
+	member, err := r.MemberSvc.Update(ctx, input.HouseholdID, input.ID, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toGQLMember(member), nil
+}
+
+// DeleteMember is the resolver for the deleteMember field.
+func (r *mutationResolver) DeleteMember(ctx context.Context, householdID int, id int) (bool, error) {
+	if err := r.MemberSvc.Delete(ctx, householdID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RecordSettlement is the resolver for the recordSettlement field.
+func (r *mutationResolver) RecordSettlement(ctx context.Context, input model.RecordSettlementInput) (*model.Settlement, error) {
+	amount, err := domain.NewMoney(input.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid amount", domain.ErrValidation)
+	}
+
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid date format, expected YYYY-MM-DD", domain.ErrValidation)
+	}
+
+	settlement, err := r.SettlementSvc.Record(ctx, input.HouseholdID, input.FromMemberID, input.ToMemberID, amount, date, derefString(input.Note))
+	if err != nil {
+		return nil, err
+	}
+	return toGQLSettlement(settlement), nil
+}
+
+// DeleteSettlement is the resolver for the deleteSettlement field.
+func (r *mutationResolver) DeleteSettlement(ctx context.Context, householdID int, id int) (bool, error) {
+	if err := r.SettlementSvc.Delete(ctx, householdID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Households is the resolver for the households field.
 func (r *queryResolver) Households(ctx context.Context) ([]model.Household, error) {
 	households, err := r.HouseholdSvc.List(ctx)
@@ -248,13 +321,25 @@ func (r *queryResolver) Categories(ctx context.Context, householdID int) ([]mode
 }
 
 // Transactions is the resolver for the transactions field.
-func (r *queryResolver) Transactions(ctx context.Context, householdID int, month string) ([]model.Transaction, error) {
+func (r *queryResolver) Transactions(ctx context.Context, householdID int, month string, minAmount *string, maxAmount *string) ([]model.Transaction, error) {
 	t, err := time.Parse("2006-01", month)
 	if err != nil {
 		return nil, fmt.Errorf("%w: invalid month format, expected YYYY-MM", domain.ErrValidation)
 	}
 
-	transactions, err := r.TransactionSvc.ListByMonth(ctx, householdID, t.Year(), t.Month())
+	var min, max string
+	if minAmount != nil {
+		min = *minAmount
+	}
+	if maxAmount != nil {
+		max = *maxAmount
+	}
+	filter, err := domain.NewAmountFilter(min, max)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := r.TransactionSvc.ListByMonth(ctx, householdID, t.Year(), t.Month(), filter)
 	if err != nil {
 		return nil, err
 	}
@@ -294,6 +379,54 @@ func (r *queryResolver) MonthlySummary(ctx context.Context, householdID int, mon
 	return toGQLMonthlySummary(summary), nil
 }
 
+// RangeSummary is the resolver for the rangeSummary field.
+func (r *queryResolver) RangeSummary(ctx context.Context, householdID int, from string, to string) (*model.RangeSummary, error) {
+	fromMonth, err := time.Parse("2006-01", from)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid from format, expected YYYY-MM", domain.ErrValidation)
+	}
+	toMonth, err := time.Parse("2006-01", to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid to format, expected YYYY-MM", domain.ErrValidation)
+	}
+
+	summary, err := r.SummarySvc.GetRangeSummary(ctx, householdID, fromMonth, toMonth)
+	if err != nil {
+		return nil, err
+	}
+	return toGQLRangeSummary(summary), nil
+}
+
+// PeriodComparison is the resolver for the periodComparison field.
+func (r *queryResolver) PeriodComparison(ctx context.Context, householdID int, current string, base *string) (*model.PeriodComparison, error) {
+	currentPeriod, err := domain.ParsePeriod(current)
+	if err != nil {
+		return nil, err
+	}
+	basePeriod := currentPeriod.PreviousYear()
+	if base != nil && *base != "" {
+		basePeriod, err = domain.ParsePeriod(*base)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cmp, err := r.SummarySvc.ComparePeriods(ctx, householdID, basePeriod, currentPeriod)
+	if err != nil {
+		return nil, err
+	}
+	return toGQLPeriodComparison(cmp), nil
+}
+
+// TaxSummary is the resolver for the taxSummary field.
+func (r *queryResolver) TaxSummary(ctx context.Context, householdID int, year int) (*model.TaxSummary, error) {
+	summary, err := r.SummarySvc.GetTaxSummary(ctx, householdID, year)
+	if err != nil {
+		return nil, err
+	}
+	return toGQLTaxSummary(summary), nil
+}
+
 // ScheduleOverrides is the resolver for the scheduleOverrides field.
 func (r *queryResolver) ScheduleOverrides(ctx context.Context, recurringExpenseID int) ([]model.ScheduleOverride, error) {
 	overrides, err := r.RecurringExpenseSvc.ListOverrides(ctx, recurringExpenseID)
@@ -308,6 +441,57 @@ func (r *queryResolver) ScheduleOverrides(ctx context.Context, recurringExpenseI
 	return result, nil
 }
 
+// Members is the resolver for the members field.
+func (r *queryResolver) Members(ctx context.Context, householdID int) ([]model.Member, error) {
+	members, err := r.MemberSvc.List(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.Member, len(members))
+	for i, m := range members {
+		result[i] = *toGQLMember(m)
+	}
+	return result, nil
+}
+
+// Balances is the resolver for the balances field.
+func (r *queryResolver) Balances(ctx context.Context, householdID int) (*model.Balances, error) {
+	balances, err := r.SettlementSvc.Balances(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	return toGQLBalances(balances), nil
+}
+
+// Settlements is the resolver for the settlements field.
+func (r *queryResolver) Settlements(ctx context.Context, householdID int) ([]model.Settlement, error) {
+	settlements, err := r.SettlementSvc.List(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.Settlement, len(settlements))
+	for i, st := range settlements {
+		result[i] = *toGQLSettlement(st)
+	}
+	return result, nil
+}
+
+// History is the resolver for the history field.
+func (r *queryResolver) History(ctx context.Context, householdID int, entityType string, entityID int) ([]model.Revision, error) {
+	revisions, err := r.RevisionSvc.History(ctx, householdID, domain.EntityType(entityType), entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.Revision, len(revisions))
+	for i, rev := range revisions {
+		result[i] = *toGQLRevision(rev)
+	}
+	return result, nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
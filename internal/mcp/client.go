@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -126,6 +127,7 @@ type Category struct {
 	HouseholdID int    `json:"household_id"`
 	Name        string `json:"name"`
 	Icon        string `json:"icon"`
+	TaxClass    string `json:"tax_class"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
@@ -162,21 +164,37 @@ func (c *Client) DeleteCategory(householdID, categoryID int) error {
 // --- Transaction endpoints ---
 
 type Transaction struct {
-	ID          int    `json:"id"`
-	HouseholdID int    `json:"household_id"`
-	CategoryID  int    `json:"category_id"`
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
-	Details     string `json:"details"`
-	Date        string `json:"date"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
-}
-
-func (c *Client) ListTransactions(householdID int, month string) ([]Transaction, error) {
-	path := fmt.Sprintf("/api/v1/households/%d/transactions", householdID)
+	ID             int          `json:"id"`
+	HouseholdID    int          `json:"household_id"`
+	CategoryID     int          `json:"category_id"`
+	Amount         string       `json:"amount"`
+	Description    string       `json:"description"`
+	Details        string       `json:"details"`
+	TaxClass       string       `json:"tax_class"`
+	Date           string       `json:"date"`
+	PaidByMemberID *int         `json:"paid_by_member_id"`
+	SplitType      string       `json:"split_type,omitempty"`
+	Shares         []SplitShare `json:"shares,omitempty"`
+	CreatedAt      string       `json:"created_at"`
+	UpdatedAt      string       `json:"updated_at"`
+}
+
+// ListTransactions lists the transactions of a month. minAmount and maxAmount
+// are optional inclusive bounds; empty strings leave them open.
+func (c *Client) ListTransactions(householdID int, month, minAmount, maxAmount string) ([]Transaction, error) {
+	q := url.Values{}
 	if month != "" {
-		path += "?month=" + month
+		q.Set("month", month)
+	}
+	if minAmount != "" {
+		q.Set("min_amount", minAmount)
+	}
+	if maxAmount != "" {
+		q.Set("max_amount", maxAmount)
+	}
+	path := fmt.Sprintf("/api/v1/households/%d/transactions", householdID)
+	if len(q) > 0 {
+		path += "?" + q.Encode()
 	}
 	data, err := c.do("GET", path, nil)
 	if err != nil {
@@ -209,19 +227,22 @@ func (c *Client) DeleteTransaction(householdID, transactionID int) error {
 // --- Recurring Expense endpoints ---
 
 type RecurringExpense struct {
-	ID          int     `json:"id"`
-	HouseholdID int     `json:"household_id"`
-	CategoryID  int     `json:"category_id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Details     string  `json:"details"`
-	Amount      string  `json:"amount"`
-	Frequency   string  `json:"frequency"`
-	Active      bool    `json:"active"`
-	StartDate   string  `json:"start_date"`
-	EndDate     *string `json:"end_date,omitempty"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	ID             int          `json:"id"`
+	HouseholdID    int          `json:"household_id"`
+	CategoryID     int          `json:"category_id"`
+	Name           string       `json:"name"`
+	Description    string       `json:"description"`
+	Details        string       `json:"details"`
+	Amount         string       `json:"amount"`
+	Frequency      string       `json:"frequency"`
+	Active         bool         `json:"active"`
+	StartDate      string       `json:"start_date"`
+	EndDate        *string      `json:"end_date,omitempty"`
+	PaidByMemberID *int         `json:"paid_by_member_id"`
+	SplitType      string       `json:"split_type,omitempty"`
+	Shares         []SplitShare `json:"shares,omitempty"`
+	CreatedAt      string       `json:"created_at"`
+	UpdatedAt      string       `json:"updated_at"`
 }
 
 func (c *Client) ListRecurringExpenses(householdID int) ([]RecurringExpense, error) {
@@ -294,6 +315,84 @@ func (c *Client) DeleteScheduleOverride(householdID, recurringID, overrideID int
 	return err
 }
 
+// --- Member & settlement endpoints ---
+
+type SplitShare struct {
+	MemberID int    `json:"member_id"`
+	Value    string `json:"value,omitempty"`
+}
+
+type Member struct {
+	ID          int    `json:"id"`
+	HouseholdID int    `json:"household_id"`
+	Name        string `json:"name"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type Settlement struct {
+	ID           int    `json:"id"`
+	HouseholdID  int    `json:"household_id"`
+	FromMemberID int    `json:"from_member_id"`
+	ToMemberID   int    `json:"to_member_id"`
+	Amount       string `json:"amount"`
+	Date         string `json:"date"`
+	Note         string `json:"note"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type MemberBalance struct {
+	MemberID   int    `json:"member_id"`
+	MemberName string `json:"member_name"`
+	Paid       string `json:"paid"`
+	Share      string `json:"share"`
+	Balance    string `json:"balance"`
+}
+
+type Transfer struct {
+	FromMemberID int    `json:"from_member_id"`
+	ToMemberID   int    `json:"to_member_id"`
+	Amount       string `json:"amount"`
+}
+
+type Balances struct {
+	HouseholdID int             `json:"household_id"`
+	Members     []MemberBalance `json:"members"`
+	Transfers   []Transfer      `json:"transfers"`
+}
+
+func (c *Client) ListMembers(householdID int) ([]Member, error) {
+	data, err := c.do("GET", fmt.Sprintf("/api/v1/households/%d/members", householdID), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decode[[]Member](data)
+}
+
+func (c *Client) CreateMember(householdID int, req map[string]any) (*Member, error) {
+	data, err := c.do("POST", fmt.Sprintf("/api/v1/households/%d/members", householdID), req)
+	if err != nil {
+		return nil, err
+	}
+	return decodePtr[Member](data)
+}
+
+func (c *Client) GetBalances(householdID int) (*Balances, error) {
+	data, err := c.do("GET", fmt.Sprintf("/api/v1/households/%d/balances", householdID), nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodePtr[Balances](data)
+}
+
+func (c *Client) RecordSettlement(householdID int, req map[string]any) (*Settlement, error) {
+	data, err := c.do("POST", fmt.Sprintf("/api/v1/households/%d/settlements", householdID), req)
+	if err != nil {
+		return nil, err
+	}
+	return decodePtr[Settlement](data)
+}
+
 // --- Summary endpoint ---
 
 type Summary struct {
@@ -331,6 +430,141 @@ func (c *Client) GetSummary(householdID int, month string) (*Summary, error) {
 	return decodePtr[Summary](data)
 }
 
+type RangeSummary struct {
+	HouseholdID     int              `json:"household_id"`
+	From            string           `json:"from"`
+	To              string           `json:"to"`
+	Months          []MonthTotals    `json:"months"`
+	Categories      []CategorySeries `json:"categories"`
+	TotalIncome     string           `json:"total_income"`
+	TotalExpenses   string           `json:"total_expenses"`
+	Total           string           `json:"total"`
+	AverageIncome   string           `json:"average_income"`
+	AverageExpenses string           `json:"average_expenses"`
+	AverageTotal    string           `json:"average_total"`
+}
+
+type MonthTotals struct {
+	Month     string `json:"month"`
+	Income    string `json:"income"`
+	Expenses  string `json:"expenses"`
+	Recurring string `json:"recurring"`
+	OneTime   string `json:"one_time"`
+	Total     string `json:"total"`
+}
+
+type CategorySeries struct {
+	CategoryID   int      `json:"category_id"`
+	CategoryName string   `json:"category_name"`
+	Values       []string `json:"values"`
+	Total        string   `json:"total"`
+	Average      string   `json:"average"`
+}
+
+func (c *Client) GetRangeSummary(householdID int, from, to string) (*RangeSummary, error) {
+	path := fmt.Sprintf("/api/v1/households/%d/summary/range?from=%s&to=%s", householdID, from, to)
+	data, err := c.do("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodePtr[RangeSummary](data)
+}
+
+type PeriodComparison struct {
+	HouseholdID        int                  `json:"household_id"`
+	Base               string               `json:"base"`
+	Current            string               `json:"current"`
+	Income             AmountDelta          `json:"income"`
+	Expenses           AmountDelta          `json:"expenses"`
+	Total              AmountDelta          `json:"total"`
+	Categories         []CategoryComparison `json:"categories"`
+	NewCategories      []CategoryComparison `json:"new_categories"`
+	VanishedCategories []CategoryComparison `json:"vanished_categories"`
+}
+
+type CategoryComparison struct {
+	CategoryID   int         `json:"category_id"`
+	CategoryName string      `json:"category_name"`
+	Status       string      `json:"status"`
+	Recurring    AmountDelta `json:"recurring"`
+	OneTime      AmountDelta `json:"one_time"`
+	Total        AmountDelta `json:"total"`
+}
+
+type AmountDelta struct {
+	Base    string  `json:"base"`
+	Current string  `json:"current"`
+	Delta   string  `json:"delta"`
+	Percent *string `json:"percent"`
+}
+
+func (c *Client) ComparePeriods(householdID int, base, current string) (*PeriodComparison, error) {
+	q := url.Values{}
+	if base != "" {
+		q.Set("base", base)
+	}
+	if current != "" {
+		q.Set("current", current)
+	}
+	path := fmt.Sprintf("/api/v1/households/%d/summary/compare", householdID)
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+	data, err := c.do("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodePtr[PeriodComparison](data)
+}
+
+type TaxSummary struct {
+	HouseholdID int               `json:"household_id"`
+	Year        int               `json:"year"`
+	Classes     []TaxClassSummary `json:"classes"`
+	Total       string            `json:"total"`
+}
+
+type TaxClassSummary struct {
+	Class        string                   `json:"class"`
+	OneTime      string                   `json:"one_time"`
+	Recurring    string                   `json:"recurring"`
+	Total        string                   `json:"total"`
+	Transactions []TaxTransaction         `json:"transactions"`
+	Occurrences  []TaxRecurringOccurrence `json:"recurring_occurrences"`
+}
+
+type TaxTransaction struct {
+	TransactionID int    `json:"transaction_id"`
+	Date          string `json:"date"`
+	CategoryID    int    `json:"category_id"`
+	CategoryName  string `json:"category_name"`
+	Description   string `json:"description"`
+	Amount        string `json:"amount"`
+	Inherited     bool   `json:"inherited"`
+}
+
+type TaxRecurringOccurrence struct {
+	RecurringExpenseID int    `json:"recurring_expense_id"`
+	Name               string `json:"name"`
+	CategoryID         int    `json:"category_id"`
+	CategoryName       string `json:"category_name"`
+	Date               string `json:"date"`
+	Amount             string `json:"amount"`
+	Frequency          string `json:"frequency"`
+}
+
+func (c *Client) GetTaxSummary(householdID, year int) (*TaxSummary, error) {
+	path := fmt.Sprintf("/api/v1/households/%d/summary/tax", householdID)
+	if year != 0 {
+		path += fmt.Sprintf("?year=%d", year)
+	}
+	data, err := c.do("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return decodePtr[TaxSummary](data)
+}
+
 func decodePtr[T any](data []byte) (*T, error) {
 	var result T
 	if len(data) == 0 {
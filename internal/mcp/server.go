@@ -30,6 +30,7 @@ func NewServer(client *Client, version string) *Server {
 	s.registerTransactionTools()
 	s.registerRecurringExpenseTools()
 	s.registerScheduleOverrideTools()
+	s.registerMemberTools()
 	s.registerSummaryTools()
 	s.registerResources()
 	s.registerPrompts()
@@ -60,6 +61,7 @@ type createHouseholdArgs struct {
 	Description string `json:"description,omitempty" jsonschema:"Description of the household"`
 	Currency    string `json:"currency" jsonschema:"required,ISO 4217 currency code (e.g. EUR)"`
 	Icon        string `json:"icon,omitempty" jsonschema:"Material icon name"`
+	TaxClass    string `json:"tax_class,omitempty" jsonschema:"Tax classification: craftsman_services, household_services, income_related_expenses, special_expenses or extraordinary_burdens (empty: not tax relevant)"`
 }
 
 type updateHouseholdArgs struct {
@@ -68,6 +70,7 @@ type updateHouseholdArgs struct {
 	Description string `json:"description,omitempty" jsonschema:"New description"`
 	Currency    string `json:"currency,omitempty" jsonschema:"New ISO 4217 currency code"`
 	Icon        string `json:"icon,omitempty" jsonschema:"New material icon name"`
+	TaxClass    string `json:"tax_class,omitempty" jsonschema:"New tax classification (empty: not tax relevant)"`
 }
 
 type deleteHouseholdArgs struct {
@@ -131,6 +134,7 @@ type createCategoryArgs struct {
 	HouseholdID int    `json:"household_id" jsonschema:"required,Household ID"`
 	Name        string `json:"name" jsonschema:"required,Category name"`
 	Icon        string `json:"icon,omitempty" jsonschema:"Material icon name"`
+	TaxClass    string `json:"tax_class,omitempty" jsonschema:"Tax classification: craftsman_services, household_services, income_related_expenses, special_expenses or extraordinary_burdens (empty: not tax relevant)"`
 }
 
 type updateCategoryArgs struct {
@@ -138,6 +142,7 @@ type updateCategoryArgs struct {
 	CategoryID  int    `json:"category_id" jsonschema:"required,Category ID"`
 	Name        string `json:"name,omitempty" jsonschema:"New name"`
 	Icon        string `json:"icon,omitempty" jsonschema:"New material icon name"`
+	TaxClass    string `json:"tax_class,omitempty" jsonschema:"New tax classification (empty: not tax relevant)"`
 }
 
 type deleteCategoryArgs struct {
@@ -200,25 +205,40 @@ func (s *Server) registerCategoryTools() {
 type listTransactionsArgs struct {
 	HouseholdID int    `json:"household_id" jsonschema:"required,Household ID"`
 	Month       string `json:"month,omitempty" jsonschema:"Month in YYYY-MM format (default: current month)"`
+	MinAmount   string `json:"min_amount,omitempty" jsonschema:"Only transactions with an amount of at least this value (e.g. -100.00)"`
+	MaxAmount   string `json:"max_amount,omitempty" jsonschema:"Only transactions with an amount of at most this value (e.g. 0 for expenses only)"`
 }
 
 type createTransactionArgs struct {
-	HouseholdID int    `json:"household_id" jsonschema:"required,Household ID"`
-	CategoryID  int    `json:"category_id" jsonschema:"required,Category ID"`
-	Amount      string `json:"amount" jsonschema:"required,Decimal amount as string (negative=expense positive=income)"`
-	Description string `json:"description" jsonschema:"required,Transaction description"`
-	Details     string `json:"details,omitempty" jsonschema:"Extended description (receipt contents, invoice details, notes)"`
-	Date        string `json:"date" jsonschema:"required,Date in YYYY-MM-DD format"`
+	HouseholdID    int              `json:"household_id" jsonschema:"required,Household ID"`
+	CategoryID     int              `json:"category_id" jsonschema:"required,Category ID"`
+	Amount         string           `json:"amount" jsonschema:"required,Decimal amount as string (negative=expense positive=income)"`
+	Description    string           `json:"description" jsonschema:"required,Transaction description"`
+	Details        string           `json:"details,omitempty" jsonschema:"Extended description (receipt contents, invoice details, notes)"`
+	TaxClass       string           `json:"tax_class,omitempty" jsonschema:"Tax classification overriding the category's (empty: as category, none: not tax relevant)"`
+	Date           string           `json:"date" jsonschema:"required,Date in YYYY-MM-DD format"`
+	PaidByMemberID int              `json:"paid_by_member_id,omitempty" jsonschema:"Household member who paid (omit if the amount isn't shared)"`
+	SplitType      string           `json:"split_type,omitempty" jsonschema:"How the amount is shared: equal (default), percentage or fixed"`
+	Shares         []splitShareArgs `json:"shares,omitempty" jsonschema:"Members sharing the amount with their percentage or fixed amount (equal split without shares: all current members)"`
 }
 
 type updateTransactionArgs struct {
-	HouseholdID   int    `json:"household_id" jsonschema:"required,Household ID"`
-	TransactionID int    `json:"transaction_id" jsonschema:"required,Transaction ID"`
-	CategoryID    int    `json:"category_id,omitempty" jsonschema:"New category ID"`
-	Amount        string `json:"amount,omitempty" jsonschema:"New decimal amount"`
-	Description   string `json:"description,omitempty" jsonschema:"New description"`
-	Details       string `json:"details,omitempty" jsonschema:"New extended description"`
-	Date          string `json:"date,omitempty" jsonschema:"New date in YYYY-MM-DD format"`
+	HouseholdID    int              `json:"household_id" jsonschema:"required,Household ID"`
+	TransactionID  int              `json:"transaction_id" jsonschema:"required,Transaction ID"`
+	CategoryID     int              `json:"category_id,omitempty" jsonschema:"New category ID"`
+	Amount         string           `json:"amount,omitempty" jsonschema:"New decimal amount"`
+	Description    string           `json:"description,omitempty" jsonschema:"New description"`
+	Details        string           `json:"details,omitempty" jsonschema:"New extended description"`
+	TaxClass       string           `json:"tax_class,omitempty" jsonschema:"New tax classification (empty: as category, none: not tax relevant)"`
+	Date           string           `json:"date,omitempty" jsonschema:"New date in YYYY-MM-DD format"`
+	PaidByMemberID int              `json:"paid_by_member_id,omitempty" jsonschema:"Household member who paid (omit if the amount isn't shared)"`
+	SplitType      string           `json:"split_type,omitempty" jsonschema:"How the amount is shared: equal (default), percentage or fixed"`
+	Shares         []splitShareArgs `json:"shares,omitempty" jsonschema:"Members sharing the amount with their percentage or fixed amount (equal split without shares: all current members)"`
+}
+
+type splitShareArgs struct {
+	MemberID int    `json:"member_id" jsonschema:"required,Household member ID"`
+	Value    string `json:"value,omitempty" jsonschema:"Percentage or fixed amount (unused for equal splits)"`
 }
 
 type deleteTransactionArgs struct {
@@ -229,9 +249,9 @@ type deleteTransactionArgs struct {
 func (s *Server) registerTransactionTools() {
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "list_transactions",
-		Description: "List transactions for a household in a given month",
+		Description: "List transactions for a household in a given month, optionally limited to an amount range",
 	}, func(ctx context.Context, req *mcp.CallToolRequest, args listTransactionsArgs) (*mcp.CallToolResult, any, error) {
-		txs, err := s.client.ListTransactions(args.HouseholdID, args.Month)
+		txs, err := s.client.ListTransactions(args.HouseholdID, args.Month, args.MinAmount, args.MaxAmount)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -283,30 +303,36 @@ type listRecurringExpensesArgs struct {
 }
 
 type createRecurringExpenseArgs struct {
-	HouseholdID int     `json:"household_id" jsonschema:"required,Household ID"`
-	CategoryID  int     `json:"category_id" jsonschema:"required,Category ID"`
-	Name        string  `json:"name" jsonschema:"required,Name of the recurring entry"`
-	Description string  `json:"description,omitempty" jsonschema:"Description"`
-	Details     string  `json:"details,omitempty" jsonschema:"Extended description (receipt contents, invoice details, notes)"`
-	Amount      string  `json:"amount" jsonschema:"required,Decimal amount (negative=expense positive=income)"`
-	Frequency   string  `json:"frequency" jsonschema:"required,Frequency: daily|weekday|weekly|biweekly|monthly|quarterly|yearly"`
-	StartDate   string  `json:"start_date" jsonschema:"required,Start date in YYYY-MM-DD format"`
-	EndDate     *string `json:"end_date,omitempty" jsonschema:"End date in YYYY-MM-DD format (empty=indefinite)"`
-	Active      *bool   `json:"active,omitempty" jsonschema:"Whether the entry is active (default: true)"`
+	HouseholdID    int              `json:"household_id" jsonschema:"required,Household ID"`
+	CategoryID     int              `json:"category_id" jsonschema:"required,Category ID"`
+	Name           string           `json:"name" jsonschema:"required,Name of the recurring entry"`
+	Description    string           `json:"description,omitempty" jsonschema:"Description"`
+	Details        string           `json:"details,omitempty" jsonschema:"Extended description (receipt contents, invoice details, notes)"`
+	Amount         string           `json:"amount" jsonschema:"required,Decimal amount (negative=expense positive=income)"`
+	Frequency      string           `json:"frequency" jsonschema:"required,Frequency: daily|weekday|weekly|biweekly|monthly|quarterly|yearly"`
+	StartDate      string           `json:"start_date" jsonschema:"required,Start date in YYYY-MM-DD format"`
+	EndDate        *string          `json:"end_date,omitempty" jsonschema:"End date in YYYY-MM-DD format (empty=indefinite)"`
+	Active         *bool            `json:"active,omitempty" jsonschema:"Whether the entry is active (default: true)"`
+	PaidByMemberID int              `json:"paid_by_member_id,omitempty" jsonschema:"Household member who paid (omit if the amount isn't shared)"`
+	SplitType      string           `json:"split_type,omitempty" jsonschema:"How the amount is shared: equal (default), percentage or fixed"`
+	Shares         []splitShareArgs `json:"shares,omitempty" jsonschema:"Members sharing the amount with their percentage or fixed amount (equal split without shares: all current members)"`
 }
 
 type updateRecurringExpenseArgs struct {
-	HouseholdID int     `json:"household_id" jsonschema:"required,Household ID"`
-	RecurringID int     `json:"recurring_id" jsonschema:"required,Recurring expense ID"`
-	CategoryID  int     `json:"category_id,omitempty" jsonschema:"New category ID"`
-	Name        string  `json:"name,omitempty" jsonschema:"New name"`
-	Description string  `json:"description,omitempty" jsonschema:"New description"`
-	Details     string  `json:"details,omitempty" jsonschema:"New extended description"`
-	Amount      string  `json:"amount,omitempty" jsonschema:"New decimal amount"`
-	Frequency   string  `json:"frequency,omitempty" jsonschema:"New frequency"`
-	Active      *bool   `json:"active,omitempty" jsonschema:"Active status"`
-	StartDate   string  `json:"start_date,omitempty" jsonschema:"New start date"`
-	EndDate     *string `json:"end_date,omitempty" jsonschema:"New end date"`
+	HouseholdID    int              `json:"household_id" jsonschema:"required,Household ID"`
+	RecurringID    int              `json:"recurring_id" jsonschema:"required,Recurring expense ID"`
+	CategoryID     int              `json:"category_id,omitempty" jsonschema:"New category ID"`
+	Name           string           `json:"name,omitempty" jsonschema:"New name"`
+	Description    string           `json:"description,omitempty" jsonschema:"New description"`
+	Details        string           `json:"details,omitempty" jsonschema:"New extended description"`
+	Amount         string           `json:"amount,omitempty" jsonschema:"New decimal amount"`
+	Frequency      string           `json:"frequency,omitempty" jsonschema:"New frequency"`
+	Active         *bool            `json:"active,omitempty" jsonschema:"Active status"`
+	StartDate      string           `json:"start_date,omitempty" jsonschema:"New start date"`
+	EndDate        *string          `json:"end_date,omitempty" jsonschema:"New end date"`
+	PaidByMemberID int              `json:"paid_by_member_id,omitempty" jsonschema:"Household member who paid (omit if the amount isn't shared)"`
+	SplitType      string           `json:"split_type,omitempty" jsonschema:"How the amount is shared: equal (default), percentage or fixed"`
+	Shares         []splitShareArgs `json:"shares,omitempty" jsonschema:"Members sharing the amount with their percentage or fixed amount (equal split without shares: all current members)"`
 }
 
 type deleteRecurringExpenseArgs struct {
@@ -446,6 +472,80 @@ func (s *Server) registerScheduleOverrideTools() {
 	})
 }
 
+// --- Member & Settlement Tools ---
+
+type listMembersArgs struct {
+	HouseholdID int `json:"household_id" jsonschema:"required,Household ID"`
+}
+
+type createMemberArgs struct {
+	HouseholdID int    `json:"household_id" jsonschema:"required,Household ID"`
+	Name        string `json:"name" jsonschema:"required,Member name (unique within the household)"`
+}
+
+type getBalancesArgs struct {
+	HouseholdID int `json:"household_id" jsonschema:"required,Household ID"`
+}
+
+type recordSettlementArgs struct {
+	HouseholdID  int    `json:"household_id" jsonschema:"required,Household ID"`
+	FromMemberID int    `json:"from_member_id" jsonschema:"required,Member who pays"`
+	ToMemberID   int    `json:"to_member_id" jsonschema:"required,Member who receives the payment"`
+	Amount       string `json:"amount" jsonschema:"required,Positive decimal amount"`
+	Date         string `json:"date" jsonschema:"required,Date in YYYY-MM-DD format"`
+	Note         string `json:"note,omitempty" jsonschema:"Optional note"`
+}
+
+func (s *Server) registerMemberTools() {
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "list_members",
+		Description: "List the members of a household who share its costs",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args listMembersArgs) (*mcp.CallToolResult, any, error) {
+		members, err := s.client.ListMembers(args.HouseholdID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(members)
+	})
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "create_member",
+		Description: "Add a member to a household so transactions can be split between members",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args createMemberArgs) (*mcp.CallToolResult, any, error) {
+		m := toMap(args)
+		delete(m, "household_id")
+		member, err := s.client.CreateMember(args.HouseholdID, m)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(member)
+	})
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "get_balances",
+		Description: "Get the open balances of the household members from split transactions, split recurring expenses and settlements, plus the transfers that would settle them",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args getBalancesArgs) (*mcp.CallToolResult, any, error) {
+		balances, err := s.client.GetBalances(args.HouseholdID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(balances)
+	})
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "record_settlement",
+		Description: "Record a payment from one household member to another that settles shared costs",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args recordSettlementArgs) (*mcp.CallToolResult, any, error) {
+		m := toMap(args)
+		delete(m, "household_id")
+		settlement, err := s.client.RecordSettlement(args.HouseholdID, m)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(settlement)
+	})
+}
+
 // --- Summary Tool ---
 
 type getMonthlySummaryArgs struct {
@@ -453,6 +553,23 @@ type getMonthlySummaryArgs struct {
 	Month       string `json:"month,omitempty" jsonschema:"Month in YYYY-MM format (default: current month)"`
 }
 
+type getRangeSummaryArgs struct {
+	HouseholdID int    `json:"household_id" jsonschema:"required,Household ID"`
+	From        string `json:"from" jsonschema:"required,First month in YYYY-MM format"`
+	To          string `json:"to" jsonschema:"required,Last month in YYYY-MM format (inclusive)"`
+}
+
+type comparePeriodsArgs struct {
+	HouseholdID int    `json:"household_id" jsonschema:"required,Household ID"`
+	Current     string `json:"current,omitempty" jsonschema:"Current period: YYYY, YYYY-Qn or YYYY-MM (default: current year)"`
+	Base        string `json:"base,omitempty" jsonschema:"Base period to compare against: YYYY, YYYY-Qn or YYYY-MM (default: same period one year earlier)"`
+}
+
+type getTaxSummaryArgs struct {
+	HouseholdID int `json:"household_id" jsonschema:"required,Household ID"`
+	Year        int `json:"year,omitempty" jsonschema:"Calendar year (default: previous year)"`
+}
+
 func (s *Server) registerSummaryTools() {
 	mcp.AddTool(s.mcpServer, &mcp.Tool{
 		Name:        "get_monthly_summary",
@@ -464,6 +581,39 @@ func (s *Server) registerSummaryTools() {
 		}
 		return textResult(summary)
 	})
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "get_range_summary",
+		Description: "Get a summary over a range of months for a household: per-month totals, per-category time series, and period totals and monthly averages",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args getRangeSummaryArgs) (*mcp.CallToolResult, any, error) {
+		summary, err := s.client.GetRangeSummary(args.HouseholdID, args.From, args.To)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(summary)
+	})
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "compare_periods",
+		Description: "Compare two periods (months, quarters or years) for a household: per-category deltas (absolute and percent) for recurring and one-time amounts, plus new and vanished categories",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args comparePeriodsArgs) (*mcp.CallToolResult, any, error) {
+		cmp, err := s.client.ComparePeriods(args.HouseholdID, args.Base, args.Current)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(cmp)
+	})
+
+	mcp.AddTool(s.mcpServer, &mcp.Tool{
+		Name:        "get_tax_summary",
+		Description: "Get the annual tax summary for a household: totals per tax classification (craftsman services, household services, income-related expenses, ...) with the supporting transactions and recurring payments",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args getTaxSummaryArgs) (*mcp.CallToolResult, any, error) {
+		summary, err := s.client.GetTaxSummary(args.HouseholdID, args.Year)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textResult(summary)
+	})
 }
 
 // --- Resources ---
@@ -544,7 +694,7 @@ func (s *Server) registerPrompts() {
 		if err != nil {
 			return nil, err
 		}
-		txs, err := s.client.ListTransactions(householdID, month)
+		txs, err := s.client.ListTransactions(householdID, month, "", "")
 		if err != nil {
 			return nil, err
 		}
@@ -602,17 +752,16 @@ Please format this as a clear, readable report with:
 			_, _ = fmt.Sscanf(monthsStr, "%d", &months)
 		}
 
-		var summaries []string
+		if months < 1 {
+			months = 1
+		}
 		now := time.Now()
-		for i := 0; i < months; i++ {
-			m := now.AddDate(0, -i, 0).Format("2006-01")
-			summary, err := s.client.GetSummary(householdID, m)
-			if err != nil {
-				continue
-			}
-			j, _ := toJSON(summary)
-			summaries = append(summaries, j)
+		from := now.AddDate(0, -(months - 1), 0).Format("2006-01")
+		summary, err := s.client.GetRangeSummary(householdID, from, now.Format("2006-01"))
+		if err != nil {
+			return nil, err
 		}
+		summaryJSON, _ := toJSON(summary)
 
 		return &mcp.GetPromptResult{
 			Description: fmt.Sprintf("Budget analysis for the last %d months", months),
@@ -621,7 +770,8 @@ Please format this as a clear, readable report with:
 				Content: &mcp.TextContent{
 					Text: fmt.Sprintf(`Please analyze the spending patterns for the last %d months and provide recommendations.
 
-## Monthly Summaries
+## Range Summary
+Per-month totals, per-category series (one value per month) and period averages:
 %s
 
 Please provide:
@@ -629,7 +779,7 @@ Please provide:
 - Categories with increasing/decreasing spend
 - Suggestions for savings
 - Comparison of recurring vs one-time expenses
-- Overall financial health assessment`, months, formatSummaries(summaries)),
+- Overall financial health assessment`, months, summaryJSON),
 				},
 			}},
 		}, nil
@@ -723,11 +873,3 @@ func parseHouseholdMonth(args map[string]string) (int, string, error) {
 	}
 	return householdID, args["month"], nil
 }
-
-func formatSummaries(summaries []string) string {
-	result := ""
-	for i, s := range summaries {
-		result += fmt.Sprintf("### Month %d\n%s\n\n", i+1, s)
-	}
-	return result
-}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/devmode"
+	"icekalt.dev/money-tracker/internal/domain"
 	"icekalt.dev/money-tracker/internal/service"
 )
 
@@ -34,6 +36,7 @@ func TokenOnlyAuth(tokenSvc *service.APITokenService, devUserID int) echo.Middle
 				}
 				c.Set(UserIDContextKey, apiToken.UserID)
 				ctx := service.WithUserID(c.Request().Context(), apiToken.UserID)
+				ctx = service.WithTokenScope(ctx, apiToken.Scope)
 				c.SetRequest(c.Request().WithContext(ctx))
 				return next(c)
 			}
@@ -44,16 +47,17 @@ func TokenOnlyAuth(tokenSvc *service.APITokenService, devUserID int) echo.Middle
 }
 
 // Auth returns auth middleware that returns 401 JSON on failure (for API routes).
-func Auth(store sessions.Store, tokenSvc *service.APITokenService, devUserID int) echo.MiddlewareFunc {
-	return authMiddleware(store, tokenSvc, devUserID, "")
+// headers is nil unless proxy header authentication is configured.
+func Auth(store sessions.Store, tokenSvc *service.APITokenService, headers *HeaderAuth, devUserID int) echo.MiddlewareFunc {
+	return authMiddleware(store, tokenSvc, headers, devUserID, "")
 }
 
 // WebAuth returns auth middleware that redirects to /login on failure (for web routes).
-func WebAuth(store sessions.Store, tokenSvc *service.APITokenService, devUserID int) echo.MiddlewareFunc {
-	return authMiddleware(store, tokenSvc, devUserID, "/login")
+func WebAuth(store sessions.Store, tokenSvc *service.APITokenService, headers *HeaderAuth, devUserID int) echo.MiddlewareFunc {
+	return authMiddleware(store, tokenSvc, headers, devUserID, "/login")
 }
 
-func authMiddleware(store sessions.Store, tokenSvc *service.APITokenService, devUserID int, redirectURL string) echo.MiddlewareFunc {
+func authMiddleware(store sessions.Store, tokenSvc *service.APITokenService, headers *HeaderAuth, devUserID int, redirectURL string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			// Dev mode: auto-auth
@@ -74,6 +78,23 @@ func authMiddleware(store sessions.Store, tokenSvc *service.APITokenService, dev
 				}
 				c.Set(UserIDContextKey, apiToken.UserID)
 				ctx := service.WithUserID(c.Request().Context(), apiToken.UserID)
+				ctx = service.WithTokenScope(ctx, apiToken.Scope)
+				c.SetRequest(c.Request().WithContext(ctx))
+				return next(c)
+			}
+
+			// Check headers of a trusted authenticating proxy
+			userID, ok, err := headers.userID(c)
+			switch {
+			case errors.Is(err, domain.ErrUserDisabled):
+				return echo.NewHTTPError(http.StatusForbidden, "account disabled")
+			case errors.Is(err, domain.ErrConflict):
+				return echo.NewHTTPError(http.StatusForbidden, "email belongs to another account")
+			case err != nil:
+				return err
+			case ok:
+				c.Set(UserIDContextKey, userID)
+				ctx := service.WithUserID(c.Request().Context(), userID)
 				c.SetRequest(c.Request().WithContext(ctx))
 				return next(c)
 			}
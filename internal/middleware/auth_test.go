@@ -2,13 +2,17 @@ package middleware_test
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/ent"
 	"icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/domain"
 	mw "icekalt.dev/money-tracker/internal/middleware"
 	"icekalt.dev/money-tracker/internal/repository"
 	"icekalt.dev/money-tracker/internal/service"
@@ -17,6 +21,8 @@ import (
 )
 
 type testSetup struct {
+	client   *ent.Client
+	userSvc  *service.UserService
 	tokenSvc *service.APITokenService
 	token    string
 	userID   int
@@ -40,9 +46,12 @@ func setup(t *testing.T) *testSetup {
 
 	userRepo := repository.NewUserRepository(client)
 	tokenRepo := repository.NewAPITokenRepository(client)
+	credentialRepo := repository.NewLocalCredentialRepository(client)
+	identityRepo := repository.NewUserIdentityRepository(client)
 
-	userSvc := service.NewUserService(userRepo)
-	tokenSvc := service.NewAPITokenService(tokenRepo)
+	userSvc := service.NewUserService(userRepo, credentialRepo, identityRepo)
+	eventSvc := service.NewSecurityEventService(repository.NewSecurityEventRepository(client))
+	tokenSvc := service.NewAPITokenService(tokenRepo, nil, eventSvc)
 
 	user, err := userSvc.GetOrCreate(context.Background(), "test-sub", "test@example.com", "Test")
 	if err != nil {
@@ -50,12 +59,14 @@ func setup(t *testing.T) *testSetup {
 	}
 
 	userCtx := service.WithUserID(context.Background(), user.ID)
-	plainToken, _, err := tokenSvc.Create(userCtx, "test-token")
+	plainToken, _, err := tokenSvc.Create(userCtx, "test-token", domain.TokenScope{}, nil)
 	if err != nil {
 		t.Fatalf("failed to create token: %v", err)
 	}
 
 	return &testSetup{
+		client:   client,
+		userSvc:  userSvc,
 		tokenSvc: tokenSvc,
 		token:    plainToken,
 		userID:   user.ID,
@@ -65,7 +76,7 @@ func setup(t *testing.T) *testSetup {
 func TestAuthMiddleware_BearerToken(t *testing.T) {
 	ts := setup(t)
 	sessionStore := auth.NewSessionStore("test-secret-for-middleware-tests", 3600, false)
-	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, 0)
+	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, nil, 0)
 
 	e := echo.New()
 	handler := authMiddleware(func(c echo.Context) error {
@@ -93,7 +104,7 @@ func TestAuthMiddleware_BearerToken(t *testing.T) {
 func TestAuthMiddleware_InvalidToken(t *testing.T) {
 	ts := setup(t)
 	sessionStore := auth.NewSessionStore("test-secret-for-middleware-tests", 3600, false)
-	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, 0)
+	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, nil, 0)
 
 	e := echo.New()
 	handler := authMiddleware(func(c echo.Context) error {
@@ -121,7 +132,7 @@ func TestAuthMiddleware_InvalidToken(t *testing.T) {
 func TestAuthMiddleware_NoAuth(t *testing.T) {
 	ts := setup(t)
 	sessionStore := auth.NewSessionStore("test-secret-for-middleware-tests", 3600, false)
-	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, 0)
+	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, nil, 0)
 
 	e := echo.New()
 	handler := authMiddleware(func(c echo.Context) error {
@@ -148,7 +159,7 @@ func TestAuthMiddleware_NoAuth(t *testing.T) {
 func TestAuthMiddleware_SessionCookie(t *testing.T) {
 	ts := setup(t)
 	sessionStore := auth.NewSessionStore("test-secret-for-middleware-tests", 3600, false)
-	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, 0)
+	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, nil, 0)
 
 	e := echo.New()
 	handler := authMiddleware(func(c echo.Context) error {
@@ -191,6 +202,71 @@ func TestAuthMiddleware_SessionCookie(t *testing.T) {
 	}
 }
 
+func TestAuthMiddleware_TrustedHeaders(t *testing.T) {
+	ts := setup(t)
+	sessionStore := auth.NewSessionStore("test-secret-for-middleware-tests", 3600, false)
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	headers := &mw.HeaderAuth{
+		Users:       ts.userSvc,
+		Proxies:     []*net.IPNet{proxies},
+		UserHeader:  "Remote-User",
+		EmailHeader: "Remote-Email",
+		NameHeader:  "Remote-Name",
+	}
+	authMiddleware := mw.Auth(sessionStore, ts.tokenSvc, headers, 0)
+
+	e := echo.New()
+	var gotUserID int
+	handler := authMiddleware(func(c echo.Context) error {
+		gotUserID = c.Get(mw.UserIDContextKey).(int)
+		return c.String(http.StatusOK, "ok")
+	})
+
+	request := func(remoteAddr, user string) error {
+		gotUserID = 0
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		if user != "" {
+			req.Header.Set("Remote-User", user)
+			req.Header.Set("Remote-Email", user+"@example.com")
+		}
+		return handler(e.NewContext(req, httptest.NewRecorder()))
+	}
+	status := func(err error) int {
+		he, ok := err.(*echo.HTTPError)
+		if !ok {
+			t.Fatalf("expected echo.HTTPError, got %v", err)
+		}
+		return he.Code
+	}
+
+	if err := request("10.1.2.3:4711", "alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	alice, err := ts.userSvc.GetByID(context.Background(), gotUserID)
+	if err != nil {
+		t.Fatalf("expected the user to be created: %v", err)
+	}
+	if alice.Subject != domain.HeaderSubjectPrefix+"alice" || alice.Email != "alice@example.com" || alice.Name != "alice" {
+		t.Errorf("unexpected user %+v", alice)
+	}
+	if err := request("10.1.2.3:4711", "alice"); err != nil || gotUserID != alice.ID {
+		t.Errorf("expected the same user again, got %d, %v", gotUserID, err)
+	}
+
+	if err := request("192.168.1.9:4711", "alice"); status(err) != http.StatusUnauthorized {
+		t.Errorf("expected headers from other addresses to be ignored")
+	}
+	if err := request("10.1.2.3:4711", ""); status(err) != http.StatusUnauthorized {
+		t.Errorf("expected 401 without user header")
+	}
+
+	ts.client.User.UpdateOneID(alice.ID).SetDisabledAt(time.Now()).ExecX(context.Background())
+	if err := request("10.1.2.3:4711", "alice"); status(err) != http.StatusForbidden {
+		t.Errorf("expected disabled users to be rejected")
+	}
+}
+
 func TestTokenOnlyAuth_BearerToken(t *testing.T) {
 	ts := setup(t)
 	tokenAuthMW := mw.TokenOnlyAuth(ts.tokenSvc, 0)
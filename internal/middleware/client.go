@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+// maxUserAgent is how much of the user agent is kept for security events.
+const maxUserAgent = 512
+
+// Client returns middleware that puts the client address and user agent
+// into the request context, where services record them with security
+// events.
+func Client() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ua := c.Request().UserAgent()
+			if len(ua) > maxUserAgent {
+				ua = ua[:maxUserAgent]
+			}
+			ctx := service.WithClient(c.Request().Context(), service.Client{
+				IP:        c.RealIP(),
+				UserAgent: ua,
+			})
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
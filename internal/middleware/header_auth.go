@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+// HeaderAuth takes the user from headers set by an authenticating reverse
+// proxy such as Authelia or oauth2-proxy. The headers are only believed on
+// requests whose direct peer is one of Proxies, since any other client could
+// set them as well. Users are created on their first request.
+type HeaderAuth struct {
+	Users       *service.UserService
+	Proxies     []*net.IPNet
+	UserHeader  string
+	EmailHeader string // falls back to the user name if empty
+	NameHeader  string // falls back to the user name if empty
+	LogoutURL   string
+}
+
+// userID returns the user named by the proxy headers. ok is false if the
+// request didn't come from a trusted proxy or carries no user header.
+func (h *HeaderAuth) userID(c echo.Context) (id int, ok bool, err error) {
+	r := c.Request()
+	if h == nil || !h.trusted(r) {
+		return 0, false, nil
+	}
+	username := strings.TrimSpace(r.Header.Get(h.UserHeader))
+	if username == "" {
+		return 0, false, nil
+	}
+
+	email := headerOr(r, h.EmailHeader, username)
+	name := headerOr(r, h.NameHeader, username)
+	user, err := h.Users.GetOrCreate(r.Context(), domain.HeaderSubjectPrefix+username, email, name)
+	if err != nil {
+		return 0, false, err
+	}
+	if user.Disabled() {
+		return 0, false, domain.ErrUserDisabled
+	}
+	return user.ID, true, nil
+}
+
+// trusted reports whether the request comes directly from a trusted proxy.
+// It deliberately ignores X-Forwarded-For.
+func (h *HeaderAuth) trusted(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, p := range h.Proxies {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func headerOr(r *http.Request, name, fallback string) string {
+	if name == "" {
+		return fallback
+	}
+	if v := strings.TrimSpace(r.Header.Get(name)); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/auth"
+	"icekalt.dev/money-tracker/internal/ratelimit"
+)
+
+// RateLimit returns middleware that limits requests per client address and
+// per bearer token. Addresses that keep sending invalid tokens are locked
+// out before the token is looked up. It has to run before the auth
+// middleware. A nil limiter disables it.
+func RateLimit(limiter *ratelimit.Limiter) echo.MiddlewareFunc {
+	if limiter == nil {
+		return passThrough
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			ip := c.RealIP()
+			now := time.Now()
+
+			wait, err := limiter.Locked(ctx, ip, now)
+			if err != nil {
+				return err
+			}
+			if wait > 0 {
+				return tooManyRequests(c, wait)
+			}
+			if err := allow(c, limiter, "ip:"+ip, limiter.IP, now); err != nil {
+				return err
+			}
+
+			token, hasToken := strings.CutPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+			if hasToken {
+				if err := allow(c, limiter, "token:"+auth.HashToken(token), limiter.Token, now); err != nil {
+					return err
+				}
+			}
+
+			err = next(c)
+			var he *echo.HTTPError
+			if hasToken && errors.As(err, &he) && he.Code == http.StatusUnauthorized {
+				if ferr := limiter.Fail(ctx, ip, now); ferr != nil {
+					return ferr
+				}
+			}
+			return err
+		}
+	}
+}
+
+// AuthRateLimit returns middleware that limits requests to the login
+// endpoints per client address. A nil limiter disables it.
+func AuthRateLimit(limiter *ratelimit.Limiter) echo.MiddlewareFunc {
+	if limiter == nil {
+		return passThrough
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := allow(c, limiter, "auth:"+c.RealIP(), limiter.Auth, time.Now()); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+func allow(c echo.Context, limiter *ratelimit.Limiter, key string, rule ratelimit.Rule, now time.Time) error {
+	ok, wait, err := limiter.Allow(c.Request().Context(), key, rule, now)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return tooManyRequests(c, wait)
+	}
+	return nil
+}
+
+// tooManyRequests answers 429 with Retry-After in whole seconds.
+func tooManyRequests(c echo.Context, wait time.Duration) error {
+	seconds := max(int(math.Ceil(wait.Seconds())), 1)
+	c.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+	return echo.NewHTTPError(http.StatusTooManyRequests, "too many requests")
+}
+
+func passThrough(next echo.HandlerFunc) echo.HandlerFunc {
+	return next
+}
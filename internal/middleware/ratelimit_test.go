@@ -0,0 +1,116 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"icekalt.dev/money-tracker/internal/auth"
+	"icekalt.dev/money-tracker/internal/devmode"
+	mw "icekalt.dev/money-tracker/internal/middleware"
+	"icekalt.dev/money-tracker/internal/ratelimit"
+)
+
+func serveRateLimited(e *echo.Echo, handler echo.HandlerFunc, token, remoteAddr string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	return rec, handler(e.NewContext(req, rec))
+}
+
+func assertTooManyRequests(t *testing.T, rec *httptest.ResponseRecorder, err error) {
+	t.Helper()
+	var he *echo.HTTPError
+	if !errors.As(err, &he) || he.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %v", err)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("expected Retry-After header")
+	}
+}
+
+func TestRateLimit_PerAddress(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.NewMemoryStore())
+	limiter.IP = ratelimit.Rule{Limit: 2, Window: time.Minute}
+
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	handler := mw.RateLimit(limiter)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := serveRateLimited(e, handler, "", "192.0.2.1:1234"); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i+1, err)
+		}
+	}
+	rec, err := serveRateLimited(e, handler, "", "192.0.2.1:1234")
+	assertTooManyRequests(t, rec, err)
+
+	if _, err := serveRateLimited(e, handler, "", "192.0.2.2:1234"); err != nil {
+		t.Errorf("other addresses must not be limited: %v", err)
+	}
+}
+
+func TestRateLimit_PerToken(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.NewMemoryStore())
+	limiter.Token = ratelimit.Rule{Limit: 1, Window: time.Minute}
+
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	handler := mw.RateLimit(limiter)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	if _, err := serveRateLimited(e, handler, "mt_one", "192.0.2.1:1234"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec, err := serveRateLimited(e, handler, "mt_one", "192.0.2.2:1234")
+	assertTooManyRequests(t, rec, err)
+	if _, err := serveRateLimited(e, handler, "mt_two", "192.0.2.1:1234"); err != nil {
+		t.Errorf("other tokens must not be limited: %v", err)
+	}
+}
+
+func TestRateLimit_LockoutAfterInvalidTokens(t *testing.T) {
+	if devmode.Enabled {
+		t.Skip("dev mode accepts every request")
+	}
+
+	ts := setup(t)
+	limiter := ratelimit.New(ratelimit.NewMemoryStore())
+	limiter.MaxFailures = 2
+	limiter.Lockout = 15 * time.Minute
+
+	e := echo.New()
+	e.IPExtractor = echo.ExtractIPDirect()
+	sessionStore := auth.NewSessionStore("test-secret-for-middleware-tests", 3600, false)
+	handler := mw.RateLimit(limiter)(mw.Auth(sessionStore, ts.tokenSvc, nil, 0)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	}))
+
+	for i := 0; i < 2; i++ {
+		_, err := serveRateLimited(e, handler, "mt_guessed", "192.0.2.1:1234")
+		var he *echo.HTTPError
+		if !errors.As(err, &he) || he.Code != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: expected 401, got %v", i+1, err)
+		}
+	}
+
+	// Locked out even with a valid token
+	rec, err := serveRateLimited(e, handler, ts.token, "192.0.2.1:1234")
+	assertTooManyRequests(t, rec, err)
+	if got := rec.Header().Get("Retry-After"); got != "900" {
+		t.Errorf("expected Retry-After 900, got %q", got)
+	}
+
+	if _, err := serveRateLimited(e, handler, ts.token, "192.0.2.2:1234"); err != nil {
+		t.Errorf("other addresses must not be locked out: %v", err)
+	}
+}
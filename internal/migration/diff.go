@@ -0,0 +1,176 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"ariga.io/atlas/sql/migrate"
+	atlas "ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+
+	entmigrate "icekalt.dev/money-tracker/ent/migrate"
+)
+
+// Diff writes a migration that brings the migrations of the driver's dialect
+// in dir up to the current ent schema. drv must be connected to an empty dev
+// database: the existing migrations are replayed on it to compute their
+// state. version names the files, so migrations generated for both dialects
+// in one go share it. It reports false if the schema is unchanged and
+// nothing was written.
+func Diff(ctx context.Context, drv *entsql.Driver, dir, version, name string) (bool, error) {
+	sub, err := dialectDir(drv.Dialect())
+	if err != nil {
+		return false, err
+	}
+	d, err := sqltool.NewGolangMigrateDir(filepath.Join(dir, sub))
+	if err != nil {
+		return false, err
+	}
+	before, err := d.Files()
+	if err != nil {
+		return false, err
+	}
+	m, err := schema.NewMigrate(drv,
+		schema.WithDir(d),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithFormatter(formatter(version)),
+		schema.WithDialect(drv.Dialect()),
+	)
+	if err != nil {
+		return false, err
+	}
+	if err := m.NamedDiff(ctx, name, entmigrate.Tables...); err != nil {
+		return false, err
+	}
+	after, err := d.Files()
+	if err != nil {
+		return false, err
+	}
+	if len(after) == len(before) {
+		return false, nil
+	}
+	if drv.Dialect() == dialect.SQLite {
+		down := strings.TrimSuffix(after[len(after)-1].Name(), ".up.sql") + ".down.sql"
+		if err := sqliteDown(ctx, drv, d, down); err != nil {
+			return true, fmt.Errorf("generating down migration: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// sqliteDown replaces the down file of the newest migration with a plan from
+// the state after all migrations back to the state before it. Atlas can't
+// reverse the table rebuilds SQLite needs for most column changes, so the
+// reverse statements of the up plan would drop the rebuilt tables.
+func sqliteDown(ctx context.Context, drv *entsql.Driver, d *sqltool.GolangMigrateDir, name string) error {
+	conn, err := drv.DB().Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	dev, err := sqlite.Open(conn)
+	if err != nil {
+		return err
+	}
+
+	files, err := d.Files()
+	if err != nil {
+		return err
+	}
+	prev, err := replayState(ctx, dev, d, len(files)-1)
+	if err != nil {
+		return err
+	}
+	cur, err := replayState(ctx, dev, d, len(files))
+	if err != nil {
+		return err
+	}
+	changes, err := dev.SchemaDiff(cur, prev)
+	if err != nil {
+		return err
+	}
+	plan, err := dev.PlanChanges(ctx, "down", changes)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	for _, c := range plan.Changes {
+		if c.Comment != "" {
+			fmt.Fprintf(&b, "-- %s\n", c.Comment)
+		}
+		fmt.Fprintf(&b, "%s;\n", c.Cmd)
+	}
+	if err := d.WriteFile(name, []byte(b.String())); err != nil {
+		return err
+	}
+	sum, err := d.Checksum()
+	if err != nil {
+		return err
+	}
+	return migrate.WriteSumFile(d, sum)
+}
+
+// replayState applies the first n migrations to the empty dev database and
+// returns the resulting schema. The dev database is emptied again afterwards.
+func replayState(ctx context.Context, dev migrate.Driver, d migrate.Dir, n int) (*atlas.Schema, error) {
+	if n > 0 {
+		ex, err := migrate.NewExecutor(dev, d, &migrate.NopRevisionReadWriter{})
+		if err != nil {
+			return nil, err
+		}
+		if err := ex.ExecuteN(ctx, n); err != nil {
+			return nil, err
+		}
+	}
+	s, err := dev.InspectSchema(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	drop := make([]atlas.Change, 0, len(s.Tables))
+	for _, t := range s.Tables {
+		drop = append(drop, &atlas.DropTable{T: t})
+	}
+	if err := dev.ApplyChanges(ctx, drop); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// formatter is sqltool.GolangMigrateFormatter with a fixed version.
+func formatter(version string) migrate.Formatter {
+	funcs := template.FuncMap{
+		"now": func() string { return version },
+		"rev": reverse,
+	}
+	templates := []string{
+		"{{ now }}{{ with .Name }}_{{ . }}{{ end }}.up.sql",
+		`{{ range .Changes }}{{ with .Comment }}-- {{ println . }}{{ end }}{{ printf "%s;\n" .Cmd }}{{ end }}`,
+		"{{ now }}{{ with .Name }}_{{ . }}{{ end }}.down.sql",
+		`{{ range $c := rev .Changes }}{{ with $stmts := .ReverseStmts }}{{ with $c.Comment }}-- reverse: {{ println . }}{{ end }}{{ range $stmts }}{{ printf "%s;\n" . }}{{ end }}{{ end }}{{ end }}`,
+	}
+	tpls := make([]*template.Template, len(templates))
+	for i, t := range templates {
+		tpls[i] = template.Must(template.New("").Funcs(funcs).Parse(t))
+	}
+	f, err := migrate.NewTemplateFormatter(tpls...)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func reverse(changes []*migrate.Change) []*migrate.Change {
+	rev := make([]*migrate.Change, len(changes))
+	for i, c := range changes {
+		rev[len(changes)-1-i] = c
+	}
+	return rev
+}
@@ -0,0 +1,471 @@
+// Package migration applies the versioned schema migrations in sqlite/ and
+// postgres/. Every migration is a pair of <version>_<name>.up.sql and
+// <version>_<name>.down.sql files generated from the ent schema by Diff.
+// atlas.sum in each directory guards the files against accidental edits.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"icekalt.dev/money-tracker/ent"
+	"icekalt.dev/money-tracker/internal/repository"
+)
+
+//go:embed sqlite postgres
+var files embed.FS
+
+// ErrSchemaBehind is returned by Check when migrations are pending.
+var ErrSchemaBehind = errors.New("database schema is behind")
+
+const (
+	sumFile      = "atlas.sum"
+	versionTable = "schema_migrations"
+	// legacyTable exists in every database created by the automatic
+	// migration that predates versioned migrations.
+	legacyTable = "users"
+)
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version string
+	Name    string
+	Up      []string
+	Down    []string
+}
+
+// Status describes a migration and whether it has been applied. Migrations
+// that are recorded in the database but unknown to this build have no
+// statements.
+type Status struct {
+	Migration *Migration
+	AppliedAt time.Time
+	Unknown   bool
+}
+
+// Applied reports whether the migration has been applied.
+func (s Status) Applied() bool {
+	return !s.AppliedAt.IsZero()
+}
+
+// Migrator applies the migrations of one dialect to a database.
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []*Migration
+}
+
+// New returns a migrator for the database. d is the ent dialect name
+// (dialect.SQLite or dialect.Postgres).
+func New(db *sql.DB, d string) (*Migrator, error) {
+	migrations, err := Load(d)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: d, migrations: migrations}, nil
+}
+
+// Load reads and verifies the embedded migrations of the dialect, ordered by
+// version.
+func Load(d string) ([]*Migration, error) {
+	dir, err := dialectDir(d)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := fs.Sub(files, dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySum(sub); err != nil {
+		return nil, fmt.Errorf("%s migrations: %w", dir, err)
+	}
+
+	names, err := fs.Glob(sub, "*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	migrations := make([]*Migration, 0, len(names))
+	for _, name := range names {
+		base := strings.TrimSuffix(name, ".up.sql")
+		version, desc, _ := strings.Cut(base, "_")
+		up, err := readStmts(sub, name)
+		if err != nil {
+			return nil, err
+		}
+		down, err := readStmts(sub, base+".down.sql")
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		migrations = append(migrations, &Migration{Version: version, Name: desc, Up: up, Down: down})
+	}
+	return migrations, nil
+}
+
+func dialectDir(d string) (string, error) {
+	switch d {
+	case dialect.SQLite:
+		return "sqlite", nil
+	case dialect.Postgres:
+		return "postgres", nil
+	default:
+		return "", fmt.Errorf("unsupported dialect: %s", d)
+	}
+}
+
+// verifySum compares atlas.sum with the checksum of all SQL files, computed
+// the same way Atlas does when writing the directory.
+func verifySum(dir fs.FS) error {
+	names, err := fs.Glob(dir, "*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	list := make([]migrate.File, len(names))
+	for i, name := range names {
+		b, err := fs.ReadFile(dir, name)
+		if err != nil {
+			return err
+		}
+		list[i] = migrate.NewLocalFile(name, b)
+	}
+	want, err := migrate.NewHashFile(list)
+	if err != nil {
+		return err
+	}
+
+	b, err := fs.ReadFile(dir, sumFile)
+	if err != nil {
+		return err
+	}
+	var got migrate.HashFile
+	if err := got.UnmarshalText(b); err != nil {
+		return err
+	}
+	if got.Sum() != want.Sum() {
+		return errors.New("checksum mismatch, regenerate atlas.sum instead of editing migration files by hand")
+	}
+	return nil
+}
+
+func readStmts(dir fs.FS, name string) ([]string, error) {
+	b, err := fs.ReadFile(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewLocalFile(name, b).Stmts()
+}
+
+// Migrations returns all known migrations ordered by version.
+func (m *Migrator) Migrations() []*Migration {
+	return m.migrations
+}
+
+// Legacy reports whether the database was created by the automatic
+// migration of earlier versions and has no migration history yet.
+func (m *Migrator) Legacy(ctx context.Context) (bool, error) {
+	tracked, err := m.tableExists(ctx, versionTable)
+	if err != nil || tracked {
+		return false, err
+	}
+	return m.tableExists(ctx, legacyTable)
+}
+
+// Status lists all known migrations in order, followed by applied versions
+// this build doesn't know.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Status, 0, len(m.migrations))
+	known := make(map[string]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		s := Status{Migration: mig}
+		if a, ok := applied[mig.Version]; ok {
+			s.AppliedAt = a.at
+		}
+		result = append(result, s)
+	}
+	var unknown []Status
+	for version, a := range applied {
+		if !known[version] {
+			unknown = append(unknown, Status{
+				Migration: &Migration{Version: version, Name: a.name},
+				AppliedAt: a.at,
+				Unknown:   true,
+			})
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i].Migration.Version < unknown[j].Migration.Version })
+	return append(result, unknown...), nil
+}
+
+// Pending returns the migrations Up would apply, in order.
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []*Migration
+	for _, s := range status {
+		if s.Unknown {
+			return nil, fmt.Errorf("database has migration %s applied that this version doesn't know, run \"migrate down\" with the newer version first", s.Migration.Version)
+		}
+		if !s.Applied() {
+			pending = append(pending, s.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// Check returns ErrSchemaBehind if the database is a legacy database or has
+// pending migrations.
+func (m *Migrator) Check(ctx context.Context) error {
+	legacy, err := m.Legacy(ctx)
+	if err != nil {
+		return err
+	}
+	if legacy {
+		return fmt.Errorf("%w: no migration history", ErrSchemaBehind)
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending migration(s), first %s_%s", ErrSchemaBehind, len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
+
+// Up applies all pending migrations and returns them. A legacy database is
+// first brought up to the current schema by the automatic migration, which
+// also converts its data, and all migrations are then recorded as applied.
+// Data changes in migrations after the baseline therefore need a matching
+// apply hook in repository.AutoMigrate unless legacy databases can't have
+// the data they change.
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	legacy, err := m.Legacy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if legacy {
+		return m.adopt(ctx)
+	}
+
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return nil, err
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, mig := range pending {
+		err := m.apply(ctx, mig.Up, func(tx *sql.Tx) error {
+			return m.record(ctx, tx, mig)
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("applying migration %s_%s: %w", mig.Version, mig.Name, err)
+		}
+	}
+	return pending, nil
+}
+
+// Down reverts the last n applied migrations, newest first, and returns them.
+func (m *Migrator) Down(ctx context.Context, n int) ([]*Migration, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("number of migrations to revert must be positive")
+	}
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var applied []Status
+	for _, s := range status {
+		if s.Applied() {
+			applied = append(applied, s)
+		}
+	}
+	sort.Slice(applied, func(i, j int) bool { return applied[i].Migration.Version > applied[j].Migration.Version })
+	if n > len(applied) {
+		return nil, fmt.Errorf("only %d migration(s) applied", len(applied))
+	}
+
+	reverted := make([]*Migration, 0, n)
+	for _, s := range applied[:n] {
+		mig := s.Migration
+		if s.Unknown {
+			return reverted, fmt.Errorf("migration %s is unknown to this version", mig.Version)
+		}
+		if len(mig.Down) == 0 {
+			return reverted, fmt.Errorf("migration %s_%s is not reversible", mig.Version, mig.Name)
+		}
+		err := m.apply(ctx, mig.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "DELETE FROM "+versionTable+" WHERE version = "+m.param(1), mig.Version)
+			return err
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("reverting migration %s_%s: %w", mig.Version, mig.Name, err)
+		}
+		reverted = append(reverted, mig)
+	}
+	return reverted, nil
+}
+
+func (m *Migrator) adopt(ctx context.Context) ([]*Migration, error) {
+	// Don't close the client, it shares the migrator's connection pool.
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(m.dialect, m.db)))
+	if err := repository.AutoMigrate(ctx, client, m.dialect); err != nil {
+		return nil, fmt.Errorf("upgrading legacy schema: %w", err)
+	}
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return nil, err
+	}
+	err := m.apply(ctx, nil, func(tx *sql.Tx) error {
+		for _, mig := range m.migrations {
+			if err := m.record(ctx, tx, mig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("recording migrations: %w", err)
+	}
+	return m.migrations, nil
+}
+
+// apply runs the statements and then record in one transaction. On SQLite,
+// foreign keys are switched off for the connection while table rebuilds
+// run, and checked before committing.
+func (m *Migrator) apply(ctx context.Context, stmts []string, record func(*sql.Tx) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if m.dialect == dialect.SQLite {
+		var fk int
+		if err := conn.QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&fk); err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = off"); err != nil {
+			return err
+		}
+		defer conn.ExecContext(context.WithoutCancel(ctx), fmt.Sprintf("PRAGMA foreign_keys = %d", fk))
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%w\n%s", err, stmt)
+		}
+	}
+	if m.dialect == dialect.SQLite {
+		if err := checkForeignKeys(ctx, tx); err != nil {
+			return err
+		}
+	}
+	if err := record(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func checkForeignKeys(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		var table string
+		var rowid sql.NullInt64
+		var parent string
+		var fkid int
+		if err := rows.Scan(&table, &rowid, &parent, &fkid); err != nil {
+			return err
+		}
+		return fmt.Errorf("foreign key violation in %s (row %d) referencing %s", table, rowid.Int64, parent)
+	}
+	return rows.Err()
+}
+
+func (m *Migrator) record(ctx context.Context, tx *sql.Tx, mig *Migration) error {
+	_, err := tx.ExecContext(ctx,
+		fmt.Sprintf("INSERT INTO %s (version, name, applied_at) VALUES (%s, %s, %s)", versionTable, m.param(1), m.param(2), m.param(3)),
+		mig.Version, mig.Name, time.Now().UTC())
+	return err
+}
+
+func (m *Migrator) ensureVersionTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+versionTable+
+		" (version VARCHAR(32) NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at TIMESTAMP NOT NULL)")
+	return err
+}
+
+type appliedMigration struct {
+	name string
+	at   time.Time
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[string]appliedMigration, error) {
+	result := make(map[string]appliedMigration)
+	exists, err := m.tableExists(ctx, versionTable)
+	if err != nil || !exists {
+		return result, err
+	}
+	rows, err := m.db.QueryContext(ctx, "SELECT version, name, applied_at FROM "+versionTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var version string
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.name, &a.at); err != nil {
+			return nil, err
+		}
+		result[version] = a
+	}
+	return result, rows.Err()
+}
+
+func (m *Migrator) tableExists(ctx context.Context, table string) (bool, error) {
+	query := "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	if m.dialect == dialect.Postgres {
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1"
+	}
+	var n int
+	if err := m.db.QueryRowContext(ctx, query, table).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (m *Migrator) param(i int) string {
+	if m.dialect == dialect.Postgres {
+		return fmt.Sprintf("$%d", i)
+	}
+	return "?"
+}
@@ -0,0 +1,289 @@
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/migration"
+	"icekalt.dev/money-tracker/internal/repository"
+)
+
+func openDB(t *testing.T) *entsql.Driver {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "test.db") + "?_pragma=foreign_keys(1)"
+	drv, err := repository.OpenDriver(config.DatabaseConfig{Driver: "sqlite", DSN: dsn})
+	if err != nil {
+		t.Fatalf("failed to open driver: %v", err)
+	}
+	t.Cleanup(func() { drv.Close() })
+	return drv
+}
+
+func newMigrator(t *testing.T, drv *entsql.Driver) *migration.Migrator {
+	t.Helper()
+	m, err := migration.New(drv.DB(), drv.Dialect())
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	return m
+}
+
+// assertNoDrift fails if the ent schema differs from the migrated database,
+// i.e. if a schema change was made without generating a migration.
+func assertNoDrift(t *testing.T, drv *entsql.Driver) {
+	t.Helper()
+	client := repository.NewClientFromDriver(drv)
+	var b strings.Builder
+	if err := client.Schema.WriteTo(context.Background(), &b); err != nil {
+		t.Fatalf("diffing schema: %v", err)
+	}
+	for _, line := range strings.Split(b.String(), "\n") {
+		if line == "" || line == "BEGIN;" || line == "COMMIT;" || strings.HasPrefix(line, "--") {
+			continue
+		}
+		t.Fatalf("ent schema differs from migrations, run \"make migration name=...\":\n%s", b.String())
+	}
+}
+
+func TestUpMatchesEntSchema(t *testing.T) {
+	ctx := context.Background()
+	drv := openDB(t)
+	m := newMigrator(t, drv)
+
+	if err := m.Check(ctx); !errors.Is(err, migration.ErrSchemaBehind) {
+		t.Fatalf("expected ErrSchemaBehind on an empty database, got %v", err)
+	}
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+	if len(applied) != len(m.Migrations()) {
+		t.Errorf("applied %d migrations, want %d", len(applied), len(m.Migrations()))
+	}
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("expected schema to be current, got %v", err)
+	}
+	assertNoDrift(t, drv)
+
+	applied, err = m.Up(ctx)
+	if err != nil || len(applied) != 0 {
+		t.Errorf("second up applied %d migrations, err %v", len(applied), err)
+	}
+}
+
+func TestDownRevertsMigrations(t *testing.T) {
+	ctx := context.Background()
+	drv := openDB(t)
+	m := newMigrator(t, drv)
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+
+	n := len(m.Migrations())
+	if _, err := m.Down(ctx, n+1); err == nil {
+		t.Error("expected error when reverting more migrations than applied")
+	}
+	reverted, err := m.Down(ctx, n)
+	if err != nil {
+		t.Fatalf("down failed: %v", err)
+	}
+	if len(reverted) != n {
+		t.Errorf("reverted %d migrations, want %d", len(reverted), n)
+	}
+
+	var tables int
+	if err := drv.DB().QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'users'").Scan(&tables); err != nil {
+		t.Fatalf("querying tables: %v", err)
+	}
+	if tables != 0 {
+		t.Error("expected users table to be dropped")
+	}
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	for _, s := range status {
+		if s.Applied() {
+			t.Errorf("migration %s still recorded as applied", s.Migration.Version)
+		}
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up after down failed: %v", err)
+	}
+	assertNoDrift(t, drv)
+}
+
+func TestDownLatestKeepsData(t *testing.T) {
+	ctx := context.Background()
+	drv := openDB(t)
+	m := newMigrator(t, drv)
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+	client := repository.NewClientFromDriver(drv)
+	u := client.User.Create().SetEmail("a@example.com").SetName("A").SetSubject("sub-a").SaveX(ctx)
+	h := client.Household.Create().SetName("Home").SetOwner(u).SaveX(ctx)
+	c := client.Category.Create().SetName("Rent").SetHousehold(h).SaveX(ctx)
+	client.Transaction.Create().SetAmount(-1234).SetDate(time.Now()).SetHousehold(h).SetCategory(c).SaveX(ctx)
+
+	if _, err := m.Down(ctx, 1); err != nil {
+		t.Fatalf("down failed: %v", err)
+	}
+	var amount int64
+	if err := drv.DB().QueryRowContext(ctx, "SELECT amount FROM transactions").Scan(&amount); err != nil {
+		t.Fatalf("querying transaction after down: %v", err)
+	}
+	if amount != -1234 {
+		t.Errorf("amount after down = %d, want -1234", amount)
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up after down failed: %v", err)
+	}
+	if n := client.Transaction.Query().CountX(ctx); n != 1 {
+		t.Errorf("expected 1 transaction after up, got %d", n)
+	}
+	assertNoDrift(t, drv)
+}
+
+func TestUpAdoptsLegacyDatabase(t *testing.T) {
+	ctx := context.Background()
+	drv := openDB(t)
+	m := newMigrator(t, drv)
+
+	// A database from before versioned migrations has the baseline schema
+	// without a migration history.
+	stmts := append([]string{}, m.Migrations()[0].Up...)
+	stmts = append(stmts,
+		"INSERT INTO users (email, name, subject, created_at, updated_at) VALUES ('a@example.com', 'A', 'sub-a', '2025-01-01 00:00:00+00:00', '2025-01-01 00:00:00+00:00')",
+		"INSERT INTO households (name, created_at, updated_at, user_households) VALUES ('Home', '2025-01-01 00:00:00+00:00', '2025-01-01 00:00:00+00:00', 1)",
+		"INSERT INTO categories (name, created_at, updated_at, household_categories) VALUES ('Rent', '2025-01-01 00:00:00+00:00', '2025-01-01 00:00:00+00:00', 1)",
+		"INSERT INTO recurring_expenses (name, amount, frequency, active, start_date, created_at, updated_at, category_recurring_expenses, household_recurring_expenses) VALUES ('Rent', -90000, 'monthly', true, '2025-01-01 00:00:00+00:00', '2025-01-01 00:00:00+00:00', '2025-01-01 00:00:00+00:00', 1, 1)",
+		"INSERT INTO recurring_expenses (name, amount, frequency, active, start_date, created_at, updated_at, category_recurring_expenses, household_recurring_expenses) VALUES ('Gym', -3000, 'monthly', false, '2025-01-01 00:00:00+00:00', '2025-01-01 00:00:00+00:00', '2025-06-15 00:00:00+00:00', 1, 1)",
+	)
+	for _, stmt := range stmts {
+		if _, err := drv.DB().ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("preparing legacy database: %v\n%s", err, stmt)
+		}
+	}
+
+	legacy, err := m.Legacy(ctx)
+	if err != nil || !legacy {
+		t.Fatalf("expected legacy database, got %v, %v", legacy, err)
+	}
+	if err := m.Check(ctx); !errors.Is(err, migration.ErrSchemaBehind) {
+		t.Errorf("expected ErrSchemaBehind for a legacy database, got %v", err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("expected schema to be current, got %v", err)
+	}
+	client := repository.NewClientFromDriver(drv)
+	if n := client.User.Query().CountX(ctx); n != 1 {
+		t.Errorf("expected existing user to be kept, got %d users", n)
+	}
+	assertNoDrift(t, drv)
+
+	for _, re := range client.RecurringExpense.Query().AllX(ctx) {
+		switch {
+		case re.Active && re.DeactivatedAt != nil:
+			t.Errorf("expected %s to stay active, deactivated at %v", re.Name, re.DeactivatedAt)
+		case !re.Active && (re.DeactivatedAt == nil || !re.DeactivatedAt.Equal(re.UpdatedAt)):
+			t.Errorf("expected %s to be deactivated at its last update %v, got %v", re.Name, re.UpdatedAt, re.DeactivatedAt)
+		}
+	}
+}
+
+func TestUpBackfillsEqualSplitMembers(t *testing.T) {
+	ctx := context.Background()
+	drv := openDB(t)
+	m := newMigrator(t, drv)
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+
+	// Go back to before the members of equal splits were stored.
+	var after int
+	for _, mig := range m.Migrations() {
+		if mig.Version >= "20261019210000" {
+			after++
+		}
+	}
+	if _, err := m.Down(ctx, after); err != nil {
+		t.Fatalf("down failed: %v", err)
+	}
+	client := repository.NewClientFromDriver(drv)
+	u := client.User.Create().SetEmail("a@example.com").SetName("A").SetSubject("sub-a").SaveX(ctx)
+	h := client.Household.Create().SetName("Home").SetOwner(u).SaveX(ctx)
+	c := client.Category.Create().SetName("Food").SetHousehold(h).SaveX(ctx)
+	alice := client.HouseholdMember.Create().SetName("Alice").SetHousehold(h).SaveX(ctx)
+	bob := client.HouseholdMember.Create().SetName("Bob").SetHousehold(h).SaveX(ctx)
+	stmt := "INSERT INTO transactions (amount, date, split_type, created_at, updated_at, category_transactions, household_transactions, household_member_paid_transactions) VALUES (-1000, '2026-01-15 00:00:00+00:00', 'equal', '2026-01-15 00:00:00+00:00', '2026-01-15 00:00:00+00:00', ?, ?, ?)"
+	if _, err := drv.DB().ExecContext(ctx, stmt, c.ID, h.ID, alice.ID); err != nil {
+		t.Fatalf("inserting transaction: %v", err)
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+	tx := client.Transaction.Query().OnlyX(ctx)
+	if len(tx.SplitShares) != 2 || tx.SplitShares[0].MemberID != alice.ID || tx.SplitShares[1].MemberID != bob.ID {
+		t.Errorf("expected the split to store both members, got %+v", tx.SplitShares)
+	}
+}
+
+func TestPendingRejectsUnknownVersion(t *testing.T) {
+	ctx := context.Background()
+	drv := openDB(t)
+	m := newMigrator(t, drv)
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("up failed: %v", err)
+	}
+	if _, err := drv.DB().ExecContext(ctx, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ('99990101000000', 'future', CURRENT_TIMESTAMP)"); err != nil {
+		t.Fatalf("inserting version: %v", err)
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	if last := status[len(status)-1]; !last.Unknown || last.Migration.Version != "99990101000000" {
+		t.Errorf("expected unknown migration last, got %+v", last)
+	}
+	if _, err := m.Pending(ctx); err == nil {
+		t.Error("expected error for unknown applied migration")
+	}
+}
+
+func TestDialectsShareVersions(t *testing.T) {
+	sqlite, err := migration.Load(dialect.SQLite)
+	if err != nil {
+		t.Fatalf("loading sqlite migrations: %v", err)
+	}
+	postgres, err := migration.Load(dialect.Postgres)
+	if err != nil {
+		t.Fatalf("loading postgres migrations: %v", err)
+	}
+	if len(sqlite) != len(postgres) {
+		t.Fatalf("sqlite has %d migrations, postgres %d", len(sqlite), len(postgres))
+	}
+	for i := range sqlite {
+		if sqlite[i].Version != postgres[i].Version || sqlite[i].Name != postgres[i].Name {
+			t.Errorf("migration %d differs: %s_%s vs %s_%s", i, sqlite[i].Version, sqlite[i].Name, postgres[i].Version, postgres[i].Name)
+		}
+		if len(postgres[i].Down) == 0 {
+			t.Errorf("postgres migration %s has no down statements", postgres[i].Version)
+		}
+	}
+}
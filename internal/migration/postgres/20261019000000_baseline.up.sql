@@ -0,0 +1,38 @@
+-- create "sessions" table
+CREATE TABLE "sessions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "token" character varying NOT NULL, "data" bytea NOT NULL, "user_id" bigint NULL, "expires_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "sessions_token_key" to table: "sessions"
+CREATE UNIQUE INDEX "sessions_token_key" ON "sessions" ("token");
+-- create index "session_expires_at" to table: "sessions"
+CREATE INDEX "session_expires_at" ON "sessions" ("expires_at");
+-- create "settings" table
+CREATE TABLE "settings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "key" character varying NOT NULL, "value" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "settings_key_key" to table: "settings"
+CREATE UNIQUE INDEX "settings_key_key" ON "settings" ("key");
+-- create "users" table
+CREATE TABLE "users" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "email" character varying NOT NULL, "name" character varying NOT NULL, "subject" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
+-- create index "users_subject_key" to table: "users"
+CREATE UNIQUE INDEX "users_subject_key" ON "users" ("subject");
+-- create "api_tokens" table
+CREATE TABLE "api_tokens" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "token_hash" character varying NOT NULL, "expires_at" timestamptz NULL, "last_used" timestamptz NULL, "created_at" timestamptz NOT NULL, "user_api_tokens" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "api_tokens_users_api_tokens" FOREIGN KEY ("user_api_tokens") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "api_tokens_token_hash_key" to table: "api_tokens"
+CREATE UNIQUE INDEX "api_tokens_token_hash_key" ON "api_tokens" ("token_hash");
+-- create "households" table
+CREATE TABLE "households" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "currency" character varying NOT NULL DEFAULT 'EUR', "description" character varying NULL DEFAULT '', "icon" character varying NULL DEFAULT 'home', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_households" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "households_users_households" FOREIGN KEY ("user_households") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create "categories" table
+CREATE TABLE "categories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "icon" character varying NULL DEFAULT 'category', "tax_class" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "household_categories" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "categories_households_categories" FOREIGN KEY ("household_categories") REFERENCES "households" ("id") ON DELETE NO ACTION);
+-- create index "category_name_household_categories" to table: "categories"
+CREATE UNIQUE INDEX "category_name_household_categories" ON "categories" ("name", "household_categories");
+-- create "monthly_aggregates" table
+CREATE TABLE "monthly_aggregates" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "month" character varying NOT NULL, "version" bigint NOT NULL, "gross_income" bigint NOT NULL, "gross_expenses" bigint NOT NULL, "recurring_total" bigint NOT NULL, "one_time_total" bigint NOT NULL, "monthly_total" bigint NOT NULL, "categories" jsonb NOT NULL, "computed_at" timestamptz NOT NULL, "household_monthly_aggregates" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "monthly_aggregates_households_monthly_aggregates" FOREIGN KEY ("household_monthly_aggregates") REFERENCES "households" ("id") ON DELETE NO ACTION);
+-- create index "monthlyaggregate_month_household_monthly_aggregates" to table: "monthly_aggregates"
+CREATE UNIQUE INDEX "monthlyaggregate_month_household_monthly_aggregates" ON "monthly_aggregates" ("month", "household_monthly_aggregates");
+-- create "recurring_expenses" table
+CREATE TABLE "recurring_expenses" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "description" character varying NULL DEFAULT '', "details" character varying NULL DEFAULT '', "amount" bigint NOT NULL, "frequency" character varying NOT NULL, "active" boolean NOT NULL DEFAULT true, "start_date" timestamptz NOT NULL, "end_date" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "category_recurring_expenses" bigint NOT NULL, "household_recurring_expenses" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "recurring_expenses_categories_recurring_expenses" FOREIGN KEY ("category_recurring_expenses") REFERENCES "categories" ("id") ON DELETE NO ACTION, CONSTRAINT "recurring_expenses_households_recurring_expenses" FOREIGN KEY ("household_recurring_expenses") REFERENCES "households" ("id") ON DELETE NO ACTION);
+-- create "recurring_schedule_overrides" table
+CREATE TABLE "recurring_schedule_overrides" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "effective_date" timestamptz NOT NULL, "amount" bigint NOT NULL, "frequency" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "recurring_expense_schedule_overrides" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "recurring_schedule_overrides_r_78eeef2ea9dd1cbb2e2a01c277f1bcb6" FOREIGN KEY ("recurring_expense_schedule_overrides") REFERENCES "recurring_expenses" ("id") ON DELETE NO ACTION);
+-- create "transactions" table
+CREATE TABLE "transactions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "amount" bigint NOT NULL, "description" character varying NULL, "details" character varying NULL, "date" timestamptz NOT NULL, "tax_class" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "category_transactions" bigint NOT NULL, "household_transactions" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "transactions_categories_transactions" FOREIGN KEY ("category_transactions") REFERENCES "categories" ("id") ON DELETE NO ACTION, CONSTRAINT "transactions_households_transactions" FOREIGN KEY ("household_transactions") REFERENCES "households" ("id") ON DELETE NO ACTION);
+-- create index "transaction_date_household_transactions" to table: "transactions"
+CREATE INDEX "transaction_date_household_transactions" ON "transactions" ("date", "household_transactions");
@@ -0,0 +1,12 @@
+-- reverse: modify "transactions" table
+ALTER TABLE "transactions" DROP CONSTRAINT "transactions_household_members_paid_transactions", DROP COLUMN "household_member_paid_transactions", DROP COLUMN "split_shares", DROP COLUMN "split_type";
+-- reverse: create index "settlement_date_household_settlements" to table: "settlements"
+DROP INDEX "settlement_date_household_settlements";
+-- reverse: create "settlements" table
+DROP TABLE "settlements";
+-- reverse: modify "recurring_expenses" table
+ALTER TABLE "recurring_expenses" DROP CONSTRAINT "recurring_expenses_household_members_paid_recurring_expenses", DROP COLUMN "household_member_paid_recurring_expenses", DROP COLUMN "split_shares", DROP COLUMN "split_type";
+-- reverse: create index "householdmember_name_household_members" to table: "household_members"
+DROP INDEX "householdmember_name_household_members";
+-- reverse: create "household_members" table
+DROP TABLE "household_members";
@@ -0,0 +1,12 @@
+-- create "household_members" table
+CREATE TABLE "household_members" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "household_members" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "household_members_households_members" FOREIGN KEY ("household_members") REFERENCES "households" ("id") ON DELETE NO ACTION);
+-- create index "householdmember_name_household_members" to table: "household_members"
+CREATE UNIQUE INDEX "householdmember_name_household_members" ON "household_members" ("name", "household_members");
+-- modify "recurring_expenses" table
+ALTER TABLE "recurring_expenses" ADD COLUMN "split_type" character varying NULL, ADD COLUMN "split_shares" jsonb NULL, ADD COLUMN "household_member_paid_recurring_expenses" bigint NULL, ADD CONSTRAINT "recurring_expenses_household_members_paid_recurring_expenses" FOREIGN KEY ("household_member_paid_recurring_expenses") REFERENCES "household_members" ("id") ON DELETE SET NULL;
+-- create "settlements" table
+CREATE TABLE "settlements" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "amount" bigint NOT NULL, "date" timestamptz NOT NULL, "note" character varying NULL DEFAULT '', "created_at" timestamptz NOT NULL, "household_settlements" bigint NOT NULL, "household_member_settlements_paid" bigint NOT NULL, "household_member_settlements_received" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "settlements_households_settlements" FOREIGN KEY ("household_settlements") REFERENCES "households" ("id") ON DELETE NO ACTION, CONSTRAINT "settlements_household_members_settlements_paid" FOREIGN KEY ("household_member_settlements_paid") REFERENCES "household_members" ("id") ON DELETE NO ACTION, CONSTRAINT "settlements_household_members_settlements_received" FOREIGN KEY ("household_member_settlements_received") REFERENCES "household_members" ("id") ON DELETE NO ACTION);
+-- create index "settlement_date_household_settlements" to table: "settlements"
+CREATE INDEX "settlement_date_household_settlements" ON "settlements" ("date", "household_settlements");
+-- modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "split_type" character varying NULL, ADD COLUMN "split_shares" jsonb NULL, ADD COLUMN "household_member_paid_transactions" bigint NULL, ADD CONSTRAINT "transactions_household_members_paid_transactions" FOREIGN KEY ("household_member_paid_transactions") REFERENCES "household_members" ("id") ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- reverse: modify "api_tokens" table
+ALTER TABLE "api_tokens" DROP COLUMN "household_ids", DROP COLUMN "access";
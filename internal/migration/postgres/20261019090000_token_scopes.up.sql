@@ -0,0 +1,2 @@
+-- modify "api_tokens" table
+ALTER TABLE "api_tokens" ADD COLUMN "access" character varying NOT NULL DEFAULT 'write', ADD COLUMN "household_ids" jsonb NULL;
@@ -0,0 +1,4 @@
+-- reverse: create index "session_user_id" to table: "sessions"
+DROP INDEX "session_user_id";
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "last_seen_at", DROP COLUMN "ip", DROP COLUMN "user_agent";
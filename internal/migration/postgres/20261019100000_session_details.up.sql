@@ -0,0 +1,4 @@
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "user_agent" character varying NOT NULL DEFAULT '', ADD COLUMN "ip" character varying NOT NULL DEFAULT '', ADD COLUMN "last_seen_at" timestamptz NULL;
+-- create index "session_user_id" to table: "sessions"
+CREATE INDEX "session_user_id" ON "sessions" ("user_id");
@@ -0,0 +1,6 @@
+-- reverse: create index "local_credentials_user_local_credential_key" to table: "local_credentials"
+DROP INDEX "local_credentials_user_local_credential_key";
+-- reverse: create index "local_credentials_username_key" to table: "local_credentials"
+DROP INDEX "local_credentials_username_key";
+-- reverse: create "local_credentials" table
+DROP TABLE "local_credentials";
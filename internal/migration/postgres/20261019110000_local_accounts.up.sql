@@ -0,0 +1,6 @@
+-- create "local_credentials" table
+CREATE TABLE "local_credentials" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "username" character varying NOT NULL, "password_hash" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_local_credential" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "local_credentials_users_local_credential" FOREIGN KEY ("user_local_credential") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "local_credentials_username_key" to table: "local_credentials"
+CREATE UNIQUE INDEX "local_credentials_username_key" ON "local_credentials" ("username");
+-- create index "local_credentials_user_local_credential_key" to table: "local_credentials"
+CREATE UNIQUE INDEX "local_credentials_user_local_credential_key" ON "local_credentials" ("user_local_credential");
@@ -0,0 +1,2 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "admin" boolean NOT NULL DEFAULT false, ADD COLUMN "disabled_at" timestamptz NULL;
@@ -0,0 +1,6 @@
+-- reverse: create index "ratelimit_reset_at" to table: "rate_limits"
+DROP INDEX "ratelimit_reset_at";
+-- reverse: create index "rate_limits_key_key" to table: "rate_limits"
+DROP INDEX "rate_limits_key_key";
+-- reverse: create "rate_limits" table
+DROP TABLE "rate_limits";
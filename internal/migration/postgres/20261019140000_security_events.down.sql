@@ -0,0 +1,8 @@
+-- reverse: create index "securityevent_created_at" to table: "security_events"
+DROP INDEX "securityevent_created_at";
+-- reverse: create index "securityevent_user_id" to table: "security_events"
+DROP INDEX "securityevent_user_id";
+-- reverse: create "security_events" table
+DROP TABLE "security_events";
+-- reverse: modify "api_tokens" table
+ALTER TABLE "api_tokens" DROP COLUMN "last_used_ip";
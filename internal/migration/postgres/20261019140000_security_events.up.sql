@@ -0,0 +1,8 @@
+-- modify "api_tokens" table
+ALTER TABLE "api_tokens" ADD COLUMN "last_used_ip" character varying NOT NULL DEFAULT '';
+-- create "security_events" table
+CREATE TABLE "security_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "type" character varying NOT NULL, "user_id" bigint NULL, "ip" character varying NOT NULL DEFAULT '', "user_agent" character varying NOT NULL DEFAULT '', "details" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "securityevent_user_id" to table: "security_events"
+CREATE INDEX "securityevent_user_id" ON "security_events" ("user_id");
+-- create index "securityevent_created_at" to table: "security_events"
+CREATE INDEX "securityevent_created_at" ON "security_events" ("created_at");
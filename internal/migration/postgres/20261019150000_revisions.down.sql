@@ -0,0 +1,4 @@
+-- reverse: create index "revision_household_id_entity_type_entity_id" to table: "revisions"
+DROP INDEX "revision_household_id_entity_type_entity_id";
+-- reverse: create "revisions" table
+DROP TABLE "revisions";
@@ -0,0 +1,4 @@
+-- create "revisions" table
+CREATE TABLE "revisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "entity_type" character varying NOT NULL, "entity_id" bigint NOT NULL, "household_id" bigint NOT NULL, "action" character varying NOT NULL, "user_id" bigint NULL, "before" jsonb NULL, "after" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "revision_household_id_entity_type_entity_id" to table: "revisions"
+CREATE INDEX "revision_household_id_entity_type_entity_id" ON "revisions" ("household_id", "entity_type", "entity_id");
@@ -0,0 +1,12 @@
+-- reverse: create index "transaction_deleted_at" to table: "transactions"
+DROP INDEX "transaction_deleted_at";
+-- reverse: modify "transactions" table
+ALTER TABLE "transactions" DROP COLUMN "deleted_at";
+-- reverse: create index "recurringexpense_deleted_at" to table: "recurring_expenses"
+DROP INDEX "recurringexpense_deleted_at";
+-- reverse: modify "recurring_expenses" table
+ALTER TABLE "recurring_expenses" DROP COLUMN "deleted_at";
+-- reverse: create index "household_deleted_at" to table: "households"
+DROP INDEX "household_deleted_at";
+-- reverse: modify "households" table
+ALTER TABLE "households" DROP COLUMN "deleted_at";
@@ -0,0 +1,12 @@
+-- modify "households" table
+ALTER TABLE "households" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "household_deleted_at" to table: "households"
+CREATE INDEX "household_deleted_at" ON "households" ("deleted_at");
+-- modify "recurring_expenses" table
+ALTER TABLE "recurring_expenses" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "recurringexpense_deleted_at" to table: "recurring_expenses"
+CREATE INDEX "recurringexpense_deleted_at" ON "recurring_expenses" ("deleted_at");
+-- modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "transaction_deleted_at" to table: "transactions"
+CREATE INDEX "transaction_deleted_at" ON "transactions" ("deleted_at");
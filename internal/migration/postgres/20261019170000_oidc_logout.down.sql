@@ -0,0 +1,6 @@
+-- reverse: create index "session_oidc_sid" to table: "sessions"
+DROP INDEX "session_oidc_sid";
+-- reverse: create index "session_oidc_subject" to table: "sessions"
+DROP INDEX "session_oidc_subject";
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "oidc_sid", DROP COLUMN "oidc_subject";
@@ -0,0 +1,6 @@
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "oidc_subject" character varying NOT NULL DEFAULT '', ADD COLUMN "oidc_sid" character varying NOT NULL DEFAULT '';
+-- create index "session_oidc_subject" to table: "sessions"
+CREATE INDEX "session_oidc_subject" ON "sessions" ("oidc_subject");
+-- create index "session_oidc_sid" to table: "sessions"
+CREATE INDEX "session_oidc_sid" ON "sessions" ("oidc_sid");
@@ -0,0 +1,6 @@
+-- reverse: create index "useridentity_issuer_subject" to table: "user_identities"
+DROP INDEX "useridentity_issuer_subject";
+-- reverse: create "user_identities" table
+DROP TABLE "user_identities";
+-- reverse: modify "sessions" table
+ALTER TABLE "sessions" DROP COLUMN "oidc_issuer";
@@ -0,0 +1,6 @@
+-- modify "sessions" table
+ALTER TABLE "sessions" ADD COLUMN "oidc_issuer" character varying NOT NULL DEFAULT '';
+-- create "user_identities" table
+CREATE TABLE "user_identities" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "issuer" character varying NOT NULL, "subject" character varying NOT NULL, "email" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "user_identities" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "user_identities_users_identities" FOREIGN KEY ("user_identities") REFERENCES "users" ("id") ON DELETE NO ACTION);
+-- create index "useridentity_issuer_subject" to table: "user_identities"
+CREATE UNIQUE INDEX "useridentity_issuer_subject" ON "user_identities" ("issuer", "subject");
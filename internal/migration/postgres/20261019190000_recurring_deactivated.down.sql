@@ -0,0 +1,2 @@
+-- reverse: modify "recurring_expenses" table
+ALTER TABLE "recurring_expenses" DROP COLUMN "deactivated_at";
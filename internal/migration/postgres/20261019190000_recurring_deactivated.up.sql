@@ -0,0 +1,4 @@
+-- modify "recurring_expenses" table
+ALTER TABLE "recurring_expenses" ADD COLUMN "deactivated_at" timestamptz NULL;
+-- backfill "deactivated_at" of inactive recurring expenses
+UPDATE "recurring_expenses" SET "deactivated_at" = "updated_at" WHERE NOT "active";
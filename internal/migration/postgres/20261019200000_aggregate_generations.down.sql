@@ -0,0 +1,4 @@
+-- reverse: create index "aggregate_generations_household_aggregate_generation_key" to table: "aggregate_generations"
+DROP INDEX "aggregate_generations_household_aggregate_generation_key";
+-- reverse: create "aggregate_generations" table
+DROP TABLE "aggregate_generations";
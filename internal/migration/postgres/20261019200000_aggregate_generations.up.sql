@@ -0,0 +1,4 @@
+-- create "aggregate_generations" table
+CREATE TABLE "aggregate_generations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "generation" bigint NOT NULL DEFAULT 0, "household_aggregate_generation" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "aggregate_generations_households_aggregate_generation" FOREIGN KEY ("household_aggregate_generation") REFERENCES "households" ("id") ON DELETE NO ACTION);
+-- create index "aggregate_generations_household_aggregate_generation_key" to table: "aggregate_generations"
+CREATE UNIQUE INDEX "aggregate_generations_household_aggregate_generation_key" ON "aggregate_generations" ("household_aggregate_generation");
@@ -0,0 +1,4 @@
+-- store the members of equal splits without shares in "transactions"
+UPDATE "transactions" SET "split_shares" = (SELECT jsonb_agg(jsonb_build_object('member_id', "m"."id", 'value', 0) ORDER BY "m"."id") FROM "household_members" AS "m" WHERE "m"."household_members" = "transactions"."household_transactions") WHERE "split_type" = 'equal' AND "household_member_paid_transactions" IS NOT NULL AND coalesce("split_shares", '[]'::jsonb) IN ('[]'::jsonb, 'null'::jsonb);
+-- store the members of equal splits without shares in "recurring_expenses"
+UPDATE "recurring_expenses" SET "split_shares" = (SELECT jsonb_agg(jsonb_build_object('member_id', "m"."id", 'value', 0) ORDER BY "m"."id") FROM "household_members" AS "m" WHERE "m"."household_members" = "recurring_expenses"."household_recurring_expenses") WHERE "split_type" = 'equal' AND "household_member_paid_recurring_expenses" IS NOT NULL AND coalesce("split_shares", '[]'::jsonb) IN ('[]'::jsonb, 'null'::jsonb);
@@ -0,0 +1,6 @@
+-- reverse: create index "householdtransfer_email" to table: "household_transfers"
+DROP INDEX "householdtransfer_email";
+-- reverse: create index "household_transfers_household_transfer_key" to table: "household_transfers"
+DROP INDEX "household_transfers_household_transfer_key";
+-- reverse: create "household_transfers" table
+DROP TABLE "household_transfers";
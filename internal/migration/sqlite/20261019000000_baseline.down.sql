@@ -0,0 +1,38 @@
+-- reverse: create index "users_subject_key" to table: "users"
+DROP INDEX `users_subject_key`;
+-- reverse: create index "users_email_key" to table: "users"
+DROP INDEX `users_email_key`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create index "transaction_date_household_transactions" to table: "transactions"
+DROP INDEX `transaction_date_household_transactions`;
+-- reverse: create "transactions" table
+DROP TABLE `transactions`;
+-- reverse: create index "settings_key_key" to table: "settings"
+DROP INDEX `settings_key_key`;
+-- reverse: create "settings" table
+DROP TABLE `settings`;
+-- reverse: create index "session_expires_at" to table: "sessions"
+DROP INDEX `session_expires_at`;
+-- reverse: create index "sessions_token_key" to table: "sessions"
+DROP INDEX `sessions_token_key`;
+-- reverse: create "sessions" table
+DROP TABLE `sessions`;
+-- reverse: create "recurring_schedule_overrides" table
+DROP TABLE `recurring_schedule_overrides`;
+-- reverse: create "recurring_expenses" table
+DROP TABLE `recurring_expenses`;
+-- reverse: create index "monthlyaggregate_month_household_monthly_aggregates" to table: "monthly_aggregates"
+DROP INDEX `monthlyaggregate_month_household_monthly_aggregates`;
+-- reverse: create "monthly_aggregates" table
+DROP TABLE `monthly_aggregates`;
+-- reverse: create "households" table
+DROP TABLE `households`;
+-- reverse: create index "category_name_household_categories" to table: "categories"
+DROP INDEX `category_name_household_categories`;
+-- reverse: create "categories" table
+DROP TABLE `categories`;
+-- reverse: create index "api_tokens_token_hash_key" to table: "api_tokens"
+DROP INDEX `api_tokens_token_hash_key`;
+-- reverse: create "api_tokens" table
+DROP TABLE `api_tokens`;
@@ -0,0 +1,38 @@
+-- create "api_tokens" table
+CREATE TABLE `api_tokens` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `token_hash` text NOT NULL, `expires_at` datetime NULL, `last_used` datetime NULL, `created_at` datetime NOT NULL, `user_api_tokens` integer NOT NULL, CONSTRAINT `api_tokens_users_api_tokens` FOREIGN KEY (`user_api_tokens`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "api_tokens_token_hash_key" to table: "api_tokens"
+CREATE UNIQUE INDEX `api_tokens_token_hash_key` ON `api_tokens` (`token_hash`);
+-- create "categories" table
+CREATE TABLE `categories` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `icon` text NULL DEFAULT ('category'), `tax_class` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `household_categories` integer NOT NULL, CONSTRAINT `categories_households_categories` FOREIGN KEY (`household_categories`) REFERENCES `households` (`id`) ON DELETE NO ACTION);
+-- create index "category_name_household_categories" to table: "categories"
+CREATE UNIQUE INDEX `category_name_household_categories` ON `categories` (`name`, `household_categories`);
+-- create "households" table
+CREATE TABLE `households` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `currency` text NOT NULL DEFAULT ('EUR'), `description` text NULL DEFAULT (''), `icon` text NULL DEFAULT ('home'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `user_households` integer NOT NULL, CONSTRAINT `households_users_households` FOREIGN KEY (`user_households`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create "monthly_aggregates" table
+CREATE TABLE `monthly_aggregates` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `month` text NOT NULL, `version` integer NOT NULL, `gross_income` integer NOT NULL, `gross_expenses` integer NOT NULL, `recurring_total` integer NOT NULL, `one_time_total` integer NOT NULL, `monthly_total` integer NOT NULL, `categories` json NOT NULL, `computed_at` datetime NOT NULL, `household_monthly_aggregates` integer NOT NULL, CONSTRAINT `monthly_aggregates_households_monthly_aggregates` FOREIGN KEY (`household_monthly_aggregates`) REFERENCES `households` (`id`) ON DELETE NO ACTION);
+-- create index "monthlyaggregate_month_household_monthly_aggregates" to table: "monthly_aggregates"
+CREATE UNIQUE INDEX `monthlyaggregate_month_household_monthly_aggregates` ON `monthly_aggregates` (`month`, `household_monthly_aggregates`);
+-- create "recurring_expenses" table
+CREATE TABLE `recurring_expenses` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NULL DEFAULT (''), `details` text NULL DEFAULT (''), `amount` integer NOT NULL, `frequency` text NOT NULL, `active` bool NOT NULL DEFAULT (true), `start_date` datetime NOT NULL, `end_date` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `category_recurring_expenses` integer NOT NULL, `household_recurring_expenses` integer NOT NULL, CONSTRAINT `recurring_expenses_categories_recurring_expenses` FOREIGN KEY (`category_recurring_expenses`) REFERENCES `categories` (`id`) ON DELETE NO ACTION, CONSTRAINT `recurring_expenses_households_recurring_expenses` FOREIGN KEY (`household_recurring_expenses`) REFERENCES `households` (`id`) ON DELETE NO ACTION);
+-- create "recurring_schedule_overrides" table
+CREATE TABLE `recurring_schedule_overrides` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `effective_date` datetime NOT NULL, `amount` integer NOT NULL, `frequency` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `recurring_expense_schedule_overrides` integer NOT NULL, CONSTRAINT `recurring_schedule_overrides_re_78eeef2ea9dd1cbb2e2a01c277f1bcb6` FOREIGN KEY (`recurring_expense_schedule_overrides`) REFERENCES `recurring_expenses` (`id`) ON DELETE NO ACTION);
+-- create "sessions" table
+CREATE TABLE `sessions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token` text NOT NULL, `data` blob NOT NULL, `user_id` integer NULL, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "sessions_token_key" to table: "sessions"
+CREATE UNIQUE INDEX `sessions_token_key` ON `sessions` (`token`);
+-- create index "session_expires_at" to table: "sessions"
+CREATE INDEX `session_expires_at` ON `sessions` (`expires_at`);
+-- create "settings" table
+CREATE TABLE `settings` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `key` text NOT NULL, `value` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "settings_key_key" to table: "settings"
+CREATE UNIQUE INDEX `settings_key_key` ON `settings` (`key`);
+-- create "transactions" table
+CREATE TABLE `transactions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `amount` integer NOT NULL, `description` text NULL, `details` text NULL, `date` datetime NOT NULL, `tax_class` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `category_transactions` integer NOT NULL, `household_transactions` integer NOT NULL, CONSTRAINT `transactions_categories_transactions` FOREIGN KEY (`category_transactions`) REFERENCES `categories` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_households_transactions` FOREIGN KEY (`household_transactions`) REFERENCES `households` (`id`) ON DELETE NO ACTION);
+-- create index "transaction_date_household_transactions" to table: "transactions"
+CREATE INDEX `transaction_date_household_transactions` ON `transactions` (`date`, `household_transactions`);
+-- create "users" table
+CREATE TABLE `users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `email` text NOT NULL, `name` text NOT NULL, `subject` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX `users_email_key` ON `users` (`email`);
+-- create index "users_subject_key" to table: "users"
+CREATE UNIQUE INDEX `users_subject_key` ON `users` (`subject`);
@@ -0,0 +1,26 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_recurring_expenses" table
+CREATE TABLE `new_recurring_expenses` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NULL DEFAULT '', `details` text NULL DEFAULT '', `amount` integer NOT NULL, `frequency` text NOT NULL, `active` bool NOT NULL DEFAULT true, `start_date` datetime NOT NULL, `end_date` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `category_recurring_expenses` integer NOT NULL, `household_recurring_expenses` integer NOT NULL, CONSTRAINT `recurring_expenses_households_recurring_expenses` FOREIGN KEY (`household_recurring_expenses`) REFERENCES `households` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT `recurring_expenses_categories_recurring_expenses` FOREIGN KEY (`category_recurring_expenses`) REFERENCES `categories` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- copy rows from old table "recurring_expenses" to new temporary table "new_recurring_expenses"
+INSERT INTO `new_recurring_expenses` (`id`, `name`, `description`, `details`, `amount`, `frequency`, `active`, `start_date`, `end_date`, `created_at`, `updated_at`, `category_recurring_expenses`, `household_recurring_expenses`) SELECT `id`, `name`, `description`, `details`, `amount`, `frequency`, `active`, `start_date`, `end_date`, `created_at`, `updated_at`, `category_recurring_expenses`, `household_recurring_expenses` FROM `recurring_expenses`;
+-- drop "recurring_expenses" table after copying rows
+DROP TABLE `recurring_expenses`;
+-- rename temporary table "new_recurring_expenses" to "recurring_expenses"
+ALTER TABLE `new_recurring_expenses` RENAME TO `recurring_expenses`;
+-- create "new_transactions" table
+CREATE TABLE `new_transactions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `amount` integer NOT NULL, `description` text NULL, `details` text NULL, `date` datetime NOT NULL, `tax_class` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `category_transactions` integer NOT NULL, `household_transactions` integer NOT NULL, CONSTRAINT `transactions_households_transactions` FOREIGN KEY (`household_transactions`) REFERENCES `households` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT `transactions_categories_transactions` FOREIGN KEY (`category_transactions`) REFERENCES `categories` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- copy rows from old table "transactions" to new temporary table "new_transactions"
+INSERT INTO `new_transactions` (`id`, `amount`, `description`, `details`, `date`, `tax_class`, `created_at`, `updated_at`, `category_transactions`, `household_transactions`) SELECT `id`, `amount`, `description`, `details`, `date`, `tax_class`, `created_at`, `updated_at`, `category_transactions`, `household_transactions` FROM `transactions`;
+-- drop "transactions" table after copying rows
+DROP TABLE `transactions`;
+-- rename temporary table "new_transactions" to "transactions"
+ALTER TABLE `new_transactions` RENAME TO `transactions`;
+-- create index "transaction_date_household_transactions" to table: "transactions"
+CREATE INDEX `transaction_date_household_transactions` ON `transactions` (`date`, `household_transactions`);
+-- drop "household_members" table
+DROP TABLE `household_members`;
+-- drop "settlements" table
+DROP TABLE `settlements`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,16 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_sessions" table
+CREATE TABLE `new_sessions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token` text NOT NULL, `data` blob NOT NULL, `user_id` integer NULL, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- copy rows from old table "sessions" to new temporary table "new_sessions"
+INSERT INTO `new_sessions` (`id`, `token`, `data`, `user_id`, `expires_at`, `created_at`, `updated_at`) SELECT `id`, `token`, `data`, `user_id`, `expires_at`, `created_at`, `updated_at` FROM `sessions`;
+-- drop "sessions" table after copying rows
+DROP TABLE `sessions`;
+-- rename temporary table "new_sessions" to "sessions"
+ALTER TABLE `new_sessions` RENAME TO `sessions`;
+-- create index "sessions_token_key" to table: "sessions"
+CREATE UNIQUE INDEX `sessions_token_key` ON `sessions` (`token`);
+-- create index "session_expires_at" to table: "sessions"
+CREATE INDEX `session_expires_at` ON `sessions` (`expires_at`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "local_credentials" table
+DROP TABLE `local_credentials`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
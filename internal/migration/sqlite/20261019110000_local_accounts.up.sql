@@ -0,0 +1,6 @@
+-- create "local_credentials" table
+CREATE TABLE `local_credentials` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `username` text NOT NULL, `password_hash` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `user_local_credential` integer NOT NULL, CONSTRAINT `local_credentials_users_local_credential` FOREIGN KEY (`user_local_credential`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "local_credentials_username_key" to table: "local_credentials"
+CREATE UNIQUE INDEX `local_credentials_username_key` ON `local_credentials` (`username`);
+-- create index "local_credentials_user_local_credential_key" to table: "local_credentials"
+CREATE UNIQUE INDEX `local_credentials_user_local_credential_key` ON `local_credentials` (`user_local_credential`);
@@ -0,0 +1,16 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_users" table
+CREATE TABLE `new_users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `email` text NOT NULL, `name` text NOT NULL, `subject` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- copy rows from old table "users" to new temporary table "new_users"
+INSERT INTO `new_users` (`id`, `email`, `name`, `subject`, `created_at`, `updated_at`) SELECT `id`, `email`, `name`, `subject`, `created_at`, `updated_at` FROM `users`;
+-- drop "users" table after copying rows
+DROP TABLE `users`;
+-- rename temporary table "new_users" to "users"
+ALTER TABLE `new_users` RENAME TO `users`;
+-- create index "users_email_key" to table: "users"
+CREATE UNIQUE INDEX `users_email_key` ON `users` (`email`);
+-- create index "users_subject_key" to table: "users"
+CREATE UNIQUE INDEX `users_subject_key` ON `users` (`subject`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
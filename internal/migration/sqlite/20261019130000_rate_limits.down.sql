@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "rate_limits" table
+DROP TABLE `rate_limits`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- create "rate_limits" table
+CREATE TABLE `rate_limits` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `key` text NOT NULL, `count` integer NOT NULL DEFAULT (0), `reset_at` datetime NOT NULL);
+-- create index "rate_limits_key_key" to table: "rate_limits"
+CREATE UNIQUE INDEX `rate_limits_key_key` ON `rate_limits` (`key`);
+-- create index "ratelimit_reset_at" to table: "rate_limits"
+CREATE INDEX `ratelimit_reset_at` ON `rate_limits` (`reset_at`);
@@ -0,0 +1,4 @@
+-- create "revisions" table
+CREATE TABLE `revisions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `entity_type` text NOT NULL, `entity_id` integer NOT NULL, `household_id` integer NOT NULL, `action` text NOT NULL, `user_id` integer NULL, `before` json NULL, `after` json NULL, `created_at` datetime NOT NULL);
+-- create index "revision_household_id_entity_type_entity_id" to table: "revisions"
+CREATE INDEX `revision_household_id_entity_type_entity_id` ON `revisions` (`household_id`, `entity_type`, `entity_id`);
@@ -0,0 +1,36 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_households" table
+CREATE TABLE `new_households` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `currency` text NOT NULL DEFAULT ('EUR'), `description` text NULL DEFAULT (''), `icon` text NULL DEFAULT ('home'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `user_households` integer NOT NULL, CONSTRAINT `households_users_households` FOREIGN KEY (`user_households`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- copy rows from old table "households" to new temporary table "new_households"
+INSERT INTO `new_households` (`id`, `name`, `currency`, `description`, `icon`, `created_at`, `updated_at`, `user_households`) SELECT `id`, `name`, `currency`, `description`, `icon`, `created_at`, `updated_at`, `user_households` FROM `households`;
+-- drop "households" table after copying rows
+DROP TABLE `households`;
+-- rename temporary table "new_households" to "households"
+ALTER TABLE `new_households` RENAME TO `households`;
+-- create index "household_deleted_at" to table: "households"
+CREATE INDEX `household_deleted_at` ON `households` (`deleted_at`);
+-- create "new_recurring_expenses" table
+CREATE TABLE `new_recurring_expenses` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NULL DEFAULT (''), `details` text NULL DEFAULT (''), `amount` integer NOT NULL, `frequency` text NOT NULL, `active` bool NOT NULL DEFAULT (true), `start_date` datetime NOT NULL, `end_date` datetime NULL, `split_type` text NULL, `split_shares` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `category_recurring_expenses` integer NOT NULL, `household_recurring_expenses` integer NOT NULL, `household_member_paid_recurring_expenses` integer NULL, CONSTRAINT `recurring_expenses_categories_recurring_expenses` FOREIGN KEY (`category_recurring_expenses`) REFERENCES `categories` (`id`) ON DELETE NO ACTION, CONSTRAINT `recurring_expenses_households_recurring_expenses` FOREIGN KEY (`household_recurring_expenses`) REFERENCES `households` (`id`) ON DELETE NO ACTION, CONSTRAINT `recurring_expenses_household_members_paid_recurring_expenses` FOREIGN KEY (`household_member_paid_recurring_expenses`) REFERENCES `household_members` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "recurring_expenses" to new temporary table "new_recurring_expenses"
+INSERT INTO `new_recurring_expenses` (`id`, `name`, `description`, `details`, `amount`, `frequency`, `active`, `start_date`, `end_date`, `split_type`, `split_shares`, `created_at`, `updated_at`, `category_recurring_expenses`, `household_recurring_expenses`, `household_member_paid_recurring_expenses`) SELECT `id`, `name`, `description`, `details`, `amount`, `frequency`, `active`, `start_date`, `end_date`, `split_type`, `split_shares`, `created_at`, `updated_at`, `category_recurring_expenses`, `household_recurring_expenses`, `household_member_paid_recurring_expenses` FROM `recurring_expenses`;
+-- drop "recurring_expenses" table after copying rows
+DROP TABLE `recurring_expenses`;
+-- rename temporary table "new_recurring_expenses" to "recurring_expenses"
+ALTER TABLE `new_recurring_expenses` RENAME TO `recurring_expenses`;
+-- create index "recurringexpense_deleted_at" to table: "recurring_expenses"
+CREATE INDEX `recurringexpense_deleted_at` ON `recurring_expenses` (`deleted_at`);
+-- create "new_transactions" table
+CREATE TABLE `new_transactions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `amount` integer NOT NULL, `description` text NULL, `details` text NULL, `date` datetime NOT NULL, `tax_class` text NULL, `split_type` text NULL, `split_shares` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `category_transactions` integer NOT NULL, `household_transactions` integer NOT NULL, `household_member_paid_transactions` integer NULL, CONSTRAINT `transactions_categories_transactions` FOREIGN KEY (`category_transactions`) REFERENCES `categories` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_households_transactions` FOREIGN KEY (`household_transactions`) REFERENCES `households` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_household_members_paid_transactions` FOREIGN KEY (`household_member_paid_transactions`) REFERENCES `household_members` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "transactions" to new temporary table "new_transactions"
+INSERT INTO `new_transactions` (`id`, `amount`, `description`, `details`, `date`, `tax_class`, `split_type`, `split_shares`, `created_at`, `updated_at`, `category_transactions`, `household_transactions`, `household_member_paid_transactions`) SELECT `id`, `amount`, `description`, `details`, `date`, `tax_class`, `split_type`, `split_shares`, `created_at`, `updated_at`, `category_transactions`, `household_transactions`, `household_member_paid_transactions` FROM `transactions`;
+-- drop "transactions" table after copying rows
+DROP TABLE `transactions`;
+-- rename temporary table "new_transactions" to "transactions"
+ALTER TABLE `new_transactions` RENAME TO `transactions`;
+-- create index "transaction_date_household_transactions" to table: "transactions"
+CREATE INDEX `transaction_date_household_transactions` ON `transactions` (`date`, `household_transactions`);
+-- create index "transaction_deleted_at" to table: "transactions"
+CREATE INDEX `transaction_deleted_at` ON `transactions` (`deleted_at`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,22 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_sessions" table
+CREATE TABLE `new_sessions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token` text NOT NULL, `data` blob NOT NULL, `user_id` integer NULL, `expires_at` datetime NOT NULL, `user_agent` text NOT NULL DEFAULT (''), `ip` text NOT NULL DEFAULT (''), `last_seen_at` datetime NULL, `oidc_subject` text NOT NULL DEFAULT (''), `oidc_sid` text NOT NULL DEFAULT (''), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- copy rows from old table "sessions" to new temporary table "new_sessions"
+INSERT INTO `new_sessions` (`id`, `token`, `data`, `user_id`, `expires_at`, `user_agent`, `ip`, `last_seen_at`, `created_at`, `updated_at`) SELECT `id`, `token`, `data`, `user_id`, `expires_at`, `user_agent`, `ip`, `last_seen_at`, `created_at`, `updated_at` FROM `sessions`;
+-- drop "sessions" table after copying rows
+DROP TABLE `sessions`;
+-- rename temporary table "new_sessions" to "sessions"
+ALTER TABLE `new_sessions` RENAME TO `sessions`;
+-- create index "sessions_token_key" to table: "sessions"
+CREATE UNIQUE INDEX `sessions_token_key` ON `sessions` (`token`);
+-- create index "session_expires_at" to table: "sessions"
+CREATE INDEX `session_expires_at` ON `sessions` (`expires_at`);
+-- create index "session_user_id" to table: "sessions"
+CREATE INDEX `session_user_id` ON `sessions` (`user_id`);
+-- create index "session_oidc_subject" to table: "sessions"
+CREATE INDEX `session_oidc_subject` ON `sessions` (`oidc_subject`);
+-- create index "session_oidc_sid" to table: "sessions"
+CREATE INDEX `session_oidc_sid` ON `sessions` (`oidc_sid`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
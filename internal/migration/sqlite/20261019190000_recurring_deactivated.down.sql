@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_recurring_expenses" table
+CREATE TABLE `new_recurring_expenses` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NULL DEFAULT '', `details` text NULL DEFAULT '', `amount` integer NOT NULL, `frequency` text NOT NULL, `active` bool NOT NULL DEFAULT true, `start_date` datetime NOT NULL, `end_date` datetime NULL, `split_type` text NULL, `split_shares` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `category_recurring_expenses` integer NOT NULL, `household_recurring_expenses` integer NOT NULL, `household_member_paid_recurring_expenses` integer NULL, CONSTRAINT `recurring_expenses_household_members_paid_recurring_expenses` FOREIGN KEY (`household_member_paid_recurring_expenses`) REFERENCES `household_members` (`id`) ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT `recurring_expenses_households_recurring_expenses` FOREIGN KEY (`household_recurring_expenses`) REFERENCES `households` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT `recurring_expenses_categories_recurring_expenses` FOREIGN KEY (`category_recurring_expenses`) REFERENCES `categories` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- copy rows from old table "recurring_expenses" to new temporary table "new_recurring_expenses"
+INSERT INTO `new_recurring_expenses` (`id`, `name`, `description`, `details`, `amount`, `frequency`, `active`, `start_date`, `end_date`, `split_type`, `split_shares`, `created_at`, `updated_at`, `deleted_at`, `category_recurring_expenses`, `household_recurring_expenses`, `household_member_paid_recurring_expenses`) SELECT `id`, `name`, `description`, `details`, `amount`, `frequency`, `active`, `start_date`, `end_date`, `split_type`, `split_shares`, `created_at`, `updated_at`, `deleted_at`, `category_recurring_expenses`, `household_recurring_expenses`, `household_member_paid_recurring_expenses` FROM `recurring_expenses`;
+-- drop "recurring_expenses" table after copying rows
+DROP TABLE `recurring_expenses`;
+-- rename temporary table "new_recurring_expenses" to "recurring_expenses"
+ALTER TABLE `new_recurring_expenses` RENAME TO `recurring_expenses`;
+-- create index "recurringexpense_deleted_at" to table: "recurring_expenses"
+CREATE INDEX `recurringexpense_deleted_at` ON `recurring_expenses` (`deleted_at`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,4 @@
+-- add column "deactivated_at" to table: "recurring_expenses"
+ALTER TABLE `recurring_expenses` ADD COLUMN `deactivated_at` datetime NULL;
+-- backfill "deactivated_at" of inactive recurring expenses
+UPDATE `recurring_expenses` SET `deactivated_at` = `updated_at` WHERE NOT `active`;
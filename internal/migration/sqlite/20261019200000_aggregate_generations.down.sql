@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "aggregate_generations" table
+DROP TABLE `aggregate_generations`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,4 @@
+-- create "aggregate_generations" table
+CREATE TABLE `aggregate_generations` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `generation` integer NOT NULL DEFAULT (0), `household_aggregate_generation` integer NOT NULL, CONSTRAINT `aggregate_generations_households_aggregate_generation` FOREIGN KEY (`household_aggregate_generation`) REFERENCES `households` (`id`) ON DELETE NO ACTION);
+-- create index "aggregate_generations_household_aggregate_generation_key" to table: "aggregate_generations"
+CREATE UNIQUE INDEX `aggregate_generations_household_aggregate_generation_key` ON `aggregate_generations` (`household_aggregate_generation`);
@@ -0,0 +1,2 @@
+-- the stored members stay valid for equal splits, there is nothing to revert
+SELECT 1;
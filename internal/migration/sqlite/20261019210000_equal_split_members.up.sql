@@ -0,0 +1,4 @@
+-- store the members of equal splits without shares in "transactions"
+UPDATE `transactions` SET `split_shares` = (SELECT json_group_array(json_object('member_id', `m`.`id`, 'value', 0)) FROM (SELECT `id` FROM `household_members` WHERE `household_members` = `transactions`.`household_transactions` ORDER BY `id`) AS `m`) WHERE `split_type` = 'equal' AND `household_member_paid_transactions` IS NOT NULL AND coalesce(json_array_length(`split_shares`), 0) = 0;
+-- store the members of equal splits without shares in "recurring_expenses"
+UPDATE `recurring_expenses` SET `split_shares` = (SELECT json_group_array(json_object('member_id', `m`.`id`, 'value', 0)) FROM (SELECT `id` FROM `household_members` WHERE `household_members` = `recurring_expenses`.`household_recurring_expenses` ORDER BY `id`) AS `m`) WHERE `split_type` = 'equal' AND `household_member_paid_recurring_expenses` IS NOT NULL AND coalesce(json_array_length(`split_shares`), 0) = 0;
@@ -0,0 +1,14 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_api_tokens" table
+CREATE TABLE `new_api_tokens` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `token_hash` text NOT NULL, `access` text NOT NULL DEFAULT 'write', `household_ids` json NULL, `expires_at` datetime NULL, `last_used` datetime NULL, `last_used_ip` text NOT NULL DEFAULT '', `created_at` datetime NOT NULL, `user_api_tokens` integer NOT NULL, CONSTRAINT `api_tokens_users_api_tokens` FOREIGN KEY (`user_api_tokens`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- copy rows from old table "api_tokens" to new temporary table "new_api_tokens"
+INSERT INTO `new_api_tokens` (`id`, `name`, `token_hash`, `access`, `household_ids`, `expires_at`, `last_used`, `last_used_ip`, `created_at`, `user_api_tokens`) SELECT `id`, `name`, `token_hash`, `access`, `household_ids`, `expires_at`, `last_used`, `last_used_ip`, `created_at`, `user_api_tokens` FROM `api_tokens`;
+-- drop "api_tokens" table after copying rows
+DROP TABLE `api_tokens`;
+-- rename temporary table "new_api_tokens" to "api_tokens"
+ALTER TABLE `new_api_tokens` RENAME TO `api_tokens`;
+-- create index "api_tokens_token_hash_key" to table: "api_tokens"
+CREATE UNIQUE INDEX `api_tokens_token_hash_key` ON `api_tokens` (`token_hash`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- add column "rotated_at" to table: "api_tokens"
+ALTER TABLE `api_tokens` ADD COLUMN `rotated_at` datetime NULL;
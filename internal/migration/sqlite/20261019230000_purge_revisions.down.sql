@@ -0,0 +1,2 @@
+-- the deleted revisions can't be restored, there is nothing to revert
+SELECT 1;
@@ -0,0 +1,4 @@
+-- delete the revisions of records that were purged from the trash
+DELETE FROM `revisions` WHERE EXISTS (SELECT 1 FROM `revisions` AS `p` WHERE `p`.`action` = 'purge' AND `p`.`entity_type` = `revisions`.`entity_type` AND `p`.`entity_id` = `revisions`.`entity_id`);
+-- delete the revisions of purged households
+DELETE FROM `revisions` WHERE `household_id` NOT IN (SELECT `id` FROM `households`);
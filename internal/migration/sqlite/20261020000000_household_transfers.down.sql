@@ -0,0 +1,6 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- drop "household_transfers" table
+DROP TABLE `household_transfers`;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
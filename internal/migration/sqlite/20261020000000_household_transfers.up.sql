@@ -0,0 +1,6 @@
+-- create "household_transfers" table
+CREATE TABLE `household_transfers` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `email` text NOT NULL, `created_at` datetime NOT NULL, `household_transfer` integer NOT NULL, `user_household_transfers` integer NOT NULL, CONSTRAINT `household_transfers_households_transfer` FOREIGN KEY (`household_transfer`) REFERENCES `households` (`id`) ON DELETE NO ACTION, CONSTRAINT `household_transfers_users_household_transfers` FOREIGN KEY (`user_household_transfers`) REFERENCES `users` (`id`) ON DELETE NO ACTION);
+-- create index "household_transfers_household_transfer_key" to table: "household_transfers"
+CREATE UNIQUE INDEX `household_transfers_household_transfer_key` ON `household_transfers` (`household_transfer`);
+-- create index "householdtransfer_email" to table: "household_transfers"
+CREATE INDEX `householdtransfer_email` ON `household_transfers` (`email`);
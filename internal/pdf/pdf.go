@@ -0,0 +1,200 @@
+// Package pdf is a minimal PDF writer for simple reports. It supports A4
+// pages, the standard Helvetica fonts, filled rectangles and paths, lines and
+// text. Documents implement chart.Canvas so charts can be embedded directly.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"icekalt.dev/money-tracker/internal/chart"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document collects pages and writes them as a PDF file. Coordinates start at
+// the top left corner of the page, y grows downwards, like in chart.Canvas.
+type Document struct {
+	title  string
+	pages  []*bytes.Buffer
+	cur    *bytes.Buffer
+	dx, dy float64 // origin offset while drawing a chart
+}
+
+var _ chart.Canvas = (*Document)(nil)
+
+// New returns an empty document. The title is stored in the document info.
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+// AddPage starts a new page. Drawing before the first AddPage adds a page
+// implicitly.
+func (d *Document) AddPage() {
+	d.cur = &bytes.Buffer{}
+	d.pages = append(d.pages, d.cur)
+}
+
+// PageCount returns the number of pages so far.
+func (d *Document) PageCount() int {
+	return len(d.pages)
+}
+
+// Draw renders ch into the box at x, y with the given size.
+func (d *Document) Draw(ch chart.Chart, x, y, width, height float64) {
+	d.dx, d.dy = x, y
+	ch.Draw(d, width, height)
+	d.dx, d.dy = 0, 0
+}
+
+func (d *Document) page() *bytes.Buffer {
+	if d.cur == nil {
+		d.AddPage()
+	}
+	return d.cur
+}
+
+func (d *Document) px(x float64) string { return num(x + d.dx) }
+func (d *Document) py(y float64) string { return num(PageHeight - (y + d.dy)) }
+
+func (d *Document) Rect(x, y, w, h float64, fill string) {
+	fmt.Fprintf(d.page(), "%s %s %s %s %s re f\n", color(fill, "rg"), d.px(x), d.py(y+h), num(w), num(h))
+}
+
+func (d *Document) Path(p *chart.Path, fill string) {
+	b := d.page()
+	b.WriteString(color(fill, "rg"))
+	for _, op := range p.Ops {
+		switch op.Kind {
+		case chart.OpMoveTo:
+			fmt.Fprintf(b, " %s %s m", d.px(op.Points[0].X), d.py(op.Points[0].Y))
+		case chart.OpLineTo:
+			fmt.Fprintf(b, " %s %s l", d.px(op.Points[0].X), d.py(op.Points[0].Y))
+		case chart.OpCubicTo:
+			fmt.Fprintf(b, " %s %s %s %s %s %s c",
+				d.px(op.Points[0].X), d.py(op.Points[0].Y),
+				d.px(op.Points[1].X), d.py(op.Points[1].Y),
+				d.px(op.Points[2].X), d.py(op.Points[2].Y))
+		case chart.OpClose:
+			b.WriteString(" h")
+		}
+	}
+	b.WriteString(" f\n")
+}
+
+func (d *Document) Line(x1, y1, x2, y2 float64, stroke string, width float64) {
+	fmt.Fprintf(d.page(), "%s %s w %s %s m %s %s l S\n",
+		color(stroke, "RG"), num(width), d.px(x1), d.py(y1), d.px(x2), d.py(y2))
+}
+
+func (d *Document) Text(x, y float64, s string, style chart.TextStyle) {
+	switch style.Anchor {
+	case chart.AnchorMiddle:
+		x -= TextWidth(s, style.Size, style.Bold) / 2
+	case chart.AnchorEnd:
+		x -= TextWidth(s, style.Size, style.Bold)
+	}
+	font := "F1"
+	if style.Bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.page(), "BT %s /%s %s Tf %s %s Td (%s) Tj ET\n",
+		color(style.Color, "rg"), font, num(style.Size), d.px(x), d.py(y), escape(encode(s)))
+}
+
+// Bytes returns the complete PDF file.
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := d.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteTo writes the complete PDF file to w.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Fixed object numbers: 1 catalog, 2 page tree, 3 info, 4-5 fonts, then
+	// a page object and a content stream per page.
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	obj(fmt.Sprintf("<< /Title (%s) /Producer (money-tracker) >>", escape(encode(d.title))))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, p := range d.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), 7+2*i))
+
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		if _, err := zw.Write(p.Bytes()); err != nil {
+			return 0, err
+		}
+		if err := zw.Close(); err != nil {
+			return 0, err
+		}
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", len(offsets), z.Len())
+		out.Write(z.Bytes())
+		out.WriteString("\nendstream\nendobj\n")
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 3 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+// color converts a "#rrggbb" color to a PDF color operator. Invalid colors
+// fall back to black.
+func color(hex, op string) string {
+	var r, g, b float64
+	if len(hex) == 7 && hex[0] == '#' {
+		if v, err := strconv.ParseUint(hex[1:], 16, 32); err == nil {
+			r = float64(v>>16&0xff) / 255
+			g = float64(v>>8&0xff) / 255
+			b = float64(v&0xff) / 255
+		}
+	}
+	return fmt.Sprintf("%s %s %s %s", num(r), num(g), num(b), op)
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+func escape(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`, "\r", `\r`, "\n", `\n`)
+	return r.Replace(s)
+}
@@ -0,0 +1,104 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"icekalt.dev/money-tracker/internal/chart"
+)
+
+func TestDocumentStructure(t *testing.T) {
+	d := New("Report (2025)")
+	d.Text(40, 40, "Hello", chart.TextStyle{Size: 12, Color: chart.TextColor})
+	d.AddPage()
+	d.Draw(chart.Donut{Title: "Donut", Slices: []chart.Slice{{Label: "A", Value: 1}, {Label: "B", Value: 2}}}, 40, 100, 300, 150)
+
+	data, err := d.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF-1.4\n")) {
+		t.Fatalf("missing header: %q", data[:16])
+	}
+	if !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatal("missing trailer")
+	}
+	if !bytes.Contains(data, []byte("/Count 2")) {
+		t.Error("expected two pages")
+	}
+	if !bytes.Contains(data, []byte(`/Title (Report \(2025\))`)) {
+		t.Error("title not escaped")
+	}
+
+	// Every xref entry must point at the start of its object.
+	m := regexp.MustCompile(`(?s)xref\n0 (\d+)\n(.*?)trailer`).FindSubmatch(data)
+	if m == nil {
+		t.Fatal("xref table not found")
+	}
+	entries := strings.Split(strings.TrimSpace(string(m[2])), "\n")[1:]
+	for i, e := range entries {
+		off, _ := strconv.Atoi(e[:10])
+		want := fmt.Sprintf("%d 0 obj", i+1)
+		if !bytes.HasPrefix(data[off:], []byte(want)) {
+			t.Errorf("xref entry %d points to %q, want %q", i+1, data[off:off+10], want)
+		}
+	}
+	start := regexp.MustCompile(`startxref\n(\d+)`).FindSubmatch(data)
+	if off, _ := strconv.Atoi(string(start[1])); !bytes.HasPrefix(data[off:], []byte("xref")) {
+		t.Errorf("startxref %d does not point to xref", off)
+	}
+}
+
+func TestContentStream(t *testing.T) {
+	d := New("")
+	d.Text(10, 20, "Miete (Ä) 1.234,00 €", chart.TextStyle{Size: 10, Color: "#ff0000", Bold: true})
+	d.Rect(10, 30, 100, 20, "#0000ff")
+
+	data, _ := d.Bytes()
+	i := bytes.Index(data, []byte("stream\n"))
+	j := bytes.Index(data, []byte("\nendstream"))
+	zr, err := zlib.NewReader(bytes.NewReader(data[i+len("stream\n") : j]))
+	if err != nil {
+		t.Fatalf("content stream not compressed: %v", err)
+	}
+	content, _ := io.ReadAll(zr)
+
+	if !bytes.Contains(content, []byte("1 0 0 rg /F2 10 Tf 10 821.89 Td (Miete \\(\xc4\\) 1.234,00 \x80) Tj")) {
+		t.Errorf("unexpected text operator:\n%s", content)
+	}
+	if !bytes.Contains(content, []byte("0 0 1 rg 10 791.89 100 20 re f")) {
+		t.Errorf("unexpected rect operator:\n%s", content)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	if got := encode("Grüße – 5 €"); got != "Gr\xfc\xdfe \x96 5 \x80" {
+		t.Errorf("encode = %q", got)
+	}
+	if got := encode("日本"); got != "??" {
+		t.Errorf("encode = %q, want ??", got)
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	if got := TextWidth("10", 10, false); got != 11.12 {
+		t.Errorf("TextWidth = %v, want 11.12", got)
+	}
+	if TextWidth("Wm", 10, true) <= TextWidth("Wm", 10, false) {
+		t.Error("bold text should be wider")
+	}
+
+	s := Truncate("A very long description", 60, 10, false)
+	if !strings.HasSuffix(s, "…") || TextWidth(s, 10, false) > 60 {
+		t.Errorf("Truncate = %q", s)
+	}
+	if got := Truncate("Short", 60, 10, false); got != "Short" {
+		t.Errorf("Truncate = %q, want Short", got)
+	}
+}
@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"icekalt.dev/money-tracker/ent"
+	entratelimit "icekalt.dev/money-tracker/ent/ratelimit"
+)
+
+var errConcurrentWindow = errors.New("rate limit window changed concurrently")
+
+// DBStore keeps the counters in the database, so all instances sharing it
+// count together.
+type DBStore struct {
+	client *ent.Client
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewDBStore returns a store backed by the rate_limits table.
+func NewDBStore(client *ent.Client) *DBStore {
+	return &DBStore{client: client}
+}
+
+func (s *DBStore) Hit(ctx context.Context, key string, window time.Duration, now time.Time) (Counter, error) {
+	if err := s.sweep(ctx, now); err != nil {
+		return Counter{}, err
+	}
+
+	// The increment runs in the database, so concurrent hits from several
+	// instances don't get lost. If there is no current window, start one;
+	// when another instance started it first, the create fails on the
+	// unique key and the second round increments that window.
+	for range 2 {
+		n, err := s.client.RateLimit.Update().
+			Where(entratelimit.KeyEQ(key), entratelimit.ResetAtGT(now)).
+			AddCount(1).
+			Save(ctx)
+		if err != nil {
+			return Counter{}, err
+		}
+		if n > 0 {
+			row, err := s.client.RateLimit.Query().
+				Where(entratelimit.KeyEQ(key)).
+				Only(ctx)
+			if err != nil {
+				return Counter{}, err
+			}
+			return Counter{Count: row.Count, ResetAt: row.ResetAt}, nil
+		}
+
+		if _, err := s.client.RateLimit.Delete().
+			Where(entratelimit.KeyEQ(key), entratelimit.ResetAtLTE(now)).
+			Exec(ctx); err != nil {
+			return Counter{}, err
+		}
+		row, err := s.client.RateLimit.Create().
+			SetKey(key).
+			SetCount(1).
+			SetResetAt(now.Add(window)).
+			Save(ctx)
+		if err == nil {
+			return Counter{Count: row.Count, ResetAt: row.ResetAt}, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return Counter{}, err
+		}
+	}
+	return Counter{}, errConcurrentWindow
+}
+
+func (s *DBStore) Get(ctx context.Context, key string, now time.Time) (Counter, error) {
+	row, err := s.client.RateLimit.Query().
+		Where(entratelimit.KeyEQ(key), entratelimit.ResetAtGT(now)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return Counter{}, nil
+		}
+		return Counter{}, err
+	}
+	return Counter{Count: row.Count, ResetAt: row.ResetAt}, nil
+}
+
+// sweep deletes ended windows at most every sweepInterval.
+func (s *DBStore) sweep(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < sweepInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	_, err := s.client.RateLimit.Delete().
+		Where(entratelimit.ResetAtLTE(now)).
+		Exec(ctx)
+	return err
+}
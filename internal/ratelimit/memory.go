@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the stores drop counters whose window has
+// ended.
+const sweepInterval = time.Minute
+
+// MemoryStore keeps the counters in process memory. Every instance counts
+// on its own and the counters are lost on restart.
+type MemoryStore struct {
+	mu        sync.Mutex
+	counters  map[string]Counter
+	lastSweep time.Time
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{counters: make(map[string]Counter)}
+}
+
+func (s *MemoryStore) Hit(_ context.Context, key string, window time.Duration, now time.Time) (Counter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.ResetAt) {
+		c = Counter{ResetAt: now.Add(window)}
+	}
+	c.Count++
+	s.counters[key] = c
+	return c, nil
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string, now time.Time) (Counter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.ResetAt) {
+		return Counter{}, nil
+	}
+	return c, nil
+}
+
+// sweep drops ended windows at most every sweepInterval, so the map doesn't
+// grow with every address ever seen. The caller holds mu.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, c := range s.counters {
+		if !now.Before(c.ResetAt) {
+			delete(s.counters, key)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package ratelimit counts requests per client in fixed windows and locks
+// out clients that keep presenting invalid credentials.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Counter is the state of one key in the current window.
+type Counter struct {
+	Count   int
+	ResetAt time.Time
+}
+
+// Store keeps the counters. A window starts with the first hit of a key
+// and the counter starts over once it has ended.
+type Store interface {
+	// Hit adds one to the counter of key and returns the new state.
+	Hit(ctx context.Context, key string, window time.Duration, now time.Time) (Counter, error)
+	// Get returns the state of key without counting. Keys without hits in
+	// the current window have a zero Counter.
+	Get(ctx context.Context, key string, now time.Time) (Counter, error)
+}
+
+// Rule allows Limit requests per Window. A zero Limit disables the rule.
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Limiter applies the configured rules to a Store.
+type Limiter struct {
+	store Store
+
+	// IP limits the requests per client address, Token the requests per
+	// bearer token and Auth the requests to login endpoints per address.
+	IP    Rule
+	Token Rule
+	Auth  Rule
+
+	// MaxFailures invalid tokens from one address within Lockout lock the
+	// address out until the Lockout window ends. Zero disables the lockout.
+	MaxFailures int
+	Lockout     time.Duration
+}
+
+// New returns a limiter without rules on store.
+func New(store Store) *Limiter {
+	return &Limiter{store: store}
+}
+
+// Allow counts a request for key under rule. If the limit is exceeded it
+// returns false and how long the client has to wait.
+func (l *Limiter) Allow(ctx context.Context, key string, rule Rule, now time.Time) (bool, time.Duration, error) {
+	if rule.Limit <= 0 {
+		return true, 0, nil
+	}
+	c, err := l.store.Hit(ctx, key, rule.Window, now)
+	if err != nil {
+		return false, 0, err
+	}
+	if c.Count > rule.Limit {
+		return false, c.ResetAt.Sub(now), nil
+	}
+	return true, 0, nil
+}
+
+// Locked reports how long the address ip is still locked out, or zero.
+func (l *Limiter) Locked(ctx context.Context, ip string, now time.Time) (time.Duration, error) {
+	if l.MaxFailures <= 0 {
+		return 0, nil
+	}
+	c, err := l.store.Get(ctx, failureKey(ip), now)
+	if err != nil {
+		return 0, err
+	}
+	if c.Count < l.MaxFailures {
+		return 0, nil
+	}
+	return c.ResetAt.Sub(now), nil
+}
+
+// Fail records an invalid credential presented from ip.
+func (l *Limiter) Fail(ctx context.Context, ip string, now time.Time) error {
+	if l.MaxFailures <= 0 {
+		return nil
+	}
+	_, err := l.store.Hit(ctx, failureKey(ip), l.Lockout, now)
+	return err
+}
+
+func failureKey(ip string) string {
+	return "fail:" + ip
+}
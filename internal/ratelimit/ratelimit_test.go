@@ -0,0 +1,118 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/ratelimit"
+	"icekalt.dev/money-tracker/internal/repository"
+
+	_ "modernc.org/sqlite"
+)
+
+func stores(t *testing.T) map[string]ratelimit.Store {
+	t.Helper()
+	client, err := repository.NewClient(config.DatabaseConfig{
+		Driver: "sqlite",
+		DSN:    "file::memory:?cache=shared&_pragma=foreign_keys(1)",
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return map[string]ratelimit.Store{
+		"memory":   ratelimit.NewMemoryStore(),
+		"database": ratelimit.NewDBStore(client),
+	}
+}
+
+func TestStores(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for name, store := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			key := "ip:" + name
+			for i := 1; i <= 3; i++ {
+				c, err := store.Hit(ctx, key, time.Minute, start.Add(time.Duration(i)*time.Second))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if c.Count != i || !c.ResetAt.Equal(start.Add(time.Second+time.Minute)) {
+					t.Errorf("hit %d: got %+v", i, c)
+				}
+			}
+
+			c, err := store.Get(ctx, key, start.Add(30*time.Second))
+			if err != nil || c.Count != 3 {
+				t.Errorf("expected count 3, got %+v, %v", c, err)
+			}
+
+			// The window started with the first hit and has ended
+			later := start.Add(2 * time.Minute)
+			if c, _ := store.Get(ctx, key, later); c.Count != 0 {
+				t.Errorf("expected ended window to be empty, got %+v", c)
+			}
+			c, err = store.Hit(ctx, key, time.Minute, later)
+			if err != nil || c.Count != 1 || !c.ResetAt.Equal(later.Add(time.Minute)) {
+				t.Errorf("expected a new window, got %+v, %v", c, err)
+			}
+		})
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	t.Run("allow", func(t *testing.T) {
+		limiter := ratelimit.New(ratelimit.NewMemoryStore())
+		rule := ratelimit.Rule{Limit: 2, Window: time.Minute}
+
+		for i := 0; i < 2; i++ {
+			if ok, _, err := limiter.Allow(ctx, "ip:a", rule, now); !ok || err != nil {
+				t.Fatalf("request %d should be allowed: %v", i+1, err)
+			}
+		}
+		ok, wait, err := limiter.Allow(ctx, "ip:a", rule, now.Add(15*time.Second))
+		if ok || err != nil || wait != 45*time.Second {
+			t.Errorf("third request: ok=%v wait=%v err=%v, want false 45s nil", ok, wait, err)
+		}
+		if ok, _, _ := limiter.Allow(ctx, "ip:b", rule, now); !ok {
+			t.Error("other keys must not be limited")
+		}
+		if ok, _, _ := limiter.Allow(ctx, "ip:a", ratelimit.Rule{}, now); !ok {
+			t.Error("a zero rule must not limit")
+		}
+	})
+
+	t.Run("lockout", func(t *testing.T) {
+		limiter := ratelimit.New(ratelimit.NewMemoryStore())
+		limiter.MaxFailures = 3
+		limiter.Lockout = 10 * time.Minute
+
+		for i := 0; i < 3; i++ {
+			if wait, _ := limiter.Locked(ctx, "192.0.2.1", now); wait != 0 {
+				t.Fatalf("locked after %d failures", i)
+			}
+			if err := limiter.Fail(ctx, "192.0.2.1", now); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if wait, _ := limiter.Locked(ctx, "192.0.2.1", now.Add(time.Minute)); wait != 9*time.Minute {
+			t.Errorf("expected 9m lockout left, got %v", wait)
+		}
+		if wait, _ := limiter.Locked(ctx, "192.0.2.2", now); wait != 0 {
+			t.Error("other addresses must not be locked")
+		}
+		if wait, _ := limiter.Locked(ctx, "192.0.2.1", now.Add(10*time.Minute)); wait != 0 {
+			t.Errorf("lockout should end with its window, got %v", wait)
+		}
+	})
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"icekalt.dev/money-tracker/ent"
+	entgeneration "icekalt.dev/money-tracker/ent/aggregategeneration"
+	entcategory "icekalt.dev/money-tracker/ent/category"
+	"icekalt.dev/money-tracker/ent/hook"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entaggregate "icekalt.dev/money-tracker/ent/monthlyaggregate"
+	entrecurring "icekalt.dev/money-tracker/ent/recurringexpense"
+	entoverride "icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	enttransaction "icekalt.dev/money-tracker/ent/transaction"
+)
+
+// registerAggregateHooks drops stored monthly aggregates whenever data they
+// are derived from changes. Transactions only affect the months of their old
+// and new date; recurring expenses, overrides and categories affect every
+// month of the household.
+func registerAggregateHooks(client *ent.Client) {
+	client.Transaction.Use(transactionAggregateHook)
+	client.RecurringExpense.Use(recurringExpenseAggregateHook)
+	client.RecurringScheduleOverride.Use(overrideAggregateHook)
+	client.Category.Use(categoryAggregateHook)
+}
+
+// staleAggregates collects the household months to invalidate. A household
+// mapped to nil is invalidated completely.
+type staleAggregates map[int]map[string]bool
+
+func (s staleAggregates) month(householdID int, date time.Time) {
+	months, ok := s[householdID]
+	if ok && months == nil {
+		return
+	}
+	if !ok {
+		months = make(map[string]bool)
+		s[householdID] = months
+	}
+	months[date.Format("2006-01")] = true
+}
+
+func (s staleAggregates) households(ids ...int) {
+	for _, id := range ids {
+		s[id] = nil
+	}
+}
+
+// invalidate bumps the generation of the households before deleting their
+// aggregates, so aggregates computed concurrently from the old data aren't
+// stored afterwards.
+func (s staleAggregates) invalidate(ctx context.Context, client *ent.Client) error {
+	if len(s) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(s))
+	for householdID := range s {
+		ids = append(ids, householdID)
+	}
+	_, err := client.AggregateGeneration.Update().
+		Where(entgeneration.HasHouseholdWith(enthousehold.IDIn(ids...))).
+		AddGeneration(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	var all []int
+	for householdID, months := range s {
+		if months == nil {
+			all = append(all, householdID)
+			continue
+		}
+		keys := make([]string, 0, len(months))
+		for m := range months {
+			keys = append(keys, m)
+		}
+		_, err := client.MonthlyAggregate.Delete().
+			Where(
+				entaggregate.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+				entaggregate.MonthIn(keys...),
+			).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	_, err = client.MonthlyAggregate.Delete().
+		Where(entaggregate.HasHouseholdWith(enthousehold.IDIn(all...))).
+		Exec(ctx)
+	return err
+}
+
+func transactionAggregateHook(next ent.Mutator) ent.Mutator {
+	return hook.TransactionFunc(func(ctx context.Context, m *ent.TransactionMutation) (ent.Value, error) {
+		stale := staleAggregates{}
+		var households []int
+		if !m.Op().Is(ent.OpCreate) {
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			existing, err := m.Client().Transaction.Query().
+				Where(enttransaction.IDIn(ids...)).
+				Select(enttransaction.FieldDate).
+				WithHousehold().
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range existing {
+				if hh := t.Edges.Household; hh != nil {
+					stale.month(hh.ID, t.Date)
+					households = append(households, hh.ID)
+				}
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if id, ok := m.HouseholdID(); ok {
+			households = append(households, id)
+		}
+		if date, ok := m.Date(); ok {
+			for _, id := range households {
+				stale.month(id, date)
+			}
+		}
+		return v, stale.invalidate(ctx, m.Client())
+	})
+}
+
+func recurringExpenseAggregateHook(next ent.Mutator) ent.Mutator {
+	return hook.RecurringExpenseFunc(func(ctx context.Context, m *ent.RecurringExpenseMutation) (ent.Value, error) {
+		stale := staleAggregates{}
+		if !m.Op().Is(ent.OpCreate) {
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			households, err := m.Client().Household.Query().
+				Where(enthousehold.HasRecurringExpensesWith(entrecurring.IDIn(ids...))).
+				IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			stale.households(households...)
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		if id, ok := m.HouseholdID(); ok {
+			stale.households(id)
+		}
+		return v, stale.invalidate(ctx, m.Client())
+	})
+}
+
+func overrideAggregateHook(next ent.Mutator) ent.Mutator {
+	return hook.RecurringScheduleOverrideFunc(func(ctx context.Context, m *ent.RecurringScheduleOverrideMutation) (ent.Value, error) {
+		var households []int
+		var err error
+		if m.Op().Is(ent.OpCreate) {
+			if id, ok := m.RecurringExpenseID(); ok {
+				households, err = m.Client().Household.Query().
+					Where(enthousehold.HasRecurringExpensesWith(entrecurring.IDEQ(id))).
+					IDs(ctx)
+			}
+		} else {
+			var ids []int
+			ids, err = m.IDs(ctx)
+			if err == nil {
+				households, err = m.Client().Household.Query().
+					Where(enthousehold.HasRecurringExpensesWith(
+						entrecurring.HasScheduleOverridesWith(entoverride.IDIn(ids...)),
+					)).
+					IDs(ctx)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		stale := staleAggregates{}
+		stale.households(households...)
+		return v, stale.invalidate(ctx, m.Client())
+	})
+}
+
+func categoryAggregateHook(next ent.Mutator) ent.Mutator {
+	return hook.CategoryFunc(func(ctx context.Context, m *ent.CategoryMutation) (ent.Value, error) {
+		// A new category has no amounts yet; only renames and deletes change
+		// stored breakdowns.
+		if m.Op().Is(ent.OpCreate) {
+			return next.Mutate(ctx, m)
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		households, err := m.Client().Household.Query().
+			Where(enthousehold.HasCategoriesWith(entcategory.IDIn(ids...))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+
+		stale := staleAggregates{}
+		stale.households(households...)
+		return v, stale.invalidate(ctx, m.Client())
+	})
+}
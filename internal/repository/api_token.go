@@ -20,10 +20,15 @@ func NewAPITokenRepository(client *ent.Client) *APITokenRepository {
 }
 
 func (r *APITokenRepository) Create(ctx context.Context, token *domain.APIToken) (*domain.APIToken, error) {
-	q := r.client.APIToken.Create().
+	q := clientFor(ctx, r.client).APIToken.Create().
 		SetName(token.Name).
 		SetTokenHash(token.TokenHash).
-		SetUserID(token.UserID)
+		SetUserID(token.UserID).
+		SetAccess(string(token.Scope.Access))
+
+	if len(token.Scope.HouseholdIDs) > 0 {
+		q.SetHouseholdIds(token.Scope.HouseholdIDs)
+	}
 
 	if token.ExpiresAt != nil {
 		q.SetExpiresAt(*token.ExpiresAt)
@@ -41,7 +46,7 @@ func (r *APITokenRepository) Create(ctx context.Context, token *domain.APIToken)
 }
 
 func (r *APITokenRepository) GetByHash(ctx context.Context, hash string) (*domain.APIToken, error) {
-	t, err := r.client.APIToken.Query().
+	t, err := clientFor(ctx, r.client).APIToken.Query().
 		Where(entapitoken.TokenHashEQ(hash)).
 		WithUser().
 		Only(ctx)
@@ -55,7 +60,7 @@ func (r *APITokenRepository) GetByHash(ctx context.Context, hash string) (*domai
 }
 
 func (r *APITokenRepository) ListByUser(ctx context.Context, userID int) ([]*domain.APIToken, error) {
-	items, err := r.client.APIToken.Query().
+	items, err := clientFor(ctx, r.client).APIToken.Query().
 		Where(entapitoken.HasUserWith(entuser.IDEQ(userID))).
 		WithUser().
 		All(ctx)
@@ -70,12 +75,26 @@ func (r *APITokenRepository) ListByUser(ctx context.Context, userID int) ([]*dom
 	return result, nil
 }
 
-func (r *APITokenRepository) UpdateLastUsed(ctx context.Context, id int, t time.Time) error {
-	return r.client.APIToken.UpdateOneID(id).SetLastUsed(t).Exec(ctx)
+func (r *APITokenRepository) UpdateLastUsed(ctx context.Context, id int, t time.Time, ip string) error {
+	return clientFor(ctx, r.client).APIToken.UpdateOneID(id).SetLastUsed(t).SetLastUsedIP(ip).Exec(ctx)
+}
+
+// MarkRotated records that the token was replaced at rotatedAt and moves its
+// expiry to expiresAt unless that is nil. It returns false if the token was
+// already rotated.
+func (r *APITokenRepository) MarkRotated(ctx context.Context, id int, rotatedAt time.Time, expiresAt *time.Time) (bool, error) {
+	q := clientFor(ctx, r.client).APIToken.Update().
+		Where(entapitoken.ID(id), entapitoken.RotatedAtIsNil()).
+		SetRotatedAt(rotatedAt)
+	if expiresAt != nil {
+		q.SetExpiresAt(*expiresAt)
+	}
+	n, err := q.Save(ctx)
+	return n > 0, err
 }
 
 func (r *APITokenRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.APIToken.DeleteOneID(id).Exec(ctx)
+	err := clientFor(ctx, r.client).APIToken.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return fmt.Errorf("%w: api token %d", domain.ErrNotFound, id)
@@ -84,3 +103,9 @@ func (r *APITokenRepository) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+func (r *APITokenRepository) DeleteByUser(ctx context.Context, userID int) (int, error) {
+	return clientFor(ctx, r.client).APIToken.Delete().
+		Where(entapitoken.HasUserWith(entuser.IDEQ(userID))).
+		Exec(ctx)
+}
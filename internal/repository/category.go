@@ -22,6 +22,7 @@ func (r *CategoryRepository) Create(ctx context.Context, category *domain.Catego
 	c, err := r.client.Category.Create().
 		SetName(category.Name).
 		SetIcon(category.Icon).
+		SetTaxClass(string(category.TaxClass)).
 		SetHouseholdID(category.HouseholdID).
 		Save(ctx)
 	if err != nil {
@@ -64,10 +65,28 @@ func (r *CategoryRepository) ListByHousehold(ctx context.Context, householdID in
 	return result, nil
 }
 
+// ListByHouseholds returns the categories of all given households in one query.
+func (r *CategoryRepository) ListByHouseholds(ctx context.Context, householdIDs []int) ([]*domain.Category, error) {
+	items, err := r.client.Category.Query().
+		Where(entcategory.HasHouseholdWith(enthousehold.IDIn(householdIDs...))).
+		WithHousehold().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Category, 0, len(items))
+	for _, c := range items {
+		result = append(result, categoryToDomain(c))
+	}
+	return result, nil
+}
+
 func (r *CategoryRepository) Update(ctx context.Context, category *domain.Category) (*domain.Category, error) {
 	c, err := r.client.Category.UpdateOneID(category.ID).
 		SetName(category.Name).
 		SetIcon(category.Icon).
+		SetTaxClass(string(category.TaxClass)).
 		Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -1,22 +1,50 @@
 package repository
 
 import (
-	"github.com/shopspring/decimal"
 	"icekalt.dev/money-tracker/ent"
+	"icekalt.dev/money-tracker/ent/schema"
 	"icekalt.dev/money-tracker/internal/domain"
 )
 
 func userToDomain(u *ent.User) *domain.User {
 	return &domain.User{
-		ID:        u.ID,
-		Email:     u.Email,
-		Name:      u.Name,
-		Subject:   u.Subject,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:         u.ID,
+		Email:      u.Email,
+		Name:       u.Name,
+		Subject:    u.Subject,
+		Admin:      u.Admin,
+		DisabledAt: u.DisabledAt,
+		CreatedAt:  u.CreatedAt,
+		UpdatedAt:  u.UpdatedAt,
 	}
 }
 
+func localCredentialToDomain(c *ent.LocalCredential) *domain.LocalCredential {
+	cred := &domain.LocalCredential{
+		Username:     c.Username,
+		PasswordHash: c.PasswordHash,
+		UpdatedAt:    c.UpdatedAt,
+	}
+	if u := c.Edges.User; u != nil {
+		cred.UserID = u.ID
+	}
+	return cred
+}
+
+func userIdentityToDomain(i *ent.UserIdentity) *domain.UserIdentity {
+	identity := &domain.UserIdentity{
+		ID:        i.ID,
+		Issuer:    i.Issuer,
+		Subject:   i.Subject,
+		Email:     i.Email,
+		CreatedAt: i.CreatedAt,
+	}
+	if u := i.Edges.User; u != nil {
+		identity.UserID = u.ID
+	}
+	return identity
+}
+
 func householdToDomain(h *ent.Household) *domain.Household {
 	hh := &domain.Household{
 		ID:          h.ID,
@@ -26,6 +54,7 @@ func householdToDomain(h *ent.Household) *domain.Household {
 		Icon:        h.Icon,
 		CreatedAt:   h.CreatedAt,
 		UpdatedAt:   h.UpdatedAt,
+		DeletedAt:   h.DeletedAt,
 	}
 	if owner := h.Edges.Owner; owner != nil {
 		hh.OwnerID = owner.ID
@@ -38,6 +67,7 @@ func categoryToDomain(c *ent.Category) *domain.Category {
 		ID:        c.ID,
 		Name:      c.Name,
 		Icon:      c.Icon,
+		TaxClass:  domain.TaxClass(c.TaxClass),
 		CreatedAt: c.CreatedAt,
 		UpdatedAt: c.UpdatedAt,
 	}
@@ -48,15 +78,17 @@ func categoryToDomain(c *ent.Category) *domain.Category {
 }
 
 func transactionToDomain(t *ent.Transaction) *domain.Transaction {
-	amount, _ := decimal.NewFromString(t.Amount)
+	amount := domain.MoneyFromInt(t.Amount)
 	tx := &domain.Transaction{
 		ID:          t.ID,
 		Amount:      amount,
 		Description: t.Description,
 		Details:     t.Details,
+		TaxClass:    domain.TaxClass(t.TaxClass),
 		Date:        t.Date,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt,
 	}
 	if hh := t.Edges.Household; hh != nil {
 		tx.HouseholdID = hh.ID
@@ -64,11 +96,12 @@ func transactionToDomain(t *ent.Transaction) *domain.Transaction {
 	if cat := t.Edges.Category; cat != nil {
 		tx.CategoryID = cat.ID
 	}
+	tx.Split = splitToDomain(t.Edges.Payer, t.SplitType, t.SplitShares)
 	return tx
 }
 
 func recurringExpenseToDomain(r *ent.RecurringExpense) *domain.RecurringExpense {
-	amount, _ := decimal.NewFromString(r.Amount)
+	amount := domain.MoneyFromInt(r.Amount)
 	re := &domain.RecurringExpense{
 		ID:          r.ID,
 		Name:        r.Name,
@@ -79,8 +112,10 @@ func recurringExpenseToDomain(r *ent.RecurringExpense) *domain.RecurringExpense
 		Active:    r.Active,
 		StartDate: r.StartDate,
 		EndDate:   r.EndDate,
+		DeactivatedAt: r.DeactivatedAt,
 		CreatedAt: r.CreatedAt,
 		UpdatedAt: r.UpdatedAt,
+		DeletedAt: r.DeletedAt,
 	}
 	if hh := r.Edges.Household; hh != nil {
 		re.HouseholdID = hh.ID
@@ -88,11 +123,76 @@ func recurringExpenseToDomain(r *ent.RecurringExpense) *domain.RecurringExpense
 	if cat := r.Edges.Category; cat != nil {
 		re.CategoryID = cat.ID
 	}
+	re.Split = splitToDomain(r.Edges.Payer, r.SplitType, r.SplitShares)
 	return re
 }
 
+// splitToDomain returns nil unless both the payer and the split type are
+// set.
+func splitToDomain(payer *ent.HouseholdMember, splitType string, shares []schema.SplitShare) *domain.Split {
+	if payer == nil || splitType == "" {
+		return nil
+	}
+	split := &domain.Split{
+		PaidBy: payer.ID,
+		Type:   domain.SplitType(splitType),
+		Shares: make([]domain.SplitShare, 0, len(shares)),
+	}
+	for _, sh := range shares {
+		split.Shares = append(split.Shares, domain.SplitShare{
+			MemberID: sh.MemberID,
+			Value:    domain.MoneyFromInt(sh.Value),
+		})
+	}
+	return split
+}
+
+func splitShares(split *domain.Split) []schema.SplitShare {
+	shares := make([]schema.SplitShare, 0, len(split.Shares))
+	for _, sh := range split.Shares {
+		shares = append(shares, schema.SplitShare{
+			MemberID: sh.MemberID,
+			Value:    domain.MinorUnits(sh.Value),
+		})
+	}
+	return shares
+}
+
+func memberToDomain(m *ent.HouseholdMember) *domain.HouseholdMember {
+	member := &domain.HouseholdMember{
+		ID:        m.ID,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if hh := m.Edges.Household; hh != nil {
+		member.HouseholdID = hh.ID
+	}
+	return member
+}
+
+func settlementToDomain(s *ent.Settlement) *domain.Settlement {
+	settlement := &domain.Settlement{
+		ID:        s.ID,
+		Amount:    domain.MoneyFromInt(s.Amount),
+		Date:      s.Date,
+		Note:      s.Note,
+		CreatedAt: s.CreatedAt,
+	}
+	if hh := s.Edges.Household; hh != nil {
+		settlement.HouseholdID = hh.ID
+	}
+	if from := s.Edges.From; from != nil {
+		settlement.FromMemberID = from.ID
+	}
+	if to := s.Edges.To; to != nil {
+		settlement.ToMemberID = to.ID
+	}
+	return settlement
+}
+
 func overrideToDomain(o *ent.RecurringScheduleOverride) *domain.RecurringScheduleOverride {
-	amount, _ := decimal.NewFromString(o.Amount)
+	amount := domain.MoneyFromInt(o.Amount)
 	override := &domain.RecurringScheduleOverride{
 		ID:            o.ID,
 		EffectiveDate: o.EffectiveDate,
@@ -112,12 +212,120 @@ func apiTokenToDomain(t *ent.APIToken) *domain.APIToken {
 		ID:        t.ID,
 		Name:      t.Name,
 		TokenHash: t.TokenHash,
-		ExpiresAt: t.ExpiresAt,
-		LastUsed:  t.LastUsed,
-		CreatedAt: t.CreatedAt,
+		Scope: domain.TokenScope{
+			Access:       domain.TokenAccess(t.Access),
+			HouseholdIDs: t.HouseholdIds,
+		},
+		ExpiresAt:  t.ExpiresAt,
+		RotatedAt:  t.RotatedAt,
+		LastUsed:   t.LastUsed,
+		LastUsedIP: t.LastUsedIP,
+		CreatedAt:  t.CreatedAt,
 	}
 	if u := t.Edges.User; u != nil {
 		tok.UserID = u.ID
 	}
 	return tok
 }
+
+func sessionToDomain(s *ent.Session) *domain.Session {
+	sess := &domain.Session{
+		ID:         s.ID,
+		Token:      s.Token,
+		UserAgent:  s.UserAgent,
+		IP:         s.IP,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.CreatedAt,
+		ExpiresAt:  s.ExpiresAt,
+	}
+	if s.UserID != nil {
+		sess.UserID = *s.UserID
+	}
+	if s.LastSeenAt != nil {
+		sess.LastSeenAt = *s.LastSeenAt
+	}
+	return sess
+}
+
+func monthlyAggregateToDomain(a *ent.MonthlyAggregate) *domain.MonthlyAggregate {
+	agg := &domain.MonthlyAggregate{
+		Month:          a.Month,
+		GrossIncome:    domain.MoneyFromInt(a.GrossIncome),
+		GrossExpenses:  domain.MoneyFromInt(a.GrossExpenses),
+		RecurringTotal: domain.MoneyFromInt(a.RecurringTotal),
+		OneTimeTotal:   domain.MoneyFromInt(a.OneTimeTotal),
+		MonthlyTotal:   domain.MoneyFromInt(a.MonthlyTotal),
+		Categories:     make([]domain.CategorySummary, 0, len(a.Categories)),
+		ComputedAt:     a.ComputedAt,
+	}
+	for _, c := range a.Categories {
+		agg.Categories = append(agg.Categories, domain.CategorySummary{
+			CategoryID:   c.CategoryID,
+			CategoryName: c.CategoryName,
+			Recurring:    domain.MoneyFromInt(c.Recurring),
+			OneTime:      domain.MoneyFromInt(c.OneTime),
+			Total:        domain.MoneyFromInt(c.Total),
+		})
+	}
+	if hh := a.Edges.Household; hh != nil {
+		agg.HouseholdID = hh.ID
+	}
+	return agg
+}
+
+func monthlyAggregateCategories(categories []domain.CategorySummary) []schema.MonthlyAggregateCategory {
+	result := make([]schema.MonthlyAggregateCategory, 0, len(categories))
+	for _, c := range categories {
+		result = append(result, schema.MonthlyAggregateCategory{
+			CategoryID:   c.CategoryID,
+			CategoryName: c.CategoryName,
+			Recurring:    domain.MinorUnits(c.Recurring),
+			OneTime:      domain.MinorUnits(c.OneTime),
+			Total:        domain.MinorUnits(c.Total),
+		})
+	}
+	return result
+}
+
+func securityEventToDomain(e *ent.SecurityEvent) *domain.SecurityEvent {
+	return &domain.SecurityEvent{
+		ID:        e.ID,
+		Type:      domain.SecurityEventType(e.Type),
+		UserID:    e.UserID,
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		Details:   e.Details,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func revisionToDomain(r *ent.Revision) *domain.Revision {
+	return &domain.Revision{
+		ID:          r.ID,
+		EntityType:  domain.EntityType(r.EntityType),
+		EntityID:    r.EntityID,
+		HouseholdID: r.HouseholdID,
+		Action:      domain.RevisionAction(r.Action),
+		UserID:      r.UserID,
+		Before:      r.Before,
+		After:       r.After,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+func householdTransferToDomain(t *ent.HouseholdTransfer) *domain.HouseholdTransfer {
+	transfer := &domain.HouseholdTransfer{
+		ID:        t.ID,
+		Email:     t.Email,
+		CreatedAt: t.CreatedAt,
+	}
+	if h := t.Edges.Household; h != nil {
+		transfer.HouseholdID = h.ID
+		transfer.HouseholdName = h.Name
+	}
+	if u := t.Edges.User; u != nil {
+		transfer.FromUserID = u.ID
+		transfer.FromEmail = u.Email
+	}
+	return transfer
+}
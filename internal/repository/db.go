@@ -14,24 +14,39 @@ import (
 )
 
 func NewClient(cfg config.DatabaseConfig) (*ent.Client, error) {
-	var drv dialect.Driver
+	drv, err := OpenDriver(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFromDriver(drv), nil
+}
 
+// NewClientFromDriver wraps an open driver in an ent client with all hooks
+// the repositories rely on.
+func NewClientFromDriver(drv dialect.Driver) *ent.Client {
+	client := ent.NewClient(ent.Driver(drv))
+	registerAggregateHooks(client)
+	registerRevisionHooks(client)
+	return client
+}
+
+// OpenDriver opens the SQL driver for the configured database without wrapping
+// it in an ent client. Used by tooling that works on raw tables.
+func OpenDriver(cfg config.DatabaseConfig) (*entsql.Driver, error) {
 	switch cfg.Driver {
 	case "sqlite", "sqlite3":
 		db, err := sql.Open("sqlite", cfg.DSN)
 		if err != nil {
 			return nil, fmt.Errorf("opening sqlite: %w", err)
 		}
-		drv = entsql.OpenDB(dialect.SQLite, db)
+		return entsql.OpenDB(dialect.SQLite, db), nil
 	case "postgres", "postgresql":
 		db, err := sql.Open("pgx", cfg.DSN)
 		if err != nil {
 			return nil, fmt.Errorf("opening postgres: %w", err)
 		}
-		drv = entsql.OpenDB(dialect.Postgres, db)
+		return entsql.OpenDB(dialect.Postgres, db), nil
 	default:
 		return nil, fmt.Errorf("unsupported database driver: %s", cfg.Driver)
 	}
-
-	return ent.NewClient(ent.Driver(drv)), nil
 }
@@ -2,11 +2,20 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"icekalt.dev/money-tracker/ent"
+	entgeneration "icekalt.dev/money-tracker/ent/aggregategeneration"
 	enthousehold "icekalt.dev/money-tracker/ent/household"
+	enttransfer "icekalt.dev/money-tracker/ent/householdtransfer"
+	entmember "icekalt.dev/money-tracker/ent/householdmember"
+	entaggregate "icekalt.dev/money-tracker/ent/monthlyaggregate"
 	entrecurring "icekalt.dev/money-tracker/ent/recurringexpense"
+	entoverride "icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	entrevision "icekalt.dev/money-tracker/ent/revision"
+	entsettlement "icekalt.dev/money-tracker/ent/settlement"
 	enttransaction "icekalt.dev/money-tracker/ent/transaction"
 	entcategory "icekalt.dev/money-tracker/ent/category"
 	entuser "icekalt.dev/money-tracker/ent/user"
@@ -22,7 +31,7 @@ func NewHouseholdRepository(client *ent.Client) *HouseholdRepository {
 }
 
 func (r *HouseholdRepository) Create(ctx context.Context, household *domain.Household) (*domain.Household, error) {
-	h, err := r.client.Household.Create().
+	h, err := clientFor(ctx, r.client).Household.Create().
 		SetName(household.Name).
 		SetDescription(household.Description).
 		SetCurrency(household.Currency).
@@ -37,8 +46,8 @@ func (r *HouseholdRepository) Create(ctx context.Context, household *domain.Hous
 }
 
 func (r *HouseholdRepository) GetByID(ctx context.Context, id int) (*domain.Household, error) {
-	h, err := r.client.Household.Query().
-		Where(enthousehold.ID(id)).
+	h, err := clientFor(ctx, r.client).Household.Query().
+		Where(enthousehold.ID(id), enthousehold.DeletedAtIsNil()).
 		WithOwner().
 		Only(ctx)
 	if err != nil {
@@ -51,8 +60,11 @@ func (r *HouseholdRepository) GetByID(ctx context.Context, id int) (*domain.Hous
 }
 
 func (r *HouseholdRepository) ListByOwner(ctx context.Context, ownerID int) ([]*domain.Household, error) {
-	items, err := r.client.Household.Query().
-		Where(enthousehold.HasOwnerWith(entuser.IDEQ(ownerID))).
+	items, err := clientFor(ctx, r.client).Household.Query().
+		Where(
+			enthousehold.HasOwnerWith(entuser.IDEQ(ownerID)),
+			enthousehold.DeletedAtIsNil(),
+		).
 		WithOwner().
 		All(ctx)
 	if err != nil {
@@ -66,8 +78,28 @@ func (r *HouseholdRepository) ListByOwner(ctx context.Context, ownerID int) ([]*
 	return result, nil
 }
 
+// ListAll returns every household regardless of owner. Only for maintenance
+// tasks; request handlers go through ListByOwner.
+func (r *HouseholdRepository) ListAll(ctx context.Context) ([]*domain.Household, error) {
+	items, err := clientFor(ctx, r.client).Household.Query().
+		Where(enthousehold.DeletedAtIsNil()).
+		WithOwner().
+		Order(ent.Asc(enthousehold.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Household, 0, len(items))
+	for _, h := range items {
+		result = append(result, householdToDomain(h))
+	}
+	return result, nil
+}
+
 func (r *HouseholdRepository) Update(ctx context.Context, household *domain.Household) (*domain.Household, error) {
-	h, err := r.client.Household.UpdateOneID(household.ID).
+	h, err := clientFor(ctx, r.client).Household.UpdateOneID(household.ID).
+		Where(enthousehold.DeletedAtIsNil()).
 		SetName(household.Name).
 		SetDescription(household.Description).
 		SetCurrency(household.Currency).
@@ -83,35 +115,188 @@ func (r *HouseholdRepository) Update(ctx context.Context, household *domain.Hous
 	return householdToDomain(h), nil
 }
 
+// SetOwner hands the household over to another user.
+func (r *HouseholdRepository) SetOwner(ctx context.Context, id, ownerID int) error {
+	err := clientFor(ctx, r.client).Household.UpdateOneID(id).SetOwnerID(ownerID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%w: household %d", domain.ErrNotFound, id)
+	}
+	return err
+}
+
+// GetDeletedByID returns a household from the trash.
+func (r *HouseholdRepository) GetDeletedByID(ctx context.Context, id int) (*domain.Household, error) {
+	h, err := clientFor(ctx, r.client).Household.Query().
+		Where(enthousehold.ID(id), enthousehold.DeletedAtNotNil()).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: deleted household %d", domain.ErrNotFound, id)
+		}
+		return nil, err
+	}
+	return householdToDomain(h), nil
+}
+
+// ListDeletedByOwner returns the owner's households in the trash, most
+// recently deleted first.
+func (r *HouseholdRepository) ListDeletedByOwner(ctx context.Context, ownerID int) ([]*domain.Household, error) {
+	items, err := clientFor(ctx, r.client).Household.Query().
+		Where(
+			enthousehold.HasOwnerWith(entuser.IDEQ(ownerID)),
+			enthousehold.DeletedAtNotNil(),
+		).
+		WithOwner().
+		Order(ent.Desc(enthousehold.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Household, 0, len(items))
+	for _, h := range items {
+		result = append(result, householdToDomain(h))
+	}
+	return result, nil
+}
+
+// Delete moves the household to the trash. Its data stays untouched until
+// the household is purged.
 func (r *HouseholdRepository) Delete(ctx context.Context, id int) error {
+	err := clientFor(ctx, r.client).Household.UpdateOneID(id).
+		Where(enthousehold.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: household %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// Restore takes the household out of the trash.
+func (r *HouseholdRepository) Restore(ctx context.Context, id int) error {
+	err := clientFor(ctx, r.client).Household.UpdateOneID(id).
+		Where(enthousehold.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: deleted household %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// PurgeDeletedBefore purges all households moved to the trash before the
+// given time and returns how many there were.
+func (r *HouseholdRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	ids, err := clientFor(ctx, r.client).Household.Query().
+		Where(enthousehold.DeletedAtLT(before)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for i, id := range ids {
+		if err := r.Purge(ctx, id); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return i, fmt.Errorf("purging household %d: %w", id, err)
+		}
+	}
+	return len(ids), nil
+}
+
+// Purge deletes the household, in the trash or not, together with all its
+// data and history.
+func (r *HouseholdRepository) Purge(ctx context.Context, id int) error {
+	return withTx(ctx, r.client, func(client *ent.Client) error {
+		return purgeHousehold(ctx, client, id)
+	})
+}
+
+func purgeHousehold(ctx context.Context, client *ent.Client, id int) error {
 	// Cascade delete children
-	_, err := r.client.RecurringExpense.Delete().
+	_, err := client.Settlement.Delete().
+		Where(entsettlement.HasHouseholdWith(enthousehold.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting settlements: %w", err)
+	}
+
+	_, err = client.RecurringScheduleOverride.Delete().
+		Where(entoverride.HasRecurringExpenseWith(entrecurring.HasHouseholdWith(enthousehold.IDEQ(id)))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting schedule overrides: %w", err)
+	}
+
+	_, err = client.RecurringExpense.Delete().
 		Where(entrecurring.HasHouseholdWith(enthousehold.IDEQ(id))).
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("deleting recurring expenses: %w", err)
 	}
 
-	_, err = r.client.Transaction.Delete().
+	_, err = client.Transaction.Delete().
 		Where(enttransaction.HasHouseholdWith(enthousehold.IDEQ(id))).
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("deleting transactions: %w", err)
 	}
 
-	_, err = r.client.Category.Delete().
+	_, err = client.Category.Delete().
 		Where(entcategory.HasHouseholdWith(enthousehold.IDEQ(id))).
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("deleting categories: %w", err)
 	}
 
-	err = r.client.Household.DeleteOneID(id).Exec(ctx)
+	_, err = client.HouseholdMember.Delete().
+		Where(entmember.HasHouseholdWith(enthousehold.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting members: %w", err)
+	}
+
+	_, err = client.MonthlyAggregate.Delete().
+		Where(entaggregate.HasHouseholdWith(enthousehold.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting monthly aggregates: %w", err)
+	}
+
+	_, err = client.AggregateGeneration.Delete().
+		Where(entgeneration.HasHouseholdWith(enthousehold.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting aggregate generation: %w", err)
+	}
+
+	_, err = client.HouseholdTransfer.Delete().
+		Where(enttransfer.HasHouseholdWith(enthousehold.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting transfer offer: %w", err)
+	}
+
+	err = client.Household.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return fmt.Errorf("%w: household %d", domain.ErrNotFound, id)
 		}
 		return err
 	}
+
+	// The revisions of the household's records were written by the deletes
+	// above, so they go last.
+	_, err = client.Revision.Delete().
+		Where(entrevision.HouseholdID(id)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting revisions: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"icekalt.dev/money-tracker/ent"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	enttransfer "icekalt.dev/money-tracker/ent/householdtransfer"
+	entuser "icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type HouseholdTransferRepository struct {
+	client *ent.Client
+}
+
+func NewHouseholdTransferRepository(client *ent.Client) *HouseholdTransferRepository {
+	return &HouseholdTransferRepository{client: client}
+}
+
+// Create offers the household, replacing an earlier offer for it.
+func (r *HouseholdTransferRepository) Create(ctx context.Context, transfer *domain.HouseholdTransfer) (*domain.HouseholdTransfer, error) {
+	var id int
+	err := withTx(ctx, r.client, func(client *ent.Client) error {
+		_, err := client.HouseholdTransfer.Delete().
+			Where(enttransfer.HasHouseholdWith(enthousehold.IDEQ(transfer.HouseholdID))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting earlier offer: %w", err)
+		}
+		t, err := client.HouseholdTransfer.Create().
+			SetEmail(strings.ToLower(transfer.Email)).
+			SetHouseholdID(transfer.HouseholdID).
+			SetUserID(transfer.FromUserID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		id = t.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+func (r *HouseholdTransferRepository) GetByID(ctx context.Context, id int) (*domain.HouseholdTransfer, error) {
+	t, err := r.query(ctx).Where(enttransfer.ID(id)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: household transfer %d", domain.ErrNotFound, id)
+		}
+		return nil, err
+	}
+	return householdTransferToDomain(t), nil
+}
+
+// ListByEmail returns the offers made to the email, oldest first.
+func (r *HouseholdTransferRepository) ListByEmail(ctx context.Context, email string) ([]*domain.HouseholdTransfer, error) {
+	return r.list(ctx, r.query(ctx).Where(enttransfer.EmailEQ(strings.ToLower(email))))
+}
+
+// ListByUser returns the offers the user made, oldest first.
+func (r *HouseholdTransferRepository) ListByUser(ctx context.Context, userID int) ([]*domain.HouseholdTransfer, error) {
+	return r.list(ctx, r.query(ctx).Where(enttransfer.HasUserWith(entuser.IDEQ(userID))))
+}
+
+func (r *HouseholdTransferRepository) Delete(ctx context.Context, id int) error {
+	err := clientFor(ctx, r.client).HouseholdTransfer.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: household transfer %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// query leaves out offers for households in the trash and loads the
+// household and the user who made the offer.
+func (r *HouseholdTransferRepository) query(ctx context.Context) *ent.HouseholdTransferQuery {
+	return clientFor(ctx, r.client).HouseholdTransfer.Query().
+		Where(enttransfer.HasHouseholdWith(enthousehold.DeletedAtIsNil())).
+		WithHousehold().
+		WithUser()
+}
+
+func (r *HouseholdTransferRepository) list(ctx context.Context, q *ent.HouseholdTransferQuery) ([]*domain.HouseholdTransfer, error) {
+	items, err := q.Order(ent.Asc(enttransfer.FieldCreatedAt), ent.Asc(enttransfer.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.HouseholdTransfer, 0, len(items))
+	for _, t := range items {
+		result = append(result, householdTransferToDomain(t))
+	}
+	return result, nil
+}
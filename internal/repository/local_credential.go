@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/ent"
+	entcredential "icekalt.dev/money-tracker/ent/localcredential"
+	entuser "icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type LocalCredentialRepository struct {
+	client *ent.Client
+}
+
+func NewLocalCredentialRepository(client *ent.Client) *LocalCredentialRepository {
+	return &LocalCredentialRepository{client: client}
+}
+
+// Create stores the user and the credential in one transaction, so a taken
+// username doesn't leave a user without a login behind.
+func (r *LocalCredentialRepository) Create(ctx context.Context, user *domain.User, cred *domain.LocalCredential) (*domain.User, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := tx.User.Create().
+		SetEmail(user.Email).
+		SetName(user.Name).
+		SetSubject(user.Subject).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: user already exists", domain.ErrConflict)
+		}
+		return nil, err
+	}
+
+	_, err = tx.LocalCredential.Create().
+		SetUsername(cred.Username).
+		SetPasswordHash(cred.PasswordHash).
+		SetUser(u).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: username already exists", domain.ErrConflict)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return userToDomain(u), nil
+}
+
+func (r *LocalCredentialRepository) GetByUsername(ctx context.Context, username string) (*domain.LocalCredential, error) {
+	c, err := r.client.LocalCredential.Query().
+		Where(entcredential.UsernameEQ(username)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: local account %s", domain.ErrNotFound, username)
+		}
+		return nil, err
+	}
+	return localCredentialToDomain(c), nil
+}
+
+func (r *LocalCredentialRepository) GetByUserID(ctx context.Context, userID int) (*domain.LocalCredential, error) {
+	c, err := r.client.LocalCredential.Query().
+		Where(entcredential.HasUserWith(entuser.IDEQ(userID))).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: local account of user %d", domain.ErrNotFound, userID)
+		}
+		return nil, err
+	}
+	return localCredentialToDomain(c), nil
+}
+
+func (r *LocalCredentialRepository) UpdatePasswordHash(ctx context.Context, userID int, hash string) error {
+	n, err := r.client.LocalCredential.Update().
+		Where(entcredential.HasUserWith(entuser.IDEQ(userID))).
+		SetPasswordHash(hash).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: local account of user %d", domain.ErrNotFound, userID)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/ent"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entmember "icekalt.dev/money-tracker/ent/householdmember"
+	entrecurring "icekalt.dev/money-tracker/ent/recurringexpense"
+	"icekalt.dev/money-tracker/ent/schema"
+	enttransaction "icekalt.dev/money-tracker/ent/transaction"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type HouseholdMemberRepository struct {
+	client *ent.Client
+}
+
+func NewHouseholdMemberRepository(client *ent.Client) *HouseholdMemberRepository {
+	return &HouseholdMemberRepository{client: client}
+}
+
+func (r *HouseholdMemberRepository) Create(ctx context.Context, member *domain.HouseholdMember) (*domain.HouseholdMember, error) {
+	m, err := r.client.HouseholdMember.Create().
+		SetName(member.Name).
+		SetHouseholdID(member.HouseholdID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: member name already exists in household", domain.ErrConflict)
+		}
+		return nil, err
+	}
+	m.Edges.Household = &ent.Household{ID: member.HouseholdID}
+	return memberToDomain(m), nil
+}
+
+func (r *HouseholdMemberRepository) GetByID(ctx context.Context, id int) (*domain.HouseholdMember, error) {
+	m, err := r.client.HouseholdMember.Query().
+		Where(entmember.ID(id)).
+		WithHousehold().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: member %d", domain.ErrNotFound, id)
+		}
+		return nil, err
+	}
+	return memberToDomain(m), nil
+}
+
+func (r *HouseholdMemberRepository) ListByHousehold(ctx context.Context, householdID int) ([]*domain.HouseholdMember, error) {
+	items, err := r.client.HouseholdMember.Query().
+		Where(entmember.HasHouseholdWith(enthousehold.IDEQ(householdID))).
+		WithHousehold().
+		Order(ent.Asc(entmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.HouseholdMember, 0, len(items))
+	for _, m := range items {
+		result = append(result, memberToDomain(m))
+	}
+	return result, nil
+}
+
+func (r *HouseholdMemberRepository) Update(ctx context.Context, member *domain.HouseholdMember) (*domain.HouseholdMember, error) {
+	m, err := r.client.HouseholdMember.UpdateOneID(member.ID).
+		SetName(member.Name).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: member %d", domain.ErrNotFound, member.ID)
+		}
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: member name already exists in household", domain.ErrConflict)
+		}
+		return nil, err
+	}
+	m.Edges.Household = &ent.Household{ID: member.HouseholdID}
+	return memberToDomain(m), nil
+}
+
+func (r *HouseholdMemberRepository) Delete(ctx context.Context, id int) error {
+	err := r.client.HouseholdMember.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: member %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// IsReferenced reports whether the member paid a transaction, recurring
+// expense or settlement, received a settlement or has a share in a split.
+// Shares are stored as JSON without a foreign key, so they are checked here.
+// Records in the trash count as well, so that they can still be restored.
+func (r *HouseholdMemberRepository) IsReferenced(ctx context.Context, id int) (bool, error) {
+	m, err := r.client.HouseholdMember.Query().
+		Where(entmember.ID(id)).
+		WithHousehold().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, fmt.Errorf("%w: member %d", domain.ErrNotFound, id)
+		}
+		return false, err
+	}
+	paid, err := r.client.HouseholdMember.Query().
+		Where(
+			entmember.ID(id),
+			entmember.Or(
+				entmember.HasPaidTransactions(),
+				entmember.HasPaidRecurringExpenses(),
+				entmember.HasSettlementsPaid(),
+				entmember.HasSettlementsReceived(),
+			),
+		).
+		Exist(ctx)
+	if err != nil || paid {
+		return paid, err
+	}
+
+	householdID := m.Edges.Household.ID
+	txs, err := r.client.Transaction.Query().
+		Where(
+			enttransaction.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			enttransaction.SplitTypeNEQ(""),
+		).
+		Select(enttransaction.FieldSplitShares).
+		All(ctx)
+	if err != nil {
+		return false, err
+	}
+	expenses, err := r.client.RecurringExpense.Query().
+		Where(
+			entrecurring.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entrecurring.SplitTypeNEQ(""),
+		).
+		Select(entrecurring.FieldSplitShares).
+		All(ctx)
+	if err != nil {
+		return false, err
+	}
+	shares := make([][]schema.SplitShare, 0, len(txs)+len(expenses))
+	for _, t := range txs {
+		shares = append(shares, t.SplitShares)
+	}
+	for _, re := range expenses {
+		shares = append(shares, re.SplitShares)
+	}
+	for _, split := range shares {
+		for _, sh := range split {
+			if sh.MemberID == id {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"icekalt.dev/money-tracker/ent"
+)
+
+// amountColumns were stored as decimal strings before amounts became integer
+// minor units.
+var amountColumns = []struct{ table, column string }{
+	{"transactions", "amount"},
+	{"recurring_expenses", "amount"},
+	{"recurring_schedule_overrides", "amount"},
+}
+
+// AutoMigrate brings the database schema up to the ent schema by diffing it
+// against the live database. It predates versioned migrations and is only
+// used to upgrade databases created that way; see internal/migration. Data
+// migrations that the diff can't express run as apply hooks in the same
+// transaction. driver is a driver or ent dialect name.
+func AutoMigrate(ctx context.Context, client *ent.Client, driver string) error {
+	d, err := dialectName(driver)
+	if err != nil {
+		return err
+	}
+	return client.Schema.Create(ctx, schema.WithApplyHook(amountsToMinorUnits(d), backfillDeactivated(d)))
+}
+
+func dialectName(driver string) (string, error) {
+	switch driver {
+	case "sqlite", "sqlite3":
+		return dialect.SQLite, nil
+	case "postgres", "postgresql":
+		return dialect.Postgres, nil
+	default:
+		return "", fmt.Errorf("unsupported database driver: %s", driver)
+	}
+}
+
+// amountsToMinorUnits converts amount columns that still hold decimal strings
+// to integer cents before the schema change retypes them. On SQLite the values
+// are rewritten in place and keep their text affinity until the table is
+// rebuilt with the new column type; on Postgres the column is converted with
+// an explicit USING clause. Stored monthly aggregates are dropped because
+// their JSON breakdown has the old format; they are recomputed on demand.
+func amountsToMinorUnits(d string) schema.ApplyHook {
+	return func(next schema.Applier) schema.Applier {
+		return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			converted := false
+			for _, c := range amountColumns {
+				text, err := isTextColumn(ctx, conn, d, c.table, c.column)
+				if err != nil {
+					return err
+				}
+				if !text {
+					continue
+				}
+				if err := convertToMinorUnits(ctx, conn, d, c.table, c.column); err != nil {
+					return fmt.Errorf("converting %s.%s to minor units: %w", c.table, c.column, err)
+				}
+				converted = true
+			}
+			if converted {
+				if err := clearAggregates(ctx, conn, d); err != nil {
+					return err
+				}
+			}
+			return next.Apply(ctx, conn, plan)
+		})
+	}
+}
+
+// backfillDeactivated sets the deactivation date of inactive recurring
+// expenses to their last update when the schema change adds the column, as
+// the versioned migration 20261019190000_recurring_deactivated does. The
+// other data migrations after the baseline only touch tables that databases
+// from before versioned migrations don't have yet, so they have nothing to
+// convert here.
+func backfillDeactivated(d string) schema.ApplyHook {
+	return func(next schema.Applier) schema.Applier {
+		return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			typ, err := columnType(ctx, conn, d, "recurring_expenses", "deactivated_at")
+			if err != nil {
+				return err
+			}
+			if err := next.Apply(ctx, conn, plan); err != nil || typ != "" {
+				return err
+			}
+			query := `UPDATE "recurring_expenses" SET "deactivated_at" = "updated_at" WHERE NOT "active"`
+			if err := conn.Exec(ctx, query, []any{}, nil); err != nil {
+				return fmt.Errorf("backfilling recurring_expenses.deactivated_at: %w", err)
+			}
+			return nil
+		})
+	}
+}
+
+func isTextColumn(ctx context.Context, conn dialect.ExecQuerier, d, table, column string) (bool, error) {
+	typ, err := columnType(ctx, conn, d, table, column)
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(typ, "char") || strings.Contains(typ, "text"), nil
+}
+
+// columnType returns the lower-case type of the column, or "" if the table
+// or column doesn't exist.
+func columnType(ctx context.Context, conn dialect.ExecQuerier, d, table, column string) (string, error) {
+	query := "SELECT type FROM pragma_table_info(?) WHERE name = ?"
+	if d == dialect.Postgres {
+		query = "SELECT data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2"
+	}
+	var rows entsql.Rows
+	if err := conn.Query(ctx, query, []any{table, column}, &rows); err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var typ string
+	if rows.Next() {
+		if err := rows.Scan(&typ); err != nil {
+			return "", err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return strings.ToLower(typ), nil
+}
+
+func convertToMinorUnits(ctx context.Context, conn dialect.ExecQuerier, d, table, column string) error {
+	var query string
+	if d == dialect.Postgres {
+		query = fmt.Sprintf(`ALTER TABLE %q ALTER COLUMN %q TYPE bigint USING round(%q::numeric * 100)::bigint`, table, column, column)
+	} else {
+		query = fmt.Sprintf(`UPDATE %q SET %q = CAST(ROUND(CAST(%q AS REAL) * 100) AS INTEGER)`, table, column, column)
+	}
+	return conn.Exec(ctx, query, []any{}, nil)
+}
+
+func clearAggregates(ctx context.Context, conn dialect.ExecQuerier, d string) error {
+	exists, err := isTextColumn(ctx, conn, d, "monthly_aggregates", "gross_income")
+	if err != nil || !exists {
+		return err
+	}
+	if err := conn.Exec(ctx, `DELETE FROM "monthly_aggregates"`, []any{}, nil); err != nil {
+		return fmt.Errorf("clearing monthly aggregates: %w", err)
+	}
+	if d != dialect.Postgres {
+		return nil
+	}
+	for _, column := range []string{"gross_income", "gross_expenses", "recurring_total", "one_time_total", "monthly_total"} {
+		query := fmt.Sprintf(`ALTER TABLE "monthly_aggregates" ALTER COLUMN %q TYPE bigint USING 0`, column)
+		if err := conn.Exec(ctx, query, []any{}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package repository_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/repository"
+)
+
+// legacySchema recreates the tables that stored amounts as decimal strings.
+var legacySchema = []string{
+	"DROP TABLE recurring_schedule_overrides",
+	"DROP TABLE transactions",
+	"DROP TABLE recurring_expenses",
+	"DROP TABLE monthly_aggregates",
+	"CREATE TABLE `transactions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `amount` text NOT NULL, `description` text NULL, `details` text NULL, `date` datetime NOT NULL, `tax_class` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `category_transactions` integer NOT NULL, `household_transactions` integer NOT NULL, CONSTRAINT `transactions_categories_transactions` FOREIGN KEY (`category_transactions`) REFERENCES `categories` (`id`) ON DELETE NO ACTION, CONSTRAINT `transactions_households_transactions` FOREIGN KEY (`household_transactions`) REFERENCES `households` (`id`) ON DELETE NO ACTION)",
+	"CREATE TABLE `recurring_expenses` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NULL DEFAULT (''), `details` text NULL DEFAULT (''), `amount` text NOT NULL, `frequency` text NOT NULL, `active` bool NOT NULL DEFAULT (true), `start_date` datetime NOT NULL, `end_date` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `category_recurring_expenses` integer NOT NULL, `household_recurring_expenses` integer NOT NULL, CONSTRAINT `recurring_expenses_categories_recurring_expenses` FOREIGN KEY (`category_recurring_expenses`) REFERENCES `categories` (`id`) ON DELETE NO ACTION, CONSTRAINT `recurring_expenses_households_recurring_expenses` FOREIGN KEY (`household_recurring_expenses`) REFERENCES `households` (`id`) ON DELETE NO ACTION)",
+	"CREATE TABLE `recurring_schedule_overrides` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `effective_date` datetime NOT NULL, `amount` text NOT NULL, `frequency` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `recurring_expense_schedule_overrides` integer NOT NULL, CONSTRAINT `recurring_schedule_overrides_re_78eeef2ea9dd1cbb2e2a01c277f1bcb6` FOREIGN KEY (`recurring_expense_schedule_overrides`) REFERENCES `recurring_expenses` (`id`) ON DELETE NO ACTION)",
+	"CREATE TABLE `monthly_aggregates` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `month` text NOT NULL, `version` integer NOT NULL, `gross_income` text NOT NULL, `gross_expenses` text NOT NULL, `recurring_total` text NOT NULL, `one_time_total` text NOT NULL, `monthly_total` text NOT NULL, `categories` json NOT NULL, `computed_at` datetime NOT NULL, `household_monthly_aggregates` integer NOT NULL, CONSTRAINT `monthly_aggregates_households_monthly_aggregates` FOREIGN KEY (`household_monthly_aggregates`) REFERENCES `households` (`id`) ON DELETE NO ACTION)",
+	"CREATE UNIQUE INDEX `monthlyaggregate_month_household_monthly_aggregates` ON `monthly_aggregates` (`month`, `household_monthly_aggregates`)",
+}
+
+var legacyRows = []string{
+	"INSERT INTO transactions (amount, date, created_at, updated_at, category_transactions, household_transactions) VALUES ('-12.34', '2026-01-15 00:00:00+00:00', '2026-01-15 00:00:00+00:00', '2026-01-15 00:00:00+00:00', 1, 1)",
+	"INSERT INTO transactions (amount, date, created_at, updated_at, category_transactions, household_transactions) VALUES ('2500', '2026-01-20 00:00:00+00:00', '2026-01-20 00:00:00+00:00', '2026-01-20 00:00:00+00:00', 1, 1)",
+	"INSERT INTO transactions (amount, date, created_at, updated_at, category_transactions, household_transactions) VALUES ('0.1', '2026-01-21 00:00:00+00:00', '2026-01-21 00:00:00+00:00', '2026-01-21 00:00:00+00:00', 1, 1)",
+	"INSERT INTO recurring_expenses (name, amount, frequency, start_date, created_at, updated_at, category_recurring_expenses, household_recurring_expenses) VALUES ('Rent', '-900', 'monthly', '2025-06-01 00:00:00+00:00', '2025-06-01 00:00:00+00:00', '2025-06-01 00:00:00+00:00', 1, 1)",
+	"INSERT INTO recurring_schedule_overrides (effective_date, amount, frequency, created_at, updated_at, recurring_expense_schedule_overrides) VALUES ('2026-01-01 00:00:00+00:00', '-950.5', 'monthly', '2026-01-01 00:00:00+00:00', '2026-01-01 00:00:00+00:00', 1)",
+	`INSERT INTO monthly_aggregates (month, version, gross_income, gross_expenses, recurring_total, one_time_total, monthly_total, categories, computed_at, household_monthly_aggregates) VALUES ('2026-01', 1, '2500.1', '-962.84', '-950.5', '2487.76', '1537.26', '[{"category_id":1,"recurring":"-950.5","one_time":"2487.76"}]', '2026-02-01 00:00:00+00:00', 1)`,
+}
+
+func TestAutoMigrateConvertsLegacyAmounts(t *testing.T) {
+	ctx := context.Background()
+	dsn := filepath.Join(t.TempDir(), "legacy.db") + "?_pragma=foreign_keys(1)"
+	drv, err := repository.OpenDriver(config.DatabaseConfig{Driver: "sqlite", DSN: dsn})
+	if err != nil {
+		t.Fatalf("failed to open driver: %v", err)
+	}
+	client := repository.NewClientFromDriver(drv)
+	t.Cleanup(func() { client.Close() })
+
+	if err := repository.AutoMigrate(ctx, client, "sqlite"); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	u := client.User.Create().SetEmail("a@example.com").SetName("A").SetSubject("sub-a").SaveX(ctx)
+	h := client.Household.Create().SetName("Home").SetOwner(u).SaveX(ctx)
+	client.Category.Create().SetName("Rent").SetHousehold(h).SaveX(ctx)
+
+	for _, stmt := range append(legacySchema, legacyRows...) {
+		if _, err := drv.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("preparing legacy schema: %v\n%s", err, stmt)
+		}
+	}
+
+	// Running twice checks that converted columns are left alone.
+	for i := 0; i < 2; i++ {
+		if err := repository.AutoMigrate(ctx, client, "sqlite"); err != nil {
+			t.Fatalf("migration %d failed: %v", i+1, err)
+		}
+	}
+
+	assertAmounts(t, drv, "transactions", -1234, 250000, 10)
+	assertAmounts(t, drv, "recurring_expenses", -90000)
+	assertAmounts(t, drv, "recurring_schedule_overrides", -95050)
+
+	txs, err := repository.NewTransactionRepository(client).ListByHouseholdAndMonth(ctx, h.ID, 2026, 1, domain.AmountFilter{})
+	if err != nil {
+		t.Fatalf("listing transactions: %v", err)
+	}
+	total := domain.ZeroMoney()
+	for _, tx := range txs {
+		total = total.Add(tx.Amount)
+	}
+	if total.String() != "2487.76" {
+		t.Errorf("transaction total = %s, want 2487.76", total)
+	}
+	if n := client.MonthlyAggregate.Query().CountX(ctx); n != 0 {
+		t.Errorf("expected stored aggregates to be dropped, got %d", n)
+	}
+}
+
+func assertAmounts(t *testing.T, drv *entsql.Driver, table string, want ...int64) {
+	t.Helper()
+	rows, err := drv.QueryContext(context.Background(), "SELECT amount, typeof(amount) FROM "+table+" ORDER BY id")
+	if err != nil {
+		t.Fatalf("querying %s: %v", table, err)
+	}
+	defer rows.Close()
+
+	var got []int64
+	for rows.Next() {
+		var amount int64
+		var typ string
+		if err := rows.Scan(&amount, &typ); err != nil {
+			t.Fatalf("scanning %s: %v", table, err)
+		}
+		if typ != "integer" {
+			t.Errorf("%s.amount stored as %s, want integer", table, typ)
+		}
+		got = append(got, amount)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%s amounts = %v, want %v", table, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s amounts = %v, want %v", table, got, want)
+			break
+		}
+	}
+}
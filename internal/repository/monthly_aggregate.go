@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/ent"
+	entgeneration "icekalt.dev/money-tracker/ent/aggregategeneration"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entaggregate "icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type MonthlyAggregateRepository struct {
+	client *ent.Client
+}
+
+func NewMonthlyAggregateRepository(client *ent.Client) *MonthlyAggregateRepository {
+	return &MonthlyAggregateRepository{client: client}
+}
+
+// ListByHouseholdAndMonths returns the stored aggregates of the given months
+// (YYYY-MM). Aggregates of another AggregateVersion are left out.
+func (r *MonthlyAggregateRepository) ListByHouseholdAndMonths(ctx context.Context, householdID int, months []string) ([]*domain.MonthlyAggregate, error) {
+	items, err := r.client.MonthlyAggregate.Query().
+		Where(
+			entaggregate.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entaggregate.MonthIn(months...),
+			entaggregate.VersionEQ(domain.AggregateVersion),
+		).
+		WithHousehold().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.MonthlyAggregate, 0, len(items))
+	for _, a := range items {
+		result = append(result, monthlyAggregateToDomain(a))
+	}
+	return result, nil
+}
+
+// Generation returns the invalidation count of the household's aggregates,
+// starting it at zero on first use.
+func (r *MonthlyAggregateRepository) Generation(ctx context.Context, householdID int) (int, error) {
+	query := r.client.AggregateGeneration.Query().
+		Where(entgeneration.HasHouseholdWith(enthousehold.IDEQ(householdID)))
+	g, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		g, err = r.client.AggregateGeneration.Create().SetHouseholdID(householdID).Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Started by a concurrent request
+			g, err = query.Clone().Only(ctx)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return g.Generation, nil
+}
+
+// Save replaces the stored aggregates of the same household months, unless
+// the household's aggregates were invalidated since generation was read. It
+// reports whether the aggregates were stored. Checking the generation locks
+// it until the aggregates are stored, so invalidations wait for Save and
+// delete what it stored.
+func (r *MonthlyAggregateRepository) Save(ctx context.Context, householdID, generation int, aggregates []*domain.MonthlyAggregate) (bool, error) {
+	if len(aggregates) == 0 {
+		return true, nil
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := tx.AggregateGeneration.Update().
+		Where(
+			entgeneration.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entgeneration.GenerationEQ(generation),
+		).
+		SetGeneration(generation).
+		Save(ctx)
+	if err != nil || n == 0 {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	builders := make([]*ent.MonthlyAggregateCreate, 0, len(aggregates))
+	for _, a := range aggregates {
+		if a.HouseholdID != householdID {
+			_ = tx.Rollback()
+			return false, fmt.Errorf("aggregate %s belongs to household %d", a.Month, a.HouseholdID)
+		}
+		_, err := tx.MonthlyAggregate.Delete().
+			Where(
+				entaggregate.HasHouseholdWith(enthousehold.IDEQ(a.HouseholdID)),
+				entaggregate.MonthEQ(a.Month),
+			).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return false, fmt.Errorf("deleting aggregate %s: %w", a.Month, err)
+		}
+		builders = append(builders, tx.MonthlyAggregate.Create().
+			SetHouseholdID(a.HouseholdID).
+			SetMonth(a.Month).
+			SetVersion(domain.AggregateVersion).
+			SetGrossIncome(domain.MinorUnits(a.GrossIncome)).
+			SetGrossExpenses(domain.MinorUnits(a.GrossExpenses)).
+			SetRecurringTotal(domain.MinorUnits(a.RecurringTotal)).
+			SetOneTimeTotal(domain.MinorUnits(a.OneTimeTotal)).
+			SetMonthlyTotal(domain.MinorUnits(a.MonthlyTotal)).
+			SetCategories(monthlyAggregateCategories(a.Categories)))
+	}
+	if _, err := tx.MonthlyAggregate.CreateBulk(builders...).Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// DeleteAll removes every stored aggregate and returns how many there were.
+// Aggregates being computed at the same time are not stored.
+func (r *MonthlyAggregateRepository) DeleteAll(ctx context.Context) (int, error) {
+	if _, err := r.client.AggregateGeneration.Update().AddGeneration(1).Save(ctx); err != nil {
+		return 0, err
+	}
+	return r.client.MonthlyAggregate.Delete().Exec(ctx)
+}
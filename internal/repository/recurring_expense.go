@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"icekalt.dev/money-tracker/ent"
 	enthousehold "icekalt.dev/money-tracker/ent/household"
 	entrecurring "icekalt.dev/money-tracker/ent/recurringexpense"
+	entoverride "icekalt.dev/money-tracker/ent/recurringscheduleoverride"
 	"icekalt.dev/money-tracker/internal/domain"
 )
 
@@ -23,7 +25,7 @@ func (r *RecurringExpenseRepository) Create(ctx context.Context, expense *domain
 		SetName(expense.Name).
 		SetDescription(expense.Description).
 		SetDetails(expense.Details).
-		SetAmount(expense.Amount.String()).
+		SetAmount(domain.MinorUnits(expense.Amount)).
 		SetFrequency(string(expense.Frequency)).
 		SetActive(expense.Active).
 		SetStartDate(expense.StartDate).
@@ -33,6 +35,14 @@ func (r *RecurringExpenseRepository) Create(ctx context.Context, expense *domain
 	if expense.EndDate != nil {
 		q.SetEndDate(*expense.EndDate)
 	}
+	if expense.DeactivatedAt != nil {
+		q.SetDeactivatedAt(*expense.DeactivatedAt)
+	}
+	if expense.Split != nil {
+		q.SetPayerID(expense.Split.PaidBy).
+			SetSplitType(string(expense.Split.Type)).
+			SetSplitShares(splitShares(expense.Split))
+	}
 
 	re, err := q.Save(ctx)
 	if err != nil {
@@ -40,14 +50,18 @@ func (r *RecurringExpenseRepository) Create(ctx context.Context, expense *domain
 	}
 	re.Edges.Household = &ent.Household{ID: expense.HouseholdID}
 	re.Edges.Category = &ent.Category{ID: expense.CategoryID}
+	if expense.Split != nil {
+		re.Edges.Payer = &ent.HouseholdMember{ID: expense.Split.PaidBy}
+	}
 	return recurringExpenseToDomain(re), nil
 }
 
 func (r *RecurringExpenseRepository) GetByID(ctx context.Context, id int) (*domain.RecurringExpense, error) {
 	re, err := r.client.RecurringExpense.Query().
-		Where(entrecurring.ID(id)).
+		Where(entrecurring.ID(id), entrecurring.DeletedAtIsNil()).
 		WithHousehold().
 		WithCategory().
+		WithPayer().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -60,9 +74,13 @@ func (r *RecurringExpenseRepository) GetByID(ctx context.Context, id int) (*doma
 
 func (r *RecurringExpenseRepository) ListByHousehold(ctx context.Context, householdID int) ([]*domain.RecurringExpense, error) {
 	items, err := r.client.RecurringExpense.Query().
-		Where(entrecurring.HasHouseholdWith(enthousehold.IDEQ(householdID))).
+		Where(
+			entrecurring.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entrecurring.DeletedAtIsNil(),
+		).
 		WithHousehold().
 		WithCategory().
+		WithPayer().
 		All(ctx)
 	if err != nil {
 		return nil, err
@@ -79,10 +97,61 @@ func (r *RecurringExpenseRepository) ListActiveByHousehold(ctx context.Context,
 	items, err := r.client.RecurringExpense.Query().
 		Where(
 			entrecurring.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entrecurring.DeletedAtIsNil(),
+			entrecurring.ActiveEQ(true),
+		).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.RecurringExpense, 0, len(items))
+	for _, re := range items {
+		result = append(result, recurringExpenseToDomain(re))
+	}
+	return result, nil
+}
+
+// ListSplitByHousehold returns all recurring expenses of the household that
+// are shared between members, including inactive ones and those in the trash.
+func (r *RecurringExpenseRepository) ListSplitByHousehold(ctx context.Context, householdID int) ([]*domain.RecurringExpense, error) {
+	items, err := r.client.RecurringExpense.Query().
+		Where(
+			entrecurring.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entrecurring.HasPayer(),
+			entrecurring.SplitTypeNEQ(""),
+		).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		Order(ent.Asc(entrecurring.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.RecurringExpense, 0, len(items))
+	for _, re := range items {
+		result = append(result, recurringExpenseToDomain(re))
+	}
+	return result, nil
+}
+
+// ListActiveByHouseholds returns the active recurring expenses of all given
+// households in one query.
+func (r *RecurringExpenseRepository) ListActiveByHouseholds(ctx context.Context, householdIDs []int) ([]*domain.RecurringExpense, error) {
+	items, err := r.client.RecurringExpense.Query().
+		Where(
+			entrecurring.HasHouseholdWith(enthousehold.IDIn(householdIDs...)),
+			entrecurring.DeletedAtIsNil(),
 			entrecurring.ActiveEQ(true),
 		).
 		WithHousehold().
 		WithCategory().
+		WithPayer().
 		All(ctx)
 	if err != nil {
 		return nil, err
@@ -97,10 +166,11 @@ func (r *RecurringExpenseRepository) ListActiveByHousehold(ctx context.Context,
 
 func (r *RecurringExpenseRepository) Update(ctx context.Context, expense *domain.RecurringExpense) (*domain.RecurringExpense, error) {
 	q := r.client.RecurringExpense.UpdateOneID(expense.ID).
+		Where(entrecurring.DeletedAtIsNil()).
 		SetName(expense.Name).
 		SetDescription(expense.Description).
 		SetDetails(expense.Details).
-		SetAmount(expense.Amount.String()).
+		SetAmount(domain.MinorUnits(expense.Amount)).
 		SetFrequency(string(expense.Frequency)).
 		SetActive(expense.Active).
 		SetStartDate(expense.StartDate).
@@ -111,6 +181,18 @@ func (r *RecurringExpenseRepository) Update(ctx context.Context, expense *domain
 	} else {
 		q.ClearEndDate()
 	}
+	if expense.DeactivatedAt != nil {
+		q.SetDeactivatedAt(*expense.DeactivatedAt)
+	} else {
+		q.ClearDeactivatedAt()
+	}
+	if expense.Split != nil {
+		q.SetPayerID(expense.Split.PaidBy).
+			SetSplitType(string(expense.Split.Type)).
+			SetSplitShares(splitShares(expense.Split))
+	} else {
+		q.ClearPayer().ClearSplitType().ClearSplitShares()
+	}
 
 	re, err := q.Save(ctx)
 	if err != nil {
@@ -121,11 +203,60 @@ func (r *RecurringExpenseRepository) Update(ctx context.Context, expense *domain
 	}
 	re.Edges.Household = &ent.Household{ID: expense.HouseholdID}
 	re.Edges.Category = &ent.Category{ID: expense.CategoryID}
+	if expense.Split != nil {
+		re.Edges.Payer = &ent.HouseholdMember{ID: expense.Split.PaidBy}
+	}
+	return recurringExpenseToDomain(re), nil
+}
+
+// GetDeletedByID returns a recurring expense from the trash.
+func (r *RecurringExpenseRepository) GetDeletedByID(ctx context.Context, id int) (*domain.RecurringExpense, error) {
+	re, err := r.client.RecurringExpense.Query().
+		Where(entrecurring.ID(id), entrecurring.DeletedAtNotNil()).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: deleted recurring expense %d", domain.ErrNotFound, id)
+		}
+		return nil, err
+	}
 	return recurringExpenseToDomain(re), nil
 }
 
+// ListDeletedByHousehold returns the household's recurring expenses in the
+// trash, most recently deleted first.
+func (r *RecurringExpenseRepository) ListDeletedByHousehold(ctx context.Context, householdID int) ([]*domain.RecurringExpense, error) {
+	items, err := r.client.RecurringExpense.Query().
+		Where(
+			entrecurring.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			entrecurring.DeletedAtNotNil(),
+		).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		Order(ent.Desc(entrecurring.FieldDeletedAt), ent.Desc(entrecurring.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.RecurringExpense, 0, len(items))
+	for _, re := range items {
+		result = append(result, recurringExpenseToDomain(re))
+	}
+	return result, nil
+}
+
+// Delete moves the recurring expense to the trash. Its schedule overrides
+// are kept for a restore.
 func (r *RecurringExpenseRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.RecurringExpense.DeleteOneID(id).Exec(ctx)
+	err := r.client.RecurringExpense.UpdateOneID(id).
+		Where(entrecurring.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return fmt.Errorf("%w: recurring expense %d", domain.ErrNotFound, id)
@@ -134,3 +265,58 @@ func (r *RecurringExpenseRepository) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// Restore takes the recurring expense out of the trash.
+func (r *RecurringExpenseRepository) Restore(ctx context.Context, id int) error {
+	err := r.client.RecurringExpense.UpdateOneID(id).
+		Where(entrecurring.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: deleted recurring expense %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// PurgeDeletedBefore deletes all recurring expenses moved to the trash
+// before the given time, together with their schedule overrides and the
+// revisions of both, and returns how many there were.
+func (r *RecurringExpenseRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	var n int
+	err := withTx(ctx, r.client, func(client *ent.Client) error {
+		ids, err := client.RecurringExpense.Query().
+			Where(entrecurring.DeletedAtLT(before)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		overrideIDs, err := client.RecurringScheduleOverride.Query().
+			Where(entoverride.HasRecurringExpenseWith(entrecurring.DeletedAtLT(before))).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.RecurringScheduleOverride.Delete().
+			Where(entoverride.HasRecurringExpenseWith(entrecurring.DeletedAtLT(before))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting schedule overrides: %w", err)
+		}
+		if n, err = client.RecurringExpense.Delete().Where(entrecurring.DeletedAtLT(before)).Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := deleteRevisions(ctx, client, domain.EntityScheduleOverride, overrideIDs); err != nil {
+			return err
+		}
+		return deleteRevisions(ctx, client, domain.EntityRecurringExpense, ids)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
@@ -21,7 +21,7 @@ func NewRecurringScheduleOverrideRepository(client *ent.Client) *RecurringSchedu
 func (r *RecurringScheduleOverrideRepository) Create(ctx context.Context, override *domain.RecurringScheduleOverride) (*domain.RecurringScheduleOverride, error) {
 	o, err := r.client.RecurringScheduleOverride.Create().
 		SetEffectiveDate(override.EffectiveDate).
-		SetAmount(override.Amount.String()).
+		SetAmount(domain.MinorUnits(override.Amount)).
 		SetFrequency(string(override.Frequency)).
 		SetRecurringExpenseID(override.RecurringExpenseID).
 		Save(ctx)
@@ -63,10 +63,29 @@ func (r *RecurringScheduleOverrideRepository) ListByRecurringExpense(ctx context
 	return result, nil
 }
 
+// ListByRecurringExpenses returns the overrides of all given recurring
+// expenses in one query, ordered by effective date.
+func (r *RecurringScheduleOverrideRepository) ListByRecurringExpenses(ctx context.Context, recurringExpenseIDs []int) ([]*domain.RecurringScheduleOverride, error) {
+	items, err := r.client.RecurringScheduleOverride.Query().
+		Where(entoverride.HasRecurringExpenseWith(entrecurring.IDIn(recurringExpenseIDs...))).
+		WithRecurringExpense().
+		Order(ent.Asc(entoverride.FieldEffectiveDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.RecurringScheduleOverride, 0, len(items))
+	for _, o := range items {
+		result = append(result, overrideToDomain(o))
+	}
+	return result, nil
+}
+
 func (r *RecurringScheduleOverrideRepository) Update(ctx context.Context, override *domain.RecurringScheduleOverride) (*domain.RecurringScheduleOverride, error) {
 	o, err := r.client.RecurringScheduleOverride.UpdateOneID(override.ID).
 		SetEffectiveDate(override.EffectiveDate).
-		SetAmount(override.Amount.String()).
+		SetAmount(domain.MinorUnits(override.Amount)).
 		SetFrequency(string(override.Frequency)).
 		Save(ctx)
 	if err != nil {
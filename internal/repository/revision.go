@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/ent"
+	entrevision "icekalt.dev/money-tracker/ent/revision"
+	entuser "icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// RevisionRepository reads the revisions the revision hooks record. The
+// repositories that purge records delete their revisions with
+// deleteRevisions.
+type RevisionRepository struct {
+	client *ent.Client
+}
+
+func NewRevisionRepository(client *ent.Client) *RevisionRepository {
+	return &RevisionRepository{client: client}
+}
+
+func (r *RevisionRepository) List(ctx context.Context, householdID int, entityType domain.EntityType, entityID int) ([]*domain.Revision, error) {
+	items, err := clientFor(ctx, r.client).Revision.Query().
+		Where(
+			entrevision.HouseholdID(householdID),
+			entrevision.EntityType(string(entityType)),
+			entrevision.EntityID(entityID),
+		).
+		Order(ent.Desc(entrevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []int
+	for _, item := range items {
+		if item.UserID != nil {
+			userIDs = append(userIDs, *item.UserID)
+		}
+	}
+	names := make(map[int]string)
+	if len(userIDs) > 0 {
+		users, err := clientFor(ctx, r.client).User.Query().
+			Where(entuser.IDIn(userIDs...)).
+			Select(entuser.FieldName).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			names[u.ID] = u.Name
+		}
+	}
+
+	result := make([]*domain.Revision, len(items))
+	for i, item := range items {
+		result[i] = revisionToDomain(item)
+		if item.UserID != nil {
+			result[i].UserName = names[*item.UserID]
+		}
+	}
+	return result, nil
+}
+
+// ListByUser returns the revisions the user made, oldest first.
+func (r *RevisionRepository) ListByUser(ctx context.Context, userID int) ([]*domain.Revision, error) {
+	items, err := clientFor(ctx, r.client).Revision.Query().
+		Where(entrevision.UserID(userID)).
+		Order(ent.Asc(entrevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Revision, len(items))
+	for i, item := range items {
+		result[i] = revisionToDomain(item)
+	}
+	return result, nil
+}
+
+// ClearUser removes the user as the author of their revisions.
+func (r *RevisionRepository) ClearUser(ctx context.Context, userID int) error {
+	_, err := clientFor(ctx, r.client).Revision.Update().
+		Where(entrevision.UserID(userID)).
+		ClearUserID().
+		Save(ctx)
+	return err
+}
+
+// deleteRevisions deletes the revisions of the given records, so that purged
+// data doesn't live on in their history.
+func deleteRevisions(ctx context.Context, client *ent.Client, entityType domain.EntityType, ids []int) error {
+	for start := 0; start < len(ids); start += revisionBatch {
+		_, err := client.Revision.Delete().
+			Where(
+				entrevision.EntityType(string(entityType)),
+				entrevision.EntityIDIn(ids[start:min(start+revisionBatch, len(ids))]...),
+			).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting revisions: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+package repository
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"icekalt.dev/money-tracker/ent"
+	entcategory "icekalt.dev/money-tracker/ent/category"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entmember "icekalt.dev/money-tracker/ent/householdmember"
+	entrecurring "icekalt.dev/money-tracker/ent/recurringexpense"
+	entoverride "icekalt.dev/money-tracker/ent/recurringscheduleoverride"
+	"icekalt.dev/money-tracker/ent/schema"
+	enttransaction "icekalt.dev/money-tracker/ent/transaction"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// revisionBatch is how many records the revision hooks load and how many
+// revisions they write at a time, so that bulk deletes stay below the
+// databases' parameter limits.
+const revisionBatch = 500
+
+// registerRevisionHooks records a revision for every create, update and
+// delete of households, categories, transactions, recurring expenses and
+// schedule overrides. Moving a record to the trash counts as its deletion;
+// deleting it from the trash is a purge, which deletes its revisions instead.
+// The revisions are written with
+// the mutation's client, so inside a transaction they are committed or rolled
+// back with the change.
+func registerRevisionHooks(client *ent.Client) {
+	client.Household.Use(revisionHook(domain.EntityHousehold, householdSnapshots))
+	client.Category.Use(revisionHook(domain.EntityCategory, categorySnapshots))
+	client.Transaction.Use(revisionHook(domain.EntityTransaction, transactionSnapshots))
+	client.RecurringExpense.Use(revisionHook(domain.EntityRecurringExpense, recurringExpenseSnapshots))
+	client.RecurringScheduleOverride.Use(revisionHook(domain.EntityScheduleOverride, overrideSnapshots))
+}
+
+// snapshot holds the values of one record as recorded in a revision.
+type snapshot struct {
+	householdID int
+	deleted     bool // in the trash
+	values      map[string]string
+}
+
+// snapshotFunc loads the current values of the records with the given IDs.
+// Records that don't exist are left out.
+type snapshotFunc func(ctx context.Context, client *ent.Client, ids []int) (map[int]snapshot, error)
+
+// revisionMutation is implemented by the generated mutations of all
+// entities with revisions.
+type revisionMutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Client() *ent.Client
+}
+
+func revisionHook(entityType domain.EntityType, load snapshotFunc) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, mutation ent.Mutation) (ent.Value, error) {
+			m, ok := mutation.(revisionMutation)
+			if !ok {
+				return next.Mutate(ctx, mutation)
+			}
+
+			var ids []int
+			var before map[int]snapshot
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = m.IDs(ctx); err != nil {
+					return nil, err
+				}
+				if before, err = loadSnapshots(ctx, m.Client(), load, ids); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			action := domain.RevisionUpdate
+			var after map[int]snapshot
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				action = domain.RevisionCreate
+				id, ok := m.ID()
+				if !ok {
+					return v, nil
+				}
+				ids = []int{id}
+				after, err = loadSnapshots(ctx, m.Client(), load, ids)
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				action = domain.RevisionDelete
+			default:
+				if _, ok := m.Field("deleted_at"); ok {
+					action = domain.RevisionDelete
+				} else if m.FieldCleared("deleted_at") {
+					action = domain.RevisionRestore
+					after, err = loadSnapshots(ctx, m.Client(), load, ids)
+					before = nil
+				} else {
+					after, err = loadSnapshots(ctx, m.Client(), load, ids)
+				}
+			}
+			if err != nil {
+				return v, err
+			}
+			return v, createRevisions(ctx, m.Client(), entityType, action, ids, before, after)
+		})
+	}
+}
+
+func loadSnapshots(ctx context.Context, client *ent.Client, load snapshotFunc, ids []int) (map[int]snapshot, error) {
+	all := make(map[int]snapshot, len(ids))
+	for start := 0; start < len(ids); start += revisionBatch {
+		batch, err := load(ctx, client, ids[start:min(start+revisionBatch, len(ids))])
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(all, batch)
+	}
+	return all, nil
+}
+
+// createRevisions writes one revision per record whose values changed.
+// Updates that only touch fields without revision values, such as
+// timestamps, leave no revision. Deleting a record that is already in the
+// trash leaves none either, the purge deletes its revisions.
+func createRevisions(ctx context.Context, client *ent.Client, entityType domain.EntityType, action domain.RevisionAction, ids []int, before, after map[int]snapshot) error {
+	var userID *int
+	if id, ok := domain.ActorFromContext(ctx); ok {
+		userID = &id
+	}
+
+	builders := make([]*ent.RevisionCreate, 0, len(ids))
+	for _, id := range ids {
+		old, hadOld := before[id]
+		cur, hasCur := after[id]
+		if action == domain.RevisionUpdate && maps.Equal(old.values, cur.values) {
+			continue
+		}
+		householdID := cur.householdID
+		if !hasCur {
+			if !hadOld {
+				continue
+			}
+			householdID = old.householdID
+		}
+		if action == domain.RevisionDelete && old.deleted {
+			continue
+		}
+		builders = append(builders, client.Revision.Create().
+			SetEntityType(string(entityType)).
+			SetEntityID(id).
+			SetHouseholdID(householdID).
+			SetAction(string(action)).
+			SetNillableUserID(userID).
+			SetBefore(old.values).
+			SetAfter(cur.values))
+	}
+
+	for start := 0; start < len(builders); start += revisionBatch {
+		batch := builders[start:min(start+revisionBatch, len(builders))]
+		if _, err := client.Revision.CreateBulk(batch...).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func householdSnapshots(ctx context.Context, client *ent.Client, ids []int) (map[int]snapshot, error) {
+	households, err := client.Household.Query().
+		Where(enthousehold.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make(map[int]snapshot, len(households))
+	for _, h := range households {
+		snapshots[h.ID] = snapshot{householdID: h.ID, deleted: h.DeletedAt != nil, values: map[string]string{
+			"name":        h.Name,
+			"description": h.Description,
+			"currency":    h.Currency,
+			"icon":        h.Icon,
+		}}
+	}
+	return snapshots, nil
+}
+
+func categorySnapshots(ctx context.Context, client *ent.Client, ids []int) (map[int]snapshot, error) {
+	categories, err := client.Category.Query().
+		Where(entcategory.IDIn(ids...)).
+		WithHousehold().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make(map[int]snapshot, len(categories))
+	for _, c := range categories {
+		snapshots[c.ID] = snapshot{householdID: householdIDOf(c.Edges.Household), values: map[string]string{
+			"name":      c.Name,
+			"icon":      c.Icon,
+			"tax_class": c.TaxClass,
+		}}
+	}
+	return snapshots, nil
+}
+
+func transactionSnapshots(ctx context.Context, client *ent.Client, ids []int) (map[int]snapshot, error) {
+	transactions, err := client.Transaction.Query().
+		Where(enttransaction.IDIn(ids...)).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var shares [][]schema.SplitShare
+	for _, t := range transactions {
+		shares = append(shares, t.SplitShares)
+	}
+	names, err := shareMemberNames(ctx, client, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[int]snapshot, len(transactions))
+	for _, t := range transactions {
+		snapshots[t.ID] = snapshot{householdID: householdIDOf(t.Edges.Household), deleted: t.DeletedAt != nil, values: map[string]string{
+			"amount":       formatRevisionAmount(t.Amount),
+			"description":  t.Description,
+			"details":      t.Details,
+			"date":         t.Date.Format("2006-01-02"),
+			"tax_class":    t.TaxClass,
+			"category":     categoryName(t.Edges.Category),
+			"paid_by":      memberName(t.Edges.Payer),
+			"split_type":   t.SplitType,
+			"split_shares": formatRevisionShares(t.SplitType, t.SplitShares, names),
+		}}
+	}
+	return snapshots, nil
+}
+
+func recurringExpenseSnapshots(ctx context.Context, client *ent.Client, ids []int) (map[int]snapshot, error) {
+	expenses, err := client.RecurringExpense.Query().
+		Where(entrecurring.IDIn(ids...)).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var shares [][]schema.SplitShare
+	for _, r := range expenses {
+		shares = append(shares, r.SplitShares)
+	}
+	names, err := shareMemberNames(ctx, client, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[int]snapshot, len(expenses))
+	for _, r := range expenses {
+		endDate := ""
+		if r.EndDate != nil {
+			endDate = r.EndDate.Format("2006-01-02")
+		}
+		snapshots[r.ID] = snapshot{householdID: householdIDOf(r.Edges.Household), deleted: r.DeletedAt != nil, values: map[string]string{
+			"name":         r.Name,
+			"description":  r.Description,
+			"details":      r.Details,
+			"amount":       formatRevisionAmount(r.Amount),
+			"frequency":    r.Frequency,
+			"active":       strconv.FormatBool(r.Active),
+			"start_date":   r.StartDate.Format("2006-01-02"),
+			"end_date":     endDate,
+			"category":     categoryName(r.Edges.Category),
+			"paid_by":      memberName(r.Edges.Payer),
+			"split_type":   r.SplitType,
+			"split_shares": formatRevisionShares(r.SplitType, r.SplitShares, names),
+		}}
+	}
+	return snapshots, nil
+}
+
+func overrideSnapshots(ctx context.Context, client *ent.Client, ids []int) (map[int]snapshot, error) {
+	overrides, err := client.RecurringScheduleOverride.Query().
+		Where(entoverride.IDIn(ids...)).
+		WithRecurringExpense(func(q *ent.RecurringExpenseQuery) {
+			q.WithHousehold()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make(map[int]snapshot, len(overrides))
+	for _, o := range overrides {
+		var householdID int
+		var expense string
+		if re := o.Edges.RecurringExpense; re != nil {
+			householdID = householdIDOf(re.Edges.Household)
+			expense = re.Name
+		}
+		snapshots[o.ID] = snapshot{householdID: householdID, values: map[string]string{
+			"recurring_expense": expense,
+			"effective_date":    o.EffectiveDate.Format("2006-01-02"),
+			"amount":            formatRevisionAmount(o.Amount),
+			"frequency":         o.Frequency,
+		}}
+	}
+	return snapshots, nil
+}
+
+// shareMemberNames loads the names of all members in the given split shares.
+func shareMemberNames(ctx context.Context, client *ent.Client, shares [][]schema.SplitShare) (map[int]string, error) {
+	seen := make(map[int]bool)
+	for _, s := range shares {
+		for _, sh := range s {
+			seen[sh.MemberID] = true
+		}
+	}
+	if len(seen) == 0 {
+		return nil, nil
+	}
+	members, err := client.HouseholdMember.Query().
+		Where(entmember.IDIn(slices.Collect(maps.Keys(seen))...)).
+		Select(entmember.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(members))
+	for _, m := range members {
+		names[m.ID] = m.Name
+	}
+	return names, nil
+}
+
+// formatRevisionShares lists the members of a split with their share.
+// Equal splits only list the members.
+func formatRevisionShares(splitType string, shares []schema.SplitShare, names map[int]string) string {
+	parts := make([]string, 0, len(shares))
+	for _, sh := range shares {
+		name, ok := names[sh.MemberID]
+		if !ok {
+			name = "#" + strconv.Itoa(sh.MemberID)
+		}
+		if splitType == string(domain.SplitEqual) {
+			parts = append(parts, name)
+			continue
+		}
+		parts = append(parts, name+" "+formatRevisionAmount(sh.Value))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatRevisionAmount(minor int64) string {
+	return domain.MoneyFromInt(minor).StringFixed(2)
+}
+
+func categoryName(c *ent.Category) string {
+	if c == nil {
+		return ""
+	}
+	return c.Name
+}
+
+func memberName(m *ent.HouseholdMember) string {
+	if m == nil {
+		return ""
+	}
+	return m.Name
+}
+
+func householdIDOf(h *ent.Household) int {
+	if h == nil {
+		return 0
+	}
+	return h.ID
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"icekalt.dev/money-tracker/ent"
+	"icekalt.dev/money-tracker/ent/predicate"
+	entevent "icekalt.dev/money-tracker/ent/securityevent"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// securityEventBatch is how many events Each loads at a time.
+const securityEventBatch = 500
+
+type SecurityEventRepository struct {
+	client *ent.Client
+}
+
+func NewSecurityEventRepository(client *ent.Client) *SecurityEventRepository {
+	return &SecurityEventRepository{client: client}
+}
+
+func (r *SecurityEventRepository) Create(ctx context.Context, event *domain.SecurityEvent) (*domain.SecurityEvent, error) {
+	e, err := clientFor(ctx, r.client).SecurityEvent.Create().
+		SetType(string(event.Type)).
+		SetNillableUserID(event.UserID).
+		SetIP(event.IP).
+		SetUserAgent(event.UserAgent).
+		SetDetails(event.Details).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return securityEventToDomain(e), nil
+}
+
+func (r *SecurityEventRepository) List(ctx context.Context, userID *int, limit int) ([]*domain.SecurityEvent, error) {
+	items, err := clientFor(ctx, r.client).SecurityEvent.Query().
+		Where(securityEventsOf(userID)...).
+		Order(ent.Desc(entevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.SecurityEvent, len(items))
+	for i, e := range items {
+		result[i] = securityEventToDomain(e)
+	}
+	return result, nil
+}
+
+// Each pages through the events by ID, so a long export doesn't hold all
+// of them in memory.
+func (r *SecurityEventRepository) Each(ctx context.Context, userID *int, fn func(*domain.SecurityEvent) error) error {
+	afterID := 0
+	for {
+		items, err := clientFor(ctx, r.client).SecurityEvent.Query().
+			Where(append(securityEventsOf(userID), entevent.IDGT(afterID))...).
+			Order(ent.Asc(entevent.FieldID)).
+			Limit(securityEventBatch).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, e := range items {
+			if err := fn(securityEventToDomain(e)); err != nil {
+				return err
+			}
+		}
+		if len(items) < securityEventBatch {
+			return nil
+		}
+		afterID = items[len(items)-1].ID
+	}
+}
+
+func securityEventsOf(userID *int) []predicate.SecurityEvent {
+	if userID == nil {
+		return nil
+	}
+	return []predicate.SecurityEvent{entevent.UserIDEQ(*userID)}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"icekalt.dev/money-tracker/ent"
+	"icekalt.dev/money-tracker/ent/predicate"
+	entsession "icekalt.dev/money-tracker/ent/session"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type SessionRepository struct {
+	client *ent.Client
+}
+
+func NewSessionRepository(client *ent.Client) *SessionRepository {
+	return &SessionRepository{client: client}
+}
+
+// ListByUser returns the user's sessions that have not expired, most
+// recently created first.
+func (r *SessionRepository) ListByUser(ctx context.Context, userID int) ([]*domain.Session, error) {
+	items, err := clientFor(ctx, r.client).Session.Query().
+		Where(
+			entsession.UserIDEQ(userID),
+			entsession.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(entsession.FieldCreatedAt), ent.Desc(entsession.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Session, 0, len(items))
+	for _, s := range items {
+		result = append(result, sessionToDomain(s))
+	}
+	return result, nil
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, userID, id int) error {
+	n, err := clientFor(ctx, r.client).Session.Delete().
+		Where(
+			entsession.ID(id),
+			entsession.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: session %d", domain.ErrNotFound, id)
+	}
+	return nil
+}
+
+func (r *SessionRepository) DeleteByUser(ctx context.Context, userID int) (int, error) {
+	return clientFor(ctx, r.client).Session.Delete().
+		Where(entsession.UserIDEQ(userID)).
+		Exec(ctx)
+}
+
+func (r *SessionRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	return clientFor(ctx, r.client).Session.Delete().
+		Where(entsession.ExpiresAtLT(before)).
+		Exec(ctx)
+}
+
+// DeleteByOIDC deletes the sessions with the given session ID at the
+// provider or, if sid is empty, all sessions of the subject. If both are
+// given, both must match. Sessions of other issuers are never matched.
+func (r *SessionRepository) DeleteByOIDC(ctx context.Context, issuer, subject, sid string) ([]int, error) {
+	where := []predicate.Session{entsession.OidcIssuerEQ(issuer)}
+	if subject != "" {
+		where = append(where, entsession.OidcSubjectEQ(subject))
+	}
+	if sid != "" {
+		where = append(where, entsession.OidcSidEQ(sid))
+	}
+	if len(where) == 1 {
+		return nil, nil
+	}
+
+	items, err := clientFor(ctx, r.client).Session.Query().Where(where...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(items))
+	var userIDs []int
+	for _, s := range items {
+		ids = append(ids, s.ID)
+		if s.UserID != nil {
+			userIDs = append(userIDs, *s.UserID)
+		}
+	}
+	if _, err := clientFor(ctx, r.client).Session.Delete().Where(entsession.IDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/ent"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entsettlement "icekalt.dev/money-tracker/ent/settlement"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type SettlementRepository struct {
+	client *ent.Client
+}
+
+func NewSettlementRepository(client *ent.Client) *SettlementRepository {
+	return &SettlementRepository{client: client}
+}
+
+func (r *SettlementRepository) Create(ctx context.Context, settlement *domain.Settlement) (*domain.Settlement, error) {
+	s, err := r.client.Settlement.Create().
+		SetAmount(domain.MinorUnits(settlement.Amount)).
+		SetDate(settlement.Date).
+		SetNote(settlement.Note).
+		SetHouseholdID(settlement.HouseholdID).
+		SetFromID(settlement.FromMemberID).
+		SetToID(settlement.ToMemberID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.Edges.Household = &ent.Household{ID: settlement.HouseholdID}
+	s.Edges.From = &ent.HouseholdMember{ID: settlement.FromMemberID}
+	s.Edges.To = &ent.HouseholdMember{ID: settlement.ToMemberID}
+	return settlementToDomain(s), nil
+}
+
+func (r *SettlementRepository) GetByID(ctx context.Context, id int) (*domain.Settlement, error) {
+	s, err := r.client.Settlement.Query().
+		Where(entsettlement.ID(id)).
+		WithHousehold().
+		WithFrom().
+		WithTo().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: settlement %d", domain.ErrNotFound, id)
+		}
+		return nil, err
+	}
+	return settlementToDomain(s), nil
+}
+
+// ListByHousehold returns the settlements of the household, newest first.
+func (r *SettlementRepository) ListByHousehold(ctx context.Context, householdID int) ([]*domain.Settlement, error) {
+	items, err := r.client.Settlement.Query().
+		Where(entsettlement.HasHouseholdWith(enthousehold.IDEQ(householdID))).
+		WithHousehold().
+		WithFrom().
+		WithTo().
+		Order(ent.Desc(entsettlement.FieldDate), ent.Desc(entsettlement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Settlement, 0, len(items))
+	for _, s := range items {
+		result = append(result, settlementToDomain(s))
+	}
+	return result, nil
+}
+
+func (r *SettlementRepository) Delete(ctx context.Context, id int) error {
+	err := r.client.Settlement.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: settlement %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
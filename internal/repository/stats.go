@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"icekalt.dev/money-tracker/ent"
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entrecurring "icekalt.dev/money-tracker/ent/recurringexpense"
+	enttransaction "icekalt.dev/money-tracker/ent/transaction"
+	entuser "icekalt.dev/money-tracker/ent/user"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type StatsRepository struct {
+	client *ent.Client
+	drv    dialect.Driver
+}
+
+// NewStatsRepository needs the raw driver besides the client to ask the
+// database for its size.
+func NewStatsRepository(client *ent.Client, drv dialect.Driver) *StatsRepository {
+	return &StatsRepository{client: client, drv: drv}
+}
+
+func (r *StatsRepository) Stats(ctx context.Context) (*domain.InstanceStats, error) {
+	stats := &domain.InstanceStats{}
+	counts := []struct {
+		name  string
+		dst   *int
+		count func(context.Context) (int, error)
+	}{
+		{"users", &stats.Users, r.client.User.Query().Count},
+		{"admins", &stats.Admins, r.client.User.Query().Where(entuser.Admin(true)).Count},
+		{"disabled users", &stats.DisabledUsers, r.client.User.Query().Where(entuser.DisabledAtNotNil()).Count},
+		{"local users", &stats.LocalUsers, r.client.LocalCredential.Query().Count},
+		{"invited users", &stats.InvitedUsers, r.client.User.Query().Where(entuser.SubjectHasPrefix(domain.InvitedSubjectPrefix)).Count},
+		{"households", &stats.Households, r.client.Household.Query().Where(enthousehold.DeletedAtIsNil()).Count},
+		{"categories", &stats.Categories, r.client.Category.Query().Count},
+		{"transactions", &stats.Transactions, r.client.Transaction.Query().Where(enttransaction.DeletedAtIsNil()).Count},
+		{"recurring expenses", &stats.RecurringExpenses, r.client.RecurringExpense.Query().Where(entrecurring.DeletedAtIsNil()).Count},
+		{"api tokens", &stats.APITokens, r.client.APIToken.Query().Count},
+		{"sessions", &stats.Sessions, r.client.Session.Query().Count},
+	}
+	for _, c := range counts {
+		n, err := c.count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("counting %s: %w", c.name, err)
+		}
+		*c.dst = n
+	}
+
+	size, err := r.databaseSize(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading database size: %w", err)
+	}
+	stats.DatabaseBytes = size
+	return stats, nil
+}
+
+func (r *StatsRepository) databaseSize(ctx context.Context) (int64, error) {
+	var query string
+	switch r.drv.Dialect() {
+	case dialect.SQLite:
+		query = "SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()"
+	case dialect.Postgres:
+		query = "SELECT pg_database_size(current_database())"
+	default:
+		return 0, nil
+	}
+
+	var rows entsql.Rows
+	if err := r.drv.Query(ctx, query, []any{}, &rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var size int64
+	if rows.Next() {
+		if err := rows.Scan(&size); err != nil {
+			return 0, err
+		}
+	}
+	return size, rows.Err()
+}
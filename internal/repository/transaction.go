@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"icekalt.dev/money-tracker/ent"
 	enthousehold "icekalt.dev/money-tracker/ent/household"
 	enttransaction "icekalt.dev/money-tracker/ent/transaction"
@@ -20,27 +22,39 @@ func NewTransactionRepository(client *ent.Client) *TransactionRepository {
 }
 
 func (r *TransactionRepository) Create(ctx context.Context, tx *domain.Transaction) (*domain.Transaction, error) {
-	t, err := r.client.Transaction.Create().
-		SetAmount(tx.Amount.String()).
+	q := r.client.Transaction.Create().
+		SetAmount(domain.MinorUnits(tx.Amount)).
 		SetDescription(tx.Description).
 		SetDetails(tx.Details).
+		SetTaxClass(string(tx.TaxClass)).
 		SetDate(tx.Date).
 		SetHouseholdID(tx.HouseholdID).
-		SetCategoryID(tx.CategoryID).
-		Save(ctx)
+		SetCategoryID(tx.CategoryID)
+
+	if tx.Split != nil {
+		q.SetPayerID(tx.Split.PaidBy).
+			SetSplitType(string(tx.Split.Type)).
+			SetSplitShares(splitShares(tx.Split))
+	}
+
+	t, err := q.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 	t.Edges.Household = &ent.Household{ID: tx.HouseholdID}
 	t.Edges.Category = &ent.Category{ID: tx.CategoryID}
+	if tx.Split != nil {
+		t.Edges.Payer = &ent.HouseholdMember{ID: tx.Split.PaidBy}
+	}
 	return transactionToDomain(t), nil
 }
 
 func (r *TransactionRepository) GetByID(ctx context.Context, id int) (*domain.Transaction, error) {
 	t, err := r.client.Transaction.Query().
-		Where(enttransaction.ID(id)).
+		Where(enttransaction.ID(id), enttransaction.DeletedAtIsNil()).
 		WithHousehold().
 		WithCategory().
+		WithPayer().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -51,18 +65,80 @@ func (r *TransactionRepository) GetByID(ctx context.Context, id int) (*domain.Tr
 	return transactionToDomain(t), nil
 }
 
-func (r *TransactionRepository) ListByHouseholdAndMonth(ctx context.Context, householdID int, year int, month time.Month) ([]*domain.Transaction, error) {
+// ListByHouseholdAndMonth returns the transactions of the month whose amount
+// lies within filter.
+func (r *TransactionRepository) ListByHouseholdAndMonth(ctx context.Context, householdID int, year int, month time.Month, filter domain.AmountFilter) ([]*domain.Transaction, error) {
 	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 	end := start.AddDate(0, 1, 0)
 
-	items, err := r.client.Transaction.Query().
+	query := r.client.Transaction.Query().
 		Where(
 			enttransaction.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			enttransaction.DeletedAtIsNil(),
 			enttransaction.DateGTE(start),
 			enttransaction.DateLT(end),
+		)
+	if filter.Min != nil {
+		query.Where(enttransaction.AmountGTE(domain.MinorUnits(*filter.Min)))
+	}
+	if filter.Max != nil {
+		query.Where(enttransaction.AmountLTE(domain.MinorUnits(*filter.Max)))
+	}
+
+	items, err := query.
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Transaction, 0, len(items))
+	for _, t := range items {
+		result = append(result, transactionToDomain(t))
+	}
+	return result, nil
+}
+
+// ListByHouseholdAndDateRange returns all transactions with from <= date < to.
+func (r *TransactionRepository) ListByHouseholdAndDateRange(ctx context.Context, householdID int, from, to time.Time) ([]*domain.Transaction, error) {
+	items, err := r.client.Transaction.Query().
+		Where(
+			enttransaction.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			enttransaction.DeletedAtIsNil(),
+			enttransaction.DateGTE(from),
+			enttransaction.DateLT(to),
+		).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Transaction, 0, len(items))
+	for _, t := range items {
+		result = append(result, transactionToDomain(t))
+	}
+	return result, nil
+}
+
+// ListSplitByHousehold returns all transactions of the household that are
+// shared between members.
+func (r *TransactionRepository) ListSplitByHousehold(ctx context.Context, householdID int) ([]*domain.Transaction, error) {
+	items, err := r.client.Transaction.Query().
+		Where(
+			enttransaction.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			enttransaction.DeletedAtIsNil(),
+			enttransaction.HasPayer(),
+			enttransaction.SplitTypeNEQ(""),
 		).
 		WithHousehold().
 		WithCategory().
+		WithPayer().
+		Order(ent.Asc(enttransaction.FieldDate), ent.Asc(enttransaction.FieldID)).
 		All(ctx)
 	if err != nil {
 		return nil, err
@@ -75,14 +151,107 @@ func (r *TransactionRepository) ListByHouseholdAndMonth(ctx context.Context, hou
 	return result, nil
 }
 
+// ListByHousehold returns all transactions of the household, oldest first.
+func (r *TransactionRepository) ListByHousehold(ctx context.Context, householdID int) ([]*domain.Transaction, error) {
+	items, err := r.client.Transaction.Query().
+		Where(
+			enttransaction.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			enttransaction.DeletedAtIsNil(),
+		).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		Order(ent.Asc(enttransaction.FieldDate), ent.Asc(enttransaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Transaction, 0, len(items))
+	for _, t := range items {
+		result = append(result, transactionToDomain(t))
+	}
+	return result, nil
+}
+
+// SumByMonthAndCategory sums up the income and expenses of all given
+// households with from <= date < to per household, category and month. The
+// grouping and summing happen in the database.
+func (r *TransactionRepository) SumByMonthAndCategory(ctx context.Context, householdIDs []int, from, to time.Time) ([]*domain.TransactionSum, error) {
+	var rows []struct {
+		HouseholdID int    `sql:"household_id"`
+		CategoryID  int    `sql:"category_id"`
+		Month       string `sql:"month"`
+		Income      int64  `sql:"income"`
+		Expenses    int64  `sql:"expenses"`
+	}
+	err := r.client.Transaction.Query().
+		Where(
+			enttransaction.HasHouseholdWith(enthousehold.IDIn(householdIDs...)),
+			enttransaction.DeletedAtIsNil(),
+			enttransaction.DateGTE(from),
+			enttransaction.DateLT(to),
+		).
+		Modify(func(s *sql.Selector) {
+			household := s.C(enttransaction.HouseholdColumn)
+			category := s.C(enttransaction.CategoryColumn)
+			month := monthExpr(s.Dialect(), s.C(enttransaction.FieldDate))
+			amount := s.C(enttransaction.FieldAmount)
+			s.Select().
+				AppendSelectExprAs(sql.Expr(household), "household_id").
+				AppendSelectExprAs(sql.Expr(category), "category_id").
+				AppendSelectExprAs(sql.Expr(month), "month").
+				AppendSelectExprAs(sql.Expr(fmt.Sprintf("CAST(SUM(CASE WHEN %s > 0 THEN %s ELSE 0 END) AS BIGINT)", amount, amount)), "income").
+				AppendSelectExprAs(sql.Expr(fmt.Sprintf("CAST(SUM(CASE WHEN %s < 0 THEN %s ELSE 0 END) AS BIGINT)", amount, amount)), "expenses").
+				GroupBy(household, category, month)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.TransactionSum, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &domain.TransactionSum{
+			HouseholdID: row.HouseholdID,
+			CategoryID:  row.CategoryID,
+			Month:       row.Month,
+			Income:      domain.MoneyFromInt(row.Income),
+			Expenses:    domain.MoneyFromInt(row.Expenses),
+		})
+	}
+	return result, nil
+}
+
+// monthExpr returns the SQL expression formatting the date column as YYYY-MM.
+// SQLite stores times as text starting with the date, so the prefix is used
+// directly; Postgres formats the timestamp in UTC.
+func monthExpr(d, column string) string {
+	if d == dialect.Postgres {
+		return fmt.Sprintf("to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM')", column)
+	}
+	return fmt.Sprintf("substr(%s, 1, 7)", column)
+}
+
 func (r *TransactionRepository) Update(ctx context.Context, tx *domain.Transaction) (*domain.Transaction, error) {
-	t, err := r.client.Transaction.UpdateOneID(tx.ID).
-		SetAmount(tx.Amount.String()).
+	q := r.client.Transaction.UpdateOneID(tx.ID).
+		Where(enttransaction.DeletedAtIsNil()).
+		SetAmount(domain.MinorUnits(tx.Amount)).
 		SetDescription(tx.Description).
 		SetDetails(tx.Details).
+		SetTaxClass(string(tx.TaxClass)).
 		SetDate(tx.Date).
-		SetCategoryID(tx.CategoryID).
-		Save(ctx)
+		SetCategoryID(tx.CategoryID)
+
+	if tx.Split != nil {
+		q.SetPayerID(tx.Split.PaidBy).
+			SetSplitType(string(tx.Split.Type)).
+			SetSplitShares(splitShares(tx.Split))
+	} else {
+		q.ClearPayer().ClearSplitType().ClearSplitShares()
+	}
+
+	t, err := q.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, fmt.Errorf("%w: transaction %d", domain.ErrNotFound, tx.ID)
@@ -91,11 +260,59 @@ func (r *TransactionRepository) Update(ctx context.Context, tx *domain.Transacti
 	}
 	t.Edges.Household = &ent.Household{ID: tx.HouseholdID}
 	t.Edges.Category = &ent.Category{ID: tx.CategoryID}
+	if tx.Split != nil {
+		t.Edges.Payer = &ent.HouseholdMember{ID: tx.Split.PaidBy}
+	}
 	return transactionToDomain(t), nil
 }
 
+// GetDeletedByID returns a transaction from the trash.
+func (r *TransactionRepository) GetDeletedByID(ctx context.Context, id int) (*domain.Transaction, error) {
+	t, err := r.client.Transaction.Query().
+		Where(enttransaction.ID(id), enttransaction.DeletedAtNotNil()).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: deleted transaction %d", domain.ErrNotFound, id)
+		}
+		return nil, err
+	}
+	return transactionToDomain(t), nil
+}
+
+// ListDeletedByHousehold returns the household's transactions in the trash,
+// most recently deleted first.
+func (r *TransactionRepository) ListDeletedByHousehold(ctx context.Context, householdID int) ([]*domain.Transaction, error) {
+	items, err := r.client.Transaction.Query().
+		Where(
+			enttransaction.HasHouseholdWith(enthousehold.IDEQ(householdID)),
+			enttransaction.DeletedAtNotNil(),
+		).
+		WithHousehold().
+		WithCategory().
+		WithPayer().
+		Order(ent.Desc(enttransaction.FieldDeletedAt), ent.Desc(enttransaction.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Transaction, 0, len(items))
+	for _, t := range items {
+		result = append(result, transactionToDomain(t))
+	}
+	return result, nil
+}
+
+// Delete moves the transaction to the trash.
 func (r *TransactionRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.Transaction.DeleteOneID(id).Exec(ctx)
+	err := r.client.Transaction.UpdateOneID(id).
+		Where(enttransaction.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return fmt.Errorf("%w: transaction %d", domain.ErrNotFound, id)
@@ -104,3 +321,40 @@ func (r *TransactionRepository) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// Restore takes the transaction out of the trash.
+func (r *TransactionRepository) Restore(ctx context.Context, id int) error {
+	err := r.client.Transaction.UpdateOneID(id).
+		Where(enttransaction.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: deleted transaction %d", domain.ErrNotFound, id)
+		}
+		return err
+	}
+	return nil
+}
+
+// PurgeDeletedBefore deletes all transactions moved to the trash before the
+// given time together with their revisions and returns how many there were.
+func (r *TransactionRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	var n int
+	err := withTx(ctx, r.client, func(client *ent.Client) error {
+		ids, err := client.Transaction.Query().
+			Where(enttransaction.DeletedAtLT(before)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if n, err = client.Transaction.Delete().Where(enttransaction.DeletedAtLT(before)).Exec(ctx); err != nil {
+			return err
+		}
+		return deleteRevisions(ctx, client, domain.EntityTransaction, ids)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
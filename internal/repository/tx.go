@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"icekalt.dev/money-tracker/ent"
+)
+
+type txKey struct{}
+
+// Transactor runs service code in one database transaction.
+type Transactor struct {
+	client *ent.Client
+}
+
+func NewTransactor(client *ent.Client) *Transactor {
+	return &Transactor{client: client}
+}
+
+// InTx runs fn in a transaction and commits it if fn succeeds. Repository
+// methods that get their client from clientFor or run withTx use it when
+// called with the context passed to fn. Nested calls join the outer
+// transaction.
+func (t *Transactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return withTx(ctx, t.client, func(client *ent.Client) error {
+		return fn(context.WithValue(ctx, txKey{}, client))
+	})
+}
+
+// clientFor returns the client of the transaction started by InTx, or client
+// outside of one.
+func clientFor(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx, ok := ctx.Value(txKey{}).(*ent.Client); ok {
+		return tx
+	}
+	return client
+}
+
+// withTx runs fn with a client bound to a transaction and commits it if fn
+// succeeds. Inside InTx, fn runs in that transaction instead. The client
+// keeps the hooks, so the revisions and aggregates they write are part of the
+// transaction.
+func withTx(ctx context.Context, client *ent.Client, fn func(client *ent.Client) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*ent.Client); ok {
+		return fn(tx)
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx.Client()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
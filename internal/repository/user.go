@@ -5,7 +5,11 @@ import (
 	"fmt"
 
 	"icekalt.dev/money-tracker/ent"
+	entapitoken "icekalt.dev/money-tracker/ent/apitoken"
+	enttransfer "icekalt.dev/money-tracker/ent/householdtransfer"
+	entcredential "icekalt.dev/money-tracker/ent/localcredential"
 	entuser "icekalt.dev/money-tracker/ent/user"
+	entidentity "icekalt.dev/money-tracker/ent/useridentity"
 	"icekalt.dev/money-tracker/internal/domain"
 )
 
@@ -18,10 +22,12 @@ func NewUserRepository(client *ent.Client) *UserRepository {
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
-	u, err := r.client.User.Create().
+	u, err := clientFor(ctx, r.client).User.Create().
 		SetEmail(user.Email).
 		SetName(user.Name).
 		SetSubject(user.Subject).
+		SetAdmin(user.Admin).
+		SetNillableDisabledAt(user.DisabledAt).
 		Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
@@ -33,7 +39,7 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) (*domain
 }
 
 func (r *UserRepository) GetByID(ctx context.Context, id int) (*domain.User, error) {
-	u, err := r.client.User.Get(ctx, id)
+	u, err := clientFor(ctx, r.client).User.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, fmt.Errorf("%w: user %d", domain.ErrNotFound, id)
@@ -44,7 +50,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (*domain.User, err
 }
 
 func (r *UserRepository) GetBySubject(ctx context.Context, subject string) (*domain.User, error) {
-	u, err := r.client.User.Query().
+	u, err := clientFor(ctx, r.client).User.Query().
 		Where(entuser.SubjectEQ(subject)).
 		Only(ctx)
 	if err != nil {
@@ -56,11 +62,45 @@ func (r *UserRepository) GetBySubject(ctx context.Context, subject string) (*dom
 	return userToDomain(u), nil
 }
 
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	u, err := clientFor(ctx, r.client).User.Query().
+		Where(entuser.EmailEqualFold(email)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: user with email %s", domain.ErrNotFound, email)
+		}
+		return nil, err
+	}
+	return userToDomain(u), nil
+}
+
+func (r *UserRepository) List(ctx context.Context) ([]*domain.User, error) {
+	users, err := clientFor(ctx, r.client).User.Query().
+		Order(ent.Asc(entuser.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.User, len(users))
+	for i, u := range users {
+		result[i] = userToDomain(u)
+	}
+	return result, nil
+}
+
 func (r *UserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
-	u, err := r.client.User.UpdateOneID(user.ID).
+	update := clientFor(ctx, r.client).User.UpdateOneID(user.ID).
 		SetEmail(user.Email).
 		SetName(user.Name).
-		Save(ctx)
+		SetSubject(user.Subject).
+		SetAdmin(user.Admin)
+	if user.DisabledAt != nil {
+		update.SetDisabledAt(*user.DisabledAt)
+	} else {
+		update.ClearDisabledAt()
+	}
+	u, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, fmt.Errorf("%w: user %d", domain.ErrNotFound, user.ID)
@@ -69,3 +109,27 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) (*domain
 	}
 	return userToDomain(u), nil
 }
+
+func (r *UserRepository) Delete(ctx context.Context, id int) error {
+	return withTx(ctx, r.client, func(client *ent.Client) error {
+		if _, err := client.APIToken.Delete().Where(entapitoken.HasUserWith(entuser.IDEQ(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting api tokens: %w", err)
+		}
+		if _, err := client.LocalCredential.Delete().Where(entcredential.HasUserWith(entuser.IDEQ(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting local credential: %w", err)
+		}
+		if _, err := client.UserIdentity.Delete().Where(entidentity.HasUserWith(entuser.IDEQ(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting identities: %w", err)
+		}
+		if _, err := client.HouseholdTransfer.Delete().Where(enttransfer.HasUserWith(entuser.IDEQ(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting household transfer offers: %w", err)
+		}
+		if err := client.User.DeleteOneID(id).Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				return fmt.Errorf("%w: user %d", domain.ErrNotFound, id)
+			}
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/ent"
+	entuser "icekalt.dev/money-tracker/ent/user"
+	entidentity "icekalt.dev/money-tracker/ent/useridentity"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type UserIdentityRepository struct {
+	client *ent.Client
+}
+
+func NewUserIdentityRepository(client *ent.Client) *UserIdentityRepository {
+	return &UserIdentityRepository{client: client}
+}
+
+// Create links the identity to the existing user identity.UserID.
+func (r *UserIdentityRepository) Create(ctx context.Context, identity *domain.UserIdentity) (*domain.UserIdentity, error) {
+	i, err := createIdentity(ctx, r.client.UserIdentity, identity)
+	if err != nil {
+		return nil, err
+	}
+	return userIdentityToDomain(i), nil
+}
+
+// CreateWithUser stores a new user and their identity in one transaction, so
+// a failed link doesn't leave a user without a login behind.
+func (r *UserIdentityRepository) CreateWithUser(ctx context.Context, user *domain.User, identity *domain.UserIdentity) (*domain.User, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := tx.User.Create().
+		SetEmail(user.Email).
+		SetName(user.Name).
+		SetSubject(user.Subject).
+		SetAdmin(user.Admin).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: user already exists", domain.ErrConflict)
+		}
+		return nil, err
+	}
+
+	identity.UserID = u.ID
+	if _, err := createIdentity(ctx, tx.UserIdentity, identity); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return userToDomain(u), nil
+}
+
+// Claim links the identity to the user identity.UserID and replaces the
+// user's subject in one transaction. It turns invited users and users from
+// before identities into OIDC users.
+func (r *UserIdentityRepository) Claim(ctx context.Context, identity *domain.UserIdentity, subject string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.User.UpdateOneID(identity.UserID).SetSubject(subject).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: user %d", domain.ErrNotFound, identity.UserID)
+		}
+		return err
+	}
+	if _, err := createIdentity(ctx, tx.UserIdentity, identity); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func createIdentity(ctx context.Context, client *ent.UserIdentityClient, identity *domain.UserIdentity) (*ent.UserIdentity, error) {
+	i, err := client.Create().
+		SetIssuer(identity.Issuer).
+		SetSubject(identity.Subject).
+		SetEmail(identity.Email).
+		SetUserID(identity.UserID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: identity is already linked", domain.ErrConflict)
+		}
+		return nil, err
+	}
+	return i, nil
+}
+
+func (r *UserIdentityRepository) GetByIssuerSubject(ctx context.Context, issuer, subject string) (*domain.UserIdentity, error) {
+	i, err := r.client.UserIdentity.Query().
+		Where(
+			entidentity.IssuerEQ(issuer),
+			entidentity.SubjectEQ(subject),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: identity %s at %s", domain.ErrNotFound, subject, issuer)
+		}
+		return nil, err
+	}
+	return userIdentityToDomain(i), nil
+}
+
+// ListByUser returns the user's identities, oldest first.
+func (r *UserIdentityRepository) ListByUser(ctx context.Context, userID int) ([]*domain.UserIdentity, error) {
+	items, err := r.client.UserIdentity.Query().
+		Where(entidentity.HasUserWith(entuser.IDEQ(userID))).
+		WithUser().
+		Order(ent.Asc(entidentity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.UserIdentity, len(items))
+	for i, item := range items {
+		result[i] = userIdentityToDomain(item)
+	}
+	return result, nil
+}
+
+func (r *UserIdentityRepository) Delete(ctx context.Context, userID, id int) error {
+	n, err := r.client.UserIdentity.Delete().
+		Where(
+			entidentity.ID(id),
+			entidentity.HasUserWith(entuser.IDEQ(userID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: identity %d", domain.ErrNotFound, id)
+	}
+	return nil
+}
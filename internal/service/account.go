@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// AccountService lets users download everything stored about them, delete
+// their own account and take over households offered to them.
+type AccountService struct {
+	users        domain.UserRepo
+	credentials  domain.LocalCredentialRepo
+	identities   domain.UserIdentityRepo
+	households   domain.HouseholdRepo
+	transfers    domain.HouseholdTransferRepo
+	categories   domain.CategoryRepo
+	members      domain.HouseholdMemberRepo
+	transactions domain.TransactionRepo
+	recurring    domain.RecurringExpenseRepo
+	overrides    domain.RecurringScheduleOverrideRepo
+	settlements  domain.SettlementRepo
+	tokens       domain.APITokenRepo
+	sessions     domain.SessionRepo
+	revisions    domain.RevisionRepo
+	events       *SecurityEventService
+	admin        *AdminService
+	tx           domain.Transactor
+}
+
+func NewAccountService(
+	users domain.UserRepo,
+	credentials domain.LocalCredentialRepo,
+	identities domain.UserIdentityRepo,
+	households domain.HouseholdRepo,
+	transfers domain.HouseholdTransferRepo,
+	categories domain.CategoryRepo,
+	members domain.HouseholdMemberRepo,
+	transactions domain.TransactionRepo,
+	recurring domain.RecurringExpenseRepo,
+	overrides domain.RecurringScheduleOverrideRepo,
+	settlements domain.SettlementRepo,
+	tokens domain.APITokenRepo,
+	sessions domain.SessionRepo,
+	revisions domain.RevisionRepo,
+	events *SecurityEventService,
+	admin *AdminService,
+	tx domain.Transactor,
+) *AccountService {
+	return &AccountService{
+		users:        users,
+		credentials:  credentials,
+		identities:   identities,
+		households:   households,
+		transfers:    transfers,
+		categories:   categories,
+		members:      members,
+		transactions: transactions,
+		recurring:    recurring,
+		overrides:    overrides,
+		settlements:  settlements,
+		tokens:       tokens,
+		sessions:     sessions,
+		revisions:    revisions,
+		events:       events,
+		admin:        admin,
+		tx:           tx,
+	}
+}
+
+// Export collects the current user's profile and identities, the households
+// they own with all their records, the revisions they made, their API
+// tokens, sessions and security events. Session tokens and API token hashes
+// are still set; exports must leave them out.
+func (s *AccountService) Export(ctx context.Context) (*domain.PersonalData, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &domain.PersonalData{User: user}
+	cred, err := s.credentials.GetByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		data.Username = cred.Username
+	}
+
+	households, err := s.households.ListByOwner(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.households.ListDeletedByOwner(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hh := range slices.Concat(households, deleted) {
+		hd, err := s.householdData(ctx, hh)
+		if err != nil {
+			return nil, fmt.Errorf("exporting household %d: %w", hh.ID, err)
+		}
+		data.Households = append(data.Households, hd)
+	}
+
+	if data.Revisions, err = s.revisions.ListByUser(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.Identities, err = s.identities.ListByUser(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.APITokens, err = s.tokens.ListByUser(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.Sessions, err = s.sessions.ListByUser(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	err = s.events.repo.Each(ctx, &user.ID, func(e *domain.SecurityEvent) error {
+		data.SecurityEvents = append(data.SecurityEvents, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.events.Record(ctx, domain.EventDataExported, user.ID, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *AccountService) householdData(ctx context.Context, hh *domain.Household) (*domain.HouseholdData, error) {
+	hd := &domain.HouseholdData{Household: hh}
+	var err error
+	if hd.Categories, err = s.categories.ListByHousehold(ctx, hh.ID); err != nil {
+		return nil, err
+	}
+	if hd.Members, err = s.members.ListByHousehold(ctx, hh.ID); err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.transactions.ListByHousehold(ctx, hh.ID)
+	if err != nil {
+		return nil, err
+	}
+	deletedTransactions, err := s.transactions.ListDeletedByHousehold(ctx, hh.ID)
+	if err != nil {
+		return nil, err
+	}
+	hd.Transactions = slices.Concat(transactions, deletedTransactions)
+
+	recurring, err := s.recurring.ListByHousehold(ctx, hh.ID)
+	if err != nil {
+		return nil, err
+	}
+	deletedRecurring, err := s.recurring.ListDeletedByHousehold(ctx, hh.ID)
+	if err != nil {
+		return nil, err
+	}
+	hd.RecurringExpenses = slices.Concat(recurring, deletedRecurring)
+
+	if len(hd.RecurringExpenses) > 0 {
+		ids := make([]int, len(hd.RecurringExpenses))
+		for i, re := range hd.RecurringExpenses {
+			ids[i] = re.ID
+		}
+		if hd.ScheduleOverrides, err = s.overrides.ListByRecurringExpenses(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	if hd.Settlements, err = s.settlements.ListByHousehold(ctx, hh.ID); err != nil {
+		return nil, err
+	}
+	return hd, nil
+}
+
+// Delete deletes the current user's account. Households they own are
+// offered to another user, deleted with the account or, by default, keep
+// the account from being deleted. Households in the trash are always
+// deleted.
+//
+// Offered households stay with the account until the recipient accepts
+// them, and the account is deleted together with the last accepted one.
+// Delete reports whether the deletion waits for that. Offers are made to an
+// email whether or not it belongs to an account, so the answer doesn't tell
+// who is registered.
+func (s *AccountService) Delete(ctx context.Context, req domain.AccountDeletion) (bool, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(req.Confirm), user.Email) {
+		return false, domain.NewValidationError("confirm", "must be the email of the account")
+	}
+	if req.Households == "" {
+		req.Households = domain.OwnedHouseholdsBlock
+	}
+	if err := domain.ValidateOwnedHouseholds(req.Households); err != nil {
+		return false, err
+	}
+
+	pending := false
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		households, err := s.households.ListByOwner(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if len(households) > 0 {
+			switch req.Households {
+			case domain.OwnedHouseholdsBlock:
+				return fmt.Errorf("%w: the account still owns %d households", domain.ErrConflict, len(households))
+			case domain.OwnedHouseholdsTransfer:
+				pending = true
+				return s.offer(ctx, user, households, req.TransferTo)
+			}
+		}
+
+		return s.admin.deleteUser(ctx, user.ID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return pending, nil
+}
+
+func (s *AccountService) offer(ctx context.Context, user *domain.User, households []*domain.Household, email string) error {
+	email = strings.TrimSpace(email)
+	if err := domain.ValidateEmail(email); err != nil {
+		return domain.NewValidationError("transfer_to", "must be a valid email")
+	}
+	if strings.EqualFold(email, user.Email) {
+		return domain.NewValidationError("transfer_to", "must be another user's email")
+	}
+
+	for _, hh := range households {
+		_, err := s.transfers.Create(ctx, &domain.HouseholdTransfer{
+			HouseholdID: hh.ID,
+			FromUserID:  user.ID,
+			Email:       email,
+		})
+		if err != nil {
+			return fmt.Errorf("offering household %d: %w", hh.ID, err)
+		}
+	}
+	return nil
+}
+
+// Transfers returns the households offered to the current user and those
+// they offered to others.
+func (s *AccountService) Transfers(ctx context.Context) (incoming, outgoing []*domain.HouseholdTransfer, err error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if incoming, err = s.transfers.ListByEmail(ctx, user.Email); err != nil {
+		return nil, nil, err
+	}
+	if outgoing, err = s.transfers.ListByUser(ctx, user.ID); err != nil {
+		return nil, nil, err
+	}
+	return incoming, outgoing, nil
+}
+
+// AcceptTransfer makes the current user the owner of a household offered to
+// them. If it was the last household of a user waiting to delete their
+// account, the account is deleted as well.
+func (s *AccountService) AcceptTransfer(ctx context.Context, id int) error {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.InTx(ctx, func(ctx context.Context) error {
+		transfer, err := s.incomingTransfer(ctx, user, id)
+		if err != nil {
+			return err
+		}
+		if err := s.households.SetOwner(ctx, transfer.HouseholdID, user.ID); err != nil {
+			return fmt.Errorf("transferring household %d: %w", transfer.HouseholdID, err)
+		}
+		if err := s.transfers.Delete(ctx, transfer.ID); err != nil {
+			return err
+		}
+		if err := s.events.Record(ctx, domain.EventHouseholdReceived, user.ID, map[string]string{
+			"household_id":   eventID(transfer.HouseholdID),
+			"household_name": transfer.HouseholdName,
+			"from":           transfer.FromEmail,
+		}); err != nil {
+			return err
+		}
+
+		remaining, err := s.transfers.ListByUser(ctx, transfer.FromUserID)
+		if err != nil {
+			return err
+		}
+		owned, err := s.households.ListByOwner(ctx, transfer.FromUserID)
+		if err != nil {
+			return err
+		}
+		if len(remaining) > 0 || len(owned) > 0 {
+			return s.events.Record(ctx, domain.EventHouseholdHandedOver, transfer.FromUserID, map[string]string{
+				"household_id":   eventID(transfer.HouseholdID),
+				"household_name": transfer.HouseholdName,
+				"to":             user.Email,
+			})
+		}
+		return s.admin.deleteUser(ctx, transfer.FromUserID)
+	})
+}
+
+// DeclineTransfer refuses a household offered to the current user. It stays
+// with its owner, who gets a household_transfer_declined security event.
+func (s *AccountService) DeclineTransfer(ctx context.Context, id int) error {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.InTx(ctx, func(ctx context.Context) error {
+		transfer, err := s.incomingTransfer(ctx, user, id)
+		if err != nil {
+			return err
+		}
+		if err := s.transfers.Delete(ctx, transfer.ID); err != nil {
+			return err
+		}
+		return s.events.Record(ctx, domain.EventHouseholdTransferDeclined, transfer.FromUserID, map[string]string{
+			"household_id":   eventID(transfer.HouseholdID),
+			"household_name": transfer.HouseholdName,
+			"by":             user.Email,
+		})
+	})
+}
+
+// CancelTransfer withdraws an offer the current user made. Their account is
+// no longer deleted once the other households are accepted.
+func (s *AccountService) CancelTransfer(ctx context.Context, id int) error {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	transfer, err := s.transfers.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if transfer.FromUserID != user.ID {
+		return fmt.Errorf("%w: household transfer %d", domain.ErrNotFound, id)
+	}
+	return s.transfers.Delete(ctx, transfer.ID)
+}
+
+// incomingTransfer returns the offer if it was made to the user. Offers to
+// others look like they don't exist.
+func (s *AccountService) incomingTransfer(ctx context.Context, user *domain.User, id int) (*domain.HouseholdTransfer, error) {
+	transfer, err := s.transfers.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(transfer.Email, user.Email) || transfer.FromUserID == user.ID {
+		return nil, fmt.Errorf("%w: household transfer %d", domain.ErrNotFound, id)
+	}
+	return transfer, nil
+}
+
+// currentUser returns the authenticated user. All methods need a session
+// or an unrestricted API token.
+func (s *AccountService) currentUser(ctx context.Context) (*domain.User, error) {
+	if err := requireFullAccess(ctx); err != nil {
+		return nil, err
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+	}
+	return s.users.GetByID(ctx, userID)
+}
@@ -0,0 +1,289 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/ent/revision"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestAccountService(t *testing.T) {
+	svc := setupTestServices(t)
+	bg := context.Background()
+	ctx, user := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	amount, _ := domain.NewMoney("-20.00")
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	other, err := svc.User.GetOrCreate(bg, "other-sub", "other@example.com", "Other User")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	otherCtx := service.WithUserID(bg, other.ID)
+
+	t.Run("export", func(t *testing.T) {
+		kept, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Food", "", "", date, nil)
+		trashed, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Drinks", "", "", date, nil)
+		svc.Transaction.Delete(ctx, hh.ID, trashed.ID)
+		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, date, nil, nil)
+		svc.RecurringExpense.CreateOverride(ctx, re.ID, date.AddDate(0, 1, 0), amount, domain.FrequencyMonthly)
+		svc.Member.Create(ctx, hh.ID, "Anna")
+		if _, _, err := svc.APIToken.Create(ctx, "cli", domain.TokenScope{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		gone := createTestHousehold(t, svc, ctx)
+		svc.Household.Delete(ctx, gone.ID)
+		createTestHousehold(t, svc, otherCtx)
+
+		data, err := svc.Account.Export(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if data.User.ID != user.ID || len(data.APITokens) != 1 {
+			t.Errorf("unexpected profile or tokens: %+v", data)
+		}
+		if len(data.Households) != 2 || data.Households[1].Household.ID != gone.ID {
+			t.Fatalf("expected the household and the one in the trash, got %d", len(data.Households))
+		}
+		hd := data.Households[0]
+		if len(hd.Transactions) != 2 || hd.Transactions[0].ID != kept.ID || hd.Transactions[1].DeletedAt == nil {
+			t.Errorf("expected both transactions, got %+v", hd.Transactions)
+		}
+		if len(hd.Categories) != 1 || len(hd.Members) != 1 || len(hd.RecurringExpenses) != 1 || len(hd.ScheduleOverrides) != 1 {
+			t.Errorf("unexpected household data: %+v", hd)
+		}
+		if len(data.Revisions) == 0 || data.Revisions[0].EntityType != domain.EntityHousehold || data.Revisions[0].EntityID != hh.ID {
+			t.Errorf("expected the revisions of the user, oldest first, got %+v", data.Revisions)
+		}
+		for _, r := range data.Revisions {
+			if r.UserID == nil || *r.UserID != user.ID {
+				t.Errorf("expected only revisions of the user, got %+v", r)
+			}
+		}
+
+		events, _ := svc.Security.List(ctx)
+		if len(events) == 0 || events[0].Type != domain.EventDataExported {
+			t.Errorf("expected the export to be logged, got %+v", events)
+		}
+	})
+
+	t.Run("restricted tokens can't export", func(t *testing.T) {
+		read := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+		if _, err := svc.Account.Export(read); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("deletion needs the email", func(t *testing.T) {
+		_, err := svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: "wrong@example.com", Households: domain.OwnedHouseholdsDelete})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("households block the deletion", func(t *testing.T) {
+		if _, err := svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: user.Email}); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+		if _, err := svc.User.GetByID(bg, user.ID); err != nil {
+			t.Errorf("expected the user to be kept, got %v", err)
+		}
+	})
+
+	t.Run("transfer needs another email", func(t *testing.T) {
+		for _, email := range []string{"", "nobody", user.Email} {
+			_, err := svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: user.Email, Households: domain.OwnedHouseholdsTransfer, TransferTo: email})
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("%q: expected ErrValidation, got %v", email, err)
+			}
+		}
+	})
+
+	t.Run("unknown and registered emails look the same", func(t *testing.T) {
+		for _, email := range []string{"nobody@example.com", other.Email} {
+			pending, err := svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: user.Email, Households: domain.OwnedHouseholdsTransfer, TransferTo: email})
+			if err != nil || !pending {
+				t.Fatalf("%s: expected a pending deletion, got %v, %v", email, pending, err)
+			}
+		}
+
+		if _, err := svc.Household.GetByID(ctx, hh.ID); err != nil {
+			t.Errorf("expected the household to stay with the user, got %v", err)
+		}
+		_, outgoing, err := svc.Account.Transfers(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(outgoing) != 1 || outgoing[0].Email != other.Email || outgoing[0].HouseholdID != hh.ID {
+			t.Errorf("expected the later offer to replace the first, got %+v", outgoing)
+		}
+		if events, _ := svc.Security.List(otherCtx); len(events) != 0 {
+			t.Errorf("expected no event before the offer is accepted, got %+v", events)
+		}
+	})
+
+	t.Run("offers to others can't be taken", func(t *testing.T) {
+		third, _ := svc.User.GetOrCreate(bg, "bystander-sub", "bystander@example.com", "Bystander")
+		thirdCtx := service.WithUserID(bg, third.ID)
+		_, outgoing, _ := svc.Account.Transfers(ctx)
+
+		incoming, _, _ := svc.Account.Transfers(thirdCtx)
+		if len(incoming) != 0 {
+			t.Errorf("expected no offers for a bystander, got %+v", incoming)
+		}
+		for _, taken := range []context.Context{thirdCtx, ctx} {
+			if err := svc.Account.AcceptTransfer(taken, outgoing[0].ID); !errors.Is(err, domain.ErrNotFound) {
+				t.Errorf("expected ErrNotFound, got %v", err)
+			}
+		}
+		if err := svc.Account.CancelTransfer(otherCtx, outgoing[0].ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected only the user who offered to withdraw, got %v", err)
+		}
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		incoming, _, _ := svc.Account.Transfers(otherCtx)
+		if len(incoming) != 1 || incoming[0].FromEmail != user.Email || incoming[0].HouseholdName != hh.Name {
+			t.Fatalf("expected the offer for the recipient, got %+v", incoming)
+		}
+		if err := svc.Account.DeclineTransfer(otherCtx, incoming[0].ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, outgoing, _ := svc.Account.Transfers(ctx); len(outgoing) != 0 {
+			t.Errorf("expected the offer to be gone, got %+v", outgoing)
+		}
+		if _, err := svc.Household.GetByID(ctx, hh.ID); err != nil {
+			t.Errorf("expected the household to stay with the user, got %v", err)
+		}
+		events, _ := svc.Security.List(ctx)
+		if len(events) == 0 || events[0].Type != domain.EventHouseholdTransferDeclined || events[0].Details["by"] != other.Email {
+			t.Errorf("expected the user to be told, got %+v", events)
+		}
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: user.Email, Households: domain.OwnedHouseholdsTransfer, TransferTo: other.Email})
+		_, outgoing, _ := svc.Account.Transfers(ctx)
+		if len(outgoing) != 1 {
+			t.Fatalf("expected one offer, got %+v", outgoing)
+		}
+		if err := svc.Account.CancelTransfer(ctx, outgoing[0].ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if incoming, _, _ := svc.Account.Transfers(otherCtx); len(incoming) != 0 {
+			t.Errorf("expected the offer to be withdrawn, got %+v", incoming)
+		}
+	})
+
+	t.Run("failed acceptance changes nothing", func(t *testing.T) {
+		svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: user.Email, Households: domain.OwnedHouseholdsTransfer, TransferTo: other.Email})
+		incoming, _, _ := svc.Account.Transfers(otherCtx)
+		if len(incoming) != 1 {
+			t.Fatalf("expected one offer, got %+v", incoming)
+		}
+
+		if err := svc.queries.Exec(bg, "CREATE TRIGGER keep_users BEFORE DELETE ON users BEGIN SELECT RAISE(ABORT, 'kept'); END", []any{}, nil); err != nil {
+			t.Fatalf("creating trigger: %v", err)
+		}
+		err := svc.Account.AcceptTransfer(otherCtx, incoming[0].ID)
+		if err := svc.queries.Exec(bg, "DROP TRIGGER keep_users", []any{}, nil); err != nil {
+			t.Fatalf("dropping trigger: %v", err)
+		}
+		if err == nil {
+			t.Fatal("expected the acceptance to fail")
+		}
+
+		if _, err := svc.Household.GetByID(ctx, hh.ID); err != nil {
+			t.Errorf("expected the household to stay with the user, got %v", err)
+		}
+		if kept, _, _ := svc.Account.Transfers(otherCtx); len(kept) != 1 {
+			t.Errorf("expected the offer to be kept, got %+v", kept)
+		}
+		if deleted, _ := svc.Trash.Households(ctx); len(deleted) != 1 {
+			t.Errorf("expected the trash to be kept, got %d", len(deleted))
+		}
+		if events, _ := svc.Security.List(otherCtx); len(events) != 0 {
+			t.Errorf("expected no event for the new owner, got %+v", events)
+		}
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		second := createTestHousehold(t, svc, ctx)
+		pending, err := svc.Account.Delete(ctx, domain.AccountDeletion{Confirm: "Test@Example.com", Households: domain.OwnedHouseholdsTransfer, TransferTo: "Other@Example.com"})
+		if err != nil || !pending {
+			t.Fatalf("expected a pending deletion, got %v, %v", pending, err)
+		}
+		incoming, _, _ := svc.Account.Transfers(otherCtx)
+		if len(incoming) != 2 {
+			t.Fatalf("expected both households to be offered, got %+v", incoming)
+		}
+
+		if err := svc.Account.AcceptTransfer(otherCtx, incoming[1].ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.User.GetByID(bg, user.ID); err != nil {
+			t.Errorf("expected the user to be kept while an offer is open, got %v", err)
+		}
+		if events, _ := svc.Security.List(ctx); len(events) == 0 || events[0].Type != domain.EventHouseholdHandedOver {
+			t.Errorf("expected the user to be told, got %+v", events)
+		}
+
+		if err := svc.Account.AcceptTransfer(otherCtx, incoming[0].ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.User.GetByID(bg, user.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected the user to be deleted, got %v", err)
+		}
+
+		if _, err := svc.Household.GetByID(otherCtx, second.ID); err != nil {
+			t.Errorf("expected the second household to belong to the new owner, got %v", err)
+		}
+		got, err := svc.Household.GetByID(otherCtx, hh.ID)
+		if err != nil {
+			t.Fatalf("expected the household to belong to the new owner, got %v", err)
+		}
+		if listed, _ := svc.Transaction.ListByMonth(otherCtx, got.ID, 2026, time.March, domain.AmountFilter{}); len(listed) != 1 {
+			t.Errorf("expected the transactions to move along, got %d", len(listed))
+		}
+		if deleted, _ := svc.Trash.Households(otherCtx); len(deleted) != 0 {
+			t.Errorf("expected the trash to be deleted with the account, got %d", len(deleted))
+		}
+		events, _ := svc.Security.List(otherCtx)
+		if len(events) == 0 || events[0].Type != domain.EventHouseholdReceived || events[0].Details["from"] != user.Email {
+			t.Errorf("expected the new owner to be told, got %+v", events)
+		}
+
+		revisions, err := svc.Revision.History(otherCtx, hh.ID, domain.EntityHousehold, hh.ID)
+		if err != nil || len(revisions) == 0 {
+			t.Fatalf("expected the history of the household to be kept, got %v", err)
+		}
+		if n := svc.client.Revision.Query().Where(revision.UserID(user.ID)).CountX(bg); n != 0 {
+			t.Errorf("expected the user to be removed from %d revisions", n)
+		}
+	})
+
+	t.Run("delete households", func(t *testing.T) {
+		third, _ := svc.User.GetOrCreate(bg, "third-sub", "third@example.com", "Third User")
+		thirdCtx := service.WithUserID(bg, third.ID)
+		owned := createTestHousehold(t, svc, thirdCtx)
+
+		if _, err := svc.Account.Delete(thirdCtx, domain.AccountDeletion{Confirm: third.Email, Households: domain.OwnedHouseholdsDelete}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := svc.client.Household.Query().CountX(bg); n != 3 {
+			t.Errorf("expected only the other user's households to be left, got %d", n)
+		}
+		if _, err := svc.Household.GetByID(otherCtx, owned.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected the household to be deleted, got %v", err)
+		}
+		if n := svc.client.Revision.Query().Where(revision.HouseholdID(owned.ID)).CountX(bg); n != 0 {
+			t.Errorf("expected the history of the household to be deleted, %d revisions left", n)
+		}
+	})
+}
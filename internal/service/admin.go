@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// AdminService manages the instance. All methods require an instance admin
+// or the operator (see WithOperator); the user management methods are meant
+// for the CLI, Stats and the event log for admins in the web UI.
+type AdminService struct {
+	users       domain.UserRepo
+	credentials domain.LocalCredentialRepo
+	households  domain.HouseholdRepo
+	tokens      domain.APITokenRepo
+	sessions    domain.SessionRepo
+	revisions   domain.RevisionRepo
+	stats       domain.StatsRepo
+	events      *SecurityEventService
+	tx          domain.Transactor
+}
+
+func NewAdminService(users domain.UserRepo, credentials domain.LocalCredentialRepo, households domain.HouseholdRepo, tokens domain.APITokenRepo, sessions domain.SessionRepo, revisions domain.RevisionRepo, stats domain.StatsRepo, events *SecurityEventService, tx domain.Transactor) *AdminService {
+	return &AdminService{
+		users:       users,
+		credentials: credentials,
+		households:  households,
+		tokens:      tokens,
+		sessions:    sessions,
+		revisions:   revisions,
+		stats:       stats,
+		events:      events,
+		tx:          tx,
+	}
+}
+
+// Stats returns an overview of the instance. Only admins may see it.
+func (s *AdminService) Stats(ctx context.Context) (*domain.InstanceStats, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.stats.Stats(ctx)
+}
+
+// Events returns the latest security events of all users, newest first.
+func (s *AdminService) Events(ctx context.Context) ([]*domain.SecurityEvent, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.events.repo.List(ctx, nil, securityEventListLimit)
+}
+
+// EachEvent calls fn for every security event of all users, oldest first.
+func (s *AdminService) EachEvent(ctx context.Context, fn func(*domain.SecurityEvent) error) error {
+	if err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+	return s.events.repo.Each(ctx, nil, fn)
+}
+
+// IsAdmin reports whether the current user is an instance admin.
+func (s *AdminService) IsAdmin(ctx context.Context) bool {
+	return s.requireAdmin(ctx) == nil
+}
+
+func (s *AdminService) requireAdmin(ctx context.Context) error {
+	if isOperator(ctx) {
+		return nil
+	}
+	if err := requireFullAccess(ctx); err != nil {
+		return err
+	}
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return domain.ErrForbidden
+	}
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.Admin || user.Disabled() {
+		return fmt.Errorf("%w: admins only", domain.ErrForbidden)
+	}
+	return nil
+}
+
+func (s *AdminService) ListUsers(ctx context.Context) ([]*domain.User, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.users.List(ctx)
+}
+
+// FindUser looks a user up by ID, email or local username.
+func (s *AdminService) FindUser(ctx context.Context, ref string) (*domain.User, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if id, err := strconv.Atoi(ref); err == nil {
+		return s.users.GetByID(ctx, id)
+	}
+	if strings.Contains(ref, "@") {
+		return s.users.GetByEmail(ctx, ref)
+	}
+	cred, err := s.credentials.GetByUsername(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return s.users.GetByID(ctx, cred.UserID)
+}
+
+// Disable locks the user out. Their sessions and API tokens are revoked, so
+// they are logged out everywhere immediately.
+func (s *AdminService) Disable(ctx context.Context, id int, now time.Time) error {
+	if err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+	return s.tx.InTx(ctx, func(ctx context.Context) error {
+		user, err := s.users.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !user.Disabled() {
+			user.DisabledAt = &now
+			if _, err := s.users.Update(ctx, user); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.sessions.DeleteByUser(ctx, id); err != nil {
+			return fmt.Errorf("revoking sessions: %w", err)
+		}
+		if _, err := s.tokens.DeleteByUser(ctx, id); err != nil {
+			return fmt.Errorf("revoking api tokens: %w", err)
+		}
+		return s.events.Record(ctx, domain.EventUserDisabled, id, nil)
+	})
+}
+
+func (s *AdminService) Enable(ctx context.Context, id int) error {
+	if err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	user.DisabledAt = nil
+	if _, err := s.users.Update(ctx, user); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventUserEnabled, id, nil)
+}
+
+func (s *AdminService) SetAdmin(ctx context.Context, id int, admin bool) error {
+	if err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	user.Admin = admin
+	if _, err := s.users.Update(ctx, user); err != nil {
+		return err
+	}
+	event := domain.EventAdminRevoked
+	if admin {
+		event = domain.EventAdminGranted
+	}
+	return s.events.Record(ctx, event, id, nil)
+}
+
+// RevokeTokens deletes all API tokens of the user.
+func (s *AdminService) RevokeTokens(ctx context.Context, id int) (int, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := s.users.GetByID(ctx, id); err != nil {
+		return 0, err
+	}
+	n, err := s.tokens.DeleteByUser(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.events.Record(ctx, domain.EventTokensRevoked, id, map[string]string{"count": strconv.Itoa(n)}); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Delete removes the user together with all households they own, including
+// those in the trash, and their history. Revisions of other households are
+// kept without the user.
+func (s *AdminService) Delete(ctx context.Context, id int) error {
+	if err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+	return s.tx.InTx(ctx, func(ctx context.Context) error {
+		return s.deleteUser(ctx, id)
+	})
+}
+
+// deleteUser does the work of Delete without authorization and without a
+// transaction of its own, so that AccountService can run it together with a
+// transfer of the user's households.
+func (s *AdminService) deleteUser(ctx context.Context, id int) error {
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	households, err := s.households.ListByOwner(ctx, id)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.households.ListDeletedByOwner(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, hh := range slices.Concat(households, deleted) {
+		if err := s.households.Purge(ctx, hh.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("deleting household %d: %w", hh.ID, err)
+		}
+		if hh.DeletedAt != nil {
+			continue
+		}
+		if err := s.events.Record(ctx, domain.EventHouseholdDeleted, id, map[string]string{
+			"household_id":   eventID(hh.ID),
+			"household_name": hh.Name,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.sessions.DeleteByUser(ctx, id); err != nil {
+		return fmt.Errorf("deleting sessions: %w", err)
+	}
+	if err := s.revisions.ClearUser(ctx, id); err != nil {
+		return fmt.Errorf("removing the user from revisions: %w", err)
+	}
+	if err := s.users.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventUserDeleted, id, map[string]string{"email": user.Email})
+}
@@ -0,0 +1,164 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestAdminService(t *testing.T) {
+	svc := setupTestServices(t)
+	bg := context.Background()
+
+	local, err := svc.User.CreateLocal(bg, "carol", "carol@example.com", "Carol", "correct horse battery")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := service.WithUserID(bg, local.ID)
+	op := service.WithOperator(bg)
+
+	t.Run("find user", func(t *testing.T) {
+		for _, ref := range []string{strconv.Itoa(local.ID), "Carol@example.com", "carol"} {
+			user, err := svc.Admin.FindUser(op, ref)
+			if err != nil {
+				t.Fatalf("FindUser(%q): %v", ref, err)
+			}
+			if user.ID != local.ID {
+				t.Errorf("FindUser(%q) = %d, want %d", ref, user.ID, local.ID)
+			}
+		}
+		if _, err := svc.Admin.FindUser(op, "nobody"); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("user management needs admin", func(t *testing.T) {
+		if err := svc.Admin.SetAdmin(ctx, local.ID, true); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+		if _, err := svc.Admin.ListUsers(bg); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("anonymous: expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("stats need admin", func(t *testing.T) {
+		if _, err := svc.Admin.Stats(ctx); !errors.Is(err, domain.ErrForbidden) {
+			t.Fatalf("expected ErrForbidden, got %v", err)
+		}
+		if err := svc.Admin.SetAdmin(op, local.ID, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.Admin.Stats(service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("restricted token: expected ErrForbidden, got %v", err)
+		}
+
+		createTestHousehold(t, svc, ctx)
+		stats, err := svc.Admin.Stats(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if stats.Users != 1 || stats.Admins != 1 || stats.LocalUsers != 1 || stats.Households != 1 {
+			t.Errorf("unexpected stats: %+v", stats)
+		}
+		if stats.DatabaseBytes <= 0 {
+			t.Errorf("expected database size, got %d", stats.DatabaseBytes)
+		}
+	})
+
+	t.Run("failed disable changes nothing", func(t *testing.T) {
+		if _, _, err := svc.APIToken.Create(ctx, "kept", domain.TokenScope{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		svc.client.Session.Create().
+			SetToken("carol-kept").
+			SetData([]byte{}).
+			SetUserID(local.ID).
+			SetExpiresAt(time.Now().Add(time.Hour)).
+			SaveX(bg)
+
+		if err := svc.queries.Exec(bg, "CREATE TRIGGER keep_tokens BEFORE DELETE ON api_tokens BEGIN SELECT RAISE(ABORT, 'kept'); END", []any{}, nil); err != nil {
+			t.Fatalf("creating trigger: %v", err)
+		}
+		err := svc.Admin.Disable(op, local.ID, time.Now())
+		if err := svc.queries.Exec(bg, "DROP TRIGGER keep_tokens", []any{}, nil); err != nil {
+			t.Fatalf("dropping trigger: %v", err)
+		}
+		if err == nil {
+			t.Fatal("expected disabling to fail")
+		}
+
+		if _, err := svc.User.Authenticate(bg, "carol", "correct horse battery"); err != nil {
+			t.Errorf("expected the user to stay enabled, got %v", err)
+		}
+		if n := svc.client.Session.Query().CountX(bg); n != 1 {
+			t.Errorf("expected the session to be kept, got %d", n)
+		}
+	})
+
+	t.Run("disable revokes access", func(t *testing.T) {
+		if _, _, err := svc.APIToken.Create(ctx, "cli", domain.TokenScope{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		svc.client.Session.Create().
+			SetToken("carol-session").
+			SetData([]byte{}).
+			SetUserID(local.ID).
+			SetExpiresAt(time.Now().Add(time.Hour)).
+			SaveX(bg)
+
+		if err := svc.Admin.Disable(op, local.ID, time.Now()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := svc.client.Session.Query().CountX(bg); n != 0 {
+			t.Errorf("expected sessions to be revoked, %d left", n)
+		}
+		if n := svc.client.APIToken.Query().CountX(bg); n != 0 {
+			t.Errorf("expected tokens to be revoked, %d left", n)
+		}
+		if _, err := svc.User.Authenticate(bg, "carol", "correct horse battery"); !errors.Is(err, domain.ErrUserDisabled) {
+			t.Errorf("expected ErrUserDisabled, got %v", err)
+		}
+		if _, err := svc.Admin.Stats(ctx); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("disabled admin: expected ErrForbidden, got %v", err)
+		}
+
+		if err := svc.Admin.Enable(op, local.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.User.Authenticate(bg, "carol", "correct horse battery"); err != nil {
+			t.Errorf("enabled user can't log in: %v", err)
+		}
+	})
+
+	t.Run("revoke tokens", func(t *testing.T) {
+		for _, name := range []string{"a", "b"} {
+			if _, _, err := svc.APIToken.Create(ctx, name, domain.TokenScope{}, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		n, err := svc.Admin.RevokeTokens(op, local.ID)
+		if err != nil || n != 2 {
+			t.Errorf("RevokeTokens = %d, %v; want 2", n, err)
+		}
+	})
+
+	t.Run("delete removes households", func(t *testing.T) {
+		if err := svc.Admin.Delete(op, local.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.User.GetByID(bg, local.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+		if n := svc.client.Household.Query().CountX(bg); n != 0 {
+			t.Errorf("expected households to be deleted, %d left", n)
+		}
+		if _, err := svc.User.Authenticate(bg, "carol", "correct horse battery"); !errors.Is(err, domain.ErrInvalidCredentials) {
+			t.Errorf("expected ErrInvalidCredentials, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,345 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	enthousehold "icekalt.dev/money-tracker/ent/household"
+	entaggregate "icekalt.dev/money-tracker/ent/monthlyaggregate"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/repository"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+// storedMonths returns the months with a stored aggregate for the household.
+func storedMonths(t *testing.T, svc *testServices, householdID int) []string {
+	t.Helper()
+	items, err := svc.client.MonthlyAggregate.Query().
+		Where(entaggregate.HasHouseholdWith(enthousehold.IDEQ(householdID))).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to query aggregates: %v", err)
+	}
+	months := make([]string, len(items))
+	for i, a := range items {
+		months[i] = a.Month
+	}
+	sort.Strings(months)
+	return months
+}
+
+func equalMonths(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMonthlyAggregates(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+
+	groceries, _ := domain.NewMoney("-60")
+	tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "Groceries", "", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), nil)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	all := []string{"2026-01", "2026-02", "2026-03"}
+	rangeTotal := func() domain.Money {
+		t.Helper()
+		summary, err := svc.Summary.GetRangeSummary(ctx, hh.ID, from, to)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return summary.Total
+	}
+	expectTotal := func(want string) {
+		t.Helper()
+		w, _ := domain.NewMoney(want)
+		if got := rangeTotal(); !got.Equal(w) {
+			t.Errorf("Total = %s, want %s", got, want)
+		}
+	}
+
+	t.Run("stored on first read and reused", func(t *testing.T) {
+		expectTotal("-60")
+		if got := storedMonths(t, svc, hh.ID); !equalMonths(got, all) {
+			t.Fatalf("stored months = %v, want %v", got, all)
+		}
+
+		warm := countQueries(svc, func() { rangeTotal() })
+		if _, err := svc.client.MonthlyAggregate.Delete().Exec(ctx); err != nil {
+			t.Fatalf("failed to delete aggregates: %v", err)
+		}
+		recompute := countQueries(svc, func() { rangeTotal() })
+		if warm >= recompute {
+			t.Errorf("queries with stored aggregates = %d, without = %d, want fewer", warm, recompute)
+		}
+	})
+
+	t.Run("transaction invalidates its months", func(t *testing.T) {
+		expectTotal("-60")
+		svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, groceries, "Groceries", "", "", time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), nil)
+		if got, want := storedMonths(t, svc, hh.ID), []string{"2026-03"}; !equalMonths(got, want) {
+			t.Errorf("stored months after moving Jan -> Feb = %v, want %v", got, want)
+		}
+		expectTotal("-60")
+
+		svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "More", "", "", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), nil)
+		if got, want := storedMonths(t, svc, hh.ID), []string{"2026-01", "2026-02"}; !equalMonths(got, want) {
+			t.Errorf("stored months after create in Mar = %v, want %v", got, want)
+		}
+		expectTotal("-120")
+
+		svc.Transaction.Delete(ctx, hh.ID, tx.ID)
+		if got, want := storedMonths(t, svc, hh.ID), []string{"2026-01", "2026-03"}; !equalMonths(got, want) {
+			t.Errorf("stored months after delete in Feb = %v, want %v", got, want)
+		}
+		expectTotal("-60")
+	})
+
+	t.Run("recurring expense invalidates household", func(t *testing.T) {
+		rent, _ := domain.NewMoney("-500")
+		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", rent, domain.FrequencyMonthly, from, nil, nil)
+		if got := storedMonths(t, svc, hh.ID); len(got) != 0 {
+			t.Errorf("stored months after recurring create = %v, want none", got)
+		}
+		expectTotal("-1560")
+
+		raised, _ := domain.NewMoney("-600")
+		svc.RecurringExpense.CreateOverride(ctx, re.ID, to, raised, domain.FrequencyMonthly)
+		if got := storedMonths(t, svc, hh.ID); len(got) != 0 {
+			t.Errorf("stored months after override create = %v, want none", got)
+		}
+		expectTotal("-1660")
+
+		svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", rent, domain.FrequencyMonthly, false, from, nil, nil)
+		if got := storedMonths(t, svc, hh.ID); len(got) != 0 {
+			t.Errorf("stored months after recurring update = %v, want none", got)
+		}
+		expectTotal("-60")
+	})
+
+	t.Run("category rename invalidates household", func(t *testing.T) {
+		expectTotal("-60")
+		svc.Category.Update(ctx, cat.ID, "Food", "", "")
+		if got := storedMonths(t, svc, hh.ID); len(got) != 0 {
+			t.Errorf("stored months after category update = %v, want none", got)
+		}
+		summary, _ := svc.Summary.GetRangeSummary(ctx, hh.ID, from, to)
+		if len(summary.Categories) != 1 || summary.Categories[0].CategoryName != "Food" {
+			t.Errorf("Categories = %+v, want Food", summary.Categories)
+		}
+	})
+
+	t.Run("other households untouched", func(t *testing.T) {
+		other := createTestHousehold(t, svc, ctx)
+		otherCat := createTestCategory(t, svc, ctx, other.ID)
+		expectTotal("-60")
+		svc.Transaction.Create(ctx, other.ID, otherCat.ID, groceries, "Elsewhere", "", "", from, nil)
+		if got := storedMonths(t, svc, hh.ID); !equalMonths(got, all) {
+			t.Errorf("stored months = %v, want %v", got, all)
+		}
+	})
+
+	t.Run("household purge removes aggregates", func(t *testing.T) {
+		gone := createTestHousehold(t, svc, ctx)
+		goneCat := createTestCategory(t, svc, ctx, gone.ID)
+		svc.Transaction.Create(ctx, gone.ID, goneCat.ID, groceries, "Groceries", "", "", from, nil)
+		if _, err := svc.Summary.GetRangeSummary(ctx, gone.ID, from, to); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.Household.Delete(ctx, gone.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.Trash.Purge(ctx, time.Now().AddDate(1, 0, 0)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := storedMonths(t, svc, gone.ID); len(got) != 0 {
+			t.Errorf("stored months after household purge = %v, want none", got)
+		}
+	})
+}
+
+func TestMonthlySummaryFromAggregates(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+
+	salary, _ := domain.NewMoney("3000")
+	rent, _ := domain.NewMoney("-900")
+	groceries, _ := domain.NewMoney("-60")
+	refund, _ := domain.NewMoney("25")
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Salary", "", "", salary, domain.FrequencyMonthly, start, nil, nil)
+	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", rent, domain.FrequencyMonthly, start, nil, nil)
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "Groceries", "", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, refund, "Refund", "", "", time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), nil)
+
+	summarize := func() *domain.MonthlySummary {
+		t.Helper()
+		summary, err := svc.Summary.GetMonthlySummary(ctx, hh.ID, 2026, time.January)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return summary
+	}
+
+	var cold *domain.MonthlySummary
+	recompute := countQueries(svc, func() { cold = summarize() })
+	if got := storedMonths(t, svc, hh.ID); !equalMonths(got, []string{"2026-01"}) {
+		t.Fatalf("stored months = %v, want 2026-01", got)
+	}
+	var warm *domain.MonthlySummary
+	stored := countQueries(svc, func() { warm = summarize() })
+	if stored >= recompute {
+		t.Errorf("queries with the stored month = %d, without = %d, want fewer", stored, recompute)
+	}
+
+	for _, s := range []*domain.MonthlySummary{cold, warm} {
+		for _, c := range []struct {
+			name string
+			got  domain.Money
+			want string
+		}{
+			{"TotalIncome", s.TotalIncome, "25"},
+			{"TotalExpenses", s.TotalExpenses, "-60"},
+			{"OneTimeTotal", s.OneTimeTotal, "-35"},
+			{"RecurringIncome", s.RecurringIncome, "3000"},
+			{"RecurringExpenses", s.RecurringExpenses, "-900"},
+			{"GrossIncome", s.GrossIncome, "3025"},
+			{"GrossExpenses", s.GrossExpenses, "-960"},
+			{"MonthlyTotal", s.MonthlyTotal, "2065"},
+		} {
+			if want, _ := domain.NewMoney(c.want); !c.got.Equal(want) {
+				t.Errorf("%s = %s, want %s", c.name, c.got, c.want)
+			}
+		}
+		if len(s.CategoryBreakdown) != 1 || len(s.IncomeRecurringEntries) != 1 || len(s.ExpenseRecurringEntries) != 1 {
+			t.Errorf("unexpected details %+v", s)
+		}
+	}
+}
+
+// failingOverrides fails to list the overrides of recurring expenses.
+type failingOverrides struct {
+	domain.RecurringScheduleOverrideRepo
+}
+
+func (failingOverrides) ListByRecurringExpenses(context.Context, []int) ([]*domain.RecurringScheduleOverride, error) {
+	return nil, errors.New("connection reset")
+}
+
+func TestAggregatesNotStoredWithoutOverrides(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	addRecurringWithOverride(t, svc, ctx, hh.ID, cat.ID, 1)
+
+	summary := service.NewSummaryService(
+		repository.NewTransactionRepository(svc.client),
+		repository.NewRecurringExpenseRepository(svc.client),
+		failingOverrides{repository.NewRecurringScheduleOverrideRepository(svc.client)},
+		repository.NewCategoryRepository(svc.client),
+		repository.NewMonthlyAggregateRepository(svc.client),
+		svc.Household,
+	)
+
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := summary.GetRangeSummary(ctx, hh.ID, from, to); err == nil {
+		t.Error("expected the range summary to fail")
+	}
+	base, _ := domain.ParsePeriod("2025-05")
+	current, _ := domain.ParsePeriod("2025-08")
+	if _, err := summary.ComparePeriods(ctx, hh.ID, base, current); err == nil {
+		t.Error("expected the comparison to fail")
+	}
+	if _, err := summary.GetMonthlySummary(ctx, hh.ID, 2025, time.August); err == nil {
+		t.Error("expected the monthly summary to fail")
+	}
+	if got := storedMonths(t, svc, hh.ID); len(got) != 0 {
+		t.Errorf("stored months = %v, want none", got)
+	}
+}
+
+func TestRebuildAggregates(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	empty := createTestHousehold(t, svc, ctx)
+
+	rent, _ := domain.NewMoney("-500")
+	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", rent, domain.FrequencyMonthly, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), nil, nil)
+	groceries, _ := domain.NewMoney("-60")
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "Groceries", "", "", time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC), nil)
+
+	stored, err := svc.Summary.RebuildAggregates(context.Background(), []int{hh.ID, empty.ID}, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"2025-11", "2025-12", "2026-01", "2026-02", "2026-03", "2026-04"}
+	if stored != len(want) {
+		t.Errorf("stored = %d, want %d", stored, len(want))
+	}
+	if got := storedMonths(t, svc, hh.ID); !equalMonths(got, want) {
+		t.Errorf("stored months = %v, want %v", got, want)
+	}
+	if got := storedMonths(t, svc, empty.ID); len(got) != 0 {
+		t.Errorf("stored months of empty household = %v, want none", got)
+	}
+
+	// Rebuilt aggregates are read back unchanged
+	summary, err := svc.Summary.GetRangeSummary(ctx, hh.ID, time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantTotal, _ := domain.NewMoney("-3060")
+	if !summary.Total.Equal(wantTotal) {
+		t.Errorf("Total = %s, want %s", summary.Total, wantTotal)
+	}
+}
+
+func TestMonthlyAggregatesInvalidatedWhileComputing(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	repo := repository.NewMonthlyAggregateRepository(svc.client)
+
+	// A computation reads the generation, then a write lands before it stores
+	// what it computed from the old data
+	generation, err := repo.Generation(ctx, hh.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stale := &domain.MonthlyAggregate{HouseholdID: hh.ID, Month: "2026-01"}
+	groceries, _ := domain.NewMoney("-60")
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "Groceries", "", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), nil)
+
+	ok, err := repo.Save(ctx, hh.ID, generation, []*domain.MonthlyAggregate{stale})
+	if err != nil || ok {
+		t.Fatalf("expected the stale aggregate to be dropped, got %v, %v", ok, err)
+	}
+	if got := storedMonths(t, svc, hh.ID); len(got) != 0 {
+		t.Errorf("stored months = %v, want none", got)
+	}
+
+	generation, _ = repo.Generation(ctx, hh.ID)
+	if ok, err := repo.Save(ctx, hh.ID, generation, []*domain.MonthlyAggregate{stale}); err != nil || !ok {
+		t.Errorf("expected the aggregate to be stored, got %v, %v", ok, err)
+	}
+}
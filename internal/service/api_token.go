@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,37 +13,138 @@ import (
 )
 
 type APITokenService struct {
-	repo domain.APITokenRepo
+	repo      domain.APITokenRepo
+	household *HouseholdService
+	events    *SecurityEventService
 }
 
-func NewAPITokenService(repo domain.APITokenRepo) *APITokenService {
-	return &APITokenService{repo: repo}
+func NewAPITokenService(repo domain.APITokenRepo, household *HouseholdService, events *SecurityEventService) *APITokenService {
+	return &APITokenService{repo: repo, household: household, events: events}
 }
 
 // Create generates a new API token and returns the plaintext token.
-// The plaintext is only available at creation time.
-func (s *APITokenService) Create(ctx context.Context, name string) (plaintext string, token *domain.APIToken, err error) {
+// The plaintext is only available at creation time. An empty access level
+// defaults to write; household IDs limit the token to these households and
+// must belong to the user. A nil expiresAt creates a token that never expires.
+func (s *APITokenService) Create(ctx context.Context, name string, scope domain.TokenScope, expiresAt *time.Time) (plaintext string, token *domain.APIToken, err error) {
 	userID, ok := UserIDFromContext(ctx)
 	if !ok {
 		return "", nil, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
 	}
+	if err := requireFullAccess(ctx); err != nil {
+		return "", nil, err
+	}
 
-	plain, err := generateToken()
+	if scope.Access == "" {
+		scope.Access = domain.TokenAccessWrite
+	}
+	if err := domain.ValidateTokenScope(scope); err != nil {
+		return "", nil, err
+	}
+	if err := domain.ValidateTokenExpiry(expiresAt, time.Now()); err != nil {
+		return "", nil, err
+	}
+	for _, id := range scope.HouseholdIDs {
+		if _, err := s.household.GetByID(ctx, id); err != nil {
+			if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrForbidden) {
+				return "", nil, domain.NewValidationError("household_ids", fmt.Sprintf("household %d not found", id))
+			}
+			return "", nil, err
+		}
+	}
+
+	plain, tok, err := s.create(ctx, &domain.APIToken{
+		UserID:    userID,
+		Name:      name,
+		Scope:     scope,
+		ExpiresAt: expiresAt,
+	})
 	if err != nil {
-		return "", nil, fmt.Errorf("generating token: %w", err)
+		return "", nil, err
+	}
+	if err := s.events.Record(ctx, domain.EventTokenCreated, userID, tokenDetails(tok)); err != nil {
+		return "", nil, err
+	}
+	return plain, tok, nil
+}
+
+// Rotate replaces a token with a new one of the same name, scope and
+// lifetime and returns the new plaintext. The old token stays valid for
+// domain.TokenRotationGrace so clients can switch over. A token can only be
+// rotated once; rotating it again returns domain.ErrConflict.
+func (s *APITokenService) Rotate(ctx context.Context, id int) (plaintext string, token *domain.APIToken, err error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", nil, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+	}
+	if err := requireFullAccess(ctx); err != nil {
+		return "", nil, err
 	}
 
-	hash := hashToken(plain)
+	old, err := s.getOwned(ctx, userID, id)
+	if err != nil {
+		return "", nil, err
+	}
+	now := time.Now()
+	if old.Expired(now) {
+		return "", nil, fmt.Errorf("%w: api token expired", domain.ErrForbidden)
+	}
+	if old.RotatedAt != nil {
+		return "", nil, fmt.Errorf("%w: api token was already rotated", domain.ErrConflict)
+	}
 
-	tok, err := s.repo.Create(ctx, &domain.APIToken{
+	var expiresAt *time.Time
+	if old.ExpiresAt != nil {
+		t := now.Add(old.ExpiresAt.Sub(old.CreatedAt))
+		expiresAt = &t
+	}
+	plain, tok, err := s.create(ctx, &domain.APIToken{
 		UserID:    userID,
-		Name:      name,
-		TokenHash: hash,
+		Name:      old.Name,
+		Scope:     old.Scope,
+		ExpiresAt: expiresAt,
 	})
 	if err != nil {
 		return "", nil, err
 	}
 
+	var graceEnd *time.Time
+	if grace := now.Add(domain.TokenRotationGrace); old.ExpiresAt == nil || old.ExpiresAt.After(grace) {
+		graceEnd = &grace
+	}
+	rotated, err := s.repo.MarkRotated(ctx, old.ID, now, graceEnd)
+	if err == nil && !rotated {
+		err = fmt.Errorf("%w: api token was already rotated", domain.ErrConflict)
+	}
+	if err != nil {
+		// The old token wasn't marked, usually because another request
+		// rotated it first, so the replacement must not stay valid.
+		if delErr := s.repo.Delete(ctx, tok.ID); delErr != nil {
+			return "", nil, errors.Join(err, delErr)
+		}
+		return "", nil, err
+	}
+
+	details := tokenDetails(tok)
+	details["replaced_token_id"] = eventID(old.ID)
+	if err := s.events.Record(ctx, domain.EventTokenRotated, userID, details); err != nil {
+		return "", nil, err
+	}
+	return plain, tok, nil
+}
+
+func (s *APITokenService) create(ctx context.Context, token *domain.APIToken) (string, *domain.APIToken, error) {
+	plain, err := generateToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("generating token: %w", err)
+	}
+	token.TokenHash = hashToken(plain)
+
+	tok, err := s.repo.Create(ctx, token)
+	if err != nil {
+		return "", nil, err
+	}
+
 	return plain, tok, nil
 }
 
@@ -60,38 +162,71 @@ func (s *APITokenService) Delete(ctx context.Context, id int) error {
 	if !ok {
 		return fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
 	}
+	if err := requireFullAccess(ctx); err != nil {
+		return err
+	}
 
-	tokens, err := s.repo.ListByUser(ctx, userID)
+	tok, err := s.getOwned(ctx, userID, id)
 	if err != nil {
 		return err
 	}
 
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventTokenDeleted, userID, tokenDetails(tok))
+}
+
+// getOwned returns the token if it belongs to the user.
+func (s *APITokenService) getOwned(ctx context.Context, userID, id int) (*domain.APIToken, error) {
+	tokens, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, t := range tokens {
 		if t.ID == id {
-			return s.repo.Delete(ctx, id)
+			return t, nil
 		}
 	}
 
-	return fmt.Errorf("%w: api token %d", domain.ErrNotFound, id)
+	return nil, fmt.Errorf("%w: api token %d", domain.ErrNotFound, id)
 }
 
+// ValidateToken returns the token for plaintext if it exists and hasn't
+// expired. Rejected tokens and the first use of a token from a new address
+// are recorded as security events.
 func (s *APITokenService) ValidateToken(ctx context.Context, plaintext string) (*domain.APIToken, error) {
 	hash := hashToken(plaintext)
 	token, err := s.repo.GetByHash(ctx, hash)
 	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			_ = s.events.Record(ctx, domain.EventTokenRejected, 0, map[string]string{"reason": "unknown"})
+		}
 		return nil, err
 	}
 
-	if token.ExpiresAt != nil && token.ExpiresAt.Before(time.Now()) {
+	if token.Expired(time.Now()) {
+		details := tokenDetails(token)
+		details["reason"] = "expired"
+		_ = s.events.Record(ctx, domain.EventTokenRejected, token.UserID, details)
 		return nil, fmt.Errorf("%w: api token expired", domain.ErrForbidden)
 	}
 
-	// Best-effort update of last used timestamp
-	_ = s.repo.UpdateLastUsed(ctx, token.ID, time.Now())
+	// Best-effort, a failed write must not reject a valid token
+	ip := ClientFromContext(ctx).IP
+	if ip != token.LastUsedIP {
+		_ = s.events.Record(ctx, domain.EventTokenUsed, token.UserID, tokenDetails(token))
+	}
+	_ = s.repo.UpdateLastUsed(ctx, token.ID, time.Now(), ip)
 
 	return token, nil
 }
 
+func tokenDetails(t *domain.APIToken) map[string]string {
+	return map[string]string{"token_id": eventID(t.ID), "token_name": t.Name}
+}
+
 func generateToken() (string, error) {
 	b := make([]byte, 32)
 	if _, err := rand.Read(b); err != nil {
@@ -15,7 +15,7 @@ func TestAPITokenCreate(t *testing.T) {
 	ctx, _ := createTestUser(t, svc)
 
 	t.Run("success", func(t *testing.T) {
-		plaintext, token, err := svc.APIToken.Create(ctx, "My Token")
+		plaintext, token, err := svc.APIToken.Create(ctx, "My Token", domain.TokenScope{}, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -34,7 +34,7 @@ func TestAPITokenCreate(t *testing.T) {
 	})
 
 	t.Run("no auth", func(t *testing.T) {
-		_, _, err := svc.APIToken.Create(t.Context(), "Test")
+		_, _, err := svc.APIToken.Create(t.Context(), "Test", domain.TokenScope{}, nil)
 		if !errors.Is(err, domain.ErrForbidden) {
 			t.Errorf("expected ErrForbidden, got %v", err)
 		}
@@ -45,7 +45,7 @@ func TestAPITokenValidate(t *testing.T) {
 	svc := setupTestServices(t)
 	ctx, _ := createTestUser(t, svc)
 
-	plaintext, _, _ := svc.APIToken.Create(ctx, "Validate Token")
+	plaintext, _, _ := svc.APIToken.Create(ctx, "Validate Token", domain.TokenScope{}, nil)
 
 	t.Run("valid token", func(t *testing.T) {
 		token, err := svc.APIToken.ValidateToken(ctx, plaintext)
@@ -69,8 +69,8 @@ func TestAPITokenList(t *testing.T) {
 	svc := setupTestServices(t)
 	ctx, _ := createTestUser(t, svc)
 
-	svc.APIToken.Create(ctx, "Token 1")
-	svc.APIToken.Create(ctx, "Token 2")
+	svc.APIToken.Create(ctx, "Token 1", domain.TokenScope{}, nil)
+	svc.APIToken.Create(ctx, "Token 2", domain.TokenScope{}, nil)
 
 	list, err := svc.APIToken.List(ctx)
 	if err != nil {
@@ -85,7 +85,7 @@ func TestAPITokenValidateExpired(t *testing.T) {
 	svc := setupTestServices(t)
 	ctx, _ := createTestUser(t, svc)
 
-	plaintext, token, _ := svc.APIToken.Create(ctx, "Expiring Token")
+	plaintext, token, _ := svc.APIToken.Create(ctx, "Expiring Token", domain.TokenScope{}, nil)
 
 	t.Run("expired token rejected", func(t *testing.T) {
 		// Set ExpiresAt to the past via ent client
@@ -113,7 +113,7 @@ func TestAPITokenValidateExpired(t *testing.T) {
 	})
 
 	t.Run("token without expiry accepted", func(t *testing.T) {
-		plain2, _, _ := svc.APIToken.Create(ctx, "No Expiry Token")
+		plain2, _, _ := svc.APIToken.Create(ctx, "No Expiry Token", domain.TokenScope{}, nil)
 		validatedToken, err := svc.APIToken.ValidateToken(ctx, plain2)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -128,7 +128,7 @@ func TestAPITokenDelete(t *testing.T) {
 	svc := setupTestServices(t)
 	ctx, _ := createTestUser(t, svc)
 
-	_, token, _ := svc.APIToken.Create(ctx, "To Delete")
+	_, token, _ := svc.APIToken.Create(ctx, "To Delete", domain.TokenScope{}, nil)
 
 	t.Run("success", func(t *testing.T) {
 		if err := svc.APIToken.Delete(ctx, token.ID); err != nil {
@@ -142,7 +142,7 @@ func TestAPITokenDelete(t *testing.T) {
 	})
 
 	t.Run("other user cannot delete", func(t *testing.T) {
-		_, token2, _ := svc.APIToken.Create(ctx, "User1 Token")
+		_, token2, _ := svc.APIToken.Create(ctx, "User1 Token", domain.TokenScope{}, nil)
 
 		user2, err := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
 		if err != nil {
@@ -175,3 +175,230 @@ func TestAPITokenDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestAPITokenCreateScope(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+
+	t.Run("defaults to write", func(t *testing.T) {
+		_, token, err := svc.APIToken.Create(ctx, "Full", domain.TokenScope{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token.Scope.Access != domain.TokenAccessWrite {
+			t.Errorf("Access = %q, want %q", token.Scope.Access, domain.TokenAccessWrite)
+		}
+	})
+
+	t.Run("read-only for one household", func(t *testing.T) {
+		plaintext, _, err := svc.APIToken.Create(ctx, "Limited", domain.TokenScope{Access: domain.TokenAccessRead, HouseholdIDs: []int{hh.ID}}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		token, err := svc.APIToken.ValidateToken(ctx, plaintext)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token.Scope.Access != domain.TokenAccessRead {
+			t.Errorf("Access = %q, want %q", token.Scope.Access, domain.TokenAccessRead)
+		}
+		if len(token.Scope.HouseholdIDs) != 1 || token.Scope.HouseholdIDs[0] != hh.ID {
+			t.Errorf("HouseholdIDs = %v, want [%d]", token.Scope.HouseholdIDs, hh.ID)
+		}
+	})
+
+	t.Run("invalid access", func(t *testing.T) {
+		_, _, err := svc.APIToken.Create(ctx, "Bad", domain.TokenScope{Access: "admin"}, nil)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("foreign household", func(t *testing.T) {
+		user2, err := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
+		if err != nil {
+			t.Fatalf("failed to create user 2: %v", err)
+		}
+		ctx2 := service.WithUserID(t.Context(), user2.ID)
+
+		_, _, err = svc.APIToken.Create(ctx2, "Foreign", domain.TokenScope{HouseholdIDs: []int{hh.ID}}, nil)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("restricted token cannot manage tokens", func(t *testing.T) {
+		_, token, _ := svc.APIToken.Create(ctx, "Victim", domain.TokenScope{}, nil)
+		scoped := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+
+		_, _, err := svc.APIToken.Create(scoped, "Escalate", domain.TokenScope{}, nil)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Create: expected ErrForbidden, got %v", err)
+		}
+		if err := svc.APIToken.Delete(scoped, token.ID); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Delete: expected ErrForbidden, got %v", err)
+		}
+	})
+}
+
+func TestTokenScopeEnforcement(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh1 := createTestHousehold(t, svc, ctx)
+	hh2 := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh1.ID)
+
+	t.Run("read-only", func(t *testing.T) {
+		readCtx := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+
+		if _, err := svc.Category.List(readCtx, hh1.ID); err != nil {
+			t.Errorf("List: unexpected error: %v", err)
+		}
+		if _, err := svc.Category.Create(readCtx, hh1.ID, "Nope", "", ""); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Category.Create: expected ErrForbidden, got %v", err)
+		}
+		amount, _ := domain.NewMoney("-1")
+		if _, err := svc.Transaction.Create(readCtx, hh1.ID, cat.ID, amount, "test", "", "", time.Now(), nil); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Transaction.Create: expected ErrForbidden, got %v", err)
+		}
+		if err := svc.Household.Delete(readCtx, hh1.ID); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Household.Delete: expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("household allow-list", func(t *testing.T) {
+		limitedCtx := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessWrite, HouseholdIDs: []int{hh1.ID}})
+
+		list, err := svc.Household.List(limitedCtx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(list) != 1 || list[0].ID != hh1.ID {
+			t.Errorf("expected only household %d, got %v", hh1.ID, list)
+		}
+		if _, err := svc.Household.GetByID(limitedCtx, hh2.ID); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("GetByID: expected ErrForbidden, got %v", err)
+		}
+		if _, err := svc.Category.Create(limitedCtx, hh1.ID, "Allowed", "", ""); err != nil {
+			t.Errorf("Category.Create: unexpected error: %v", err)
+		}
+		if _, err := svc.Household.Create(limitedCtx, "New", "", "EUR", ""); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Household.Create: expected ErrForbidden, got %v", err)
+		}
+	})
+}
+
+func TestAPITokenCreateExpiry(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+
+	t.Run("future expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(30 * 24 * time.Hour)
+		_, token, err := svc.APIToken.Create(ctx, "Expiring", domain.TokenScope{}, &expiresAt)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token.ExpiresAt == nil || !token.ExpiresAt.Equal(expiresAt) {
+			t.Errorf("ExpiresAt = %v, want %v", token.ExpiresAt, expiresAt)
+		}
+	})
+
+	t.Run("past expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+		_, _, err := svc.APIToken.Create(ctx, "Expired", domain.TokenScope{}, &expiresAt)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
+
+func TestAPITokenRotate(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+
+	t.Run("success", func(t *testing.T) {
+		expiresAt := time.Now().Add(30 * 24 * time.Hour)
+		scope := domain.TokenScope{Access: domain.TokenAccessRead, HouseholdIDs: []int{hh.ID}}
+		oldPlain, old, _ := svc.APIToken.Create(ctx, "Dashboard", scope, &expiresAt)
+
+		newPlain, rotated, err := svc.APIToken.Rotate(ctx, old.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if newPlain == oldPlain || rotated.ID == old.ID {
+			t.Error("expected a new token")
+		}
+		if rotated.Name != "Dashboard" || rotated.Scope.Access != domain.TokenAccessRead || len(rotated.Scope.HouseholdIDs) != 1 {
+			t.Errorf("expected name and scope to be kept, got %+v", rotated)
+		}
+		if rotated.ExpiresAt == nil || rotated.ExpiresAt.Sub(*old.ExpiresAt) < 0 {
+			t.Errorf("expected the lifetime to be kept, got %v", rotated.ExpiresAt)
+		}
+
+		// The old token is still valid, but only for the grace period
+		validated, err := svc.APIToken.ValidateToken(ctx, oldPlain)
+		if err != nil {
+			t.Fatalf("old token should be valid during grace period: %v", err)
+		}
+		if validated.ExpiresAt == nil || validated.ExpiresAt.After(time.Now().Add(domain.TokenRotationGrace)) {
+			t.Errorf("expected old token to expire within the grace period, got %v", validated.ExpiresAt)
+		}
+		if _, err := svc.APIToken.ValidateToken(ctx, newPlain); err != nil {
+			t.Errorf("new token should be valid: %v", err)
+		}
+		if validated.RotatedAt == nil {
+			t.Error("expected the old token to be marked as rotated")
+		}
+
+		// Rotating the old token again must not issue another replacement or
+		// move its expiry.
+		tokens, _ := svc.APIToken.List(ctx)
+		if _, _, err := svc.APIToken.Rotate(ctx, old.ID); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected ErrConflict rotating a rotated token, got %v", err)
+		}
+		after, _ := svc.APIToken.List(ctx)
+		if len(after) != len(tokens) {
+			t.Errorf("expected %d tokens after the rejected rotation, got %d", len(tokens), len(after))
+		}
+		again, err := svc.APIToken.ValidateToken(ctx, oldPlain)
+		if err != nil || !again.ExpiresAt.Equal(*validated.ExpiresAt) {
+			t.Errorf("expected the grace period to stay unchanged, got %v, %v", again, err)
+		}
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		_, token, _ := svc.APIToken.Create(ctx, "Old", domain.TokenScope{}, nil)
+		svc.client.APIToken.UpdateOneID(token.ID).SetExpiresAt(time.Now().Add(-time.Hour)).SaveX(ctx)
+
+		_, _, err := svc.APIToken.Rotate(ctx, token.ID)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		_, token, _ := svc.APIToken.Create(ctx, "Mine", domain.TokenScope{}, nil)
+		user2, err := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
+		if err != nil {
+			t.Fatalf("failed to create user 2: %v", err)
+		}
+		ctx2 := service.WithUserID(t.Context(), user2.ID)
+
+		_, _, err = svc.APIToken.Rotate(ctx2, token.ID)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("restricted token", func(t *testing.T) {
+		_, token, _ := svc.APIToken.Create(ctx, "Full", domain.TokenScope{}, nil)
+		scoped := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+
+		_, _, err := svc.APIToken.Rotate(scoped, token.ID)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+}
@@ -16,10 +16,17 @@ func NewCategoryService(repo domain.CategoryRepo, household *HouseholdService) *
 	return &CategoryService{repo: repo, household: household}
 }
 
-func (s *CategoryService) Create(ctx context.Context, householdID int, name, icon string) (*domain.Category, error) {
+func (s *CategoryService) Create(ctx context.Context, householdID int, name, icon string, taxClass domain.TaxClass) (*domain.Category, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateCategoryName(name); err != nil {
 		return nil, err
 	}
+	if err := domain.ValidateCategoryTaxClass(taxClass); err != nil {
+		return nil, err
+	}
 
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return nil, err
@@ -33,6 +40,7 @@ func (s *CategoryService) Create(ctx context.Context, householdID int, name, ico
 		HouseholdID: householdID,
 		Name:        name,
 		Icon:        icon,
+		TaxClass:    taxClass,
 	})
 }
 
@@ -48,7 +56,11 @@ func (s *CategoryService) List(ctx context.Context, householdID int) ([]*domain.
 	return s.repo.ListByHousehold(ctx, householdID)
 }
 
-func (s *CategoryService) Update(ctx context.Context, id int, name, icon string) (*domain.Category, error) {
+func (s *CategoryService) Update(ctx context.Context, id int, name, icon string, taxClass domain.TaxClass) (*domain.Category, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	cat, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -61,13 +73,21 @@ func (s *CategoryService) Update(ctx context.Context, id int, name, icon string)
 	if err := domain.ValidateCategoryName(name); err != nil {
 		return nil, err
 	}
+	if err := domain.ValidateCategoryTaxClass(taxClass); err != nil {
+		return nil, err
+	}
 
 	cat.Name = name
 	cat.Icon = icon
+	cat.TaxClass = taxClass
 	return s.repo.Update(ctx, cat)
 }
 
 func (s *CategoryService) Delete(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return err
 	}
@@ -13,7 +13,7 @@ func TestCategoryCreate(t *testing.T) {
 	hh := createTestHousehold(t, svc, ctx)
 
 	t.Run("success", func(t *testing.T) {
-		cat, err := svc.Category.Create(ctx, hh.ID, "Groceries", "")
+		cat, err := svc.Category.Create(ctx, hh.ID, "Groceries", "", "")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -29,7 +29,7 @@ func TestCategoryCreate(t *testing.T) {
 	})
 
 	t.Run("custom icon", func(t *testing.T) {
-		cat, err := svc.Category.Create(ctx, hh.ID, "Transport", "car")
+		cat, err := svc.Category.Create(ctx, hh.ID, "Transport", "car", "")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -39,14 +39,14 @@ func TestCategoryCreate(t *testing.T) {
 	})
 
 	t.Run("empty name", func(t *testing.T) {
-		_, err := svc.Category.Create(ctx, hh.ID, "", "")
+		_, err := svc.Category.Create(ctx, hh.ID, "", "", "")
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
 	})
 
 	t.Run("household not found", func(t *testing.T) {
-		_, err := svc.Category.Create(ctx, 99999, "Test", "")
+		_, err := svc.Category.Create(ctx, 99999, "Test", "", "")
 		if !errors.Is(err, domain.ErrNotFound) {
 			t.Errorf("expected ErrNotFound, got %v", err)
 		}
@@ -69,11 +69,11 @@ func TestCategoryList(t *testing.T) {
 	})
 
 	t.Run("filter by household", func(t *testing.T) {
-		svc.Category.Create(ctx, hh.ID, "Cat A", "")
-		svc.Category.Create(ctx, hh.ID, "Cat B", "")
+		svc.Category.Create(ctx, hh.ID, "Cat A", "", "")
+		svc.Category.Create(ctx, hh.ID, "Cat B", "", "")
 
 		hh2, _ := svc.Household.Create(ctx, "Other Home", "", "USD", "")
-		svc.Category.Create(ctx, hh2.ID, "Cat C", "")
+		svc.Category.Create(ctx, hh2.ID, "Cat C", "", "")
 
 		list, err := svc.Category.List(ctx, hh.ID)
 		if err != nil {
@@ -116,7 +116,7 @@ func TestCategoryUpdate(t *testing.T) {
 	cat := createTestCategory(t, svc, ctx, hh.ID)
 
 	t.Run("success", func(t *testing.T) {
-		updated, err := svc.Category.Update(ctx, cat.ID, "New Name", "star")
+		updated, err := svc.Category.Update(ctx, cat.ID, "New Name", "star", "")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -129,14 +129,14 @@ func TestCategoryUpdate(t *testing.T) {
 	})
 
 	t.Run("not found", func(t *testing.T) {
-		_, err := svc.Category.Update(ctx, 99999, "Name", "icon")
+		_, err := svc.Category.Update(ctx, 99999, "Name", "icon", "")
 		if !errors.Is(err, domain.ErrNotFound) {
 			t.Errorf("expected ErrNotFound, got %v", err)
 		}
 	})
 
 	t.Run("empty name", func(t *testing.T) {
-		_, err := svc.Category.Update(ctx, cat.ID, "", "icon")
+		_, err := svc.Category.Update(ctx, cat.ID, "", "icon", "")
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
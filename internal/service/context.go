@@ -1,16 +1,83 @@
 package service
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
 
 type contextKey string
 
-const userIDKey contextKey = "user_id"
+const (
+	tokenScopeKey contextKey = "token_scope"
+	clientKey     contextKey = "client"
+	operatorKey   contextKey = "operator"
+)
+
+// Client describes where a request came from. It is recorded with
+// security events.
+type Client struct {
+	IP        string
+	UserAgent string
+}
 
+// WithUserID authenticates the request as the given user. The user is also
+// recorded as the author of the revisions the request creates.
 func WithUserID(ctx context.Context, userID int) context.Context {
-	return context.WithValue(ctx, userIDKey, userID)
+	return domain.WithActor(ctx, userID)
 }
 
 func UserIDFromContext(ctx context.Context) (int, bool) {
-	id, ok := ctx.Value(userIDKey).(int)
-	return id, ok
+	return domain.ActorFromContext(ctx)
+}
+
+// WithTokenScope marks the request as authenticated by an API token with the
+// given scope. Requests without a scope have full access.
+func WithTokenScope(ctx context.Context, scope domain.TokenScope) context.Context {
+	return context.WithValue(ctx, tokenScopeKey, scope)
+}
+
+func TokenScopeFromContext(ctx context.Context) domain.TokenScope {
+	scope, _ := ctx.Value(tokenScopeKey).(domain.TokenScope)
+	return scope
+}
+
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientKey, client)
+}
+
+// ClientFromContext returns the client of the request, or a zero Client
+// outside of requests, for example on the CLI.
+func ClientFromContext(ctx context.Context) Client {
+	client, _ := ctx.Value(clientKey).(Client)
+	return client
+}
+
+// WithOperator marks the caller as the operator of the instance, for example
+// on the CLI. The operator may use the admin methods without being signed in.
+func WithOperator(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operatorKey, true)
+}
+
+func isOperator(ctx context.Context) bool {
+	operator, _ := ctx.Value(operatorKey).(bool)
+	return operator
+}
+
+// requireWrite rejects requests whose token only allows reading.
+func requireWrite(ctx context.Context) error {
+	if !TokenScopeFromContext(ctx).CanWrite() {
+		return fmt.Errorf("%w: token is read-only", domain.ErrForbidden)
+	}
+	return nil
+}
+
+// requireFullAccess rejects requests whose token is restricted in any way, so
+// a restricted token can't manage tokens with more access than itself.
+func requireFullAccess(ctx context.Context) error {
+	if TokenScopeFromContext(ctx).Restricted() {
+		return fmt.Errorf("%w: token is restricted", domain.ErrForbidden)
+	}
+	return nil
 }
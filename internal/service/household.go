@@ -12,6 +12,7 @@ type HouseholdService struct {
 	categoryR   domain.CategoryRepo
 	txR         domain.TransactionRepo
 	recurringR  domain.RecurringExpenseRepo
+	events      *SecurityEventService
 }
 
 func NewHouseholdService(
@@ -19,16 +20,25 @@ func NewHouseholdService(
 	categoryR domain.CategoryRepo,
 	txR domain.TransactionRepo,
 	recurringR domain.RecurringExpenseRepo,
+	events *SecurityEventService,
 ) *HouseholdService {
 	return &HouseholdService{
 		repo:       repo,
 		categoryR:  categoryR,
 		txR:        txR,
 		recurringR: recurringR,
+		events:     events,
 	}
 }
 
 func (s *HouseholdService) Create(ctx context.Context, name, description, currency, icon string) (*domain.Household, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+	if len(TokenScopeFromContext(ctx).HouseholdIDs) > 0 {
+		return nil, fmt.Errorf("%w: token is limited to existing households", domain.ErrForbidden)
+	}
+
 	userID, ok := UserIDFromContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
@@ -73,10 +83,26 @@ func (s *HouseholdService) List(ctx context.Context) ([]*domain.Household, error
 		return nil, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
 	}
 
-	return s.repo.ListByOwner(ctx, userID)
+	households, err := s.repo.ListByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := TokenScopeFromContext(ctx)
+	allowed := households[:0]
+	for _, hh := range households {
+		if scope.AllowsHousehold(hh.ID) {
+			allowed = append(allowed, hh)
+		}
+	}
+	return allowed, nil
 }
 
 func (s *HouseholdService) Update(ctx context.Context, id int, name, description, currency, icon string) (*domain.Household, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	hh, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -100,7 +126,13 @@ func (s *HouseholdService) Update(ctx context.Context, id int, name, description
 	return s.repo.Update(ctx, hh)
 }
 
+// Delete moves the household to the trash, from where the TrashService can
+// restore it.
 func (s *HouseholdService) Delete(ctx context.Context, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
 	hh, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return err
@@ -110,7 +142,13 @@ func (s *HouseholdService) Delete(ctx context.Context, id int) error {
 		return err
 	}
 
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventHouseholdDeleted, hh.OwnerID, map[string]string{
+		"household_id":   eventID(hh.ID),
+		"household_name": hh.Name,
+	})
 }
 
 func (s *HouseholdService) authorize(ctx context.Context, hh *domain.Household) error {
@@ -121,5 +159,8 @@ func (s *HouseholdService) authorize(ctx context.Context, hh *domain.Household)
 	if hh.OwnerID != userID {
 		return fmt.Errorf("%w: not household owner", domain.ErrForbidden)
 	}
+	if !TokenScopeFromContext(ctx).AllowsHousehold(hh.ID) {
+		return fmt.Errorf("%w: token is not allowed for this household", domain.ErrForbidden)
+	}
 	return nil
 }
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// IdentityService manages the OIDC identities linked to the current user,
+// so one account can be logged in to through several providers.
+type IdentityService struct {
+	identities  domain.UserIdentityRepo
+	credentials domain.LocalCredentialRepo
+	users       domain.UserRepo
+	events      *SecurityEventService
+}
+
+func NewIdentityService(identities domain.UserIdentityRepo, credentials domain.LocalCredentialRepo, users domain.UserRepo, events *SecurityEventService) *IdentityService {
+	return &IdentityService{identities: identities, credentials: credentials, users: users, events: events}
+}
+
+// List returns the identities of the current user, oldest first.
+func (s *IdentityService) List(ctx context.Context) ([]*domain.UserIdentity, error) {
+	userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.identities.ListByUser(ctx, userID)
+}
+
+// Link links an identity the user just logged in with at a provider to the
+// current user. Linking an identity the user already has does nothing; one
+// that belongs to another account is a conflict.
+func (s *IdentityService) Link(ctx context.Context, id domain.OIDCIdentity) (*domain.UserIdentity, error) {
+	userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.identities.GetByIssuerSubject(ctx, id.Issuer, id.Subject)
+	if err == nil {
+		if existing.UserID != userID {
+			return nil, fmt.Errorf("%w: identity belongs to another account", domain.ErrConflict)
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	identity, err := s.identities.Create(ctx, &domain.UserIdentity{
+		UserID:  userID,
+		Issuer:  id.Issuer,
+		Subject: id.Subject,
+		Email:   id.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.events.Record(ctx, domain.EventIdentityLinked, userID, map[string]string{
+		"issuer": id.Issuer,
+		"email":  id.Email,
+	}); err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// Unlink removes one of the current user's identities. The last way to log
+// in can't be removed.
+func (s *IdentityService) Unlink(ctx context.Context, id int) error {
+	userID, err := sessionUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	identities, err := s.identities.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var identity *domain.UserIdentity
+	for _, i := range identities {
+		if i.ID == id {
+			identity = i
+		}
+	}
+	if identity == nil {
+		return fmt.Errorf("%w: identity %d", domain.ErrNotFound, id)
+	}
+
+	if len(identities) == 1 {
+		other, err := s.otherLogin(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if !other {
+			return fmt.Errorf("%w: the last way to log in can't be removed", domain.ErrConflict)
+		}
+	}
+
+	if err := s.identities.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventIdentityUnlinked, userID, map[string]string{
+		"issuer": identity.Issuer,
+		"email":  identity.Email,
+	})
+}
+
+// otherLogin reports whether the user can log in without OIDC: with a local
+// account or through the reverse proxy.
+func (s *IdentityService) otherLogin(ctx context.Context, userID int) (bool, error) {
+	_, err := s.credentials.GetByUserID(ctx, userID)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return false, err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return strings.HasPrefix(user.Subject, domain.HeaderSubjectPrefix), nil
+}
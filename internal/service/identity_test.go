@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestIdentityService(t *testing.T) {
+	svc := setupTestServices(t)
+	open := domain.SignInPolicy{Registration: domain.RegistrationOpen}
+	google := domain.OIDCIdentity{Issuer: "https://accounts.google.com", Subject: "g-1", Email: "anna@example.com", Name: "Anna"}
+	keycloak := domain.OIDCIdentity{Issuer: "https://keycloak.example.com", Subject: "k-1", Email: "anna@example.com", Name: "Anna"}
+
+	user, err := svc.User.SignInOIDC(t.Context(), open, google)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := service.WithUserID(t.Context(), user.ID)
+
+	t.Run("link", func(t *testing.T) {
+		if _, err := svc.Identity.Link(ctx, keycloak); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.Identity.Link(ctx, keycloak); err != nil {
+			t.Errorf("expected linking again to do nothing, got %v", err)
+		}
+		signedIn, err := svc.User.SignInOIDC(t.Context(), open, keycloak)
+		if err != nil || signedIn.ID != user.ID {
+			t.Errorf("expected the linked identity to sign in to the account, got %+v, %v", signedIn, err)
+		}
+		events, _ := svc.Security.List(ctx)
+		if len(events) == 0 || events[0].Type != domain.EventIdentityLinked {
+			t.Errorf("expected the link to be logged, got %+v", events)
+		}
+	})
+
+	t.Run("identities of other accounts can't be linked", func(t *testing.T) {
+		other, _ := svc.User.SignInOIDC(t.Context(), open, domain.OIDCIdentity{Issuer: google.Issuer, Subject: "g-2", Email: "ben@example.com", Name: "Ben"})
+		otherCtx := service.WithUserID(t.Context(), other.ID)
+		if _, err := svc.Identity.Link(otherCtx, keycloak); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("unlink", func(t *testing.T) {
+		identities, err := svc.Identity.List(ctx)
+		if err != nil || len(identities) != 2 {
+			t.Fatalf("expected two identities, got %d, %v", len(identities), err)
+		}
+		if err := svc.Identity.Unlink(ctx, identities[0].ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.Identity.Unlink(ctx, identities[1].ID); !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected the last login method to be kept, got %v", err)
+		}
+		if err := svc.Identity.Unlink(ctx, identities[0].ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("restricted tokens can't manage identities", func(t *testing.T) {
+		read := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+		if _, err := svc.Identity.List(read); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type MemberService struct {
+	repo      domain.HouseholdMemberRepo
+	household *HouseholdService
+}
+
+func NewMemberService(repo domain.HouseholdMemberRepo, household *HouseholdService) *MemberService {
+	return &MemberService{repo: repo, household: household}
+}
+
+func (s *MemberService) Create(ctx context.Context, householdID int, name string) (*domain.HouseholdMember, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := domain.ValidateMemberName(name); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, &domain.HouseholdMember{
+		HouseholdID: householdID,
+		Name:        name,
+	})
+}
+
+func (s *MemberService) List(ctx context.Context, householdID int) ([]*domain.HouseholdMember, error) {
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByHousehold(ctx, householdID)
+}
+
+func (s *MemberService) Update(ctx context.Context, householdID, id int, name string) (*domain.HouseholdMember, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := domain.ValidateMemberName(name); err != nil {
+		return nil, err
+	}
+
+	member, err := s.get(ctx, householdID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Name = name
+	return s.repo.Update(ctx, member)
+}
+
+// Delete removes a member that isn't referenced by any split or settlement.
+// Members with history are kept so balances don't change retroactively.
+func (s *MemberService) Delete(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.get(ctx, householdID, id); err != nil {
+		return err
+	}
+
+	referenced, err := s.repo.IsReferenced(ctx, id)
+	if err != nil {
+		return err
+	}
+	if referenced {
+		return fmt.Errorf("%w: member is used by splits or settlements", domain.ErrConflict)
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+// ResolveSplit checks a split of amount and that all members involved
+// belong to the household. An equal split without shares is shared by the
+// current members, which are stored with it, so members added or removed
+// later don't change who shared it.
+func (s *MemberService) ResolveSplit(ctx context.Context, householdID int, split *domain.Split, amount domain.Money) error {
+	if split == nil {
+		return nil
+	}
+
+	members, err := s.repo.ListByHousehold(ctx, householdID)
+	if err != nil {
+		return err
+	}
+	if split.Type == domain.SplitEqual && len(split.Shares) == 0 {
+		for _, m := range members {
+			split.Shares = append(split.Shares, domain.SplitShare{MemberID: m.ID, Value: domain.ZeroMoney()})
+		}
+	}
+	if err := domain.ValidateSplit(split, amount); err != nil {
+		return err
+	}
+
+	known := make(map[int]bool, len(members))
+	for _, m := range members {
+		known[m.ID] = true
+	}
+	if !known[split.PaidBy] {
+		return domain.NewValidationError("paid_by", "must be a member of the household")
+	}
+	for _, sh := range split.Shares {
+		if !known[sh.MemberID] {
+			return domain.NewValidationError("shares", "must only list members of the household")
+		}
+	}
+	return nil
+}
+
+// get returns the member after checking that it belongs to the household
+// and the household to the user.
+func (s *MemberService) get(ctx context.Context, householdID, id int) (*domain.HouseholdMember, error) {
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	member, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if member.HouseholdID != householdID {
+		return nil, fmt.Errorf("%w: member does not belong to household", domain.ErrForbidden)
+	}
+	return member, nil
+}
@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+func TestMemberCRUD(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+
+	anna, err := svc.Member.Create(ctx, hh.ID, "Anna")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if anna.HouseholdID != hh.ID || anna.Name != "Anna" {
+		t.Errorf("unexpected member %+v", anna)
+	}
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := svc.Member.Create(ctx, hh.ID, "Anna")
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := svc.Member.Create(ctx, hh.ID, "")
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		m, err := svc.Member.Update(ctx, hh.ID, anna.ID, "Anna K.")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if m.Name != "Anna K." {
+			t.Errorf("Name = %q, want %q", m.Name, "Anna K.")
+		}
+	})
+
+	t.Run("wrong household", func(t *testing.T) {
+		other, _ := svc.Household.Create(ctx, "Other Home", "", "EUR", "")
+		err := svc.Member.Delete(ctx, other.ID, anna.ID)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := svc.Member.Delete(ctx, hh.ID, anna.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		list, _ := svc.Member.List(ctx, hh.ID)
+		if len(list) != 0 {
+			t.Errorf("expected no members, got %d", len(list))
+		}
+	})
+}
+
+func TestMemberDeleteReferenced(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+	ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+
+	split := &domain.Split{PaidBy: anna.ID, Type: domain.SplitEqual, Shares: []domain.SplitShare{{MemberID: anna.ID}, {MemberID: ben.ID}}}
+	tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-20), "Groceries", "", "", time.Now(), split)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Ben only has a share, which isn't covered by a foreign key.
+	if err := svc.Member.Delete(ctx, hh.ID, ben.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("expected ErrConflict for member with a share, got %v", err)
+	}
+	if err := svc.Member.Delete(ctx, hh.ID, anna.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("expected ErrConflict for payer, got %v", err)
+	}
+
+	if _, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, tx.Amount, tx.Description, "", "", tx.Date, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.Member.Delete(ctx, hh.ID, ben.ID); err != nil {
+		t.Errorf("expected member to be deletable after removing the split, got %v", err)
+	}
+}
+
+func TestEqualSplitKeepsMembers(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+	ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+
+	// Shared by everyone, so the request lists no shares.
+	tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-20), "Groceries", "", "", time.Now(),
+		&domain.Split{PaidBy: anna.ID, Type: domain.SplitEqual})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tx.Split.Shares) != 2 || tx.Split.Shares[0].MemberID != anna.ID || tx.Split.Shares[1].MemberID != ben.ID {
+		t.Fatalf("expected the split to store Anna and Ben, got %+v", tx.Split.Shares)
+	}
+
+	before, err := svc.Settlement.Balances(ctx, hh.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Ben shares the groceries, so he can't be deleted.
+	if err := svc.Member.Delete(ctx, hh.ID, ben.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("expected ErrConflict for member of an equal split, got %v", err)
+	}
+	// A member joining later doesn't share old expenses.
+	cem, err := svc.Member.Create(ctx, hh.ID, "Cem")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	after, err := svc.Settlement.Balances(ctx, hh.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[int]string{anna.ID: "10", ben.ID: "-10", cem.ID: "0"}
+	for _, m := range after.Members {
+		if !m.Balance.Equal(decimal.RequireFromString(want[m.MemberID])) {
+			t.Errorf("%s: balance %s, want %s", m.MemberName, m.Balance, want[m.MemberID])
+		}
+	}
+	for i, m := range before.Members {
+		if !after.Members[i].Balance.Equal(m.Balance) {
+			t.Errorf("%s: balance changed from %s to %s", m.MemberName, m.Balance, after.Members[i].Balance)
+		}
+	}
+}
+
+func TestTransactionSplit(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+	ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+
+	t.Run("stored and loaded", func(t *testing.T) {
+		split := &domain.Split{PaidBy: anna.ID, Type: domain.SplitPercentage, Shares: []domain.SplitShare{
+			{MemberID: anna.ID, Value: decimal.RequireFromString("62.5")},
+			{MemberID: ben.ID, Value: decimal.RequireFromString("37.5")},
+		}}
+		created, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-80), "Internet", "", "", time.Now(), split)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, err := svc.Transaction.GetByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Split == nil || got.Split.PaidBy != anna.ID || got.Split.Type != domain.SplitPercentage || len(got.Split.Shares) != 2 {
+			t.Fatalf("unexpected split %+v", got.Split)
+		}
+		if !got.Split.Shares[0].Value.Equal(decimal.RequireFromString("62.5")) {
+			t.Errorf("share = %s, want 62.5", got.Split.Shares[0].Value)
+		}
+	})
+
+	t.Run("unsplit", func(t *testing.T) {
+		created, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-5), "Coffee", "", "", time.Now(), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if created.Split != nil {
+			t.Errorf("expected no split, got %+v", created.Split)
+		}
+	})
+
+	t.Run("member of another household", func(t *testing.T) {
+		other, _ := svc.Household.Create(ctx, "Other Home", "", "EUR", "")
+		stranger, _ := svc.Member.Create(ctx, other.ID, "Cem")
+		split := &domain.Split{PaidBy: stranger.ID, Type: domain.SplitEqual}
+		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-5), "Coffee", "", "", time.Now(), split)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("fixed shares must match", func(t *testing.T) {
+		split := &domain.Split{PaidBy: anna.ID, Type: domain.SplitFixed, Shares: []domain.SplitShare{
+			{MemberID: anna.ID, Value: decimal.NewFromInt(10)},
+		}}
+		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-20), "Pizza", "", "", time.Now(), split)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
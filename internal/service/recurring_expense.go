@@ -12,13 +12,20 @@ type RecurringExpenseService struct {
 	repo         domain.RecurringExpenseRepo
 	overrideRepo domain.RecurringScheduleOverrideRepo
 	household    *HouseholdService
+	members      *MemberService
 }
 
-func NewRecurringExpenseService(repo domain.RecurringExpenseRepo, overrideRepo domain.RecurringScheduleOverrideRepo, household *HouseholdService) *RecurringExpenseService {
-	return &RecurringExpenseService{repo: repo, overrideRepo: overrideRepo, household: household}
+func NewRecurringExpenseService(repo domain.RecurringExpenseRepo, overrideRepo domain.RecurringScheduleOverrideRepo, household *HouseholdService, members *MemberService) *RecurringExpenseService {
+	return &RecurringExpenseService{repo: repo, overrideRepo: overrideRepo, household: household, members: members}
 }
 
-func (s *RecurringExpenseService) Create(ctx context.Context, householdID, categoryID int, name, description, details string, amount domain.Money, freq domain.Frequency, startDate time.Time, endDate *time.Time) (*domain.RecurringExpense, error) {
+// Create stores a recurring expense. split is nil unless the expense is
+// shared between members of the household.
+func (s *RecurringExpenseService) Create(ctx context.Context, householdID, categoryID int, name, description, details string, amount domain.Money, freq domain.Frequency, startDate time.Time, endDate *time.Time, split *domain.Split) (*domain.RecurringExpense, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateHouseholdName(name); err != nil {
 		return nil, err
 	}
@@ -43,6 +50,9 @@ func (s *RecurringExpenseService) Create(ctx context.Context, householdID, categ
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return nil, err
 	}
+	if err := s.members.ResolveSplit(ctx, householdID, split, amount); err != nil {
+		return nil, err
+	}
 
 	return s.repo.Create(ctx, &domain.RecurringExpense{
 		HouseholdID: householdID,
@@ -55,6 +65,7 @@ func (s *RecurringExpenseService) Create(ctx context.Context, householdID, categ
 		Active:      true,
 		StartDate:   startDate,
 		EndDate:     endDate,
+		Split:       split,
 	})
 }
 
@@ -70,7 +81,11 @@ func (s *RecurringExpenseService) List(ctx context.Context, householdID int) ([]
 	return s.repo.ListByHousehold(ctx, householdID)
 }
 
-func (s *RecurringExpenseService) Update(ctx context.Context, id, categoryID int, name, description, details string, amount domain.Money, freq domain.Frequency, active bool, startDate time.Time, endDate *time.Time) (*domain.RecurringExpense, error) {
+func (s *RecurringExpenseService) Update(ctx context.Context, id, categoryID int, name, description, details string, amount domain.Money, freq domain.Frequency, active bool, startDate time.Time, endDate *time.Time, split *domain.Split) (*domain.RecurringExpense, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	existing, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -101,19 +116,36 @@ func (s *RecurringExpenseService) Update(ctx context.Context, id, categoryID int
 		}
 	}
 
+	if err := s.members.ResolveSplit(ctx, existing.HouseholdID, split, amount); err != nil {
+		return nil, err
+	}
+
 	existing.Name = name
 	existing.Description = description
 	existing.Details = details
 	existing.Amount = amount
 	existing.CategoryID = categoryID
 	existing.Frequency = freq
+	switch {
+	case existing.Active && !active:
+		now := time.Now()
+		existing.DeactivatedAt = &now
+	case active:
+		existing.DeactivatedAt = nil
+	}
 	existing.Active = active
 	existing.StartDate = startDate
 	existing.EndDate = endDate
+	existing.Split = split
 	return s.repo.Update(ctx, existing)
 }
 
+// Delete moves the recurring expense to the trash.
 func (s *RecurringExpenseService) Delete(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return err
 	}
@@ -153,6 +185,10 @@ func (s *RecurringExpenseService) ListOverrides(ctx context.Context, recurringEx
 }
 
 func (s *RecurringExpenseService) CreateOverride(ctx context.Context, recurringExpenseID int, effectiveDate time.Time, amount domain.Money, freq domain.Frequency) (*domain.RecurringScheduleOverride, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateAmount(amount); err != nil {
 		return nil, err
 	}
@@ -173,6 +209,10 @@ func (s *RecurringExpenseService) CreateOverride(ctx context.Context, recurringE
 }
 
 func (s *RecurringExpenseService) UpdateOverride(ctx context.Context, overrideID int, effectiveDate time.Time, amount domain.Money, freq domain.Frequency) (*domain.RecurringScheduleOverride, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateAmount(amount); err != nil {
 		return nil, err
 	}
@@ -196,6 +236,10 @@ func (s *RecurringExpenseService) UpdateOverride(ctx context.Context, overrideID
 }
 
 func (s *RecurringExpenseService) DeleteOverride(ctx context.Context, overrideID int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
 	existing, err := s.overrideRepo.GetByID(ctx, overrideID)
 	if err != nil {
 		return err
@@ -17,7 +17,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-800.00")
-		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "Monthly rent", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "Monthly rent", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -35,7 +35,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 	t.Run("with end date", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-100.00")
 		end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
-		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Subscription", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &end)
+		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Subscription", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &end, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -45,7 +45,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 	})
 
 	t.Run("zero amount", func(t *testing.T) {
-		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", domain.ZeroMoney(), domain.FrequencyMonthly, time.Now(), nil)
+		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", domain.ZeroMoney(), domain.FrequencyMonthly, time.Now(), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -53,7 +53,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 
 	t.Run("invalid frequency", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
-		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", amount, domain.Frequency("invalid"), time.Now(), nil)
+		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", amount, domain.Frequency("invalid"), time.Now(), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -61,7 +61,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 
 	t.Run("empty name", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
-		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "", "", "", amount, domain.FrequencyMonthly, time.Now(), nil)
+		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "", "", "", amount, domain.FrequencyMonthly, time.Now(), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -69,7 +69,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 
 	t.Run("with details", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
-		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Internet", "Monthly bill", "Provider: Telekom\nContract: 12345", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Internet", "Monthly bill", "Provider: Telekom\nContract: 12345", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -81,7 +81,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 	t.Run("details too long", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
 		longDetails := string(make([]byte, 5001))
-		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", longDetails, amount, domain.FrequencyMonthly, time.Now(), nil)
+		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", longDetails, amount, domain.FrequencyMonthly, time.Now(), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -91,7 +91,7 @@ func TestRecurringExpenseCreate(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
 		start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
 		end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
-		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", amount, domain.FrequencyMonthly, start, &end)
+		_, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", amount, domain.FrequencyMonthly, start, &end, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -105,8 +105,8 @@ func TestRecurringExpenseList(t *testing.T) {
 	cat := createTestCategory(t, svc, ctx, hh.ID)
 
 	amount, _ := domain.NewMoney("-100.00")
-	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Expense 1", "", "", amount, domain.FrequencyMonthly, time.Now(), nil)
-	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Expense 2", "", "", amount, domain.FrequencyWeekly, time.Now(), nil)
+	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Expense 1", "", "", amount, domain.FrequencyMonthly, time.Now(), nil, nil)
+	svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Expense 2", "", "", amount, domain.FrequencyWeekly, time.Now(), nil, nil)
 
 	t.Run("filter by household", func(t *testing.T) {
 		list, err := svc.RecurringExpense.List(ctx, hh.ID)
@@ -126,11 +126,11 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 	cat := createTestCategory(t, svc, ctx, hh.ID)
 
 	amount, _ := domain.NewMoney("-800.00")
-	re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 	t.Run("success", func(t *testing.T) {
 		newAmount, _ := domain.NewMoney("-900.00")
-		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "New Rent", "updated", "", newAmount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "New Rent", "updated", "", newAmount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -143,7 +143,7 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 	})
 
 	t.Run("change frequency", func(t *testing.T) {
-		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyYearly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyYearly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -153,7 +153,7 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 	})
 
 	t.Run("deactivate", func(t *testing.T) {
-		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyYearly, false, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyYearly, false, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -164,7 +164,7 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		newAmount, _ := domain.NewMoney("10")
-		_, err := svc.RecurringExpense.Update(ctx, 99999, cat.ID, "Test", "", "", newAmount, domain.FrequencyMonthly, true, time.Now(), nil)
+		_, err := svc.RecurringExpense.Update(ctx, 99999, cat.ID, "Test", "", "", newAmount, domain.FrequencyMonthly, true, time.Now(), nil, nil)
 		if !errors.Is(err, domain.ErrNotFound) {
 			t.Errorf("expected ErrNotFound, got %v", err)
 		}
@@ -172,7 +172,7 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 
 	t.Run("add end date", func(t *testing.T) {
 		endDate := time.Date(2027, 12, 31, 0, 0, 0, 0, time.UTC)
-		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &endDate)
+		updated, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &endDate, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -183,28 +183,28 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 
 	t.Run("end date before start date", func(t *testing.T) {
 		endDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &endDate)
+		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &endDate, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
 	})
 
 	t.Run("empty name", func(t *testing.T) {
-		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "", "", "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "", "", "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
 	})
 
 	t.Run("zero amount", func(t *testing.T) {
-		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", domain.ZeroMoney(), domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", domain.ZeroMoney(), domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
 	})
 
 	t.Run("invalid frequency", func(t *testing.T) {
-		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.Frequency("invalid"), true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", "", "", amount, domain.Frequency("invalid"), true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -212,7 +212,7 @@ func TestRecurringExpenseUpdate(t *testing.T) {
 
 	t.Run("long description", func(t *testing.T) {
 		longDesc := string(make([]byte, 501))
-		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", longDesc, "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		_, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, "Rent", longDesc, "", amount, domain.FrequencyMonthly, true, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -226,7 +226,7 @@ func TestScheduleOverrideCRUD(t *testing.T) {
 	cat := createTestCategory(t, svc, ctx, hh.ID)
 
 	amount, _ := domain.NewMoney("-800.00")
-	re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 	t.Run("create override", func(t *testing.T) {
 		overrideAmount, _ := domain.NewMoney("-900.00")
@@ -311,7 +311,7 @@ func TestScheduleOverrideAuthorization(t *testing.T) {
 	hh := createTestHousehold(t, svc, ctx1)
 	cat := createTestCategory(t, svc, ctx1, hh.ID)
 	amount, _ := domain.NewMoney("-800.00")
-	re, _ := svc.RecurringExpense.Create(ctx1, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+	re, _ := svc.RecurringExpense.Create(ctx1, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 	// Create an override as user 1
 	overrideAmount, _ := domain.NewMoney("-900.00")
@@ -376,7 +376,7 @@ func TestRecurringExpenseDelete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-100.00")
-		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "ToDelete", "", "", amount, domain.FrequencyMonthly, time.Now(), nil)
+		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "ToDelete", "", "", amount, domain.FrequencyMonthly, time.Now(), nil, nil)
 
 		if err := svc.RecurringExpense.Delete(ctx, hh.ID, re.ID); err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -390,7 +390,7 @@ func TestRecurringExpenseDelete(t *testing.T) {
 
 	t.Run("wrong household", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-100.00")
-		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", amount, domain.FrequencyMonthly, time.Now(), nil)
+		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Test", "", "", amount, domain.FrequencyMonthly, time.Now(), nil, nil)
 		hh2, _ := svc.Household.Create(ctx, "Other", "", "EUR", "")
 
 		err := svc.RecurringExpense.Delete(ctx, hh2.ID, re.ID)
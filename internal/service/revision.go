@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// RevisionService lists the change history of household data. The
+// revisions themselves are recorded by the repositories.
+type RevisionService struct {
+	repo      domain.RevisionRepo
+	household *HouseholdService
+}
+
+func NewRevisionService(repo domain.RevisionRepo, household *HouseholdService) *RevisionService {
+	return &RevisionService{repo: repo, household: household}
+}
+
+// History returns the revisions of one record of the household, newest
+// first. It includes the record's deletion, so the history stays readable
+// after the record is gone.
+func (s *RevisionService) History(ctx context.Context, householdID int, entityType domain.EntityType, entityID int) ([]*domain.Revision, error) {
+	if err := domain.ValidateEntityType(entityType); err != nil {
+		return nil, err
+	}
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, householdID, entityType, entityID)
+}
@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestRevisionHistory(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, user := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+
+	history := func(t *testing.T, entityType domain.EntityType, id int) []*domain.Revision {
+		t.Helper()
+		revisions, err := svc.Revision.History(ctx, hh.ID, entityType, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return revisions
+	}
+
+	t.Run("transaction lifecycle", func(t *testing.T) {
+		anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+		ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+		groceries, err := svc.Category.Create(ctx, hh.ID, "Groceries", "", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		amount, _ := domain.NewMoney("-10.00")
+		tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Food", "", "", date, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		changed, _ := domain.NewMoney("-12.50")
+		split := &domain.Split{PaidBy: anna.ID, Type: domain.SplitPercentage, Shares: []domain.SplitShare{
+			{MemberID: anna.ID, Value: domain.MoneyFromInt(5000)},
+			{MemberID: ben.ID, Value: domain.MoneyFromInt(5000)},
+		}}
+		if _, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, groceries.ID, changed, "Food", "", "", date, split); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.Transaction.Delete(ctx, hh.ID, tx.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		revisions := history(t, domain.EntityTransaction, tx.ID)
+		if len(revisions) != 3 {
+			t.Fatalf("expected 3 revisions, got %d", len(revisions))
+		}
+		deleted, updated, created := revisions[0], revisions[1], revisions[2]
+		if created.Action != domain.RevisionCreate || created.After["amount"] != "-10.00" || created.Before != nil {
+			t.Errorf("unexpected creation %+v", created)
+		}
+		if deleted.Action != domain.RevisionDelete || deleted.Before["amount"] != "-12.50" || deleted.After != nil {
+			t.Errorf("unexpected deletion %+v", deleted)
+		}
+		if updated.UserID == nil || *updated.UserID != user.ID || updated.UserName != user.Name {
+			t.Errorf("expected the change to be attributed to %d, got %v %q", user.ID, updated.UserID, updated.UserName)
+		}
+
+		want := map[string][2]string{
+			"amount":       {"-10.00", "-12.50"},
+			"category":     {cat.Name, groceries.Name},
+			"paid_by":      {"", "Anna"},
+			"split_type":   {"", "percentage"},
+			"split_shares": {"", "Anna 50.00, Ben 50.00"},
+		}
+		changes := updated.Changes()
+		if len(changes) != len(want) {
+			t.Fatalf("expected %d changes, got %+v", len(want), changes)
+		}
+		for _, ch := range changes {
+			if w, ok := want[ch.Field]; !ok || ch.Before != w[0] || ch.After != w[1] {
+				t.Errorf("unexpected change %+v", ch)
+			}
+		}
+	})
+
+	t.Run("unchanged update leaves no revision", func(t *testing.T) {
+		if _, err := svc.Household.Update(ctx, hh.ID, "Home", "", "EUR", "home"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.Household.Update(ctx, hh.ID, "Home", "", "EUR", "home"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		revisions := history(t, domain.EntityHousehold, hh.ID)
+		if len(revisions) != 2 || revisions[0].After["name"] != "Home" || revisions[1].Action != domain.RevisionCreate {
+			t.Errorf("expected creation and one update, got %+v", revisions)
+		}
+	})
+
+	t.Run("schedule overrides", func(t *testing.T) {
+		amount, _ := domain.NewMoney("-800")
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, start, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		raised, _ := domain.NewMoney("-850")
+		o, err := svc.RecurringExpense.CreateOverride(ctx, re.ID, start.AddDate(0, 6, 0), raised, domain.FrequencyMonthly)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		revisions := history(t, domain.EntityScheduleOverride, o.ID)
+		if len(revisions) != 1 || revisions[0].HouseholdID != hh.ID || revisions[0].After["recurring_expense"] != "Rent" {
+			t.Errorf("expected the override's creation in the household, got %+v", revisions)
+		}
+	})
+
+	t.Run("changes outside of requests have no user", func(t *testing.T) {
+		if err := svc.client.Category.UpdateOneID(cat.ID).SetName("Renamed").Exec(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		revisions := history(t, domain.EntityCategory, cat.ID)
+		if revisions[0].UserID != nil || revisions[0].After["name"] != "Renamed" {
+			t.Errorf("expected an update without user, got %+v", revisions[0])
+		}
+	})
+
+	t.Run("other users", func(t *testing.T) {
+		other, _ := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
+		otherCtx := service.WithUserID(t.Context(), other.ID)
+		if _, err := svc.Revision.History(otherCtx, hh.ID, domain.EntityCategory, cat.ID); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("unknown entity type", func(t *testing.T) {
+		if _, err := svc.Revision.History(ctx, hh.ID, "member", 1); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// securityEventListLimit is how many events the event lists show. Exports
+// contain all events.
+const securityEventListLimit = 200
+
+// SecurityEventService records and lists security events. Admins see the
+// events of all users through AdminService.
+type SecurityEventService struct {
+	repo domain.SecurityEventRepo
+}
+
+func NewSecurityEventService(repo domain.SecurityEventRepo) *SecurityEventService {
+	return &SecurityEventService{repo: repo}
+}
+
+// Record appends an event concerning userID, or no user if userID is 0.
+// The client address and user agent are taken from ctx.
+func (s *SecurityEventService) Record(ctx context.Context, typ domain.SecurityEventType, userID int, details map[string]string) error {
+	client := ClientFromContext(ctx)
+	event := &domain.SecurityEvent{
+		Type:      typ,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		Details:   details,
+	}
+	if userID != 0 {
+		event.UserID = &userID
+	}
+	if _, err := s.repo.Create(ctx, event); err != nil {
+		return fmt.Errorf("recording security event: %w", err)
+	}
+	return nil
+}
+
+// List returns the latest events of the authenticated user, newest first.
+func (s *SecurityEventService) List(ctx context.Context) ([]*domain.SecurityEvent, error) {
+	userID, err := eventUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, &userID, securityEventListLimit)
+}
+
+// Each calls fn for every event of the authenticated user, oldest first.
+func (s *SecurityEventService) Each(ctx context.Context, fn func(*domain.SecurityEvent) error) error {
+	userID, err := eventUser(ctx)
+	if err != nil {
+		return err
+	}
+	return s.repo.Each(ctx, &userID, fn)
+}
+
+// eventUser returns the authenticated user. Like sessions, the log needs
+// full access, since it shows where the user logs in from.
+func eventUser(ctx context.Context) (int, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return 0, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+	}
+	if err := requireFullAccess(ctx); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+// eventID formats an ID for event details.
+func eventID(id int) string {
+	return strconv.Itoa(id)
+}
@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestSecurityEventService(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, user := createTestUser(t, svc)
+	ctx = service.WithClient(ctx, service.Client{IP: "192.0.2.1", UserAgent: "TestBrowser"})
+	other, err := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
+	if err != nil {
+		t.Fatalf("failed to create user 2: %v", err)
+	}
+
+	// latest returns the types of the user's events, newest first.
+	latest := func(t *testing.T) []domain.SecurityEventType {
+		t.Helper()
+		events, err := svc.Security.List(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		types := make([]domain.SecurityEventType, len(events))
+		for i, e := range events {
+			types[i] = e.Type
+		}
+		return types
+	}
+
+	t.Run("token lifecycle", func(t *testing.T) {
+		plain, tok, err := svc.APIToken.Create(ctx, "laptop", domain.TokenScope{}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _, err := svc.APIToken.ValidateToken(ctx, plain); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.APIToken.ValidateToken(ctx, plain); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.APIToken.Delete(ctx, tok.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := []domain.SecurityEventType{domain.EventTokenDeleted, domain.EventTokenUsed, domain.EventTokenCreated}
+		if got := latest(t); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+
+		events, _ := svc.Security.List(ctx)
+		created := events[2]
+		if created.IP != "192.0.2.1" || created.UserAgent != "TestBrowser" || created.Details["token_name"] != "laptop" {
+			t.Errorf("expected client and token details, got %+v", created)
+		}
+	})
+
+	t.Run("unknown token is recorded without user", func(t *testing.T) {
+		if _, err := svc.APIToken.ValidateToken(ctx, "mt_guessed"); !errors.Is(err, domain.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+		var rejected []*domain.SecurityEvent
+		if err := svc.Admin.SetAdmin(service.WithOperator(t.Context()), user.ID, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events, err := svc.Admin.Events(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, e := range events {
+			if e.Type == domain.EventTokenRejected {
+				rejected = append(rejected, e)
+			}
+		}
+		if len(rejected) != 1 || rejected[0].UserID != nil || rejected[0].Details["reason"] != "unknown" {
+			t.Errorf("expected one rejected token without user, got %+v", rejected)
+		}
+	})
+
+	t.Run("household deletion", func(t *testing.T) {
+		hh := createTestHousehold(t, svc, ctx)
+		if err := svc.Household.Delete(ctx, hh.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events, _ := svc.Security.List(ctx)
+		if events[0].Type != domain.EventHouseholdDeleted || events[0].Details["household_name"] != hh.Name {
+			t.Errorf("expected household deletion, got %+v", events[0])
+		}
+	})
+
+	t.Run("users only see their own events", func(t *testing.T) {
+		otherCtx := service.WithUserID(t.Context(), other.ID)
+		if _, _, err := svc.APIToken.Create(otherCtx, "other", domain.TokenScope{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events, err := svc.Security.List(otherCtx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(events) != 1 || *events[0].UserID != other.ID {
+			t.Errorf("expected only the other user's event, got %+v", events)
+		}
+		if _, err := svc.Admin.Events(otherCtx); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden for non-admins, got %v", err)
+		}
+	})
+
+	t.Run("restricted token", func(t *testing.T) {
+		scoped := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+		if _, err := svc.Security.List(scoped); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("each returns oldest first", func(t *testing.T) {
+		var ids []int
+		err := svc.Security.Each(ctx, func(e *domain.SecurityEvent) error {
+			ids = append(ids, e.ID)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for i := 1; i < len(ids); i++ {
+			if ids[i] <= ids[i-1] {
+				t.Fatalf("expected ascending IDs, got %v", ids)
+			}
+		}
+		list, _ := svc.Security.List(ctx)
+		if len(ids) != len(list) {
+			t.Errorf("expected %d events, got %d", len(list), len(ids))
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type SessionService struct {
+	repo   domain.SessionRepo
+	events *SecurityEventService
+}
+
+func NewSessionService(repo domain.SessionRepo, events *SecurityEventService) *SessionService {
+	return &SessionService{repo: repo, events: events}
+}
+
+// List returns the active browser sessions of the authenticated user.
+func (s *SessionService) List(ctx context.Context) ([]*domain.Session, error) {
+	userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByUser(ctx, userID)
+}
+
+// Revoke ends one of the user's sessions. The browser using it is logged out
+// with its next request.
+func (s *SessionService) Revoke(ctx context.Context, id int) error {
+	userID, err := sessionUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventSessionRevoked, userID, map[string]string{"session_id": eventID(id)})
+}
+
+// RevokeAll ends all sessions of the user, including the current one, and
+// returns how many were ended.
+func (s *SessionService) RevokeAll(ctx context.Context) (int, error) {
+	userID, err := sessionUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := s.repo.DeleteByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.events.Record(ctx, domain.EventSessionsRevoked, userID, map[string]string{"count": strconv.Itoa(n)}); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// DeleteExpired removes sessions that expired before now. It is run
+// periodically and needs no authenticated user.
+func (s *SessionService) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return s.repo.DeleteExpired(ctx, now)
+}
+
+// LogoutOIDC ends the sessions the OIDC provider issuer asked to end through
+// back-channel logout: the one with the provider's session ID sid or, if sid
+// is empty, all sessions of the subject. The caller must have verified the
+// logout token; there is no authenticated user.
+func (s *SessionService) LogoutOIDC(ctx context.Context, issuer, subject, sid string) (int, error) {
+	userIDs, err := s.repo.DeleteByOIDC(ctx, issuer, subject, sid)
+	if err != nil {
+		return 0, err
+	}
+	for _, userID := range userIDs {
+		if err := s.events.Record(ctx, domain.EventLogout, userID, map[string]string{"method": "backchannel"}); err != nil {
+			return 0, err
+		}
+	}
+	return len(userIDs), nil
+}
+
+// sessionUser returns the authenticated user. Sessions can only be managed
+// with full access, since a restricted token could otherwise lock out its
+// owner or read where they are logged in.
+func sessionUser(ctx context.Context) (int, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return 0, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+	}
+	if err := requireFullAccess(ctx); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestSessionService(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, user := createTestUser(t, svc)
+	other, err := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
+	if err != nil {
+		t.Fatalf("failed to create user 2: %v", err)
+	}
+
+	newSession := func(token string, userID int, expiresAt time.Time) int {
+		return svc.client.Session.Create().
+			SetToken(token).
+			SetData([]byte{}).
+			SetUserID(userID).
+			SetExpiresAt(expiresAt).
+			SetUserAgent("TestBrowser").
+			SaveX(ctx).ID
+	}
+	future := time.Now().Add(time.Hour)
+	laptop := newSession("laptop", user.ID, future)
+	newSession("phone", user.ID, future)
+	newSession("expired", user.ID, time.Now().Add(-time.Hour))
+	foreign := newSession("foreign", other.ID, future)
+
+	t.Run("list", func(t *testing.T) {
+		list, err := svc.Session.List(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("expected 2 active sessions, got %d", len(list))
+		}
+		if list[0].UserAgent != "TestBrowser" || list[0].LastSeenAt.IsZero() {
+			t.Errorf("expected user agent and last seen, got %+v", list[0])
+		}
+	})
+
+	t.Run("restricted token", func(t *testing.T) {
+		scoped := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+		if _, err := svc.Session.List(scoped); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("List: expected ErrForbidden, got %v", err)
+		}
+		if err := svc.Session.Revoke(scoped, laptop); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Revoke: expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("revoke other user's session", func(t *testing.T) {
+		if err := svc.Session.Revoke(ctx, foreign); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		if err := svc.Session.Revoke(ctx, laptop); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		list, _ := svc.Session.List(ctx)
+		if len(list) != 1 {
+			t.Errorf("expected 1 session after revoke, got %d", len(list))
+		}
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		n, err := svc.Session.DeleteExpired(t.Context(), time.Now())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 1 {
+			t.Errorf("expected 1 expired session deleted, got %d", n)
+		}
+	})
+
+	t.Run("revoke all", func(t *testing.T) {
+		n, err := svc.Session.RevokeAll(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 1 {
+			t.Errorf("expected 1 session revoked, got %d", n)
+		}
+		if remaining := svc.client.Session.Query().CountX(ctx); remaining != 1 {
+			t.Errorf("expected only the other user's session to remain, got %d", remaining)
+		}
+	})
+}
+
+func TestSessionService_LogoutOIDC(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, user := createTestUser(t, svc)
+
+	const issuer = "https://idp.example.com"
+	newSession := func(token, iss, subject, sid string) {
+		svc.client.Session.Create().
+			SetToken(token).
+			SetData([]byte{}).
+			SetUserID(user.ID).
+			SetExpiresAt(time.Now().Add(time.Hour)).
+			SetOidcIssuer(iss).
+			SetOidcSubject(subject).
+			SetOidcSid(sid).
+			ExecX(ctx)
+	}
+	newSession("laptop", issuer, "sub-1", "sid-a")
+	newSession("phone", issuer, "sub-1", "sid-b")
+	newSession("tablet", issuer, "sub-1", "sid-c")
+	newSession("other", "https://other.example.com", "sub-1", "sid-c")
+	newSession("local", "", "", "")
+
+	remaining := func() int { return svc.client.Session.Query().CountX(ctx) }
+
+	if n, err := svc.Session.LogoutOIDC(t.Context(), issuer, "sub-2", "sid-a"); err != nil || n != 0 {
+		t.Errorf("expected sid and subject to both match, got %d, %v", n, err)
+	}
+	if n, err := svc.Session.LogoutOIDC(t.Context(), issuer, "", "sid-a"); err != nil || n != 1 {
+		t.Errorf("expected one session for the sid, got %d, %v", n, err)
+	}
+	if n, err := svc.Session.LogoutOIDC(t.Context(), issuer, "sub-1", ""); err != nil || n != 2 {
+		t.Errorf("expected the subject's other sessions at the issuer, got %d, %v", n, err)
+	}
+	if n, err := svc.Session.LogoutOIDC(t.Context(), issuer, "", ""); err != nil || n != 0 {
+		t.Errorf("expected nothing without subject and sid, got %d, %v", n, err)
+	}
+	if got := remaining(); got != 2 {
+		t.Errorf("expected the other provider's and the local session to remain, got %d", got)
+	}
+
+	events, _ := svc.Security.List(ctx)
+	if len(events) != 3 || events[0].Type != domain.EventLogout || events[0].Details["method"] != "backchannel" {
+		t.Errorf("expected a logout event per session, got %+v", events)
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+type SettlementService struct {
+	repo          domain.SettlementRepo
+	memberRepo    domain.HouseholdMemberRepo
+	txRepo        domain.TransactionRepo
+	recurringRepo domain.RecurringExpenseRepo
+	overrideRepo  domain.RecurringScheduleOverrideRepo
+	household     *HouseholdService
+	now           func() time.Time
+}
+
+func NewSettlementService(repo domain.SettlementRepo, memberRepo domain.HouseholdMemberRepo, txRepo domain.TransactionRepo, recurringRepo domain.RecurringExpenseRepo, overrideRepo domain.RecurringScheduleOverrideRepo, household *HouseholdService) *SettlementService {
+	return &SettlementService{
+		repo:          repo,
+		memberRepo:    memberRepo,
+		txRepo:        txRepo,
+		recurringRepo: recurringRepo,
+		overrideRepo:  overrideRepo,
+		household:     household,
+		now:           time.Now,
+	}
+}
+
+// Balances computes who owes whom in the household. It counts all split
+// transactions, the split recurring expenses of every month up to the
+// current one or the month they stopped in, and all recorded settlements.
+func (s *SettlementService) Balances(ctx context.Context, householdID int) (*domain.Balances, error) {
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.memberRepo.ListByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	ledger := domain.NewLedger()
+
+	txs, err := s.txRepo.ListSplitByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		ledger.AddSplit(tx.Split, tx.Amount)
+	}
+
+	split, err := s.recurringRepo.ListSplitByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	if len(split) > 0 {
+		ids := make([]int, 0, len(split))
+		for _, re := range split {
+			ids = append(ids, re.ID)
+		}
+		overrides, err := s.overrideRepo.ListByRecurringExpenses(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		byExpense := make(map[int][]*domain.RecurringScheduleOverride)
+		for _, o := range overrides {
+			byExpense[o.RecurringExpenseID] = append(byExpense[o.RecurringExpenseID], o)
+		}
+		now := s.now()
+		for _, re := range split {
+			ledger.AddSplit(re.Split, domain.AccrueRecurring(re, byExpense[re.ID], now))
+		}
+	}
+
+	settlements, err := s.repo.ListByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range settlements {
+		ledger.AddSettlement(st)
+	}
+
+	return ledger.Balances(householdID, members), nil
+}
+
+func (s *SettlementService) List(ctx context.Context, householdID int) ([]*domain.Settlement, error) {
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListByHousehold(ctx, householdID)
+}
+
+// Record stores a payment from one member to another.
+func (s *SettlementService) Record(ctx context.Context, householdID, fromMemberID, toMemberID int, amount domain.Money, date time.Time, note string) (*domain.Settlement, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
+	settlement := &domain.Settlement{
+		HouseholdID:  householdID,
+		FromMemberID: fromMemberID,
+		ToMemberID:   toMemberID,
+		Amount:       amount,
+		Date:         date,
+		Note:         note,
+	}
+	if err := domain.ValidateSettlement(settlement); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+	if err := s.checkMember(ctx, householdID, "from_member_id", fromMemberID); err != nil {
+		return nil, err
+	}
+	if err := s.checkMember(ctx, householdID, "to_member_id", toMemberID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, settlement)
+}
+
+func (s *SettlementService) checkMember(ctx context.Context, householdID int, field string, id int) error {
+	member, err := s.memberRepo.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) || (err == nil && member.HouseholdID != householdID) {
+		return domain.NewValidationError(field, "must be a member of the household")
+	}
+	return err
+}
+
+func (s *SettlementService) Delete(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return err
+	}
+
+	settlement, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if settlement.HouseholdID != householdID {
+		return fmt.Errorf("%w: settlement does not belong to household", domain.ErrForbidden)
+	}
+
+	return s.repo.Delete(ctx, id)
+}
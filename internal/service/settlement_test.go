@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+func TestSettlementBalances(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+	ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+	cem, _ := svc.Member.Create(ctx, hh.ID, "Cem")
+
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	// Anna pays 90 for groceries shared by everyone.
+	if _, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-90), "Groceries", "", "", date,
+		&domain.Split{PaidBy: anna.ID, Type: domain.SplitEqual}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Not shared, doesn't count.
+	if _, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, decimal.NewFromInt(-500), "Bike", "", "", date, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Ben pays the internet for two months, 20 of every 30 is Cem's.
+	end := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Internet", "", "", decimal.NewFromInt(-30), domain.FrequencyMonthly,
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &end,
+		&domain.Split{PaidBy: ben.ID, Type: domain.SplitFixed, Shares: []domain.SplitShare{
+			{MemberID: ben.ID, Value: decimal.NewFromInt(10)},
+			{MemberID: cem.ID, Value: decimal.NewFromInt(20)},
+		}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	balances, err := svc.Settlement.Balances(ctx, hh.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Anna: paid 90, share 30 → +60; Ben: paid 60, share 30+20 → +10; Cem: share 30+40 → -70
+	want := map[int]string{anna.ID: "60", ben.ID: "10", cem.ID: "-70"}
+	for _, m := range balances.Members {
+		if !m.Balance.Equal(decimal.RequireFromString(want[m.MemberID])) {
+			t.Errorf("%s: balance %s, want %s", m.MemberName, m.Balance, want[m.MemberID])
+		}
+	}
+	if len(balances.Transfers) != 2 {
+		t.Fatalf("expected 2 transfers, got %+v", balances.Transfers)
+	}
+
+	for _, tr := range balances.Transfers {
+		if _, err := svc.Settlement.Record(ctx, hh.ID, tr.FromMemberID, tr.ToMemberID, tr.Amount, date, "Settle up"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	balances, err = svc.Settlement.Balances(ctx, hh.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range balances.Members {
+		if !m.Balance.IsZero() {
+			t.Errorf("%s: balance %s after settling, want 0", m.MemberName, m.Balance)
+		}
+	}
+
+	list, err := svc.Settlement.List(ctx, hh.ID)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("expected 2 settlements, got %d, %v", len(list), err)
+	}
+	if err := svc.Settlement.Delete(ctx, hh.ID, list[0].ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The household can still be deleted with members and settlements.
+	if err := svc.Household.Delete(ctx, hh.ID); err != nil {
+		t.Fatalf("deleting household: %v", err)
+	}
+}
+
+func TestSettlementBalancesStoppedRecurring(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+	ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+
+	// Anna has paid the cleaner for this and the two previous months.
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month()-2, 1, 0, 0, 0, 0, time.UTC)
+	re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Cleaner", "", "", decimal.NewFromInt(-40), domain.FrequencyMonthly,
+		start, nil, &domain.Split{PaidBy: anna.ID, Type: domain.SplitEqual})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	check := func(step string) {
+		t.Helper()
+		balances, err := svc.Settlement.Balances(ctx, hh.ID)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step, err)
+		}
+		want := map[int]string{anna.ID: "60", ben.ID: "-60"}
+		for _, m := range balances.Members {
+			if !m.Balance.Equal(decimal.RequireFromString(want[m.MemberID])) {
+				t.Errorf("%s: %s has balance %s, want %s", step, m.MemberName, m.Balance, want[m.MemberID])
+			}
+		}
+	}
+
+	check("active")
+	// Stopping the cleaner doesn't undo what Anna already paid.
+	if _, err := svc.RecurringExpense.Update(ctx, re.ID, cat.ID, re.Name, "", "", re.Amount, re.Frequency, false, re.StartDate, nil, re.Split); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check("deactivated")
+	if err := svc.RecurringExpense.Delete(ctx, hh.ID, re.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	check("in the trash")
+}
+
+func TestSettlementRecordValidation(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	anna, _ := svc.Member.Create(ctx, hh.ID, "Anna")
+	ben, _ := svc.Member.Create(ctx, hh.ID, "Ben")
+	other, _ := svc.Household.Create(ctx, "Other Home", "", "EUR", "")
+	stranger, _ := svc.Member.Create(ctx, other.ID, "Cem")
+
+	tests := []struct {
+		name     string
+		from, to int
+		amount   string
+	}{
+		{"same member", anna.ID, anna.ID, "10"},
+		{"negative amount", anna.ID, ben.ID, "-10"},
+		{"member of another household", anna.ID, stranger.ID, "10"},
+		{"unknown member", 99999, ben.ID, "10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Settlement.Record(ctx, hh.ID, tt.from, tt.to, decimal.RequireFromString(tt.amount), time.Now(), "")
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+		})
+	}
+}
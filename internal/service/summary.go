@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -14,6 +15,7 @@ type SummaryService struct {
 	recurringRepo domain.RecurringExpenseRepo
 	overrideRepo  domain.RecurringScheduleOverrideRepo
 	categoryRepo  domain.CategoryRepo
+	aggregateRepo domain.MonthlyAggregateRepo
 	household     *HouseholdService
 }
 
@@ -22,6 +24,7 @@ func NewSummaryService(
 	recurringRepo domain.RecurringExpenseRepo,
 	overrideRepo domain.RecurringScheduleOverrideRepo,
 	categoryRepo domain.CategoryRepo,
+	aggregateRepo domain.MonthlyAggregateRepo,
 	household *HouseholdService,
 ) *SummaryService {
 	return &SummaryService{
@@ -29,39 +32,557 @@ func NewSummaryService(
 		recurringRepo: recurringRepo,
 		overrideRepo:  overrideRepo,
 		categoryRepo:  categoryRepo,
+		aggregateRepo: aggregateRepo,
 		household:     household,
 	}
 }
 
+// GetMonthlySummary summarizes one month. The totals and the category
+// breakdown come from the month's aggregate; only the recurring entries are
+// built from the recurring items.
 func (s *SummaryService) GetMonthlySummary(ctx context.Context, householdID int, year int, month time.Month) (*domain.MonthlySummary, error) {
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return nil, err
 	}
 
-	refMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	data, err := s.loadSummaryData(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get active recurring expenses
-	recurring, err := s.recurringRepo.ListActiveByHousehold(ctx, householdID)
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	aggregates, err := s.monthlyAggregates(ctx, householdID, []time.Time{from}, data)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := data.month(year, month)
+	summary.ApplyAggregate(aggregates[summary.Month])
+	return summary, nil
+}
+
+// GetMonthlySummaries returns the monthly summary of each given household,
+// keyed by household ID. All households are loaded together, so the number of
+// queries neither grows with the households nor with their recurring items.
+func (s *SummaryService) GetMonthlySummaries(ctx context.Context, householdIDs []int, year int, month time.Month) (map[int]*domain.MonthlySummary, error) {
+	if len(householdIDs) == 0 {
+		return map[int]*domain.MonthlySummary{}, nil
+	}
+	if err := s.authorizeHouseholds(ctx, householdIDs); err != nil {
+		return nil, err
+	}
+
+	batch, err := s.loadSummaryDataBatch(ctx, householdIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if err := s.loadTransactionsBatch(ctx, batch, householdIDs, from, from.AddDate(0, 1, 0)); err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]*domain.MonthlySummary, len(householdIDs))
+	for _, id := range householdIDs {
+		result[id] = batch[id].month(year, month)
+	}
+	return result, nil
+}
+
+// GetRangeSummary summarizes all months from the month of from up to and
+// including the month of to. Months come from the stored aggregates where
+// available; the rest is computed from the raw data in one pass.
+func (s *SummaryService) GetRangeSummary(ctx context.Context, householdID int, from, to time.Time) (*domain.RangeSummary, error) {
+	from = time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: from must not be after to", domain.ErrValidation)
+	}
+	monthCount := (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+	if monthCount > domain.MaxRangeMonths {
+		return nil, fmt.Errorf("%w: range must not exceed %d months", domain.ErrValidation, domain.MaxRangeMonths)
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	months := make([]time.Time, monthCount)
+	for i := range months {
+		months[i] = from.AddDate(0, i, 0)
+	}
+	aggregates, err := s.monthlyAggregates(ctx, householdID, months, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get one-time transactions for the month
-	transactions, err := s.txRepo.ListByHouseholdAndMonth(ctx, householdID, year, month)
+	result := &domain.RangeSummary{
+		HouseholdID:   householdID,
+		From:          from.Format("2006-01"),
+		To:            to.Format("2006-01"),
+		Months:        make([]domain.MonthTotals, 0, monthCount),
+		TotalIncome:   decimal.Zero,
+		TotalExpenses: decimal.Zero,
+		Total:         decimal.Zero,
+	}
+
+	series := make(map[int]*domain.CategorySeries)
+	for i, m := range months {
+		agg := aggregates[m.Format("2006-01")]
+
+		result.Months = append(result.Months, domain.MonthTotals{
+			Month:     agg.Month,
+			Income:    agg.GrossIncome,
+			Expenses:  agg.GrossExpenses,
+			Recurring: agg.RecurringTotal,
+			OneTime:   agg.OneTimeTotal,
+			Total:     agg.MonthlyTotal,
+		})
+		result.TotalIncome = result.TotalIncome.Add(agg.GrossIncome)
+		result.TotalExpenses = result.TotalExpenses.Add(agg.GrossExpenses)
+		result.Total = result.Total.Add(agg.MonthlyTotal)
+
+		for _, cs := range agg.Categories {
+			cat, ok := series[cs.CategoryID]
+			if !ok {
+				cat = &domain.CategorySeries{
+					CategoryID:   cs.CategoryID,
+					CategoryName: cs.CategoryName,
+					Values:       make([]domain.Money, monthCount),
+					Total:        decimal.Zero,
+				}
+				for j := range cat.Values {
+					cat.Values[j] = decimal.Zero
+				}
+				series[cs.CategoryID] = cat
+			}
+			cat.Values[i] = cs.Total
+			cat.Total = cat.Total.Add(cs.Total)
+		}
+	}
+
+	n := decimal.NewFromInt(int64(monthCount))
+	result.AverageIncome = result.TotalIncome.Div(n).Round(2)
+	result.AverageExpenses = result.TotalExpenses.Div(n).Round(2)
+	result.AverageTotal = result.Total.Div(n).Round(2)
+
+	result.Categories = make([]domain.CategorySeries, 0, len(series))
+	for _, cat := range series {
+		cat.Average = cat.Total.Div(n).Round(2)
+		result.Categories = append(result.Categories, *cat)
+	}
+	sort.Slice(result.Categories, func(i, j int) bool {
+		return result.Categories[i].CategoryName < result.Categories[j].CategoryName
+	})
+
+	return result, nil
+}
+
+// ComparePeriods compares recurring and one-time amounts per category between
+// a base period and a current period.
+func (s *SummaryService) ComparePeriods(ctx context.Context, householdID int, base, current domain.Period) (*domain.PeriodComparison, error) {
+	if base.Start.Before(current.End()) && current.Start.Before(base.End()) {
+		return nil, fmt.Errorf("%w: periods must not overlap", domain.ErrValidation)
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	months := append(base.MonthStarts(), current.MonthStarts()...)
+	aggregates, err := s.monthlyAggregates(ctx, householdID, months, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b := sumPeriod(aggregates, base)
+	c := sumPeriod(aggregates, current)
+
+	result := &domain.PeriodComparison{
+		HouseholdID: householdID,
+		Base:        base.String(),
+		Current:     current.String(),
+		Income:      domain.NewAmountDelta(b.income, c.income),
+		Expenses:    domain.NewAmountDelta(b.expenses, c.expenses),
+		Total:       domain.NewAmountDelta(b.income.Add(b.expenses), c.income.Add(c.expenses)),
+	}
+
+	catIDs := make(map[int]bool)
+	for id := range b.categories {
+		catIDs[id] = true
+	}
+	for id := range c.categories {
+		catIDs[id] = true
+	}
+
+	for id := range catIDs {
+		bc, inBase := b.categories[id]
+		cc, inCurrent := c.categories[id]
+		name := cc.CategoryName
+		if !inCurrent {
+			name = bc.CategoryName
+		}
+		cmp := domain.CategoryComparison{
+			CategoryID:   id,
+			CategoryName: name,
+			Recurring:    domain.NewAmountDelta(bc.Recurring, cc.Recurring),
+			OneTime:      domain.NewAmountDelta(bc.OneTime, cc.OneTime),
+			Total:        domain.NewAmountDelta(bc.Total, cc.Total),
+		}
+		switch {
+		case inBase && inCurrent:
+			cmp.Status = domain.ComparisonCommon
+			result.Categories = append(result.Categories, cmp)
+		case inCurrent:
+			cmp.Status = domain.ComparisonNew
+			result.NewCategories = append(result.NewCategories, cmp)
+		default:
+			cmp.Status = domain.ComparisonVanished
+			result.VanishedCategories = append(result.VanishedCategories, cmp)
+		}
+	}
+
+	for _, list := range [][]domain.CategoryComparison{result.Categories, result.NewCategories, result.VanishedCategories} {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].CategoryName < list[j].CategoryName
+		})
+	}
+
+	return result, nil
+}
+
+// summaryData holds everything needed to build monthly summaries of a
+// household from memory.
+type summaryData struct {
+	householdID int
+	recurring   []*domain.RecurringExpense
+	overrides   map[int][]*domain.RecurringScheduleOverride
+	catMap      map[int]string
+	categories  map[int]*domain.Category
+	sumsByMonth map[string][]*domain.TransactionSum
+}
+
+// periodTotals are the aggregated amounts of all months in a period.
+type periodTotals struct {
+	income     domain.Money
+	expenses   domain.Money
+	categories map[int]domain.CategorySummary
+}
+
+// monthlyAggregates returns the aggregates of the given months keyed by
+// YYYY-MM. Stored aggregates are used as they are; missing ones are computed
+// from the raw data, loading transactions once per run of consecutive months,
+// and stored for later requests unless the data changed in the meantime. The
+// caller must have checked access. data holds the household's recurring items
+// and categories if the caller loaded them already, and is loaded when needed
+// if nil.
+func (s *SummaryService) monthlyAggregates(ctx context.Context, householdID int, months []time.Time, data *summaryData) (map[string]*domain.MonthlyAggregate, error) {
+	result := make(map[string]*domain.MonthlyAggregate, len(months))
+	keys := make([]string, len(months))
+	for i, m := range months {
+		keys[i] = m.Format("2006-01")
+	}
+
+	var generation int
+	if s.aggregateRepo != nil {
+		var err error
+		generation, err = s.aggregateRepo.Generation(ctx, householdID)
+		if err != nil {
+			return nil, err
+		}
+		stored, err := s.aggregateRepo.ListByHouseholdAndMonths(ctx, householdID, keys)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range stored {
+			result[a.Month] = a
+		}
+	}
+
+	var missing []time.Time
+	seen := make(map[string]bool, len(months))
+	for i, m := range months {
+		if result[keys[i]] == nil && !seen[keys[i]] {
+			seen[keys[i]] = true
+			missing = append(missing, m)
+		}
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].Before(missing[j]) })
+
+	if data == nil {
+		var err error
+		if data, err = s.loadSummaryData(ctx, householdID); err != nil {
+			return nil, err
+		}
+	}
+	for start := 0; start < len(missing); {
+		end := start + 1
+		for end < len(missing) && missing[end].Equal(missing[end-1].AddDate(0, 1, 0)) {
+			end++
+		}
+		if err := s.loadTransactions(ctx, data, missing[start], missing[end-1].AddDate(0, 1, 0)); err != nil {
+			return nil, err
+		}
+		start = end
+	}
+
+	computed := make([]*domain.MonthlyAggregate, 0, len(missing))
+	for _, m := range missing {
+		agg := domain.NewMonthlyAggregate(data.month(m.Year(), m.Month()))
+		result[agg.Month] = agg
+		computed = append(computed, agg)
+	}
+	if s.aggregateRepo != nil {
+		// Not stored if a write invalidated the household meanwhile; the
+		// values may be stale then, but the next request computes them again
+		if _, err := s.aggregateRepo.Save(ctx, householdID, generation, computed); err != nil {
+			return nil, fmt.Errorf("storing aggregates: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// RebuildAggregates recomputes and stores the monthly aggregates of the given
+// households for every month from their first transaction or recurring start
+// up to the later of their last transaction and the month of until. It does
+// not check access and is meant for maintenance commands. It returns the
+// number of months stored.
+func (s *SummaryService) RebuildAggregates(ctx context.Context, householdIDs []int, until time.Time) (int, error) {
+	if s.aggregateRepo == nil {
+		return 0, nil
+	}
+
+	until = time.Date(until.Year(), until.Month(), 1, 0, 0, 0, 0, time.UTC)
+	stored := 0
+	for _, id := range householdIDs {
+		generation, err := s.aggregateRepo.Generation(ctx, id)
+		if err != nil {
+			return stored, err
+		}
+		data, err := s.loadSummaryData(ctx, id)
+		if err != nil {
+			return stored, err
+		}
+		sums, err := s.txRepo.SumByMonthAndCategory(ctx, []int{id}, time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			return stored, err
+		}
+		data.addSums(sums)
+
+		var first time.Time
+		last := until
+		for _, sum := range sums {
+			m, err := time.Parse("2006-01", sum.Month)
+			if err != nil {
+				continue
+			}
+			if first.IsZero() || m.Before(first) {
+				first = m
+			}
+			if m.After(last) {
+				last = m
+			}
+		}
+		for _, re := range data.recurring {
+			if first.IsZero() || re.StartDate.Before(first) {
+				first = re.StartDate
+			}
+		}
+		if first.IsZero() {
+			continue
+		}
+
+		var aggregates []*domain.MonthlyAggregate
+		m := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, time.UTC)
+		for !m.After(last) {
+			aggregates = append(aggregates, domain.NewMonthlyAggregate(data.month(m.Year(), m.Month())))
+			m = m.AddDate(0, 1, 0)
+		}
+		ok, err := s.aggregateRepo.Save(ctx, id, generation, aggregates)
+		if err != nil {
+			return stored, fmt.Errorf("storing aggregates of household %d: %w", id, err)
+		}
+		if ok {
+			stored += len(aggregates)
+		}
+	}
+	return stored, nil
+}
+
+// authorizeHouseholds checks access to all given households. The user's own
+// households are listed once; only IDs not among them are looked up one by
+// one, so GetByID reports whether they are missing or forbidden.
+func (s *SummaryService) authorizeHouseholds(ctx context.Context, householdIDs []int) error {
+	owned, err := s.household.List(ctx)
+	if err != nil {
+		return err
+	}
+	allowed := make(map[int]bool, len(owned))
+	for _, hh := range owned {
+		allowed[hh.ID] = true
+	}
+	for _, id := range householdIDs {
+		if allowed[id] {
+			continue
+		}
+		if _, err := s.household.GetByID(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SummaryService) loadSummaryData(ctx context.Context, householdID int) (*summaryData, error) {
+	batch, err := s.loadSummaryDataBatch(ctx, []int{householdID})
 	if err != nil {
 		return nil, err
 	}
+	return batch[householdID], nil
+}
 
-	// Get all categories for the household
-	categories, err := s.categoryRepo.ListByHousehold(ctx, householdID)
+// loadSummaryDataBatch loads recurring items, their overrides and the
+// categories of all given households with one query each. The result has an
+// entry for every household ID. Category and override maps are keyed by
+// globally unique IDs and therefore shared between the households.
+func (s *SummaryService) loadSummaryDataBatch(ctx context.Context, householdIDs []int) (map[int]*summaryData, error) {
+	// Get active recurring expenses
+	recurring, err := s.recurringRepo.ListActiveByHouseholds(ctx, householdIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	catMap := make(map[int]string)
+	// Get all categories of the households
+	categories, err := s.categoryRepo.ListByHouseholds(ctx, householdIDs)
+	if err != nil {
+		return nil, err
+	}
+	catMap := make(map[int]string, len(categories))
+	catByID := make(map[int]*domain.Category, len(categories))
 	for _, c := range categories {
 		catMap[c.ID] = c.Name
+		catByID[c.ID] = c
+	}
+
+	overrides, err := s.loadOverrides(ctx, recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]*summaryData, len(householdIDs))
+	for _, id := range householdIDs {
+		result[id] = &summaryData{
+			householdID: id,
+			overrides:   overrides,
+			catMap:      catMap,
+			categories:  catByID,
+			sumsByMonth: make(map[string][]*domain.TransactionSum),
+		}
+	}
+	for _, re := range recurring {
+		if d, ok := result[re.HouseholdID]; ok {
+			d.recurring = append(d.recurring, re)
+		}
+	}
+	return result, nil
+}
+
+// loadTransactions adds the sums of the one-time transactions with
+// from <= date < to.
+func (s *SummaryService) loadTransactions(ctx context.Context, data *summaryData, from, to time.Time) error {
+	return s.loadTransactionsBatch(ctx, map[int]*summaryData{data.householdID: data}, []int{data.householdID}, from, to)
+}
+
+// loadTransactionsBatch adds the sums of the one-time transactions with
+// from <= date < to of all households in batch with a single query.
+func (s *SummaryService) loadTransactionsBatch(ctx context.Context, batch map[int]*summaryData, householdIDs []int, from, to time.Time) error {
+	sums, err := s.txRepo.SumByMonthAndCategory(ctx, householdIDs, from, to)
+	if err != nil {
+		return err
+	}
+	for _, sum := range sums {
+		if d, ok := batch[sum.HouseholdID]; ok {
+			d.addSums([]*domain.TransactionSum{sum})
+		}
 	}
+	return nil
+}
+
+// loadOverrides returns the schedule overrides of each recurring item keyed by
+// its ID, loaded with a single query.
+func (s *SummaryService) loadOverrides(ctx context.Context, recurring []*domain.RecurringExpense) (map[int][]*domain.RecurringScheduleOverride, error) {
+	result := make(map[int][]*domain.RecurringScheduleOverride)
+	if s.overrideRepo == nil || len(recurring) == 0 {
+		return result, nil
+	}
+	ids := make([]int, len(recurring))
+	for i, re := range recurring {
+		ids[i] = re.ID
+	}
+	overrides, err := s.overrideRepo.ListByRecurringExpenses(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("loading schedule overrides: %w", err)
+	}
+	for _, o := range overrides {
+		result[o.RecurringExpenseID] = append(result[o.RecurringExpenseID], o)
+	}
+	return result, nil
+}
+
+func (d *summaryData) addSums(sums []*domain.TransactionSum) {
+	for _, sum := range sums {
+		d.sumsByMonth[sum.Month] = append(d.sumsByMonth[sum.Month], sum)
+	}
+}
+
+func (d *summaryData) month(year int, month time.Month) *domain.MonthlySummary {
+	key := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+	return buildMonthlySummary(d.householdID, year, month, d.recurring, d.overrides, d.sumsByMonth[key], d.catMap)
+}
+
+// sumPeriod adds up the aggregates of all months in p.
+func sumPeriod(aggregates map[string]*domain.MonthlyAggregate, p domain.Period) periodTotals {
+	totals := periodTotals{
+		income:     decimal.Zero,
+		expenses:   decimal.Zero,
+		categories: make(map[int]domain.CategorySummary),
+	}
+	for _, m := range p.MonthStarts() {
+		agg := aggregates[m.Format("2006-01")]
+		totals.income = totals.income.Add(agg.GrossIncome)
+		totals.expenses = totals.expenses.Add(agg.GrossExpenses)
+		for _, cs := range agg.Categories {
+			acc, ok := totals.categories[cs.CategoryID]
+			if !ok {
+				acc = domain.CategorySummary{
+					CategoryID:   cs.CategoryID,
+					CategoryName: cs.CategoryName,
+					Recurring:    decimal.Zero,
+					OneTime:      decimal.Zero,
+					Total:        decimal.Zero,
+				}
+			}
+			acc.Recurring = acc.Recurring.Add(cs.Recurring)
+			acc.OneTime = acc.OneTime.Add(cs.OneTime)
+			acc.Total = acc.Total.Add(cs.Total)
+			totals.categories[cs.CategoryID] = acc
+		}
+	}
+	return totals
+}
+
+func buildMonthlySummary(
+	householdID int,
+	year int,
+	month time.Month,
+	recurring []*domain.RecurringExpense,
+	overrides map[int][]*domain.RecurringScheduleOverride,
+	sums []*domain.TransactionSum,
+	catMap map[int]string,
+) *domain.MonthlySummary {
+	refMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
 
 	// Build category breakdown
 	catRecurring := make(map[int]decimal.Decimal)
@@ -81,11 +602,8 @@ func (s *SummaryService) GetMonthlySummary(ctx context.Context, householdID int,
 		// Check for schedule overrides
 		amount := re.Amount
 		freq := re.Frequency
-		if s.overrideRepo != nil {
-			overrides, err := s.overrideRepo.ListByRecurringExpense(ctx, re.ID)
-			if err == nil && len(overrides) > 0 {
-				amount, freq = domain.EffectiveSchedule(re.Amount, re.Frequency, overrides, year, month)
-			}
+		if o := overrides[re.ID]; len(o) > 0 {
+			amount, freq = domain.EffectiveSchedule(re.Amount, re.Frequency, o, year, month)
 		}
 
 		monthly, err := domain.NormalizeToMonthly(amount, freq, refMonth)
@@ -143,15 +661,12 @@ func (s *SummaryService) GetMonthlySummary(ctx context.Context, householdID int,
 	totalIncome := decimal.Zero
 	totalExpenses := decimal.Zero
 
-	for _, tx := range transactions {
-		catOneTime[tx.CategoryID] = catOneTime[tx.CategoryID].Add(tx.Amount)
-		totalOneTime = totalOneTime.Add(tx.Amount)
-
-		if tx.Amount.IsPositive() {
-			totalIncome = totalIncome.Add(tx.Amount)
-		} else {
-			totalExpenses = totalExpenses.Add(tx.Amount)
-		}
+	for _, sum := range sums {
+		amount := sum.Income.Add(sum.Expenses)
+		catOneTime[sum.CategoryID] = catOneTime[sum.CategoryID].Add(amount)
+		totalOneTime = totalOneTime.Add(amount)
+		totalIncome = totalIncome.Add(sum.Income)
+		totalExpenses = totalExpenses.Add(sum.Expenses)
 	}
 
 	// Build breakdown
@@ -194,5 +709,5 @@ func (s *SummaryService) GetMonthlySummary(ctx context.Context, householdID int,
 		RecurringGroups:         recurringGroups,
 		IncomeRecurringEntries:  incomeRecurringEntries,
 		ExpenseRecurringEntries: expenseRecurringEntries,
-	}, nil
+	}
 }
@@ -1,10 +1,14 @@
 package service_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
 )
 
 func TestGetMonthlySummary(t *testing.T) {
@@ -32,8 +36,8 @@ func TestGetMonthlySummary(t *testing.T) {
 	t.Run("only one-time transactions", func(t *testing.T) {
 		expense, _ := domain.NewMoney("-50.00")
 		income, _ := domain.NewMoney("1000.00")
-		svc.Transaction.Create(ctx, hh.ID, cat.ID, expense, "Groceries", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC))
-		svc.Transaction.Create(ctx, hh.ID, cat.ID, income, "Salary", "", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		svc.Transaction.Create(ctx, hh.ID, cat.ID, expense, "Groceries", "", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), nil)
+		svc.Transaction.Create(ctx, hh.ID, cat.ID, income, "Salary", "", "", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
 
 		summary, err := svc.Summary.GetMonthlySummary(ctx, hh.ID, 2026, time.January)
 		if err != nil {
@@ -56,10 +60,10 @@ func TestGetMonthlySummary(t *testing.T) {
 	t.Run("only recurring expenses", func(t *testing.T) {
 		// Use a fresh household to avoid interference
 		hh2, _ := svc.Household.Create(ctx, "Summary Test 2", "", "EUR", "")
-		cat2, _ := svc.Category.Create(ctx, hh2.ID, "Bills", "")
+		cat2, _ := svc.Category.Create(ctx, hh2.ID, "Bills", "", "")
 
 		recurAmount, _ := domain.NewMoney("-800.00")
-		svc.RecurringExpense.Create(ctx, hh2.ID, cat2.ID, "Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		svc.RecurringExpense.Create(ctx, hh2.ID, cat2.ID, "Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 		summary, err := svc.Summary.GetMonthlySummary(ctx, hh2.ID, 2026, time.January)
 		if err != nil {
@@ -77,13 +81,13 @@ func TestGetMonthlySummary(t *testing.T) {
 
 	t.Run("mixed recurring and one-time", func(t *testing.T) {
 		hh3, _ := svc.Household.Create(ctx, "Summary Test 3", "", "EUR", "")
-		cat3, _ := svc.Category.Create(ctx, hh3.ID, "Mixed", "")
+		cat3, _ := svc.Category.Create(ctx, hh3.ID, "Mixed", "", "")
 
 		recurAmount, _ := domain.NewMoney("-800.00")
-		svc.RecurringExpense.Create(ctx, hh3.ID, cat3.ID, "Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		svc.RecurringExpense.Create(ctx, hh3.ID, cat3.ID, "Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 		oneTime, _ := domain.NewMoney("-50.00")
-		svc.Transaction.Create(ctx, hh3.ID, cat3.ID, oneTime, "Extra", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+		svc.Transaction.Create(ctx, hh3.ID, cat3.ID, oneTime, "Extra", "", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), nil)
 
 		summary, err := svc.Summary.GetMonthlySummary(ctx, hh3.ID, 2026, time.January)
 		if err != nil {
@@ -106,17 +110,17 @@ func TestGetMonthlySummary(t *testing.T) {
 
 	t.Run("recurring income and expenses split", func(t *testing.T) {
 		hh5, _ := svc.Household.Create(ctx, "Summary Test 5", "", "EUR", "")
-		cat5, _ := svc.Category.Create(ctx, hh5.ID, "Split", "")
+		cat5, _ := svc.Category.Create(ctx, hh5.ID, "Split", "", "")
 
 		recurExpense, _ := domain.NewMoney("-800.00")
 		recurIncome, _ := domain.NewMoney("3000.00")
-		svc.RecurringExpense.Create(ctx, hh5.ID, cat5.ID, "Rent", "", "", recurExpense, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
-		svc.RecurringExpense.Create(ctx, hh5.ID, cat5.ID, "Salary", "", "", recurIncome, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		svc.RecurringExpense.Create(ctx, hh5.ID, cat5.ID, "Rent", "", "", recurExpense, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
+		svc.RecurringExpense.Create(ctx, hh5.ID, cat5.ID, "Salary", "", "", recurIncome, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 		oneTimeExpense, _ := domain.NewMoney("-50.00")
 		oneTimeIncome, _ := domain.NewMoney("200.00")
-		svc.Transaction.Create(ctx, hh5.ID, cat5.ID, oneTimeExpense, "Groceries", "", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
-		svc.Transaction.Create(ctx, hh5.ID, cat5.ID, oneTimeIncome, "Refund", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC))
+		svc.Transaction.Create(ctx, hh5.ID, cat5.ID, oneTimeExpense, "Groceries", "", "", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), nil)
+		svc.Transaction.Create(ctx, hh5.ID, cat5.ID, oneTimeIncome, "Refund", "", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), nil)
 
 		summary, err := svc.Summary.GetMonthlySummary(ctx, hh5.ID, 2026, time.January)
 		if err != nil {
@@ -148,10 +152,10 @@ func TestGetMonthlySummary(t *testing.T) {
 
 	t.Run("recurring with schedule override", func(t *testing.T) {
 		hh6, _ := svc.Household.Create(ctx, "Summary Test 6", "", "EUR", "")
-		cat6, _ := svc.Category.Create(ctx, hh6.ID, "Override", "")
+		cat6, _ := svc.Category.Create(ctx, hh6.ID, "Override", "", "")
 
 		recurAmount, _ := domain.NewMoney("-800.00")
-		re, _ := svc.RecurringExpense.Create(ctx, hh6.ID, cat6.ID, "Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil)
+		re, _ := svc.RecurringExpense.Create(ctx, hh6.ID, cat6.ID, "Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 		// Add override: from April, rent increases to -900
 		overrideAmount, _ := domain.NewMoney("-900.00")
@@ -180,11 +184,11 @@ func TestGetMonthlySummary(t *testing.T) {
 
 	t.Run("recurring not started yet excluded", func(t *testing.T) {
 		hhF, _ := svc.Household.Create(ctx, "Summary Test Future", "", "EUR", "")
-		catF, _ := svc.Category.Create(ctx, hhF.ID, "Future", "")
+		catF, _ := svc.Category.Create(ctx, hhF.ID, "Future", "", "")
 
 		recurAmount, _ := domain.NewMoney("-500.00")
 		// Recurring starts in March 2026
-		svc.RecurringExpense.Create(ctx, hhF.ID, catF.ID, "Future Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), nil)
+		svc.RecurringExpense.Create(ctx, hhF.ID, catF.ID, "Future Rent", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), nil, nil)
 
 		// January summary should not include it
 		summary, err := svc.Summary.GetMonthlySummary(ctx, hhF.ID, 2026, time.January)
@@ -208,11 +212,11 @@ func TestGetMonthlySummary(t *testing.T) {
 
 	t.Run("recurring already ended excluded", func(t *testing.T) {
 		hhE, _ := svc.Household.Create(ctx, "Summary Test Ended", "", "EUR", "")
-		catE, _ := svc.Category.Create(ctx, hhE.ID, "Ended", "")
+		catE, _ := svc.Category.Create(ctx, hhE.ID, "Ended", "", "")
 
 		recurAmount, _ := domain.NewMoney("-300.00")
 		endDate := time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)
-		svc.RecurringExpense.Create(ctx, hhE.ID, catE.ID, "Old Sub", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &endDate)
+		svc.RecurringExpense.Create(ctx, hhE.ID, catE.ID, "Old Sub", "", "", recurAmount, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &endDate, nil)
 
 		// February should include it
 		summaryFeb, err := svc.Summary.GetMonthlySummary(ctx, hhE.ID, 2026, time.February)
@@ -236,13 +240,13 @@ func TestGetMonthlySummary(t *testing.T) {
 
 	t.Run("category breakdown", func(t *testing.T) {
 		hh4, _ := svc.Household.Create(ctx, "Summary Test 4", "", "EUR", "")
-		catA, _ := svc.Category.Create(ctx, hh4.ID, "Food", "")
-		catB, _ := svc.Category.Create(ctx, hh4.ID, "Transport", "")
+		catA, _ := svc.Category.Create(ctx, hh4.ID, "Food", "", "")
+		catB, _ := svc.Category.Create(ctx, hh4.ID, "Transport", "", "")
 
 		amountA, _ := domain.NewMoney("-100.00")
 		amountB, _ := domain.NewMoney("-200.00")
-		svc.Transaction.Create(ctx, hh4.ID, catA.ID, amountA, "Groceries", "", time.Date(2026, 2, 5, 0, 0, 0, 0, time.UTC))
-		svc.Transaction.Create(ctx, hh4.ID, catB.ID, amountB, "Gas", "", time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC))
+		svc.Transaction.Create(ctx, hh4.ID, catA.ID, amountA, "Groceries", "", "", time.Date(2026, 2, 5, 0, 0, 0, 0, time.UTC), nil)
+		svc.Transaction.Create(ctx, hh4.ID, catB.ID, amountB, "Gas", "", "", time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), nil)
 
 		summary, err := svc.Summary.GetMonthlySummary(ctx, hh4.ID, 2026, time.February)
 		if err != nil {
@@ -254,3 +258,313 @@ func TestGetMonthlySummary(t *testing.T) {
 		}
 	})
 }
+
+func TestGetRangeSummary(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	bills, _ := svc.Category.Create(ctx, hh.ID, "Bills", "", "")
+
+	rent, _ := domain.NewMoney("-800.00")
+	re, _ := svc.RecurringExpense.Create(ctx, hh.ID, bills.ID, "Rent", "", "", rent, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
+	raised, _ := domain.NewMoney("-900.00")
+	svc.RecurringExpense.CreateOverride(ctx, re.ID, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), raised, domain.FrequencyMonthly)
+
+	groceries, _ := domain.NewMoney("-60.00")
+	salary, _ := domain.NewMoney("3000.00")
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "Groceries", "", "", time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, salary, "Salary", "", "", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, groceries, "Outside", "", "", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), nil)
+
+	t.Run("per month totals and series", func(t *testing.T) {
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+		summary, err := svc.Summary.GetRangeSummary(ctx, hh.ID, from, to)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if summary.From != "2026-01" || summary.To != "2026-03" {
+			t.Errorf("range = %s..%s, want 2026-01..2026-03", summary.From, summary.To)
+		}
+		if len(summary.Months) != 3 {
+			t.Fatalf("len(Months) = %d, want 3", len(summary.Months))
+		}
+
+		wantTotals := []string{"-860", "2200", "-900"}
+		for i, want := range wantTotals {
+			w, _ := domain.NewMoney(want)
+			if !summary.Months[i].Total.Equal(w) {
+				t.Errorf("Months[%d].Total = %s, want %s", i, summary.Months[i].Total.String(), want)
+			}
+		}
+
+		wantTotal, _ := domain.NewMoney("440")
+		if !summary.Total.Equal(wantTotal) {
+			t.Errorf("Total = %s, want %s", summary.Total.String(), wantTotal.String())
+		}
+		wantAvg, _ := domain.NewMoney("146.67")
+		if !summary.AverageTotal.Equal(wantAvg) {
+			t.Errorf("AverageTotal = %s, want %s", summary.AverageTotal.String(), wantAvg.String())
+		}
+
+		if len(summary.Categories) != 2 {
+			t.Fatalf("len(Categories) = %d, want 2", len(summary.Categories))
+		}
+		billSeries := summary.Categories[0]
+		if billSeries.CategoryName != "Bills" {
+			t.Fatalf("Categories[0] = %q, want Bills", billSeries.CategoryName)
+		}
+		wantBills := []string{"-800", "-800", "-900"}
+		for i, want := range wantBills {
+			w, _ := domain.NewMoney(want)
+			if !billSeries.Values[i].Equal(w) {
+				t.Errorf("Bills[%d] = %s, want %s", i, billSeries.Values[i].String(), want)
+			}
+		}
+	})
+
+	t.Run("from after to", func(t *testing.T) {
+		_, err := svc.Summary.GetRangeSummary(ctx, hh.ID, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("range too long", func(t *testing.T) {
+		_, err := svc.Summary.GetRangeSummary(ctx, hh.ID, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
+
+func TestComparePeriods(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	energy, _ := svc.Category.Create(ctx, hh.ID, "Energy", "", "")
+	hobby, _ := svc.Category.Create(ctx, hh.ID, "Hobby", "", "")
+	travel, _ := svc.Category.Create(ctx, hh.ID, "Travel", "", "")
+
+	power, _ := domain.NewMoney("-100.00")
+	re, _ := svc.RecurringExpense.Create(ctx, hh.ID, energy.ID, "Power", "", "", power, domain.FrequencyMonthly, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
+	raised, _ := domain.NewMoney("-120.00")
+	svc.RecurringExpense.CreateOverride(ctx, re.ID, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), raised, domain.FrequencyMonthly)
+
+	lessons, _ := domain.NewMoney("-50.00")
+	flight, _ := domain.NewMoney("-400.00")
+	svc.Transaction.Create(ctx, hh.ID, hobby.ID, lessons, "Lessons", "", "", time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, travel.ID, flight, "Flight", "", "", time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), nil)
+
+	t.Run("quarter year over year", func(t *testing.T) {
+		base, _ := domain.ParsePeriod("2024-Q1")
+		current, _ := domain.ParsePeriod("2025-Q1")
+		cmp, err := svc.Summary.ComparePeriods(ctx, hh.ID, base, current)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cmp.Base != "2024-Q1" || cmp.Current != "2025-Q1" {
+			t.Errorf("labels = %s/%s", cmp.Base, cmp.Current)
+		}
+
+		if len(cmp.Categories) != 1 || cmp.Categories[0].CategoryName != "Energy" {
+			t.Fatalf("Categories = %+v, want Energy only", cmp.Categories)
+		}
+		e := cmp.Categories[0]
+		wantDelta, _ := domain.NewMoney("-60")
+		if !e.Recurring.Delta.Equal(wantDelta) {
+			t.Errorf("Energy recurring delta = %s, want %s", e.Recurring.Delta.String(), wantDelta.String())
+		}
+		if e.Recurring.Percent == nil || e.Recurring.Percent.String() != "20" {
+			t.Errorf("Energy recurring percent = %v, want 20", e.Recurring.Percent)
+		}
+		if e.OneTime.Percent != nil {
+			t.Errorf("Energy one-time percent = %v, want nil", e.OneTime.Percent)
+		}
+
+		if len(cmp.NewCategories) != 1 || cmp.NewCategories[0].CategoryID != travel.ID {
+			t.Errorf("NewCategories = %+v, want Travel", cmp.NewCategories)
+		}
+		if len(cmp.VanishedCategories) != 1 || cmp.VanishedCategories[0].CategoryID != hobby.ID {
+			t.Errorf("VanishedCategories = %+v, want Hobby", cmp.VanishedCategories)
+		}
+
+		wantExpenses, _ := domain.NewMoney("-760")
+		if !cmp.Expenses.Current.Equal(wantExpenses) {
+			t.Errorf("Expenses.Current = %s, want %s", cmp.Expenses.Current.String(), wantExpenses.String())
+		}
+	})
+
+	t.Run("overlapping periods", func(t *testing.T) {
+		base, _ := domain.ParsePeriod("2025")
+		current, _ := domain.ParsePeriod("2025-03")
+		_, err := svc.Summary.ComparePeriods(ctx, hh.ID, base, current)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
+
+// addRecurringWithOverride adds n monthly recurring items, each with a
+// schedule override, to the household.
+func addRecurringWithOverride(t testing.TB, svc *testServices, ctx context.Context, householdID, categoryID, n int) {
+	t.Helper()
+	amount, _ := domain.NewMoney("-10")
+	changed, _ := domain.NewMoney("-12")
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < n; i++ {
+		re, err := svc.RecurringExpense.Create(ctx, householdID, categoryID, fmt.Sprintf("Item %d", i), "", "", amount, domain.FrequencyMonthly, start, nil, nil)
+		if err != nil {
+			t.Fatalf("failed to create recurring expense: %v", err)
+		}
+		if _, err := svc.RecurringExpense.CreateOverride(ctx, re.ID, start.AddDate(0, 6, 0), changed, domain.FrequencyMonthly); err != nil {
+			t.Fatalf("failed to create override: %v", err)
+		}
+	}
+}
+
+// countQueries returns the number of database statements executed by fn.
+func countQueries(svc *testServices, fn func()) int64 {
+	before := svc.queries.Count()
+	fn()
+	return svc.queries.Count() - before
+}
+
+func TestSummaryQueryCount(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+
+	t.Run("monthly summary independent of recurring items", func(t *testing.T) {
+		hh := createTestHousehold(t, svc, ctx)
+		cat := createTestCategory(t, svc, ctx, hh.ID)
+		addRecurringWithOverride(t, svc, ctx, hh.ID, cat.ID, 1)
+
+		summarize := func() {
+			if _, err := svc.Summary.GetMonthlySummary(ctx, hh.ID, 2026, time.January); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		// Both counts compute the month; the first call also starts the
+		// household's aggregate generation
+		summarize()
+		svc.client.MonthlyAggregate.Delete().ExecX(ctx)
+		few := countQueries(svc, summarize)
+		addRecurringWithOverride(t, svc, ctx, hh.ID, cat.ID, 20)
+		many := countQueries(svc, summarize)
+
+		if few != many {
+			t.Errorf("queries = %d with 1 item, %d with 21 items, want equal", few, many)
+		}
+	})
+
+	t.Run("multiple households independent of household count", func(t *testing.T) {
+		var ids []int
+		addHousehold := func() {
+			hh := createTestHousehold(t, svc, ctx)
+			cat := createTestCategory(t, svc, ctx, hh.ID)
+			addRecurringWithOverride(t, svc, ctx, hh.ID, cat.ID, 3)
+			ids = append(ids, hh.ID)
+		}
+		summarize := func() {
+			summaries, err := svc.Summary.GetMonthlySummaries(ctx, ids, 2026, time.January)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(summaries) != len(ids) {
+				t.Fatalf("len(summaries) = %d, want %d", len(summaries), len(ids))
+			}
+		}
+
+		addHousehold()
+		few := countQueries(svc, summarize)
+		for i := 0; i < 5; i++ {
+			addHousehold()
+		}
+		many := countQueries(svc, summarize)
+
+		if few != many {
+			t.Errorf("queries = %d with 1 household, %d with 6 households, want equal", few, many)
+		}
+	})
+}
+
+func TestGetMonthlySummaries(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+
+	hh1 := createTestHousehold(t, svc, ctx)
+	cat1 := createTestCategory(t, svc, ctx, hh1.ID)
+	hh2 := createTestHousehold(t, svc, ctx)
+	cat2 := createTestCategory(t, svc, ctx, hh2.ID)
+
+	rent, _ := domain.NewMoney("-800")
+	svc.RecurringExpense.Create(ctx, hh1.ID, cat1.ID, "Rent", "", "", rent, domain.FrequencyMonthly, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
+	groceries, _ := domain.NewMoney("-60")
+	svc.Transaction.Create(ctx, hh2.ID, cat2.ID, groceries, "Groceries", "", "", time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), nil)
+
+	summaries, err := svc.Summary.GetMonthlySummaries(ctx, []int{hh1.ID, hh2.ID}, 2026, time.January)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, hh := range []*domain.Household{hh1, hh2} {
+		single, err := svc.Summary.GetMonthlySummary(ctx, hh.ID, 2026, time.January)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		batched := summaries[hh.ID]
+		if batched == nil {
+			t.Fatalf("missing summary for household %d", hh.ID)
+		}
+		if !batched.MonthlyTotal.Equal(single.MonthlyTotal) || !batched.RecurringTotal.Equal(single.RecurringTotal) {
+			t.Errorf("household %d: batched total %s/%s, single %s/%s", hh.ID,
+				batched.MonthlyTotal, batched.RecurringTotal, single.MonthlyTotal, single.RecurringTotal)
+		}
+	}
+	if !summaries[hh1.ID].RecurringTotal.Equal(rent) {
+		t.Errorf("RecurringTotal = %s, want %s", summaries[hh1.ID].RecurringTotal, rent)
+	}
+	if !summaries[hh2.ID].OneTimeTotal.Equal(groceries) {
+		t.Errorf("OneTimeTotal = %s, want %s", summaries[hh2.ID].OneTimeTotal, groceries)
+	}
+
+	t.Run("foreign household", func(t *testing.T) {
+		other, _ := svc.User.GetOrCreate(ctx, "other-sub", "other@example.com", "Other")
+		otherCtx := service.WithUserID(context.Background(), other.ID)
+		_, err := svc.Summary.GetMonthlySummaries(otherCtx, []int{hh1.ID}, 2026, time.January)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("unknown household", func(t *testing.T) {
+		_, err := svc.Summary.GetMonthlySummaries(ctx, []int{99999}, 2026, time.January)
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+// BenchmarkGetMonthlySummary reports the database statements per summary
+// as "queries/op"; the value stays the same for any number of recurring items.
+func BenchmarkGetMonthlySummary(b *testing.B) {
+	for _, n := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("recurring=%d", n), func(b *testing.B) {
+			svc := setupTestServices(b)
+			ctx, _ := createTestUser(b, svc)
+			hh := createTestHousehold(b, svc, ctx)
+			cat := createTestCategory(b, svc, ctx, hh.ID)
+			addRecurringWithOverride(b, svc, ctx, hh.ID, cat.ID, n)
+
+			b.ResetTimer()
+			before := svc.queries.Count()
+			for i := 0; i < b.N; i++ {
+				if _, err := svc.Summary.GetMonthlySummary(ctx, hh.ID, 2026, time.January); err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+			}
+			b.ReportMetric(float64(svc.queries.Count()-before)/float64(b.N), "queries/op")
+		})
+	}
+}
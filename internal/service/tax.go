@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// GetTaxSummary collects all tax relevant transactions and recurring
+// payments due in a calendar year, grouped by tax class. Transactions use their
+// own class or inherit the class of their category; recurring items always use
+// the class of their category.
+func (s *SummaryService) GetTaxSummary(ctx context.Context, householdID, year int) (*domain.TaxSummary, error) {
+	if year < 1 || year > 9999 {
+		return nil, fmt.Errorf("%w: invalid year %d", domain.ErrValidation, year)
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepo.ListByHouseholds(ctx, []int{householdID})
+	if err != nil {
+		return nil, err
+	}
+	catByID := make(map[int]*domain.Category, len(categories))
+	for _, c := range categories {
+		catByID[c.ID] = c
+	}
+	categoryName := func(id int) string {
+		if c, ok := catByID[id]; ok {
+			return c.Name
+		}
+		return ""
+	}
+	categoryTaxClass := func(id int) domain.TaxClass {
+		if c, ok := catByID[id]; ok {
+			return c.TaxClass
+		}
+		return ""
+	}
+
+	from := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+	transactions, err := s.txRepo.ListByHouseholdAndDateRange(ctx, householdID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inactive expenses count up to the day they were deactivated
+	recurring, err := s.recurringRepo.ListByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[int][]*domain.RecurringScheduleOverride)
+	if s.overrideRepo != nil && len(recurring) > 0 {
+		ids := make([]int, len(recurring))
+		for i, re := range recurring {
+			ids[i] = re.ID
+		}
+		list, err := s.overrideRepo.ListByRecurringExpenses(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range list {
+			overrides[o.RecurringExpenseID] = append(overrides[o.RecurringExpenseID], o)
+		}
+	}
+
+	classes := make(map[domain.TaxClass]*domain.TaxClassSummary)
+	classFor := func(tc domain.TaxClass) *domain.TaxClassSummary {
+		cs, ok := classes[tc]
+		if !ok {
+			cs = &domain.TaxClassSummary{
+				Class:     tc,
+				OneTime:   decimal.Zero,
+				Recurring: decimal.Zero,
+				Total:     decimal.Zero,
+			}
+			classes[tc] = cs
+		}
+		return cs
+	}
+
+	for _, tx := range transactions {
+		catClass := categoryTaxClass(tx.CategoryID)
+		tc := domain.EffectiveTaxClass(tx.TaxClass, catClass)
+		if tc == "" {
+			continue
+		}
+		cs := classFor(tc)
+		cs.OneTime = cs.OneTime.Add(tx.Amount)
+		cs.Transactions = append(cs.Transactions, domain.TaxTransaction{
+			TransactionID: tx.ID,
+			Date:          tx.Date,
+			CategoryID:    tx.CategoryID,
+			CategoryName:  categoryName(tx.CategoryID),
+			Description:   tx.Description,
+			Amount:        tx.Amount,
+			Inherited:     tx.TaxClass == "",
+		})
+	}
+
+	for _, re := range recurring {
+		tc := categoryTaxClass(re.CategoryID)
+		if tc == "" {
+			continue
+		}
+		payments, err := domain.Payments(re, overrides[re.ID], from, to)
+		if err != nil {
+			return nil, fmt.Errorf("recurring expense %d: %w", re.ID, err)
+		}
+		for _, p := range payments {
+			cs := classFor(tc)
+			cs.Recurring = cs.Recurring.Add(p.Amount)
+			cs.Occurrences = append(cs.Occurrences, domain.TaxRecurringOccurrence{
+				RecurringExpenseID: re.ID,
+				Name:               re.Name,
+				CategoryID:         re.CategoryID,
+				CategoryName:       categoryName(re.CategoryID),
+				Date:               p.Date,
+				Amount:             p.Amount,
+				Frequency:          p.Frequency,
+			})
+		}
+	}
+
+	result := &domain.TaxSummary{
+		HouseholdID: householdID,
+		Year:        year,
+		Total:       decimal.Zero,
+	}
+	for _, tc := range domain.AllTaxClasses() {
+		cs, ok := classes[tc]
+		if !ok {
+			continue
+		}
+		cs.Total = cs.OneTime.Add(cs.Recurring)
+		sort.SliceStable(cs.Transactions, func(i, j int) bool {
+			return cs.Transactions[i].Date.Before(cs.Transactions[j].Date)
+		})
+		sort.SliceStable(cs.Occurrences, func(i, j int) bool {
+			a, b := cs.Occurrences[i], cs.Occurrences[j]
+			if !a.Date.Equal(b.Date) {
+				return a.Date.Before(b.Date)
+			}
+			return a.Name < b.Name
+		})
+		result.Classes = append(result.Classes, *cs)
+		result.Total = result.Total.Add(cs.Total)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+func TestGetTaxSummary(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	repairs, _ := svc.Category.Create(ctx, hh.ID, "Repairs", "", domain.TaxClassCraftsmanServices)
+	cleaning, _ := svc.Category.Create(ctx, hh.ID, "Cleaning", "", domain.TaxClassHouseholdServices)
+	food, _ := svc.Category.Create(ctx, hh.ID, "Food", "", "")
+
+	plumber, _ := domain.NewMoney("-450.00")
+	tools, _ := domain.NewMoney("-80.00")
+	books, _ := domain.NewMoney("-35.00")
+	groceries, _ := domain.NewMoney("-60.00")
+	svc.Transaction.Create(ctx, hh.ID, repairs.ID, plumber, "Plumber", "", "", time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, repairs.ID, tools, "Own tools", "", domain.TaxClassNone, time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, food.ID, books, "Work books", "", domain.TaxClassIncomeRelated, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, food.ID, groceries, "Groceries", "", "", time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, repairs.ID, plumber, "Last year", "", "", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), nil)
+
+	fee, _ := domain.NewMoney("-100.00")
+	end := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	svc.RecurringExpense.Create(ctx, hh.ID, cleaning.ID, "Cleaner", "", "", fee, domain.FrequencyMonthly, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), &end, nil)
+	svc.RecurringExpense.Create(ctx, hh.ID, food.ID, "Delivery", "", "", fee, domain.FrequencyMonthly, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), nil, nil)
+
+	// A yearly bill due in November, deactivated since
+	chimney, _ := domain.NewMoney("-240.00")
+	sweep, _ := svc.RecurringExpense.Create(ctx, hh.ID, repairs.ID, "Chimney sweep", "", "", chimney, domain.FrequencyYearly, time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC), nil, nil)
+	if _, err := svc.RecurringExpense.Update(ctx, sweep.ID, repairs.ID, sweep.Name, "", "", chimney, domain.FrequencyYearly, false, sweep.StartDate, nil, nil); err != nil {
+		t.Fatalf("deactivating: %v", err)
+	}
+
+	t.Run("classes", func(t *testing.T) {
+		summary, err := svc.Summary.GetTaxSummary(ctx, hh.ID, 2025)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(summary.Classes) != 3 {
+			t.Fatalf("len(Classes) = %d, want 3", len(summary.Classes))
+		}
+
+		craft := summary.Classes[0]
+		if craft.Class != domain.TaxClassCraftsmanServices {
+			t.Fatalf("Classes[0] = %q, want %q", craft.Class, domain.TaxClassCraftsmanServices)
+		}
+		if len(craft.Transactions) != 1 || !craft.Transactions[0].Inherited || !craft.OneTime.Equal(plumber) {
+			t.Errorf("craftsman services = %+v", craft)
+		}
+		wantDue := time.Date(2025, 11, 20, 0, 0, 0, 0, time.UTC)
+		if len(craft.Occurrences) != 1 || !craft.Occurrences[0].Date.Equal(wantDue) || !craft.Recurring.Equal(chimney) {
+			t.Errorf("expected the yearly bill once on its due date, got %+v", craft.Occurrences)
+		}
+
+		household := summary.Classes[1]
+		if household.Class != domain.TaxClassHouseholdServices {
+			t.Fatalf("Classes[1] = %q, want %q", household.Class, domain.TaxClassHouseholdServices)
+		}
+		if len(household.Occurrences) != 4 {
+			t.Fatalf("len(Occurrences) = %d, want 4", len(household.Occurrences))
+		}
+		if got := household.Occurrences[3].Date; !got.Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("last payment on %s, want 2025-04-01", got.Format("2006-01-02"))
+		}
+		wantRecurring, _ := domain.NewMoney("-400")
+		if !household.Recurring.Equal(wantRecurring) {
+			t.Errorf("Recurring = %s, want %s", household.Recurring.String(), wantRecurring.String())
+		}
+
+		income := summary.Classes[2]
+		if income.Class != domain.TaxClassIncomeRelated || len(income.Transactions) != 1 || income.Transactions[0].Inherited {
+			t.Errorf("income related = %+v", income)
+		}
+
+		wantTotal, _ := domain.NewMoney("-1125")
+		if !summary.Total.Equal(wantTotal) {
+			t.Errorf("Total = %s, want %s", summary.Total.String(), wantTotal.String())
+		}
+	})
+
+	t.Run("invalid year", func(t *testing.T) {
+		_, err := svc.Summary.GetTaxSummary(ctx, hh.ID, 0)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+
+	t.Run("invalid tax class", func(t *testing.T) {
+		_, err := svc.Category.Create(ctx, hh.ID, "Bogus", "", "bogus")
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+		_, err = svc.Category.Create(ctx, hh.ID, "None", "", domain.TaxClassNone)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation for none on category, got %v", err)
+		}
+	})
+}
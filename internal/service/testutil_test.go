@@ -2,8 +2,11 @@ package service_test
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"icekalt.dev/money-tracker/ent"
 	"icekalt.dev/money-tracker/internal/config"
 	"icekalt.dev/money-tracker/internal/domain"
@@ -15,16 +18,48 @@ import (
 
 type testServices struct {
 	client           *ent.Client
+	queries          *queryCounter
 	User             *service.UserService
 	Household        *service.HouseholdService
 	Category         *service.CategoryService
 	Transaction      *service.TransactionService
 	RecurringExpense *service.RecurringExpenseService
+	Member           *service.MemberService
+	Settlement       *service.SettlementService
 	Summary          *service.SummaryService
 	APIToken         *service.APITokenService
+	Session          *service.SessionService
+	Admin            *service.AdminService
+	Security         *service.SecurityEventService
+	Revision         *service.RevisionService
+	Trash            *service.TrashService
+	Account          *service.AccountService
+	Identity         *service.IdentityService
 }
 
-func setupTestServices(t *testing.T) *testServices {
+// queryCounter wraps an ent driver and counts the statements sent to the
+// database outside of transactions.
+type queryCounter struct {
+	dialect.Driver
+	n atomic.Int64
+}
+
+func (q *queryCounter) Exec(ctx context.Context, query string, args, v any) error {
+	q.n.Add(1)
+	return q.Driver.Exec(ctx, query, args, v)
+}
+
+func (q *queryCounter) Query(ctx context.Context, query string, args, v any) error {
+	q.n.Add(1)
+	return q.Driver.Query(ctx, query, args, v)
+}
+
+// Count returns the number of statements so far.
+func (q *queryCounter) Count() int64 {
+	return q.n.Load()
+}
+
+func setupTestServices(t testing.TB) *testServices {
 	t.Helper()
 
 	dbCfg := config.DatabaseConfig{
@@ -32,10 +67,12 @@ func setupTestServices(t *testing.T) *testServices {
 		DSN:    "file::memory:?cache=shared&_pragma=foreign_keys(1)",
 	}
 
-	client, err := repository.NewClient(dbCfg)
+	drv, err := repository.OpenDriver(dbCfg)
 	if err != nil {
 		t.Fatalf("failed to create client: %v", err)
 	}
+	queries := &queryCounter{Driver: drv}
+	client := repository.NewClientFromDriver(queries)
 
 	if err := client.Schema.Create(context.Background()); err != nil {
 		t.Fatalf("failed to migrate: %v", err)
@@ -43,19 +80,39 @@ func setupTestServices(t *testing.T) *testServices {
 
 	userRepo := repository.NewUserRepository(client)
 	householdRepo := repository.NewHouseholdRepository(client)
+	transferRepo := repository.NewHouseholdTransferRepository(client)
 	categoryRepo := repository.NewCategoryRepository(client)
 	txRepo := repository.NewTransactionRepository(client)
 	recurringRepo := repository.NewRecurringExpenseRepository(client)
 	overrideRepo := repository.NewRecurringScheduleOverrideRepository(client)
+	aggregateRepo := repository.NewMonthlyAggregateRepository(client)
 	tokenRepo := repository.NewAPITokenRepository(client)
+	memberRepo := repository.NewHouseholdMemberRepository(client)
+	settlementRepo := repository.NewSettlementRepository(client)
+	sessionRepo := repository.NewSessionRepository(client)
+	credentialRepo := repository.NewLocalCredentialRepository(client)
+	identityRepo := repository.NewUserIdentityRepository(client)
+	statsRepo := repository.NewStatsRepository(client, drv)
+	eventRepo := repository.NewSecurityEventRepository(client)
+	revisionRepo := repository.NewRevisionRepository(client)
+	transactor := repository.NewTransactor(client)
 
-	userSvc := service.NewUserService(userRepo)
-	householdSvc := service.NewHouseholdService(householdRepo, categoryRepo, txRepo, recurringRepo)
+	userSvc := service.NewUserService(userRepo, credentialRepo, identityRepo)
+	eventSvc := service.NewSecurityEventService(eventRepo)
+	householdSvc := service.NewHouseholdService(householdRepo, categoryRepo, txRepo, recurringRepo, eventSvc)
 	categorySvc := service.NewCategoryService(categoryRepo, householdSvc)
-	txSvc := service.NewTransactionService(txRepo, householdSvc)
-	recurringSvc := service.NewRecurringExpenseService(recurringRepo, overrideRepo, householdSvc)
-	summarySvc := service.NewSummaryService(txRepo, recurringRepo, overrideRepo, categoryRepo, householdSvc)
-	tokenSvc := service.NewAPITokenService(tokenRepo)
+	memberSvc := service.NewMemberService(memberRepo, householdSvc)
+	txSvc := service.NewTransactionService(txRepo, householdSvc, memberSvc)
+	recurringSvc := service.NewRecurringExpenseService(recurringRepo, overrideRepo, householdSvc, memberSvc)
+	settlementSvc := service.NewSettlementService(settlementRepo, memberRepo, txRepo, recurringRepo, overrideRepo, householdSvc)
+	summarySvc := service.NewSummaryService(txRepo, recurringRepo, overrideRepo, categoryRepo, aggregateRepo, householdSvc)
+	tokenSvc := service.NewAPITokenService(tokenRepo, householdSvc, eventSvc)
+	sessionSvc := service.NewSessionService(sessionRepo, eventSvc)
+	adminSvc := service.NewAdminService(userRepo, credentialRepo, householdRepo, tokenRepo, sessionRepo, revisionRepo, statsRepo, eventSvc, transactor)
+	revisionSvc := service.NewRevisionService(revisionRepo, householdSvc)
+	accountSvc := service.NewAccountService(userRepo, credentialRepo, identityRepo, householdRepo, transferRepo, categoryRepo, memberRepo, txRepo, recurringRepo, overrideRepo, settlementRepo, tokenRepo, sessionRepo, revisionRepo, eventSvc, adminSvc, transactor)
+	identitySvc := service.NewIdentityService(identityRepo, credentialRepo, userRepo, eventSvc)
+	trashSvc := service.NewTrashService(householdRepo, txRepo, recurringRepo, householdSvc, eventSvc, 30*24*time.Hour)
 
 	t.Cleanup(func() {
 		client.Close()
@@ -63,18 +120,28 @@ func setupTestServices(t *testing.T) *testServices {
 
 	return &testServices{
 		client:           client,
+		queries:          queries,
 		User:             userSvc,
 		Household:        householdSvc,
 		Category:         categorySvc,
 		Transaction:      txSvc,
 		RecurringExpense: recurringSvc,
+		Member:           memberSvc,
+		Settlement:       settlementSvc,
 		Summary:          summarySvc,
 		APIToken:         tokenSvc,
+		Session:          sessionSvc,
+		Admin:            adminSvc,
+		Security:         eventSvc,
+		Revision:         revisionSvc,
+		Trash:            trashSvc,
+		Account:          accountSvc,
+		Identity:         identitySvc,
 	}
 }
 
 // createTestUser creates a user and returns a context with the user ID set.
-func createTestUser(t *testing.T, svc *testServices) (context.Context, *domain.User) {
+func createTestUser(t testing.TB, svc *testServices) (context.Context, *domain.User) {
 	t.Helper()
 	user, err := svc.User.GetOrCreate(context.Background(), "test-sub", "test@example.com", "Test User")
 	if err != nil {
@@ -85,7 +152,7 @@ func createTestUser(t *testing.T, svc *testServices) (context.Context, *domain.U
 }
 
 // createTestHousehold creates a household and returns it.
-func createTestHousehold(t *testing.T, svc *testServices, ctx context.Context) *domain.Household {
+func createTestHousehold(t testing.TB, svc *testServices, ctx context.Context) *domain.Household {
 	t.Helper()
 	hh, err := svc.Household.Create(ctx, "Test Household", "", "EUR", "")
 	if err != nil {
@@ -95,9 +162,9 @@ func createTestHousehold(t *testing.T, svc *testServices, ctx context.Context) *
 }
 
 // createTestCategory creates a category and returns it.
-func createTestCategory(t *testing.T, svc *testServices, ctx context.Context, householdID int) *domain.Category {
+func createTestCategory(t testing.TB, svc *testServices, ctx context.Context, householdID int) *domain.Category {
 	t.Helper()
-	cat, err := svc.Category.Create(ctx, householdID, "Test Category", "")
+	cat, err := svc.Category.Create(ctx, householdID, "Test Category", "", "")
 	if err != nil {
 		t.Fatalf("failed to create test category: %v", err)
 	}
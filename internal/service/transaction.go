@@ -11,13 +11,20 @@ import (
 type TransactionService struct {
 	repo      domain.TransactionRepo
 	household *HouseholdService
+	members   *MemberService
 }
 
-func NewTransactionService(repo domain.TransactionRepo, household *HouseholdService) *TransactionService {
-	return &TransactionService{repo: repo, household: household}
+func NewTransactionService(repo domain.TransactionRepo, household *HouseholdService, members *MemberService) *TransactionService {
+	return &TransactionService{repo: repo, household: household, members: members}
 }
 
-func (s *TransactionService) Create(ctx context.Context, householdID, categoryID int, amount domain.Money, description, details string, date time.Time) (*domain.Transaction, error) {
+// Create stores a transaction. split is nil unless the transaction is shared
+// between members of the household.
+func (s *TransactionService) Create(ctx context.Context, householdID, categoryID int, amount domain.Money, description, details string, taxClass domain.TaxClass, date time.Time, split *domain.Split) (*domain.Transaction, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateAmount(amount); err != nil {
 		return nil, err
 	}
@@ -27,10 +34,16 @@ func (s *TransactionService) Create(ctx context.Context, householdID, categoryID
 	if err := domain.ValidateDetails(details); err != nil {
 		return nil, err
 	}
+	if err := domain.ValidateTransactionTaxClass(taxClass); err != nil {
+		return nil, err
+	}
 
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return nil, err
 	}
+	if err := s.members.ResolveSplit(ctx, householdID, split, amount); err != nil {
+		return nil, err
+	}
 
 	return s.repo.Create(ctx, &domain.Transaction{
 		HouseholdID: householdID,
@@ -38,6 +51,8 @@ func (s *TransactionService) Create(ctx context.Context, householdID, categoryID
 		Amount:      amount,
 		Description: description,
 		Details:     details,
+		TaxClass:    taxClass,
+		Split:       split,
 		Date:        date,
 	})
 }
@@ -46,15 +61,24 @@ func (s *TransactionService) GetByID(ctx context.Context, id int) (*domain.Trans
 	return s.repo.GetByID(ctx, id)
 }
 
-func (s *TransactionService) ListByMonth(ctx context.Context, householdID int, year int, month time.Month) ([]*domain.Transaction, error) {
+// ListByMonth returns the transactions of the month whose amount lies within
+// filter. Use a zero AmountFilter to list all of them.
+func (s *TransactionService) ListByMonth(ctx context.Context, householdID int, year int, month time.Month, filter domain.AmountFilter) ([]*domain.Transaction, error) {
+	if err := domain.ValidateAmountFilter(filter); err != nil {
+		return nil, err
+	}
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return nil, err
 	}
 
-	return s.repo.ListByHouseholdAndMonth(ctx, householdID, year, month)
+	return s.repo.ListByHouseholdAndMonth(ctx, householdID, year, month, filter)
 }
 
-func (s *TransactionService) Update(ctx context.Context, householdID, id, categoryID int, amount domain.Money, description, details string, date time.Time) (*domain.Transaction, error) {
+func (s *TransactionService) Update(ctx context.Context, householdID, id, categoryID int, amount domain.Money, description, details string, taxClass domain.TaxClass, date time.Time, split *domain.Split) (*domain.Transaction, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateAmount(amount); err != nil {
 		return nil, err
 	}
@@ -64,6 +88,9 @@ func (s *TransactionService) Update(ctx context.Context, householdID, id, catego
 	if err := domain.ValidateDetails(details); err != nil {
 		return nil, err
 	}
+	if err := domain.ValidateTransactionTaxClass(taxClass); err != nil {
+		return nil, err
+	}
 
 	existing, err := s.repo.GetByID(ctx, id)
 	if err != nil {
@@ -72,16 +99,26 @@ func (s *TransactionService) Update(ctx context.Context, householdID, id, catego
 	if existing.HouseholdID != householdID {
 		return nil, fmt.Errorf("%w: transaction does not belong to household", domain.ErrForbidden)
 	}
+	if err := s.members.ResolveSplit(ctx, householdID, split, amount); err != nil {
+		return nil, err
+	}
 
 	existing.CategoryID = categoryID
 	existing.Amount = amount
 	existing.Description = description
 	existing.Details = details
+	existing.TaxClass = taxClass
+	existing.Split = split
 	existing.Date = date
 	return s.repo.Update(ctx, existing)
 }
 
+// Delete moves the transaction to the trash.
 func (s *TransactionService) Delete(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
 	if _, err := s.household.GetByID(ctx, householdID); err != nil {
 		return err
 	}
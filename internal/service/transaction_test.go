@@ -17,7 +17,7 @@ func TestTransactionCreate(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
-		tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Groceries", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC))
+		tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Groceries", "", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -33,7 +33,7 @@ func TestTransactionCreate(t *testing.T) {
 	})
 
 	t.Run("zero amount", func(t *testing.T) {
-		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, domain.ZeroMoney(), "test", "", time.Now())
+		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, domain.ZeroMoney(), "test", "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -41,7 +41,7 @@ func TestTransactionCreate(t *testing.T) {
 
 	t.Run("household not found", func(t *testing.T) {
 		amount, _ := domain.NewMoney("10")
-		_, err := svc.Transaction.Create(ctx, 99999, cat.ID, amount, "test", "", time.Now())
+		_, err := svc.Transaction.Create(ctx, 99999, cat.ID, amount, "test", "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrNotFound) {
 			t.Errorf("expected ErrNotFound, got %v", err)
 		}
@@ -50,7 +50,7 @@ func TestTransactionCreate(t *testing.T) {
 	t.Run("long description", func(t *testing.T) {
 		amount, _ := domain.NewMoney("10")
 		longDesc := string(make([]byte, 501))
-		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, longDesc, "", time.Now())
+		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, longDesc, "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -58,7 +58,7 @@ func TestTransactionCreate(t *testing.T) {
 
 	t.Run("with details", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-25.00")
-		tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Receipt", "Item 1: 10.00\nItem 2: 15.00", time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC))
+		tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Receipt", "Item 1: 10.00\nItem 2: 15.00", "", time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -70,7 +70,7 @@ func TestTransactionCreate(t *testing.T) {
 	t.Run("details too long", func(t *testing.T) {
 		amount, _ := domain.NewMoney("10")
 		longDetails := string(make([]byte, 5001))
-		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "test", longDetails, time.Now())
+		_, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "test", longDetails, "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -84,11 +84,11 @@ func TestTransactionListByMonth(t *testing.T) {
 	cat := createTestCategory(t, svc, ctx, hh.ID)
 
 	amount, _ := domain.NewMoney("-50.00")
-	svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Jan tx", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC))
-	svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Feb tx", "", time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC))
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Jan tx", "", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), nil)
+	svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Feb tx", "", "", time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC), nil)
 
 	t.Run("filter by month", func(t *testing.T) {
-		list, err := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.January)
+		list, err := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.January, domain.AmountFilter{})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -101,7 +101,7 @@ func TestTransactionListByMonth(t *testing.T) {
 	})
 
 	t.Run("empty month", func(t *testing.T) {
-		list, err := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.March)
+		list, err := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.March, domain.AmountFilter{})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -111,6 +111,65 @@ func TestTransactionListByMonth(t *testing.T) {
 	})
 }
 
+func TestTransactionListByMonthAmountFilter(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, _ := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+
+	date := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	for _, a := range []string{"-120.50", "-20", "-19.99", "1500"} {
+		amount, _ := domain.NewMoney(a)
+		if _, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, a, "", "", date, nil); err != nil {
+			t.Fatalf("creating transaction %s: %v", a, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		min, max string
+		want     []string
+	}{
+		{"no bounds", "", "", []string{"-120.50", "-20", "-19.99", "1500"}},
+		{"min only", "0", "", []string{"1500"}},
+		{"max only", "", "-20", []string{"-120.50", "-20"}},
+		{"inclusive range", "-20", "-19.99", []string{"-20", "-19.99"}},
+		{"comma decimal", "-120,50", "-120,50", []string{"-120.50"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := domain.NewAmountFilter(tt.min, tt.max)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			list, err := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.January, filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := make(map[string]bool, len(list))
+			for _, tx := range list {
+				got[tx.Description] = true
+			}
+			if len(list) != len(tt.want) {
+				t.Errorf("got %d transactions, want %d", len(list), len(tt.want))
+			}
+			for _, w := range tt.want {
+				if !got[w] {
+					t.Errorf("missing transaction %s", w)
+				}
+			}
+		})
+	}
+
+	t.Run("min greater than max", func(t *testing.T) {
+		min, max := domain.MoneyFromInt(1000), domain.MoneyFromInt(500)
+		_, err := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.January, domain.AmountFilter{Min: &min, Max: &max})
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+}
+
 func TestTransactionUpdate(t *testing.T) {
 	svc := setupTestServices(t)
 	ctx, _ := createTestUser(t, svc)
@@ -118,11 +177,11 @@ func TestTransactionUpdate(t *testing.T) {
 	cat := createTestCategory(t, svc, ctx, hh.ID)
 
 	amount, _ := domain.NewMoney("-50.00")
-	tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "original", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC))
+	tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "original", "", "", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), nil)
 
 	t.Run("success", func(t *testing.T) {
 		newAmount, _ := domain.NewMoney("-75.00")
-		updated, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, newAmount, "updated", "", time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC))
+		updated, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, newAmount, "updated", "", "", time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -137,7 +196,7 @@ func TestTransactionUpdate(t *testing.T) {
 	t.Run("wrong household", func(t *testing.T) {
 		hh2, _ := svc.Household.Create(ctx, "Other", "", "EUR", "")
 		newAmount, _ := domain.NewMoney("10")
-		_, err := svc.Transaction.Update(ctx, hh2.ID, tx.ID, cat.ID, newAmount, "hack", "", time.Now())
+		_, err := svc.Transaction.Update(ctx, hh2.ID, tx.ID, cat.ID, newAmount, "hack", "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrForbidden) {
 			t.Errorf("expected ErrForbidden, got %v", err)
 		}
@@ -145,14 +204,14 @@ func TestTransactionUpdate(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		newAmount, _ := domain.NewMoney("10")
-		_, err := svc.Transaction.Update(ctx, hh.ID, 99999, cat.ID, newAmount, "test", "", time.Now())
+		_, err := svc.Transaction.Update(ctx, hh.ID, 99999, cat.ID, newAmount, "test", "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrNotFound) {
 			t.Errorf("expected ErrNotFound, got %v", err)
 		}
 	})
 
 	t.Run("zero amount", func(t *testing.T) {
-		_, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, domain.ZeroMoney(), "test", "", time.Now())
+		_, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, domain.ZeroMoney(), "test", "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -161,7 +220,7 @@ func TestTransactionUpdate(t *testing.T) {
 	t.Run("long description", func(t *testing.T) {
 		amount, _ := domain.NewMoney("10")
 		longDesc := string(make([]byte, 501))
-		_, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, amount, longDesc, "", time.Now())
+		_, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, amount, longDesc, "", "", time.Now(), nil)
 		if !errors.Is(err, domain.ErrValidation) {
 			t.Errorf("expected ErrValidation, got %v", err)
 		}
@@ -176,7 +235,7 @@ func TestTransactionDelete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
-		tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "to delete", "", time.Now())
+		tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "to delete", "", "", time.Now(), nil)
 
 		if err := svc.Transaction.Delete(ctx, hh.ID, tx.ID); err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -190,7 +249,7 @@ func TestTransactionDelete(t *testing.T) {
 
 	t.Run("wrong household", func(t *testing.T) {
 		amount, _ := domain.NewMoney("-50.00")
-		tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "test", "", time.Now())
+		tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "test", "", "", time.Now(), nil)
 		hh2, _ := svc.Household.Create(ctx, "Other", "", "EUR", "")
 
 		err := svc.Transaction.Delete(ctx, hh2.ID, tx.ID)
@@ -218,7 +277,7 @@ func TestTransactionNoAuth(t *testing.T) {
 	otherCtx := service.WithUserID(ctx, otherUser.ID)
 
 	amount, _ := domain.NewMoney("-50.00")
-	_, err := svc.Transaction.Create(otherCtx, hh.ID, cat.ID, amount, "test", "", time.Now())
+	_, err := svc.Transaction.Create(otherCtx, hh.ID, cat.ID, amount, "test", "", "", time.Now(), nil)
 	if !errors.Is(err, domain.ErrForbidden) {
 		t.Errorf("expected ErrForbidden for wrong owner, got %v", err)
 	}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"icekalt.dev/money-tracker/internal/domain"
+)
+
+// TrashService lists and restores deleted households, transactions and
+// recurring expenses, and purges them once they have been in the trash for
+// longer than the retention period.
+type TrashService struct {
+	households   domain.HouseholdRepo
+	transactions domain.TransactionRepo
+	recurring    domain.RecurringExpenseRepo
+	household    *HouseholdService
+	events       *SecurityEventService
+	retention    time.Duration
+}
+
+// NewTrashService keeps deleted records for retention before they are
+// purged. A retention of 0 keeps them until they are restored.
+func NewTrashService(households domain.HouseholdRepo, transactions domain.TransactionRepo, recurring domain.RecurringExpenseRepo, household *HouseholdService, events *SecurityEventService, retention time.Duration) *TrashService {
+	return &TrashService{
+		households:   households,
+		transactions: transactions,
+		recurring:    recurring,
+		household:    household,
+		events:       events,
+		retention:    retention,
+	}
+}
+
+// Retention returns how long deleted records are kept, 0 for forever.
+func (s *TrashService) Retention() time.Duration {
+	return s.retention
+}
+
+// List returns the deleted transactions and recurring expenses of the
+// household.
+func (s *TrashService) List(ctx context.Context, householdID int) (*domain.Trash, error) {
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.transactions.ListDeletedByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	recurring, err := s.recurring.ListDeletedByHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Trash{Transactions: transactions, RecurringExpenses: recurring}, nil
+}
+
+// Households returns the deleted households of the authenticated user.
+func (s *TrashService) Households(ctx context.Context) ([]*domain.Household, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+	}
+
+	households, err := s.households.ListDeletedByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := TokenScopeFromContext(ctx)
+	allowed := households[:0]
+	for _, hh := range households {
+		if scope.AllowsHousehold(hh.ID) {
+			allowed = append(allowed, hh)
+		}
+	}
+	return allowed, nil
+}
+
+func (s *TrashService) RestoreHousehold(ctx context.Context, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
+	hh, err := s.households.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.household.authorize(ctx, hh); err != nil {
+		return err
+	}
+
+	if err := s.households.Restore(ctx, id); err != nil {
+		return err
+	}
+	return s.events.Record(ctx, domain.EventHouseholdRestored, hh.OwnerID, map[string]string{
+		"household_id":   eventID(hh.ID),
+		"household_name": hh.Name,
+	})
+}
+
+func (s *TrashService) RestoreTransaction(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return err
+	}
+
+	tx, err := s.transactions.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if tx.HouseholdID != householdID {
+		return fmt.Errorf("%w: transaction does not belong to household", domain.ErrForbidden)
+	}
+
+	return s.transactions.Restore(ctx, id)
+}
+
+func (s *TrashService) RestoreRecurringExpense(ctx context.Context, householdID, id int) error {
+	if err := requireWrite(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.household.GetByID(ctx, householdID); err != nil {
+		return err
+	}
+
+	re, err := s.recurring.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if re.HouseholdID != householdID {
+		return fmt.Errorf("%w: recurring expense does not belong to household", domain.ErrForbidden)
+	}
+
+	return s.recurring.Restore(ctx, id)
+}
+
+// Purge deletes everything that was moved to the trash longer than the
+// retention ago and returns how many records that were, not counting the
+// data of purged households. It is meant for the retention job and does no
+// authorization.
+func (s *TrashService) Purge(ctx context.Context, now time.Time) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	before := now.Add(-s.retention)
+
+	households, err := s.households.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return households, fmt.Errorf("purging households: %w", err)
+	}
+	transactions, err := s.transactions.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return households, fmt.Errorf("purging transactions: %w", err)
+	}
+	recurring, err := s.recurring.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return households + transactions, fmt.Errorf("purging recurring expenses: %w", err)
+	}
+	return households + transactions + recurring, nil
+}
@@ -0,0 +1,195 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"icekalt.dev/money-tracker/ent/revision"
+	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
+)
+
+func TestTrash(t *testing.T) {
+	svc := setupTestServices(t)
+	ctx, user := createTestUser(t, svc)
+	hh := createTestHousehold(t, svc, ctx)
+	cat := createTestCategory(t, svc, ctx, hh.ID)
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	amount, _ := domain.NewMoney("-20.00")
+
+	trash := func(t *testing.T) *domain.Trash {
+		t.Helper()
+		tr, err := svc.Trash.List(ctx, hh.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return tr
+	}
+
+	t.Run("transactions", func(t *testing.T) {
+		tx, err := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Food", "", "", date, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.Transaction.Delete(ctx, hh.ID, tx.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		listed, _ := svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.March, domain.AmountFilter{})
+		if len(listed) != 0 {
+			t.Errorf("expected the deleted transaction to be hidden, got %d", len(listed))
+		}
+		summary, _ := svc.Summary.GetMonthlySummary(ctx, hh.ID, 2026, time.March)
+		if !summary.OneTimeTotal.IsZero() {
+			t.Errorf("expected the deleted transaction to be left out of the summary, got %s", summary.OneTimeTotal)
+		}
+		if _, err := svc.Transaction.Update(ctx, hh.ID, tx.ID, cat.ID, amount, "Food", "", "", date, nil); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound updating a deleted transaction, got %v", err)
+		}
+		if tr := trash(t); len(tr.Transactions) != 1 || tr.Transactions[0].DeletedAt == nil {
+			t.Fatalf("expected the transaction in the trash, got %+v", tr.Transactions)
+		}
+
+		if err := svc.Trash.RestoreTransaction(ctx, hh.ID, tx.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		listed, _ = svc.Transaction.ListByMonth(ctx, hh.ID, 2026, time.March, domain.AmountFilter{})
+		if len(listed) != 1 || len(trash(t).Transactions) != 0 {
+			t.Errorf("expected the transaction to be back, got %d listed", len(listed))
+		}
+		if err := svc.Trash.RestoreTransaction(ctx, hh.ID, tx.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound restoring twice, got %v", err)
+		}
+
+		revisions, _ := svc.Revision.History(ctx, hh.ID, domain.EntityTransaction, tx.ID)
+		if len(revisions) != 3 || revisions[0].Action != domain.RevisionRestore || revisions[1].Action != domain.RevisionDelete {
+			t.Errorf("expected creation, deletion and restore, got %+v", revisions)
+		}
+	})
+
+	t.Run("recurring expenses keep their overrides", func(t *testing.T) {
+		re, err := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Rent", "", "", amount, domain.FrequencyMonthly, date, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.RecurringExpense.CreateOverride(ctx, re.ID, date.AddDate(0, 2, 0), amount, domain.FrequencyMonthly); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.RecurringExpense.Delete(ctx, hh.ID, re.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if listed, _ := svc.RecurringExpense.List(ctx, hh.ID); len(listed) != 0 {
+			t.Errorf("expected the deleted expense to be hidden, got %d", len(listed))
+		}
+		if _, err := svc.RecurringExpense.ListOverrides(ctx, re.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound for the overrides of a deleted expense, got %v", err)
+		}
+		if tr := trash(t); len(tr.RecurringExpenses) != 1 {
+			t.Fatalf("expected the expense in the trash, got %+v", tr.RecurringExpenses)
+		}
+
+		if err := svc.Trash.RestoreRecurringExpense(ctx, hh.ID, re.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if overrides, _ := svc.RecurringExpense.ListOverrides(ctx, re.ID); len(overrides) != 1 {
+			t.Errorf("expected the override to be restored with the expense, got %d", len(overrides))
+		}
+	})
+
+	t.Run("households", func(t *testing.T) {
+		gone := createTestHousehold(t, svc, ctx)
+		if err := svc.Household.Delete(ctx, gone.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.Household.GetByID(ctx, gone.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound for a deleted household, got %v", err)
+		}
+		deleted, err := svc.Trash.Households(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(deleted) != 1 || deleted[0].ID != gone.ID {
+			t.Fatalf("expected the household in the trash, got %+v", deleted)
+		}
+
+		other, _ := svc.User.GetOrCreate(t.Context(), "other-sub", "other@example.com", "Other User")
+		otherCtx := service.WithUserID(t.Context(), other.ID)
+		if err := svc.Trash.RestoreHousehold(otherCtx, gone.ID); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden restoring someone else's household, got %v", err)
+		}
+
+		if err := svc.Trash.RestoreHousehold(ctx, gone.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.Household.GetByID(ctx, gone.ID); err != nil {
+			t.Errorf("expected the household to be back, got %v", err)
+		}
+		events, _ := svc.Security.List(ctx)
+		if len(events) < 2 || events[0].Type != domain.EventHouseholdRestored || events[1].Type != domain.EventHouseholdDeleted {
+			t.Errorf("expected deletion and restore events, got %+v", events)
+		}
+	})
+
+	t.Run("purge after the retention", func(t *testing.T) {
+		tx, _ := svc.Transaction.Create(ctx, hh.ID, cat.ID, amount, "Old", "", "", date, nil)
+		svc.Transaction.Delete(ctx, hh.ID, tx.ID)
+		re, _ := svc.RecurringExpense.Create(ctx, hh.ID, cat.ID, "Old rent", "", "", amount, domain.FrequencyMonthly, date, nil, nil)
+		override, _ := svc.RecurringExpense.CreateOverride(ctx, re.ID, date.AddDate(0, 2, 0), amount, domain.FrequencyMonthly)
+		svc.RecurringExpense.Delete(ctx, hh.ID, re.ID)
+		gone := createTestHousehold(t, svc, ctx)
+		goneCat := createTestCategory(t, svc, ctx, gone.ID)
+		svc.Transaction.Create(ctx, gone.ID, goneCat.ID, amount, "Elsewhere", "", "", date, nil)
+		svc.Household.Delete(ctx, gone.ID)
+
+		if n, err := svc.Trash.Purge(ctx, time.Now()); err != nil || n != 0 {
+			t.Errorf("expected nothing to purge within the retention, got %d, %v", n, err)
+		}
+		n, err := svc.Trash.Purge(ctx, time.Now().Add(31*24*time.Hour))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 3 {
+			t.Errorf("expected the transaction, the recurring expense and the household to be purged, got %d", n)
+		}
+		if deleted, _ := svc.Trash.Households(ctx); len(deleted) != 0 {
+			t.Errorf("expected no deleted households left, got %d", len(deleted))
+		}
+		if len(trash(t).Transactions) != 0 {
+			t.Error("expected the transaction trash to be empty")
+		}
+
+		if revisions, err := svc.Revision.History(ctx, hh.ID, domain.EntityTransaction, tx.ID); err != nil || len(revisions) != 0 {
+			t.Errorf("expected the revisions of the transaction to be purged, got %+v, %v", revisions, err)
+		}
+		for _, r := range []struct {
+			entity domain.EntityType
+			id     int
+		}{{domain.EntityRecurringExpense, re.ID}, {domain.EntityScheduleOverride, override.ID}} {
+			if revisions, err := svc.Revision.History(ctx, hh.ID, r.entity, r.id); err != nil || len(revisions) != 0 {
+				t.Errorf("expected the revisions of the %s to be purged, got %+v, %v", r.entity, revisions, err)
+			}
+		}
+		if n := svc.client.Revision.Query().Where(revision.HouseholdID(gone.ID)).CountX(ctx); n != 0 {
+			t.Errorf("expected the revisions of the household to be purged, got %d", n)
+		}
+	})
+
+	t.Run("zero retention keeps the trash", func(t *testing.T) {
+		keep := service.NewTrashService(nil, nil, nil, svc.Household, svc.Security, 0)
+		if n, err := keep.Purge(ctx, time.Now().AddDate(10, 0, 0)); err != nil || n != 0 {
+			t.Errorf("expected nothing to be purged, got %d, %v", n, err)
+		}
+	})
+
+	t.Run("deleting the user purges their trash", func(t *testing.T) {
+		gone := createTestHousehold(t, svc, ctx)
+		svc.Household.Delete(ctx, gone.ID)
+		if err := svc.Admin.Delete(service.WithOperator(t.Context()), user.ID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n, _ := svc.client.Household.Query().Count(t.Context()); n != 0 {
+			t.Errorf("expected no households left, got %d", n)
+		}
+	})
+}
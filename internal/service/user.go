@@ -2,16 +2,24 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 
 	"icekalt.dev/money-tracker/internal/domain"
 )
 
 type UserService struct {
-	repo domain.UserRepo
+	repo        domain.UserRepo
+	credentials domain.LocalCredentialRepo
+	identities  domain.UserIdentityRepo
 }
 
-func NewUserService(repo domain.UserRepo) *UserService {
-	return &UserService{repo: repo}
+func NewUserService(repo domain.UserRepo, credentials domain.LocalCredentialRepo, identities domain.UserIdentityRepo) *UserService {
+	return &UserService{repo: repo, credentials: credentials, identities: identities}
 }
 
 func (s *UserService) GetOrCreate(ctx context.Context, subject, email, name string) (*domain.User, error) {
@@ -27,11 +35,168 @@ func (s *UserService) GetOrCreate(ctx context.Context, subject, email, name stri
 	})
 }
 
+// SignInOIDC returns the user for an OIDC login. Users are found by the
+// identity's issuer and subject. Unknown identities take over an invite
+// with their email or, if registration is open, get a new account; if
+// another account already has the email, domain.ErrLinkRequired asks to
+// link the identity to it instead. If the policy has admin groups, the
+// admin flag follows them.
+func (s *UserService) SignInOIDC(ctx context.Context, policy domain.SignInPolicy, id domain.OIDCIdentity) (*domain.User, error) {
+	if !policy.Allows(id) {
+		return nil, domain.ErrSignInDenied
+	}
+
+	user, err := s.oidcUser(ctx, policy, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.Disabled() {
+		return nil, domain.ErrUserDisabled
+	}
+
+	if admin, managed := policy.Admin(id); managed && admin != user.Admin {
+		user.Admin = admin
+		return s.repo.Update(ctx, user)
+	}
+	return user, nil
+}
+
+func (s *UserService) oidcUser(ctx context.Context, policy domain.SignInPolicy, id domain.OIDCIdentity) (*domain.User, error) {
+	identity, err := s.identities.GetByIssuerSubject(ctx, id.Issuer, id.Subject)
+	if err == nil {
+		return s.repo.GetByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	identity = &domain.UserIdentity{Issuer: id.Issuer, Subject: id.Subject, Email: id.Email}
+	subject, err := randomSubject(domain.OIDCSubjectPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.Email != "" {
+		existing, err := s.repo.GetByEmail(ctx, id.Email)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			// An unchecked address must neither claim the account nor
+			// create a second one with the same email.
+			if !id.EmailTrusted() {
+				return nil, domain.ErrSignInDenied
+			}
+			if !existing.Invited() {
+				return nil, domain.ErrLinkRequired
+			}
+			identity.UserID = existing.ID
+			if err := s.identities.Claim(ctx, identity, subject); err != nil {
+				return nil, err
+			}
+			return s.repo.GetByID(ctx, existing.ID)
+		}
+	}
+
+	if policy.Registration == domain.RegistrationClosed {
+		return nil, domain.ErrRegistrationClosed
+	}
+	return s.identities.CreateWithUser(ctx, &domain.User{
+		Email:   id.Email,
+		Name:    id.Name,
+		Subject: subject,
+	}, identity)
+}
+
+// LinkLegacyUsers moves the subjects of users from before identities, which
+// are the plain subject at the only provider there was, to identities at
+// issuer. It returns how many users were moved. Users that already have the
+// OIDC prefix or another known prefix are left alone, so running it again
+// does nothing.
+func (s *UserService) LinkLegacyUsers(ctx context.Context, issuer string) (int, error) {
+	users, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, user := range users {
+		if !legacySubject(user.Subject) {
+			continue
+		}
+		subject, err := randomSubject(domain.OIDCSubjectPrefix)
+		if err != nil {
+			return n, err
+		}
+		identity := &domain.UserIdentity{UserID: user.ID, Issuer: issuer, Subject: user.Subject, Email: user.Email}
+		if err := s.identities.Claim(ctx, identity, subject); err != nil {
+			return n, fmt.Errorf("linking user %d: %w", user.ID, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// CountLegacyUsers returns how many users LinkLegacyUsers would move.
+func (s *UserService) CountLegacyUsers(ctx context.Context) (int, error) {
+	users, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, user := range users {
+		if legacySubject(user.Subject) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func legacySubject(subject string) bool {
+	for _, prefix := range []string{
+		domain.OIDCSubjectPrefix,
+		domain.InvitedSubjectPrefix,
+		domain.HeaderSubjectPrefix,
+		localSubjectPrefix,
+	} {
+		if strings.HasPrefix(subject, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Invite creates a user that the first OIDC login with the given email
+// takes over, even while registration is closed. It is meant for the CLI
+// and does no authorization.
+func (s *UserService) Invite(ctx context.Context, email, name string) (*domain.User, error) {
+	if err := domain.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateHouseholdName(name); err != nil {
+		return nil, err
+	}
+
+	subject, err := randomSubject(domain.InvitedSubjectPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, &domain.User{
+		Email:   email,
+		Name:    name,
+		Subject: subject,
+	})
+}
+
 func (s *UserService) GetByID(ctx context.Context, id int) (*domain.User, error) {
 	return s.repo.GetByID(ctx, id)
 }
 
 func (s *UserService) UpdateName(ctx context.Context, name string) (*domain.User, error) {
+	if err := requireWrite(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := domain.ValidateHouseholdName(name); err != nil {
 		return nil, err
 	}
@@ -49,3 +214,175 @@ func (s *UserService) UpdateName(ctx context.Context, name string) (*domain.User
 	user.Name = name
 	return s.repo.Update(ctx, user)
 }
+
+// CreateLocal creates a user with a local account. It is meant for the CLI
+// and does no authorization.
+func (s *UserService) CreateLocal(ctx context.Context, username, email, name, password string) (*domain.User, error) {
+	if err := domain.ValidateUsername(username); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateHouseholdName(name); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidatePassword(password); err != nil {
+		return nil, err
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := randomSubject(localSubjectPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.credentials.Create(ctx, &domain.User{
+		Email:   email,
+		Name:    name,
+		Subject: subject,
+	}, &domain.LocalCredential{
+		Username:     username,
+		PasswordHash: hash,
+	})
+}
+
+// Authenticate checks a username and password and returns the user. It
+// returns domain.ErrInvalidCredentials for unknown users and wrong passwords
+// alike, and domain.ErrUserDisabled only after a correct password.
+func (s *UserService) Authenticate(ctx context.Context, username, password string) (*domain.User, error) {
+	cred, err := s.credentials.GetByUsername(ctx, username)
+	if errors.Is(err, domain.ErrNotFound) {
+		// Hash anyway so the response time doesn't reveal unknown usernames.
+		_, _ = verifyPassword(password, dummyHash())
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := verifyPassword(password, cred.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	user, err := s.repo.GetByID(ctx, cred.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Disabled() {
+		return nil, domain.ErrUserDisabled
+	}
+	return user, nil
+}
+
+// LocalUserID returns the ID of the user with the local username, or 0 if
+// there is none. It attributes failed logins to the account they targeted.
+func (s *UserService) LocalUserID(ctx context.Context, username string) int {
+	cred, err := s.credentials.GetByUsername(ctx, username)
+	if err != nil {
+		return 0
+	}
+	return cred.UserID
+}
+
+// HasLocalAccount reports whether the current user logs in with a password.
+func (s *UserService) HasLocalAccount(ctx context.Context) (bool, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return false, domain.ErrForbidden
+	}
+
+	_, err := s.credentials.GetByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ChangePassword replaces the current user's password after checking the
+// old one.
+func (s *UserService) ChangePassword(ctx context.Context, current, password string) error {
+	if err := requireFullAccess(ctx); err != nil {
+		return err
+	}
+
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return domain.ErrForbidden
+	}
+
+	cred, err := s.credentials.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	ok, err = verifyPassword(current, cred.PasswordHash)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.NewValidationError("current_password", "is wrong")
+	}
+
+	return s.setPassword(ctx, userID, password)
+}
+
+// SetPassword replaces the password of a local account without checking the
+// old one. It is meant for the CLI and does no authorization.
+func (s *UserService) SetPassword(ctx context.Context, username, password string) error {
+	cred, err := s.credentials.GetByUsername(ctx, username)
+	if err != nil {
+		return err
+	}
+	return s.setPassword(ctx, cred.UserID, password)
+}
+
+func (s *UserService) setPassword(ctx context.Context, userID int, password string) error {
+	if err := domain.ValidatePassword(password); err != nil {
+		return err
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	return s.credentials.UpdatePasswordHash(ctx, userID, hash)
+}
+
+var (
+	dummyHashOnce  sync.Once
+	dummyHashValue string
+)
+
+// dummyHash returns a hash to verify against when the username is unknown.
+func dummyHash() string {
+	dummyHashOnce.Do(func() {
+		dummyHashValue, _ = hashPassword(rand.Text())
+	})
+	return dummyHashValue
+}
+
+// localSubjectPrefix marks users with a local account.
+const localSubjectPrefix = "local:"
+
+// randomSubject returns a new subject with the prefix. The subject only has
+// to be unique; a random one can't collide with others and survives
+// renaming the account.
+func randomSubject(prefix string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + hex.EncodeToString(b), nil
+}
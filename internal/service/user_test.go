@@ -3,9 +3,13 @@ package service_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"icekalt.dev/money-tracker/ent/useridentity"
 	"icekalt.dev/money-tracker/internal/domain"
+	"icekalt.dev/money-tracker/internal/service"
 )
 
 func TestUserGetByID(t *testing.T) {
@@ -96,3 +100,292 @@ func TestUserGetOrCreate(t *testing.T) {
 		}
 	})
 }
+
+func TestLocalAccounts(t *testing.T) {
+	svc := setupTestServices(t)
+	bg := context.Background()
+
+	user, err := svc.User.CreateLocal(bg, "alice", "alice@example.com", "Alice", "correct horse battery")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(user.Subject, "local:") {
+		t.Errorf("Subject = %q, want local: prefix", user.Subject)
+	}
+	ctx := service.WithUserID(bg, user.ID)
+
+	t.Run("create validates input", func(t *testing.T) {
+		cases := []struct{ username, email, password string }{
+			{"Alice!", "b@example.com", "correct horse battery"},
+			{"bob", "not-an-email", "correct horse battery"},
+			{"bob", "b@example.com", "short"},
+		}
+		for _, c := range cases {
+			_, err := svc.User.CreateLocal(bg, c.username, c.email, "Bob", c.password)
+			if !errors.Is(err, domain.ErrValidation) {
+				t.Errorf("CreateLocal(%q, %q): expected ErrValidation, got %v", c.username, c.email, err)
+			}
+		}
+	})
+
+	t.Run("duplicate username", func(t *testing.T) {
+		_, err := svc.User.CreateLocal(bg, "alice", "other@example.com", "Other", "correct horse battery")
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("authenticate", func(t *testing.T) {
+		got, err := svc.User.Authenticate(bg, "alice", "correct horse battery")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.ID != user.ID {
+			t.Errorf("ID = %d, want %d", got.ID, user.ID)
+		}
+
+		for _, c := range [][2]string{{"alice", "wrong password"}, {"nobody", "correct horse battery"}} {
+			if _, err := svc.User.Authenticate(bg, c[0], c[1]); !errors.Is(err, domain.ErrInvalidCredentials) {
+				t.Errorf("Authenticate(%q): expected ErrInvalidCredentials, got %v", c[0], err)
+			}
+		}
+	})
+
+	t.Run("has local account", func(t *testing.T) {
+		ok, err := svc.User.HasLocalAccount(ctx)
+		if err != nil || !ok {
+			t.Errorf("HasLocalAccount = %v, %v; want true", ok, err)
+		}
+		oidcCtx, _ := createTestUser(t, svc)
+		ok, err = svc.User.HasLocalAccount(oidcCtx)
+		if err != nil || ok {
+			t.Errorf("HasLocalAccount for OIDC user = %v, %v; want false", ok, err)
+		}
+	})
+
+	t.Run("change password", func(t *testing.T) {
+		err := svc.User.ChangePassword(ctx, "wrong password", "another long password")
+		var verr *domain.ValidationError
+		if !errors.As(err, &verr) || verr.Field != "current_password" {
+			t.Fatalf("expected current_password validation error, got %v", err)
+		}
+		if err := svc.User.ChangePassword(ctx, "correct horse battery", "short"); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation for short password, got %v", err)
+		}
+
+		if err := svc.User.ChangePassword(ctx, "correct horse battery", "another long password"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.User.Authenticate(bg, "alice", "correct horse battery"); !errors.Is(err, domain.ErrInvalidCredentials) {
+			t.Errorf("old password still works: %v", err)
+		}
+		if _, err := svc.User.Authenticate(bg, "alice", "another long password"); err != nil {
+			t.Errorf("new password rejected: %v", err)
+		}
+	})
+
+	t.Run("change password needs full access", func(t *testing.T) {
+		tokenCtx := service.WithTokenScope(ctx, domain.TokenScope{Access: domain.TokenAccessRead})
+		if err := svc.User.ChangePassword(tokenCtx, "another long password", "yet another password"); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("set password", func(t *testing.T) {
+		if err := svc.User.SetPassword(bg, "alice", "reset by the admin"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := svc.User.Authenticate(bg, "alice", "reset by the admin"); err != nil {
+			t.Errorf("new password rejected: %v", err)
+		}
+		if err := svc.User.SetPassword(bg, "nobody", "reset by the admin"); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestSignInOIDC(t *testing.T) {
+	svc := setupTestServices(t)
+	bg := context.Background()
+	open := domain.SignInPolicy{Registration: domain.RegistrationOpen}
+	closed := domain.SignInPolicy{Registration: domain.RegistrationClosed}
+	const issuer = "https://idp.example.com"
+
+	t.Run("open registration creates user", func(t *testing.T) {
+		user, err := svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-new", Email: "new@example.com", Name: "New"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(user.Subject, domain.OIDCSubjectPrefix) {
+			t.Errorf("Subject = %q, want an OIDC subject", user.Subject)
+		}
+		identities := svc.client.UserIdentity.Query().AllX(bg)
+		if len(identities) != 1 || identities[0].Issuer != issuer || identities[0].Subject != "sub-new" {
+			t.Errorf("expected the identity to be stored, got %+v", identities)
+		}
+	})
+
+	t.Run("same subject at another issuer is another user", func(t *testing.T) {
+		first, _ := svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-shared", Email: "shared-a@example.com", Name: "A"})
+		second, err := svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: "https://other.example.com", Subject: "sub-shared", Email: "shared-b@example.com", Name: "B"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if first == nil || second.ID == first.ID {
+			t.Errorf("expected two users, got %+v and %+v", first, second)
+		}
+	})
+
+	t.Run("matching email needs linking", func(t *testing.T) {
+		verified, unverified := true, false
+		_, err := svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: "https://other.example.com", Subject: "sub-other", Email: "New@example.com", EmailVerified: &verified})
+		if !errors.Is(err, domain.ErrLinkRequired) {
+			t.Errorf("expected ErrLinkRequired, got %v", err)
+		}
+		_, err = svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: "https://other.example.com", Subject: "sub-other", Email: "new@example.com", TrustUnverifiedEmail: true})
+		if !errors.Is(err, domain.ErrLinkRequired) {
+			t.Errorf("expected ErrLinkRequired from a trusted provider, got %v", err)
+		}
+
+		_, err = svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: "https://other.example.com", Subject: "sub-fake", Email: "new@example.com", EmailVerified: &unverified})
+		if !errors.Is(err, domain.ErrSignInDenied) {
+			t.Errorf("expected unverified emails to be denied, got %v", err)
+		}
+		_, err = svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: "https://other.example.com", Subject: "sub-fake", Email: "new@example.com"})
+		if !errors.Is(err, domain.ErrSignInDenied) {
+			t.Errorf("expected emails without email_verified to be denied, got %v", err)
+		}
+	})
+
+	t.Run("closed registration rejects unknown users", func(t *testing.T) {
+		_, err := svc.User.SignInOIDC(bg, closed, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-stranger", Email: "stranger@example.com", Name: "Stranger"})
+		if !errors.Is(err, domain.ErrRegistrationClosed) {
+			t.Errorf("expected ErrRegistrationClosed, got %v", err)
+		}
+	})
+
+	t.Run("closed registration lets existing users in", func(t *testing.T) {
+		if _, err := svc.User.SignInOIDC(bg, closed, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-new", Email: "new@example.com", Name: "New"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("allow-list applies to existing users", func(t *testing.T) {
+		policy := domain.SignInPolicy{AllowedDomains: []string{"example.org"}, Registration: domain.RegistrationOpen}
+		_, err := svc.User.SignInOIDC(bg, policy, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-new", Email: "new@example.com", Name: "New"})
+		if !errors.Is(err, domain.ErrSignInDenied) {
+			t.Errorf("expected ErrSignInDenied, got %v", err)
+		}
+	})
+
+	t.Run("invite is claimed by verified email", func(t *testing.T) {
+		invited, err := svc.User.Invite(bg, "invited@example.com", "Invited")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !invited.Invited() {
+			t.Fatalf("Subject = %q, want invite", invited.Subject)
+		}
+
+		// Neither claims the invite nor creates a second account with its
+		// email, whether or not registration is open.
+		verified, unverified := true, false
+		for _, policy := range []domain.SignInPolicy{closed, open} {
+			_, err = svc.User.SignInOIDC(bg, policy, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-fake", Email: "invited@example.com", EmailVerified: &unverified, Name: "Fake"})
+			if !errors.Is(err, domain.ErrSignInDenied) {
+				t.Errorf("%s registration: expected ErrSignInDenied for unverified email, got %v", policy.Registration, err)
+			}
+		}
+		if n := svc.client.UserIdentity.Query().Where(useridentity.Subject("sub-fake")).CountX(bg); n != 0 {
+			t.Errorf("expected no identity for the unverified login, got %d", n)
+		}
+
+		user, err := svc.User.SignInOIDC(bg, closed, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-invited", Email: "Invited@example.com", EmailVerified: &verified, Name: "From IdP"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.ID != invited.ID || user.Invited() {
+			t.Errorf("invite not claimed: %+v", user)
+		}
+
+		again, err := svc.User.SignInOIDC(bg, closed, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-invited", Email: "invited@example.com"})
+		if err != nil || again.ID != invited.ID {
+			t.Errorf("second login = %v, %v", again, err)
+		}
+	})
+
+	t.Run("admin follows admin groups", func(t *testing.T) {
+		policy := domain.SignInPolicy{AdminGroups: []string{"admins"}, Registration: domain.RegistrationOpen}
+		user, err := svc.User.SignInOIDC(bg, policy, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-admin", Email: "admin@example.com", Name: "Admin", Groups: []string{"admins"}})
+		if err != nil || !user.Admin {
+			t.Fatalf("expected admin, got %+v, %v", user, err)
+		}
+		user, err = svc.User.SignInOIDC(bg, policy, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-admin", Email: "admin@example.com", Name: "Admin"})
+		if err != nil || user.Admin {
+			t.Errorf("expected admin role to be removed, got %+v, %v", user, err)
+		}
+
+		if err := svc.Admin.SetAdmin(service.WithOperator(bg), user.ID, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		user, err = svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-admin", Email: "admin@example.com", Name: "Admin"})
+		if err != nil || !user.Admin {
+			t.Errorf("without admin groups the flag must be kept, got %+v, %v", user, err)
+		}
+	})
+
+	t.Run("disabled users can't sign in", func(t *testing.T) {
+		user, err := svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-gone", Email: "gone@example.com", Name: "Gone"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.Admin.Disable(service.WithOperator(bg), user.ID, time.Now()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err = svc.User.SignInOIDC(bg, open, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-gone", Email: "gone@example.com", Name: "Gone"})
+		if !errors.Is(err, domain.ErrUserDisabled) {
+			t.Errorf("expected ErrUserDisabled, got %v", err)
+		}
+	})
+
+	t.Run("invite validates input", func(t *testing.T) {
+		if _, err := svc.User.Invite(bg, "not-an-email", "Name"); !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("expected ErrValidation, got %v", err)
+		}
+	})
+}
+
+func TestLinkLegacyUsers(t *testing.T) {
+	svc := setupTestServices(t)
+	bg := context.Background()
+	const issuer = "https://idp.example.com"
+
+	legacy, _ := svc.User.GetOrCreate(bg, "sub-legacy", "legacy@example.com", "Legacy")
+	proxied, _ := svc.User.GetOrCreate(bg, domain.HeaderSubjectPrefix+"proxied", "proxied@example.com", "Proxied")
+	invited, _ := svc.User.Invite(bg, "invited@example.com", "Invited")
+
+	if n, err := svc.User.CountLegacyUsers(bg); err != nil || n != 1 {
+		t.Fatalf("expected one user to link, got %d, %v", n, err)
+	}
+	n, err := svc.User.LinkLegacyUsers(bg, issuer)
+	if err != nil || n != 1 {
+		t.Fatalf("expected one user to be linked, got %d, %v", n, err)
+	}
+	user, err := svc.User.SignInOIDC(bg, domain.SignInPolicy{Registration: domain.RegistrationClosed}, domain.OIDCIdentity{Issuer: issuer, Subject: "sub-legacy", Email: "legacy@example.com"})
+	if err != nil || user.ID != legacy.ID {
+		t.Errorf("expected the legacy user to sign in, got %+v, %v", user, err)
+	}
+	for _, u := range []*domain.User{proxied, invited} {
+		got, _ := svc.User.GetByID(bg, u.ID)
+		if got.Subject != u.Subject {
+			t.Errorf("expected %s to be left alone, got %q", u.Email, got.Subject)
+		}
+	}
+
+	if n, err := svc.User.LinkLegacyUsers(bg, issuer); err != nil || n != 0 {
+		t.Errorf("expected nothing to do the second time, got %d, %v", n, err)
+	}
+	if n, err := svc.User.CountLegacyUsers(bg); err != nil || n != 0 {
+		t.Errorf("expected no users left to link, got %d, %v", n, err)
+	}
+}
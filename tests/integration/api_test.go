@@ -3,14 +3,22 @@
 package integration
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"icekalt.dev/money-tracker/internal/devmode"
+	"icekalt.dev/money-tracker/internal/service"
 )
 
 func TestFullFlow(t *testing.T) {
@@ -235,6 +243,29 @@ func TestTransactionCRUD(t *testing.T) {
 		t.Errorf("expected 0 transactions for wrong month, got %d", len(txList))
 	}
 
+	// Amount filters
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions?month=2026-02&min_amount=-30&max_amount=-25.50", "")
+	assertStatus(t, resp, http.StatusOK)
+	decodeJSON(t, resp, &txList)
+	if len(txList) != 1 {
+		t.Errorf("expected 1 transaction within amount range, got %d", len(txList))
+	}
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions?month=2026-02&min_amount=0", "")
+	assertStatus(t, resp, http.StatusOK)
+	decodeJSON(t, resp, &txList)
+	if len(txList) != 0 {
+		t.Errorf("expected 0 transactions with min_amount=0, got %d", len(txList))
+	}
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions?month=2026-02&min_amount=10&max_amount=5", "")
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions?month=2026-02&min_amount=abc", "")
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+
+	// More than two decimal places are rejected
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-1.005","date":"2026-02-11"}`)
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+
 	// Delete transaction
 	resp = doRequest(t, env, "DELETE", "/api/v1/households/"+hhID+"/transactions/"+txID, "")
 	assertStatus(t, resp, http.StatusNoContent)
@@ -344,6 +375,39 @@ func TestSummaryEndpoint(t *testing.T) {
 	if summary["total_expenses"] != "-75" {
 		t.Errorf("total_expenses = %v, want '-75'", summary["total_expenses"])
 	}
+
+	// Range summary
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/range?from=2026-01&to=2026-02", "")
+	assertStatus(t, resp, http.StatusOK)
+	var rangeSummary map[string]interface{}
+	decodeJSON(t, resp, &rangeSummary)
+
+	if months := rangeSummary["months"].([]interface{}); len(months) != 2 {
+		t.Errorf("len(months) = %d, want 2", len(months))
+	}
+	if rangeSummary["total"] != "925" {
+		t.Errorf("total = %v, want '925'", rangeSummary["total"])
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/range?from=2026-03&to=2026-01", "")
+	assertStatus(t, resp, http.StatusBadRequest)
+	resp.Body.Close()
+
+	// Period comparison against the previous year
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/compare?current=2026-Q1", "")
+	assertStatus(t, resp, http.StatusOK)
+	var cmp map[string]interface{}
+	decodeJSON(t, resp, &cmp)
+	if cmp["base"] != "2025-Q1" || cmp["current"] != "2026-Q1" {
+		t.Errorf("periods = %v/%v, want 2025-Q1/2026-Q1", cmp["base"], cmp["current"])
+	}
+	if newCats := cmp["new_categories"].([]interface{}); len(newCats) != 1 {
+		t.Errorf("len(new_categories) = %d, want 1", len(newCats))
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/compare?current=2026-Q5", "")
+	assertStatus(t, resp, http.StatusBadRequest)
+	resp.Body.Close()
 }
 
 func TestTokenManagement(t *testing.T) {
@@ -406,6 +470,413 @@ func TestTokenDeleteInvalidID(t *testing.T) {
 	assertStatus(t, resp, http.StatusBadRequest)
 }
 
+func TestTokenExpiryAndRotate(t *testing.T) {
+	env := setupTestEnv(t)
+
+	// Expiry in the past is rejected
+	resp := doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"Old","expires_at":"2020-01-01T00:00:00Z"}`)
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"Expiring","expires_at":"2099-01-01T00:00:00Z"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var created map[string]interface{}
+	decodeJSON(t, resp, &created)
+	if created["expires_at"] != "2099-01-01T00:00:00Z" {
+		t.Errorf("expected expires_at '2099-01-01T00:00:00Z', got %v", created["expires_at"])
+	}
+	tokenID := itoa(int(created["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/tokens/"+tokenID+"/rotate", "")
+	assertStatus(t, resp, http.StatusCreated)
+	var rotated map[string]interface{}
+	decodeJSON(t, resp, &rotated)
+	if rotated["token"] == nil || rotated["token"] == created["token"] {
+		t.Errorf("expected a new plaintext token, got %v", rotated["token"])
+	}
+	if rotated["name"] != "Expiring" {
+		t.Errorf("expected name 'Expiring', got %v", rotated["name"])
+	}
+
+	resp = doRequest(t, env, "POST", "/api/v1/tokens/"+tokenID+"/rotate", "")
+	assertStatus(t, resp, http.StatusConflict)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "POST", "/api/v1/tokens/99999/rotate", "")
+	assertStatus(t, resp, http.StatusNotFound)
+	resp.Body.Close()
+
+	if devmode.Enabled {
+		return
+	}
+
+	// Both tokens work during the grace period
+	fullToken := env.token
+	for _, tok := range []string{created["token"].(string), rotated["token"].(string)} {
+		env.token = tok
+		resp = doRequest(t, env, "GET", "/api/v1/households", "")
+		assertStatus(t, resp, http.StatusOK)
+		resp.Body.Close()
+	}
+	env.token = fullToken
+}
+
+func TestSessionManagement(t *testing.T) {
+	env := setupTestEnv(t)
+	ctx := context.Background()
+
+	for _, token := range []string{"laptop", "phone"} {
+		env.client.Session.Create().
+			SetToken(token).
+			SetData([]byte{}).
+			SetUserID(env.userID).
+			SetExpiresAt(time.Now().Add(time.Hour)).
+			SetUserAgent("Browser " + token).
+			SetIP("192.0.2.1").
+			SaveX(ctx)
+	}
+
+	resp := doRequest(t, env, "GET", "/api/v1/sessions", "")
+	assertStatus(t, resp, http.StatusOK)
+	var sessions []map[string]interface{}
+	decodeJSON(t, resp, &sessions)
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+	if sessions[0]["ip"] != "192.0.2.1" || sessions[0]["current"] != false {
+		t.Errorf("unexpected session: %v", sessions[0])
+	}
+	if _, ok := sessions[0]["token"]; ok {
+		t.Error("session token must not be exposed")
+	}
+
+	resp = doRequest(t, env, "GET", "/sessions", "")
+	assertStatus(t, resp, http.StatusOK)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/sessions/"+itoa(int(sessions[0]["id"].(float64))), "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp = doRequest(t, env, "DELETE", "/api/v1/sessions/99999", "")
+	assertStatus(t, resp, http.StatusNotFound)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/sessions", "")
+	assertStatus(t, resp, http.StatusNoContent)
+
+	resp = doRequest(t, env, "GET", "/api/v1/sessions", "")
+	assertStatus(t, resp, http.StatusOK)
+	decodeJSON(t, resp, &sessions)
+	if len(sessions) != 0 {
+		t.Errorf("expected no sessions after logging out everywhere, got %d", len(sessions))
+	}
+}
+
+func TestIdentities(t *testing.T) {
+	env := setupTestEnv(t)
+	ctx := context.Background()
+
+	for _, issuer := range []string{"https://accounts.google.com", "https://keycloak.example.com"} {
+		env.client.UserIdentity.Create().
+			SetUserID(env.userID).
+			SetIssuer(issuer).
+			SetSubject("sub-1").
+			SetEmail("test@example.com").
+			SaveX(ctx)
+	}
+
+	resp := doRequest(t, env, "GET", "/api/v1/identities", "")
+	assertStatus(t, resp, http.StatusOK)
+	var identities []map[string]interface{}
+	decodeJSON(t, resp, &identities)
+	if len(identities) != 2 || identities[0]["issuer"] != "https://accounts.google.com" {
+		t.Fatalf("unexpected identities: %v", identities)
+	}
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/identities/"+itoa(int(identities[0]["id"].(float64))), "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp = doRequest(t, env, "DELETE", "/api/v1/identities/99999", "")
+	assertStatus(t, resp, http.StatusNotFound)
+	resp.Body.Close()
+
+	// The test user has no other way to log in
+	resp = doRequest(t, env, "DELETE", "/api/v1/identities/"+itoa(int(identities[1]["id"].(float64))), "")
+	assertStatus(t, resp, http.StatusConflict)
+	resp.Body.Close()
+}
+
+func TestLocalLogin(t *testing.T) {
+	if devmode.Enabled {
+		t.Skip("dev mode uses auto-auth")
+	}
+
+	env := setupTestEnv(t)
+	if _, err := env.services.User.CreateLocal(context.Background(), "alice", "alice@example.com", "Alice", "correct horse battery"); err != nil {
+		t.Fatalf("creating local user: %v", err)
+	}
+
+	jar, _ := cookiejar.New(nil)
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(env.server.URL + "/login")
+	if err != nil {
+		t.Fatalf("loading login page: %v", err)
+	}
+	assertStatus(t, resp, http.StatusOK)
+	page, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	m := regexp.MustCompile(`name="_csrf" value="([^"]+)"`).FindSubmatch(page)
+	if m == nil {
+		t.Fatal("login page has no CSRF field")
+	}
+	if strings.Contains(string(page), "/auth/login") {
+		t.Error("login page must not offer OIDC when it isn't configured")
+	}
+
+	login := func(password string) *http.Response {
+		t.Helper()
+		resp, err := client.PostForm(env.server.URL+"/auth/local/login", url.Values{
+			"_csrf":    {string(m[1])},
+			"username": {"alice"},
+			"password": {password},
+		})
+		if err != nil {
+			t.Fatalf("logging in: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	resp = login("wrong password")
+	assertStatus(t, resp, http.StatusFound)
+	if loc := resp.Header.Get("Location"); loc != "/login?error=credentials" {
+		t.Errorf("expected redirect back to login, got %q", loc)
+	}
+
+	resp, err = client.Get(env.server.URL + "/settings")
+	if err != nil {
+		t.Fatalf("loading settings: %v", err)
+	}
+	resp.Body.Close()
+	assertStatus(t, resp, http.StatusFound)
+
+	resp = login("correct horse battery")
+	assertStatus(t, resp, http.StatusFound)
+	if loc := resp.Header.Get("Location"); loc != "/" {
+		t.Errorf("expected redirect to dashboard, got %q", loc)
+	}
+
+	resp, err = client.Get(env.server.URL + "/settings")
+	if err != nil {
+		t.Fatalf("loading settings: %v", err)
+	}
+	assertStatus(t, resp, http.StatusOK)
+	page, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(page), `action="/settings/password"`) {
+		t.Error("settings page of a local user should offer a password change")
+	}
+
+	resp, err = client.Get(env.server.URL + "/security/export")
+	if err != nil {
+		t.Fatalf("exporting security events: %v", err)
+	}
+	assertStatus(t, resp, http.StatusOK)
+	export, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	lines := strings.Split(strings.TrimSpace(string(export)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected failed and successful login, got:\n%s", export)
+	}
+	var failed, succeeded map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &failed); err != nil {
+		t.Fatalf("decoding event: %v", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &succeeded); err != nil {
+		t.Fatalf("decoding event: %v", err)
+	}
+	if failed["type"] != "login_failed" || failed["ip"] != "127.0.0.1" {
+		t.Errorf("unexpected first event: %v", failed)
+	}
+	if succeeded["type"] != "login_succeeded" {
+		t.Errorf("unexpected second event: %v", succeeded)
+	}
+}
+
+func TestLoginDeniedPage(t *testing.T) {
+	if devmode.Enabled {
+		t.Skip("dev mode has no login pages")
+	}
+
+	env := setupTestEnv(t)
+
+	req, _ := http.NewRequest("GET", env.server.URL+"/login/denied?reason=registration_closed", nil)
+	req.Header.Set("Accept-Language", "de")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("loading denied page: %v", err)
+	}
+	assertStatus(t, resp, http.StatusForbidden)
+	page, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(page), "Registrierung ist geschlossen") {
+		t.Errorf("expected localized reason, got:\n%s", page)
+	}
+}
+
+func TestAdminPage(t *testing.T) {
+	env := setupTestEnv(t)
+	ctx := context.Background()
+
+	resp := doRequest(t, env, "GET", "/admin", "")
+	assertStatus(t, resp, http.StatusForbidden)
+	resp.Body.Close()
+
+	if err := env.services.Admin.SetAdmin(service.WithOperator(ctx), env.userID, true); err != nil {
+		t.Fatalf("making user admin: %v", err)
+	}
+
+	resp = doRequest(t, env, "GET", "/admin", "")
+	assertStatus(t, resp, http.StatusOK)
+	page, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(page), "Database") {
+		t.Errorf("expected database stats on admin page, got:\n%s", page)
+	}
+}
+
+func TestSecurityEvents(t *testing.T) {
+	if devmode.Enabled {
+		t.Skip("dev mode uses auto-auth")
+	}
+
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"CI"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/security-events", "")
+	assertStatus(t, resp, http.StatusOK)
+	var events []map[string]interface{}
+	decodeJSON(t, resp, &events)
+	if len(events) == 0 || events[0]["type"] != "token_created" {
+		t.Fatalf("expected token_created as latest event, got %v", events)
+	}
+	if details, _ := events[0]["details"].(map[string]interface{}); details["token_name"] != "CI" {
+		t.Errorf("expected token name in details, got %v", events[0]["details"])
+	}
+
+	req, _ := http.NewRequest("GET", env.server.URL+"/api/v1/households", nil)
+	req.Header.Set("Authorization", "Bearer mt_guessed")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("executing request: %v", err)
+	}
+	assertStatus(t, resp, http.StatusUnauthorized)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/admin/events/export", "")
+	assertStatus(t, resp, http.StatusForbidden)
+	resp.Body.Close()
+
+	if err := env.services.Admin.SetAdmin(service.WithOperator(context.Background()), env.userID, true); err != nil {
+		t.Fatalf("making user admin: %v", err)
+	}
+	resp = doRequest(t, env, "GET", "/admin/events/export", "")
+	assertStatus(t, resp, http.StatusOK)
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("expected JSON Lines, got %q", ct)
+	}
+	export, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(export), `"type":"token_rejected"`) {
+		t.Errorf("expected rejected token in the global export, got:\n%s", export)
+	}
+
+	resp = doRequest(t, env, "GET", "/admin/events", "")
+	assertStatus(t, resp, http.StatusOK)
+	resp.Body.Close()
+	resp = doRequest(t, env, "GET", "/security", "")
+	assertStatus(t, resp, http.StatusOK)
+	resp.Body.Close()
+}
+
+func TestTokenScopes(t *testing.T) {
+	if devmode.Enabled {
+		t.Skip("dev mode uses auto-auth")
+	}
+
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/households", `{"name":"Allowed","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var allowed map[string]interface{}
+	decodeJSON(t, resp, &allowed)
+	allowedID := itoa(int(allowed["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/households", `{"name":"Hidden","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var hidden map[string]interface{}
+	decodeJSON(t, resp, &hidden)
+	hiddenID := itoa(int(hidden["id"].(float64)))
+
+	// Invalid scopes are rejected
+	resp = doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"Bad","access":"admin"}`)
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+	resp.Body.Close()
+	resp = doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"Bad","household_ids":[99999]}`)
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"Read Only","access":"read","household_ids":[`+allowedID+`]}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var created map[string]interface{}
+	decodeJSON(t, resp, &created)
+	if created["access"] != "read" {
+		t.Errorf("expected access 'read', got %v", created["access"])
+	}
+	if ids, _ := created["household_ids"].([]interface{}); len(ids) != 1 {
+		t.Errorf("expected one household ID, got %v", created["household_ids"])
+	}
+
+	fullToken := env.token
+	env.token = created["token"].(string)
+
+	// Only the allowed household is visible
+	resp = doRequest(t, env, "GET", "/api/v1/households", "")
+	assertStatus(t, resp, http.StatusOK)
+	var households []map[string]interface{}
+	decodeJSON(t, resp, &households)
+	if len(households) != 1 || itoa(int(households[0]["id"].(float64))) != allowedID {
+		t.Errorf("expected only household %s, got %v", allowedID, households)
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+allowedID+"/categories", "")
+	assertStatus(t, resp, http.StatusOK)
+	resp.Body.Close()
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hiddenID+"/categories", "")
+	assertStatus(t, resp, http.StatusForbidden)
+	resp.Body.Close()
+
+	// Writes are rejected
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+allowedID+"/categories", `{"name":"Nope"}`)
+	assertStatus(t, resp, http.StatusForbidden)
+	resp.Body.Close()
+	resp = doRequest(t, env, "POST", "/api/v1/tokens", `{"name":"Escalate"}`)
+	assertStatus(t, resp, http.StatusForbidden)
+	resp.Body.Close()
+
+	env.token = fullToken
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+allowedID+"/categories", `{"name":"Yes"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	resp.Body.Close()
+}
+
 func TestHandlerErrorPaths(t *testing.T) {
 	env := setupTestEnv(t)
 
@@ -723,6 +1194,248 @@ func TestTransactionUpdate(t *testing.T) {
 	}
 }
 
+func TestHistory(t *testing.T) {
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/households", `{"name":"History Test","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var hh map[string]interface{}
+	decodeJSON(t, resp, &hh)
+	hhID := itoa(int(hh["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/categories", `{"name":"Bills"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var cat map[string]interface{}
+	decodeJSON(t, resp, &cat)
+	catID := itoa(int(cat["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-25.50","description":"Electric","date":"2026-02-10"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var tx map[string]interface{}
+	decodeJSON(t, resp, &tx)
+	txID := itoa(int(tx["id"].(float64)))
+
+	resp = doRequest(t, env, "PUT", "/api/v1/households/"+hhID+"/transactions/"+txID,
+		`{"category_id":`+catID+`,"amount":"-30.00","description":"Electric","date":"2026-02-10"}`)
+	assertStatus(t, resp, http.StatusOK)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions/"+txID+"/history", "")
+	assertStatus(t, resp, http.StatusOK)
+	var revisions []map[string]interface{}
+	decodeJSON(t, resp, &revisions)
+	if len(revisions) != 2 || revisions[0]["action"] != "update" || revisions[1]["action"] != "create" {
+		t.Fatalf("expected update and create, got %v", revisions)
+	}
+	if int(revisions[0]["user_id"].(float64)) != env.userID {
+		t.Errorf("expected user %d, got %v", env.userID, revisions[0]["user_id"])
+	}
+	changes := revisions[0]["changes"].([]interface{})
+	if len(changes) != 1 {
+		t.Fatalf("expected one change, got %v", changes)
+	}
+	if ch := changes[0].(map[string]interface{}); ch["field"] != "amount" || ch["before"] != "-25.50" || ch["after"] != "-30.00" {
+		t.Errorf("unexpected change %v", ch)
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/history", "")
+	assertStatus(t, resp, http.StatusOK)
+	decodeJSON(t, resp, &revisions)
+	if len(revisions) != 1 || revisions[0]["entity_type"] != "household" {
+		t.Errorf("expected the household's creation, got %v", revisions)
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/999999/transactions/"+txID+"/history", "")
+	assertStatus(t, resp, http.StatusNotFound)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/households/"+hhID+"/transactions/"+txID+"/edit", "")
+	assertStatus(t, resp, http.StatusOK)
+	page, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(page), "History") || !strings.Contains(string(page), "-25.50") {
+		t.Errorf("expected history panel with the old amount on the edit page")
+	}
+}
+
+func TestTrash(t *testing.T) {
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/households", `{"name":"Trash Test","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var hh map[string]interface{}
+	decodeJSON(t, resp, &hh)
+	hhID := itoa(int(hh["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/categories", `{"name":"Bills"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var cat map[string]interface{}
+	decodeJSON(t, resp, &cat)
+	catID := itoa(int(cat["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-25.50","description":"Electric","date":"2026-02-10"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var tx map[string]interface{}
+	decodeJSON(t, resp, &tx)
+	txID := itoa(int(tx["id"].(float64)))
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/households/"+hhID+"/transactions/"+txID, "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions?month=2026-02", "")
+	assertStatus(t, resp, http.StatusOK)
+	var txs []map[string]interface{}
+	decodeJSON(t, resp, &txs)
+	if len(txs) != 0 {
+		t.Errorf("expected the deleted transaction to be hidden, got %v", txs)
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/trash", "")
+	assertStatus(t, resp, http.StatusOK)
+	var trash struct {
+		Transactions  []map[string]interface{} `json:"transactions"`
+		RetentionDays int                      `json:"retention_days"`
+	}
+	decodeJSON(t, resp, &trash)
+	if len(trash.Transactions) != 1 || trash.Transactions[0]["deleted_at"] == nil || trash.RetentionDays != 30 {
+		t.Fatalf("expected the transaction in the trash, got %+v", trash)
+	}
+
+	resp = doRequest(t, env, "GET", "/households/"+hhID+"/settings?section=trash", "")
+	assertStatus(t, resp, http.StatusOK)
+	page, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(page), "Electric") || !strings.Contains(string(page), "/transactions/"+txID+"/restore") {
+		t.Errorf("expected the transaction with a restore button on the trash page")
+	}
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions/"+txID+"/restore", "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp.Body.Close()
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions/"+txID+"/restore", "")
+	assertStatus(t, resp, http.StatusNotFound)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/households/"+hhID, "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/trash", "")
+	assertStatus(t, resp, http.StatusOK)
+	var deleted []map[string]interface{}
+	decodeJSON(t, resp, &deleted)
+	if len(deleted) != 1 || itoa(int(deleted[0]["id"].(float64))) != hhID {
+		t.Fatalf("expected the household in the trash, got %v", deleted)
+	}
+
+	resp = doRequest(t, env, "GET", "/", "")
+	assertStatus(t, resp, http.StatusOK)
+	page, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(page), "/households/"+hhID+"/restore") {
+		t.Errorf("expected the deleted household with a restore button on the dashboard")
+	}
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/restore", "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/transactions?month=2026-02", "")
+	assertStatus(t, resp, http.StatusOK)
+	decodeJSON(t, resp, &txs)
+	if len(txs) != 1 {
+		t.Errorf("expected the restored household with its transaction, got %v", txs)
+	}
+}
+
+func TestAccount(t *testing.T) {
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/households", `{"name":"Export Test","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var hh map[string]interface{}
+	decodeJSON(t, resp, &hh)
+	hhID := itoa(int(hh["id"].(float64)))
+
+	resp = doRequest(t, env, "GET", "/api/v1/account/export", "")
+	assertStatus(t, resp, http.StatusOK)
+	if ct := resp.Header.Get("Content-Type"); ct != "application/zip" {
+		t.Errorf("expected a ZIP, got %q", ct)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("opening %s: %v", f.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	for _, name := range []string{"profile.json", "households/" + hhID + ".json", "revisions.json", "identities.json", "api_tokens.json", "sessions.json", "security_events.json"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected %s in the export, got %v", name, zr.File)
+		}
+	}
+	if !strings.Contains(files["profile.json"], "test@example.com") || !strings.Contains(files["api_tokens.json"], "test-token") {
+		t.Errorf("unexpected profile or tokens: %s %s", files["profile.json"], files["api_tokens.json"])
+	}
+	if !strings.Contains(files["revisions.json"], `"name": "Export Test"`) {
+		t.Errorf("expected the creation of the household in the revisions, got %s", files["revisions.json"])
+	}
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/account", `{"confirm":"test@example.com"}`)
+	assertStatus(t, resp, http.StatusConflict)
+	resp.Body.Close()
+	resp = doRequest(t, env, "DELETE", "/api/v1/account", `{"confirm":"someone@example.com","households":"delete"}`)
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/account", `{"confirm":"test@example.com","households":"transfer","transfer_to":"nobody@example.com"}`)
+	assertStatus(t, resp, http.StatusAccepted)
+	resp.Body.Close()
+	resp = doRequest(t, env, "GET", "/api/v1/account/transfers", "")
+	assertStatus(t, resp, http.StatusOK)
+	var transfers struct {
+		Incoming []map[string]interface{} `json:"incoming"`
+		Outgoing []map[string]interface{} `json:"outgoing"`
+	}
+	decodeJSON(t, resp, &transfers)
+	if len(transfers.Incoming) != 0 || len(transfers.Outgoing) != 1 || transfers.Outgoing[0]["to"] != "nobody@example.com" {
+		t.Fatalf("expected the household to be offered, got %+v", transfers)
+	}
+	transferID := itoa(int(transfers.Outgoing[0]["id"].(float64)))
+	resp = doRequest(t, env, "POST", "/api/v1/account/transfers/"+transferID+"/accept", "")
+	assertStatus(t, resp, http.StatusNotFound)
+	resp.Body.Close()
+	resp = doRequest(t, env, "DELETE", "/api/v1/account/transfers/"+transferID, "")
+	assertStatus(t, resp, http.StatusNoContent)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "DELETE", "/api/v1/account", `{"confirm":"test@example.com","households":"delete"}`)
+	assertStatus(t, resp, http.StatusNoContent)
+	resp.Body.Close()
+
+	if !devmode.Enabled {
+		// Dev builds fall back to the dev user without a token.
+		resp = doRequest(t, env, "GET", "/api/v1/households", "")
+		assertStatus(t, resp, http.StatusUnauthorized)
+		resp.Body.Close()
+	}
+	if n := env.client.Household.Query().CountX(context.Background()); n != 0 {
+		t.Errorf("expected the household to be deleted, %d left", n)
+	}
+}
+
 func TestHouseholdFullFields(t *testing.T) {
 	env := setupTestEnv(t)
 
@@ -786,6 +1499,88 @@ func TestCategoryWithIcon(t *testing.T) {
 	}
 }
 
+func TestTaxSummary(t *testing.T) {
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/households", `{"name":"Tax Test","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var hh map[string]interface{}
+	decodeJSON(t, resp, &hh)
+	hhID := itoa(int(hh["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/categories", `{"name":"Repairs","tax_class":"craftsman_services"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var cat map[string]interface{}
+	decodeJSON(t, resp, &cat)
+	catID := itoa(int(cat["id"].(float64)))
+	if cat["tax_class"] != "craftsman_services" {
+		t.Errorf("tax_class = %v, want 'craftsman_services'", cat["tax_class"])
+	}
+
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/categories", `{"name":"Bad","tax_class":"bogus"}`)
+	assertStatus(t, resp, http.StatusBadRequest)
+	resp.Body.Close()
+
+	// Inherits the category class
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-400","description":"Plumber","date":"2025-03-10"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	resp.Body.Close()
+
+	// Explicitly excluded
+	resp = doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-50","description":"Screws","tax_class":"none","date":"2025-04-02"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var tx map[string]interface{}
+	decodeJSON(t, resp, &tx)
+	if tx["tax_class"] != "none" {
+		t.Errorf("tax_class = %v, want 'none'", tx["tax_class"])
+	}
+
+	// Outside the year
+	doRequest(t, env, "POST", "/api/v1/households/"+hhID+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-99","date":"2026-01-05"}`).Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/tax?year=2025", "")
+	assertStatus(t, resp, http.StatusOK)
+	var summary map[string]interface{}
+	decodeJSON(t, resp, &summary)
+	if summary["total"] != "-400" {
+		t.Errorf("total = %v, want '-400'", summary["total"])
+	}
+	classes := summary["classes"].([]interface{})
+	if len(classes) != 1 {
+		t.Fatalf("len(classes) = %d, want 1", len(classes))
+	}
+	if c := classes[0].(map[string]interface{}); c["class"] != "craftsman_services" {
+		t.Errorf("class = %v, want 'craftsman_services'", c["class"])
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/tax?year=abc", "")
+	assertStatus(t, resp, http.StatusBadRequest)
+	resp.Body.Close()
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/tax/export?year=2025&format=csv", "")
+	assertStatus(t, resp, http.StatusOK)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "Plumber") || strings.Contains(string(body), "Screws") {
+		t.Errorf("unexpected CSV export:\n%s", body)
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/tax/export?year=2025&format=pdf", "")
+	assertStatus(t, resp, http.StatusOK)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.HasPrefix(string(body), "%PDF-") {
+		t.Errorf("PDF export does not start with %%PDF-")
+	}
+
+	resp = doRequest(t, env, "GET", "/api/v1/households/"+hhID+"/summary/tax/export?year=2025&format=xls", "")
+	assertStatus(t, resp, http.StatusBadRequest)
+	resp.Body.Close()
+}
+
 func TestScheduleOverrideCRUD(t *testing.T) {
 	env := setupTestEnv(t)
 
@@ -999,3 +1794,97 @@ func TestRecurringExpenseDetails(t *testing.T) {
 func itoa(i int) string {
 	return fmt.Sprintf("%d", i)
 }
+
+func TestMemberBalances(t *testing.T) {
+	env := setupTestEnv(t)
+
+	resp := doRequest(t, env, "POST", "/api/v1/households", `{"name":"WG","currency":"EUR"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var hh map[string]interface{}
+	decodeJSON(t, resp, &hh)
+	base := "/api/v1/households/" + itoa(int(hh["id"].(float64)))
+
+	resp = doRequest(t, env, "POST", base+"/categories", `{"name":"Einkauf"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var cat map[string]interface{}
+	decodeJSON(t, resp, &cat)
+	catID := itoa(int(cat["id"].(float64)))
+
+	memberIDs := map[string]string{}
+	for _, name := range []string{"Anna", "Ben"} {
+		resp = doRequest(t, env, "POST", base+"/members", `{"name":"`+name+`"}`)
+		assertStatus(t, resp, http.StatusCreated)
+		var m map[string]interface{}
+		decodeJSON(t, resp, &m)
+		memberIDs[name] = itoa(int(m["id"].(float64)))
+	}
+	anna, ben := memberIDs["Anna"], memberIDs["Ben"]
+
+	// Duplicate names are rejected
+	resp = doRequest(t, env, "POST", base+"/members", `{"name":"Anna"}`)
+	assertStatus(t, resp, http.StatusConflict)
+
+	// Anna pays 90, split 2:1 with fixed shares
+	resp = doRequest(t, env, "POST", base+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-90.00","date":"2026-01-15","paid_by_member_id":`+anna+`,"split_type":"fixed","shares":[{"member_id":`+anna+`,"value":"60.00"},{"member_id":`+ben+`,"value":"30.00"}]}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var tx map[string]interface{}
+	decodeJSON(t, resp, &tx)
+	if tx["split_type"] != "fixed" {
+		t.Errorf("expected split_type fixed, got %v", tx["split_type"])
+	}
+	if shares, _ := tx["shares"].([]interface{}); len(shares) != 2 {
+		t.Errorf("expected 2 shares, got %v", tx["shares"])
+	}
+
+	// Shares that don't add up are rejected
+	resp = doRequest(t, env, "POST", base+"/transactions",
+		`{"category_id":`+catID+`,"amount":"-90.00","date":"2026-01-15","paid_by_member_id":`+anna+`,"split_type":"fixed","shares":[{"member_id":`+anna+`,"value":"10.00"}]}`)
+	assertStatus(t, resp, http.StatusUnprocessableEntity)
+
+	resp = doRequest(t, env, "GET", base+"/balances", "")
+	assertStatus(t, resp, http.StatusOK)
+	var balances struct {
+		Members []struct {
+			MemberID int    `json:"member_id"`
+			Balance  string `json:"balance"`
+		} `json:"members"`
+		Transfers []struct {
+			FromMemberID int    `json:"from_member_id"`
+			ToMemberID   int    `json:"to_member_id"`
+			Amount       string `json:"amount"`
+		} `json:"transfers"`
+	}
+	decodeJSON(t, resp, &balances)
+	if len(balances.Transfers) != 1 || itoa(balances.Transfers[0].FromMemberID) != ben || balances.Transfers[0].Amount != "30" {
+		t.Fatalf("expected Ben to pay Anna 30, got %+v", balances.Transfers)
+	}
+
+	// A member that is part of a split can't be deleted
+	resp = doRequest(t, env, "DELETE", base+"/members/"+ben, "")
+	assertStatus(t, resp, http.StatusConflict)
+
+	resp = doRequest(t, env, "POST", base+"/settlements",
+		`{"from_member_id":`+ben+`,"to_member_id":`+anna+`,"amount":"30.00","date":"2026-01-20","note":"Überweisung"}`)
+	assertStatus(t, resp, http.StatusCreated)
+	var settlement map[string]interface{}
+	decodeJSON(t, resp, &settlement)
+
+	resp = doRequest(t, env, "GET", base+"/balances", "")
+	assertStatus(t, resp, http.StatusOK)
+	decodeJSON(t, resp, &balances)
+	if len(balances.Transfers) != 0 {
+		t.Errorf("expected settled balances, got %+v", balances.Transfers)
+	}
+
+	resp = doRequest(t, env, "GET", base+"/settlements", "")
+	assertStatus(t, resp, http.StatusOK)
+	var settlements []map[string]interface{}
+	decodeJSON(t, resp, &settlements)
+	if len(settlements) != 1 {
+		t.Errorf("expected 1 settlement, got %d", len(settlements))
+	}
+
+	resp = doRequest(t, env, "DELETE", base+"/settlements/"+itoa(int(settlement["id"].(float64))), "")
+	assertStatus(t, resp, http.StatusNoContent)
+}
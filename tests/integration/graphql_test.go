@@ -224,6 +224,18 @@ func TestGraphQLTransactions(t *testing.T) {
 	if updatedTx["description"] != "Electric Updated" {
 		t.Errorf("expected description 'Electric Updated', got %v", updatedTx["description"])
 	}
+
+	// History
+	result = gqlRequest(t, env, `{ history(householdID: `+itoa(hhID)+`, entityType: "transaction", entityID: `+itoa(txID)+`) { action changes { field before after } } }`)
+	data = gqlData(t, result)
+	history := data["history"].([]interface{})
+	if len(history) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(history))
+	}
+	latest := history[0].(map[string]interface{})
+	if latest["action"] != "update" || len(latest["changes"].([]interface{})) != 4 {
+		t.Errorf("expected an update of amount, date, description and details, got %v", latest)
+	}
 }
 
 func TestGraphQLRecurringExpenses(t *testing.T) {
@@ -289,6 +301,68 @@ func TestGraphQLRecurringExpenses(t *testing.T) {
 	}
 }
 
+func TestGraphQLMemberBalances(t *testing.T) {
+	env := setupTestEnv(t)
+
+	result := gqlRequest(t, env, `mutation {
+		createHousehold(input: {name: "WG GQL", currency: "EUR"}) { id }
+	}`)
+	hhID := itoa(int(gqlData(t, result)["createHousehold"].(map[string]interface{})["id"].(float64)))
+
+	result = gqlRequest(t, env, `mutation {
+		createCategory(input: {householdID: `+hhID+`, name: "Internet"}) { id }
+	}`)
+	catID := itoa(int(gqlData(t, result)["createCategory"].(map[string]interface{})["id"].(float64)))
+
+	var memberIDs []string
+	for _, name := range []string{"Anna", "Ben"} {
+		result = gqlRequest(t, env, `mutation {
+			createMember(input: {householdID: `+hhID+`, name: "`+name+`"}) { id name }
+		}`)
+		member := gqlData(t, result)["createMember"].(map[string]interface{})
+		memberIDs = append(memberIDs, itoa(int(member["id"].(float64))))
+	}
+
+	// Ben pays 40, 75% of it is Anna's
+	result = gqlRequest(t, env, `mutation {
+		createTransaction(input: {householdID: `+hhID+`, categoryID: `+catID+`, amount: "-40.00", date: "2026-02-01", paidByMemberID: `+memberIDs[1]+`, splitType: "percentage", shares: [{memberID: `+memberIDs[0]+`, value: "75"}, {memberID: `+memberIDs[1]+`, value: "25"}]}) {
+			id paidByMemberID splitType shares { memberID value }
+		}
+	}`)
+	tx := gqlData(t, result)["createTransaction"].(map[string]interface{})
+	if tx["splitType"] != "percentage" {
+		t.Errorf("expected splitType 'percentage', got %v", tx["splitType"])
+	}
+	if shares := tx["shares"].([]interface{}); len(shares) != 2 {
+		t.Errorf("expected 2 shares, got %d", len(shares))
+	}
+
+	result = gqlRequest(t, env, `{ balances(householdID: `+hhID+`) { members { memberID balance } transfers { fromMemberID toMemberID amount } } }`)
+	balances := gqlData(t, result)["balances"].(map[string]interface{})
+	transfers := balances["transfers"].([]interface{})
+	if len(transfers) != 1 {
+		t.Fatalf("expected 1 transfer, got %v", transfers)
+	}
+	transfer := transfers[0].(map[string]interface{})
+	if itoa(int(transfer["fromMemberID"].(float64))) != memberIDs[0] || transfer["amount"] != "30" {
+		t.Errorf("expected Anna to pay 30, got %v", transfer)
+	}
+
+	result = gqlRequest(t, env, `mutation {
+		recordSettlement(input: {householdID: `+hhID+`, fromMemberID: `+memberIDs[0]+`, toMemberID: `+memberIDs[1]+`, amount: "30.00", date: "2026-02-03"}) { id amount }
+	}`)
+	gqlData(t, result)
+
+	result = gqlRequest(t, env, `{ settlements(householdID: `+hhID+`) { id } balances(householdID: `+hhID+`) { transfers { amount } } }`)
+	data := gqlData(t, result)
+	if settlements := data["settlements"].([]interface{}); len(settlements) != 1 {
+		t.Errorf("expected 1 settlement, got %d", len(settlements))
+	}
+	if transfers := data["balances"].(map[string]interface{})["transfers"].([]interface{}); len(transfers) != 0 {
+		t.Errorf("expected settled balances, got %v", transfers)
+	}
+}
+
 func TestGraphQLSummary(t *testing.T) {
 	env := setupTestEnv(t)
 
@@ -339,6 +413,23 @@ func TestGraphQLSummary(t *testing.T) {
 	if len(breakdown) == 0 {
 		t.Error("expected non-empty category breakdown")
 	}
+
+	// Compare with the previous month
+	result = gqlRequest(t, env, `{ periodComparison(householdID: `+itoa(hhID)+`, base: "2025-12", current: "2026-01") {
+		base current total { delta percent }
+		categories { categoryName }
+		newCategories { categoryName recurring { delta } }
+	}}`)
+	cmp := gqlData(t, result)["periodComparison"].(map[string]interface{})
+	if cmp["base"] != "2025-12" || cmp["current"] != "2026-01" {
+		t.Errorf("unexpected periods %v / %v", cmp["base"], cmp["current"])
+	}
+	if total := cmp["total"].(map[string]interface{}); total["delta"] != "1925" || total["percent"] != nil {
+		t.Errorf("unexpected total delta %v", total)
+	}
+	if newCats := cmp["newCategories"].([]interface{}); len(newCats) != 1 {
+		t.Errorf("expected 1 new category, got %d", len(newCats))
+	}
 }
 
 func TestGraphQLAuth(t *testing.T) {
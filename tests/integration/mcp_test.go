@@ -659,6 +659,73 @@ func TestMCPMonthlySummary(t *testing.T) {
 	}
 }
 
+func TestMCPMemberBalances(t *testing.T) {
+	_, session := setupMCPEnv(t)
+
+	text := callTool(t, session, "create_household", map[string]any{
+		"name": "WG", "currency": "EUR",
+	})
+	hhID := int(parseJSONObject(t, text)["id"].(float64))
+
+	text = callTool(t, session, "create_category", map[string]any{
+		"household_id": hhID, "name": "Einkauf",
+	})
+	catID := int(parseJSONObject(t, text)["id"].(float64))
+
+	var memberIDs []int
+	for _, name := range []string{"Anna", "Ben"} {
+		text = callTool(t, session, "create_member", map[string]any{
+			"household_id": hhID, "name": name,
+		})
+		memberIDs = append(memberIDs, int(parseJSONObject(t, text)["id"].(float64)))
+	}
+
+	text = callTool(t, session, "list_members", map[string]any{"household_id": hhID})
+	if members := parseJSONArray(t, text); len(members) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(members))
+	}
+
+	// Anna pays 60, split equally between all members
+	text = callTool(t, session, "create_transaction", map[string]any{
+		"household_id":      hhID,
+		"category_id":       catID,
+		"amount":            "-60.00",
+		"description":       "Wocheneinkauf",
+		"date":              "2026-01-10",
+		"paid_by_member_id": memberIDs[0],
+		"split_type":        "equal",
+	})
+	tx := parseJSONObject(t, text)
+	if tx["split_type"] != "equal" {
+		t.Errorf("expected split_type 'equal', got %v", tx["split_type"])
+	}
+
+	text = callTool(t, session, "get_balances", map[string]any{"household_id": hhID})
+	balances := parseJSONObject(t, text)
+	transfers, _ := balances["transfers"].([]any)
+	if len(transfers) != 1 {
+		t.Fatalf("expected 1 transfer, got %v", balances["transfers"])
+	}
+	transfer := transfers[0].(map[string]any)
+	if int(transfer["from_member_id"].(float64)) != memberIDs[1] || transfer["amount"] != "30" {
+		t.Errorf("expected Ben to pay 30, got %v", transfer)
+	}
+
+	callTool(t, session, "record_settlement", map[string]any{
+		"household_id":   hhID,
+		"from_member_id": memberIDs[1],
+		"to_member_id":   memberIDs[0],
+		"amount":         "30.00",
+		"date":           "2026-01-12",
+	})
+
+	text = callTool(t, session, "get_balances", map[string]any{"household_id": hhID})
+	balances = parseJSONObject(t, text)
+	if transfers, _ := balances["transfers"].([]any); len(transfers) != 0 {
+		t.Errorf("expected settled balances, got %v", transfers)
+	}
+}
+
 // --- MCP Resources Test ---
 
 func TestMCPResources(t *testing.T) {
@@ -818,7 +885,8 @@ func TestMCPToolListing(t *testing.T) {
 		"list_transactions", "create_transaction", "update_transaction", "delete_transaction",
 		"list_recurring_expenses", "create_recurring_expense", "update_recurring_expense", "delete_recurring_expense",
 		"list_schedule_overrides", "create_schedule_override", "update_schedule_override", "delete_schedule_override",
-		"get_monthly_summary",
+		"list_members", "create_member", "get_balances", "record_settlement",
+		"get_monthly_summary", "get_range_summary", "compare_periods", "get_tax_summary",
 	}
 
 	toolNames := make(map[string]bool)
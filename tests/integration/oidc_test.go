@@ -0,0 +1,272 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"icekalt.dev/money-tracker/ent/useridentity"
+	"icekalt.dev/money-tracker/internal/auth"
+	"icekalt.dev/money-tracker/internal/devidp"
+	"icekalt.dev/money-tracker/internal/devmode"
+)
+
+// startIDP serves a development OIDC provider with the users.
+func startIDP(t *testing.T, users ...devidp.User) *devidp.Provider {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(nil)
+	idp, err := devidp.New("http://"+srv.Listener.Addr().String(), users...)
+	if err != nil {
+		t.Fatalf("creating provider: %v", err)
+	}
+	srv.Config.Handler = idp
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return idp
+}
+
+// oidcProvider registers the server at the provider and sets it up as the
+// OIDC provider with the name.
+func oidcProvider(t *testing.T, idp *devidp.Provider, name, serverURL string) *auth.OIDCConfig {
+	t.Helper()
+	callback := serverURL + "/auth/callback"
+	if name != "" {
+		callback += "/" + name
+	}
+	idp.Clients = []devidp.Client{{ID: "money-tracker", Secret: "secret", RedirectURIs: []string{callback}}}
+
+	cfg, err := auth.NewOIDC(context.Background(), idp.Issuer(), "money-tracker", "secret", callback)
+	if err != nil {
+		t.Fatalf("setting up OIDC: %v", err)
+	}
+	cfg.Name = name
+	cfg.PostLogoutRedirectURL = serverURL + "/"
+	return cfg
+}
+
+// browser is an HTTP client with cookies that follows redirects unless
+// told otherwise.
+type browser struct {
+	*http.Client
+	follow bool
+}
+
+func newBrowser() *browser {
+	jar, _ := cookiejar.New(nil)
+	b := &browser{follow: true}
+	b.Client = &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			if !b.follow {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+	return b
+}
+
+// page loads a page and returns the response with its body and CSRF token.
+func (b *browser) page(t *testing.T, u string) (*http.Response, string, string) {
+	t.Helper()
+	resp, err := b.Get(u)
+	if err != nil {
+		t.Fatalf("loading %s: %v", u, err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	var csrf string
+	if m := regexp.MustCompile(`name="_csrf" value="([^"]+)"`).FindSubmatch(body); m != nil {
+		csrf = string(m[1])
+	}
+	return resp, string(body), csrf
+}
+
+func TestOIDCLogin(t *testing.T) {
+	if devmode.Enabled {
+		t.Skip("dev mode uses auto-auth")
+	}
+
+	verified, unverified := true, false
+	home := startIDP(t, devidp.User{Subject: "alice", Email: "alice@example.com", Name: "Alice"})
+	family := startIDP(t,
+		devidp.User{Subject: "alice-kc", Email: "alice@example.com", EmailVerified: &verified, Name: "Alice"},
+		devidp.User{Subject: "bob-kc", Email: "bob@example.com", EmailVerified: &verified, Name: "Bob"},
+		devidp.User{Subject: "bob-unverified", Email: "bob@example.com", EmailVerified: &unverified, Name: "Not Bob"},
+	)
+	env := setupTestEnvWithOIDC(t, func(serverURL string) []*auth.OIDCConfig {
+		keycloak := oidcProvider(t, family, "keycloak", serverURL)
+		keycloak.DisplayName = "Family Keycloak"
+		return []*auth.OIDCConfig{oidcProvider(t, home, "", serverURL), keycloak}
+	})
+	ctx := context.Background()
+
+	t.Run("login page", func(t *testing.T) {
+		_, page, _ := newBrowser().page(t, env.server.URL+"/login")
+		for _, want := range []string{`href="/auth/login"`, `href="/auth/login/keycloak"`, "Sign in with OIDC", "Sign in with Family Keycloak"} {
+			if !strings.Contains(page, want) {
+				t.Errorf("expected the login page to contain %s", want)
+			}
+		}
+		resp, _, _ := newBrowser().page(t, env.server.URL+"/auth/login/unknown")
+		assertStatus(t, resp, http.StatusNotFound)
+	})
+
+	alice := newBrowser()
+	t.Run("login", func(t *testing.T) {
+		home.LoginAs("alice")
+		resp, _, _ := alice.page(t, env.server.URL+"/auth/login")
+		assertStatus(t, resp, http.StatusOK)
+		if resp.Request.URL.Path != "/" {
+			t.Errorf("expected to end up on the dashboard, got %s", resp.Request.URL)
+		}
+
+		identity, err := env.client.UserIdentity.Query().Where(useridentity.Issuer(home.Issuer()), useridentity.Subject("alice")).WithUser().Only(ctx)
+		if err != nil {
+			t.Fatalf("expected an identity for the login, got %v", err)
+		}
+		if identity.Edges.User.Email != "alice@example.com" {
+			t.Errorf("unexpected user %+v", identity.Edges.User)
+		}
+	})
+
+	t.Run("link another provider", func(t *testing.T) {
+		resp, page, csrf := alice.page(t, env.server.URL+"/settings")
+		assertStatus(t, resp, http.StatusOK)
+		if !strings.Contains(page, home.Issuer()) || !strings.Contains(page, "Link Family Keycloak") {
+			t.Fatal("expected the settings to list the identity and offer linking")
+		}
+
+		family.LoginAs("alice-kc")
+		resp, err := alice.PostForm(env.server.URL+"/settings/identities/link/keycloak", url.Values{"_csrf": {csrf}})
+		if err != nil {
+			t.Fatalf("linking: %v", err)
+		}
+		resp.Body.Close()
+		if resp.Request.URL.Path != "/settings" || resp.Request.URL.RawQuery != "" {
+			t.Errorf("expected to come back to the settings, got %s", resp.Request.URL)
+		}
+		if n := env.client.UserIdentity.Query().Where(useridentity.Issuer(family.Issuer())).CountX(ctx); n != 1 {
+			t.Errorf("expected the identity to be linked, got %d", n)
+		}
+
+		// Logging in with it reaches the same account
+		other := newBrowser()
+		resp, _, _ = other.page(t, env.server.URL+"/auth/login/keycloak")
+		if resp.Request.URL.Path != "/" {
+			t.Errorf("expected the linked login to work, got %s", resp.Request.URL)
+		}
+	})
+
+	t.Run("logout at the provider", func(t *testing.T) {
+		alice.follow = false
+		defer func() { alice.follow = true }()
+		resp, _, _ := alice.page(t, env.server.URL+"/auth/logout")
+		assertStatus(t, resp, http.StatusFound)
+		loc, _ := url.Parse(resp.Header.Get("Location"))
+		if !strings.HasPrefix(loc.String(), home.Issuer()+"/logout?") || loc.Query().Get("id_token_hint") == "" {
+			t.Errorf("expected the logout at the provider, got %s", loc)
+		}
+	})
+
+	t.Run("email of an existing account", func(t *testing.T) {
+		if _, err := env.services.User.CreateLocal(ctx, "bob", "bob@example.com", "Bob", "correct horse battery"); err != nil {
+			t.Fatalf("creating local user: %v", err)
+		}
+
+		// signIn tries the provider, then logs in to the account in the
+		// same browser and returns the confirm page's CSRF token.
+		signIn := func(t *testing.T, bob *browser) string {
+			t.Helper()
+			family.LoginAs("bob-kc")
+			resp, page, csrf := bob.page(t, env.server.URL+"/auth/login/keycloak")
+			if resp.Request.URL.Path != "/login" || !strings.Contains(page, "An account with bob@example.com already exists") {
+				t.Fatalf("expected to be asked to log in to the account, got %s", resp.Request.URL)
+			}
+			if strings.Contains(page, `href="/auth/login/keycloak"`) {
+				t.Error("expected the provider to be hidden while the link is pending")
+			}
+
+			resp, err := bob.PostForm(env.server.URL+"/auth/local/login", url.Values{
+				"_csrf":    {csrf},
+				"username": {"bob"},
+				"password": {"correct horse battery"},
+			})
+			if err != nil {
+				t.Fatalf("logging in: %v", err)
+			}
+			resp.Body.Close()
+			if resp.Request.URL.Path != "/settings/identities/pending" {
+				t.Fatalf("expected to be asked to confirm the link, got %s", resp.Request.URL)
+			}
+			if n := env.client.UserIdentity.Query().Where(useridentity.Subject("bob-kc")).CountX(ctx); n != 0 {
+				t.Errorf("expected no link before confirming, got %d", n)
+			}
+
+			_, page, csrf = bob.page(t, env.server.URL+"/settings/identities/pending")
+			if !strings.Contains(page, "Family Keycloak as bob@example.com") {
+				t.Error("expected the confirm page to name the login")
+			}
+			return csrf
+		}
+
+		t.Run("dismiss", func(t *testing.T) {
+			bob := newBrowser()
+			csrf := signIn(t, bob)
+			resp, err := bob.PostForm(env.server.URL+"/settings/identities/pending/dismiss", url.Values{"_csrf": {csrf}})
+			if err != nil {
+				t.Fatalf("dismissing: %v", err)
+			}
+			resp.Body.Close()
+			if resp.Request.URL.Path != "/settings" {
+				t.Errorf("expected to end up on the settings, got %s", resp.Request.URL)
+			}
+			if n := env.client.UserIdentity.Query().Where(useridentity.Subject("bob-kc")).CountX(ctx); n != 0 {
+				t.Errorf("expected no link after dismissing, got %d", n)
+			}
+
+			// Nothing is left to link
+			resp, _, _ = bob.page(t, env.server.URL+"/settings/identities/pending")
+			if resp.Request.URL.Path != "/settings" {
+				t.Errorf("expected the pending login to be gone, got %s", resp.Request.URL)
+			}
+		})
+
+		t.Run("link", func(t *testing.T) {
+			bob := newBrowser()
+			csrf := signIn(t, bob)
+			resp, err := bob.PostForm(env.server.URL+"/settings/identities/pending", url.Values{"_csrf": {csrf}})
+			if err != nil {
+				t.Fatalf("linking: %v", err)
+			}
+			resp.Body.Close()
+			if resp.Request.URL.Path != "/settings" || resp.Request.URL.RawQuery != "" {
+				t.Errorf("expected to come back to the settings, got %s", resp.Request.URL)
+			}
+
+			identity, err := env.client.UserIdentity.Query().Where(useridentity.Subject("bob-kc")).WithUser().Only(ctx)
+			if err != nil || identity.Edges.User.Email != "bob@example.com" {
+				t.Errorf("expected the identity to be linked to bob, got %+v, %v", identity, err)
+			}
+		})
+	})
+
+	t.Run("unverified email of an existing account", func(t *testing.T) {
+		family.LoginAs("bob-unverified")
+		resp, _, _ := newBrowser().page(t, env.server.URL+"/auth/login/keycloak")
+		if resp.Request.URL.Path != "/login/denied" {
+			t.Errorf("expected the login to be denied, got %s", resp.Request.URL)
+		}
+		if n := env.client.UserIdentity.Query().Where(useridentity.Subject("bob-unverified")).CountX(ctx); n != 0 {
+			t.Errorf("expected no identity for the unverified login, got %d", n)
+		}
+	})
+}
@@ -6,13 +6,16 @@ import (
 	"context"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/sessions"
 	"icekalt.dev/money-tracker/ent"
 	"icekalt.dev/money-tracker/internal/api"
 	"icekalt.dev/money-tracker/internal/auth"
 	"icekalt.dev/money-tracker/internal/config"
+	"icekalt.dev/money-tracker/internal/domain"
 	"icekalt.dev/money-tracker/internal/logging"
+	"icekalt.dev/money-tracker/internal/migration"
 	"icekalt.dev/money-tracker/internal/repository"
 	"icekalt.dev/money-tracker/internal/service"
 
@@ -30,36 +33,67 @@ type testEnv struct {
 
 func setupTestEnv(t *testing.T) *testEnv {
 	t.Helper()
+	return setupTestEnvWithOIDC(t, nil)
+}
+
+// setupTestEnvWithOIDC sets up the test server with the OIDC providers that
+// newProviders returns for the server's URL.
+func setupTestEnvWithOIDC(t *testing.T, newProviders func(serverURL string) []*auth.OIDCConfig) *testEnv {
+	t.Helper()
 
 	dbCfg := config.DatabaseConfig{
 		Driver: "sqlite",
 		DSN:    "file::memory:?cache=shared&_pragma=foreign_keys(1)",
 	}
 
-	client, err := repository.NewClient(dbCfg)
+	drv, err := repository.OpenDriver(dbCfg)
 	if err != nil {
-		t.Fatalf("failed to create client: %v", err)
+		t.Fatalf("failed to open database: %v", err)
 	}
-
-	if err := client.Schema.Create(context.Background()); err != nil {
+	migrator, err := migration.New(drv.DB(), drv.Dialect())
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
 		t.Fatalf("failed to migrate: %v", err)
 	}
+	client := repository.NewClientFromDriver(drv)
 
 	userRepo := repository.NewUserRepository(client)
 	householdRepo := repository.NewHouseholdRepository(client)
+	transferRepo := repository.NewHouseholdTransferRepository(client)
 	categoryRepo := repository.NewCategoryRepository(client)
 	txRepo := repository.NewTransactionRepository(client)
 	recurringRepo := repository.NewRecurringExpenseRepository(client)
 	overrideRepo := repository.NewRecurringScheduleOverrideRepository(client)
+	aggregateRepo := repository.NewMonthlyAggregateRepository(client)
 	tokenRepo := repository.NewAPITokenRepository(client)
-
-	userSvc := service.NewUserService(userRepo)
-	householdSvc := service.NewHouseholdService(householdRepo, categoryRepo, txRepo, recurringRepo)
+	memberRepo := repository.NewHouseholdMemberRepository(client)
+	settlementRepo := repository.NewSettlementRepository(client)
+	sessionRepo := repository.NewSessionRepository(client)
+	credentialRepo := repository.NewLocalCredentialRepository(client)
+	identityRepo := repository.NewUserIdentityRepository(client)
+	statsRepo := repository.NewStatsRepository(client, drv)
+	eventRepo := repository.NewSecurityEventRepository(client)
+	revisionRepo := repository.NewRevisionRepository(client)
+	transactor := repository.NewTransactor(client)
+
+	userSvc := service.NewUserService(userRepo, credentialRepo, identityRepo)
+	eventSvc := service.NewSecurityEventService(eventRepo)
+	householdSvc := service.NewHouseholdService(householdRepo, categoryRepo, txRepo, recurringRepo, eventSvc)
 	categorySvc := service.NewCategoryService(categoryRepo, householdSvc)
-	txSvc := service.NewTransactionService(txRepo, householdSvc)
-	recurringSvc := service.NewRecurringExpenseService(recurringRepo, overrideRepo, householdSvc)
-	summarySvc := service.NewSummaryService(txRepo, recurringRepo, overrideRepo, categoryRepo, householdSvc)
-	tokenSvc := service.NewAPITokenService(tokenRepo)
+	memberSvc := service.NewMemberService(memberRepo, householdSvc)
+	txSvc := service.NewTransactionService(txRepo, householdSvc, memberSvc)
+	recurringSvc := service.NewRecurringExpenseService(recurringRepo, overrideRepo, householdSvc, memberSvc)
+	settlementSvc := service.NewSettlementService(settlementRepo, memberRepo, txRepo, recurringRepo, overrideRepo, householdSvc)
+	summarySvc := service.NewSummaryService(txRepo, recurringRepo, overrideRepo, categoryRepo, aggregateRepo, householdSvc)
+	tokenSvc := service.NewAPITokenService(tokenRepo, householdSvc, eventSvc)
+	sessionSvc := service.NewSessionService(sessionRepo, eventSvc)
+	adminSvc := service.NewAdminService(userRepo, credentialRepo, householdRepo, tokenRepo, sessionRepo, revisionRepo, statsRepo, eventSvc, transactor)
+	revisionSvc := service.NewRevisionService(revisionRepo, householdSvc)
+	accountSvc := service.NewAccountService(userRepo, credentialRepo, identityRepo, householdRepo, transferRepo, categoryRepo, memberRepo, txRepo, recurringRepo, overrideRepo, settlementRepo, tokenRepo, sessionRepo, revisionRepo, eventSvc, adminSvc, transactor)
+	identitySvc := service.NewIdentityService(identityRepo, credentialRepo, userRepo, eventSvc)
+	trashSvc := service.NewTrashService(householdRepo, txRepo, recurringRepo, householdSvc, eventSvc, 30*24*time.Hour)
 
 	svcs := &api.Services{
 		User:             userSvc,
@@ -67,8 +101,17 @@ func setupTestEnv(t *testing.T) *testEnv {
 		Category:         categorySvc,
 		Transaction:      txSvc,
 		RecurringExpense: recurringSvc,
+		Member:           memberSvc,
+		Settlement:       settlementSvc,
 		Summary:          summarySvc,
 		APIToken:         tokenSvc,
+		Session:          sessionSvc,
+		Admin:            adminSvc,
+		Security:         eventSvc,
+		Revision:         revisionSvc,
+		Trash:            trashSvc,
+		Account:          accountSvc,
+		Identity:         identitySvc,
 	}
 
 	logger, _ := logging.New("error")
@@ -79,17 +122,25 @@ func setupTestEnv(t *testing.T) *testEnv {
 		t.Fatalf("failed to create dev user: %v", err)
 	}
 
+	// The server's URL is needed for the providers' redirect URLs
+	ts := httptest.NewUnstartedServer(nil)
+	var providers []*auth.OIDCConfig
+	if newProviders != nil {
+		providers = newProviders("http://" + ts.Listener.Addr().String())
+	}
+
 	store := auth.NewSessionStore("test-secret-key-for-testing-only", 3600, false)
-	srv.SetupAuth(nil, store, devUser.ID)
+	srv.SetupAuth(providers, true, store, devUser.ID)
 
 	// Create an API token for authenticated requests
 	userCtx := service.WithUserID(context.Background(), devUser.ID)
-	plainToken, _, err := tokenSvc.Create(userCtx, "test-token")
+	plainToken, _, err := tokenSvc.Create(userCtx, "test-token", domain.TokenScope{}, nil)
 	if err != nil {
 		t.Fatalf("failed to create test token: %v", err)
 	}
 
-	ts := httptest.NewServer(srv.Echo())
+	ts.Config.Handler = srv.Echo()
+	ts.Start()
 
 	t.Cleanup(func() {
 		ts.Close()